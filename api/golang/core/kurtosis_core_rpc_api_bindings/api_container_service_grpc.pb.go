@@ -28,6 +28,7 @@ const (
 	ApiContainerService_ExecCommand_FullMethodName                                = "/api_container_api.ApiContainerService/ExecCommand"
 	ApiContainerService_WaitForHttpGetEndpointAvailability_FullMethodName         = "/api_container_api.ApiContainerService/WaitForHttpGetEndpointAvailability"
 	ApiContainerService_WaitForHttpPostEndpointAvailability_FullMethodName        = "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability"
+	ApiContainerService_HttpRequestService_FullMethodName                         = "/api_container_api.ApiContainerService/HttpRequestService"
 	ApiContainerService_UploadFilesArtifact_FullMethodName                        = "/api_container_api.ApiContainerService/UploadFilesArtifact"
 	ApiContainerService_DownloadFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/DownloadFilesArtifact"
 	ApiContainerService_StoreWebFilesArtifact_FullMethodName                      = "/api_container_api.ApiContainerService/StoreWebFilesArtifact"
@@ -35,6 +36,18 @@ const (
 	ApiContainerService_ListFilesArtifactNamesAndUuids_FullMethodName             = "/api_container_api.ApiContainerService/ListFilesArtifactNamesAndUuids"
 	ApiContainerService_InspectFilesArtifactContents_FullMethodName               = "/api_container_api.ApiContainerService/InspectFilesArtifactContents"
 	ApiContainerService_ConnectServices_FullMethodName                            = "/api_container_api.ApiContainerService/ConnectServices"
+	ApiContainerService_GetEnclaveSnapshot_FullMethodName                         = "/api_container_api.ApiContainerService/GetEnclaveSnapshot"
+	ApiContainerService_RestoreEnclaveSnapshot_FullMethodName                     = "/api_container_api.ApiContainerService/RestoreEnclaveSnapshot"
+	ApiContainerService_Repartition_FullMethodName                                = "/api_container_api.ApiContainerService/Repartition"
+	ApiContainerService_RunStarlarkPackageTests_FullMethodName                    = "/api_container_api.ApiContainerService/RunStarlarkPackageTests"
+	ApiContainerService_GetStarlarkRunPlan_FullMethodName                         = "/api_container_api.ApiContainerService/GetStarlarkRunPlan"
+	ApiContainerService_GetStarlarkRunDiff_FullMethodName                         = "/api_container_api.ApiContainerService/GetStarlarkRunDiff"
+	ApiContainerService_ResumeStarlarkRun_FullMethodName                          = "/api_container_api.ApiContainerService/ResumeStarlarkRun"
+	ApiContainerService_CancelStarlarkRun_FullMethodName                          = "/api_container_api.ApiContainerService/CancelStarlarkRun"
+	ApiContainerService_ListPersistentDirectories_FullMethodName                  = "/api_container_api.ApiContainerService/ListPersistentDirectories"
+	ApiContainerService_RemovePersistentDirectories_FullMethodName                = "/api_container_api.ApiContainerService/RemovePersistentDirectories"
+	ApiContainerService_DownloadPersistentDirectory_FullMethodName                = "/api_container_api.ApiContainerService/DownloadPersistentDirectory"
+	ApiContainerService_StoreFilesArtifactFromPersistentDirectory_FullMethodName  = "/api_container_api.ApiContainerService/StoreFilesArtifactFromPersistentDirectory"
 )
 
 // ApiContainerServiceClient is the client API for ApiContainerService service.
@@ -57,6 +70,8 @@ type ApiContainerServiceClient interface {
 	WaitForHttpGetEndpointAvailability(ctx context.Context, in *WaitForHttpGetEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(ctx context.Context, in *WaitForHttpPostEndpointAvailabilityArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Sends an HTTP request to a port of a service from inside the enclave, and returns the response
+	HttpRequestService(ctx context.Context, in *HttpRequestServiceArgs, opts ...grpc.CallOption) (*HttpRequestServiceResponse, error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactClient, error)
 	// Downloads a files artifact from the Kurtosis File System
@@ -69,6 +84,37 @@ type ApiContainerServiceClient interface {
 	InspectFilesArtifactContents(ctx context.Context, in *InspectFilesArtifactContentsRequest, opts ...grpc.CallOption) (*InspectFilesArtifactContentsResponse, error)
 	// User services port forwarding
 	ConnectServices(ctx context.Context, in *ConnectServicesArgs, opts ...grpc.CallOption) (*ConnectServicesResponse, error)
+	// Streams back an archive containing the enclave plan, the enclave database, the files artifacts and optionally
+	// the persistent directories of the enclave, which can later be used to restore an equivalent enclave
+	GetEnclaveSnapshot(ctx context.Context, in *GetEnclaveSnapshotArgs, opts ...grpc.CallOption) (ApiContainerService_GetEnclaveSnapshotClient, error)
+	// Restores the content of an enclave snapshot into this (empty) enclave. The returned script needs to be run
+	// to recreate the services of the snapshot
+	RestoreEnclaveSnapshot(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_RestoreEnclaveSnapshotClient, error)
+	// Splits the services of the enclave into partitions and conditions the traffic between those partitions
+	Repartition(ctx context.Context, in *RepartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Runs the test functions of the *_test.star files of a package uploaded beforehand against a fake service network,
+	// without starting any container
+	RunStarlarkPackageTests(ctx context.Context, in *RunStarlarkPackageTestsArgs, opts ...grpc.CallOption) (*RunStarlarkPackageTestsResponse, error)
+	// Interprets a Starlark script or package without executing it, and returns the plan describing
+	// what running it against an empty enclave would do
+	GetStarlarkRunPlan(ctx context.Context, in *GetStarlarkRunPlanArgs, opts ...grpc.CallOption) (*GetStarlarkRunPlanResponse, error)
+	// Interprets a Starlark script or package without executing it, and returns the changes running it would make to
+	// the services of the enclave given the instructions run in the enclave before
+	GetStarlarkRunDiff(ctx context.Context, in *GetStarlarkRunDiffArgs, opts ...grpc.CallOption) (*GetStarlarkRunDiffResponse, error)
+	// Streams the response lines of the current Starlark run (or the last one if none is running) starting at the given
+	// line index, and keeps streaming until the run finishes. Lets clients reconnect to a run after a disconnect
+	ResumeStarlarkRun(ctx context.Context, in *ResumeStarlarkRunArgs, opts ...grpc.CallOption) (ApiContainerService_ResumeStarlarkRunClient, error)
+	// Cancels the given Starlark run if it's still running. Runs aren't tied to the stream they were started with, so
+	// this is the way for clients to stop a run
+	CancelStarlarkRun(ctx context.Context, in *CancelStarlarkRunArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Lists the persistent directories of the enclave, including the ones of services that were removed since
+	ListPersistentDirectories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPersistentDirectoriesResponse, error)
+	// Removes persistent directories of a service, along with their content
+	RemovePersistentDirectories(ctx context.Context, in *RemovePersistentDirectoriesArgs, opts ...grpc.CallOption) (*RemovePersistentDirectoriesResponse, error)
+	// Downloads the content of a persistent directory as a TAR
+	DownloadPersistentDirectory(ctx context.Context, in *DownloadPersistentDirectoryArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadPersistentDirectoryClient, error)
+	// Stores the content of a persistent directory in a new files artifact, e.g. to seed other persistent directories
+	StoreFilesArtifactFromPersistentDirectory(ctx context.Context, in *StoreFilesArtifactFromPersistentDirectoryArgs, opts ...grpc.CallOption) (*StoreFilesArtifactFromPersistentDirectoryResponse, error)
 }
 
 type apiContainerServiceClient struct {
@@ -222,6 +268,15 @@ func (c *apiContainerServiceClient) WaitForHttpPostEndpointAvailability(ctx cont
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) HttpRequestService(ctx context.Context, in *HttpRequestServiceArgs, opts ...grpc.CallOption) (*HttpRequestServiceResponse, error) {
+	out := new(HttpRequestServiceResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_HttpRequestService_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiContainerServiceClient) UploadFilesArtifact(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_UploadFilesArtifactClient, error) {
 	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[3], ApiContainerService_UploadFilesArtifact_FullMethodName, opts...)
 	if err != nil {
@@ -333,6 +388,208 @@ func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, in *Con
 	return out, nil
 }
 
+func (c *apiContainerServiceClient) GetEnclaveSnapshot(ctx context.Context, in *GetEnclaveSnapshotArgs, opts ...grpc.CallOption) (ApiContainerService_GetEnclaveSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[5], ApiContainerService_GetEnclaveSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceGetEnclaveSnapshotClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_GetEnclaveSnapshotClient interface {
+	Recv() (*StreamedDataChunk, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceGetEnclaveSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceGetEnclaveSnapshotClient) Recv() (*StreamedDataChunk, error) {
+	m := new(StreamedDataChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) RestoreEnclaveSnapshot(ctx context.Context, opts ...grpc.CallOption) (ApiContainerService_RestoreEnclaveSnapshotClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[6], ApiContainerService_RestoreEnclaveSnapshot_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceRestoreEnclaveSnapshotClient{stream}
+	return x, nil
+}
+
+type ApiContainerService_RestoreEnclaveSnapshotClient interface {
+	Send(*StreamedDataChunk) error
+	CloseAndRecv() (*RestoreEnclaveSnapshotResponse, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceRestoreEnclaveSnapshotClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceRestoreEnclaveSnapshotClient) Send(m *StreamedDataChunk) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceRestoreEnclaveSnapshotClient) CloseAndRecv() (*RestoreEnclaveSnapshotResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(RestoreEnclaveSnapshotResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) Repartition(ctx context.Context, in *RepartitionArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_Repartition_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RunStarlarkPackageTests(ctx context.Context, in *RunStarlarkPackageTestsArgs, opts ...grpc.CallOption) (*RunStarlarkPackageTestsResponse, error) {
+	out := new(RunStarlarkPackageTestsResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_RunStarlarkPackageTests_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetStarlarkRunPlan(ctx context.Context, in *GetStarlarkRunPlanArgs, opts ...grpc.CallOption) (*GetStarlarkRunPlanResponse, error) {
+	out := new(GetStarlarkRunPlanResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetStarlarkRunPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) GetStarlarkRunDiff(ctx context.Context, in *GetStarlarkRunDiffArgs, opts ...grpc.CallOption) (*GetStarlarkRunDiffResponse, error) {
+	out := new(GetStarlarkRunDiffResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_GetStarlarkRunDiff_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ResumeStarlarkRun(ctx context.Context, in *ResumeStarlarkRunArgs, opts ...grpc.CallOption) (ApiContainerService_ResumeStarlarkRunClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[7], ApiContainerService_ResumeStarlarkRun_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceResumeStarlarkRunClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_ResumeStarlarkRunClient interface {
+	Recv() (*StarlarkRunResponseLine, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceResumeStarlarkRunClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceResumeStarlarkRunClient) Recv() (*StarlarkRunResponseLine, error) {
+	m := new(StarlarkRunResponseLine)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) CancelStarlarkRun(ctx context.Context, in *CancelStarlarkRunArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApiContainerService_CancelStarlarkRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) ListPersistentDirectories(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListPersistentDirectoriesResponse, error) {
+	out := new(ListPersistentDirectoriesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_ListPersistentDirectories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) RemovePersistentDirectories(ctx context.Context, in *RemovePersistentDirectoriesArgs, opts ...grpc.CallOption) (*RemovePersistentDirectoriesResponse, error) {
+	out := new(RemovePersistentDirectoriesResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_RemovePersistentDirectories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiContainerServiceClient) DownloadPersistentDirectory(ctx context.Context, in *DownloadPersistentDirectoryArgs, opts ...grpc.CallOption) (ApiContainerService_DownloadPersistentDirectoryClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ApiContainerService_ServiceDesc.Streams[8], ApiContainerService_DownloadPersistentDirectory_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &apiContainerServiceDownloadPersistentDirectoryClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ApiContainerService_DownloadPersistentDirectoryClient interface {
+	Recv() (*StreamedDataChunk, error)
+	grpc.ClientStream
+}
+
+type apiContainerServiceDownloadPersistentDirectoryClient struct {
+	grpc.ClientStream
+}
+
+func (x *apiContainerServiceDownloadPersistentDirectoryClient) Recv() (*StreamedDataChunk, error) {
+	m := new(StreamedDataChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *apiContainerServiceClient) StoreFilesArtifactFromPersistentDirectory(ctx context.Context, in *StoreFilesArtifactFromPersistentDirectoryArgs, opts ...grpc.CallOption) (*StoreFilesArtifactFromPersistentDirectoryResponse, error) {
+	out := new(StoreFilesArtifactFromPersistentDirectoryResponse)
+	err := c.cc.Invoke(ctx, ApiContainerService_StoreFilesArtifactFromPersistentDirectory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApiContainerServiceServer is the server API for ApiContainerService service.
 // All implementations should embed UnimplementedApiContainerServiceServer
 // for forward compatibility
@@ -353,6 +610,8 @@ type ApiContainerServiceServer interface {
 	WaitForHttpGetEndpointAvailability(context.Context, *WaitForHttpGetEndpointAvailabilityArgs) (*emptypb.Empty, error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(context.Context, *WaitForHttpPostEndpointAvailabilityArgs) (*emptypb.Empty, error)
+	// Sends an HTTP request to a port of a service from inside the enclave, and returns the response
+	HttpRequestService(context.Context, *HttpRequestServiceArgs) (*HttpRequestServiceResponse, error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(ApiContainerService_UploadFilesArtifactServer) error
 	// Downloads a files artifact from the Kurtosis File System
@@ -365,6 +624,37 @@ type ApiContainerServiceServer interface {
 	InspectFilesArtifactContents(context.Context, *InspectFilesArtifactContentsRequest) (*InspectFilesArtifactContentsResponse, error)
 	// User services port forwarding
 	ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error)
+	// Streams back an archive containing the enclave plan, the enclave database, the files artifacts and optionally
+	// the persistent directories of the enclave, which can later be used to restore an equivalent enclave
+	GetEnclaveSnapshot(*GetEnclaveSnapshotArgs, ApiContainerService_GetEnclaveSnapshotServer) error
+	// Restores the content of an enclave snapshot into this (empty) enclave. The returned script needs to be run
+	// to recreate the services of the snapshot
+	RestoreEnclaveSnapshot(ApiContainerService_RestoreEnclaveSnapshotServer) error
+	// Splits the services of the enclave into partitions and conditions the traffic between those partitions
+	Repartition(context.Context, *RepartitionArgs) (*emptypb.Empty, error)
+	// Runs the test functions of the *_test.star files of a package uploaded beforehand against a fake service network,
+	// without starting any container
+	RunStarlarkPackageTests(context.Context, *RunStarlarkPackageTestsArgs) (*RunStarlarkPackageTestsResponse, error)
+	// Interprets a Starlark script or package without executing it, and returns the plan describing
+	// what running it against an empty enclave would do
+	GetStarlarkRunPlan(context.Context, *GetStarlarkRunPlanArgs) (*GetStarlarkRunPlanResponse, error)
+	// Interprets a Starlark script or package without executing it, and returns the changes running it would make to
+	// the services of the enclave given the instructions run in the enclave before
+	GetStarlarkRunDiff(context.Context, *GetStarlarkRunDiffArgs) (*GetStarlarkRunDiffResponse, error)
+	// Streams the response lines of the current Starlark run (or the last one if none is running) starting at the given
+	// line index, and keeps streaming until the run finishes. Lets clients reconnect to a run after a disconnect
+	ResumeStarlarkRun(*ResumeStarlarkRunArgs, ApiContainerService_ResumeStarlarkRunServer) error
+	// Cancels the given Starlark run if it's still running. Runs aren't tied to the stream they were started with, so
+	// this is the way for clients to stop a run
+	CancelStarlarkRun(context.Context, *CancelStarlarkRunArgs) (*emptypb.Empty, error)
+	// Lists the persistent directories of the enclave, including the ones of services that were removed since
+	ListPersistentDirectories(context.Context, *emptypb.Empty) (*ListPersistentDirectoriesResponse, error)
+	// Removes persistent directories of a service, along with their content
+	RemovePersistentDirectories(context.Context, *RemovePersistentDirectoriesArgs) (*RemovePersistentDirectoriesResponse, error)
+	// Downloads the content of a persistent directory as a TAR
+	DownloadPersistentDirectory(*DownloadPersistentDirectoryArgs, ApiContainerService_DownloadPersistentDirectoryServer) error
+	// Stores the content of a persistent directory in a new files artifact, e.g. to seed other persistent directories
+	StoreFilesArtifactFromPersistentDirectory(context.Context, *StoreFilesArtifactFromPersistentDirectoryArgs) (*StoreFilesArtifactFromPersistentDirectoryResponse, error)
 }
 
 // UnimplementedApiContainerServiceServer should be embedded to have forward compatible implementations.
@@ -395,6 +685,9 @@ func (UnimplementedApiContainerServiceServer) WaitForHttpGetEndpointAvailability
 func (UnimplementedApiContainerServiceServer) WaitForHttpPostEndpointAvailability(context.Context, *WaitForHttpPostEndpointAvailabilityArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WaitForHttpPostEndpointAvailability not implemented")
 }
+func (UnimplementedApiContainerServiceServer) HttpRequestService(context.Context, *HttpRequestServiceArgs) (*HttpRequestServiceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HttpRequestService not implemented")
+}
 func (UnimplementedApiContainerServiceServer) UploadFilesArtifact(ApiContainerService_UploadFilesArtifactServer) error {
 	return status.Errorf(codes.Unimplemented, "method UploadFilesArtifact not implemented")
 }
@@ -416,6 +709,42 @@ func (UnimplementedApiContainerServiceServer) InspectFilesArtifactContents(conte
 func (UnimplementedApiContainerServiceServer) ConnectServices(context.Context, *ConnectServicesArgs) (*ConnectServicesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectServices not implemented")
 }
+func (UnimplementedApiContainerServiceServer) GetEnclaveSnapshot(*GetEnclaveSnapshotArgs, ApiContainerService_GetEnclaveSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetEnclaveSnapshot not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RestoreEnclaveSnapshot(ApiContainerService_RestoreEnclaveSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method RestoreEnclaveSnapshot not implemented")
+}
+func (UnimplementedApiContainerServiceServer) Repartition(context.Context, *RepartitionArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Repartition not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RunStarlarkPackageTests(context.Context, *RunStarlarkPackageTestsArgs) (*RunStarlarkPackageTestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunStarlarkPackageTests not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetStarlarkRunPlan(context.Context, *GetStarlarkRunPlanArgs) (*GetStarlarkRunPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRunPlan not implemented")
+}
+func (UnimplementedApiContainerServiceServer) GetStarlarkRunDiff(context.Context, *GetStarlarkRunDiffArgs) (*GetStarlarkRunDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStarlarkRunDiff not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ResumeStarlarkRun(*ResumeStarlarkRunArgs, ApiContainerService_ResumeStarlarkRunServer) error {
+	return status.Errorf(codes.Unimplemented, "method ResumeStarlarkRun not implemented")
+}
+func (UnimplementedApiContainerServiceServer) CancelStarlarkRun(context.Context, *CancelStarlarkRunArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelStarlarkRun not implemented")
+}
+func (UnimplementedApiContainerServiceServer) ListPersistentDirectories(context.Context, *emptypb.Empty) (*ListPersistentDirectoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPersistentDirectories not implemented")
+}
+func (UnimplementedApiContainerServiceServer) RemovePersistentDirectories(context.Context, *RemovePersistentDirectoriesArgs) (*RemovePersistentDirectoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePersistentDirectories not implemented")
+}
+func (UnimplementedApiContainerServiceServer) DownloadPersistentDirectory(*DownloadPersistentDirectoryArgs, ApiContainerService_DownloadPersistentDirectoryServer) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadPersistentDirectory not implemented")
+}
+func (UnimplementedApiContainerServiceServer) StoreFilesArtifactFromPersistentDirectory(context.Context, *StoreFilesArtifactFromPersistentDirectoryArgs) (*StoreFilesArtifactFromPersistentDirectoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StoreFilesArtifactFromPersistentDirectory not implemented")
+}
 
 // UnsafeApiContainerServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ApiContainerServiceServer will
@@ -586,6 +915,24 @@ func _ApiContainerService_WaitForHttpPostEndpointAvailability_Handler(srv interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_HttpRequestService_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HttpRequestServiceArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).HttpRequestService(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_HttpRequestService_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).HttpRequestService(ctx, req.(*HttpRequestServiceArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApiContainerService_UploadFilesArtifact_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(ApiContainerServiceServer).UploadFilesArtifact(&apiContainerServiceUploadFilesArtifactServer{stream})
 }
@@ -723,6 +1070,239 @@ func _ApiContainerService_ConnectServices_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApiContainerService_GetEnclaveSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetEnclaveSnapshotArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).GetEnclaveSnapshot(m, &apiContainerServiceGetEnclaveSnapshotServer{stream})
+}
+
+type ApiContainerService_GetEnclaveSnapshotServer interface {
+	Send(*StreamedDataChunk) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceGetEnclaveSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceGetEnclaveSnapshotServer) Send(m *StreamedDataChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_RestoreEnclaveSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(ApiContainerServiceServer).RestoreEnclaveSnapshot(&apiContainerServiceRestoreEnclaveSnapshotServer{stream})
+}
+
+type ApiContainerService_RestoreEnclaveSnapshotServer interface {
+	SendAndClose(*RestoreEnclaveSnapshotResponse) error
+	Recv() (*StreamedDataChunk, error)
+	grpc.ServerStream
+}
+
+type apiContainerServiceRestoreEnclaveSnapshotServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceRestoreEnclaveSnapshotServer) SendAndClose(m *RestoreEnclaveSnapshotResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *apiContainerServiceRestoreEnclaveSnapshotServer) Recv() (*StreamedDataChunk, error) {
+	m := new(StreamedDataChunk)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _ApiContainerService_Repartition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepartitionArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).Repartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_Repartition_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).Repartition(ctx, req.(*RepartitionArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RunStarlarkPackageTests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunStarlarkPackageTestsArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RunStarlarkPackageTests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RunStarlarkPackageTests_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RunStarlarkPackageTests(ctx, req.(*RunStarlarkPackageTestsArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetStarlarkRunPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStarlarkRunPlanArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetStarlarkRunPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunPlan(ctx, req.(*GetStarlarkRunPlanArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_GetStarlarkRunDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStarlarkRunDiffArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_GetStarlarkRunDiff_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).GetStarlarkRunDiff(ctx, req.(*GetStarlarkRunDiffArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ResumeStarlarkRun_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ResumeStarlarkRunArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).ResumeStarlarkRun(m, &apiContainerServiceResumeStarlarkRunServer{stream})
+}
+
+type ApiContainerService_ResumeStarlarkRunServer interface {
+	Send(*StarlarkRunResponseLine) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceResumeStarlarkRunServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceResumeStarlarkRunServer) Send(m *StarlarkRunResponseLine) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_CancelStarlarkRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelStarlarkRunArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).CancelStarlarkRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_CancelStarlarkRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).CancelStarlarkRun(ctx, req.(*CancelStarlarkRunArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_ListPersistentDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).ListPersistentDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_ListPersistentDirectories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).ListPersistentDirectories(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_RemovePersistentDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePersistentDirectoriesArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).RemovePersistentDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_RemovePersistentDirectories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).RemovePersistentDirectories(ctx, req.(*RemovePersistentDirectoriesArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApiContainerService_DownloadPersistentDirectory_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DownloadPersistentDirectoryArgs)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ApiContainerServiceServer).DownloadPersistentDirectory(m, &apiContainerServiceDownloadPersistentDirectoryServer{stream})
+}
+
+type ApiContainerService_DownloadPersistentDirectoryServer interface {
+	Send(*StreamedDataChunk) error
+	grpc.ServerStream
+}
+
+type apiContainerServiceDownloadPersistentDirectoryServer struct {
+	grpc.ServerStream
+}
+
+func (x *apiContainerServiceDownloadPersistentDirectoryServer) Send(m *StreamedDataChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ApiContainerService_StoreFilesArtifactFromPersistentDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StoreFilesArtifactFromPersistentDirectoryArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiContainerServiceServer).StoreFilesArtifactFromPersistentDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApiContainerService_StoreFilesArtifactFromPersistentDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiContainerServiceServer).StoreFilesArtifactFromPersistentDirectory(ctx, req.(*StoreFilesArtifactFromPersistentDirectoryArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApiContainerService_ServiceDesc is the grpc.ServiceDesc for ApiContainerService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -750,6 +1330,10 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "WaitForHttpPostEndpointAvailability",
 			Handler:    _ApiContainerService_WaitForHttpPostEndpointAvailability_Handler,
 		},
+		{
+			MethodName: "HttpRequestService",
+			Handler:    _ApiContainerService_HttpRequestService_Handler,
+		},
 		{
 			MethodName: "StoreWebFilesArtifact",
 			Handler:    _ApiContainerService_StoreWebFilesArtifact_Handler,
@@ -770,6 +1354,38 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectServices",
 			Handler:    _ApiContainerService_ConnectServices_Handler,
 		},
+		{
+			MethodName: "Repartition",
+			Handler:    _ApiContainerService_Repartition_Handler,
+		},
+		{
+			MethodName: "RunStarlarkPackageTests",
+			Handler:    _ApiContainerService_RunStarlarkPackageTests_Handler,
+		},
+		{
+			MethodName: "GetStarlarkRunPlan",
+			Handler:    _ApiContainerService_GetStarlarkRunPlan_Handler,
+		},
+		{
+			MethodName: "GetStarlarkRunDiff",
+			Handler:    _ApiContainerService_GetStarlarkRunDiff_Handler,
+		},
+		{
+			MethodName: "CancelStarlarkRun",
+			Handler:    _ApiContainerService_CancelStarlarkRun_Handler,
+		},
+		{
+			MethodName: "ListPersistentDirectories",
+			Handler:    _ApiContainerService_ListPersistentDirectories_Handler,
+		},
+		{
+			MethodName: "RemovePersistentDirectories",
+			Handler:    _ApiContainerService_RemovePersistentDirectories_Handler,
+		},
+		{
+			MethodName: "StoreFilesArtifactFromPersistentDirectory",
+			Handler:    _ApiContainerService_StoreFilesArtifactFromPersistentDirectory_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -797,6 +1413,26 @@ var ApiContainerService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _ApiContainerService_DownloadFilesArtifact_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetEnclaveSnapshot",
+			Handler:       _ApiContainerService_GetEnclaveSnapshot_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RestoreEnclaveSnapshot",
+			Handler:       _ApiContainerService_RestoreEnclaveSnapshot_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ResumeStarlarkRun",
+			Handler:       _ApiContainerService_ResumeStarlarkRun_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadPersistentDirectory",
+			Handler:       _ApiContainerService_DownloadPersistentDirectory_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "api_container_service.proto",
 }
@@ -58,6 +58,9 @@ const (
 	// ApiContainerServiceWaitForHttpPostEndpointAvailabilityProcedure is the fully-qualified name of
 	// the ApiContainerService's WaitForHttpPostEndpointAvailability RPC.
 	ApiContainerServiceWaitForHttpPostEndpointAvailabilityProcedure = "/api_container_api.ApiContainerService/WaitForHttpPostEndpointAvailability"
+	// ApiContainerServiceHttpRequestServiceProcedure is the fully-qualified name of the
+	// ApiContainerService's HttpRequestService RPC.
+	ApiContainerServiceHttpRequestServiceProcedure = "/api_container_api.ApiContainerService/HttpRequestService"
 	// ApiContainerServiceUploadFilesArtifactProcedure is the fully-qualified name of the
 	// ApiContainerService's UploadFilesArtifact RPC.
 	ApiContainerServiceUploadFilesArtifactProcedure = "/api_container_api.ApiContainerService/UploadFilesArtifact"
@@ -79,6 +82,42 @@ const (
 	// ApiContainerServiceConnectServicesProcedure is the fully-qualified name of the
 	// ApiContainerService's ConnectServices RPC.
 	ApiContainerServiceConnectServicesProcedure = "/api_container_api.ApiContainerService/ConnectServices"
+	// ApiContainerServiceGetEnclaveSnapshotProcedure is the fully-qualified name of the
+	// ApiContainerService's GetEnclaveSnapshot RPC.
+	ApiContainerServiceGetEnclaveSnapshotProcedure = "/api_container_api.ApiContainerService/GetEnclaveSnapshot"
+	// ApiContainerServiceRestoreEnclaveSnapshotProcedure is the fully-qualified name of the
+	// ApiContainerService's RestoreEnclaveSnapshot RPC.
+	ApiContainerServiceRestoreEnclaveSnapshotProcedure = "/api_container_api.ApiContainerService/RestoreEnclaveSnapshot"
+	// ApiContainerServiceRepartitionProcedure is the fully-qualified name of the ApiContainerService's
+	// Repartition RPC.
+	ApiContainerServiceRepartitionProcedure = "/api_container_api.ApiContainerService/Repartition"
+	// ApiContainerServiceRunStarlarkPackageTestsProcedure is the fully-qualified name of the
+	// ApiContainerService's RunStarlarkPackageTests RPC.
+	ApiContainerServiceRunStarlarkPackageTestsProcedure = "/api_container_api.ApiContainerService/RunStarlarkPackageTests"
+	// ApiContainerServiceGetStarlarkRunPlanProcedure is the fully-qualified name of the
+	// ApiContainerService's GetStarlarkRunPlan RPC.
+	ApiContainerServiceGetStarlarkRunPlanProcedure = "/api_container_api.ApiContainerService/GetStarlarkRunPlan"
+	// ApiContainerServiceGetStarlarkRunDiffProcedure is the fully-qualified name of the
+	// ApiContainerService's GetStarlarkRunDiff RPC.
+	ApiContainerServiceGetStarlarkRunDiffProcedure = "/api_container_api.ApiContainerService/GetStarlarkRunDiff"
+	// ApiContainerServiceResumeStarlarkRunProcedure is the fully-qualified name of the
+	// ApiContainerService's ResumeStarlarkRun RPC.
+	ApiContainerServiceResumeStarlarkRunProcedure = "/api_container_api.ApiContainerService/ResumeStarlarkRun"
+	// ApiContainerServiceCancelStarlarkRunProcedure is the fully-qualified name of the
+	// ApiContainerService's CancelStarlarkRun RPC.
+	ApiContainerServiceCancelStarlarkRunProcedure = "/api_container_api.ApiContainerService/CancelStarlarkRun"
+	// ApiContainerServiceListPersistentDirectoriesProcedure is the fully-qualified name of the
+	// ApiContainerService's ListPersistentDirectories RPC.
+	ApiContainerServiceListPersistentDirectoriesProcedure = "/api_container_api.ApiContainerService/ListPersistentDirectories"
+	// ApiContainerServiceRemovePersistentDirectoriesProcedure is the fully-qualified name of the
+	// ApiContainerService's RemovePersistentDirectories RPC.
+	ApiContainerServiceRemovePersistentDirectoriesProcedure = "/api_container_api.ApiContainerService/RemovePersistentDirectories"
+	// ApiContainerServiceDownloadPersistentDirectoryProcedure is the fully-qualified name of the
+	// ApiContainerService's DownloadPersistentDirectory RPC.
+	ApiContainerServiceDownloadPersistentDirectoryProcedure = "/api_container_api.ApiContainerService/DownloadPersistentDirectory"
+	// ApiContainerServiceStoreFilesArtifactFromPersistentDirectoryProcedure is the fully-qualified name
+	// of the ApiContainerService's StoreFilesArtifactFromPersistentDirectory RPC.
+	ApiContainerServiceStoreFilesArtifactFromPersistentDirectoryProcedure = "/api_container_api.ApiContainerService/StoreFilesArtifactFromPersistentDirectory"
 )
 
 // ApiContainerServiceClient is a client for the api_container_api.ApiContainerService service.
@@ -99,6 +138,8 @@ type ApiContainerServiceClient interface {
 	WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
+	// Sends an HTTP request to a port of a service from inside the enclave, and returns the response
+	HttpRequestService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse], error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse]
 	// Downloads a files artifact from the Kurtosis File System
@@ -111,6 +152,37 @@ type ApiContainerServiceClient interface {
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
+	// Streams back an archive containing the enclave plan, the enclave database, the files artifacts and optionally
+	// the persistent directories of the enclave, which can later be used to restore an equivalent enclave
+	GetEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error)
+	// Restores the content of an enclave snapshot into this (empty) enclave. The returned script needs to be run
+	// to recreate the services of the snapshot
+	RestoreEnclaveSnapshot(context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse]
+	// Splits the services of the enclave into partitions and conditions the traffic between those partitions
+	Repartition(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RepartitionArgs]) (*connect.Response[emptypb.Empty], error)
+	// Runs the test functions of the *_test.star files of a package uploaded beforehand against a fake service network,
+	// without starting any container
+	RunStarlarkPackageTests(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse], error)
+	// Interprets a Starlark script or package without executing it, and returns the plan describing
+	// what running it against an empty enclave would do
+	GetStarlarkRunPlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse], error)
+	// Interprets a Starlark script or package without executing it, and returns the changes running it would make to
+	// the services of the enclave given the instructions run in the enclave before
+	GetStarlarkRunDiff(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse], error)
+	// Streams the response lines of the current Starlark run (or the last one if none is running) starting at the given
+	// line index, and keeps streaming until the run finishes. Lets clients reconnect to a run after a disconnect
+	ResumeStarlarkRun(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error)
+	// Cancels the given Starlark run if it's still running. Runs aren't tied to the stream they were started with, so
+	// this is the way for clients to stop a run
+	CancelStarlarkRun(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs]) (*connect.Response[emptypb.Empty], error)
+	// Lists the persistent directories of the enclave, including the ones of services that were removed since
+	ListPersistentDirectories(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse], error)
+	// Removes persistent directories of a service, along with their content
+	RemovePersistentDirectories(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse], error)
+	// Downloads the content of a persistent directory as a TAR
+	DownloadPersistentDirectory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error)
+	// Stores the content of a persistent directory in a new files artifact, e.g. to seed other persistent directories
+	StoreFilesArtifactFromPersistentDirectory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse], error)
 }
 
 // NewApiContainerServiceClient constructs a client for the api_container_api.ApiContainerService
@@ -163,6 +235,11 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceWaitForHttpPostEndpointAvailabilityProcedure,
 			opts...,
 		),
+		httpRequestService: connect.NewClient[kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs, kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse](
+			httpClient,
+			baseURL+ApiContainerServiceHttpRequestServiceProcedure,
+			opts...,
+		),
 		uploadFilesArtifact: connect.NewClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse](
 			httpClient,
 			baseURL+ApiContainerServiceUploadFilesArtifactProcedure,
@@ -198,6 +275,66 @@ func NewApiContainerServiceClient(httpClient connect.HTTPClient, baseURL string,
 			baseURL+ApiContainerServiceConnectServicesProcedure,
 			opts...,
 		),
+		getEnclaveSnapshot: connect.NewClient[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk](
+			httpClient,
+			baseURL+ApiContainerServiceGetEnclaveSnapshotProcedure,
+			opts...,
+		),
+		restoreEnclaveSnapshot: connect.NewClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse](
+			httpClient,
+			baseURL+ApiContainerServiceRestoreEnclaveSnapshotProcedure,
+			opts...,
+		),
+		repartition: connect.NewClient[kurtosis_core_rpc_api_bindings.RepartitionArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceRepartitionProcedure,
+			opts...,
+		),
+		runStarlarkPackageTests: connect.NewClient[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs, kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse](
+			httpClient,
+			baseURL+ApiContainerServiceRunStarlarkPackageTestsProcedure,
+			opts...,
+		),
+		getStarlarkRunPlan: connect.NewClient[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs, kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetStarlarkRunPlanProcedure,
+			opts...,
+		),
+		getStarlarkRunDiff: connect.NewClient[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs, kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse](
+			httpClient,
+			baseURL+ApiContainerServiceGetStarlarkRunDiffProcedure,
+			opts...,
+		),
+		resumeStarlarkRun: connect.NewClient[kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](
+			httpClient,
+			baseURL+ApiContainerServiceResumeStarlarkRunProcedure,
+			opts...,
+		),
+		cancelStarlarkRun: connect.NewClient[kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs, emptypb.Empty](
+			httpClient,
+			baseURL+ApiContainerServiceCancelStarlarkRunProcedure,
+			opts...,
+		),
+		listPersistentDirectories: connect.NewClient[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse](
+			httpClient,
+			baseURL+ApiContainerServiceListPersistentDirectoriesProcedure,
+			opts...,
+		),
+		removePersistentDirectories: connect.NewClient[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs, kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse](
+			httpClient,
+			baseURL+ApiContainerServiceRemovePersistentDirectoriesProcedure,
+			opts...,
+		),
+		downloadPersistentDirectory: connect.NewClient[kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk](
+			httpClient,
+			baseURL+ApiContainerServiceDownloadPersistentDirectoryProcedure,
+			opts...,
+		),
+		storeFilesArtifactFromPersistentDirectory: connect.NewClient[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs, kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse](
+			httpClient,
+			baseURL+ApiContainerServiceStoreFilesArtifactFromPersistentDirectoryProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -211,6 +348,7 @@ type apiContainerServiceClient struct {
 	execCommand                                *connect.Client[kurtosis_core_rpc_api_bindings.ExecCommandArgs, kurtosis_core_rpc_api_bindings.ExecCommandResponse]
 	waitForHttpGetEndpointAvailability         *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs, emptypb.Empty]
 	waitForHttpPostEndpointAvailability        *connect.Client[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs, emptypb.Empty]
+	httpRequestService                         *connect.Client[kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs, kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse]
 	uploadFilesArtifact                        *connect.Client[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse]
 	downloadFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
 	storeWebFilesArtifact                      *connect.Client[kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactArgs, kurtosis_core_rpc_api_bindings.StoreWebFilesArtifactResponse]
@@ -218,6 +356,18 @@ type apiContainerServiceClient struct {
 	listFilesArtifactNamesAndUuids             *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse]
 	inspectFilesArtifactContents               *connect.Client[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest, kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse]
 	connectServices                            *connect.Client[kurtosis_core_rpc_api_bindings.ConnectServicesArgs, kurtosis_core_rpc_api_bindings.ConnectServicesResponse]
+	getEnclaveSnapshot                         *connect.Client[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
+	restoreEnclaveSnapshot                     *connect.Client[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse]
+	repartition                                *connect.Client[kurtosis_core_rpc_api_bindings.RepartitionArgs, emptypb.Empty]
+	runStarlarkPackageTests                    *connect.Client[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs, kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse]
+	getStarlarkRunPlan                         *connect.Client[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs, kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse]
+	getStarlarkRunDiff                         *connect.Client[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs, kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse]
+	resumeStarlarkRun                          *connect.Client[kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs, kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]
+	cancelStarlarkRun                          *connect.Client[kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs, emptypb.Empty]
+	listPersistentDirectories                  *connect.Client[emptypb.Empty, kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse]
+	removePersistentDirectories                *connect.Client[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs, kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse]
+	downloadPersistentDirectory                *connect.Client[kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs, kurtosis_core_rpc_api_bindings.StreamedDataChunk]
+	storeFilesArtifactFromPersistentDirectory  *connect.Client[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs, kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse]
 }
 
 // RunStarlarkScript calls api_container_api.ApiContainerService.RunStarlarkScript.
@@ -263,6 +413,11 @@ func (c *apiContainerServiceClient) WaitForHttpPostEndpointAvailability(ctx cont
 	return c.waitForHttpPostEndpointAvailability.CallUnary(ctx, req)
 }
 
+// HttpRequestService calls api_container_api.ApiContainerService.HttpRequestService.
+func (c *apiContainerServiceClient) HttpRequestService(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse], error) {
+	return c.httpRequestService.CallUnary(ctx, req)
+}
+
 // UploadFilesArtifact calls api_container_api.ApiContainerService.UploadFilesArtifact.
 func (c *apiContainerServiceClient) UploadFilesArtifact(ctx context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse] {
 	return c.uploadFilesArtifact.CallClientStream(ctx)
@@ -301,6 +456,69 @@ func (c *apiContainerServiceClient) ConnectServices(ctx context.Context, req *co
 	return c.connectServices.CallUnary(ctx, req)
 }
 
+// GetEnclaveSnapshot calls api_container_api.ApiContainerService.GetEnclaveSnapshot.
+func (c *apiContainerServiceClient) GetEnclaveSnapshot(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error) {
+	return c.getEnclaveSnapshot.CallServerStream(ctx, req)
+}
+
+// RestoreEnclaveSnapshot calls api_container_api.ApiContainerService.RestoreEnclaveSnapshot.
+func (c *apiContainerServiceClient) RestoreEnclaveSnapshot(ctx context.Context) *connect.ClientStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse] {
+	return c.restoreEnclaveSnapshot.CallClientStream(ctx)
+}
+
+// Repartition calls api_container_api.ApiContainerService.Repartition.
+func (c *apiContainerServiceClient) Repartition(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RepartitionArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.repartition.CallUnary(ctx, req)
+}
+
+// RunStarlarkPackageTests calls api_container_api.ApiContainerService.RunStarlarkPackageTests.
+func (c *apiContainerServiceClient) RunStarlarkPackageTests(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse], error) {
+	return c.runStarlarkPackageTests.CallUnary(ctx, req)
+}
+
+// GetStarlarkRunPlan calls api_container_api.ApiContainerService.GetStarlarkRunPlan.
+func (c *apiContainerServiceClient) GetStarlarkRunPlan(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse], error) {
+	return c.getStarlarkRunPlan.CallUnary(ctx, req)
+}
+
+// GetStarlarkRunDiff calls api_container_api.ApiContainerService.GetStarlarkRunDiff.
+func (c *apiContainerServiceClient) GetStarlarkRunDiff(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse], error) {
+	return c.getStarlarkRunDiff.CallUnary(ctx, req)
+}
+
+// ResumeStarlarkRun calls api_container_api.ApiContainerService.ResumeStarlarkRun.
+func (c *apiContainerServiceClient) ResumeStarlarkRun(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine], error) {
+	return c.resumeStarlarkRun.CallServerStream(ctx, req)
+}
+
+// CancelStarlarkRun calls api_container_api.ApiContainerService.CancelStarlarkRun.
+func (c *apiContainerServiceClient) CancelStarlarkRun(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.cancelStarlarkRun.CallUnary(ctx, req)
+}
+
+// ListPersistentDirectories calls api_container_api.ApiContainerService.ListPersistentDirectories.
+func (c *apiContainerServiceClient) ListPersistentDirectories(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse], error) {
+	return c.listPersistentDirectories.CallUnary(ctx, req)
+}
+
+// RemovePersistentDirectories calls
+// api_container_api.ApiContainerService.RemovePersistentDirectories.
+func (c *apiContainerServiceClient) RemovePersistentDirectories(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse], error) {
+	return c.removePersistentDirectories.CallUnary(ctx, req)
+}
+
+// DownloadPersistentDirectory calls
+// api_container_api.ApiContainerService.DownloadPersistentDirectory.
+func (c *apiContainerServiceClient) DownloadPersistentDirectory(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs]) (*connect.ServerStreamForClient[kurtosis_core_rpc_api_bindings.StreamedDataChunk], error) {
+	return c.downloadPersistentDirectory.CallServerStream(ctx, req)
+}
+
+// StoreFilesArtifactFromPersistentDirectory calls
+// api_container_api.ApiContainerService.StoreFilesArtifactFromPersistentDirectory.
+func (c *apiContainerServiceClient) StoreFilesArtifactFromPersistentDirectory(ctx context.Context, req *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse], error) {
+	return c.storeFilesArtifactFromPersistentDirectory.CallUnary(ctx, req)
+}
+
 // ApiContainerServiceHandler is an implementation of the api_container_api.ApiContainerService
 // service.
 type ApiContainerServiceHandler interface {
@@ -320,6 +538,8 @@ type ApiContainerServiceHandler interface {
 	WaitForHttpGetEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpGetEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
 	// Block until the given HTTP endpoint returns available, calling it through a HTTP Post request
 	WaitForHttpPostEndpointAvailability(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.WaitForHttpPostEndpointAvailabilityArgs]) (*connect.Response[emptypb.Empty], error)
+	// Sends an HTTP request to a port of a service from inside the enclave, and returns the response
+	HttpRequestService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse], error)
 	// Uploads a files artifact to the Kurtosis File System
 	UploadFilesArtifact(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) (*connect.Response[kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse], error)
 	// Downloads a files artifact from the Kurtosis File System
@@ -332,6 +552,37 @@ type ApiContainerServiceHandler interface {
 	InspectFilesArtifactContents(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsRequest]) (*connect.Response[kurtosis_core_rpc_api_bindings.InspectFilesArtifactContentsResponse], error)
 	// User services port forwarding
 	ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error)
+	// Streams back an archive containing the enclave plan, the enclave database, the files artifacts and optionally
+	// the persistent directories of the enclave, which can later be used to restore an equivalent enclave
+	GetEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error
+	// Restores the content of an enclave snapshot into this (empty) enclave. The returned script needs to be run
+	// to recreate the services of the snapshot
+	RestoreEnclaveSnapshot(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) (*connect.Response[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse], error)
+	// Splits the services of the enclave into partitions and conditions the traffic between those partitions
+	Repartition(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RepartitionArgs]) (*connect.Response[emptypb.Empty], error)
+	// Runs the test functions of the *_test.star files of a package uploaded beforehand against a fake service network,
+	// without starting any container
+	RunStarlarkPackageTests(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse], error)
+	// Interprets a Starlark script or package without executing it, and returns the plan describing
+	// what running it against an empty enclave would do
+	GetStarlarkRunPlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse], error)
+	// Interprets a Starlark script or package without executing it, and returns the changes running it would make to
+	// the services of the enclave given the instructions run in the enclave before
+	GetStarlarkRunDiff(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse], error)
+	// Streams the response lines of the current Starlark run (or the last one if none is running) starting at the given
+	// line index, and keeps streaming until the run finishes. Lets clients reconnect to a run after a disconnect
+	ResumeStarlarkRun(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error
+	// Cancels the given Starlark run if it's still running. Runs aren't tied to the stream they were started with, so
+	// this is the way for clients to stop a run
+	CancelStarlarkRun(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs]) (*connect.Response[emptypb.Empty], error)
+	// Lists the persistent directories of the enclave, including the ones of services that were removed since
+	ListPersistentDirectories(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse], error)
+	// Removes persistent directories of a service, along with their content
+	RemovePersistentDirectories(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse], error)
+	// Downloads the content of a persistent directory as a TAR
+	DownloadPersistentDirectory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error
+	// Stores the content of a persistent directory in a new files artifact, e.g. to seed other persistent directories
+	StoreFilesArtifactFromPersistentDirectory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse], error)
 }
 
 // NewApiContainerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -380,6 +631,11 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.WaitForHttpPostEndpointAvailability,
 		opts...,
 	)
+	apiContainerServiceHttpRequestServiceHandler := connect.NewUnaryHandler(
+		ApiContainerServiceHttpRequestServiceProcedure,
+		svc.HttpRequestService,
+		opts...,
+	)
 	apiContainerServiceUploadFilesArtifactHandler := connect.NewClientStreamHandler(
 		ApiContainerServiceUploadFilesArtifactProcedure,
 		svc.UploadFilesArtifact,
@@ -415,6 +671,66 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 		svc.ConnectServices,
 		opts...,
 	)
+	apiContainerServiceGetEnclaveSnapshotHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceGetEnclaveSnapshotProcedure,
+		svc.GetEnclaveSnapshot,
+		opts...,
+	)
+	apiContainerServiceRestoreEnclaveSnapshotHandler := connect.NewClientStreamHandler(
+		ApiContainerServiceRestoreEnclaveSnapshotProcedure,
+		svc.RestoreEnclaveSnapshot,
+		opts...,
+	)
+	apiContainerServiceRepartitionHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRepartitionProcedure,
+		svc.Repartition,
+		opts...,
+	)
+	apiContainerServiceRunStarlarkPackageTestsHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRunStarlarkPackageTestsProcedure,
+		svc.RunStarlarkPackageTests,
+		opts...,
+	)
+	apiContainerServiceGetStarlarkRunPlanHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetStarlarkRunPlanProcedure,
+		svc.GetStarlarkRunPlan,
+		opts...,
+	)
+	apiContainerServiceGetStarlarkRunDiffHandler := connect.NewUnaryHandler(
+		ApiContainerServiceGetStarlarkRunDiffProcedure,
+		svc.GetStarlarkRunDiff,
+		opts...,
+	)
+	apiContainerServiceResumeStarlarkRunHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceResumeStarlarkRunProcedure,
+		svc.ResumeStarlarkRun,
+		opts...,
+	)
+	apiContainerServiceCancelStarlarkRunHandler := connect.NewUnaryHandler(
+		ApiContainerServiceCancelStarlarkRunProcedure,
+		svc.CancelStarlarkRun,
+		opts...,
+	)
+	apiContainerServiceListPersistentDirectoriesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceListPersistentDirectoriesProcedure,
+		svc.ListPersistentDirectories,
+		opts...,
+	)
+	apiContainerServiceRemovePersistentDirectoriesHandler := connect.NewUnaryHandler(
+		ApiContainerServiceRemovePersistentDirectoriesProcedure,
+		svc.RemovePersistentDirectories,
+		opts...,
+	)
+	apiContainerServiceDownloadPersistentDirectoryHandler := connect.NewServerStreamHandler(
+		ApiContainerServiceDownloadPersistentDirectoryProcedure,
+		svc.DownloadPersistentDirectory,
+		opts...,
+	)
+	apiContainerServiceStoreFilesArtifactFromPersistentDirectoryHandler := connect.NewUnaryHandler(
+		ApiContainerServiceStoreFilesArtifactFromPersistentDirectoryProcedure,
+		svc.StoreFilesArtifactFromPersistentDirectory,
+		opts...,
+	)
 	return "/api_container_api.ApiContainerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ApiContainerServiceRunStarlarkScriptProcedure:
@@ -433,6 +749,8 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceWaitForHttpGetEndpointAvailabilityHandler.ServeHTTP(w, r)
 		case ApiContainerServiceWaitForHttpPostEndpointAvailabilityProcedure:
 			apiContainerServiceWaitForHttpPostEndpointAvailabilityHandler.ServeHTTP(w, r)
+		case ApiContainerServiceHttpRequestServiceProcedure:
+			apiContainerServiceHttpRequestServiceHandler.ServeHTTP(w, r)
 		case ApiContainerServiceUploadFilesArtifactProcedure:
 			apiContainerServiceUploadFilesArtifactHandler.ServeHTTP(w, r)
 		case ApiContainerServiceDownloadFilesArtifactProcedure:
@@ -447,6 +765,30 @@ func NewApiContainerServiceHandler(svc ApiContainerServiceHandler, opts ...conne
 			apiContainerServiceInspectFilesArtifactContentsHandler.ServeHTTP(w, r)
 		case ApiContainerServiceConnectServicesProcedure:
 			apiContainerServiceConnectServicesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetEnclaveSnapshotProcedure:
+			apiContainerServiceGetEnclaveSnapshotHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRestoreEnclaveSnapshotProcedure:
+			apiContainerServiceRestoreEnclaveSnapshotHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRepartitionProcedure:
+			apiContainerServiceRepartitionHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRunStarlarkPackageTestsProcedure:
+			apiContainerServiceRunStarlarkPackageTestsHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetStarlarkRunPlanProcedure:
+			apiContainerServiceGetStarlarkRunPlanHandler.ServeHTTP(w, r)
+		case ApiContainerServiceGetStarlarkRunDiffProcedure:
+			apiContainerServiceGetStarlarkRunDiffHandler.ServeHTTP(w, r)
+		case ApiContainerServiceResumeStarlarkRunProcedure:
+			apiContainerServiceResumeStarlarkRunHandler.ServeHTTP(w, r)
+		case ApiContainerServiceCancelStarlarkRunProcedure:
+			apiContainerServiceCancelStarlarkRunHandler.ServeHTTP(w, r)
+		case ApiContainerServiceListPersistentDirectoriesProcedure:
+			apiContainerServiceListPersistentDirectoriesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceRemovePersistentDirectoriesProcedure:
+			apiContainerServiceRemovePersistentDirectoriesHandler.ServeHTTP(w, r)
+		case ApiContainerServiceDownloadPersistentDirectoryProcedure:
+			apiContainerServiceDownloadPersistentDirectoryHandler.ServeHTTP(w, r)
+		case ApiContainerServiceStoreFilesArtifactFromPersistentDirectoryProcedure:
+			apiContainerServiceStoreFilesArtifactFromPersistentDirectoryHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -488,6 +830,10 @@ func (UnimplementedApiContainerServiceHandler) WaitForHttpPostEndpointAvailabili
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.WaitForHttpPostEndpointAvailability is not implemented"))
 }
 
+func (UnimplementedApiContainerServiceHandler) HttpRequestService(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.HttpRequestService is not implemented"))
+}
+
 func (UnimplementedApiContainerServiceHandler) UploadFilesArtifact(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) (*connect.Response[kurtosis_core_rpc_api_bindings.UploadFilesArtifactResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.UploadFilesArtifact is not implemented"))
 }
@@ -515,3 +861,51 @@ func (UnimplementedApiContainerServiceHandler) InspectFilesArtifactContents(cont
 func (UnimplementedApiContainerServiceHandler) ConnectServices(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ConnectServicesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.ConnectServicesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ConnectServices is not implemented"))
 }
+
+func (UnimplementedApiContainerServiceHandler) GetEnclaveSnapshot(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetEnclaveSnapshot is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RestoreEnclaveSnapshot(context.Context, *connect.ClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) (*connect.Response[kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RestoreEnclaveSnapshot is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) Repartition(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RepartitionArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.Repartition is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RunStarlarkPackageTests(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RunStarlarkPackageTests is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetStarlarkRunPlan(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRunPlan is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) GetStarlarkRunDiff(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.GetStarlarkRunDiff is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ResumeStarlarkRun(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ResumeStarlarkRun is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) CancelStarlarkRun(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.CancelStarlarkRun is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) ListPersistentDirectories(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.ListPersistentDirectories is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) RemovePersistentDirectories(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.RemovePersistentDirectories is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) DownloadPersistentDirectory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs], *connect.ServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.DownloadPersistentDirectory is not implemented"))
+}
+
+func (UnimplementedApiContainerServiceHandler) StoreFilesArtifactFromPersistentDirectory(context.Context, *connect.Request[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs]) (*connect.Response[kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("api_container_api.ApiContainerService.StoreFilesArtifactFromPersistentDirectory is not implemented"))
+}
@@ -2,6 +2,7 @@ package binding_constructors
 
 import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"sort"
 )
 
 // The generated bindings don't come with constructors (leaving it up to the user to initialize all the fields), so we
@@ -39,6 +40,8 @@ func NewRunStarlarkScriptArgs(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkScriptArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
@@ -49,6 +52,8 @@ func NewRunStarlarkScriptArgs(
 		Parallelism:          parallelismCopy,
 		MainFunctionName:     mainFunctionName,
 		ExperimentalFeatures: experimentalFeatures,
+		GitHosts:             gitHosts,
+		Offline:              isOffline,
 	}
 }
 
@@ -60,20 +65,28 @@ func NewRunStarlarkPackageArgs(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	locallyReplacedPackageIds []string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
 	clonePackage := false
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
-		PackageId:              packageId,
-		ClonePackage:           &clonePackage,
-		StarlarkPackageContent: nil,
-		SerializedParams:       serializedParams,
-		DryRun:                 &dryRun,
-		Parallelism:            parallelismCopy,
-		RelativePathToMainFile: relativePathToMainFile,
-		MainFunctionName:       mainFunctionName,
-		ExperimentalFeatures:   experimentalFeatures,
+		PackageId:                 packageId,
+		ClonePackage:              &clonePackage,
+		StarlarkPackageContent:    nil,
+		SerializedParams:          serializedParams,
+		DryRun:                    &dryRun,
+		Parallelism:               parallelismCopy,
+		RelativePathToMainFile:    relativePathToMainFile,
+		MainFunctionName:          mainFunctionName,
+		ExperimentalFeatures:      experimentalFeatures,
+		LocallyReplacedPackageIds: locallyReplacedPackageIds,
+		Prune:                     &prune,
+		GitHosts:                  gitHosts,
+		Offline:                   isOffline,
 	}
 }
 
@@ -85,20 +98,28 @@ func NewRunStarlarkRemotePackageArgs(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	locallyReplacedPackageIds []string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs {
 	parallelismCopy := new(int32)
 	*parallelismCopy = parallelism
 	clonePackage := true
 	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs{
-		PackageId:              packageId,
-		ClonePackage:           &clonePackage,
-		StarlarkPackageContent: nil,
-		SerializedParams:       serializedParams,
-		DryRun:                 &dryRun,
-		Parallelism:            parallelismCopy,
-		RelativePathToMainFile: relativePathToMainFile,
-		MainFunctionName:       mainFunctionName,
-		ExperimentalFeatures:   experimentalFeatures,
+		PackageId:                 packageId,
+		ClonePackage:              &clonePackage,
+		StarlarkPackageContent:    nil,
+		SerializedParams:          serializedParams,
+		DryRun:                    &dryRun,
+		Parallelism:               parallelismCopy,
+		RelativePathToMainFile:    relativePathToMainFile,
+		MainFunctionName:          mainFunctionName,
+		ExperimentalFeatures:      experimentalFeatures,
+		LocallyReplacedPackageIds: locallyReplacedPackageIds,
+		Prune:                     &prune,
+		GitHosts:                  gitHosts,
+		Offline:                   isOffline,
 	}
 }
 
@@ -125,6 +146,16 @@ func NewStarlarkRunResponseLineFromWarning(warningMessage string) *kurtosis_core
 	}
 }
 
+func NewStarlarkRunResponseLineFromInfo(infoMessage string) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Info{
+			Info: &kurtosis_core_rpc_api_bindings.StarlarkInfo{
+				InfoMessage: infoMessage,
+			},
+		},
+	}
+}
+
 func NewStarlarkRunResponseLineFromInstructionResult(serializedInstructionResult string) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_InstructionResult{
@@ -135,6 +166,14 @@ func NewStarlarkRunResponseLineFromInstructionResult(serializedInstructionResult
 	}
 }
 
+func NewStarlarkRunResponseLineFromInstructionMetrics(instructionMetrics *kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_InstructionMetrics{
+			InstructionMetrics: instructionMetrics,
+		},
+	}
+}
+
 func NewStarlarkRunResponseLineFromInterpretationError(interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_Error{
@@ -195,6 +234,16 @@ func NewStarlarkRunResponseLineFromMultilineProgressInfo(currentStepInfoMultilin
 	}
 }
 
+func NewStarlarkRunResponseLineFromRunStartedEvent(runId string) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
+		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunStartedEvent{
+			RunStartedEvent: &kurtosis_core_rpc_api_bindings.StarlarkRunStartedEvent{
+				RunId: runId,
+			},
+		},
+	}
+}
+
 func NewStarlarkRunResponseLineFromRunFailureEvent() *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	return &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{
 		RunResponseLine: &kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine_RunFinishedEvent{
@@ -251,6 +300,16 @@ func NewStarlarkInstructionArg(serializedArgValue string, isRepresentative bool)
 	}
 }
 
+func NewStarlarkInstructionMetrics(startTimestampMs int64, endTimestampMs int64, durationMs uint64, imagePullDurationMs uint64, numRetries uint32) *kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics {
+	return &kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics{
+		StartTimestampMs:    startTimestampMs,
+		EndTimestampMs:      endTimestampMs,
+		DurationMs:          durationMs,
+		ImagePullDurationMs: imagePullDurationMs,
+		NumRetries:          numRetries,
+	}
+}
+
 func NewStarlarkInterpretationError(errorMessage string) *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError {
 	return &kurtosis_core_rpc_api_bindings.StarlarkInterpretationError{
 		ErrorMessage: errorMessage,
@@ -298,6 +357,7 @@ func NewServiceInfo(
 	maybePublicIpAddr string,
 	maybePublicPorts map[string]*kurtosis_core_rpc_api_bindings.Port,
 	serviceStatus kurtosis_core_rpc_api_bindings.ServiceStatus,
+	maybeHealth *kurtosis_core_rpc_api_bindings.ServiceHealth,
 ) *kurtosis_core_rpc_api_bindings.ServiceInfo {
 	return &kurtosis_core_rpc_api_bindings.ServiceInfo{
 		ServiceUuid:       uuid,
@@ -308,6 +368,21 @@ func NewServiceInfo(
 		MaybePublicIpAddr: maybePublicIpAddr,
 		MaybePublicPorts:  maybePublicPorts,
 		ServiceStatus:     serviceStatus,
+		Health:            maybeHealth,
+	}
+}
+
+func NewServiceHealth(
+	status kurtosis_core_rpc_api_bindings.ServiceHealthStatus,
+	numRestarts uint32,
+	lastFailureReason string,
+	lastFailureTimestampMs int64,
+) *kurtosis_core_rpc_api_bindings.ServiceHealth {
+	return &kurtosis_core_rpc_api_bindings.ServiceHealth{
+		Status:                 status,
+		NumRestarts:            numRestarts,
+		LastFailureReason:      lastFailureReason,
+		LastFailureTimestampMs: lastFailureTimestampMs,
 	}
 }
 
@@ -331,6 +406,66 @@ func NewExecCommandResponse(exitCode int32, logOutput string) *kurtosis_core_rpc
 	}
 }
 
+// ==============================================================================================
+//
+//	HTTP Request Service
+//
+// ==============================================================================================
+
+func NewHttpRequestServiceArgs(
+	serviceIdentifier string,
+	portId string,
+	method string,
+	endpoint string,
+	headers map[string]string,
+	queryParams map[string]string,
+	body string,
+	useTls bool,
+	skipTlsVerify bool,
+) *kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs {
+	headersList := []*kurtosis_core_rpc_api_bindings.HttpHeader{}
+	for _, name := range getSortedKeys(headers) {
+		headersList = append(headersList, NewHttpHeader(name, headers[name]))
+	}
+	queryParamsList := []*kurtosis_core_rpc_api_bindings.HttpQueryParam{}
+	for _, name := range getSortedKeys(queryParams) {
+		queryParamsList = append(queryParamsList, NewHttpQueryParam(name, queryParams[name]))
+	}
+	return &kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs{
+		ServiceIdentifier: serviceIdentifier,
+		PortId:            portId,
+		Method:            method,
+		Endpoint:          endpoint,
+		Headers:           headersList,
+		QueryParams:       queryParamsList,
+		Body:              body,
+		UseTls:            useTls,
+		SkipTlsVerify:     skipTlsVerify,
+	}
+}
+
+func NewHttpRequestServiceResponse(statusCode int32, headers []*kurtosis_core_rpc_api_bindings.HttpHeader, body string) *kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse {
+	return &kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse{
+		StatusCode: statusCode,
+		Headers:    headers,
+		Body:       body,
+	}
+}
+
+func NewHttpHeader(name string, value string) *kurtosis_core_rpc_api_bindings.HttpHeader {
+	return &kurtosis_core_rpc_api_bindings.HttpHeader{
+		Name:  name,
+		Value: value,
+	}
+}
+
+func NewHttpQueryParam(name string, value string) *kurtosis_core_rpc_api_bindings.HttpQueryParam {
+	return &kurtosis_core_rpc_api_bindings.HttpQueryParam{
+		Name:  name,
+		Value: value,
+	}
+}
+
 // ==============================================================================================
 //
 //	Upload Files Artifact
@@ -381,3 +516,294 @@ func NewConnectServicesArgs(connect kurtosis_core_rpc_api_bindings.Connect) *kur
 func NewConnectServicesResponse() *kurtosis_core_rpc_api_bindings.ConnectServicesResponse {
 	return &kurtosis_core_rpc_api_bindings.ConnectServicesResponse{}
 }
+
+// ==============================================================================================
+//
+//	Enclave Snapshots
+//
+// ==============================================================================================
+
+func NewGetEnclaveSnapshotArgs(includePersistentDirectories bool) *kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs {
+	return &kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs{
+		IncludePersistentDirectories: &includePersistentDirectories,
+	}
+}
+
+func NewRestoreEnclaveSnapshotResponse(serializedReplayScript string, numRestoredFilesArtifacts uint32) *kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse {
+	return &kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse{
+		SerializedReplayScript:    serializedReplayScript,
+		NumRestoredFilesArtifacts: numRestoredFilesArtifacts,
+	}
+}
+
+// ==============================================================================================
+//
+//	Network Partitioning
+//
+// ==============================================================================================
+
+func NewRepartitionArgs(
+	servicePartitions []*kurtosis_core_rpc_api_bindings.ServicePartition,
+	partitionConnections []*kurtosis_core_rpc_api_bindings.PartitionConnection,
+	defaultConnection *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo,
+) *kurtosis_core_rpc_api_bindings.RepartitionArgs {
+	return &kurtosis_core_rpc_api_bindings.RepartitionArgs{
+		ServicePartitions:    servicePartitions,
+		PartitionConnections: partitionConnections,
+		DefaultConnection:    defaultConnection,
+	}
+}
+
+func NewServicePartition(serviceName string, partitionId string) *kurtosis_core_rpc_api_bindings.ServicePartition {
+	return &kurtosis_core_rpc_api_bindings.ServicePartition{
+		ServiceName: serviceName,
+		PartitionId: partitionId,
+	}
+}
+
+func NewPartitionConnection(partition1 string, partition2 string, info *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo) *kurtosis_core_rpc_api_bindings.PartitionConnection {
+	return &kurtosis_core_rpc_api_bindings.PartitionConnection{
+		Partition1: partition1,
+		Partition2: partition2,
+		Info:       info,
+	}
+}
+
+func NewPartitionConnectionInfo(
+	packetLossPercentage float32,
+	delayMs uint32,
+	jitterMs uint32,
+	delayCorrelation float32,
+	delayDistribution kurtosis_core_rpc_api_bindings.PacketDelayDistribution,
+) *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo {
+	return &kurtosis_core_rpc_api_bindings.PartitionConnectionInfo{
+		PacketLossPercentage: packetLossPercentage,
+		DelayMs:              delayMs,
+		JitterMs:             jitterMs,
+		DelayCorrelation:     delayCorrelation,
+		DelayDistribution:    delayDistribution,
+	}
+}
+
+// ==============================================================================================
+//
+//	Package Sources
+//
+// ==============================================================================================
+
+func NewGitHost(host string, cloneUrlPrefix string, username string, token string) *kurtosis_core_rpc_api_bindings.GitHost {
+	return &kurtosis_core_rpc_api_bindings.GitHost{
+		Host:           host,
+		CloneUrlPrefix: cloneUrlPrefix,
+		Username:       username,
+		Token:          token,
+	}
+}
+
+// ==============================================================================================
+//
+//	Package Tests
+//
+// ==============================================================================================
+
+func NewRunStarlarkPackageTestsArgs(packageId string, testNameFilter string, locallyReplacedPackageIds []string, gitHosts []*kurtosis_core_rpc_api_bindings.GitHost, isOffline bool) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs {
+	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs{
+		PackageId:                 packageId,
+		TestNameFilter:            testNameFilter,
+		LocallyReplacedPackageIds: locallyReplacedPackageIds,
+		GitHosts:                  gitHosts,
+		Offline:                   isOffline,
+	}
+}
+
+func NewRunStarlarkPackageTestsResponse(testResults []*kurtosis_core_rpc_api_bindings.StarlarkTestResult) *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse {
+	return &kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse{
+		TestResults: testResults,
+	}
+}
+
+func NewStarlarkTestResult(file string, name string, passed bool, failureMessage string, durationMs uint64) *kurtosis_core_rpc_api_bindings.StarlarkTestResult {
+	return &kurtosis_core_rpc_api_bindings.StarlarkTestResult{
+		File:           file,
+		Name:           name,
+		Passed:         passed,
+		FailureMessage: failureMessage,
+		DurationMs:     durationMs,
+	}
+}
+
+// ==============================================================================================
+//
+//	Get Starlark Run Plan
+//
+// ==============================================================================================
+func NewGetStarlarkRunPlanArgsForPackage(packageId string, relativePathToMainFile string, mainFunctionName string, serializedParams string, locallyReplacedPackageIds []string, clonePackage bool, format kurtosis_core_rpc_api_bindings.PlanFormat, gitHosts []*kurtosis_core_rpc_api_bindings.GitHost, isOffline bool) *kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs{
+		PackageId:                 packageId,
+		SerializedScript:          "",
+		RelativePathToMainFile:    relativePathToMainFile,
+		MainFunctionName:          mainFunctionName,
+		SerializedParams:          serializedParams,
+		LocallyReplacedPackageIds: locallyReplacedPackageIds,
+		Format:                    format,
+		ClonePackage:              clonePackage,
+		GitHosts:                  gitHosts,
+		Offline:                   isOffline,
+	}
+}
+
+func NewGetStarlarkRunPlanArgsForScript(serializedScript string, mainFunctionName string, serializedParams string, format kurtosis_core_rpc_api_bindings.PlanFormat, gitHosts []*kurtosis_core_rpc_api_bindings.GitHost, isOffline bool) *kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs{
+		PackageId:                 "",
+		SerializedScript:          serializedScript,
+		RelativePathToMainFile:    "",
+		MainFunctionName:          mainFunctionName,
+		SerializedParams:          serializedParams,
+		LocallyReplacedPackageIds: nil,
+		Format:                    format,
+		ClonePackage:              false,
+		GitHosts:                  gitHosts,
+		Offline:                   isOffline,
+	}
+}
+
+func NewGetStarlarkRunPlanResponseFromPlan(serializedPlan string) *kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse{
+		InterpretationError: nil,
+		SerializedPlan:      serializedPlan,
+	}
+}
+
+func NewGetStarlarkRunPlanResponseFromInterpretationError(interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) *kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse{
+		InterpretationError: interpretationError,
+		SerializedPlan:      "",
+	}
+}
+
+// ==============================================================================================
+//
+//	Get Starlark Run Diff
+//
+// ==============================================================================================
+func NewGetStarlarkRunDiffArgsForPackage(packageId string, relativePathToMainFile string, mainFunctionName string, serializedParams string, locallyReplacedPackageIds []string, clonePackage bool, prune bool, gitHosts []*kurtosis_core_rpc_api_bindings.GitHost, isOffline bool) *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs{
+		PackageId:                 packageId,
+		SerializedScript:          "",
+		RelativePathToMainFile:    relativePathToMainFile,
+		MainFunctionName:          mainFunctionName,
+		SerializedParams:          serializedParams,
+		LocallyReplacedPackageIds: locallyReplacedPackageIds,
+		ClonePackage:              clonePackage,
+		Prune:                     prune,
+		GitHosts:                  gitHosts,
+		Offline:                   isOffline,
+	}
+}
+
+func NewGetStarlarkRunDiffArgsForScript(serializedScript string, mainFunctionName string, serializedParams string, gitHosts []*kurtosis_core_rpc_api_bindings.GitHost, isOffline bool) *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs{
+		PackageId:                 "",
+		SerializedScript:          serializedScript,
+		RelativePathToMainFile:    "",
+		MainFunctionName:          mainFunctionName,
+		SerializedParams:          serializedParams,
+		LocallyReplacedPackageIds: nil,
+		ClonePackage:              false,
+		Prune:                     false,
+		GitHosts:                  gitHosts,
+		Offline:                   isOffline,
+	}
+}
+
+func NewServiceChange(serviceName string, changeType kurtosis_core_rpc_api_bindings.ServiceChangeType, reasons []string) *kurtosis_core_rpc_api_bindings.ServiceChange {
+	return &kurtosis_core_rpc_api_bindings.ServiceChange{
+		ServiceName: serviceName,
+		ChangeType:  changeType,
+		Reasons:     reasons,
+	}
+}
+
+func NewGetStarlarkRunDiffResponseFromServiceChanges(serviceChanges []*kurtosis_core_rpc_api_bindings.ServiceChange, instructionsToRunCount uint32, instructionsToSkipCount uint32) *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse{
+		InterpretationError:     nil,
+		ServiceChanges:          serviceChanges,
+		InstructionsToRunCount:  instructionsToRunCount,
+		InstructionsToSkipCount: instructionsToSkipCount,
+	}
+}
+
+func NewGetStarlarkRunDiffResponseFromInterpretationError(interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse {
+	return &kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse{
+		InterpretationError:     interpretationError,
+		ServiceChanges:          nil,
+		InstructionsToRunCount:  0,
+		InstructionsToSkipCount: 0,
+	}
+}
+
+// ==============================================================================================
+//
+//	Resume Starlark Run
+//
+// ==============================================================================================
+func NewResumeStarlarkRunArgs(runId string, startingLineIndex uint32) *kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs {
+	return &kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs{
+		StartingLineIndex: startingLineIndex,
+		RunId:             runId,
+	}
+}
+
+// ==============================================================================================
+//
+//	Cancel Starlark Run
+//
+// ==============================================================================================
+func NewCancelStarlarkRunArgs(runId string) *kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs {
+	return &kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs{
+		RunId: runId,
+	}
+}
+
+// ==============================================================================================
+//
+//	Persistent Directories
+//
+// ==============================================================================================
+func NewPersistentDirectory(serviceUuid string, persistentKey string) *kurtosis_core_rpc_api_bindings.PersistentDirectory {
+	return &kurtosis_core_rpc_api_bindings.PersistentDirectory{
+		ServiceUuid:   serviceUuid,
+		PersistentKey: persistentKey,
+	}
+}
+
+func NewRemovePersistentDirectoriesArgs(serviceUuid string, persistentKeys []string) *kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs {
+	return &kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs{
+		ServiceUuid:    serviceUuid,
+		PersistentKeys: persistentKeys,
+	}
+}
+
+func NewDownloadPersistentDirectoryArgs(serviceUuid string, persistentKey string) *kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs {
+	return &kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs{
+		ServiceUuid:   serviceUuid,
+		PersistentKey: persistentKey,
+	}
+}
+
+func NewStoreFilesArtifactFromPersistentDirectoryArgs(serviceUuid string, persistentKey string, name string) *kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs {
+	return &kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs{
+		ServiceUuid:   serviceUuid,
+		PersistentKey: persistentKey,
+		Name:          name,
+	}
+}
+
+func getSortedKeys(stringMap map[string]string) []string {
+	keys := make([]string, 0, len(stringMap))
+	for key := range stringMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
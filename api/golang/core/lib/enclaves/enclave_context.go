@@ -30,14 +30,19 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 	"io"
 	"path"
+	"sort"
 	"strings"
 )
 
 type EnclaveUUID string
 
 const (
-	kurtosisYamlFilename    = "kurtosis.yml"
-	enforceMaxFileSizeLimit = true
+	kurtosisYamlFilename     = "kurtosis.yml"
+	enforceMaxFileSizeLimit  = true
+	enclaveSnapshotChunkName = "enclave-snapshot"
+
+	doClonePackage    = true
+	doNotClonePackage = false
 )
 
 // Docs available at https://docs.kurtosis.com/sdk/#enclavecontext
@@ -84,9 +89,11 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScript(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkScriptArgs(mainFunctionName, serializedScript, serializedParams, dryRun, parallelism, experimentalFeatures)
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkScriptArgs(mainFunctionName, serializedScript, serializedParams, dryRun, parallelism, experimentalFeatures, gitHosts, isOffline)
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 
 	stream, err := enclaveCtx.client.RunStarlarkScript(ctxWithCancel, executeStartosisScriptArgs)
@@ -108,8 +115,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkScriptBlocking(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkScript(ctx, mainFunctionName, serializedScript, serializedParams, dryRun, parallelism, experimentalFeatures)
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkScript(ctx, mainFunctionName, serializedScript, serializedParams, dryRun, parallelism, experimentalFeatures, gitHosts, isOffline)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error requesting Starlark Script run")
 	}
@@ -127,6 +136,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackage(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	executionStartedSuccessfully := false
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
@@ -137,11 +150,15 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackage(
 	}()
 
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisPackageArgs, err := enclaveCtx.assembleRunStartosisPackageArg(packageRootPath, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures)
+	executeStartosisPackageArgs, packageReplacements, err := enclaveCtx.assembleRunStartosisPackageArg(packageRootPath, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, packageReplaceOptions, prune, gitHosts, isOffline)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Error preparing package '%s' for execution", packageRootPath)
 	}
 
+	if err = enclaveCtx.uploadLocallyReplacedPackages(packageReplacements); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Error uploading the local packages replacing dependencies of package '%s'", packageRootPath)
+	}
+
 	err = enclaveCtx.uploadStarlarkPackage(executeStartosisPackageArgs.PackageId, packageRootPath)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Error uploading package '%s' prior to executing it", packageRootPath)
@@ -167,8 +184,12 @@ func (enclaveCtx *EnclaveContext) RunStarlarkPackageBlocking(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkPackage(ctx, packageRootPath, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures)
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkPackage(ctx, packageRootPath, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, packageReplaceOptions, prune, gitHosts, isOffline)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error running Starlark package")
 	}
@@ -186,6 +207,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	executionStartedSuccessfully := false
 	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
@@ -196,7 +221,10 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackage(
 	}()
 
 	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
-	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures)
+	if err := enclaveCtx.uploadLocallyReplacedPackages(packageReplaceOptions); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Error uploading the local packages replacing dependencies of package '%s'", packageId)
+	}
+	executeStartosisScriptArgs := binding_constructors.NewRunStarlarkRemotePackageArgs(packageId, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, getSortedPackageIds(packageReplaceOptions), prune, gitHosts, isOffline)
 
 	stream, err := enclaveCtx.client.RunStarlarkPackage(ctxWithCancel, executeStartosisScriptArgs)
 	if err != nil {
@@ -218,8 +246,12 @@ func (enclaveCtx *EnclaveContext) RunStarlarkRemotePackageBlocking(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (*StarlarkRunResult, error) {
-	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures)
+	starlarkRunResponseLineChan, _, err := enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, packageReplaceOptions, prune, gitHosts, isOffline)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Error running remote Starlark package")
 	}
@@ -396,12 +428,360 @@ func (enclaveCtx *EnclaveContext) ConnectServices(ctx context.Context, connect k
 	return nil
 }
 
+// GetEnclaveSnapshot returns a gzip'd TAR archive of the enclave plan, the enclave database, the files artifacts and
+// optionally the persistent directories of this enclave, which can be restored into another enclave with RestoreEnclaveSnapshot
+func (enclaveCtx *EnclaveContext) GetEnclaveSnapshot(ctx context.Context, includePersistentDirectories bool) ([]byte, error) {
+	args := binding_constructors.NewGetEnclaveSnapshotArgs(includePersistentDirectories)
+
+	client, err := enclaveCtx.client.GetEnclaveSnapshot(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred initiating the download of the snapshot of enclave '%v'", enclaveCtx.enclaveName)
+	}
+	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](client)
+	snapshotContent, err := clientStream.ReceiveData(
+		enclaveSnapshotChunkName,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
+		},
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading the snapshot of enclave '%v'", enclaveCtx.enclaveName)
+	}
+	return snapshotContent, nil
+}
+
+// RestoreEnclaveSnapshot restores the files artifacts and runtime values of the snapshot into this enclave, which
+// must be empty, and returns the Starlark script that needs to be run to recreate the services of the snapshot
+func (enclaveCtx *EnclaveContext) RestoreEnclaveSnapshot(ctx context.Context, snapshotContent io.Reader, snapshotSize uint64) (string, error) {
+	client, err := enclaveCtx.client.RestoreEnclaveSnapshot(ctx)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error was encountered initiating the snapshot upload to the API Container.")
+	}
+	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse](client)
+	response, err := clientStream.SendData(
+		enclaveSnapshotChunkName,
+		snapshotContent,
+		snapshotSize,
+		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.StreamedDataChunk, error) {
+			return &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
+				Data:              contentChunk,
+				PreviousChunkHash: previousChunkHash,
+				Metadata: &kurtosis_core_rpc_api_bindings.DataChunkMetadata{
+					Name: enclaveSnapshotChunkName,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error was encountered while restoring the snapshot into enclave '%v'", enclaveCtx.enclaveName)
+	}
+	return response.GetSerializedReplayScript(), nil
+}
+
+// Repartition splits the services of this enclave into the given partitions, services not listed being placed in the
+// default partition, and conditions the traffic between partitions. Calling it with no partitions and an unblocked
+// default connection heals the network
+func (enclaveCtx *EnclaveContext) Repartition(
+	ctx context.Context,
+	partitionServices map[PartitionID]map[services.ServiceName]bool,
+	partitionConnections map[PartitionID]map[PartitionID]PartitionConnection,
+	defaultConnection PartitionConnection,
+) error {
+	servicePartitions := []*kurtosis_core_rpc_api_bindings.ServicePartition{}
+	for partitionId, serviceNames := range partitionServices {
+		for serviceName := range serviceNames {
+			servicePartitions = append(servicePartitions, binding_constructors.NewServicePartition(string(serviceName), string(partitionId)))
+		}
+	}
+
+	apiPartitionConnections := []*kurtosis_core_rpc_api_bindings.PartitionConnection{}
+	for partitionA, connectionsByPartition := range partitionConnections {
+		for partitionB, connection := range connectionsByPartition {
+			apiPartitionConnections = append(apiPartitionConnections, binding_constructors.NewPartitionConnection(string(partitionA), string(partitionB), connection.toApiPartitionConnectionInfo()))
+		}
+	}
+
+	args := binding_constructors.NewRepartitionArgs(servicePartitions, apiPartitionConnections, defaultConnection.toApiPartitionConnectionInfo())
+	if _, err := enclaveCtx.client.Repartition(ctx, args); err != nil {
+		return stacktrace.Propagate(err, "An error occurred repartitioning the services of enclave '%v'", enclaveCtx.enclaveName)
+	}
+	return nil
+}
+
+// RunStarlarkPackageTests uploads the package at packageRootPath and runs the test functions of its *_test.star files
+// against a fake service network. Only the tests whose name contains testNameFilter are run, all of them if it's empty
+func (enclaveCtx *EnclaveContext) RunStarlarkPackageTests(
+	ctx context.Context,
+	packageRootPath string,
+	testNameFilter string,
+	packageReplaceOptions map[string]string,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) ([]*kurtosis_core_rpc_api_bindings.StarlarkTestResult, error) {
+	packageName, packageReplacements, err := getPackageNameAndReplacements(packageRootPath, packageReplaceOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error preparing package '%s' for testing", packageRootPath)
+	}
+
+	if err = enclaveCtx.uploadLocallyReplacedPackages(packageReplacements); err != nil {
+		return nil, stacktrace.Propagate(err, "Error uploading the local packages replacing dependencies of package '%s'", packageRootPath)
+	}
+
+	if err = enclaveCtx.uploadStarlarkPackage(packageName, packageRootPath); err != nil {
+		return nil, stacktrace.Propagate(err, "Error uploading package '%s' prior to testing it", packageRootPath)
+	}
+
+	args := binding_constructors.NewRunStarlarkPackageTestsArgs(packageName, testNameFilter, getSortedPackageIds(packageReplacements), gitHosts, isOffline)
+	response, err := enclaveCtx.client.RunStarlarkPackageTests(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the tests of package '%v'", packageRootPath)
+	}
+	return response.GetTestResults(), nil
+}
+
+// GetStarlarkScriptPlan interprets the script without running it, and returns the plan describing what running it
+// against an empty enclave would do, serialized in the requested format
+func (enclaveCtx *EnclaveContext) GetStarlarkScriptPlan(
+	ctx context.Context,
+	mainFunctionName string,
+	serializedScript string,
+	serializedParams string,
+	format kurtosis_core_rpc_api_bindings.PlanFormat,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (string, error) {
+	args := binding_constructors.NewGetStarlarkRunPlanArgsForScript(serializedScript, mainFunctionName, serializedParams, format, gitHosts, isOffline)
+	return enclaveCtx.getStarlarkRunPlan(ctx, args)
+}
+
+// GetStarlarkPackagePlan is the equivalent of GetStarlarkScriptPlan for a package on the local filesystem
+func (enclaveCtx *EnclaveContext) GetStarlarkPackagePlan(
+	ctx context.Context,
+	packageRootPath string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+	serializedParams string,
+	packageReplaceOptions map[string]string,
+	format kurtosis_core_rpc_api_bindings.PlanFormat,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (string, error) {
+	packageName, packageReplacements, err := getPackageNameAndReplacements(packageRootPath, packageReplaceOptions)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Error preparing package '%s' for planning", packageRootPath)
+	}
+
+	if err = enclaveCtx.uploadLocallyReplacedPackages(packageReplacements); err != nil {
+		return "", stacktrace.Propagate(err, "Error uploading the local packages replacing dependencies of package '%s'", packageRootPath)
+	}
+
+	if err = enclaveCtx.uploadStarlarkPackage(packageName, packageRootPath); err != nil {
+		return "", stacktrace.Propagate(err, "Error uploading package '%s' prior to planning it", packageRootPath)
+	}
+
+	args := binding_constructors.NewGetStarlarkRunPlanArgsForPackage(packageName, relativePathToMainFile, mainFunctionName, serializedParams, getSortedPackageIds(packageReplacements), doNotClonePackage, format, gitHosts, isOffline)
+	return enclaveCtx.getStarlarkRunPlan(ctx, args)
+}
+
+// GetStarlarkRemotePackagePlan is the equivalent of GetStarlarkScriptPlan for a package cloned from its repository
+func (enclaveCtx *EnclaveContext) GetStarlarkRemotePackagePlan(
+	ctx context.Context,
+	packageId string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+	serializedParams string,
+	packageReplaceOptions map[string]string,
+	format kurtosis_core_rpc_api_bindings.PlanFormat,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (string, error) {
+	if err := enclaveCtx.uploadLocallyReplacedPackages(packageReplaceOptions); err != nil {
+		return "", stacktrace.Propagate(err, "Error uploading the local packages replacing dependencies of package '%s'", packageId)
+	}
+
+	args := binding_constructors.NewGetStarlarkRunPlanArgsForPackage(packageId, relativePathToMainFile, mainFunctionName, serializedParams, getSortedPackageIds(packageReplaceOptions), doClonePackage, format, gitHosts, isOffline)
+	return enclaveCtx.getStarlarkRunPlan(ctx, args)
+}
+
+// GetStarlarkScriptDiff interprets the script without running it, and returns the changes running it would make to the
+// services of the enclave given the instructions run in the enclave before
+func (enclaveCtx *EnclaveContext) GetStarlarkScriptDiff(
+	ctx context.Context,
+	mainFunctionName string,
+	serializedScript string,
+	serializedParams string,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse, error) {
+	args := binding_constructors.NewGetStarlarkRunDiffArgsForScript(serializedScript, mainFunctionName, serializedParams, gitHosts, isOffline)
+	return enclaveCtx.getStarlarkRunDiff(ctx, args)
+}
+
+// GetStarlarkPackageDiff is the equivalent of GetStarlarkScriptDiff for a package on the local filesystem. When prune is
+// true, the services the run would prune from the enclave are part of the diff
+func (enclaveCtx *EnclaveContext) GetStarlarkPackageDiff(
+	ctx context.Context,
+	packageRootPath string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+	serializedParams string,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse, error) {
+	packageName, packageReplacements, err := getPackageNameAndReplacements(packageRootPath, packageReplaceOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Error preparing package '%s' for diffing", packageRootPath)
+	}
+
+	if err = enclaveCtx.uploadLocallyReplacedPackages(packageReplacements); err != nil {
+		return nil, stacktrace.Propagate(err, "Error uploading the local packages replacing dependencies of package '%s'", packageRootPath)
+	}
+
+	if err = enclaveCtx.uploadStarlarkPackage(packageName, packageRootPath); err != nil {
+		return nil, stacktrace.Propagate(err, "Error uploading package '%s' prior to diffing it", packageRootPath)
+	}
+
+	args := binding_constructors.NewGetStarlarkRunDiffArgsForPackage(packageName, relativePathToMainFile, mainFunctionName, serializedParams, getSortedPackageIds(packageReplacements), doNotClonePackage, prune, gitHosts, isOffline)
+	return enclaveCtx.getStarlarkRunDiff(ctx, args)
+}
+
+// GetStarlarkRemotePackageDiff is the equivalent of GetStarlarkPackageDiff for a package cloned from its repository
+func (enclaveCtx *EnclaveContext) GetStarlarkRemotePackageDiff(
+	ctx context.Context,
+	packageId string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+	serializedParams string,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse, error) {
+	if err := enclaveCtx.uploadLocallyReplacedPackages(packageReplaceOptions); err != nil {
+		return nil, stacktrace.Propagate(err, "Error uploading the local packages replacing dependencies of package '%s'", packageId)
+	}
+
+	args := binding_constructors.NewGetStarlarkRunDiffArgsForPackage(packageId, relativePathToMainFile, mainFunctionName, serializedParams, getSortedPackageIds(packageReplaceOptions), doClonePackage, prune, gitHosts, isOffline)
+	return enclaveCtx.getStarlarkRunDiff(ctx, args)
+}
+
+// ResumeStarlarkRun streams the response lines of the Starlark run with the given run ID, sent in the first response
+// line of every run, starting at startingLineIndex and until the run finishes. Clients that got disconnected from a
+// run can pass the number of response lines they already received to keep streaming it from where they stopped. Only
+// the run currently happening in the enclave, or the last one, can be resumed
+func (enclaveCtx *EnclaveContext) ResumeStarlarkRun(
+	ctx context.Context,
+	runId string,
+	startingLineIndex uint32,
+) (chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+	ctxWithCancel, cancelCtxFunc := context.WithCancel(ctx)
+	resumeStarlarkRunArgs := binding_constructors.NewResumeStarlarkRunArgs(runId, startingLineIndex)
+	starlarkResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+
+	stream, err := enclaveCtx.client.ResumeStarlarkRun(ctxWithCancel, resumeStarlarkRunArgs)
+	if err != nil {
+		cancelCtxFunc() // manually call the cancel function as something went wrong
+		return nil, nil, stacktrace.Propagate(err, "Unexpected error happened resuming Starlark run '%v' of enclave '%v'", runId, enclaveCtx.enclaveName)
+	}
+
+	go runReceiveStarlarkResponseLineRoutine(cancelCtxFunc, stream, starlarkResponseLineChan)
+	return starlarkResponseLineChan, cancelCtxFunc, nil
+}
+
+// CancelStarlarkRun cancels the Starlark run with the given run ID, sent in the first response line of every run, if
+// it's still running. Runs keep going in the enclave when the client stops reading their response lines, so this is
+// the way to stop one. The run stops before its next instruction, and its response lines end with an execution error
+func (enclaveCtx *EnclaveContext) CancelStarlarkRun(ctx context.Context, runId string) error {
+	cancelStarlarkRunArgs := binding_constructors.NewCancelStarlarkRunArgs(runId)
+	if _, err := enclaveCtx.client.CancelStarlarkRun(ctx, cancelStarlarkRunArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred cancelling Starlark run '%v' of enclave '%v'", runId, enclaveCtx.enclaveName)
+	}
+	return nil
+}
+
+// GetPersistentDirectories returns the persistent directories of this enclave, sorted by service UUID and persistent
+// key. They outlive the services they were created for, so the service UUIDs may belong to services removed since
+func (enclaveCtx *EnclaveContext) GetPersistentDirectories(ctx context.Context) ([]*kurtosis_core_rpc_api_bindings.PersistentDirectory, error) {
+	response, err := enclaveCtx.client.ListPersistentDirectories(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred listing the persistent directories of enclave '%v'", enclaveCtx.enclaveName)
+	}
+	return response.GetPersistentDirectories(), nil
+}
+
+// RemovePersistentDirectories removes the persistent directories of the service with the given persistent keys, along
+// with their content, and returns the removed persistent keys
+func (enclaveCtx *EnclaveContext) RemovePersistentDirectories(ctx context.Context, serviceUuid services.ServiceUUID, persistentKeys []string) ([]string, error) {
+	args := binding_constructors.NewRemovePersistentDirectoriesArgs(string(serviceUuid), persistentKeys)
+	response, err := enclaveCtx.client.RemovePersistentDirectories(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing persistent directories '%v' of service '%v'", persistentKeys, serviceUuid)
+	}
+	return response.GetRemovedPersistentKeys(), nil
+}
+
+// DownloadPersistentDirectory returns the content of the persistent directory of the service as a TAR archive
+func (enclaveCtx *EnclaveContext) DownloadPersistentDirectory(ctx context.Context, serviceUuid services.ServiceUUID, persistentKey string) ([]byte, error) {
+	args := binding_constructors.NewDownloadPersistentDirectoryArgs(string(serviceUuid), persistentKey)
+
+	client, err := enclaveCtx.client.DownloadPersistentDirectory(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred initiating the download of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	clientStream := grpc_file_streaming.NewClientStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](client)
+	content, err := clientStream.ReceiveData(
+		persistentKey,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.Data, dataChunk.PreviousChunkHash, nil
+		},
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred downloading persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	return content, nil
+}
+
+// StoreFilesArtifactFromPersistentDirectory stores the content of the persistent directory of the service in a new
+// files artifact with the given name, and returns its UUID
+func (enclaveCtx *EnclaveContext) StoreFilesArtifactFromPersistentDirectory(ctx context.Context, serviceUuid services.ServiceUUID, persistentKey string, artifactName string) (services.FilesArtifactUUID, error) {
+	args := binding_constructors.NewStoreFilesArtifactFromPersistentDirectoryArgs(string(serviceUuid), persistentKey, artifactName)
+	response, err := enclaveCtx.client.StoreFilesArtifactFromPersistentDirectory(ctx, args)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred storing persistent directory '%v' of service '%v' in files artifact '%v'", persistentKey, serviceUuid, artifactName)
+	}
+	return services.FilesArtifactUUID(response.GetUuid()), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
 //
 // ====================================================================================================
 
+func (enclaveCtx *EnclaveContext) getStarlarkRunPlan(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs) (string, error) {
+	response, err := enclaveCtx.client.GetStarlarkRunPlan(ctx, args)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the plan of the Starlark code")
+	}
+	if response.GetInterpretationError() != nil {
+		return "", stacktrace.NewError("An error occurred interpreting the Starlark code:\n%v", response.GetInterpretationError().GetErrorMessage())
+	}
+	return response.GetSerializedPlan(), nil
+}
+
+func (enclaveCtx *EnclaveContext) getStarlarkRunDiff(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse, error) {
+	response, err := enclaveCtx.client.GetStarlarkRunDiff(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the diff of the Starlark code")
+	}
+	if response.GetInterpretationError() != nil {
+		return nil, stacktrace.NewError("An error occurred interpreting the Starlark code:\n%v", response.GetInterpretationError().GetErrorMessage())
+	}
+	return response, nil
+}
+
 // convertApiPortsToServiceContextPorts returns a converted map where Port objects associated with strings in [apiPorts] are
 // properly converted to PortSpec objects.
 // Returns error if:
@@ -479,14 +859,60 @@ func (enclaveCtx *EnclaveContext) assembleRunStartosisPackageArg(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
-) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, error) {
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageArgs, map[string]string, error) {
+	packageName, packageReplacements, err := getPackageNameAndReplacements(packageRootPath, packageReplaceOptions)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred reading the package at '%v'", packageRootPath)
+	}
+	runPackageArgs := binding_constructors.NewRunStarlarkPackageArgs(packageName, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, getSortedPackageIds(packageReplacements), prune, gitHosts, isOffline)
+	return runPackageArgs, packageReplacements, nil
+}
+
+// getPackageNameAndReplacements returns the name of the package at packageRootPath along with the local packages
+// replacing its dependencies, keyed by the package they replace
+func getPackageNameAndReplacements(packageRootPath string, packageReplaceOptions map[string]string) (string, map[string]string, error) {
 	kurtosisYamlFilepath := path.Join(packageRootPath, kurtosisYamlFilename)
 
 	kurtosisYaml, err := ParseKurtosisYaml(kurtosisYamlFilepath)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "There was an error parsing the '%v' at '%v'", kurtosisYamlFilename, packageRootPath)
+		return "", nil, stacktrace.Propagate(err, "There was an error parsing the '%v' at '%v'", kurtosisYamlFilename, packageRootPath)
+	}
+
+	// the replacements passed explicitly win over the ones declared in the kurtosis.yml
+	packageReplacements := map[string]string{}
+	for packageId, relativeLocalPath := range kurtosisYaml.Replace {
+		packageReplacements[packageId] = path.Join(packageRootPath, relativeLocalPath)
+	}
+	for packageId, localPath := range packageReplaceOptions {
+		packageReplacements[packageId] = localPath
+	}
+	if _, found := packageReplacements[kurtosisYaml.PackageName]; found {
+		return "", nil, stacktrace.NewError("Package '%v' can't replace itself with a local package", kurtosisYaml.PackageName)
 	}
-	return binding_constructors.NewRunStarlarkPackageArgs(kurtosisYaml.PackageName, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures), nil
+	return kurtosisYaml.PackageName, packageReplacements, nil
+}
+
+// uploadLocallyReplacedPackages uploads the local packages replacing remote ones, checking beforehand that each of
+// them is indeed the package it replaces
+func (enclaveCtx *EnclaveContext) uploadLocallyReplacedPackages(packageReplacements map[string]string) error {
+	for _, packageId := range getSortedPackageIds(packageReplacements) {
+		localPackagePath := packageReplacements[packageId]
+		kurtosisYaml, err := ParseKurtosisYaml(path.Join(localPackagePath, kurtosisYamlFilename))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing the '%v' of the local package at '%v' replacing package '%v'", kurtosisYamlFilename, localPackagePath, packageId)
+		}
+		if kurtosisYaml.PackageName != packageId {
+			return stacktrace.NewError("The local package at '%v' is named '%v' and so can't replace package '%v'", localPackagePath, kurtosisYaml.PackageName, packageId)
+		}
+		if err = enclaveCtx.uploadStarlarkPackage(packageId, localPackagePath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred uploading the local package at '%v' replacing package '%v'", localPackagePath, packageId)
+		}
+	}
+	return nil
 }
 
 func (enclaveCtx *EnclaveContext) uploadStarlarkPackage(packageId string, packageRootPath string) error {
@@ -523,3 +949,12 @@ func (enclaveCtx *EnclaveContext) uploadStarlarkPackage(packageId string, packag
 	}
 	return nil
 }
+
+func getSortedPackageIds(packageReplacements map[string]string) []string {
+	packageIds := []string{}
+	for packageId := range packageReplacements {
+		packageIds = append(packageIds, packageId)
+	}
+	sort.Strings(packageIds)
+	return packageIds
+}
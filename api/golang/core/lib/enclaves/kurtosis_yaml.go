@@ -13,6 +13,14 @@ const (
 // fields are public because it's needed for YAML decoding
 type KurtosisYaml struct {
 	PackageName string `yaml:"name"`
+
+	// The packages this package imports from, mapped to the version to use, i.e. a tag, a branch or a commit. An
+	// empty version means the default branch of the repository
+	Dependencies map[string]string `yaml:"dependencies,omitempty"`
+
+	// The packages to take from a local directory rather than from GitHub, mapped to the path of that directory,
+	// relative to the root of this package
+	Replace map[string]string `yaml:"replace,omitempty"`
 }
 
 func ParseKurtosisYaml(kurtosisYamlFilepath string) (*KurtosisYaml, error) {
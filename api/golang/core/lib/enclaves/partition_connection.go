@@ -0,0 +1,92 @@
+package enclaves
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+)
+
+type PartitionID string
+
+const (
+	blockedPacketLossPercentage   = float32(100)
+	unblockedPacketLossPercentage = float32(0)
+)
+
+// Docs available at https://docs.kurtosis.com/sdk/#partitionconnection
+type PartitionConnection struct {
+	packetLossPercentage float32
+	delayMs              uint32
+	jitterMs             uint32
+	delayCorrelation     float32
+	delayDistribution    kurtosis_core_rpc_api_bindings.PacketDelayDistribution
+}
+
+// NewUnblockedPartitionConnection returns a connection letting all the traffic through, without any added delay
+func NewUnblockedPartitionConnection() PartitionConnection {
+	return NewPartitionConnection(unblockedPacketLossPercentage)
+}
+
+// NewBlockedPartitionConnection returns a connection dropping all the traffic
+func NewBlockedPartitionConnection() PartitionConnection {
+	return NewPartitionConnection(blockedPacketLossPercentage)
+}
+
+// NewPartitionConnection returns a connection dropping the given percentage of the packets, without any added delay
+func NewPartitionConnection(packetLossPercentage float32) PartitionConnection {
+	return PartitionConnection{
+		packetLossPercentage: packetLossPercentage,
+		delayMs:              0,
+		jitterMs:             0,
+		delayCorrelation:     0,
+		delayDistribution:    kurtosis_core_rpc_api_bindings.PacketDelayDistribution_UNIFORM,
+	}
+}
+
+// WithUniformDelay returns a copy of the connection delaying the packets by delayMs, plus or minus jitterMs
+func (connection PartitionConnection) WithUniformDelay(delayMs uint32, jitterMs uint32) PartitionConnection {
+	connection.delayMs = delayMs
+	connection.jitterMs = jitterMs
+	connection.delayCorrelation = 0
+	connection.delayDistribution = kurtosis_core_rpc_api_bindings.PacketDelayDistribution_UNIFORM
+	return connection
+}
+
+// WithNormalDelay returns a copy of the connection delaying the packets following a normal distribution. The correlation
+// is the percentage, between 0 and 100, by which the delay of a packet depends on the delay of the previous one
+func (connection PartitionConnection) WithNormalDelay(meanDelayMs uint32, stdDevMs uint32, correlation float32) PartitionConnection {
+	connection.delayMs = meanDelayMs
+	connection.jitterMs = stdDevMs
+	connection.delayCorrelation = correlation
+	connection.delayDistribution = kurtosis_core_rpc_api_bindings.PacketDelayDistribution_NORMAL
+	return connection
+}
+
+func (connection PartitionConnection) GetPacketLossPercentage() float32 {
+	return connection.packetLossPercentage
+}
+
+func (connection PartitionConnection) GetDelayMs() uint32 {
+	return connection.delayMs
+}
+
+func (connection PartitionConnection) GetJitterMs() uint32 {
+	return connection.jitterMs
+}
+
+func (connection PartitionConnection) GetDelayCorrelation() float32 {
+	return connection.delayCorrelation
+}
+
+func (connection PartitionConnection) GetDelayDistribution() kurtosis_core_rpc_api_bindings.PacketDelayDistribution {
+	return connection.delayDistribution
+}
+
+func (connection PartitionConnection) toApiPartitionConnectionInfo() *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo {
+	return binding_constructors.NewPartitionConnectionInfo(
+		connection.packetLossPercentage,
+		connection.delayMs,
+		connection.jitterMs,
+		connection.delayCorrelation,
+		connection.delayDistribution,
+	)
+}
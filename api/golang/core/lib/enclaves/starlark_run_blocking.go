@@ -16,6 +16,9 @@ type StarlarkRunResult struct {
 
 	Instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
 
+	// One entry per executed instruction, in the same order as Instructions. Empty for dry runs
+	InstructionsMetrics []*kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics
+
 	InterpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 
 	ValidationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError
@@ -23,10 +26,11 @@ type StarlarkRunResult struct {
 	ExecutionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
 }
 
-func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) *StarlarkRunResult {
+func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, instructionsMetrics []*kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics, interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError, validationErrors []*kurtosis_core_rpc_api_bindings.StarlarkValidationError, executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) *StarlarkRunResult {
 	return &StarlarkRunResult{
 		RunOutput:           runOutput,
 		Instructions:        instructions,
+		InstructionsMetrics: instructionsMetrics,
 		InterpretationError: interpretationError,
 		ValidationErrors:    validationErrors,
 		ExecutionError:      executionError,
@@ -36,6 +40,7 @@ func NewStarlarkRunResult(runOutput StarlarkRunMultilineOutput, instructions []*
 func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) *StarlarkRunResult {
 	scriptOutput := strings.Builder{}
 	instructions := make([]*kurtosis_core_rpc_api_bindings.StarlarkInstruction, 0)
+	instructionsMetrics := make([]*kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics, 0)
 	var interpretationError *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError
 	validationErrors := make([]*kurtosis_core_rpc_api_bindings.StarlarkValidationError, 0)
 	var executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
@@ -46,6 +51,8 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 		} else if responseLine.GetInstructionResult() != nil {
 			scriptOutput.WriteString(responseLine.GetInstructionResult().GetSerializedInstructionResult())
 			scriptOutput.WriteString(starlarkRunOutputLinesSplit)
+		} else if responseLine.GetInstructionMetrics() != nil {
+			instructionsMetrics = append(instructionsMetrics, responseLine.GetInstructionMetrics())
 		} else if responseLine.GetError() != nil {
 			if responseLine.GetError().GetInterpretationError() != nil {
 				interpretationError = responseLine.GetError().GetInterpretationError()
@@ -65,6 +72,7 @@ func ReadStarlarkRunResponseLineBlocking(starlarkRunResponseLines <-chan *kurtos
 	return NewStarlarkRunResult(
 		StarlarkRunMultilineOutput(scriptOutput.String()),
 		instructions,
+		instructionsMetrics,
 		interpretationError,
 		validationErrors,
 		executionError)
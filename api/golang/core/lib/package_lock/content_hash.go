@@ -0,0 +1,76 @@
+package package_lock
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+const (
+	contentHashPrefix = "sha256:"
+
+	// The metadata of the repository the package was cloned from isn't part of its content
+	gitDirName = ".git"
+)
+
+// ComputePackageContentHash hashes the files of the package at the path, so that a dependency which changed without
+// its commit changing (e.g. a tag which got moved) is detected. The hash covers the relative path and the content of
+// every file, in the lexical order of their paths, and doesn't depend on file modes nor timestamps
+func ComputePackageContentHash(packageRootPath string) (string, error) {
+	packageHash := sha256.New()
+	err := filepath.WalkDir(packageRootPath, func(filePath string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if dirEntry.IsDir() {
+			if dirEntry.Name() == gitDirName {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		relativeFilePath, err := filepath.Rel(packageRootPath, filePath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", filePath, packageRootPath)
+		}
+		fileHash, err := computeFileHash(filePath, dirEntry)
+		if err != nil {
+			return err
+		}
+		// hashing the hash of each file rather than its content avoids collisions between a file name and content
+		if _, err = fmt.Fprintf(packageHash, "%s  %s\n", fileHash, filepath.ToSlash(relativeFilePath)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred hashing '%v'", filePath)
+		}
+		return nil
+	})
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred computing the content hash of the package at '%v'", packageRootPath)
+	}
+	return contentHashPrefix + hex.EncodeToString(packageHash.Sum(nil)), nil
+}
+
+// computeFileHash hashes the content of a regular file, or the target of a symbolic link
+func computeFileHash(filePath string, dirEntry fs.DirEntry) (string, error) {
+	fileHash := sha256.New()
+	if dirEntry.Type()&fs.ModeSymlink != 0 {
+		linkTarget, err := os.Readlink(filePath)
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred reading the target of the symbolic link '%v'", filePath)
+		}
+		fileHash.Write([]byte(linkTarget))
+		return hex.EncodeToString(fileHash.Sum(nil)), nil
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred opening '%v'", filePath)
+	}
+	defer file.Close()
+	if _, err = io.Copy(fileHash, file); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred reading '%v'", filePath)
+	}
+	return hex.EncodeToString(fileHash.Sum(nil)), nil
+}
@@ -0,0 +1,57 @@
+package package_lock
+
+import (
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testFilePerm = 0644
+	testDirPerm  = 0755
+)
+
+func TestComputePackageContentHash(t *testing.T) {
+	packageRootPath := createTestPackage(t)
+
+	contentHash, err := ComputePackageContentHash(packageRootPath)
+	require.NoError(t, err)
+	require.Regexp(t, "^sha256:[0-9a-f]{64}$", contentHash)
+
+	// neither timestamps nor the git metadata are part of the content
+	require.NoError(t, os.Chtimes(path.Join(packageRootPath, "main.star"), time.Now(), time.Unix(0, 0)))
+	require.NoError(t, os.WriteFile(path.Join(packageRootPath, gitDirName, "HEAD"), []byte("ref: refs/heads/other"), testFilePerm))
+	unchangedContentHash, err := ComputePackageContentHash(packageRootPath)
+	require.NoError(t, err)
+	require.Equal(t, contentHash, unchangedContentHash)
+
+	require.NoError(t, os.WriteFile(path.Join(packageRootPath, "lib", "helpers.star"), []byte("def helper(): return 2"), testFilePerm))
+	changedContentHash, err := ComputePackageContentHash(packageRootPath)
+	require.NoError(t, err)
+	require.NotEqual(t, contentHash, changedContentHash)
+}
+
+func TestComputePackageContentHash_RenamedFile(t *testing.T) {
+	packageRootPath := createTestPackage(t)
+	contentHash, err := ComputePackageContentHash(packageRootPath)
+	require.NoError(t, err)
+
+	require.NoError(t, os.Rename(path.Join(packageRootPath, "lib", "helpers.star"), path.Join(packageRootPath, "lib", "utils.star")))
+	renamedFileContentHash, err := ComputePackageContentHash(packageRootPath)
+	require.NoError(t, err)
+	require.NotEqual(t, contentHash, renamedFileContentHash)
+}
+
+func createTestPackage(t *testing.T) string {
+	packageRootPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(path.Join(packageRootPath, "lib"), testDirPerm))
+	require.NoError(t, os.MkdirAll(path.Join(packageRootPath, gitDirName), testDirPerm))
+	require.NoError(t, os.WriteFile(path.Join(packageRootPath, "kurtosis.yml"), []byte("name: github.com/test/package"), testFilePerm))
+	require.NoError(t, os.WriteFile(path.Join(packageRootPath, "main.star"), []byte("def run(plan): pass"), testFilePerm))
+	require.NoError(t, os.WriteFile(path.Join(packageRootPath, "lib", "helpers.star"), []byte("def helper(): return 1"), testFilePerm))
+	require.NoError(t, os.WriteFile(path.Join(packageRootPath, gitDirName, "HEAD"), []byte("ref: refs/heads/main"), testFilePerm))
+	return packageRootPath
+}
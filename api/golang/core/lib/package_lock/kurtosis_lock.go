@@ -0,0 +1,111 @@
+package package_lock
+
+import (
+	"github.com/go-yaml/yaml"
+	"github.com/kurtosis-tech/stacktrace"
+	"os"
+	"sort"
+	"strings"
+)
+
+const (
+	KurtosisLockFilename = "kurtosis.lock"
+
+	kurtosisLockFilePerm = 0644
+
+	kurtosisLockHeader = "# This file is generated by `kurtosis package lock` and `kurtosis package update`. Do not edit it by hand.\n"
+)
+
+// KurtosisLock pins the dependencies of a package, including the transitive ones, to the commit their version
+// resolved to when the package got locked, along with the hash of their content at this commit.
+// Fields are public because it's needed for YAML decoding
+type KurtosisLock struct {
+	Dependencies map[string]*LockedDependency `yaml:"dependencies"`
+}
+
+type LockedDependency struct {
+	// The version of the dependency in the kurtosis.yml declaring it, i.e. a tag, a branch or a commit. Empty
+	// means the default branch of the repository
+	Version string `yaml:"version,omitempty"`
+
+	// The SHA of the commit the version resolved to
+	Commit string `yaml:"commit"`
+
+	// The hash of the content of the dependency at this commit, see ComputePackageContentHash
+	Hash string `yaml:"hash"`
+}
+
+func NewKurtosisLock() *KurtosisLock {
+	return &KurtosisLock{
+		Dependencies: map[string]*LockedDependency{},
+	}
+}
+
+func NewLockedDependency(version string, commit string, hash string) *LockedDependency {
+	return &LockedDependency{
+		Version: version,
+		Commit:  commit,
+		Hash:    hash,
+	}
+}
+
+// GetLockedDependency returns the dependency locked under the package name
+func (lock *KurtosisLock) GetLockedDependency(packageName string) (*LockedDependency, bool) {
+	if lock == nil {
+		return nil, false
+	}
+	lockedDependency, found := lock.Dependencies[packageName]
+	return lockedDependency, found
+}
+
+// GetSortedPackageNames returns the names of the locked dependencies in alphabetical order
+func (lock *KurtosisLock) GetSortedPackageNames() []string {
+	packageNames := []string{}
+	if lock == nil {
+		return packageNames
+	}
+	for packageName := range lock.Dependencies {
+		packageNames = append(packageNames, packageName)
+	}
+	sort.Strings(packageNames)
+	return packageNames
+}
+
+// ParseKurtosisLock reads the lock at the path. The returned boolean is false when the file doesn't exist, in which
+// case the returned lock is empty
+func ParseKurtosisLock(kurtosisLockFilepath string) (*KurtosisLock, bool, error) {
+	kurtosisLockContents, err := os.ReadFile(kurtosisLockFilepath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return NewKurtosisLock(), false, nil
+		}
+		return nil, false, stacktrace.Propagate(err, "An error occurred while reading the '%v' file at '%v'", KurtosisLockFilename, kurtosisLockFilepath)
+	}
+
+	kurtosisLock := NewKurtosisLock()
+	if err = yaml.Unmarshal(kurtosisLockContents, kurtosisLock); err != nil {
+		return nil, false, stacktrace.Propagate(err, "An error occurred while parsing the '%v' file at '%v'", KurtosisLockFilename, kurtosisLockFilepath)
+	}
+	if kurtosisLock.Dependencies == nil {
+		kurtosisLock.Dependencies = map[string]*LockedDependency{}
+	}
+	for packageName, lockedDependency := range kurtosisLock.Dependencies {
+		if lockedDependency == nil || strings.TrimSpace(lockedDependency.Commit) == "" {
+			return nil, false, stacktrace.NewError("Dependency '%v' in the '%v' file at '%v' has no commit", packageName, KurtosisLockFilename, kurtosisLockFilepath)
+		}
+	}
+	return kurtosisLock, true, nil
+}
+
+// WriteKurtosisLock writes the lock at the path, replacing any existing file
+func WriteKurtosisLock(kurtosisLockFilepath string, kurtosisLock *KurtosisLock) error {
+	serializedLock, err := yaml.Marshal(kurtosisLock)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the '%v' file", KurtosisLockFilename)
+	}
+	kurtosisLockContents := append([]byte(kurtosisLockHeader), serializedLock...)
+	if err = os.WriteFile(kurtosisLockFilepath, kurtosisLockContents, kurtosisLockFilePerm); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the '%v' file at '%v'", KurtosisLockFilename, kurtosisLockFilepath)
+	}
+	return nil
+}
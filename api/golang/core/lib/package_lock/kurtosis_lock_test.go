@@ -0,0 +1,49 @@
+package package_lock
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDependencyName = "github.com/kurtosis-tech/redis-package"
+	testCommit         = "1b4b9fd1bd29f63dc1a87e1b2a3b4a5a5b6e7f80"
+	testHash           = "sha256:2f8c8e5d"
+)
+
+func TestKurtosisLock_WriteAndParse(t *testing.T) {
+	kurtosisLockFilepath := path.Join(t.TempDir(), KurtosisLockFilename)
+	kurtosisLock := NewKurtosisLock()
+	kurtosisLock.Dependencies[testDependencyName] = NewLockedDependency("0.1.0", testCommit, testHash)
+
+	require.NoError(t, WriteKurtosisLock(kurtosisLockFilepath, kurtosisLock))
+	parsedLock, found, err := ParseKurtosisLock(kurtosisLockFilepath)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, kurtosisLock, parsedLock)
+
+	lockedDependency, found := parsedLock.GetLockedDependency(testDependencyName)
+	require.True(t, found)
+	require.Equal(t, testCommit, lockedDependency.Commit)
+	require.Equal(t, []string{testDependencyName}, parsedLock.GetSortedPackageNames())
+}
+
+func TestParseKurtosisLock_MissingFile(t *testing.T) {
+	kurtosisLock, found, err := ParseKurtosisLock(path.Join(t.TempDir(), KurtosisLockFilename))
+	require.NoError(t, err)
+	require.False(t, found)
+	require.Empty(t, kurtosisLock.Dependencies)
+}
+
+func TestParseKurtosisLock_DependencyWithoutCommit(t *testing.T) {
+	kurtosisLockFilepath := path.Join(t.TempDir(), KurtosisLockFilename)
+	kurtosisLockContents := "dependencies:\n  " + testDependencyName + ":\n    version: 0.1.0\n"
+	require.NoError(t, os.WriteFile(kurtosisLockFilepath, []byte(kurtosisLockContents), kurtosisLockFilePerm))
+
+	_, _, err := ParseKurtosisLock(kurtosisLockFilepath)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "has no commit")
+}
@@ -103,3 +103,31 @@ func (service *ServiceContext) ExecCommand(command []string) (int32, string, err
 	}
 	return resp.ExitCode, resp.LogOutput, nil
 }
+
+// HttpRequest sends an HTTP request to the port of the service with the given ID, from inside the enclave such that the
+// port does not need to be exposed publicly. An empty method sends a GET request
+func (service *ServiceContext) HttpRequest(
+	ctx context.Context,
+	portId string,
+	method string,
+	endpoint string,
+	headers map[string]string,
+	queryParams map[string]string,
+	body string,
+	useTls bool,
+	skipTlsVerify bool,
+) (*kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse, error) {
+	serviceName := service.serviceName
+	args := binding_constructors.NewHttpRequestServiceArgs(string(serviceName), portId, method, endpoint, headers, queryParams, body, useTls, skipTlsVerify)
+	resp, err := service.client.HttpRequestService(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred sending HTTP request '%v %v' to port '%v' of service '%v'",
+			method,
+			endpoint,
+			portId,
+			serviceName)
+	}
+	return resp, nil
+}
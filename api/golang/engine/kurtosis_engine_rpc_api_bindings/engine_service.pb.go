@@ -250,6 +250,8 @@ type GetEngineInfoResponse struct {
 
 	// Version of the engine server
 	EngineVersion string `protobuf:"bytes,1,opt,name=engine_version,json=engineVersion,proto3" json:"engine_version,omitempty"`
+	// The state of the enclave pool, only set if the engine runs with it
+	EnclavePoolInfo *EnclavePoolInfo `protobuf:"bytes,2,opt,name=enclave_pool_info,json=enclavePoolInfo,proto3,oneof" json:"enclave_pool_info,omitempty"`
 }
 
 func (x *GetEngineInfoResponse) Reset() {
@@ -291,6 +293,88 @@ func (x *GetEngineInfoResponse) GetEngineVersion() string {
 	return ""
 }
 
+func (x *GetEngineInfoResponse) GetEnclavePoolInfo() *EnclavePoolInfo {
+	if x != nil {
+		return x.EnclavePoolInfo
+	}
+	return nil
+}
+
+type EnclavePoolInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The number of idle enclaves the pool keeps ready to be used
+	PoolSize uint32 `protobuf:"varint,1,opt,name=pool_size,json=poolSize,proto3" json:"pool_size,omitempty"`
+	// The API container version of the idle enclaves, only enclaves requested with it can be taken from the pool
+	ApiContainerVersion string `protobuf:"bytes,2,opt,name=api_container_version,json=apiContainerVersion,proto3" json:"api_container_version,omitempty"`
+	// The number of idle enclaves currently ready to be used, the rest are being created
+	NumIdleEnclaves uint32 `protobuf:"varint,3,opt,name=num_idle_enclaves,json=numIdleEnclaves,proto3" json:"num_idle_enclaves,omitempty"`
+	// The number of idle enclaves from previous engine runs that were adopted by the pool when the engine started
+	NumAdoptedIdleEnclaves uint32 `protobuf:"varint,4,opt,name=num_adopted_idle_enclaves,json=numAdoptedIdleEnclaves,proto3" json:"num_adopted_idle_enclaves,omitempty"`
+}
+
+func (x *EnclavePoolInfo) Reset() {
+	*x = EnclavePoolInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnclavePoolInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnclavePoolInfo) ProtoMessage() {}
+
+func (x *EnclavePoolInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnclavePoolInfo.ProtoReflect.Descriptor instead.
+func (*EnclavePoolInfo) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EnclavePoolInfo) GetPoolSize() uint32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *EnclavePoolInfo) GetApiContainerVersion() string {
+	if x != nil {
+		return x.ApiContainerVersion
+	}
+	return ""
+}
+
+func (x *EnclavePoolInfo) GetNumIdleEnclaves() uint32 {
+	if x != nil {
+		return x.NumIdleEnclaves
+	}
+	return 0
+}
+
+func (x *EnclavePoolInfo) GetNumAdoptedIdleEnclaves() uint32 {
+	if x != nil {
+		return x.NumAdoptedIdleEnclaves
+	}
+	return 0
+}
+
 // ==============================================================================================
 //
 //	Create Enclave
@@ -309,12 +393,14 @@ type CreateEnclaveArgs struct {
 	// The API container log level
 	ApiContainerLogLevel string      `protobuf:"bytes,3,opt,name=api_container_log_level,json=apiContainerLogLevel,proto3" json:"api_container_log_level,omitempty"`
 	Mode                 EnclaveMode `protobuf:"varint,4,opt,name=mode,proto3,enum=engine_api.EnclaveMode" json:"mode,omitempty"`
+	// Settings of the enclave only available for Kubernetes
+	KubernetesSettings *KubernetesEnclaveSettings `protobuf:"bytes,5,opt,name=kubernetes_settings,json=kubernetesSettings,proto3,oneof" json:"kubernetes_settings,omitempty"`
 }
 
 func (x *CreateEnclaveArgs) Reset() {
 	*x = CreateEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[1]
+		mi := &file_engine_service_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -327,7 +413,7 @@ func (x *CreateEnclaveArgs) String() string {
 func (*CreateEnclaveArgs) ProtoMessage() {}
 
 func (x *CreateEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[1]
+	mi := &file_engine_service_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -340,7 +426,7 @@ func (x *CreateEnclaveArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEnclaveArgs.ProtoReflect.Descriptor instead.
 func (*CreateEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{1}
+	return file_engine_service_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *CreateEnclaveArgs) GetEnclaveName() string {
@@ -371,6 +457,88 @@ func (x *CreateEnclaveArgs) GetMode() EnclaveMode {
 	return EnclaveMode_TEST
 }
 
+func (x *CreateEnclaveArgs) GetKubernetesSettings() *KubernetesEnclaveSettings {
+	if x != nil {
+		return x.KubernetesSettings
+	}
+	return nil
+}
+
+type KubernetesEnclaveSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Labels added to the namespace of the enclave
+	NamespaceLabels map[string]string `protobuf:"bytes,1,rep,name=namespace_labels,json=namespaceLabels,proto3" json:"namespace_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Annotations added to the namespace of the enclave
+	NamespaceAnnotations map[string]string `protobuf:"bytes,2,rep,name=namespace_annotations,json=namespaceAnnotations,proto3" json:"namespace_annotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Node selectors of all the services of the enclave, which the node selectors of a service add to or override
+	DefaultNodeSelectors map[string]string `protobuf:"bytes,3,rep,name=default_node_selectors,json=defaultNodeSelectors,proto3" json:"default_node_selectors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Service account the services of the enclave run with, created in the namespace of the enclave
+	ServiceAccountName string `protobuf:"bytes,4,opt,name=service_account_name,json=serviceAccountName,proto3" json:"service_account_name,omitempty"`
+}
+
+func (x *KubernetesEnclaveSettings) Reset() {
+	*x = KubernetesEnclaveSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KubernetesEnclaveSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KubernetesEnclaveSettings) ProtoMessage() {}
+
+func (x *KubernetesEnclaveSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KubernetesEnclaveSettings.ProtoReflect.Descriptor instead.
+func (*KubernetesEnclaveSettings) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *KubernetesEnclaveSettings) GetNamespaceLabels() map[string]string {
+	if x != nil {
+		return x.NamespaceLabels
+	}
+	return nil
+}
+
+func (x *KubernetesEnclaveSettings) GetNamespaceAnnotations() map[string]string {
+	if x != nil {
+		return x.NamespaceAnnotations
+	}
+	return nil
+}
+
+func (x *KubernetesEnclaveSettings) GetDefaultNodeSelectors() map[string]string {
+	if x != nil {
+		return x.DefaultNodeSelectors
+	}
+	return nil
+}
+
+func (x *KubernetesEnclaveSettings) GetServiceAccountName() string {
+	if x != nil {
+		return x.ServiceAccountName
+	}
+	return ""
+}
+
 type CreateEnclaveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -383,7 +551,7 @@ type CreateEnclaveResponse struct {
 func (x *CreateEnclaveResponse) Reset() {
 	*x = CreateEnclaveResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[2]
+		mi := &file_engine_service_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -396,7 +564,7 @@ func (x *CreateEnclaveResponse) String() string {
 func (*CreateEnclaveResponse) ProtoMessage() {}
 
 func (x *CreateEnclaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[2]
+	mi := &file_engine_service_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -409,7 +577,7 @@ func (x *CreateEnclaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateEnclaveResponse.ProtoReflect.Descriptor instead.
 func (*CreateEnclaveResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{2}
+	return file_engine_service_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *CreateEnclaveResponse) GetEnclaveInfo() *EnclaveInfo {
@@ -437,7 +605,7 @@ type EnclaveAPIContainerInfo struct {
 func (x *EnclaveAPIContainerInfo) Reset() {
 	*x = EnclaveAPIContainerInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[3]
+		mi := &file_engine_service_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +618,7 @@ func (x *EnclaveAPIContainerInfo) String() string {
 func (*EnclaveAPIContainerInfo) ProtoMessage() {}
 
 func (x *EnclaveAPIContainerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[3]
+	mi := &file_engine_service_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +631,7 @@ func (x *EnclaveAPIContainerInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveAPIContainerInfo.ProtoReflect.Descriptor instead.
 func (*EnclaveAPIContainerInfo) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{3}
+	return file_engine_service_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *EnclaveAPIContainerInfo) GetContainerId() string {
@@ -509,7 +677,7 @@ type EnclaveAPIContainerHostMachineInfo struct {
 func (x *EnclaveAPIContainerHostMachineInfo) Reset() {
 	*x = EnclaveAPIContainerHostMachineInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[4]
+		mi := &file_engine_service_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -522,7 +690,7 @@ func (x *EnclaveAPIContainerHostMachineInfo) String() string {
 func (*EnclaveAPIContainerHostMachineInfo) ProtoMessage() {}
 
 func (x *EnclaveAPIContainerHostMachineInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[4]
+	mi := &file_engine_service_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,7 +703,7 @@ func (x *EnclaveAPIContainerHostMachineInfo) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use EnclaveAPIContainerHostMachineInfo.ProtoReflect.Descriptor instead.
 func (*EnclaveAPIContainerHostMachineInfo) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{4}
+	return file_engine_service_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnclaveAPIContainerHostMachineInfo) GetIpOnHostMachine() string {
@@ -579,7 +747,7 @@ type EnclaveInfo struct {
 func (x *EnclaveInfo) Reset() {
 	*x = EnclaveInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[5]
+		mi := &file_engine_service_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -592,7 +760,7 @@ func (x *EnclaveInfo) String() string {
 func (*EnclaveInfo) ProtoMessage() {}
 
 func (x *EnclaveInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[5]
+	mi := &file_engine_service_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,7 +773,7 @@ func (x *EnclaveInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveInfo.ProtoReflect.Descriptor instead.
 func (*EnclaveInfo) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{5}
+	return file_engine_service_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *EnclaveInfo) GetEnclaveUuid() string {
@@ -676,7 +844,7 @@ type GetEnclavesResponse struct {
 func (x *GetEnclavesResponse) Reset() {
 	*x = GetEnclavesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[6]
+		mi := &file_engine_service_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -689,7 +857,7 @@ func (x *GetEnclavesResponse) String() string {
 func (*GetEnclavesResponse) ProtoMessage() {}
 
 func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[6]
+	mi := &file_engine_service_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -702,7 +870,7 @@ func (x *GetEnclavesResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetEnclavesResponse.ProtoReflect.Descriptor instead.
 func (*GetEnclavesResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{6}
+	return file_engine_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetEnclavesResponse) GetEnclaveInfo() map[string]*EnclaveInfo {
@@ -729,7 +897,7 @@ type EnclaveIdentifiers struct {
 func (x *EnclaveIdentifiers) Reset() {
 	*x = EnclaveIdentifiers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[7]
+		mi := &file_engine_service_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -742,7 +910,7 @@ func (x *EnclaveIdentifiers) String() string {
 func (*EnclaveIdentifiers) ProtoMessage() {}
 
 func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[7]
+	mi := &file_engine_service_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +923,7 @@ func (x *EnclaveIdentifiers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveIdentifiers.ProtoReflect.Descriptor instead.
 func (*EnclaveIdentifiers) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{7}
+	return file_engine_service_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EnclaveIdentifiers) GetEnclaveUuid() string {
@@ -790,7 +958,7 @@ type GetExistingAndHistoricalEnclaveIdentifiersResponse struct {
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) Reset() {
 	*x = GetExistingAndHistoricalEnclaveIdentifiersResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[8]
+		mi := &file_engine_service_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -803,7 +971,7 @@ func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) String() string {
 func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoMessage() {}
 
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[8]
+	mi := &file_engine_service_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -816,7 +984,7 @@ func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) ProtoReflect() prot
 
 // Deprecated: Use GetExistingAndHistoricalEnclaveIdentifiersResponse.ProtoReflect.Descriptor instead.
 func (*GetExistingAndHistoricalEnclaveIdentifiersResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{8}
+	return file_engine_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *GetExistingAndHistoricalEnclaveIdentifiersResponse) GetAllIdentifiers() []*EnclaveIdentifiers {
@@ -843,7 +1011,7 @@ type StopEnclaveArgs struct {
 func (x *StopEnclaveArgs) Reset() {
 	*x = StopEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[9]
+		mi := &file_engine_service_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -856,7 +1024,7 @@ func (x *StopEnclaveArgs) String() string {
 func (*StopEnclaveArgs) ProtoMessage() {}
 
 func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[9]
+	mi := &file_engine_service_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,7 +1037,7 @@ func (x *StopEnclaveArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopEnclaveArgs.ProtoReflect.Descriptor instead.
 func (*StopEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{9}
+	return file_engine_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
@@ -879,6 +1047,59 @@ func (x *StopEnclaveArgs) GetEnclaveIdentifier() string {
 	return ""
 }
 
+// ==============================================================================================
+//
+//	Start Enclave
+//
+// ==============================================================================================
+type StartEnclaveArgs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to start
+	EnclaveIdentifier string `protobuf:"bytes,1,opt,name=enclave_identifier,json=enclaveIdentifier,proto3" json:"enclave_identifier,omitempty"`
+}
+
+func (x *StartEnclaveArgs) Reset() {
+	*x = StartEnclaveArgs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StartEnclaveArgs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartEnclaveArgs) ProtoMessage() {}
+
+func (x *StartEnclaveArgs) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartEnclaveArgs.ProtoReflect.Descriptor instead.
+func (*StartEnclaveArgs) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *StartEnclaveArgs) GetEnclaveIdentifier() string {
+	if x != nil {
+		return x.EnclaveIdentifier
+	}
+	return ""
+}
+
 // ==============================================================================================
 //
 //	Destroy Enclave
@@ -896,7 +1117,7 @@ type DestroyEnclaveArgs struct {
 func (x *DestroyEnclaveArgs) Reset() {
 	*x = DestroyEnclaveArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[10]
+		mi := &file_engine_service_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -909,7 +1130,7 @@ func (x *DestroyEnclaveArgs) String() string {
 func (*DestroyEnclaveArgs) ProtoMessage() {}
 
 func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[10]
+	mi := &file_engine_service_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -922,7 +1143,7 @@ func (x *DestroyEnclaveArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DestroyEnclaveArgs.ProtoReflect.Descriptor instead.
 func (*DestroyEnclaveArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{10}
+	return file_engine_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *DestroyEnclaveArgs) GetEnclaveIdentifier() string {
@@ -949,7 +1170,7 @@ type CleanArgs struct {
 func (x *CleanArgs) Reset() {
 	*x = CleanArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[11]
+		mi := &file_engine_service_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -962,7 +1183,7 @@ func (x *CleanArgs) String() string {
 func (*CleanArgs) ProtoMessage() {}
 
 func (x *CleanArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[11]
+	mi := &file_engine_service_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -975,7 +1196,7 @@ func (x *CleanArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanArgs.ProtoReflect.Descriptor instead.
 func (*CleanArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{11}
+	return file_engine_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *CleanArgs) GetShouldCleanAll() bool {
@@ -997,7 +1218,7 @@ type EnclaveNameAndUuid struct {
 func (x *EnclaveNameAndUuid) Reset() {
 	*x = EnclaveNameAndUuid{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[12]
+		mi := &file_engine_service_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1010,7 +1231,7 @@ func (x *EnclaveNameAndUuid) String() string {
 func (*EnclaveNameAndUuid) ProtoMessage() {}
 
 func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[12]
+	mi := &file_engine_service_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1023,7 +1244,7 @@ func (x *EnclaveNameAndUuid) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnclaveNameAndUuid.ProtoReflect.Descriptor instead.
 func (*EnclaveNameAndUuid) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{12}
+	return file_engine_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *EnclaveNameAndUuid) GetName() string {
@@ -1052,7 +1273,7 @@ type CleanResponse struct {
 func (x *CleanResponse) Reset() {
 	*x = CleanResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[13]
+		mi := &file_engine_service_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1065,7 +1286,7 @@ func (x *CleanResponse) String() string {
 func (*CleanResponse) ProtoMessage() {}
 
 func (x *CleanResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[13]
+	mi := &file_engine_service_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1078,7 +1299,7 @@ func (x *CleanResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CleanResponse.ProtoReflect.Descriptor instead.
 func (*CleanResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{13}
+	return file_engine_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *CleanResponse) GetRemovedEnclaveNameAndUuids() []*EnclaveNameAndUuid {
@@ -1106,12 +1327,18 @@ type GetServiceLogsArgs struct {
 	FollowLogs bool `protobuf:"varint,3,opt,name=follow_logs,json=followLogs,proto3" json:"follow_logs,omitempty"`
 	// The conjunctive log lines filters, the first filter is applied over the found log lines, the second filter is applied over the filter one result and so on (like grep)
 	ConjunctiveFilters []*LogLineFilter `protobuf:"bytes,4,rep,name=conjunctive_filters,json=conjunctiveFilters,proto3" json:"conjunctive_filters,omitempty"`
+	// If set, only the log lines logged at or after this time will be returned
+	Since *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=since,proto3" json:"since,omitempty"`
+	// If set, only the log lines logged before this time will be returned
+	Until *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=until,proto3" json:"until,omitempty"`
+	// If greater than zero, only the last N log lines of each service will be returned (after applying the time bounds and the filters)
+	NumLogLines uint32 `protobuf:"varint,7,opt,name=num_log_lines,json=numLogLines,proto3" json:"num_log_lines,omitempty"`
 }
 
 func (x *GetServiceLogsArgs) Reset() {
 	*x = GetServiceLogsArgs{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[14]
+		mi := &file_engine_service_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1124,7 +1351,7 @@ func (x *GetServiceLogsArgs) String() string {
 func (*GetServiceLogsArgs) ProtoMessage() {}
 
 func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[14]
+	mi := &file_engine_service_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1137,7 +1364,7 @@ func (x *GetServiceLogsArgs) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceLogsArgs.ProtoReflect.Descriptor instead.
 func (*GetServiceLogsArgs) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{14}
+	return file_engine_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetServiceLogsArgs) GetEnclaveIdentifier() string {
@@ -1168,6 +1395,27 @@ func (x *GetServiceLogsArgs) GetConjunctiveFilters() []*LogLineFilter {
 	return nil
 }
 
+func (x *GetServiceLogsArgs) GetSince() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Since
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetUntil() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Until
+	}
+	return nil
+}
+
+func (x *GetServiceLogsArgs) GetNumLogLines() uint32 {
+	if x != nil {
+		return x.NumLogLines
+	}
+	return 0
+}
+
 type GetServiceLogsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1183,7 +1431,7 @@ type GetServiceLogsResponse struct {
 func (x *GetServiceLogsResponse) Reset() {
 	*x = GetServiceLogsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[15]
+		mi := &file_engine_service_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1196,7 +1444,7 @@ func (x *GetServiceLogsResponse) String() string {
 func (*GetServiceLogsResponse) ProtoMessage() {}
 
 func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[15]
+	mi := &file_engine_service_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1209,7 +1457,7 @@ func (x *GetServiceLogsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServiceLogsResponse.ProtoReflect.Descriptor instead.
 func (*GetServiceLogsResponse) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{15}
+	return file_engine_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetServiceLogsResponse) GetServiceLogsByServiceUuid() map[string]*LogLine {
@@ -1226,19 +1474,20 @@ func (x *GetServiceLogsResponse) GetNotFoundServiceUuidSet() map[string]bool {
 	return nil
 }
 
-// TODO add timestamp as well, for when we do timestamp-handling on the client side
 type LogLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Line []string `protobuf:"bytes,1,rep,name=line,proto3" json:"line,omitempty"`
+	// The time each line was logged at, index-aligned with the lines
+	Timestamp []*timestamppb.Timestamp `protobuf:"bytes,2,rep,name=timestamp,proto3" json:"timestamp,omitempty"`
 }
 
 func (x *LogLine) Reset() {
 	*x = LogLine{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[16]
+		mi := &file_engine_service_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1251,7 +1500,7 @@ func (x *LogLine) String() string {
 func (*LogLine) ProtoMessage() {}
 
 func (x *LogLine) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[16]
+	mi := &file_engine_service_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1264,7 +1513,7 @@ func (x *LogLine) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogLine.ProtoReflect.Descriptor instead.
 func (*LogLine) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{16}
+	return file_engine_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *LogLine) GetLine() []string {
@@ -1274,6 +1523,13 @@ func (x *LogLine) GetLine() []string {
 	return nil
 }
 
+func (x *LogLine) GetTimestamp() []*timestamppb.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
 type LogLineFilter struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1286,7 +1542,7 @@ type LogLineFilter struct {
 func (x *LogLineFilter) Reset() {
 	*x = LogLineFilter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_engine_service_proto_msgTypes[17]
+		mi := &file_engine_service_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1299,7 +1555,7 @@ func (x *LogLineFilter) String() string {
 func (*LogLineFilter) ProtoMessage() {}
 
 func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
-	mi := &file_engine_service_proto_msgTypes[17]
+	mi := &file_engine_service_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1312,7 +1568,7 @@ func (x *LogLineFilter) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LogLineFilter.ProtoReflect.Descriptor instead.
 func (*LogLineFilter) Descriptor() ([]byte, []int) {
-	return file_engine_service_proto_rawDescGZIP(), []int{17}
+	return file_engine_service_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *LogLineFilter) GetOperator() LogLineOperator {
@@ -1329,6 +1585,68 @@ func (x *LogLineFilter) GetTextPattern() string {
 	return ""
 }
 
+// ==============================================================================================
+//
+//	Get Logs Storage Usage
+//
+// ==============================================================================================
+type GetLogsStorageUsageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The disk space, in bytes, taken by all the stored service logs
+	TotalSizeInBytes uint64 `protobuf:"varint,1,opt,name=total_size_in_bytes,json=totalSizeInBytes,proto3" json:"total_size_in_bytes,omitempty"`
+	// The disk space, in bytes, taken by the stored service logs of each enclave, keyed by enclave UUID
+	SizeInBytesByEnclaveUuid map[string]uint64 `protobuf:"bytes,2,rep,name=size_in_bytes_by_enclave_uuid,json=sizeInBytesByEnclaveUuid,proto3" json:"size_in_bytes_by_enclave_uuid,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (x *GetLogsStorageUsageResponse) Reset() {
+	*x = GetLogsStorageUsageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_engine_service_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLogsStorageUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsStorageUsageResponse) ProtoMessage() {}
+
+func (x *GetLogsStorageUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_engine_service_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsStorageUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetLogsStorageUsageResponse) Descriptor() ([]byte, []int) {
+	return file_engine_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetLogsStorageUsageResponse) GetTotalSizeInBytes() uint64 {
+	if x != nil {
+		return x.TotalSizeInBytes
+	}
+	return 0
+}
+
+func (x *GetLogsStorageUsageResponse) GetSizeInBytesByEnclaveUuid() map[string]uint64 {
+	if x != nil {
+		return x.SizeInBytesByEnclaveUuid
+	}
+	return nil
+}
+
 var File_engine_service_proto protoreflect.FileDescriptor
 
 var file_engine_service_proto_rawDesc = []byte{
@@ -1338,24 +1656,90 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x22, 0x3e, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x67,
-	0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0xd5, 0x01, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
-	0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65, 0x6e,
-	0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x70, 0x69,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61, 0x70,
-	0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x04, 0x6d,
-	0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f,
-	0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
+	0x22, 0xa2, 0x01, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e,
+	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x4c, 0x0a, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x88, 0x01, 0x01, 0x42,
+	0x14, 0x0a, 0x12, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x70, 0x6f, 0x6f, 0x6c,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xc9, 0x01, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x6f, 0x6f,
+	0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x6f,
+	0x6f, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x75,
+	0x6d, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x6e, 0x75, 0x6d, 0x49, 0x64, 0x6c, 0x65, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x6e, 0x75, 0x6d, 0x5f, 0x61, 0x64,
+	0x6f, 0x70, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6e, 0x75, 0x6d, 0x41, 0x64,
+	0x6f, 0x70, 0x74, 0x65, 0x64, 0x49, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x73, 0x22, 0xca, 0x02, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x39, 0x0a, 0x19, 0x61, 0x70,
+	0x69, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x61,
+	0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x54, 0x61, 0x67, 0x12, 0x35, 0x0a, 0x17, 0x61, 0x70, 0x69, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x61, 0x70, 0x69, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x2b, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d,
+	0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x5b, 0x0a, 0x13, 0x6b, 0x75, 0x62,
+	0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x48, 0x00, 0x52,
+	0x12, 0x6b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x88, 0x01, 0x01, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x6b, 0x75, 0x62, 0x65, 0x72,
+	0x6e, 0x65, 0x74, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0xf7,
+	0x04, 0x0a, 0x19, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63,
+	0x6c, 0x61, 0x76, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x65, 0x0a, 0x10,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
+	0x61, 0x70, 0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x12, 0x74, 0x0a, 0x15, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x14, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x75, 0x0a, 0x16, 0x64, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65,
+	0x73, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
+	0x12, 0x30, 0x0a, 0x14, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4e, 0x61,
+	0x6d, 0x65, 0x1a, 0x42, 0x0a, 0x14, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x47, 0x0a, 0x19, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x53, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
 	0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x6e, 0x66,
 	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
@@ -1448,166 +1832,211 @@ var file_engine_service_proto_rawDesc = []byte{
 	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63,
 	0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64,
-	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x73, 0x74,
-	0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d,
-	0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x35, 0x0a,
-	0x09, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x68,
-	0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43, 0x6c, 0x65, 0x61,
-	0x6e, 0x41, 0x6c, 0x6c, 0x22, 0x3c, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e,
-	0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x65,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
-	0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x6e,
-	0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x52, 0x1a, 0x72, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41,
-	0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0xd1, 0x02, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d,
-	0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x5c, 0x0a,
-	0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
-	0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55,
-	0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x66,
-	0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x4a, 0x0a, 0x13,
-	0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76,
-	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x03, 0x0a, 0x16,
-	0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x80, 0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x18, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74,
-	0x5f, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75,
-	0x75, 0x69, 0x64, 0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x41, 0x0a, 0x10, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x2d, 0x0a, 0x12,
+	0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x22, 0x43, 0x0a, 0x12, 0x44,
+	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x22, 0x35, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x12, 0x28, 0x0a,
+	0x10, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x5f, 0x63, 0x6c, 0x65, 0x61, 0x6e, 0x5f, 0x61, 0x6c,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x43,
+	0x6c, 0x65, 0x61, 0x6e, 0x41, 0x6c, 0x6c, 0x22, 0x3c, 0x0a, 0x12, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x22, 0x73, 0x0a, 0x0d, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x62, 0x0a, 0x1e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x64, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x61,
+	0x6e, 0x64, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x52, 0x1a,
+	0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x41, 0x6e, 0x64, 0x55, 0x75, 0x69, 0x64, 0x73, 0x22, 0xd9, 0x03, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x65,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72,
+	0x12, 0x5c, 0x0a, 0x10, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64,
+	0x5f, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x5f, 0x6c, 0x6f, 0x67, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x66, 0x6f, 0x6c, 0x6c, 0x6f, 0x77, 0x4c, 0x6f, 0x67, 0x73, 0x12,
+	0x4a, 0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x65,
+	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x6a, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x75, 0x6e, 0x74, 0x69, 0x6c, 0x12,
+	0x22, 0x0a, 0x0d, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6e, 0x75, 0x6d, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x73, 0x1a, 0x41, 0x0a, 0x13, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75,
+	0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xc4, 0x03, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x65,
 	0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e,
-	0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75,
-	0x69, 0x64, 0x53, 0x65, 0x74, 0x1a, 0x60, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69,
-	0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f,
-	0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65,
-	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x1d, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e,
-	0x65, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f,
-	0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x74,
-	0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x2a, 0x27,
-	0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a,
-	0x04, 0x54, 0x45, 0x53, 0x54, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x44, 0x55,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x2a, 0x86, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c,
-	0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x1d, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x45,
-	0x4d, 0x50, 0x54, 0x59, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45,
-	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02,
-	0x2a, 0x94, 0x01, 0x0a, 0x19, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29,
-	0x0a, 0x25, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x4f, 0x4e, 0x45,
-	0x58, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01,
-	0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54,
-	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x4c,
-	0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x21, 0x4c,
-	0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44,
-	0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54,
-	0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43,
-	0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x2c, 0x0a,
-	0x28, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
-	0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41,
-	0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x4c,
-	0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44,
-	0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f,
-	0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x32, 0xae, 0x05,
-	0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
-	0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
-	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a,
-	0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1d,
-	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69,
-	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a,
-	0x2a, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x65, 0x12, 0x80, 0x01, 0x0a, 0x1c, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x6c, 0x6f,
+	0x67, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x40, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x18, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42, 0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x55, 0x75, 0x69, 0x64, 0x12, 0x7a, 0x0a, 0x1a, 0x6e, 0x6f, 0x74, 0x5f, 0x66, 0x6f, 0x75, 0x6e,
+	0x64, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x75, 0x69, 0x64, 0x5f, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64,
+	0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x16, 0x6e, 0x6f, 0x74, 0x46, 0x6f, 0x75,
+	0x6e, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74,
+	0x1a, 0x60, 0x0a, 0x1d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x42,
+	0x79, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x13, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x49, 0x0a, 0x1b, 0x4e, 0x6f, 0x74, 0x46, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x55, 0x75, 0x69, 0x64, 0x53, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a,
+	0x07, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x38, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x6b, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e,
+	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x21, 0x0a, 0x0c, 0x74, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x65, 0x78, 0x74, 0x50, 0x61, 0x74, 0x74,
+	0x65, 0x72, 0x6e, 0x22, 0xa2, 0x02, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x13, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x73, 0x69, 0x7a,
+	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x86, 0x01, 0x0a, 0x1d, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x5f, 0x62, 0x79, 0x5f, 0x65, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x5f,
+	0x75, 0x75, 0x69, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x45, 0x2e, 0x65, 0x6e, 0x67,
+	0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x2e, 0x53, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42,
+	0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x18, 0x73, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x79,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x1a, 0x4b, 0x0a, 0x1d, 0x53,
+	0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x79, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x55, 0x75, 0x69, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x2a, 0x27, 0x0a, 0x0b, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x54, 0x45, 0x53, 0x54, 0x10,
+	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10,
+	0x01, 0x2a, 0x86, 0x01, 0x0a, 0x17, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a,
+	0x1d, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x00,
+	0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x52, 0x55, 0x4e, 0x4e,
+	0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x73, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x94, 0x01, 0x0a, 0x19, 0x45,
+	0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x29, 0x0a, 0x25, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x4e, 0x4f, 0x4e, 0x45, 0x58, 0x49, 0x53, 0x54, 0x45, 0x4e,
+	0x54, 0x10, 0x00, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50,
+	0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x5f, 0x52, 0x55, 0x4e, 0x4e, 0x49, 0x4e, 0x47, 0x10, 0x01, 0x12, 0x25, 0x0a, 0x21, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x50, 0x49, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x53, 0x54, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10,
+	0x02, 0x2a, 0xc3, 0x01, 0x0a, 0x0f, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x21, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x43, 0x4f,
+	0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25,
+	0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f,
+	0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e,
+	0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x4c, 0x6f, 0x67, 0x4c, 0x69,
+	0x6e, 0x65, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f,
+	0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x52, 0x45,
+	0x47, 0x45, 0x58, 0x10, 0x02, 0x12, 0x30, 0x0a, 0x2c, 0x4c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x44, 0x4f, 0x45, 0x53, 0x5f, 0x4e, 0x4f,
+	0x54, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x5f, 0x4d, 0x41, 0x54, 0x43, 0x48, 0x5f,
+	0x52, 0x45, 0x47, 0x45, 0x58, 0x10, 0x03, 0x32, 0xd0, 0x06, 0x0a, 0x0d, 0x45, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1d, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c,
+	0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x21, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
+	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x63, 0x6c, 0x61,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x48, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
+	0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x86, 0x01, 0x0a, 0x2a, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x3e, 0x2e,
+	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x78,
+	0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x69,
+	0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x44, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1b,
+	0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
-	0x70, 0x74, 0x79, 0x1a, 0x3e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69,
-	0x2e, 0x47, 0x65, 0x74, 0x45, 0x78, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x48,
-	0x69, 0x73, 0x74, 0x6f, 0x72, 0x69, 0x63, 0x61, 0x6c, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65,
-	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63,
-	0x6c, 0x61, 0x76, 0x65, 0x12, 0x1b, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70,
-	0x69, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67,
-	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x0e, 0x44,
-	0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1e, 0x2e,
-	0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73, 0x74, 0x72,
-	0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x05, 0x43, 0x6c, 0x65, 0x61, 0x6e,
-	0x12, 0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c,
-	0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65,
-	0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
-	0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f,
-	0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
-	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x56,
-	0x5a, 0x54, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72,
-	0x74, 0x6f, 0x73, 0x69, 0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f,
-	0x73, 0x69, 0x73, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65,
-	0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69,
-	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0c, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e,
+	0x63, 0x6c, 0x61, 0x76, 0x65, 0x12, 0x1c, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41,
+	0x72, 0x67, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x4a, 0x0a,
+	0x0e, 0x44, 0x65, 0x73, 0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x12,
+	0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x73,
+	0x74, 0x72, 0x6f, 0x79, 0x45, 0x6e, 0x63, 0x6c, 0x61, 0x76, 0x65, 0x41, 0x72, 0x67, 0x73, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x00, 0x12, 0x3b, 0x0a, 0x05, 0x43, 0x6c, 0x65,
+	0x61, 0x6e, 0x12, 0x15, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e,
+	0x43, 0x6c, 0x65, 0x61, 0x6e, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x19, 0x2e, 0x65, 0x6e, 0x67, 0x69,
+	0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x58, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x1e, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x41, 0x72, 0x67, 0x73, 0x1a, 0x22, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x58, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x27, 0x2e, 0x65, 0x6e, 0x67, 0x69, 0x6e, 0x65, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x4c, 0x6f, 0x67, 0x73, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x56, 0x5a, 0x54, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69,
+	0x73, 0x2d, 0x74, 0x65, 0x63, 0x68, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2f, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x2f, 0x6b, 0x75, 0x72, 0x74, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x67, 0x69, 0x6e,
+	0x65, 0x5f, 0x72, 0x70, 0x63, 0x5f, 0x61, 0x70, 0x69, 0x5f, 0x62, 0x69, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1623,76 +2052,97 @@ func file_engine_service_proto_rawDescGZIP() []byte {
 }
 
 var file_engine_service_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_engine_service_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_engine_service_proto_goTypes = []interface{}{
 	(EnclaveMode)(0),                                           // 0: engine_api.EnclaveMode
 	(EnclaveContainersStatus)(0),                               // 1: engine_api.EnclaveContainersStatus
 	(EnclaveAPIContainerStatus)(0),                             // 2: engine_api.EnclaveAPIContainerStatus
 	(LogLineOperator)(0),                                       // 3: engine_api.LogLineOperator
 	(*GetEngineInfoResponse)(nil),                              // 4: engine_api.GetEngineInfoResponse
-	(*CreateEnclaveArgs)(nil),                                  // 5: engine_api.CreateEnclaveArgs
-	(*CreateEnclaveResponse)(nil),                              // 6: engine_api.CreateEnclaveResponse
-	(*EnclaveAPIContainerInfo)(nil),                            // 7: engine_api.EnclaveAPIContainerInfo
-	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 8: engine_api.EnclaveAPIContainerHostMachineInfo
-	(*EnclaveInfo)(nil),                                        // 9: engine_api.EnclaveInfo
-	(*GetEnclavesResponse)(nil),                                // 10: engine_api.GetEnclavesResponse
-	(*EnclaveIdentifiers)(nil),                                 // 11: engine_api.EnclaveIdentifiers
-	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 12: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	(*StopEnclaveArgs)(nil),                                    // 13: engine_api.StopEnclaveArgs
-	(*DestroyEnclaveArgs)(nil),                                 // 14: engine_api.DestroyEnclaveArgs
-	(*CleanArgs)(nil),                                          // 15: engine_api.CleanArgs
-	(*EnclaveNameAndUuid)(nil),                                 // 16: engine_api.EnclaveNameAndUuid
-	(*CleanResponse)(nil),                                      // 17: engine_api.CleanResponse
-	(*GetServiceLogsArgs)(nil),                                 // 18: engine_api.GetServiceLogsArgs
-	(*GetServiceLogsResponse)(nil),                             // 19: engine_api.GetServiceLogsResponse
-	(*LogLine)(nil),                                            // 20: engine_api.LogLine
-	(*LogLineFilter)(nil),                                      // 21: engine_api.LogLineFilter
-	nil,                                                        // 22: engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	nil,                                                        // 23: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	nil,                                                        // 24: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	nil,                                                        // 25: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	(*timestamppb.Timestamp)(nil),                              // 26: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),                                      // 27: google.protobuf.Empty
+	(*EnclavePoolInfo)(nil),                                    // 5: engine_api.EnclavePoolInfo
+	(*CreateEnclaveArgs)(nil),                                  // 6: engine_api.CreateEnclaveArgs
+	(*KubernetesEnclaveSettings)(nil),                          // 7: engine_api.KubernetesEnclaveSettings
+	(*CreateEnclaveResponse)(nil),                              // 8: engine_api.CreateEnclaveResponse
+	(*EnclaveAPIContainerInfo)(nil),                            // 9: engine_api.EnclaveAPIContainerInfo
+	(*EnclaveAPIContainerHostMachineInfo)(nil),                 // 10: engine_api.EnclaveAPIContainerHostMachineInfo
+	(*EnclaveInfo)(nil),                                        // 11: engine_api.EnclaveInfo
+	(*GetEnclavesResponse)(nil),                                // 12: engine_api.GetEnclavesResponse
+	(*EnclaveIdentifiers)(nil),                                 // 13: engine_api.EnclaveIdentifiers
+	(*GetExistingAndHistoricalEnclaveIdentifiersResponse)(nil), // 14: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	(*StopEnclaveArgs)(nil),                                    // 15: engine_api.StopEnclaveArgs
+	(*StartEnclaveArgs)(nil),                                   // 16: engine_api.StartEnclaveArgs
+	(*DestroyEnclaveArgs)(nil),                                 // 17: engine_api.DestroyEnclaveArgs
+	(*CleanArgs)(nil),                                          // 18: engine_api.CleanArgs
+	(*EnclaveNameAndUuid)(nil),                                 // 19: engine_api.EnclaveNameAndUuid
+	(*CleanResponse)(nil),                                      // 20: engine_api.CleanResponse
+	(*GetServiceLogsArgs)(nil),                                 // 21: engine_api.GetServiceLogsArgs
+	(*GetServiceLogsResponse)(nil),                             // 22: engine_api.GetServiceLogsResponse
+	(*LogLine)(nil),                                            // 23: engine_api.LogLine
+	(*LogLineFilter)(nil),                                      // 24: engine_api.LogLineFilter
+	(*GetLogsStorageUsageResponse)(nil),                        // 25: engine_api.GetLogsStorageUsageResponse
+	nil,                                                        // 26: engine_api.KubernetesEnclaveSettings.NamespaceLabelsEntry
+	nil,                                                        // 27: engine_api.KubernetesEnclaveSettings.NamespaceAnnotationsEntry
+	nil,                                                        // 28: engine_api.KubernetesEnclaveSettings.DefaultNodeSelectorsEntry
+	nil,                                                        // 29: engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	nil,                                                        // 30: engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	nil,                                                        // 31: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	nil,                                                        // 32: engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	nil,                                                        // 33: engine_api.GetLogsStorageUsageResponse.SizeInBytesByEnclaveUuidEntry
+	(*timestamppb.Timestamp)(nil),                              // 34: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                                      // 35: google.protobuf.Empty
 }
 var file_engine_service_proto_depIdxs = []int32{
-	0,  // 0: engine_api.CreateEnclaveArgs.mode:type_name -> engine_api.EnclaveMode
-	9,  // 1: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
-	1,  // 2: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
-	2,  // 3: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
-	7,  // 4: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
-	8,  // 5: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
-	26, // 6: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
-	22, // 7: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
-	11, // 8: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
-	16, // 9: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
-	23, // 10: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
-	21, // 11: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
-	24, // 12: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
-	25, // 13: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
-	3,  // 14: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
-	9,  // 15: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
-	20, // 16: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
-	27, // 17: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
-	5,  // 18: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
-	27, // 19: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
-	27, // 20: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
-	13, // 21: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
-	14, // 22: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
-	15, // 23: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
-	18, // 24: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
-	4,  // 25: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
-	6,  // 26: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
-	10, // 27: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
-	12, // 28: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
-	27, // 29: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
-	27, // 30: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
-	17, // 31: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
-	19, // 32: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
-	25, // [25:33] is the sub-list for method output_type
-	17, // [17:25] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	5,  // 0: engine_api.GetEngineInfoResponse.enclave_pool_info:type_name -> engine_api.EnclavePoolInfo
+	0,  // 1: engine_api.CreateEnclaveArgs.mode:type_name -> engine_api.EnclaveMode
+	7,  // 2: engine_api.CreateEnclaveArgs.kubernetes_settings:type_name -> engine_api.KubernetesEnclaveSettings
+	26, // 3: engine_api.KubernetesEnclaveSettings.namespace_labels:type_name -> engine_api.KubernetesEnclaveSettings.NamespaceLabelsEntry
+	27, // 4: engine_api.KubernetesEnclaveSettings.namespace_annotations:type_name -> engine_api.KubernetesEnclaveSettings.NamespaceAnnotationsEntry
+	28, // 5: engine_api.KubernetesEnclaveSettings.default_node_selectors:type_name -> engine_api.KubernetesEnclaveSettings.DefaultNodeSelectorsEntry
+	11, // 6: engine_api.CreateEnclaveResponse.enclave_info:type_name -> engine_api.EnclaveInfo
+	1,  // 7: engine_api.EnclaveInfo.containers_status:type_name -> engine_api.EnclaveContainersStatus
+	2,  // 8: engine_api.EnclaveInfo.api_container_status:type_name -> engine_api.EnclaveAPIContainerStatus
+	9,  // 9: engine_api.EnclaveInfo.api_container_info:type_name -> engine_api.EnclaveAPIContainerInfo
+	10, // 10: engine_api.EnclaveInfo.api_container_host_machine_info:type_name -> engine_api.EnclaveAPIContainerHostMachineInfo
+	34, // 11: engine_api.EnclaveInfo.creation_time:type_name -> google.protobuf.Timestamp
+	29, // 12: engine_api.GetEnclavesResponse.enclave_info:type_name -> engine_api.GetEnclavesResponse.EnclaveInfoEntry
+	13, // 13: engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse.allIdentifiers:type_name -> engine_api.EnclaveIdentifiers
+	19, // 14: engine_api.CleanResponse.removed_enclave_name_and_uuids:type_name -> engine_api.EnclaveNameAndUuid
+	30, // 15: engine_api.GetServiceLogsArgs.service_uuid_set:type_name -> engine_api.GetServiceLogsArgs.ServiceUuidSetEntry
+	24, // 16: engine_api.GetServiceLogsArgs.conjunctive_filters:type_name -> engine_api.LogLineFilter
+	34, // 17: engine_api.GetServiceLogsArgs.since:type_name -> google.protobuf.Timestamp
+	34, // 18: engine_api.GetServiceLogsArgs.until:type_name -> google.protobuf.Timestamp
+	31, // 19: engine_api.GetServiceLogsResponse.service_logs_by_service_uuid:type_name -> engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry
+	32, // 20: engine_api.GetServiceLogsResponse.not_found_service_uuid_set:type_name -> engine_api.GetServiceLogsResponse.NotFoundServiceUuidSetEntry
+	34, // 21: engine_api.LogLine.timestamp:type_name -> google.protobuf.Timestamp
+	3,  // 22: engine_api.LogLineFilter.operator:type_name -> engine_api.LogLineOperator
+	33, // 23: engine_api.GetLogsStorageUsageResponse.size_in_bytes_by_enclave_uuid:type_name -> engine_api.GetLogsStorageUsageResponse.SizeInBytesByEnclaveUuidEntry
+	11, // 24: engine_api.GetEnclavesResponse.EnclaveInfoEntry.value:type_name -> engine_api.EnclaveInfo
+	23, // 25: engine_api.GetServiceLogsResponse.ServiceLogsByServiceUuidEntry.value:type_name -> engine_api.LogLine
+	35, // 26: engine_api.EngineService.GetEngineInfo:input_type -> google.protobuf.Empty
+	6,  // 27: engine_api.EngineService.CreateEnclave:input_type -> engine_api.CreateEnclaveArgs
+	35, // 28: engine_api.EngineService.GetEnclaves:input_type -> google.protobuf.Empty
+	35, // 29: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:input_type -> google.protobuf.Empty
+	15, // 30: engine_api.EngineService.StopEnclave:input_type -> engine_api.StopEnclaveArgs
+	16, // 31: engine_api.EngineService.StartEnclave:input_type -> engine_api.StartEnclaveArgs
+	17, // 32: engine_api.EngineService.DestroyEnclave:input_type -> engine_api.DestroyEnclaveArgs
+	18, // 33: engine_api.EngineService.Clean:input_type -> engine_api.CleanArgs
+	21, // 34: engine_api.EngineService.GetServiceLogs:input_type -> engine_api.GetServiceLogsArgs
+	35, // 35: engine_api.EngineService.GetLogsStorageUsage:input_type -> google.protobuf.Empty
+	4,  // 36: engine_api.EngineService.GetEngineInfo:output_type -> engine_api.GetEngineInfoResponse
+	8,  // 37: engine_api.EngineService.CreateEnclave:output_type -> engine_api.CreateEnclaveResponse
+	12, // 38: engine_api.EngineService.GetEnclaves:output_type -> engine_api.GetEnclavesResponse
+	14, // 39: engine_api.EngineService.GetExistingAndHistoricalEnclaveIdentifiers:output_type -> engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse
+	35, // 40: engine_api.EngineService.StopEnclave:output_type -> google.protobuf.Empty
+	35, // 41: engine_api.EngineService.StartEnclave:output_type -> google.protobuf.Empty
+	35, // 42: engine_api.EngineService.DestroyEnclave:output_type -> google.protobuf.Empty
+	20, // 43: engine_api.EngineService.Clean:output_type -> engine_api.CleanResponse
+	22, // 44: engine_api.EngineService.GetServiceLogs:output_type -> engine_api.GetServiceLogsResponse
+	25, // 45: engine_api.EngineService.GetLogsStorageUsage:output_type -> engine_api.GetLogsStorageUsageResponse
+	36, // [36:46] is the sub-list for method output_type
+	26, // [26:36] is the sub-list for method input_type
+	26, // [26:26] is the sub-list for extension type_name
+	26, // [26:26] is the sub-list for extension extendee
+	0,  // [0:26] is the sub-list for field type_name
 }
 
 func init() { file_engine_service_proto_init() }
@@ -1714,7 +2164,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEnclaveArgs); i {
+			switch v := v.(*EnclavePoolInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1726,7 +2176,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateEnclaveResponse); i {
+			switch v := v.(*CreateEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1738,7 +2188,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveAPIContainerInfo); i {
+			switch v := v.(*KubernetesEnclaveSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1750,7 +2200,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveAPIContainerHostMachineInfo); i {
+			switch v := v.(*CreateEnclaveResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1762,7 +2212,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveInfo); i {
+			switch v := v.(*EnclaveAPIContainerInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1774,7 +2224,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetEnclavesResponse); i {
+			switch v := v.(*EnclaveAPIContainerHostMachineInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1786,7 +2236,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveIdentifiers); i {
+			switch v := v.(*EnclaveInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1798,7 +2248,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
+			switch v := v.(*GetEnclavesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1810,7 +2260,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopEnclaveArgs); i {
+			switch v := v.(*EnclaveIdentifiers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1822,7 +2272,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DestroyEnclaveArgs); i {
+			switch v := v.(*GetExistingAndHistoricalEnclaveIdentifiersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1834,7 +2284,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanArgs); i {
+			switch v := v.(*StopEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1846,7 +2296,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EnclaveNameAndUuid); i {
+			switch v := v.(*StartEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1858,7 +2308,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CleanResponse); i {
+			switch v := v.(*DestroyEnclaveArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1870,7 +2320,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsArgs); i {
+			switch v := v.(*CleanArgs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1882,7 +2332,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetServiceLogsResponse); i {
+			switch v := v.(*EnclaveNameAndUuid); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1894,7 +2344,7 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LogLine); i {
+			switch v := v.(*CleanResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1906,6 +2356,42 @@ func file_engine_service_proto_init() {
 			}
 		}
 		file_engine_service_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsArgs); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetServiceLogsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_engine_service_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LogLineFilter); i {
 			case 0:
 				return &v.state
@@ -1917,14 +2403,28 @@ func file_engine_service_proto_init() {
 				return nil
 			}
 		}
+		file_engine_service_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLogsStorageUsageResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
+	file_engine_service_proto_msgTypes[0].OneofWrappers = []interface{}{}
+	file_engine_service_proto_msgTypes[2].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_engine_service_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   22,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -25,9 +25,11 @@ const (
 	EngineService_GetEnclaves_FullMethodName                                = "/engine_api.EngineService/GetEnclaves"
 	EngineService_GetExistingAndHistoricalEnclaveIdentifiers_FullMethodName = "/engine_api.EngineService/GetExistingAndHistoricalEnclaveIdentifiers"
 	EngineService_StopEnclave_FullMethodName                                = "/engine_api.EngineService/StopEnclave"
+	EngineService_StartEnclave_FullMethodName                               = "/engine_api.EngineService/StartEnclave"
 	EngineService_DestroyEnclave_FullMethodName                             = "/engine_api.EngineService/DestroyEnclave"
 	EngineService_Clean_FullMethodName                                      = "/engine_api.EngineService/Clean"
 	EngineService_GetServiceLogs_FullMethodName                             = "/engine_api.EngineService/GetServiceLogs"
+	EngineService_GetLogsStorageUsage_FullMethodName                        = "/engine_api.EngineService/GetLogsStorageUsage"
 )
 
 // EngineServiceClient is the client API for EngineService service.
@@ -49,12 +51,16 @@ type EngineServiceClient interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(ctx context.Context, in *StopEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Starts a stopped enclave again, restoring its services to the state they had when it was stopped
+	StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(ctx context.Context, in *CleanArgs, opts ...grpc.CallOption) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(ctx context.Context, in *GetServiceLogsArgs, opts ...grpc.CallOption) (EngineService_GetServiceLogsClient, error)
+	// Returns the disk space taken by the stored service logs
+	GetLogsStorageUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLogsStorageUsageResponse, error)
 }
 
 type engineServiceClient struct {
@@ -110,6 +116,15 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, in *StopEnclaveAr
 	return out, nil
 }
 
+func (c *engineServiceClient) StartEnclave(ctx context.Context, in *StartEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, EngineService_StartEnclave_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, in *DestroyEnclaveArgs, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, EngineService_DestroyEnclave_FullMethodName, in, out, opts...)
@@ -160,6 +175,15 @@ func (x *engineServiceGetServiceLogsClient) Recv() (*GetServiceLogsResponse, err
 	return m, nil
 }
 
+func (c *engineServiceClient) GetLogsStorageUsage(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetLogsStorageUsageResponse, error) {
+	out := new(GetLogsStorageUsageResponse)
+	err := c.cc.Invoke(ctx, EngineService_GetLogsStorageUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EngineServiceServer is the server API for EngineService service.
 // All implementations should embed UnimplementedEngineServiceServer
 // for forward compatibility
@@ -179,12 +203,16 @@ type EngineServiceServer interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *emptypb.Empty) (*GetExistingAndHistoricalEnclaveIdentifiersResponse, error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error)
+	// Starts a stopped enclave again, restoring its services to the state they had when it was stopped
+	StartEnclave(context.Context, *StartEnclaveArgs) (*emptypb.Empty, error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *CleanArgs) (*CleanResponse, error)
 	// Get service logs
 	GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error
+	// Returns the disk space taken by the stored service logs
+	GetLogsStorageUsage(context.Context, *emptypb.Empty) (*GetLogsStorageUsageResponse, error)
 }
 
 // UnimplementedEngineServiceServer should be embedded to have forward compatible implementations.
@@ -206,6 +234,9 @@ func (UnimplementedEngineServiceServer) GetExistingAndHistoricalEnclaveIdentifie
 func (UnimplementedEngineServiceServer) StopEnclave(context.Context, *StopEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StopEnclave not implemented")
 }
+func (UnimplementedEngineServiceServer) StartEnclave(context.Context, *StartEnclaveArgs) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartEnclave not implemented")
+}
 func (UnimplementedEngineServiceServer) DestroyEnclave(context.Context, *DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DestroyEnclave not implemented")
 }
@@ -215,6 +246,9 @@ func (UnimplementedEngineServiceServer) Clean(context.Context, *CleanArgs) (*Cle
 func (UnimplementedEngineServiceServer) GetServiceLogs(*GetServiceLogsArgs, EngineService_GetServiceLogsServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetServiceLogs not implemented")
 }
+func (UnimplementedEngineServiceServer) GetLogsStorageUsage(context.Context, *emptypb.Empty) (*GetLogsStorageUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLogsStorageUsage not implemented")
+}
 
 // UnsafeEngineServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to EngineServiceServer will
@@ -317,6 +351,24 @@ func _EngineService_StopEnclave_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EngineService_StartEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartEnclaveArgs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).StartEnclave(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_StartEnclave_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).StartEnclave(ctx, req.(*StartEnclaveArgs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EngineService_DestroyEnclave_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DestroyEnclaveArgs)
 	if err := dec(in); err != nil {
@@ -374,6 +426,24 @@ func (x *engineServiceGetServiceLogsServer) Send(m *GetServiceLogsResponse) erro
 	return x.ServerStream.SendMsg(m)
 }
 
+func _EngineService_GetLogsStorageUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EngineServiceServer).GetLogsStorageUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EngineService_GetLogsStorageUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EngineServiceServer).GetLogsStorageUsage(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EngineService_ServiceDesc is the grpc.ServiceDesc for EngineService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -401,6 +471,10 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "StopEnclave",
 			Handler:    _EngineService_StopEnclave_Handler,
 		},
+		{
+			MethodName: "StartEnclave",
+			Handler:    _EngineService_StartEnclave_Handler,
+		},
 		{
 			MethodName: "DestroyEnclave",
 			Handler:    _EngineService_DestroyEnclave_Handler,
@@ -409,6 +483,10 @@ var EngineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Clean",
 			Handler:    _EngineService_Clean_Handler,
 		},
+		{
+			MethodName: "GetLogsStorageUsage",
+			Handler:    _EngineService_GetLogsStorageUsage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -49,6 +49,9 @@ const (
 	// EngineServiceStopEnclaveProcedure is the fully-qualified name of the EngineService's StopEnclave
 	// RPC.
 	EngineServiceStopEnclaveProcedure = "/engine_api.EngineService/StopEnclave"
+	// EngineServiceStartEnclaveProcedure is the fully-qualified name of the EngineService's
+	// StartEnclave RPC.
+	EngineServiceStartEnclaveProcedure = "/engine_api.EngineService/StartEnclave"
 	// EngineServiceDestroyEnclaveProcedure is the fully-qualified name of the EngineService's
 	// DestroyEnclave RPC.
 	EngineServiceDestroyEnclaveProcedure = "/engine_api.EngineService/DestroyEnclave"
@@ -57,6 +60,9 @@ const (
 	// EngineServiceGetServiceLogsProcedure is the fully-qualified name of the EngineService's
 	// GetServiceLogs RPC.
 	EngineServiceGetServiceLogsProcedure = "/engine_api.EngineService/GetServiceLogs"
+	// EngineServiceGetLogsStorageUsageProcedure is the fully-qualified name of the EngineService's
+	// GetLogsStorageUsage RPC.
+	EngineServiceGetLogsStorageUsageProcedure = "/engine_api.EngineService/GetLogsStorageUsage"
 )
 
 // EngineServiceClient is a client for the engine_api.EngineService service.
@@ -76,12 +82,16 @@ type EngineServiceClient interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts a stopped enclave again, restoring its services to the state they had when it was stopped
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs]) (*connect.ServerStreamForClient[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse], error)
+	// Returns the disk space taken by the stored service logs
+	GetLogsStorageUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse], error)
 }
 
 // NewEngineServiceClient constructs a client for the engine_api.EngineService service. By default,
@@ -119,6 +129,11 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceStopEnclaveProcedure,
 			opts...,
 		),
+		startEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, emptypb.Empty](
+			httpClient,
+			baseURL+EngineServiceStartEnclaveProcedure,
+			opts...,
+		),
 		destroyEnclave: connect.NewClient[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty](
 			httpClient,
 			baseURL+EngineServiceDestroyEnclaveProcedure,
@@ -134,6 +149,11 @@ func NewEngineServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			baseURL+EngineServiceGetServiceLogsProcedure,
 			opts...,
 		),
+		getLogsStorageUsage: connect.NewClient[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse](
+			httpClient,
+			baseURL+EngineServiceGetLogsStorageUsageProcedure,
+			opts...,
+		),
 	}
 }
 
@@ -144,9 +164,11 @@ type engineServiceClient struct {
 	getEnclaves                                *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetEnclavesResponse]
 	getExistingAndHistoricalEnclaveIdentifiers *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse]
 	stopEnclave                                *connect.Client[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs, emptypb.Empty]
+	startEnclave                               *connect.Client[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs, emptypb.Empty]
 	destroyEnclave                             *connect.Client[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs, emptypb.Empty]
 	clean                                      *connect.Client[kurtosis_engine_rpc_api_bindings.CleanArgs, kurtosis_engine_rpc_api_bindings.CleanResponse]
 	getServiceLogs                             *connect.Client[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]
+	getLogsStorageUsage                        *connect.Client[emptypb.Empty, kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse]
 }
 
 // GetEngineInfo calls engine_api.EngineService.GetEngineInfo.
@@ -175,6 +197,11 @@ func (c *engineServiceClient) StopEnclave(ctx context.Context, req *connect.Requ
 	return c.stopEnclave.CallUnary(ctx, req)
 }
 
+// StartEnclave calls engine_api.EngineService.StartEnclave.
+func (c *engineServiceClient) StartEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	return c.startEnclave.CallUnary(ctx, req)
+}
+
 // DestroyEnclave calls engine_api.EngineService.DestroyEnclave.
 func (c *engineServiceClient) DestroyEnclave(ctx context.Context, req *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return c.destroyEnclave.CallUnary(ctx, req)
@@ -190,6 +217,11 @@ func (c *engineServiceClient) GetServiceLogs(ctx context.Context, req *connect.R
 	return c.getServiceLogs.CallServerStream(ctx, req)
 }
 
+// GetLogsStorageUsage calls engine_api.EngineService.GetLogsStorageUsage.
+func (c *engineServiceClient) GetLogsStorageUsage(ctx context.Context, req *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse], error) {
+	return c.getLogsStorageUsage.CallUnary(ctx, req)
+}
+
 // EngineServiceHandler is an implementation of the engine_api.EngineService service.
 type EngineServiceHandler interface {
 	// Endpoint for getting information about the engine, which is also what we use to verify that the engine has become available
@@ -207,12 +239,16 @@ type EngineServiceHandler interface {
 	GetExistingAndHistoricalEnclaveIdentifiers(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetExistingAndHistoricalEnclaveIdentifiersResponse], error)
 	// Stops all containers in an enclave
 	StopEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StopEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
+	// Starts a stopped enclave again, restoring its services to the state they had when it was stopped
+	StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Destroys an enclave, removing all artifacts associated with it
 	DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error)
 	// Gets rid of old enclaves
 	Clean(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.CleanArgs]) (*connect.Response[kurtosis_engine_rpc_api_bindings.CleanResponse], error)
 	// Get service logs
 	GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error
+	// Returns the disk space taken by the stored service logs
+	GetLogsStorageUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse], error)
 }
 
 // NewEngineServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -246,6 +282,11 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.StopEnclave,
 		opts...,
 	)
+	engineServiceStartEnclaveHandler := connect.NewUnaryHandler(
+		EngineServiceStartEnclaveProcedure,
+		svc.StartEnclave,
+		opts...,
+	)
 	engineServiceDestroyEnclaveHandler := connect.NewUnaryHandler(
 		EngineServiceDestroyEnclaveProcedure,
 		svc.DestroyEnclave,
@@ -261,6 +302,11 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 		svc.GetServiceLogs,
 		opts...,
 	)
+	engineServiceGetLogsStorageUsageHandler := connect.NewUnaryHandler(
+		EngineServiceGetLogsStorageUsageProcedure,
+		svc.GetLogsStorageUsage,
+		opts...,
+	)
 	return "/engine_api.EngineService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case EngineServiceGetEngineInfoProcedure:
@@ -273,12 +319,16 @@ func NewEngineServiceHandler(svc EngineServiceHandler, opts ...connect.HandlerOp
 			engineServiceGetExistingAndHistoricalEnclaveIdentifiersHandler.ServeHTTP(w, r)
 		case EngineServiceStopEnclaveProcedure:
 			engineServiceStopEnclaveHandler.ServeHTTP(w, r)
+		case EngineServiceStartEnclaveProcedure:
+			engineServiceStartEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceDestroyEnclaveProcedure:
 			engineServiceDestroyEnclaveHandler.ServeHTTP(w, r)
 		case EngineServiceCleanProcedure:
 			engineServiceCleanHandler.ServeHTTP(w, r)
 		case EngineServiceGetServiceLogsProcedure:
 			engineServiceGetServiceLogsHandler.ServeHTTP(w, r)
+		case EngineServiceGetLogsStorageUsageProcedure:
+			engineServiceGetLogsStorageUsageHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -308,6 +358,10 @@ func (UnimplementedEngineServiceHandler) StopEnclave(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StopEnclave is not implemented"))
 }
 
+func (UnimplementedEngineServiceHandler) StartEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.StartEnclave is not implemented"))
+}
+
 func (UnimplementedEngineServiceHandler) DestroyEnclave(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.DestroyEnclave is not implemented"))
 }
@@ -319,3 +373,7 @@ func (UnimplementedEngineServiceHandler) Clean(context.Context, *connect.Request
 func (UnimplementedEngineServiceHandler) GetServiceLogs(context.Context, *connect.Request[kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs], *connect.ServerStream[kurtosis_engine_rpc_api_bindings.GetServiceLogsResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetServiceLogs is not implemented"))
 }
+
+func (UnimplementedEngineServiceHandler) GetLogsStorageUsage(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("engine_api.EngineService.GetLogsStorageUsage is not implemented"))
+}
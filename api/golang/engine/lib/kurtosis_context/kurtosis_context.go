@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"github.com/Masterminds/semver/v3"
 	portal_constructors "github.com/kurtosis-tech/kurtosis-portal/api/golang/constructors"
@@ -21,6 +22,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -130,6 +132,35 @@ func (kurtosisCtx *KurtosisContext) CreateEnclave(
 	return enclaveContext, nil
 }
 
+// CreateEnclaveWithKubernetesSettings creates a test enclave with settings only available for Kubernetes, like the
+// labels of its namespace or the default node selectors of its services
+func (kurtosisCtx *KurtosisContext) CreateEnclaveWithKubernetesSettings(
+	ctx context.Context,
+	enclaveName string,
+	kubernetesSettings *kurtosis_engine_rpc_api_bindings.KubernetesEnclaveSettings,
+) (*enclaves.EnclaveContext, error) {
+
+	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
+		EnclaveName:            enclaveName,
+		ApiContainerVersionTag: defaultApiContainerVersionTag,
+		ApiContainerLogLevel:   apiContainerLogLevel.String(),
+		Mode:                   kurtosis_engine_rpc_api_bindings.EnclaveMode_TEST,
+		KubernetesSettings:     kubernetesSettings,
+	}
+
+	response, err := kurtosisCtx.engineClient.CreateEnclave(ctx, createEnclaveArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v' and Kubernetes settings '%+v'", enclaveName, kubernetesSettings)
+	}
+
+	enclaveContext, err := newEnclaveContextFromEnclaveInfo(ctx, kurtosisCtx.portalClient, response.EnclaveInfo)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating an enclave context from a newly-created enclave; this should never happen")
+	}
+
+	return enclaveContext, nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk#createenclaveenclaveid-enclaveid-boolean-issubnetworkingenabled---enclavecontextenclavecontext-enclavecontext
 func (kurtosisCtx *KurtosisContext) CreateProductionEnclave(
 	ctx context.Context,
@@ -244,6 +275,19 @@ func (kurtosisCtx *KurtosisContext) StopEnclave(ctx context.Context, enclaveIden
 	return nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk/#startenclavestring-enclaveidentifier
+func (kurtosisCtx *KurtosisContext) StartEnclave(ctx context.Context, enclaveIdentifier string) error {
+	startEnclaveArgs := &kurtosis_engine_rpc_api_bindings.StartEnclaveArgs{
+		EnclaveIdentifier: enclaveIdentifier,
+	}
+
+	if _, err := kurtosisCtx.engineClient.StartEnclave(ctx, startEnclaveArgs); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting enclave with identifier '%v'", enclaveIdentifier)
+	}
+
+	return nil
+}
+
 // Docs available at https://docs.kurtosis.com/sdk/#destroyenclavestring-enclaveidentifier
 func (kurtosisCtx *KurtosisContext) DestroyEnclave(ctx context.Context, enclaveIdentifier string) error {
 	destroyEnclaveArgs := &kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs{
@@ -270,13 +314,14 @@ func (kurtosisCtx *KurtosisContext) Clean(ctx context.Context, shouldCleanAll bo
 	return cleanResponse.RemovedEnclaveNameAndUuids, nil
 }
 
-// Docs available at https://docs.kurtosis.com/sdk#getservicelogsstring-enclaveidentifier-setserviceuuid-serviceuuids-boolean-shouldfollowlogs-loglinefilter-loglinefilter---servicelogsstreamcontent-servicelogsstreamcontent
+// Docs available at https://docs.kurtosis.com/sdk#getservicelogsstring-enclaveidentifier-setserviceuuid-serviceuuids-boolean-shouldfollowlogs-loglinefilter-loglinefilter-loglinerange-loglinerange---servicelogsstreamcontent-servicelogsstreamcontent
 func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	ctx context.Context,
 	enclaveIdentifier string,
 	userServiceUuids map[services.ServiceUUID]bool,
 	shouldFollowLogs bool,
 	logLineFilter *LogLineFilter,
+	logLineRange *LogLineRange,
 ) (
 	chan *serviceLogsStreamContent,
 	func(),
@@ -295,15 +340,16 @@ func (kurtosisCtx *KurtosisContext) GetServiceLogs(
 	//this process could take much time until the next channel pull, so we could be filling the buffer during that time to not let the servers thread idled
 	serviceLogsStreamContentChan := make(chan *serviceLogsStreamContent, serviceLogsStreamContentChanBufferSize)
 
-	getServiceLogsArgs, err := newGetServiceLogsArgs(enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter)
+	getServiceLogsArgs, err := newGetServiceLogsArgs(enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter, logLineRange)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(
 			err,
-			"An error occurred creating the service logs arguments with enclave identifier '%v', user service UUID '%+v', should follow logs value '%v' and with these conjunctive log line filters '%+v'",
+			"An error occurred creating the service logs arguments with enclave identifier '%v', user service UUID '%+v', should follow logs value '%v', with these conjunctive log line filters '%+v' and this log line range '%+v'",
 			enclaveIdentifier,
 			userServiceUuids,
 			shouldFollowLogs,
 			logLineFilter,
+			logLineRange,
 		)
 	}
 
@@ -335,6 +381,16 @@ func (kurtosisCtx *KurtosisContext) GetExistingAndHistoricalEnclaveIdentifiers(c
 	return newEnclaveIdentifiers(historicalEnclaveIdentifiers.AllIdentifiers), nil
 }
 
+// Docs available at https://docs.kurtosis.com/sdk#getlogsstorageusage---logsstorageusage-logsstorageusage
+func (kurtosisCtx *KurtosisContext) GetLogsStorageUsage(ctx context.Context) (*LogsStorageUsage, error) {
+	getLogsStorageUsageResponse, err := kurtosisCtx.engineClient.GetLogsStorageUsage(ctx, &emptypb.Empty{})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs storage usage")
+	}
+
+	return newLogsStorageUsage(getLogsStorageUsageResponse), nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -499,6 +555,7 @@ func newGetServiceLogsArgs(
 	userServiceUUIDs map[services.ServiceUUID]bool,
 	shouldFollowLogs bool,
 	logLineFilter *LogLineFilter,
+	logLineRange *LogLineRange,
 ) (*kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs, error) {
 	userServiceUuuidSet := make(map[string]bool, len(userServiceUUIDs))
 
@@ -517,6 +574,19 @@ func newGetServiceLogsArgs(
 		ServiceUuidSet:     userServiceUuuidSet,
 		FollowLogs:         shouldFollowLogs,
 		ConjunctiveFilters: grpcConjunctiveFilters,
+		Since:              nil,
+		Until:              nil,
+		NumLogLines:        0,
+	}
+
+	if logLineRange != nil {
+		if logLineRange.since != nil {
+			getUserServiceLogsArgs.Since = timestamppb.New(*logLineRange.since)
+		}
+		if logLineRange.until != nil {
+			getUserServiceLogsArgs.Until = timestamppb.New(*logLineRange.until)
+		}
+		getUserServiceLogsArgs.NumLogLines = logLineRange.numLogLines
 	}
 
 	return getUserServiceLogsArgs, nil
@@ -571,8 +641,14 @@ func newServiceLogsStreamContentFromGrpcStreamResponse(
 		serviceLogs := []*ServiceLog{}
 		serviceLogLine, found := receivedServiceLogsByServiceUuid[serviceUuidStr]
 		if found {
-			for _, logLineContent := range serviceLogLine.Line {
-				serviceLog := newServiceLog(logLineContent)
+			logLineTimestamps := serviceLogLine.GetTimestamp()
+			for logLineIndex, logLineContent := range serviceLogLine.GetLine() {
+				// engines that don't send timestamps leave the time of the log lines unknown
+				var logLineTimestamp time.Time
+				if logLineIndex < len(logLineTimestamps) && logLineTimestamps[logLineIndex] != nil {
+					logLineTimestamp = logLineTimestamps[logLineIndex].AsTime()
+				}
+				serviceLog := newServiceLog(logLineContent, logLineTimestamp)
 				serviceLogs = append(serviceLogs, serviceLog)
 			}
 		}
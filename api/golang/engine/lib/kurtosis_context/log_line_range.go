@@ -0,0 +1,21 @@
+package kurtosis_context
+
+import "time"
+
+// LogLineRange narrows down which of the stored log lines of the services are returned
+type LogLineRange struct {
+	// Only the log lines logged at or after this time are returned, nil means no lower bound
+	since *time.Time
+	// Only the log lines logged before this time are returned, nil means no upper bound
+	until *time.Time
+	// Only the last N log lines are returned, zero means all of them
+	numLogLines uint32
+}
+
+func NewLogLineRange(since *time.Time, until *time.Time, numLogLines uint32) *LogLineRange {
+	return &LogLineRange{since: since, until: until, numLogLines: numLogLines}
+}
+
+func NewLastLogLinesRange(numLogLines uint32) *LogLineRange {
+	return &LogLineRange{since: nil, until: nil, numLogLines: numLogLines}
+}
@@ -0,0 +1,32 @@
+package kurtosis_context
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+)
+
+// Docs available at https://docs.kurtosis.com/sdk#logsstorageusage
+type LogsStorageUsage struct {
+	totalSizeInBytes         uint64
+	sizeInBytesByEnclaveUuid map[enclaves.EnclaveUUID]uint64
+}
+
+func newLogsStorageUsage(response *kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse) *LogsStorageUsage {
+	sizeInBytesByEnclaveUuid := map[enclaves.EnclaveUUID]uint64{}
+	for enclaveUuidStr, sizeInBytes := range response.GetSizeInBytesByEnclaveUuid() {
+		sizeInBytesByEnclaveUuid[enclaves.EnclaveUUID(enclaveUuidStr)] = sizeInBytes
+	}
+	return &LogsStorageUsage{
+		totalSizeInBytes:         response.GetTotalSizeInBytes(),
+		sizeInBytesByEnclaveUuid: sizeInBytesByEnclaveUuid,
+	}
+}
+
+func (usage *LogsStorageUsage) GetTotalSizeInBytes() uint64 {
+	return usage.totalSizeInBytes
+}
+
+// GetSizeInBytesByEnclaveUuid also contains the enclaves that were destroyed but whose logs haven't been removed yet
+func (usage *LogsStorageUsage) GetSizeInBytesByEnclaveUuid() map[enclaves.EnclaveUUID]uint64 {
+	return usage.sizeInBytesByEnclaveUuid
+}
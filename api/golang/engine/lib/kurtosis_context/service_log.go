@@ -1,15 +1,22 @@
 package kurtosis_context
 
+import "time"
+
 // This is an object to represent a simple log line information
 type ServiceLog struct {
-	// lineTime time.Time //TODO add the time from loki logs result
 	content string
+	// The zero value means that the time the line was logged at is unknown
+	timestamp time.Time
 }
 
-func newServiceLog(content string) *ServiceLog {
-	return &ServiceLog{content: content}
+func newServiceLog(content string, timestamp time.Time) *ServiceLog {
+	return &ServiceLog{content: content, timestamp: timestamp}
 }
 
 func (serviceLog ServiceLog) GetContent() string {
 	return serviceLog.content
 }
+
+func (serviceLog ServiceLog) GetTimestamp() time.Time {
+	return serviceLog.timestamp
+}
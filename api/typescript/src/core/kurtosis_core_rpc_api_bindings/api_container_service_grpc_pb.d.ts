@@ -23,6 +23,19 @@ interface IApiContainerServiceService extends grpc.ServiceDefinition<grpc.Untype
   listFilesArtifactNamesAndUuids: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
   inspectFilesArtifactContents: grpc.MethodDefinition<api_container_service_pb.InspectFilesArtifactContentsRequest, api_container_service_pb.InspectFilesArtifactContentsResponse>;
   connectServices: grpc.MethodDefinition<api_container_service_pb.ConnectServicesArgs, api_container_service_pb.ConnectServicesResponse>;
+  getEnclaveSnapshot: grpc.MethodDefinition<api_container_service_pb.GetEnclaveSnapshotArgs, api_container_service_pb.StreamedDataChunk>;
+  restoreEnclaveSnapshot: grpc.MethodDefinition<api_container_service_pb.StreamedDataChunk, api_container_service_pb.RestoreEnclaveSnapshotResponse>;
+  repartition: grpc.MethodDefinition<api_container_service_pb.RepartitionArgs, google_protobuf_empty_pb.Empty>;
+  httpRequestService: grpc.MethodDefinition<api_container_service_pb.HttpRequestServiceArgs, api_container_service_pb.HttpRequestServiceResponse>;
+  runStarlarkPackageTests: grpc.MethodDefinition<api_container_service_pb.RunStarlarkPackageTestsArgs, api_container_service_pb.RunStarlarkPackageTestsResponse>;
+  getStarlarkRunPlan: grpc.MethodDefinition<api_container_service_pb.GetStarlarkRunPlanArgs, api_container_service_pb.GetStarlarkRunPlanResponse>;
+  getStarlarkRunDiff: grpc.MethodDefinition<api_container_service_pb.GetStarlarkRunDiffArgs, api_container_service_pb.GetStarlarkRunDiffResponse>;
+  resumeStarlarkRun: grpc.MethodDefinition<api_container_service_pb.ResumeStarlarkRunArgs, api_container_service_pb.StarlarkRunResponseLine>;
+  cancelStarlarkRun: grpc.MethodDefinition<api_container_service_pb.CancelStarlarkRunArgs, google_protobuf_empty_pb.Empty>;
+  listPersistentDirectories: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, api_container_service_pb.ListPersistentDirectoriesResponse>;
+  removePersistentDirectories: grpc.MethodDefinition<api_container_service_pb.RemovePersistentDirectoriesArgs, api_container_service_pb.RemovePersistentDirectoriesResponse>;
+  downloadPersistentDirectory: grpc.MethodDefinition<api_container_service_pb.DownloadPersistentDirectoryArgs, api_container_service_pb.StreamedDataChunk>;
+  storeFilesArtifactFromPersistentDirectory: grpc.MethodDefinition<api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs, api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse>;
 }
 
 export const ApiContainerServiceService: IApiContainerServiceService;
@@ -43,6 +56,19 @@ export interface IApiContainerServiceServer extends grpc.UntypedServiceImplement
   listFilesArtifactNamesAndUuids: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse>;
   inspectFilesArtifactContents: grpc.handleUnaryCall<api_container_service_pb.InspectFilesArtifactContentsRequest, api_container_service_pb.InspectFilesArtifactContentsResponse>;
   connectServices: grpc.handleUnaryCall<api_container_service_pb.ConnectServicesArgs, api_container_service_pb.ConnectServicesResponse>;
+  getEnclaveSnapshot: grpc.handleServerStreamingCall<api_container_service_pb.GetEnclaveSnapshotArgs, api_container_service_pb.StreamedDataChunk>;
+  restoreEnclaveSnapshot: grpc.handleClientStreamingCall<api_container_service_pb.StreamedDataChunk, api_container_service_pb.RestoreEnclaveSnapshotResponse>;
+  repartition: grpc.handleUnaryCall<api_container_service_pb.RepartitionArgs, google_protobuf_empty_pb.Empty>;
+  httpRequestService: grpc.handleUnaryCall<api_container_service_pb.HttpRequestServiceArgs, api_container_service_pb.HttpRequestServiceResponse>;
+  runStarlarkPackageTests: grpc.handleUnaryCall<api_container_service_pb.RunStarlarkPackageTestsArgs, api_container_service_pb.RunStarlarkPackageTestsResponse>;
+  getStarlarkRunPlan: grpc.handleUnaryCall<api_container_service_pb.GetStarlarkRunPlanArgs, api_container_service_pb.GetStarlarkRunPlanResponse>;
+  getStarlarkRunDiff: grpc.handleUnaryCall<api_container_service_pb.GetStarlarkRunDiffArgs, api_container_service_pb.GetStarlarkRunDiffResponse>;
+  resumeStarlarkRun: grpc.handleServerStreamingCall<api_container_service_pb.ResumeStarlarkRunArgs, api_container_service_pb.StarlarkRunResponseLine>;
+  cancelStarlarkRun: grpc.handleUnaryCall<api_container_service_pb.CancelStarlarkRunArgs, google_protobuf_empty_pb.Empty>;
+  listPersistentDirectories: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, api_container_service_pb.ListPersistentDirectoriesResponse>;
+  removePersistentDirectories: grpc.handleUnaryCall<api_container_service_pb.RemovePersistentDirectoriesArgs, api_container_service_pb.RemovePersistentDirectoriesResponse>;
+  downloadPersistentDirectory: grpc.handleServerStreamingCall<api_container_service_pb.DownloadPersistentDirectoryArgs, api_container_service_pb.StreamedDataChunk>;
+  storeFilesArtifactFromPersistentDirectory: grpc.handleUnaryCall<api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs, api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse>;
 }
 
 export class ApiContainerServiceClient extends grpc.Client {
@@ -89,4 +115,40 @@ export class ApiContainerServiceClient extends grpc.Client {
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
   connectServices(argument: api_container_service_pb.ConnectServicesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ConnectServicesResponse>): grpc.ClientUnaryCall;
+  getEnclaveSnapshot(argument: api_container_service_pb.GetEnclaveSnapshotArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+  getEnclaveSnapshot(argument: api_container_service_pb.GetEnclaveSnapshotArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+  restoreEnclaveSnapshot(callback: grpc.requestCallback<api_container_service_pb.RestoreEnclaveSnapshotResponse>): grpc.ClientWritableStream<api_container_service_pb.StreamedDataChunk>;
+  restoreEnclaveSnapshot(metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RestoreEnclaveSnapshotResponse>): grpc.ClientWritableStream<api_container_service_pb.StreamedDataChunk>;
+  restoreEnclaveSnapshot(metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RestoreEnclaveSnapshotResponse>): grpc.ClientWritableStream<api_container_service_pb.StreamedDataChunk>;
+  repartition(argument: api_container_service_pb.RepartitionArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  repartition(argument: api_container_service_pb.RepartitionArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  repartition(argument: api_container_service_pb.RepartitionArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  httpRequestService(argument: api_container_service_pb.HttpRequestServiceArgs, callback: grpc.requestCallback<api_container_service_pb.HttpRequestServiceResponse>): grpc.ClientUnaryCall;
+  httpRequestService(argument: api_container_service_pb.HttpRequestServiceArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.HttpRequestServiceResponse>): grpc.ClientUnaryCall;
+  httpRequestService(argument: api_container_service_pb.HttpRequestServiceArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.HttpRequestServiceResponse>): grpc.ClientUnaryCall;
+  runStarlarkPackageTests(argument: api_container_service_pb.RunStarlarkPackageTestsArgs, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageTestsResponse>): grpc.ClientUnaryCall;
+  runStarlarkPackageTests(argument: api_container_service_pb.RunStarlarkPackageTestsArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageTestsResponse>): grpc.ClientUnaryCall;
+  runStarlarkPackageTests(argument: api_container_service_pb.RunStarlarkPackageTestsArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RunStarlarkPackageTestsResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunPlan(argument: api_container_service_pb.GetStarlarkRunPlanArgs, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunPlanResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunPlan(argument: api_container_service_pb.GetStarlarkRunPlanArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunPlanResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunPlan(argument: api_container_service_pb.GetStarlarkRunPlanArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunPlanResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunDiff(argument: api_container_service_pb.GetStarlarkRunDiffArgs, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunDiffResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunDiff(argument: api_container_service_pb.GetStarlarkRunDiffArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunDiffResponse>): grpc.ClientUnaryCall;
+  getStarlarkRunDiff(argument: api_container_service_pb.GetStarlarkRunDiffArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.GetStarlarkRunDiffResponse>): grpc.ClientUnaryCall;
+  resumeStarlarkRun(argument: api_container_service_pb.ResumeStarlarkRunArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+  resumeStarlarkRun(argument: api_container_service_pb.ResumeStarlarkRunArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+  cancelStarlarkRun(argument: api_container_service_pb.CancelStarlarkRunArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  cancelStarlarkRun(argument: api_container_service_pb.CancelStarlarkRunArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  cancelStarlarkRun(argument: api_container_service_pb.CancelStarlarkRunArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  listPersistentDirectories(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<api_container_service_pb.ListPersistentDirectoriesResponse>): grpc.ClientUnaryCall;
+  listPersistentDirectories(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListPersistentDirectoriesResponse>): grpc.ClientUnaryCall;
+  listPersistentDirectories(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.ListPersistentDirectoriesResponse>): grpc.ClientUnaryCall;
+  removePersistentDirectories(argument: api_container_service_pb.RemovePersistentDirectoriesArgs, callback: grpc.requestCallback<api_container_service_pb.RemovePersistentDirectoriesResponse>): grpc.ClientUnaryCall;
+  removePersistentDirectories(argument: api_container_service_pb.RemovePersistentDirectoriesArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RemovePersistentDirectoriesResponse>): grpc.ClientUnaryCall;
+  removePersistentDirectories(argument: api_container_service_pb.RemovePersistentDirectoriesArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.RemovePersistentDirectoriesResponse>): grpc.ClientUnaryCall;
+  downloadPersistentDirectory(argument: api_container_service_pb.DownloadPersistentDirectoryArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+  downloadPersistentDirectory(argument: api_container_service_pb.DownloadPersistentDirectoryArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+  storeFilesArtifactFromPersistentDirectory(argument: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs, callback: grpc.requestCallback<api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse>): grpc.ClientUnaryCall;
+  storeFilesArtifactFromPersistentDirectory(argument: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse>): grpc.ClientUnaryCall;
+  storeFilesArtifactFromPersistentDirectory(argument: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse>): grpc.ClientUnaryCall;
 }
@@ -5,6 +5,17 @@ var grpc = require('@grpc/grpc-js');
 var api_container_service_pb = require('./api_container_service_pb.js');
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 
+function serialize_api_container_api_CancelStarlarkRunArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.CancelStarlarkRunArgs)) {
+    throw new Error('Expected argument of type api_container_api.CancelStarlarkRunArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_CancelStarlarkRunArgs(buffer_arg) {
+  return api_container_service_pb.CancelStarlarkRunArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_ConnectServicesArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ConnectServicesArgs)) {
     throw new Error('Expected argument of type api_container_api.ConnectServicesArgs');
@@ -38,6 +49,17 @@ function deserialize_api_container_api_DownloadFilesArtifactArgs(buffer_arg) {
   return api_container_service_pb.DownloadFilesArtifactArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_DownloadPersistentDirectoryArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.DownloadPersistentDirectoryArgs)) {
+    throw new Error('Expected argument of type api_container_api.DownloadPersistentDirectoryArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_DownloadPersistentDirectoryArgs(buffer_arg) {
+  return api_container_service_pb.DownloadPersistentDirectoryArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_ExecCommandArgs(arg) {
   if (!(arg instanceof api_container_service_pb.ExecCommandArgs)) {
     throw new Error('Expected argument of type api_container_api.ExecCommandArgs');
@@ -60,6 +82,17 @@ function deserialize_api_container_api_ExecCommandResponse(buffer_arg) {
   return api_container_service_pb.ExecCommandResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetEnclaveSnapshotArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetEnclaveSnapshotArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetEnclaveSnapshotArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetEnclaveSnapshotArgs(buffer_arg) {
+  return api_container_service_pb.GetEnclaveSnapshotArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_GetExistingAndHistoricalServiceIdentifiersResponse(arg) {
   if (!(arg instanceof api_container_service_pb.GetExistingAndHistoricalServiceIdentifiersResponse)) {
     throw new Error('Expected argument of type api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse');
@@ -93,6 +126,72 @@ function deserialize_api_container_api_GetServicesResponse(buffer_arg) {
   return api_container_service_pb.GetServicesResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_GetStarlarkRunDiffArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetStarlarkRunDiffArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetStarlarkRunDiffArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetStarlarkRunDiffArgs(buffer_arg) {
+  return api_container_service_pb.GetStarlarkRunDiffArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetStarlarkRunDiffResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetStarlarkRunDiffResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetStarlarkRunDiffResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetStarlarkRunDiffResponse(buffer_arg) {
+  return api_container_service_pb.GetStarlarkRunDiffResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetStarlarkRunPlanArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.GetStarlarkRunPlanArgs)) {
+    throw new Error('Expected argument of type api_container_api.GetStarlarkRunPlanArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetStarlarkRunPlanArgs(buffer_arg) {
+  return api_container_service_pb.GetStarlarkRunPlanArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_GetStarlarkRunPlanResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.GetStarlarkRunPlanResponse)) {
+    throw new Error('Expected argument of type api_container_api.GetStarlarkRunPlanResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_GetStarlarkRunPlanResponse(buffer_arg) {
+  return api_container_service_pb.GetStarlarkRunPlanResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_HttpRequestServiceArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.HttpRequestServiceArgs)) {
+    throw new Error('Expected argument of type api_container_api.HttpRequestServiceArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_HttpRequestServiceArgs(buffer_arg) {
+  return api_container_service_pb.HttpRequestServiceArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_HttpRequestServiceResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.HttpRequestServiceResponse)) {
+    throw new Error('Expected argument of type api_container_api.HttpRequestServiceResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_HttpRequestServiceResponse(buffer_arg) {
+  return api_container_service_pb.HttpRequestServiceResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_InspectFilesArtifactContentsRequest(arg) {
   if (!(arg instanceof api_container_service_pb.InspectFilesArtifactContentsRequest)) {
     throw new Error('Expected argument of type api_container_api.InspectFilesArtifactContentsRequest');
@@ -126,6 +225,72 @@ function deserialize_api_container_api_ListFilesArtifactNamesAndUuidsResponse(bu
   return api_container_service_pb.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_ListPersistentDirectoriesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.ListPersistentDirectoriesResponse)) {
+    throw new Error('Expected argument of type api_container_api.ListPersistentDirectoriesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ListPersistentDirectoriesResponse(buffer_arg) {
+  return api_container_service_pb.ListPersistentDirectoriesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RemovePersistentDirectoriesArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RemovePersistentDirectoriesArgs)) {
+    throw new Error('Expected argument of type api_container_api.RemovePersistentDirectoriesArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RemovePersistentDirectoriesArgs(buffer_arg) {
+  return api_container_service_pb.RemovePersistentDirectoriesArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RemovePersistentDirectoriesResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.RemovePersistentDirectoriesResponse)) {
+    throw new Error('Expected argument of type api_container_api.RemovePersistentDirectoriesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RemovePersistentDirectoriesResponse(buffer_arg) {
+  return api_container_service_pb.RemovePersistentDirectoriesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RepartitionArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RepartitionArgs)) {
+    throw new Error('Expected argument of type api_container_api.RepartitionArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RepartitionArgs(buffer_arg) {
+  return api_container_service_pb.RepartitionArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RestoreEnclaveSnapshotResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.RestoreEnclaveSnapshotResponse)) {
+    throw new Error('Expected argument of type api_container_api.RestoreEnclaveSnapshotResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RestoreEnclaveSnapshotResponse(buffer_arg) {
+  return api_container_service_pb.RestoreEnclaveSnapshotResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_ResumeStarlarkRunArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.ResumeStarlarkRunArgs)) {
+    throw new Error('Expected argument of type api_container_api.ResumeStarlarkRunArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_ResumeStarlarkRunArgs(buffer_arg) {
+  return api_container_service_pb.ResumeStarlarkRunArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkPackageArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkPackageArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkPackageArgs');
@@ -137,6 +302,28 @@ function deserialize_api_container_api_RunStarlarkPackageArgs(buffer_arg) {
   return api_container_service_pb.RunStarlarkPackageArgs.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_RunStarlarkPackageTestsArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.RunStarlarkPackageTestsArgs)) {
+    throw new Error('Expected argument of type api_container_api.RunStarlarkPackageTestsArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RunStarlarkPackageTestsArgs(buffer_arg) {
+  return api_container_service_pb.RunStarlarkPackageTestsArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_RunStarlarkPackageTestsResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.RunStarlarkPackageTestsResponse)) {
+    throw new Error('Expected argument of type api_container_api.RunStarlarkPackageTestsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_RunStarlarkPackageTestsResponse(buffer_arg) {
+  return api_container_service_pb.RunStarlarkPackageTestsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_RunStarlarkScriptArgs(arg) {
   if (!(arg instanceof api_container_service_pb.RunStarlarkScriptArgs)) {
     throw new Error('Expected argument of type api_container_api.RunStarlarkScriptArgs');
@@ -159,6 +346,28 @@ function deserialize_api_container_api_StarlarkRunResponseLine(buffer_arg) {
   return api_container_service_pb.StarlarkRunResponseLine.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryArgs(arg) {
+  if (!(arg instanceof api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs)) {
+    throw new Error('Expected argument of type api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryArgs(buffer_arg) {
+  return api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryResponse(arg) {
+  if (!(arg instanceof api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse)) {
+    throw new Error('Expected argument of type api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryResponse(buffer_arg) {
+  return api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_api_container_api_StoreFilesArtifactFromServiceArgs(arg) {
   if (!(arg instanceof api_container_service_pb.StoreFilesArtifactFromServiceArgs)) {
     throw new Error('Expected argument of type api_container_api.StoreFilesArtifactFromServiceArgs');
@@ -438,6 +647,169 @@ connectServices: {
     responseSerialize: serialize_api_container_api_ConnectServicesResponse,
     responseDeserialize: deserialize_api_container_api_ConnectServicesResponse,
   },
+  // Streams back an archive containing the enclave plan, the enclave database, the files artifacts and optionally
+// the persistent directories of the enclave, which can later be used to restore an equivalent enclave
+getEnclaveSnapshot: {
+    path: '/api_container_api.ApiContainerService/GetEnclaveSnapshot',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.GetEnclaveSnapshotArgs,
+    responseType: api_container_service_pb.StreamedDataChunk,
+    requestSerialize: serialize_api_container_api_GetEnclaveSnapshotArgs,
+    requestDeserialize: deserialize_api_container_api_GetEnclaveSnapshotArgs,
+    responseSerialize: serialize_api_container_api_StreamedDataChunk,
+    responseDeserialize: deserialize_api_container_api_StreamedDataChunk,
+  },
+  // Restores the content of an enclave snapshot into this (empty) enclave. The returned script needs to be run
+// to recreate the services of the snapshot
+restoreEnclaveSnapshot: {
+    path: '/api_container_api.ApiContainerService/RestoreEnclaveSnapshot',
+    requestStream: true,
+    responseStream: false,
+    requestType: api_container_service_pb.StreamedDataChunk,
+    responseType: api_container_service_pb.RestoreEnclaveSnapshotResponse,
+    requestSerialize: serialize_api_container_api_StreamedDataChunk,
+    requestDeserialize: deserialize_api_container_api_StreamedDataChunk,
+    responseSerialize: serialize_api_container_api_RestoreEnclaveSnapshotResponse,
+    responseDeserialize: deserialize_api_container_api_RestoreEnclaveSnapshotResponse,
+  },
+  // Splits the services of the enclave into partitions and conditions the traffic between those partitions
+repartition: {
+    path: '/api_container_api.ApiContainerService/Repartition',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RepartitionArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_RepartitionArgs,
+    requestDeserialize: deserialize_api_container_api_RepartitionArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Sends an HTTP request to a port of a service from inside the enclave, and returns the response
+httpRequestService: {
+    path: '/api_container_api.ApiContainerService/HttpRequestService',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.HttpRequestServiceArgs,
+    responseType: api_container_service_pb.HttpRequestServiceResponse,
+    requestSerialize: serialize_api_container_api_HttpRequestServiceArgs,
+    requestDeserialize: deserialize_api_container_api_HttpRequestServiceArgs,
+    responseSerialize: serialize_api_container_api_HttpRequestServiceResponse,
+    responseDeserialize: deserialize_api_container_api_HttpRequestServiceResponse,
+  },
+  // Runs the test functions of the *_test.star files of a package uploaded beforehand against a fake service network,
+// without starting any container
+runStarlarkPackageTests: {
+    path: '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RunStarlarkPackageTestsArgs,
+    responseType: api_container_service_pb.RunStarlarkPackageTestsResponse,
+    requestSerialize: serialize_api_container_api_RunStarlarkPackageTestsArgs,
+    requestDeserialize: deserialize_api_container_api_RunStarlarkPackageTestsArgs,
+    responseSerialize: serialize_api_container_api_RunStarlarkPackageTestsResponse,
+    responseDeserialize: deserialize_api_container_api_RunStarlarkPackageTestsResponse,
+  },
+  // Interprets a Starlark script or package without executing it, and returns the plan describing
+// what running it against an empty enclave would do
+getStarlarkRunPlan: {
+    path: '/api_container_api.ApiContainerService/GetStarlarkRunPlan',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetStarlarkRunPlanArgs,
+    responseType: api_container_service_pb.GetStarlarkRunPlanResponse,
+    requestSerialize: serialize_api_container_api_GetStarlarkRunPlanArgs,
+    requestDeserialize: deserialize_api_container_api_GetStarlarkRunPlanArgs,
+    responseSerialize: serialize_api_container_api_GetStarlarkRunPlanResponse,
+    responseDeserialize: deserialize_api_container_api_GetStarlarkRunPlanResponse,
+  },
+  // Interprets a Starlark script or package without executing it, and returns the changes running it would make to
+// the services of the enclave given the instructions run in the enclave before
+getStarlarkRunDiff: {
+    path: '/api_container_api.ApiContainerService/GetStarlarkRunDiff',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.GetStarlarkRunDiffArgs,
+    responseType: api_container_service_pb.GetStarlarkRunDiffResponse,
+    requestSerialize: serialize_api_container_api_GetStarlarkRunDiffArgs,
+    requestDeserialize: deserialize_api_container_api_GetStarlarkRunDiffArgs,
+    responseSerialize: serialize_api_container_api_GetStarlarkRunDiffResponse,
+    responseDeserialize: deserialize_api_container_api_GetStarlarkRunDiffResponse,
+  },
+  // Streams the response lines of the current Starlark run (or the last one if none is running) starting at the given
+// line index, and keeps streaming until the run finishes. Lets clients reconnect to a run after a disconnect
+resumeStarlarkRun: {
+    path: '/api_container_api.ApiContainerService/ResumeStarlarkRun',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.ResumeStarlarkRunArgs,
+    responseType: api_container_service_pb.StarlarkRunResponseLine,
+    requestSerialize: serialize_api_container_api_ResumeStarlarkRunArgs,
+    requestDeserialize: deserialize_api_container_api_ResumeStarlarkRunArgs,
+    responseSerialize: serialize_api_container_api_StarlarkRunResponseLine,
+    responseDeserialize: deserialize_api_container_api_StarlarkRunResponseLine,
+  },
+  // Cancels the given Starlark run if it's still running. Runs aren't tied to the stream they were started with, so
+// this is the way for clients to stop a run
+cancelStarlarkRun: {
+    path: '/api_container_api.ApiContainerService/CancelStarlarkRun',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.CancelStarlarkRunArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_api_container_api_CancelStarlarkRunArgs,
+    requestDeserialize: deserialize_api_container_api_CancelStarlarkRunArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
+  // Lists the persistent directories of the enclave, including the ones of services that were removed since
+listPersistentDirectories: {
+    path: '/api_container_api.ApiContainerService/ListPersistentDirectories',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: api_container_service_pb.ListPersistentDirectoriesResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_api_container_api_ListPersistentDirectoriesResponse,
+    responseDeserialize: deserialize_api_container_api_ListPersistentDirectoriesResponse,
+  },
+  // Removes persistent directories of a service, along with their content
+removePersistentDirectories: {
+    path: '/api_container_api.ApiContainerService/RemovePersistentDirectories',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.RemovePersistentDirectoriesArgs,
+    responseType: api_container_service_pb.RemovePersistentDirectoriesResponse,
+    requestSerialize: serialize_api_container_api_RemovePersistentDirectoriesArgs,
+    requestDeserialize: deserialize_api_container_api_RemovePersistentDirectoriesArgs,
+    responseSerialize: serialize_api_container_api_RemovePersistentDirectoriesResponse,
+    responseDeserialize: deserialize_api_container_api_RemovePersistentDirectoriesResponse,
+  },
+  // Downloads the content of a persistent directory as a TAR
+downloadPersistentDirectory: {
+    path: '/api_container_api.ApiContainerService/DownloadPersistentDirectory',
+    requestStream: false,
+    responseStream: true,
+    requestType: api_container_service_pb.DownloadPersistentDirectoryArgs,
+    responseType: api_container_service_pb.StreamedDataChunk,
+    requestSerialize: serialize_api_container_api_DownloadPersistentDirectoryArgs,
+    requestDeserialize: deserialize_api_container_api_DownloadPersistentDirectoryArgs,
+    responseSerialize: serialize_api_container_api_StreamedDataChunk,
+    responseDeserialize: deserialize_api_container_api_StreamedDataChunk,
+  },
+  // Stores the content of a persistent directory in a new files artifact, e.g. to seed other persistent directories
+storeFilesArtifactFromPersistentDirectory: {
+    path: '/api_container_api.ApiContainerService/StoreFilesArtifactFromPersistentDirectory',
+    requestStream: false,
+    responseStream: false,
+    requestType: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs,
+    responseType: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse,
+    requestSerialize: serialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryArgs,
+    requestDeserialize: deserialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryArgs,
+    responseSerialize: serialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryResponse,
+    responseDeserialize: deserialize_api_container_api_StoreFilesArtifactFromPersistentDirectoryResponse,
+  },
 };
 
 exports.ApiContainerServiceClient = grpc.makeGenericClientConstructor(ApiContainerServiceService);
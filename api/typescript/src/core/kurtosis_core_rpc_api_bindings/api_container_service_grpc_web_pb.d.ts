@@ -94,6 +94,84 @@ export class ApiContainerServiceClient {
                response: api_container_service_pb.ConnectServicesResponse) => void
   ): grpcWeb.ClientReadableStream<api_container_service_pb.ConnectServicesResponse>;
 
+  getEnclaveSnapshot(
+    request: api_container_service_pb.GetEnclaveSnapshotArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
+  repartition(
+    request: api_container_service_pb.RepartitionArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  httpRequestService(
+    request: api_container_service_pb.HttpRequestServiceArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.HttpRequestServiceResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.HttpRequestServiceResponse>;
+
+  runStarlarkPackageTests(
+    request: api_container_service_pb.RunStarlarkPackageTestsArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.RunStarlarkPackageTestsResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.RunStarlarkPackageTestsResponse>;
+
+  getStarlarkRunPlan(
+    request: api_container_service_pb.GetStarlarkRunPlanArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetStarlarkRunPlanResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetStarlarkRunPlanResponse>;
+
+  getStarlarkRunDiff(
+    request: api_container_service_pb.GetStarlarkRunDiffArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.GetStarlarkRunDiffResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.GetStarlarkRunDiffResponse>;
+
+  resumeStarlarkRun(
+    request: api_container_service_pb.ResumeStarlarkRunArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+
+  cancelStarlarkRun(
+    request: api_container_service_pb.CancelStarlarkRunArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
+  listPersistentDirectories(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.ListPersistentDirectoriesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.ListPersistentDirectoriesResponse>;
+
+  removePersistentDirectories(
+    request: api_container_service_pb.RemovePersistentDirectoriesArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.RemovePersistentDirectoriesResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.RemovePersistentDirectoriesResponse>;
+
+  downloadPersistentDirectory(
+    request: api_container_service_pb.DownloadPersistentDirectoryArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
+  storeFilesArtifactFromPersistentDirectory(
+    request: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse) => void
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse>;
+
 }
 
 export class ApiContainerServicePromiseClient {
@@ -166,5 +244,65 @@ export class ApiContainerServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<api_container_service_pb.ConnectServicesResponse>;
 
+  getEnclaveSnapshot(
+    request: api_container_service_pb.GetEnclaveSnapshotArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
+  repartition(
+    request: api_container_service_pb.RepartitionArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  httpRequestService(
+    request: api_container_service_pb.HttpRequestServiceArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.HttpRequestServiceResponse>;
+
+  runStarlarkPackageTests(
+    request: api_container_service_pb.RunStarlarkPackageTestsArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.RunStarlarkPackageTestsResponse>;
+
+  getStarlarkRunPlan(
+    request: api_container_service_pb.GetStarlarkRunPlanArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetStarlarkRunPlanResponse>;
+
+  getStarlarkRunDiff(
+    request: api_container_service_pb.GetStarlarkRunDiffArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.GetStarlarkRunDiffResponse>;
+
+  resumeStarlarkRun(
+    request: api_container_service_pb.ResumeStarlarkRunArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StarlarkRunResponseLine>;
+
+  cancelStarlarkRun(
+    request: api_container_service_pb.CancelStarlarkRunArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
+  listPersistentDirectories(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.ListPersistentDirectoriesResponse>;
+
+  removePersistentDirectories(
+    request: api_container_service_pb.RemovePersistentDirectoriesArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.RemovePersistentDirectoriesResponse>;
+
+  downloadPersistentDirectory(
+    request: api_container_service_pb.DownloadPersistentDirectoryArgs,
+    metadata?: grpcWeb.Metadata
+  ): grpcWeb.ClientReadableStream<api_container_service_pb.StreamedDataChunk>;
+
+  storeFilesArtifactFromPersistentDirectory(
+    request: api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<api_container_service_pb.StoreFilesArtifactFromPersistentDirectoryResponse>;
+
 }
 
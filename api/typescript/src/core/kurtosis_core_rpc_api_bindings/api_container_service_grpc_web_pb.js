@@ -854,5 +854,722 @@ proto.api_container_api.ApiContainerServicePromiseClient.prototype.connectServic
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetEnclaveSnapshotArgs,
+ *   !proto.api_container_api.StreamedDataChunk>}
+ */
+const methodDescriptor_ApiContainerService_GetEnclaveSnapshot = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetEnclaveSnapshot',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.GetEnclaveSnapshotArgs,
+  proto.api_container_api.StreamedDataChunk,
+  /**
+   * @param {!proto.api_container_api.GetEnclaveSnapshotArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StreamedDataChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetEnclaveSnapshotArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getEnclaveSnapshot =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveSnapshot',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveSnapshot);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetEnclaveSnapshotArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getEnclaveSnapshot =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetEnclaveSnapshot',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetEnclaveSnapshot);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RepartitionArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_Repartition = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/Repartition',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RepartitionArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.RepartitionArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RepartitionArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.repartition =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/Repartition',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_Repartition,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RepartitionArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.repartition =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/Repartition',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_Repartition);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.HttpRequestServiceArgs,
+ *   !proto.api_container_api.HttpRequestServiceResponse>}
+ */
+const methodDescriptor_ApiContainerService_HttpRequestService = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/HttpRequestService',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.HttpRequestServiceArgs,
+  proto.api_container_api.HttpRequestServiceResponse,
+  /**
+   * @param {!proto.api_container_api.HttpRequestServiceArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.HttpRequestServiceResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.HttpRequestServiceResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.HttpRequestServiceResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.httpRequestService =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/HttpRequestService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_HttpRequestService,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.HttpRequestServiceResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.httpRequestService =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/HttpRequestService',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_HttpRequestService);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RunStarlarkPackageTestsArgs,
+ *   !proto.api_container_api.RunStarlarkPackageTestsResponse>}
+ */
+const methodDescriptor_ApiContainerService_RunStarlarkPackageTests = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RunStarlarkPackageTestsArgs,
+  proto.api_container_api.RunStarlarkPackageTestsResponse,
+  /**
+   * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RunStarlarkPackageTestsResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RunStarlarkPackageTestsResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.runStarlarkPackageTests =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RunStarlarkPackageTests,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.RunStarlarkPackageTestsResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.runStarlarkPackageTests =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RunStarlarkPackageTests',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RunStarlarkPackageTests);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetStarlarkRunPlanArgs,
+ *   !proto.api_container_api.GetStarlarkRunPlanResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetStarlarkRunPlan = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetStarlarkRunPlan',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetStarlarkRunPlanArgs,
+  proto.api_container_api.GetStarlarkRunPlanResponse,
+  /**
+   * @param {!proto.api_container_api.GetStarlarkRunPlanArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetStarlarkRunPlanResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetStarlarkRunPlanArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetStarlarkRunPlanResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetStarlarkRunPlanResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getStarlarkRunPlan =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunPlan',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunPlan,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetStarlarkRunPlanArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetStarlarkRunPlanResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkRunPlan =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunPlan',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunPlan);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.GetStarlarkRunDiffArgs,
+ *   !proto.api_container_api.GetStarlarkRunDiffResponse>}
+ */
+const methodDescriptor_ApiContainerService_GetStarlarkRunDiff = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/GetStarlarkRunDiff',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.GetStarlarkRunDiffArgs,
+  proto.api_container_api.GetStarlarkRunDiffResponse,
+  /**
+   * @param {!proto.api_container_api.GetStarlarkRunDiffArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.GetStarlarkRunDiffResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.GetStarlarkRunDiffArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.GetStarlarkRunDiffResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.GetStarlarkRunDiffResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.getStarlarkRunDiff =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunDiff',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunDiff,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GetStarlarkRunDiffArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.GetStarlarkRunDiffResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.getStarlarkRunDiff =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/GetStarlarkRunDiff',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_GetStarlarkRunDiff);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.ResumeStarlarkRunArgs,
+ *   !proto.api_container_api.StarlarkRunResponseLine>}
+ */
+const methodDescriptor_ApiContainerService_ResumeStarlarkRun = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ResumeStarlarkRun',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.ResumeStarlarkRunArgs,
+  proto.api_container_api.StarlarkRunResponseLine,
+  /**
+   * @param {!proto.api_container_api.ResumeStarlarkRunArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StarlarkRunResponseLine.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.ResumeStarlarkRunArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StarlarkRunResponseLine>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.resumeStarlarkRun =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/ResumeStarlarkRun',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ResumeStarlarkRun);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ResumeStarlarkRunArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StarlarkRunResponseLine>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.resumeStarlarkRun =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/ResumeStarlarkRun',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ResumeStarlarkRun);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.CancelStarlarkRunArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_ApiContainerService_CancelStarlarkRun = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/CancelStarlarkRun',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.CancelStarlarkRunArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.api_container_api.CancelStarlarkRunArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.CancelStarlarkRunArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.cancelStarlarkRun =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CancelStarlarkRun',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CancelStarlarkRun,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.CancelStarlarkRunArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.cancelStarlarkRun =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/CancelStarlarkRun',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_CancelStarlarkRun);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.api_container_api.ListPersistentDirectoriesResponse>}
+ */
+const methodDescriptor_ApiContainerService_ListPersistentDirectories = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/ListPersistentDirectories',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.api_container_api.ListPersistentDirectoriesResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.ListPersistentDirectoriesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.ListPersistentDirectoriesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.ListPersistentDirectoriesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.listPersistentDirectories =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListPersistentDirectories',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListPersistentDirectories,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.ListPersistentDirectoriesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.listPersistentDirectories =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/ListPersistentDirectories',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_ListPersistentDirectories);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.RemovePersistentDirectoriesArgs,
+ *   !proto.api_container_api.RemovePersistentDirectoriesResponse>}
+ */
+const methodDescriptor_ApiContainerService_RemovePersistentDirectories = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/RemovePersistentDirectories',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.RemovePersistentDirectoriesArgs,
+  proto.api_container_api.RemovePersistentDirectoriesResponse,
+  /**
+   * @param {!proto.api_container_api.RemovePersistentDirectoriesArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.RemovePersistentDirectoriesResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.RemovePersistentDirectoriesResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.RemovePersistentDirectoriesResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.removePersistentDirectories =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemovePersistentDirectories',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemovePersistentDirectories,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.RemovePersistentDirectoriesResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.removePersistentDirectories =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/RemovePersistentDirectories',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_RemovePersistentDirectories);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.DownloadPersistentDirectoryArgs,
+ *   !proto.api_container_api.StreamedDataChunk>}
+ */
+const methodDescriptor_ApiContainerService_DownloadPersistentDirectory = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/DownloadPersistentDirectory',
+  grpc.web.MethodType.SERVER_STREAMING,
+  proto.api_container_api.DownloadPersistentDirectoryArgs,
+  proto.api_container_api.StreamedDataChunk,
+  /**
+   * @param {!proto.api_container_api.DownloadPersistentDirectoryArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StreamedDataChunk.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.DownloadPersistentDirectoryArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.downloadPersistentDirectory =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/DownloadPersistentDirectory',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DownloadPersistentDirectory);
+};
+
+
+/**
+ * @param {!proto.api_container_api.DownloadPersistentDirectoryArgs} request The request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StreamedDataChunk>}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.downloadPersistentDirectory =
+    function(request, metadata) {
+  return this.client_.serverStreaming(this.hostname_ +
+      '/api_container_api.ApiContainerService/DownloadPersistentDirectory',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_DownloadPersistentDirectory);
+};
+
+
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs,
+ *   !proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse>}
+ */
+const methodDescriptor_ApiContainerService_StoreFilesArtifactFromPersistentDirectory = new grpc.web.MethodDescriptor(
+  '/api_container_api.ApiContainerService/StoreFilesArtifactFromPersistentDirectory',
+  grpc.web.MethodType.UNARY,
+  proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs,
+  proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse,
+  /**
+   * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.api_container_api.ApiContainerServiceClient.prototype.storeFilesArtifactFromPersistentDirectory =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreFilesArtifactFromPersistentDirectory',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreFilesArtifactFromPersistentDirectory,
+      callback);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse>}
+ *     Promise that resolves to the response
+ */
+proto.api_container_api.ApiContainerServicePromiseClient.prototype.storeFilesArtifactFromPersistentDirectory =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/api_container_api.ApiContainerService/StoreFilesArtifactFromPersistentDirectory',
+      request,
+      metadata || {},
+      methodDescriptor_ApiContainerService_StoreFilesArtifactFromPersistentDirectory);
+};
+
+
 module.exports = proto.api_container_api;
 
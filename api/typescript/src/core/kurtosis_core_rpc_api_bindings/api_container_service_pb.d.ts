@@ -64,6 +64,11 @@ export class ServiceInfo extends jspb.Message {
   getServiceStatus(): ServiceStatus;
   setServiceStatus(value: ServiceStatus): ServiceInfo;
 
+  getHealth(): ServiceHealth | undefined;
+  setHealth(value?: ServiceHealth): ServiceInfo;
+  hasHealth(): boolean;
+  clearHealth(): ServiceInfo;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): ServiceInfo.AsObject;
   static toObject(includeInstance: boolean, msg: ServiceInfo): ServiceInfo.AsObject;
@@ -82,6 +87,42 @@ export namespace ServiceInfo {
     name: string,
     shortenedUuid: string,
     serviceStatus: ServiceStatus,
+    health?: ServiceHealth.AsObject,
+  }
+
+  export enum HealthCase { 
+    _HEALTH_NOT_SET = 0,
+    HEALTH = 9,
+  }
+}
+
+export class ServiceHealth extends jspb.Message {
+  getStatus(): ServiceHealthStatus;
+  setStatus(value: ServiceHealthStatus): ServiceHealth;
+
+  getNumRestarts(): number;
+  setNumRestarts(value: number): ServiceHealth;
+
+  getLastFailureReason(): string;
+  setLastFailureReason(value: string): ServiceHealth;
+
+  getLastFailureTimestampMs(): number;
+  setLastFailureTimestampMs(value: number): ServiceHealth;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceHealth.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceHealth): ServiceHealth.AsObject;
+  static serializeBinaryToWriter(message: ServiceHealth, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceHealth;
+  static deserializeBinaryFromReader(message: ServiceHealth, reader: jspb.BinaryReader): ServiceHealth;
+}
+
+export namespace ServiceHealth {
+  export type AsObject = {
+    status: ServiceHealthStatus,
+    numRestarts: number,
+    lastFailureReason: string,
+    lastFailureTimestampMs: number,
   }
 }
 
@@ -110,6 +151,14 @@ export class RunStarlarkScriptArgs extends jspb.Message {
   clearExperimentalFeaturesList(): RunStarlarkScriptArgs;
   addExperimentalFeatures(value: KurtosisFeatureFlag, index?: number): RunStarlarkScriptArgs;
 
+  getGitHostsList(): Array<GitHost>;
+  setGitHostsList(value: Array<GitHost>): RunStarlarkScriptArgs;
+  clearGitHostsList(): RunStarlarkScriptArgs;
+  addGitHosts(value?: GitHost, index?: number): GitHost;
+
+  getOffline(): boolean;
+  setOffline(value: boolean): RunStarlarkScriptArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RunStarlarkScriptArgs.AsObject;
   static toObject(includeInstance: boolean, msg: RunStarlarkScriptArgs): RunStarlarkScriptArgs.AsObject;
@@ -126,6 +175,8 @@ export namespace RunStarlarkScriptArgs {
     parallelism?: number,
     mainFunctionName: string,
     experimentalFeaturesList: Array<KurtosisFeatureFlag>,
+    gitHostsList: Array<GitHost.AsObject>,
+    offline: boolean,
   }
 
   export enum DryRunCase { 
@@ -180,8 +231,26 @@ export class RunStarlarkPackageArgs extends jspb.Message {
   clearExperimentalFeaturesList(): RunStarlarkPackageArgs;
   addExperimentalFeatures(value: KurtosisFeatureFlag, index?: number): RunStarlarkPackageArgs;
 
+  getLocallyReplacedPackageIdsList(): Array<string>;
+  setLocallyReplacedPackageIdsList(value: Array<string>): RunStarlarkPackageArgs;
+  clearLocallyReplacedPackageIdsList(): RunStarlarkPackageArgs;
+  addLocallyReplacedPackageIds(value: string, index?: number): RunStarlarkPackageArgs;
+
+  getPrune(): boolean;
+  setPrune(value: boolean): RunStarlarkPackageArgs;
+  hasPrune(): boolean;
+  clearPrune(): RunStarlarkPackageArgs;
+
   getStarlarkPackageContentCase(): RunStarlarkPackageArgs.StarlarkPackageContentCase;
 
+  getGitHostsList(): Array<GitHost>;
+  setGitHostsList(value: Array<GitHost>): RunStarlarkPackageArgs;
+  clearGitHostsList(): RunStarlarkPackageArgs;
+  addGitHosts(value?: GitHost, index?: number): GitHost;
+
+  getOffline(): boolean;
+  setOffline(value: boolean): RunStarlarkPackageArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): RunStarlarkPackageArgs.AsObject;
   static toObject(includeInstance: boolean, msg: RunStarlarkPackageArgs): RunStarlarkPackageArgs.AsObject;
@@ -202,6 +271,10 @@ export namespace RunStarlarkPackageArgs {
     relativePathToMainFile: string,
     mainFunctionName: string,
     experimentalFeaturesList: Array<KurtosisFeatureFlag>,
+    locallyReplacedPackageIdsList: Array<string>,
+    prune?: boolean,
+    gitHostsList: Array<GitHost.AsObject>,
+    offline: boolean,
   }
 
   export enum StarlarkPackageContentCase { 
@@ -224,6 +297,11 @@ export namespace RunStarlarkPackageArgs {
     _CLONE_PACKAGE_NOT_SET = 0,
     CLONE_PACKAGE = 8,
   }
+
+  export enum PruneCase { 
+    _PRUNE_NOT_SET = 0,
+    PRUNE = 13,
+  }
 }
 
 export class StarlarkRunResponseLine extends jspb.Message {
@@ -257,6 +335,21 @@ export class StarlarkRunResponseLine extends jspb.Message {
   hasWarning(): boolean;
   clearWarning(): StarlarkRunResponseLine;
 
+  getInstructionMetrics(): StarlarkInstructionMetrics | undefined;
+  setInstructionMetrics(value?: StarlarkInstructionMetrics): StarlarkRunResponseLine;
+  hasInstructionMetrics(): boolean;
+  clearInstructionMetrics(): StarlarkRunResponseLine;
+
+  getRunStartedEvent(): StarlarkRunStartedEvent | undefined;
+  setRunStartedEvent(value?: StarlarkRunStartedEvent): StarlarkRunResponseLine;
+  hasRunStartedEvent(): boolean;
+  clearRunStartedEvent(): StarlarkRunResponseLine;
+
+  getInfo(): StarlarkInfo | undefined;
+  setInfo(value?: StarlarkInfo): StarlarkRunResponseLine;
+  hasInfo(): boolean;
+  clearInfo(): StarlarkRunResponseLine;
+
   getRunResponseLineCase(): StarlarkRunResponseLine.RunResponseLineCase;
 
   serializeBinary(): Uint8Array;
@@ -275,6 +368,9 @@ export namespace StarlarkRunResponseLine {
     instructionResult?: StarlarkInstructionResult.AsObject,
     runFinishedEvent?: StarlarkRunFinishedEvent.AsObject,
     warning?: StarlarkWarning.AsObject,
+    instructionMetrics?: StarlarkInstructionMetrics.AsObject,
+    runStartedEvent?: StarlarkRunStartedEvent.AsObject,
+    info?: StarlarkInfo.AsObject,
   }
 
   export enum RunResponseLineCase { 
@@ -285,6 +381,9 @@ export namespace StarlarkRunResponseLine {
     INSTRUCTION_RESULT = 4,
     RUN_FINISHED_EVENT = 5,
     WARNING = 6,
+    INSTRUCTION_METRICS = 7,
+    RUN_STARTED_EVENT = 8,
+    INFO = 9,
   }
 }
 
@@ -306,6 +405,24 @@ export namespace StarlarkWarning {
   }
 }
 
+export class StarlarkInfo extends jspb.Message {
+  getInfoMessage(): string;
+  setInfoMessage(value: string): StarlarkInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkInfo): StarlarkInfo.AsObject;
+  static serializeBinaryToWriter(message: StarlarkInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkInfo;
+  static deserializeBinaryFromReader(message: StarlarkInfo, reader: jspb.BinaryReader): StarlarkInfo;
+}
+
+export namespace StarlarkInfo {
+  export type AsObject = {
+    infoMessage: string,
+  }
+}
+
 export class StarlarkInstruction extends jspb.Message {
   getPosition(): StarlarkInstructionPosition | undefined;
   setPosition(value?: StarlarkInstructionPosition): StarlarkInstruction;
@@ -362,6 +479,40 @@ export namespace StarlarkInstructionResult {
   }
 }
 
+export class StarlarkInstructionMetrics extends jspb.Message {
+  getStartTimestampMs(): number;
+  setStartTimestampMs(value: number): StarlarkInstructionMetrics;
+
+  getEndTimestampMs(): number;
+  setEndTimestampMs(value: number): StarlarkInstructionMetrics;
+
+  getDurationMs(): number;
+  setDurationMs(value: number): StarlarkInstructionMetrics;
+
+  getImagePullDurationMs(): number;
+  setImagePullDurationMs(value: number): StarlarkInstructionMetrics;
+
+  getNumRetries(): number;
+  setNumRetries(value: number): StarlarkInstructionMetrics;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkInstructionMetrics.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkInstructionMetrics): StarlarkInstructionMetrics.AsObject;
+  static serializeBinaryToWriter(message: StarlarkInstructionMetrics, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkInstructionMetrics;
+  static deserializeBinaryFromReader(message: StarlarkInstructionMetrics, reader: jspb.BinaryReader): StarlarkInstructionMetrics;
+}
+
+export namespace StarlarkInstructionMetrics {
+  export type AsObject = {
+    startTimestampMs: number,
+    endTimestampMs: number,
+    durationMs: number,
+    imagePullDurationMs: number,
+    numRetries: number,
+  }
+}
+
 export class StarlarkInstructionArg extends jspb.Message {
   getSerializedArgValue(): string;
   setSerializedArgValue(value: string): StarlarkInstructionArg;
@@ -544,6 +695,24 @@ export namespace StarlarkRunProgress {
   }
 }
 
+export class StarlarkRunStartedEvent extends jspb.Message {
+  getRunId(): string;
+  setRunId(value: string): StarlarkRunStartedEvent;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkRunStartedEvent.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkRunStartedEvent): StarlarkRunStartedEvent.AsObject;
+  static serializeBinaryToWriter(message: StarlarkRunStartedEvent, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkRunStartedEvent;
+  static deserializeBinaryFromReader(message: StarlarkRunStartedEvent, reader: jspb.BinaryReader): StarlarkRunStartedEvent;
+}
+
+export namespace StarlarkRunStartedEvent {
+  export type AsObject = {
+    runId: string,
+  }
+}
+
 export class StarlarkRunFinishedEvent extends jspb.Message {
   getIsRunSuccessful(): boolean;
   setIsRunSuccessful(value: boolean): StarlarkRunFinishedEvent;
@@ -789,6 +958,132 @@ export namespace WaitForHttpPostEndpointAvailabilityArgs {
   }
 }
 
+export class HttpRequestServiceArgs extends jspb.Message {
+  getServiceIdentifier(): string;
+  setServiceIdentifier(value: string): HttpRequestServiceArgs;
+
+  getPortId(): string;
+  setPortId(value: string): HttpRequestServiceArgs;
+
+  getMethod(): string;
+  setMethod(value: string): HttpRequestServiceArgs;
+
+  getEndpoint(): string;
+  setEndpoint(value: string): HttpRequestServiceArgs;
+
+  getHeadersList(): Array<HttpHeader>;
+  setHeadersList(value: Array<HttpHeader>): HttpRequestServiceArgs;
+  clearHeadersList(): HttpRequestServiceArgs;
+  addHeaders(value?: HttpHeader, index?: number): HttpHeader;
+
+  getQueryParamsList(): Array<HttpQueryParam>;
+  setQueryParamsList(value: Array<HttpQueryParam>): HttpRequestServiceArgs;
+  clearQueryParamsList(): HttpRequestServiceArgs;
+  addQueryParams(value?: HttpQueryParam, index?: number): HttpQueryParam;
+
+  getBody(): string;
+  setBody(value: string): HttpRequestServiceArgs;
+
+  getUseTls(): boolean;
+  setUseTls(value: boolean): HttpRequestServiceArgs;
+
+  getSkipTlsVerify(): boolean;
+  setSkipTlsVerify(value: boolean): HttpRequestServiceArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpRequestServiceArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpRequestServiceArgs): HttpRequestServiceArgs.AsObject;
+  static serializeBinaryToWriter(message: HttpRequestServiceArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpRequestServiceArgs;
+  static deserializeBinaryFromReader(message: HttpRequestServiceArgs, reader: jspb.BinaryReader): HttpRequestServiceArgs;
+}
+
+export namespace HttpRequestServiceArgs {
+  export type AsObject = {
+    serviceIdentifier: string,
+    portId: string,
+    method: string,
+    endpoint: string,
+    headersList: Array<HttpHeader.AsObject>,
+    queryParamsList: Array<HttpQueryParam.AsObject>,
+    body: string,
+    useTls: boolean,
+    skipTlsVerify: boolean,
+  }
+}
+
+export class HttpRequestServiceResponse extends jspb.Message {
+  getStatusCode(): number;
+  setStatusCode(value: number): HttpRequestServiceResponse;
+
+  getHeadersList(): Array<HttpHeader>;
+  setHeadersList(value: Array<HttpHeader>): HttpRequestServiceResponse;
+  clearHeadersList(): HttpRequestServiceResponse;
+  addHeaders(value?: HttpHeader, index?: number): HttpHeader;
+
+  getBody(): string;
+  setBody(value: string): HttpRequestServiceResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpRequestServiceResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpRequestServiceResponse): HttpRequestServiceResponse.AsObject;
+  static serializeBinaryToWriter(message: HttpRequestServiceResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpRequestServiceResponse;
+  static deserializeBinaryFromReader(message: HttpRequestServiceResponse, reader: jspb.BinaryReader): HttpRequestServiceResponse;
+}
+
+export namespace HttpRequestServiceResponse {
+  export type AsObject = {
+    statusCode: number,
+    headersList: Array<HttpHeader.AsObject>,
+    body: string,
+  }
+}
+
+export class HttpHeader extends jspb.Message {
+  getName(): string;
+  setName(value: string): HttpHeader;
+
+  getValue(): string;
+  setValue(value: string): HttpHeader;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpHeader.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpHeader): HttpHeader.AsObject;
+  static serializeBinaryToWriter(message: HttpHeader, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpHeader;
+  static deserializeBinaryFromReader(message: HttpHeader, reader: jspb.BinaryReader): HttpHeader;
+}
+
+export namespace HttpHeader {
+  export type AsObject = {
+    name: string,
+    value: string,
+  }
+}
+
+export class HttpQueryParam extends jspb.Message {
+  getName(): string;
+  setName(value: string): HttpQueryParam;
+
+  getValue(): string;
+  setValue(value: string): HttpQueryParam;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): HttpQueryParam.AsObject;
+  static toObject(includeInstance: boolean, msg: HttpQueryParam): HttpQueryParam.AsObject;
+  static serializeBinaryToWriter(message: HttpQueryParam, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): HttpQueryParam;
+  static deserializeBinaryFromReader(message: HttpQueryParam, reader: jspb.BinaryReader): HttpQueryParam;
+}
+
+export namespace HttpQueryParam {
+  export type AsObject = {
+    name: string,
+    value: string,
+  }
+}
+
 export class StreamedDataChunk extends jspb.Message {
   getData(): Uint8Array | string;
   getData_asU8(): Uint8Array;
@@ -1108,6 +1403,685 @@ export namespace ConnectServicesResponse {
   }
 }
 
+export class GetEnclaveSnapshotArgs extends jspb.Message {
+  getIncludePersistentDirectories(): boolean;
+  setIncludePersistentDirectories(value: boolean): GetEnclaveSnapshotArgs;
+  hasIncludePersistentDirectories(): boolean;
+  clearIncludePersistentDirectories(): GetEnclaveSnapshotArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetEnclaveSnapshotArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetEnclaveSnapshotArgs): GetEnclaveSnapshotArgs.AsObject;
+  static serializeBinaryToWriter(message: GetEnclaveSnapshotArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetEnclaveSnapshotArgs;
+  static deserializeBinaryFromReader(message: GetEnclaveSnapshotArgs, reader: jspb.BinaryReader): GetEnclaveSnapshotArgs;
+}
+
+export namespace GetEnclaveSnapshotArgs {
+  export type AsObject = {
+    includePersistentDirectories?: boolean,
+  }
+
+  export enum IncludePersistentDirectoriesCase { 
+    _INCLUDE_PERSISTENT_DIRECTORIES_NOT_SET = 0,
+    INCLUDE_PERSISTENT_DIRECTORIES = 1,
+  }
+}
+
+export class RestoreEnclaveSnapshotResponse extends jspb.Message {
+  getSerializedReplayScript(): string;
+  setSerializedReplayScript(value: string): RestoreEnclaveSnapshotResponse;
+
+  getNumRestoredFilesArtifacts(): number;
+  setNumRestoredFilesArtifacts(value: number): RestoreEnclaveSnapshotResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RestoreEnclaveSnapshotResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RestoreEnclaveSnapshotResponse): RestoreEnclaveSnapshotResponse.AsObject;
+  static serializeBinaryToWriter(message: RestoreEnclaveSnapshotResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RestoreEnclaveSnapshotResponse;
+  static deserializeBinaryFromReader(message: RestoreEnclaveSnapshotResponse, reader: jspb.BinaryReader): RestoreEnclaveSnapshotResponse;
+}
+
+export namespace RestoreEnclaveSnapshotResponse {
+  export type AsObject = {
+    serializedReplayScript: string,
+    numRestoredFilesArtifacts: number,
+  }
+}
+
+export class RepartitionArgs extends jspb.Message {
+  getServicePartitionsList(): Array<ServicePartition>;
+  setServicePartitionsList(value: Array<ServicePartition>): RepartitionArgs;
+  clearServicePartitionsList(): RepartitionArgs;
+  addServicePartitions(value?: ServicePartition, index?: number): ServicePartition;
+
+  getPartitionConnectionsList(): Array<PartitionConnection>;
+  setPartitionConnectionsList(value: Array<PartitionConnection>): RepartitionArgs;
+  clearPartitionConnectionsList(): RepartitionArgs;
+  addPartitionConnections(value?: PartitionConnection, index?: number): PartitionConnection;
+
+  getDefaultConnection(): PartitionConnectionInfo | undefined;
+  setDefaultConnection(value?: PartitionConnectionInfo): RepartitionArgs;
+  hasDefaultConnection(): boolean;
+  clearDefaultConnection(): RepartitionArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RepartitionArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RepartitionArgs): RepartitionArgs.AsObject;
+  static serializeBinaryToWriter(message: RepartitionArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RepartitionArgs;
+  static deserializeBinaryFromReader(message: RepartitionArgs, reader: jspb.BinaryReader): RepartitionArgs;
+}
+
+export namespace RepartitionArgs {
+  export type AsObject = {
+    servicePartitionsList: Array<ServicePartition.AsObject>,
+    partitionConnectionsList: Array<PartitionConnection.AsObject>,
+    defaultConnection?: PartitionConnectionInfo.AsObject,
+  }
+}
+
+export class ServicePartition extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ServicePartition;
+
+  getPartitionId(): string;
+  setPartitionId(value: string): ServicePartition;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServicePartition.AsObject;
+  static toObject(includeInstance: boolean, msg: ServicePartition): ServicePartition.AsObject;
+  static serializeBinaryToWriter(message: ServicePartition, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServicePartition;
+  static deserializeBinaryFromReader(message: ServicePartition, reader: jspb.BinaryReader): ServicePartition;
+}
+
+export namespace ServicePartition {
+  export type AsObject = {
+    serviceName: string,
+    partitionId: string,
+  }
+}
+
+export class PartitionConnection extends jspb.Message {
+  getPartition1(): string;
+  setPartition1(value: string): PartitionConnection;
+
+  getPartition2(): string;
+  setPartition2(value: string): PartitionConnection;
+
+  getInfo(): PartitionConnectionInfo | undefined;
+  setInfo(value?: PartitionConnectionInfo): PartitionConnection;
+  hasInfo(): boolean;
+  clearInfo(): PartitionConnection;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PartitionConnection.AsObject;
+  static toObject(includeInstance: boolean, msg: PartitionConnection): PartitionConnection.AsObject;
+  static serializeBinaryToWriter(message: PartitionConnection, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PartitionConnection;
+  static deserializeBinaryFromReader(message: PartitionConnection, reader: jspb.BinaryReader): PartitionConnection;
+}
+
+export namespace PartitionConnection {
+  export type AsObject = {
+    partition1: string,
+    partition2: string,
+    info?: PartitionConnectionInfo.AsObject,
+  }
+}
+
+export class PartitionConnectionInfo extends jspb.Message {
+  getPacketLossPercentage(): number;
+  setPacketLossPercentage(value: number): PartitionConnectionInfo;
+
+  getDelayMs(): number;
+  setDelayMs(value: number): PartitionConnectionInfo;
+
+  getJitterMs(): number;
+  setJitterMs(value: number): PartitionConnectionInfo;
+
+  getDelayCorrelation(): number;
+  setDelayCorrelation(value: number): PartitionConnectionInfo;
+
+  getDelayDistribution(): PacketDelayDistribution;
+  setDelayDistribution(value: PacketDelayDistribution): PartitionConnectionInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PartitionConnectionInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: PartitionConnectionInfo): PartitionConnectionInfo.AsObject;
+  static serializeBinaryToWriter(message: PartitionConnectionInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PartitionConnectionInfo;
+  static deserializeBinaryFromReader(message: PartitionConnectionInfo, reader: jspb.BinaryReader): PartitionConnectionInfo;
+}
+
+export namespace PartitionConnectionInfo {
+  export type AsObject = {
+    packetLossPercentage: number,
+    delayMs: number,
+    jitterMs: number,
+    delayCorrelation: number,
+    delayDistribution: PacketDelayDistribution,
+  }
+}
+
+export class GitHost extends jspb.Message {
+  getHost(): string;
+  setHost(value: string): GitHost;
+
+  getCloneUrlPrefix(): string;
+  setCloneUrlPrefix(value: string): GitHost;
+
+  getUsername(): string;
+  setUsername(value: string): GitHost;
+
+  getToken(): string;
+  setToken(value: string): GitHost;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GitHost.AsObject;
+  static toObject(includeInstance: boolean, msg: GitHost): GitHost.AsObject;
+  static serializeBinaryToWriter(message: GitHost, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GitHost;
+  static deserializeBinaryFromReader(message: GitHost, reader: jspb.BinaryReader): GitHost;
+}
+
+export namespace GitHost {
+  export type AsObject = {
+    host: string,
+    cloneUrlPrefix: string,
+    username: string,
+    token: string,
+  }
+}
+
+export class RunStarlarkPackageTestsArgs extends jspb.Message {
+  getPackageId(): string;
+  setPackageId(value: string): RunStarlarkPackageTestsArgs;
+
+  getTestNameFilter(): string;
+  setTestNameFilter(value: string): RunStarlarkPackageTestsArgs;
+
+  getLocallyReplacedPackageIdsList(): Array<string>;
+  setLocallyReplacedPackageIdsList(value: Array<string>): RunStarlarkPackageTestsArgs;
+  clearLocallyReplacedPackageIdsList(): RunStarlarkPackageTestsArgs;
+  addLocallyReplacedPackageIds(value: string, index?: number): RunStarlarkPackageTestsArgs;
+
+  getGitHostsList(): Array<GitHost>;
+  setGitHostsList(value: Array<GitHost>): RunStarlarkPackageTestsArgs;
+  clearGitHostsList(): RunStarlarkPackageTestsArgs;
+  addGitHosts(value?: GitHost, index?: number): GitHost;
+
+  getOffline(): boolean;
+  setOffline(value: boolean): RunStarlarkPackageTestsArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunStarlarkPackageTestsArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RunStarlarkPackageTestsArgs): RunStarlarkPackageTestsArgs.AsObject;
+  static serializeBinaryToWriter(message: RunStarlarkPackageTestsArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunStarlarkPackageTestsArgs;
+  static deserializeBinaryFromReader(message: RunStarlarkPackageTestsArgs, reader: jspb.BinaryReader): RunStarlarkPackageTestsArgs;
+}
+
+export namespace RunStarlarkPackageTestsArgs {
+  export type AsObject = {
+    packageId: string,
+    testNameFilter: string,
+    locallyReplacedPackageIdsList: Array<string>,
+    gitHostsList: Array<GitHost.AsObject>,
+    offline: boolean,
+  }
+}
+
+export class RunStarlarkPackageTestsResponse extends jspb.Message {
+  getTestResultsList(): Array<StarlarkTestResult>;
+  setTestResultsList(value: Array<StarlarkTestResult>): RunStarlarkPackageTestsResponse;
+  clearTestResultsList(): RunStarlarkPackageTestsResponse;
+  addTestResults(value?: StarlarkTestResult, index?: number): StarlarkTestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RunStarlarkPackageTestsResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RunStarlarkPackageTestsResponse): RunStarlarkPackageTestsResponse.AsObject;
+  static serializeBinaryToWriter(message: RunStarlarkPackageTestsResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RunStarlarkPackageTestsResponse;
+  static deserializeBinaryFromReader(message: RunStarlarkPackageTestsResponse, reader: jspb.BinaryReader): RunStarlarkPackageTestsResponse;
+}
+
+export namespace RunStarlarkPackageTestsResponse {
+  export type AsObject = {
+    testResultsList: Array<StarlarkTestResult.AsObject>,
+  }
+}
+
+export class StarlarkTestResult extends jspb.Message {
+  getFile(): string;
+  setFile(value: string): StarlarkTestResult;
+
+  getName(): string;
+  setName(value: string): StarlarkTestResult;
+
+  getPassed(): boolean;
+  setPassed(value: boolean): StarlarkTestResult;
+
+  getFailureMessage(): string;
+  setFailureMessage(value: string): StarlarkTestResult;
+
+  getDurationMs(): number;
+  setDurationMs(value: number): StarlarkTestResult;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StarlarkTestResult.AsObject;
+  static toObject(includeInstance: boolean, msg: StarlarkTestResult): StarlarkTestResult.AsObject;
+  static serializeBinaryToWriter(message: StarlarkTestResult, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StarlarkTestResult;
+  static deserializeBinaryFromReader(message: StarlarkTestResult, reader: jspb.BinaryReader): StarlarkTestResult;
+}
+
+export namespace StarlarkTestResult {
+  export type AsObject = {
+    file: string,
+    name: string,
+    passed: boolean,
+    failureMessage: string,
+    durationMs: number,
+  }
+}
+
+export class GetStarlarkRunPlanArgs extends jspb.Message {
+  getPackageId(): string;
+  setPackageId(value: string): GetStarlarkRunPlanArgs;
+
+  getSerializedScript(): string;
+  setSerializedScript(value: string): GetStarlarkRunPlanArgs;
+
+  getRelativePathToMainFile(): string;
+  setRelativePathToMainFile(value: string): GetStarlarkRunPlanArgs;
+
+  getMainFunctionName(): string;
+  setMainFunctionName(value: string): GetStarlarkRunPlanArgs;
+
+  getSerializedParams(): string;
+  setSerializedParams(value: string): GetStarlarkRunPlanArgs;
+
+  getLocallyReplacedPackageIdsList(): Array<string>;
+  setLocallyReplacedPackageIdsList(value: Array<string>): GetStarlarkRunPlanArgs;
+  clearLocallyReplacedPackageIdsList(): GetStarlarkRunPlanArgs;
+  addLocallyReplacedPackageIds(value: string, index?: number): GetStarlarkRunPlanArgs;
+
+  getFormat(): PlanFormat;
+  setFormat(value: PlanFormat): GetStarlarkRunPlanArgs;
+
+  getClonePackage(): boolean;
+  setClonePackage(value: boolean): GetStarlarkRunPlanArgs;
+
+  getGitHostsList(): Array<GitHost>;
+  setGitHostsList(value: Array<GitHost>): GetStarlarkRunPlanArgs;
+  clearGitHostsList(): GetStarlarkRunPlanArgs;
+  addGitHosts(value?: GitHost, index?: number): GitHost;
+
+  getOffline(): boolean;
+  setOffline(value: boolean): GetStarlarkRunPlanArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStarlarkRunPlanArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStarlarkRunPlanArgs): GetStarlarkRunPlanArgs.AsObject;
+  static serializeBinaryToWriter(message: GetStarlarkRunPlanArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStarlarkRunPlanArgs;
+  static deserializeBinaryFromReader(message: GetStarlarkRunPlanArgs, reader: jspb.BinaryReader): GetStarlarkRunPlanArgs;
+}
+
+export namespace GetStarlarkRunPlanArgs {
+  export type AsObject = {
+    packageId: string,
+    serializedScript: string,
+    relativePathToMainFile: string,
+    mainFunctionName: string,
+    serializedParams: string,
+    locallyReplacedPackageIdsList: Array<string>,
+    format: PlanFormat,
+    clonePackage: boolean,
+    gitHostsList: Array<GitHost.AsObject>,
+    offline: boolean,
+  }
+}
+
+export class GetStarlarkRunPlanResponse extends jspb.Message {
+  getInterpretationError(): StarlarkInterpretationError | undefined;
+  setInterpretationError(value?: StarlarkInterpretationError): GetStarlarkRunPlanResponse;
+  hasInterpretationError(): boolean;
+  clearInterpretationError(): GetStarlarkRunPlanResponse;
+
+  getSerializedPlan(): string;
+  setSerializedPlan(value: string): GetStarlarkRunPlanResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStarlarkRunPlanResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStarlarkRunPlanResponse): GetStarlarkRunPlanResponse.AsObject;
+  static serializeBinaryToWriter(message: GetStarlarkRunPlanResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStarlarkRunPlanResponse;
+  static deserializeBinaryFromReader(message: GetStarlarkRunPlanResponse, reader: jspb.BinaryReader): GetStarlarkRunPlanResponse;
+}
+
+export namespace GetStarlarkRunPlanResponse {
+  export type AsObject = {
+    interpretationError?: StarlarkInterpretationError.AsObject,
+    serializedPlan: string,
+  }
+}
+
+export class GetStarlarkRunDiffArgs extends jspb.Message {
+  getPackageId(): string;
+  setPackageId(value: string): GetStarlarkRunDiffArgs;
+
+  getSerializedScript(): string;
+  setSerializedScript(value: string): GetStarlarkRunDiffArgs;
+
+  getRelativePathToMainFile(): string;
+  setRelativePathToMainFile(value: string): GetStarlarkRunDiffArgs;
+
+  getMainFunctionName(): string;
+  setMainFunctionName(value: string): GetStarlarkRunDiffArgs;
+
+  getSerializedParams(): string;
+  setSerializedParams(value: string): GetStarlarkRunDiffArgs;
+
+  getLocallyReplacedPackageIdsList(): Array<string>;
+  setLocallyReplacedPackageIdsList(value: Array<string>): GetStarlarkRunDiffArgs;
+  clearLocallyReplacedPackageIdsList(): GetStarlarkRunDiffArgs;
+  addLocallyReplacedPackageIds(value: string, index?: number): GetStarlarkRunDiffArgs;
+
+  getClonePackage(): boolean;
+  setClonePackage(value: boolean): GetStarlarkRunDiffArgs;
+
+  getPrune(): boolean;
+  setPrune(value: boolean): GetStarlarkRunDiffArgs;
+
+  getGitHostsList(): Array<GitHost>;
+  setGitHostsList(value: Array<GitHost>): GetStarlarkRunDiffArgs;
+  clearGitHostsList(): GetStarlarkRunDiffArgs;
+  addGitHosts(value?: GitHost, index?: number): GitHost;
+
+  getOffline(): boolean;
+  setOffline(value: boolean): GetStarlarkRunDiffArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStarlarkRunDiffArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStarlarkRunDiffArgs): GetStarlarkRunDiffArgs.AsObject;
+  static serializeBinaryToWriter(message: GetStarlarkRunDiffArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStarlarkRunDiffArgs;
+  static deserializeBinaryFromReader(message: GetStarlarkRunDiffArgs, reader: jspb.BinaryReader): GetStarlarkRunDiffArgs;
+}
+
+export namespace GetStarlarkRunDiffArgs {
+  export type AsObject = {
+    packageId: string,
+    serializedScript: string,
+    relativePathToMainFile: string,
+    mainFunctionName: string,
+    serializedParams: string,
+    locallyReplacedPackageIdsList: Array<string>,
+    clonePackage: boolean,
+    prune: boolean,
+    gitHostsList: Array<GitHost.AsObject>,
+    offline: boolean,
+  }
+}
+
+export class ServiceChange extends jspb.Message {
+  getServiceName(): string;
+  setServiceName(value: string): ServiceChange;
+
+  getChangeType(): ServiceChangeType;
+  setChangeType(value: ServiceChangeType): ServiceChange;
+
+  getReasonsList(): Array<string>;
+  setReasonsList(value: Array<string>): ServiceChange;
+  clearReasonsList(): ServiceChange;
+  addReasons(value: string, index?: number): ServiceChange;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ServiceChange.AsObject;
+  static toObject(includeInstance: boolean, msg: ServiceChange): ServiceChange.AsObject;
+  static serializeBinaryToWriter(message: ServiceChange, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ServiceChange;
+  static deserializeBinaryFromReader(message: ServiceChange, reader: jspb.BinaryReader): ServiceChange;
+}
+
+export namespace ServiceChange {
+  export type AsObject = {
+    serviceName: string,
+    changeType: ServiceChangeType,
+    reasonsList: Array<string>,
+  }
+}
+
+export class GetStarlarkRunDiffResponse extends jspb.Message {
+  getInterpretationError(): StarlarkInterpretationError | undefined;
+  setInterpretationError(value?: StarlarkInterpretationError): GetStarlarkRunDiffResponse;
+  hasInterpretationError(): boolean;
+  clearInterpretationError(): GetStarlarkRunDiffResponse;
+
+  getServiceChangesList(): Array<ServiceChange>;
+  setServiceChangesList(value: Array<ServiceChange>): GetStarlarkRunDiffResponse;
+  clearServiceChangesList(): GetStarlarkRunDiffResponse;
+  addServiceChanges(value?: ServiceChange, index?: number): ServiceChange;
+
+  getInstructionsToRunCount(): number;
+  setInstructionsToRunCount(value: number): GetStarlarkRunDiffResponse;
+
+  getInstructionsToSkipCount(): number;
+  setInstructionsToSkipCount(value: number): GetStarlarkRunDiffResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetStarlarkRunDiffResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetStarlarkRunDiffResponse): GetStarlarkRunDiffResponse.AsObject;
+  static serializeBinaryToWriter(message: GetStarlarkRunDiffResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetStarlarkRunDiffResponse;
+  static deserializeBinaryFromReader(message: GetStarlarkRunDiffResponse, reader: jspb.BinaryReader): GetStarlarkRunDiffResponse;
+}
+
+export namespace GetStarlarkRunDiffResponse {
+  export type AsObject = {
+    interpretationError?: StarlarkInterpretationError.AsObject,
+    serviceChangesList: Array<ServiceChange.AsObject>,
+    instructionsToRunCount: number,
+    instructionsToSkipCount: number,
+  }
+}
+
+export class ResumeStarlarkRunArgs extends jspb.Message {
+  getStartingLineIndex(): number;
+  setStartingLineIndex(value: number): ResumeStarlarkRunArgs;
+
+  getRunId(): string;
+  setRunId(value: string): ResumeStarlarkRunArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ResumeStarlarkRunArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: ResumeStarlarkRunArgs): ResumeStarlarkRunArgs.AsObject;
+  static serializeBinaryToWriter(message: ResumeStarlarkRunArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ResumeStarlarkRunArgs;
+  static deserializeBinaryFromReader(message: ResumeStarlarkRunArgs, reader: jspb.BinaryReader): ResumeStarlarkRunArgs;
+}
+
+export namespace ResumeStarlarkRunArgs {
+  export type AsObject = {
+    startingLineIndex: number,
+    runId: string,
+  }
+}
+
+export class CancelStarlarkRunArgs extends jspb.Message {
+  getRunId(): string;
+  setRunId(value: string): CancelStarlarkRunArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): CancelStarlarkRunArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: CancelStarlarkRunArgs): CancelStarlarkRunArgs.AsObject;
+  static serializeBinaryToWriter(message: CancelStarlarkRunArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): CancelStarlarkRunArgs;
+  static deserializeBinaryFromReader(message: CancelStarlarkRunArgs, reader: jspb.BinaryReader): CancelStarlarkRunArgs;
+}
+
+export namespace CancelStarlarkRunArgs {
+  export type AsObject = {
+    runId: string,
+  }
+}
+
+export class PersistentDirectory extends jspb.Message {
+  getServiceUuid(): string;
+  setServiceUuid(value: string): PersistentDirectory;
+
+  getPersistentKey(): string;
+  setPersistentKey(value: string): PersistentDirectory;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): PersistentDirectory.AsObject;
+  static toObject(includeInstance: boolean, msg: PersistentDirectory): PersistentDirectory.AsObject;
+  static serializeBinaryToWriter(message: PersistentDirectory, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): PersistentDirectory;
+  static deserializeBinaryFromReader(message: PersistentDirectory, reader: jspb.BinaryReader): PersistentDirectory;
+}
+
+export namespace PersistentDirectory {
+  export type AsObject = {
+    serviceUuid: string,
+    persistentKey: string,
+  }
+}
+
+export class ListPersistentDirectoriesResponse extends jspb.Message {
+  getPersistentDirectoriesList(): Array<PersistentDirectory>;
+  setPersistentDirectoriesList(value: Array<PersistentDirectory>): ListPersistentDirectoriesResponse;
+  clearPersistentDirectoriesList(): ListPersistentDirectoriesResponse;
+  addPersistentDirectories(value?: PersistentDirectory, index?: number): PersistentDirectory;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): ListPersistentDirectoriesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: ListPersistentDirectoriesResponse): ListPersistentDirectoriesResponse.AsObject;
+  static serializeBinaryToWriter(message: ListPersistentDirectoriesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): ListPersistentDirectoriesResponse;
+  static deserializeBinaryFromReader(message: ListPersistentDirectoriesResponse, reader: jspb.BinaryReader): ListPersistentDirectoriesResponse;
+}
+
+export namespace ListPersistentDirectoriesResponse {
+  export type AsObject = {
+    persistentDirectoriesList: Array<PersistentDirectory.AsObject>,
+  }
+}
+
+export class RemovePersistentDirectoriesArgs extends jspb.Message {
+  getServiceUuid(): string;
+  setServiceUuid(value: string): RemovePersistentDirectoriesArgs;
+
+  getPersistentKeysList(): Array<string>;
+  setPersistentKeysList(value: Array<string>): RemovePersistentDirectoriesArgs;
+  clearPersistentKeysList(): RemovePersistentDirectoriesArgs;
+  addPersistentKeys(value: string, index?: number): RemovePersistentDirectoriesArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemovePersistentDirectoriesArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: RemovePersistentDirectoriesArgs): RemovePersistentDirectoriesArgs.AsObject;
+  static serializeBinaryToWriter(message: RemovePersistentDirectoriesArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemovePersistentDirectoriesArgs;
+  static deserializeBinaryFromReader(message: RemovePersistentDirectoriesArgs, reader: jspb.BinaryReader): RemovePersistentDirectoriesArgs;
+}
+
+export namespace RemovePersistentDirectoriesArgs {
+  export type AsObject = {
+    serviceUuid: string,
+    persistentKeysList: Array<string>,
+  }
+}
+
+export class RemovePersistentDirectoriesResponse extends jspb.Message {
+  getRemovedPersistentKeysList(): Array<string>;
+  setRemovedPersistentKeysList(value: Array<string>): RemovePersistentDirectoriesResponse;
+  clearRemovedPersistentKeysList(): RemovePersistentDirectoriesResponse;
+  addRemovedPersistentKeys(value: string, index?: number): RemovePersistentDirectoriesResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): RemovePersistentDirectoriesResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: RemovePersistentDirectoriesResponse): RemovePersistentDirectoriesResponse.AsObject;
+  static serializeBinaryToWriter(message: RemovePersistentDirectoriesResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): RemovePersistentDirectoriesResponse;
+  static deserializeBinaryFromReader(message: RemovePersistentDirectoriesResponse, reader: jspb.BinaryReader): RemovePersistentDirectoriesResponse;
+}
+
+export namespace RemovePersistentDirectoriesResponse {
+  export type AsObject = {
+    removedPersistentKeysList: Array<string>,
+  }
+}
+
+export class DownloadPersistentDirectoryArgs extends jspb.Message {
+  getServiceUuid(): string;
+  setServiceUuid(value: string): DownloadPersistentDirectoryArgs;
+
+  getPersistentKey(): string;
+  setPersistentKey(value: string): DownloadPersistentDirectoryArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): DownloadPersistentDirectoryArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: DownloadPersistentDirectoryArgs): DownloadPersistentDirectoryArgs.AsObject;
+  static serializeBinaryToWriter(message: DownloadPersistentDirectoryArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): DownloadPersistentDirectoryArgs;
+  static deserializeBinaryFromReader(message: DownloadPersistentDirectoryArgs, reader: jspb.BinaryReader): DownloadPersistentDirectoryArgs;
+}
+
+export namespace DownloadPersistentDirectoryArgs {
+  export type AsObject = {
+    serviceUuid: string,
+    persistentKey: string,
+  }
+}
+
+export class StoreFilesArtifactFromPersistentDirectoryArgs extends jspb.Message {
+  getServiceUuid(): string;
+  setServiceUuid(value: string): StoreFilesArtifactFromPersistentDirectoryArgs;
+
+  getPersistentKey(): string;
+  setPersistentKey(value: string): StoreFilesArtifactFromPersistentDirectoryArgs;
+
+  getName(): string;
+  setName(value: string): StoreFilesArtifactFromPersistentDirectoryArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StoreFilesArtifactFromPersistentDirectoryArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: StoreFilesArtifactFromPersistentDirectoryArgs): StoreFilesArtifactFromPersistentDirectoryArgs.AsObject;
+  static serializeBinaryToWriter(message: StoreFilesArtifactFromPersistentDirectoryArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StoreFilesArtifactFromPersistentDirectoryArgs;
+  static deserializeBinaryFromReader(message: StoreFilesArtifactFromPersistentDirectoryArgs, reader: jspb.BinaryReader): StoreFilesArtifactFromPersistentDirectoryArgs;
+}
+
+export namespace StoreFilesArtifactFromPersistentDirectoryArgs {
+  export type AsObject = {
+    serviceUuid: string,
+    persistentKey: string,
+    name: string,
+  }
+}
+
+export class StoreFilesArtifactFromPersistentDirectoryResponse extends jspb.Message {
+  getUuid(): string;
+  setUuid(value: string): StoreFilesArtifactFromPersistentDirectoryResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StoreFilesArtifactFromPersistentDirectoryResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: StoreFilesArtifactFromPersistentDirectoryResponse): StoreFilesArtifactFromPersistentDirectoryResponse.AsObject;
+  static serializeBinaryToWriter(message: StoreFilesArtifactFromPersistentDirectoryResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StoreFilesArtifactFromPersistentDirectoryResponse;
+  static deserializeBinaryFromReader(message: StoreFilesArtifactFromPersistentDirectoryResponse, reader: jspb.BinaryReader): StoreFilesArtifactFromPersistentDirectoryResponse;
+}
+
+export namespace StoreFilesArtifactFromPersistentDirectoryResponse {
+  export type AsObject = {
+    uuid: string,
+  }
+}
+
 export enum ServiceStatus { 
   STOPPED = 0,
   RUNNING = 1,
@@ -1120,3 +2094,21 @@ export enum Connect {
 export enum KurtosisFeatureFlag { 
   NO_INSTRUCTIONS_CACHING = 0,
 }
+export enum PacketDelayDistribution { 
+  UNIFORM = 0,
+  NORMAL = 1,
+}
+export enum ServiceHealthStatus { 
+  HEALTHY = 0,
+  UNHEALTHY = 1,
+}
+export enum PlanFormat { 
+  YAML = 0,
+  JSON = 1,
+}
+export enum ServiceChangeType { 
+  ADDED = 0,
+  UPDATED = 1,
+  RESTARTED = 2,
+  REMOVED = 3,
+}
@@ -23,35 +23,67 @@ var global = (function() {
 
 var google_protobuf_empty_pb = require('google-protobuf/google/protobuf/empty_pb.js');
 goog.object.extend(proto, google_protobuf_empty_pb);
+goog.exportSymbol('proto.api_container_api.CancelStarlarkRunArgs', null, global);
 goog.exportSymbol('proto.api_container_api.Connect', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ConnectServicesResponse', null, global);
 goog.exportSymbol('proto.api_container_api.DataChunkMetadata', null, global);
 goog.exportSymbol('proto.api_container_api.DownloadFilesArtifactArgs', null, global);
+goog.exportSymbol('proto.api_container_api.DownloadPersistentDirectoryArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandArgs', null, global);
 goog.exportSymbol('proto.api_container_api.ExecCommandResponse', null, global);
 goog.exportSymbol('proto.api_container_api.FileArtifactContentsFileDescription', null, global);
 goog.exportSymbol('proto.api_container_api.FilesArtifactNameAndUuid', null, global);
+goog.exportSymbol('proto.api_container_api.GetEnclaveSnapshotArgs', null, global);
 goog.exportSymbol('proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesArgs', null, global);
 goog.exportSymbol('proto.api_container_api.GetServicesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetStarlarkRunDiffArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetStarlarkRunDiffResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GetStarlarkRunPlanArgs', null, global);
+goog.exportSymbol('proto.api_container_api.GetStarlarkRunPlanResponse', null, global);
+goog.exportSymbol('proto.api_container_api.GitHost', null, global);
+goog.exportSymbol('proto.api_container_api.HttpHeader', null, global);
+goog.exportSymbol('proto.api_container_api.HttpQueryParam', null, global);
+goog.exportSymbol('proto.api_container_api.HttpRequestServiceArgs', null, global);
+goog.exportSymbol('proto.api_container_api.HttpRequestServiceResponse', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsRequest', null, global);
 goog.exportSymbol('proto.api_container_api.InspectFilesArtifactContentsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.KurtosisFeatureFlag', null, global);
 goog.exportSymbol('proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ListPersistentDirectoriesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.PacketDelayDistribution', null, global);
+goog.exportSymbol('proto.api_container_api.PartitionConnection', null, global);
+goog.exportSymbol('proto.api_container_api.PartitionConnectionInfo', null, global);
+goog.exportSymbol('proto.api_container_api.PersistentDirectory', null, global);
+goog.exportSymbol('proto.api_container_api.PlanFormat', null, global);
 goog.exportSymbol('proto.api_container_api.Port', null, global);
 goog.exportSymbol('proto.api_container_api.Port.TransportProtocol', null, global);
+goog.exportSymbol('proto.api_container_api.RemovePersistentDirectoriesArgs', null, global);
+goog.exportSymbol('proto.api_container_api.RemovePersistentDirectoriesResponse', null, global);
+goog.exportSymbol('proto.api_container_api.RepartitionArgs', null, global);
+goog.exportSymbol('proto.api_container_api.RestoreEnclaveSnapshotResponse', null, global);
+goog.exportSymbol('proto.api_container_api.ResumeStarlarkRunArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase', null, global);
+goog.exportSymbol('proto.api_container_api.RunStarlarkPackageTestsArgs', null, global);
+goog.exportSymbol('proto.api_container_api.RunStarlarkPackageTestsResponse', null, global);
 goog.exportSymbol('proto.api_container_api.RunStarlarkScriptArgs', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceChange', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceChangeType', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceHealth', null, global);
+goog.exportSymbol('proto.api_container_api.ServiceHealthStatus', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceIdentifiers', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceInfo', null, global);
+goog.exportSymbol('proto.api_container_api.ServicePartition', null, global);
 goog.exportSymbol('proto.api_container_api.ServiceStatus', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkError.ErrorCase', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkExecutionError', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkInfo', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstruction', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionArg', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkInstructionMetrics', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionPosition', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInstructionResult', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkInterpretationError', null, global);
@@ -59,8 +91,12 @@ goog.exportSymbol('proto.api_container_api.StarlarkRunFinishedEvent', null, glob
 goog.exportSymbol('proto.api_container_api.StarlarkRunProgress', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkRunStartedEvent', null, global);
+goog.exportSymbol('proto.api_container_api.StarlarkTestResult', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkValidationError', null, global);
 goog.exportSymbol('proto.api_container_api.StarlarkWarning', null, global);
+goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs', null, global);
+goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse', null, global);
 goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromServiceArgs', null, global);
 goog.exportSymbol('proto.api_container_api.StoreFilesArtifactFromServiceResponse', null, global);
 goog.exportSymbol('proto.api_container_api.StoreWebFilesArtifactArgs', null, global);
@@ -111,6 +147,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.ServiceInfo.displayName = 'proto.api_container_api.ServiceInfo';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceHealth = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServiceHealth, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceHealth.displayName = 'proto.api_container_api.ServiceHealth';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -195,6 +252,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkWarning.displayName = 'proto.api_container_api.StarlarkWarning';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkInfo.displayName = 'proto.api_container_api.StarlarkInfo';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -237,6 +315,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkInstructionResult.displayName = 'proto.api_container_api.StarlarkInstructionResult';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkInstructionMetrics = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkInstructionMetrics, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkInstructionMetrics.displayName = 'proto.api_container_api.StarlarkInstructionMetrics';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -384,6 +483,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.StarlarkRunProgress.displayName = 'proto.api_container_api.StarlarkRunProgress';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkRunStartedEvent = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkRunStartedEvent, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkRunStartedEvent.displayName = 'proto.api_container_api.StarlarkRunStartedEvent';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -573,6 +693,90 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.displayName = 'proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpRequestServiceArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.HttpRequestServiceArgs.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.HttpRequestServiceArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpRequestServiceArgs.displayName = 'proto.api_container_api.HttpRequestServiceArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpRequestServiceResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.HttpRequestServiceResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.HttpRequestServiceResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpRequestServiceResponse.displayName = 'proto.api_container_api.HttpRequestServiceResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpHeader = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.HttpHeader, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpHeader.displayName = 'proto.api_container_api.HttpHeader';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.HttpQueryParam = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.HttpQueryParam, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.HttpQueryParam.displayName = 'proto.api_container_api.HttpQueryParam';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -888,93 +1092,7783 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.api_container_api.ConnectServicesResponse.displayName = 'proto.api_container_api.ConnectServicesResponse';
 }
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+proto.api_container_api.GetEnclaveSnapshotArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
-
-
+goog.inherits(proto.api_container_api.GetEnclaveSnapshotArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetEnclaveSnapshotArgs.displayName = 'proto.api_container_api.GetEnclaveSnapshotArgs';
+}
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.Port} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    maybeWaitTimeout: jspb.Message.getFieldWithDefault(msg, 4, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.RestoreEnclaveSnapshotResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
 };
+goog.inherits(proto.api_container_api.RestoreEnclaveSnapshotResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RestoreEnclaveSnapshotResponse.displayName = 'proto.api_container_api.RestoreEnclaveSnapshotResponse';
 }
-
-
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.Port}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.Port;
-  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.RepartitionArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RepartitionArgs.repeatedFields_, null);
 };
-
-
+goog.inherits(proto.api_container_api.RepartitionArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RepartitionArgs.displayName = 'proto.api_container_api.RepartitionArgs';
+}
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.Port}
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
  */
-proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setNumber(value);
+proto.api_container_api.ServicePartition = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ServicePartition, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServicePartition.displayName = 'proto.api_container_api.ServicePartition';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.PartitionConnection = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.PartitionConnection, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.PartitionConnection.displayName = 'proto.api_container_api.PartitionConnection';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.PartitionConnectionInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.PartitionConnectionInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.PartitionConnectionInfo.displayName = 'proto.api_container_api.PartitionConnectionInfo';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GitHost = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GitHost, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GitHost.displayName = 'proto.api_container_api.GitHost';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RunStarlarkPackageTestsArgs.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.RunStarlarkPackageTestsArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RunStarlarkPackageTestsArgs.displayName = 'proto.api_container_api.RunStarlarkPackageTestsArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RunStarlarkPackageTestsResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RunStarlarkPackageTestsResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.RunStarlarkPackageTestsResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RunStarlarkPackageTestsResponse.displayName = 'proto.api_container_api.RunStarlarkPackageTestsResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StarlarkTestResult = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StarlarkTestResult, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StarlarkTestResult.displayName = 'proto.api_container_api.StarlarkTestResult';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetStarlarkRunPlanArgs.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetStarlarkRunPlanArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetStarlarkRunPlanArgs.displayName = 'proto.api_container_api.GetStarlarkRunPlanArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetStarlarkRunPlanResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.GetStarlarkRunPlanResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetStarlarkRunPlanResponse.displayName = 'proto.api_container_api.GetStarlarkRunPlanResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetStarlarkRunDiffArgs.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetStarlarkRunDiffArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetStarlarkRunDiffArgs.displayName = 'proto.api_container_api.GetStarlarkRunDiffArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ServiceChange = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ServiceChange.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.ServiceChange, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ServiceChange.displayName = 'proto.api_container_api.ServiceChange';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.GetStarlarkRunDiffResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.GetStarlarkRunDiffResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.GetStarlarkRunDiffResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.GetStarlarkRunDiffResponse.displayName = 'proto.api_container_api.GetStarlarkRunDiffResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ResumeStarlarkRunArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.ResumeStarlarkRunArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ResumeStarlarkRunArgs.displayName = 'proto.api_container_api.ResumeStarlarkRunArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.CancelStarlarkRunArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.CancelStarlarkRunArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.CancelStarlarkRunArgs.displayName = 'proto.api_container_api.CancelStarlarkRunArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.PersistentDirectory = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.PersistentDirectory, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.PersistentDirectory.displayName = 'proto.api_container_api.PersistentDirectory';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.ListPersistentDirectoriesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.ListPersistentDirectoriesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.ListPersistentDirectoriesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.ListPersistentDirectoriesResponse.displayName = 'proto.api_container_api.ListPersistentDirectoriesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RemovePersistentDirectoriesArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RemovePersistentDirectoriesArgs.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.RemovePersistentDirectoriesArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RemovePersistentDirectoriesArgs.displayName = 'proto.api_container_api.RemovePersistentDirectoriesArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.RemovePersistentDirectoriesResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.api_container_api.RemovePersistentDirectoriesResponse.repeatedFields_, null);
+};
+goog.inherits(proto.api_container_api.RemovePersistentDirectoriesResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.RemovePersistentDirectoriesResponse.displayName = 'proto.api_container_api.RemovePersistentDirectoriesResponse';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.DownloadPersistentDirectoryArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.DownloadPersistentDirectoryArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.DownloadPersistentDirectoryArgs.displayName = 'proto.api_container_api.DownloadPersistentDirectoryArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.displayName = 'proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs';
+}
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.displayName = 'proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse';
+}
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.Port.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.Port.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.Port} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Port.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    number: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    transportProtocol: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    maybeApplicationProtocol: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    maybeWaitTimeout: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.Port;
+  return proto.api_container_api.Port.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.Port} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.Port}
+ */
+proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumber(value);
+      break;
+    case 2:
+      var value = /** @type {!proto.api_container_api.Port.TransportProtocol} */ (reader.readEnum());
+      msg.setTransportProtocol(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybeApplicationProtocol(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybeWaitTimeout(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.Port.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.Port.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.Port} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.Port.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      1,
+      f
+    );
+  }
+  f = message.getTransportProtocol();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      2,
+      f
+    );
+  }
+  f = message.getMaybeApplicationProtocol();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMaybeWaitTimeout();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.Port.TransportProtocol = {
+  TCP: 0,
+  SCTP: 1,
+  UDP: 2
+};
+
+/**
+ * optional uint32 number = 1;
+ * @return {number}
+ */
+proto.api_container_api.Port.prototype.getNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional TransportProtocol transport_protocol = 2;
+ * @return {!proto.api_container_api.Port.TransportProtocol}
+ */
+proto.api_container_api.Port.prototype.getTransportProtocol = function() {
+  return /** @type {!proto.api_container_api.Port.TransportProtocol} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.Port.TransportProtocol} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setTransportProtocol = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
+};
+
+
+/**
+ * optional string maybe_application_protocol = 3;
+ * @return {string}
+ */
+proto.api_container_api.Port.prototype.getMaybeApplicationProtocol = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setMaybeApplicationProtocol = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string maybe_wait_timeout = 4;
+ * @return {string}
+ */
+proto.api_container_api.Port.prototype.getMaybeWaitTimeout = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.Port} returns this
+ */
+proto.api_container_api.Port.prototype.setMaybeWaitTimeout = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    privateIpAddr: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    maybePublicIpAddr: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    maybePublicPortsMap: (f = msg.getMaybePublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
+    name: jspb.Message.getFieldWithDefault(msg, 6, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    serviceStatus: jspb.Message.getFieldWithDefault(msg, 8, 0),
+    health: (f = msg.getHealth()) && proto.api_container_api.ServiceHealth.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceInfo}
+ */
+proto.api_container_api.ServiceInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceInfo;
+  return proto.api_container_api.ServiceInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceInfo}
+ */
+proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPrivateIpAddr(value);
+      break;
+    case 3:
+      var value = msg.getPrivatePortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMaybePublicIpAddr(value);
+      break;
+    case 5:
+      var value = msg.getMaybePublicPortsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
+         });
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    case 8:
+      var value = /** @type {!proto.api_container_api.ServiceStatus} */ (reader.readEnum());
+      msg.setServiceStatus(value);
+      break;
+    case 9:
+      var value = new proto.api_container_api.ServiceHealth;
+      reader.readMessage(value,proto.api_container_api.ServiceHealth.deserializeBinaryFromReader);
+      msg.setHealth(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPrivateIpAddr();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getPrivatePortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getMaybePublicIpAddr();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getMaybePublicPortsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      6,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getServiceStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      8,
+      f
+    );
+  }
+  f = message.getHealth();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.api_container_api.ServiceHealth.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string service_uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string private_ip_addr = 2;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivateIpAddr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setPrivateIpAddr = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * map<string, Port> private_ports = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearPrivatePortsMap = function() {
+  this.getPrivatePortsMap().clear();
+  return this;};
+
+
+/**
+ * optional string maybe_public_ip_addr = 4;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getMaybePublicIpAddr = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setMaybePublicIpAddr = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * map<string, Port> maybe_public_ports = 5;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.Port>}
+ */
+proto.api_container_api.ServiceInfo.prototype.getMaybePublicPortsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
+      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
+      proto.api_container_api.Port));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearMaybePublicPortsMap = function() {
+  this.getMaybePublicPortsMap().clear();
+  return this;};
+
+
+/**
+ * optional string name = 6;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 6, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 7;
+ * @return {string}
+ */
+proto.api_container_api.ServiceInfo.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional ServiceStatus service_status = 8;
+ * @return {!proto.api_container_api.ServiceStatus}
+ */
+proto.api_container_api.ServiceInfo.prototype.getServiceStatus = function() {
+  return /** @type {!proto.api_container_api.ServiceStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceStatus} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.setServiceStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 8, value);
+};
+
+
+/**
+ * optional ServiceHealth health = 9;
+ * @return {?proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceInfo.prototype.getHealth = function() {
+  return /** @type{?proto.api_container_api.ServiceHealth} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.ServiceHealth, 9));
+};
+
+
+/**
+ * @param {?proto.api_container_api.ServiceHealth|undefined} value
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+*/
+proto.api_container_api.ServiceInfo.prototype.setHealth = function(value) {
+  return jspb.Message.setWrapperField(this, 9, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.ServiceInfo} returns this
+ */
+proto.api_container_api.ServiceInfo.prototype.clearHealth = function() {
+  return this.setHealth(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.ServiceInfo.prototype.hasHealth = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceHealth.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceHealth.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceHealth} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceHealth.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    status: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    numRestarts: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    lastFailureReason: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    lastFailureTimestampMs: jspb.Message.getFieldWithDefault(msg, 4, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceHealth.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceHealth;
+  return proto.api_container_api.ServiceHealth.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceHealth} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceHealth}
+ */
+proto.api_container_api.ServiceHealth.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!proto.api_container_api.ServiceHealthStatus} */ (reader.readEnum());
+      msg.setStatus(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumRestarts(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLastFailureReason(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setLastFailureTimestampMs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceHealth.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceHealth.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceHealth} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceHealth.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStatus();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      1,
+      f
+    );
+  }
+  f = message.getNumRestarts();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getLastFailureReason();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getLastFailureTimestampMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional ServiceHealthStatus status = 1;
+ * @return {!proto.api_container_api.ServiceHealthStatus}
+ */
+proto.api_container_api.ServiceHealth.prototype.getStatus = function() {
+  return /** @type {!proto.api_container_api.ServiceHealthStatus} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceHealthStatus} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setStatus = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 num_restarts = 2;
+ * @return {number}
+ */
+proto.api_container_api.ServiceHealth.prototype.getNumRestarts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setNumRestarts = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string last_failure_reason = 3;
+ * @return {string}
+ */
+proto.api_container_api.ServiceHealth.prototype.getLastFailureReason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setLastFailureReason = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional int64 last_failure_timestamp_ms = 4;
+ * @return {number}
+ */
+proto.api_container_api.ServiceHealth.prototype.getLastFailureTimestampMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ServiceHealth} returns this
+ */
+proto.api_container_api.ServiceHealth.prototype.setLastFailureTimestampMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6,7];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    gitHostsList: jspb.Message.toObjectList(msg.getGitHostsList(),
+    proto.api_container_api.GitHost.toObject, includeInstance),
+    offline: jspb.Message.getBooleanFieldWithDefault(msg, 8, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
+  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 6:
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
+      break;
+    case 7:
+      var value = new proto.api_container_api.GitHost;
+      reader.readMessage(value,proto.api_container_api.GitHost.deserializeBinaryFromReader);
+      msg.addGitHosts(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOffline(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeInt32(
+      4,
+      f
+    );
+  }
+  f = message.getMainFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      6,
+      f
+    );
+  }
+  f = message.getGitHostsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      7,
+      f,
+      proto.api_container_api.GitHost.serializeBinaryToWriter
+    );
+  }
+  f = message.getOffline();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_script = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string serialized_params = 2;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional bool dry_run = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 3, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 3, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional int32 parallelism = 4;
+ * @return {number}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 4, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 4, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string main_function_name = 5;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated KurtosisFeatureFlag experimental_features = 6;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
+};
+
+
+/**
+ * repeated GitHost git_hosts = 7;
+ * @return {!Array<!proto.api_container_api.GitHost>}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getGitHostsList = function() {
+  return /** @type{!Array<!proto.api_container_api.GitHost>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.GitHost, 7));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.GitHost>} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+*/
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setGitHostsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 7, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GitHost=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GitHost}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.addGitHosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 7, opt_value, proto.api_container_api.GitHost, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.clearGitHostsList = function() {
+  return this.setGitHostsList([]);
+};
+
+
+/**
+ * optional bool offline = 8;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.getOffline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ */
+proto.api_container_api.RunStarlarkScriptArgs.prototype.setOffline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11,12,14];
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
+  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
+  LOCAL: 3,
+  REMOTE: 4
+};
+
+/**
+ * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
+  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    local: msg.getLocal_asB64(),
+    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
+    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 9, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 10, ""),
+    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f,
+    locallyReplacedPackageIdsList: (f = jspb.Message.getRepeatedField(msg, 12)) == null ? undefined : f,
+    prune: jspb.Message.getBooleanFieldWithDefault(msg, 13, false),
+    gitHostsList: jspb.Message.toObjectList(msg.getGitHostsList(),
+    proto.api_container_api.GitHost.toObject, includeInstance),
+    offline: jspb.Message.getBooleanFieldWithDefault(msg, 15, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
+  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
+      break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setLocal(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setRemote(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 6:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDryRun(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setParallelism(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setClonePackage(value);
+      break;
+    case 9:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
+      break;
+    case 10:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 11:
+      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
+      for (var i = 0; i < values.length; i++) {
+        msg.addExperimentalFeatures(values[i]);
+      }
+      break;
+    case 12:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLocallyReplacedPackageIds(value);
+      break;
+    case 13:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPrune(value);
+      break;
+    case 14:
+      var value = new proto.api_container_api.GitHost;
+      reader.readMessage(value,proto.api_container_api.GitHost.deserializeBinaryFromReader);
+      msg.addGitHosts(value);
+      break;
+    case 15:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOffline(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPackageId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
+  if (f != null) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
+  if (f != null) {
+    writer.writeBool(
+      6,
+      f
+    );
+  }
+  f = /** @type {number} */ (jspb.Message.getField(message, 7));
+  if (f != null) {
+    writer.writeInt32(
+      7,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
+  if (f != null) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getRelativePathToMainFile();
+  if (f.length > 0) {
+    writer.writeString(
+      9,
+      f
+    );
+  }
+  f = message.getMainFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
+      10,
+      f
+    );
+  }
+  f = message.getExperimentalFeaturesList();
+  if (f.length > 0) {
+    writer.writePackedEnum(
+      11,
+      f
+    );
+  }
+  f = message.getLocallyReplacedPackageIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      12,
+      f
+    );
+  }
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 13));
+  if (f != null) {
+    writer.writeBool(
+      13,
+      f
+    );
+  }
+  f = message.getGitHostsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      14,
+      f,
+      proto.api_container_api.GitHost.serializeBinaryToWriter
+    );
+  }
+  f = message.getOffline();
+  if (f) {
+    writer.writeBool(
+      15,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string package_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional bytes local = 3;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getLocal()));
+};
+
+
+/**
+ * optional bytes local = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getLocal()`
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getLocal()));
+};
+
+
+/**
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
+  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional bool remote = 4;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
+  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional string serialized_params = 5;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * optional bool dry_run = 6;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
+  return jspb.Message.setField(this, 6, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
+  return jspb.Message.setField(this, 6, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional int32 parallelism = 7;
+ * @return {number}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
+  return jspb.Message.setField(this, 7, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
+  return jspb.Message.setField(this, 7, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional bool clone_package = 8;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getClonePackage = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setClonePackage = function(value) {
+  return jspb.Message.setField(this, 8, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearClonePackage = function() {
+  return jspb.Message.setField(this, 8, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasClonePackage = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional string relative_path_to_main_file = 9;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 9, value);
+};
+
+
+/**
+ * optional string main_function_name = 10;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 10, value);
+};
+
+
+/**
+ * repeated KurtosisFeatureFlag experimental_features = 11;
+ * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getExperimentalFeaturesList = function() {
+  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 11));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setExperimentalFeaturesList = function(value) {
+  return jspb.Message.setField(this, 11, value || []);
+};
+
+
+/**
+ * @param {!proto.api_container_api.KurtosisFeatureFlag} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearExperimentalFeaturesList = function() {
+  return this.setExperimentalFeaturesList([]);
+};
+
+
+/**
+ * repeated string locally_replaced_package_ids = 12;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocallyReplacedPackageIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 12));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocallyReplacedPackageIdsList = function(value) {
+  return jspb.Message.setField(this, 12, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.addLocallyReplacedPackageIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 12, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocallyReplacedPackageIdsList = function() {
+  return this.setLocallyReplacedPackageIdsList([]);
+};
+
+
+/**
+ * optional bool prune = 13;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getPrune = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 13, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setPrune = function(value) {
+  return jspb.Message.setField(this, 13, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearPrune = function() {
+  return jspb.Message.setField(this, 13, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.hasPrune = function() {
+  return jspb.Message.getField(this, 13) != null;
+};
+
+
+/**
+ * repeated GitHost git_hosts = 14;
+ * @return {!Array<!proto.api_container_api.GitHost>}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getGitHostsList = function() {
+  return /** @type{!Array<!proto.api_container_api.GitHost>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.GitHost, 14));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.GitHost>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+*/
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setGitHostsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 14, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GitHost=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GitHost}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.addGitHosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 14, opt_value, proto.api_container_api.GitHost, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.clearGitHostsList = function() {
+  return this.setGitHostsList([]);
+};
+
+
+/**
+ * optional bool offline = 15;
+ * @return {boolean}
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.getOffline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 15, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageArgs.prototype.setOffline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 15, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6,7,8,9]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
+  RUN_RESPONSE_LINE_NOT_SET: 0,
+  INSTRUCTION: 1,
+  ERROR: 2,
+  PROGRESS_INFO: 3,
+  INSTRUCTION_RESULT: 4,
+  RUN_FINISHED_EVENT: 5,
+  WARNING: 6,
+  INSTRUCTION_METRICS: 7,
+  RUN_STARTED_EVENT: 8,
+  INFO: 9
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
+  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
+    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
+    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
+    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
+    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
+    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f),
+    instructionMetrics: (f = msg.getInstructionMetrics()) && proto.api_container_api.StarlarkInstructionMetrics.toObject(includeInstance, f),
+    runStartedEvent: (f = msg.getRunStartedEvent()) && proto.api_container_api.StarlarkRunStartedEvent.toObject(includeInstance, f),
+    info: (f = msg.getInfo()) && proto.api_container_api.StarlarkInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunResponseLine;
+  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ */
+proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstruction;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
+      msg.setInstruction(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkError;
+      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
+      msg.setError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkRunProgress;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
+      msg.setProgressInfo(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.StarlarkInstructionResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
+      msg.setInstructionResult(value);
+      break;
+    case 5:
+      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
+      msg.setRunFinishedEvent(value);
+      break;
+    case 6:
+      var value = new proto.api_container_api.StarlarkWarning;
+      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
+      msg.setWarning(value);
+      break;
+    case 7:
+      var value = new proto.api_container_api.StarlarkInstructionMetrics;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionMetrics.deserializeBinaryFromReader);
+      msg.setInstructionMetrics(value);
+      break;
+    case 8:
+      var value = new proto.api_container_api.StarlarkRunStartedEvent;
+      reader.readMessage(value,proto.api_container_api.StarlarkRunStartedEvent.deserializeBinaryFromReader);
+      msg.setRunStartedEvent(value);
+      break;
+    case 9:
+      var value = new proto.api_container_api.StarlarkInfo;
+      reader.readMessage(value,proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader);
+      msg.setInfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInstruction();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
+    );
+  }
+  f = message.getError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkError.serializeBinaryToWriter
+    );
+  }
+  f = message.getProgressInfo();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionResult();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunFinishedEvent();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getWarning();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionMetrics();
+  if (f != null) {
+    writer.writeMessage(
+      7,
+      f,
+      proto.api_container_api.StarlarkInstructionMetrics.serializeBinaryToWriter
+    );
+  }
+  f = message.getRunStartedEvent();
+  if (f != null) {
+    writer.writeMessage(
+      8,
+      f,
+      proto.api_container_api.StarlarkRunStartedEvent.serializeBinaryToWriter
+    );
+  }
+  f = message.getInfo();
+  if (f != null) {
+    writer.writeMessage(
+      9,
+      f,
+      proto.api_container_api.StarlarkInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstruction instruction = 1;
+ * @return {?proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
+  return this.setInstruction(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkError error = 2;
+ * @return {?proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
+  return /** @type{?proto.api_container_api.StarlarkError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkError|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
+  return this.setError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkRunProgress progress_info = 3;
+ * @return {?proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
+  return this.setProgressInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+/**
+ * optional StarlarkInstructionResult instruction_result = 4;
+ * @return {?proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
+  return this.setInstructionResult(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
+/**
+ * optional StarlarkRunFinishedEvent run_finished_event = 5;
+ * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
+  return this.setRunFinishedEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional StarlarkWarning warning = 6;
+ * @return {?proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
+  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkWarning|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
+  return this.setWarning(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional StarlarkInstructionMetrics instruction_metrics = 7;
+ * @return {?proto.api_container_api.StarlarkInstructionMetrics}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionMetrics = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionMetrics} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionMetrics, 7));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionMetrics|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionMetrics = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 7, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionMetrics = function() {
+  return this.setInstructionMetrics(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionMetrics = function() {
+  return jspb.Message.getField(this, 7) != null;
+};
+
+
+/**
+ * optional StarlarkRunStartedEvent run_started_event = 8;
+ * @return {?proto.api_container_api.StarlarkRunStartedEvent}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getRunStartedEvent = function() {
+  return /** @type{?proto.api_container_api.StarlarkRunStartedEvent} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunStartedEvent, 8));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkRunStartedEvent|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setRunStartedEvent = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 8, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunStartedEvent = function() {
+  return this.setRunStartedEvent(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunStartedEvent = function() {
+  return jspb.Message.getField(this, 8) != null;
+};
+
+
+/**
+ * optional StarlarkInfo info = 9;
+ * @return {?proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.getInfo = function() {
+  return /** @type{?proto.api_container_api.StarlarkInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInfo, 9));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInfo|undefined} value
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+*/
+proto.api_container_api.StarlarkRunResponseLine.prototype.setInfo = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 9, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.clearInfo = function() {
+  return this.setInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunResponseLine.prototype.hasInfo = function() {
+  return jspb.Message.getField(this, 9) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkWarning;
+  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkWarning}
+ */
+proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setWarningMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkWarning} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getWarningMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string warning_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkWarning} returns this
+ */
+proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInfo.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInfo} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInfo.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    infoMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkInfo.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInfo;
+  return proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInfo} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInfo}
+ */
+proto.api_container_api.StarlarkInfo.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInfoMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInfoMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string info_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInfo.prototype.getInfoMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInfo} returns this
+ */
+proto.api_container_api.StarlarkInfo.prototype.setInfoMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
+    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
+    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
+    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstruction;
+  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstruction}
+ */
+proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInstructionPosition;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
+      msg.setPosition(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setInstructionName(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkInstructionArg;
+      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
+      msg.addArguments(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setExecutableInstruction(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsSkipped(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPosition();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getArgumentsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutableInstruction();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getIsSkipped();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInstructionPosition position = 1;
+ * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
+  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
+  return this.setPosition(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional string instruction_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated StarlarkInstructionArg arguments = 3;
+ * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+*/
+proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
+  return this.setArgumentsList([]);
+};
+
+
+/**
+ * optional string executable_instruction = 4;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional bool is_skipped = 5;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ */
+proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionResult;
+  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionResult}
+ */
+proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedInstructionResult(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionResult} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedInstructionResult();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_instruction_result = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ */
+proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionMetrics.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionMetrics} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionMetrics.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    startTimestampMs: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    endTimestampMs: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    durationMs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    imagePullDurationMs: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    numRetries: jspb.Message.getFieldWithDefault(msg, 5, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionMetrics}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionMetrics;
+  return proto.api_container_api.StarlarkInstructionMetrics.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionMetrics} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionMetrics}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setStartTimestampMs(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt64());
+      msg.setEndTimestampMs(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDurationMs(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setImagePullDurationMs(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumRetries(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionMetrics.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionMetrics} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionMetrics.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getStartTimestampMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      1,
+      f
+    );
+  }
+  f = message.getEndTimestampMs();
+  if (f !== 0) {
+    writer.writeInt64(
+      2,
+      f
+    );
+  }
+  f = message.getDurationMs();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
+  f = message.getImagePullDurationMs();
+  if (f !== 0) {
+    writer.writeUint64(
+      4,
+      f
+    );
+  }
+  f = message.getNumRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int64 start_timestamp_ms = 1;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.getStartTimestampMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionMetrics} returns this
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.setStartTimestampMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional int64 end_timestamp_ms = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.getEndTimestampMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionMetrics} returns this
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.setEndTimestampMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint64 duration_ms = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.getDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionMetrics} returns this
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.setDurationMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+/**
+ * optional uint64 image_pull_duration_ms = 4;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.getImagePullDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionMetrics} returns this
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.setImagePullDurationMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 num_retries = 5;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.getNumRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionMetrics} returns this
+ */
+proto.api_container_api.StarlarkInstructionMetrics.prototype.setNumRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionArg;
+  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionArg}
+ */
+proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedArgValue(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setArgName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRepresentative(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getSerializedArgValue();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getIsRepresentative();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string serialized_arg_value = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string arg_name = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional bool is_representative = 3;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ */
+proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInstructionPosition;
+  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ */
+proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFilename(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setLine(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setColumn(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFilename();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getLine();
+  if (f !== 0) {
+    writer.writeInt32(
+      2,
+      f
+    );
+  }
+  f = message.getColumn();
+  if (f !== 0) {
+    writer.writeInt32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string filename = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional int32 line = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional int32 column = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ */
+proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+/**
+ * Oneof group definitions for this message. Each group defines the field
+ * numbers belonging to that group. When of these fields' value is set, all
+ * other fields in the group are cleared. During deserialization, if multiple
+ * fields are encountered for a group, only the last value seen will be kept.
+ * @private {!Array<!Array<number>>}
+ * @const
+ */
+proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.StarlarkError.ErrorCase = {
+  ERROR_NOT_SET: 0,
+  INTERPRETATION_ERROR: 1,
+  VALIDATION_ERROR: 2,
+  EXECUTION_ERROR: 3
+};
+
+/**
+ * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ */
+proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
+  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
+    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkError;
+  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkError}
+ */
+proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.StarlarkValidationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
+      msg.setValidationError(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.StarlarkExecutionError;
+      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
+      msg.setExecutionError(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getValidationError();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
+    );
+  }
+  f = message.getExecutionError();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
+};
+
+
+/**
+ * optional StarlarkValidationError validation_error = 2;
+ * @return {?proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
+  return this.setValidationError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+/**
+ * optional StarlarkExecutionError execution_error = 3;
+ * @return {?proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
+  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
+ * @return {!proto.api_container_api.StarlarkError} returns this
+*/
+proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
+  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StarlarkError} returns this
+ */
+proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
+  return this.setExecutionError(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkInterpretationError;
+  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkInterpretationError}
+ */
+proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ */
+proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkValidationError;
+  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkValidationError}
+ */
+proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ */
+proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkExecutionError;
+  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkExecutionError}
+ */
+proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setErrorMessage(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getErrorMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string error_message = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ */
+proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunProgress;
+  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunProgress}
+ */
+proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCurrentStepInfo(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setTotalSteps(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setCurrentStepNumber(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getCurrentStepInfoList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      1,
+      f
+    );
+  }
+  f = message.getTotalSteps();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getCurrentStepNumber();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * repeated string current_step_info = 1;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
+  return this.setCurrentStepInfoList([]);
+};
+
+
+/**
+ * optional uint32 total_steps = 2;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 current_step_number = 3;
+ * @return {number}
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ */
+proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
+
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunStartedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunStartedEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunStartedEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunStartedEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    runId: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunStartedEvent}
+ */
+proto.api_container_api.StarlarkRunStartedEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunStartedEvent;
+  return proto.api_container_api.StarlarkRunStartedEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunStartedEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunStartedEvent}
+ */
+proto.api_container_api.StarlarkRunStartedEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRunId(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunStartedEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunStartedEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunStartedEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunStartedEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getRunId();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string run_id = 1;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkRunStartedEvent.prototype.getRunId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunStartedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunStartedEvent.prototype.setRunId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
+    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
+  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIsRunSuccessful(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedOutput(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIsRunSuccessful();
+  if (f) {
+    writer.writeBool(
+      1,
+      f
+    );
+  }
+  f = /** @type {string} */ (jspb.Message.getField(message, 2));
+  if (f != null) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional bool is_run_successful = 1;
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 1, value);
+};
+
+
+/**
+ * optional string serialized_output = 2;
+ * @return {string}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
+  return jspb.Message.setField(this, 2, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
+  return jspb.Message.setField(this, 2, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesArgs}
+ */
+proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesArgs;
+  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesArgs}
+ */
+proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceIdentifiersMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifiersMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
+  }
+};
+
+
+/**
+ * map<string, bool> service_identifiers = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,boolean>}
+ */
+proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,boolean>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesArgs} returns this
+ */
+proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
+  this.getServiceIdentifiersMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetServicesResponse}
+ */
+proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetServicesResponse;
+  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetServicesResponse}
+ */
+proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getServiceInfoMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceInfoMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  }
+};
+
+
+/**
+ * map<string, ServiceInfo> service_info = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ */
+proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      proto.api_container_api.ServiceInfo));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.api_container_api.GetServicesResponse} returns this
+ */
+proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
+  this.getServiceInfoMap().clear();
+  return this;};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
+ */
+proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ServiceIdentifiers;
+  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ServiceIdentifiers}
+ */
+proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setShortenedUuid(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getShortenedUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string name = 2;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string shortened_uuid = 3;
+ * @return {string}
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ */
+proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
+    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
+  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.ServiceIdentifiers;
+      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
+      msg.addAllidentifiers(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getAllidentifiersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * repeated ServiceIdentifiers allIdentifiers = 1;
+ * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+*/
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceIdentifiers}
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ */
+proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
+  return this.setAllidentifiersList([]);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ExecCommandArgs}
+ */
+proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ExecCommandArgs;
+  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ExecCommandArgs}
+ */
+proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addCommandArgs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCommandArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated string command_args = 2;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ */
+proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
+  return this.setCommandArgsList([]);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ExecCommandResponse}
+ */
+proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ExecCommandResponse;
+  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ExecCommandResponse}
+ */
+proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setExitCode(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setLogOutput(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ExecCommandResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getExitCode();
+  if (f !== 0) {
+    writer.writeInt32(
+      1,
+      f
+    );
+  }
+  f = message.getLogOutput();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional int32 exit_code = 1;
+ * @return {number}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ */
+proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * optional string log_output = 2;
+ * @return {string}
+ */
+proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ */
+proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string path = 3;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional uint32 initial_delay_milliseconds = 4;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 retries = 5;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 retries_delay_milliseconds = 6;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional string body_text = 7;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
+    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
+    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
+  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPort(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPath(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRequestBody(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInitialDelayMilliseconds(value);
+      break;
+    case 6:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetries(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setRetriesDelayMilliseconds(value);
+      break;
+    case 8:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBodyText(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPort();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getPath();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getRequestBody();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getInitialDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      5,
+      f
+    );
+  }
+  f = message.getRetries();
+  if (f !== 0) {
+    writer.writeUint32(
+      6,
+      f
+    );
+  }
+  f = message.getRetriesDelayMilliseconds();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
+  f = message.getBodyText();
+  if (f.length > 0) {
+    writer.writeString(
+      8,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 port = 2;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional string path = 3;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string request_body = 4;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional uint32 initial_delay_milliseconds = 5;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
+};
+
+
+/**
+ * optional uint32 retries = 6;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
+  return jspb.Message.setProto3IntField(this, 6, value);
+};
+
+
+/**
+ * optional uint32 retries_delay_milliseconds = 7;
+ * @return {number}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
+/**
+ * optional string body_text = 8;
+ * @return {string}
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ */
+proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
+  return jspb.Message.setProto3StringField(this, 8, value);
+};
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.HttpRequestServiceArgs.repeatedFields_ = [5,6];
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpRequestServiceArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.HttpRequestServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    portId: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    method: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    endpoint: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    headersList: jspb.Message.toObjectList(msg.getHeadersList(),
+    proto.api_container_api.HttpHeader.toObject, includeInstance),
+    queryParamsList: jspb.Message.toObjectList(msg.getQueryParamsList(),
+    proto.api_container_api.HttpQueryParam.toObject, includeInstance),
+    body: jspb.Message.getFieldWithDefault(msg, 7, ""),
+    useTls: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    skipTlsVerify: jspb.Message.getBooleanFieldWithDefault(msg, 9, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.HttpRequestServiceArgs}
+ */
+proto.api_container_api.HttpRequestServiceArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.HttpRequestServiceArgs;
+  return proto.api_container_api.HttpRequestServiceArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.HttpRequestServiceArgs}
+ */
+proto.api_container_api.HttpRequestServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPortId(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMethod(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEndpoint(value);
+      break;
+    case 5:
+      var value = new proto.api_container_api.HttpHeader;
+      reader.readMessage(value,proto.api_container_api.HttpHeader.deserializeBinaryFromReader);
+      msg.addHeaders(value);
+      break;
+    case 6:
+      var value = new proto.api_container_api.HttpQueryParam;
+      reader.readMessage(value,proto.api_container_api.HttpQueryParam.deserializeBinaryFromReader);
+      msg.addQueryParams(value);
+      break;
+    case 7:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setBody(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setUseTls(value);
+      break;
+    case 9:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setSkipTlsVerify(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.HttpRequestServiceArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.HttpRequestServiceArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.HttpRequestServiceArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getPortId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMethod();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getEndpoint();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getHeadersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      5,
+      f,
+      proto.api_container_api.HttpHeader.serializeBinaryToWriter
+    );
+  }
+  f = message.getQueryParamsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      6,
+      f,
+      proto.api_container_api.HttpQueryParam.serializeBinaryToWriter
+    );
+  }
+  f = message.getBody();
+  if (f.length > 0) {
+    writer.writeString(
+      7,
+      f
+    );
+  }
+  f = message.getUseTls();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getSkipTlsVerify();
+  if (f) {
+    writer.writeBool(
+      9,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string service_identifier = 1;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string port_id = 2;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getPortId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setPortId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string method = 3;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getMethod = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setMethod = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string endpoint = 4;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getEndpoint = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setEndpoint = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * repeated HttpHeader headers = 5;
+ * @return {!Array<!proto.api_container_api.HttpHeader>}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getHeadersList = function() {
+  return /** @type{!Array<!proto.api_container_api.HttpHeader>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.HttpHeader, 5));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.HttpHeader>} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+*/
+proto.api_container_api.HttpRequestServiceArgs.prototype.setHeadersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 5, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.HttpHeader=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.HttpHeader}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.addHeaders = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 5, opt_value, proto.api_container_api.HttpHeader, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.clearHeadersList = function() {
+  return this.setHeadersList([]);
+};
+
+
+/**
+ * repeated HttpQueryParam query_params = 6;
+ * @return {!Array<!proto.api_container_api.HttpQueryParam>}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getQueryParamsList = function() {
+  return /** @type{!Array<!proto.api_container_api.HttpQueryParam>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.HttpQueryParam, 6));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.HttpQueryParam>} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+*/
+proto.api_container_api.HttpRequestServiceArgs.prototype.setQueryParamsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 6, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.HttpQueryParam=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.HttpQueryParam}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.addQueryParams = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 6, opt_value, proto.api_container_api.HttpQueryParam, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.clearQueryParamsList = function() {
+  return this.setQueryParamsList([]);
+};
+
+
+/**
+ * optional string body = 7;
+ * @return {string}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 7, value);
+};
+
+
+/**
+ * optional bool use_tls = 8;
+ * @return {boolean}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getUseTls = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setUseTls = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
+};
+
+
+/**
+ * optional bool skip_tls_verify = 9;
+ * @return {boolean}
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.getSkipTlsVerify = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 9, false));
+};
+
+
+/**
+ * @param {boolean} value
+ * @return {!proto.api_container_api.HttpRequestServiceArgs} returns this
+ */
+proto.api_container_api.HttpRequestServiceArgs.prototype.setSkipTlsVerify = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 9, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.HttpRequestServiceResponse.repeatedFields_ = [2];
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.HttpRequestServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpRequestServiceResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.HttpRequestServiceResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.HttpRequestServiceResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    statusCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    headersList: jspb.Message.toObjectList(msg.getHeadersList(),
+    proto.api_container_api.HttpHeader.toObject, includeInstance),
+    body: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.HttpRequestServiceResponse}
+ */
+proto.api_container_api.HttpRequestServiceResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.HttpRequestServiceResponse;
+  return proto.api_container_api.HttpRequestServiceResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.HttpRequestServiceResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.HttpRequestServiceResponse}
+ */
+proto.api_container_api.HttpRequestServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
       break;
-    case 2:
-      var value = /** @type {!proto.api_container_api.Port.TransportProtocol} */ (reader.readEnum());
-      msg.setTransportProtocol(value);
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readInt32());
+      msg.setStatusCode(value);
       break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaybeApplicationProtocol(value);
+    case 2:
+      var value = new proto.api_container_api.HttpHeader;
+      reader.readMessage(value,proto.api_container_api.HttpHeader.deserializeBinaryFromReader);
+      msg.addHeaders(value);
       break;
-    case 4:
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setMaybeWaitTimeout(value);
+      msg.setBody(value);
       break;
     default:
       reader.skipField();
@@ -989,9 +8883,9 @@ proto.api_container_api.Port.deserializeBinaryFromReader = function(msg, reader)
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.Port.prototype.serializeBinary = function() {
+proto.api_container_api.HttpRequestServiceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.Port.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.HttpRequestServiceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -999,121 +8893,108 @@ proto.api_container_api.Port.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.Port} message
+ * @param {!proto.api_container_api.HttpRequestServiceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.Port.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.HttpRequestServiceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getNumber();
+  f = message.getStatusCode();
   if (f !== 0) {
-    writer.writeUint32(
+    writer.writeInt32(
       1,
       f
     );
   }
-  f = message.getTransportProtocol();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getHeadersList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
       2,
-      f
+      f,
+      proto.api_container_api.HttpHeader.serializeBinaryToWriter
     );
   }
-  f = message.getMaybeApplicationProtocol();
+  f = message.getBody();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getMaybeWaitTimeout();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
 };
 
 
 /**
- * @enum {number}
- */
-proto.api_container_api.Port.TransportProtocol = {
-  TCP: 0,
-  SCTP: 1,
-  UDP: 2
-};
-
-/**
- * optional uint32 number = 1;
+ * optional int32 status_code = 1;
  * @return {number}
  */
-proto.api_container_api.Port.prototype.getNumber = function() {
+proto.api_container_api.HttpRequestServiceResponse.prototype.getStatusCode = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.Port} returns this
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
  */
-proto.api_container_api.Port.prototype.setNumber = function(value) {
+proto.api_container_api.HttpRequestServiceResponse.prototype.setStatusCode = function(value) {
   return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional TransportProtocol transport_protocol = 2;
- * @return {!proto.api_container_api.Port.TransportProtocol}
+ * repeated HttpHeader headers = 2;
+ * @return {!Array<!proto.api_container_api.HttpHeader>}
  */
-proto.api_container_api.Port.prototype.getTransportProtocol = function() {
-  return /** @type {!proto.api_container_api.Port.TransportProtocol} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.HttpRequestServiceResponse.prototype.getHeadersList = function() {
+  return /** @type{!Array<!proto.api_container_api.HttpHeader>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.HttpHeader, 2));
 };
 
 
 /**
- * @param {!proto.api_container_api.Port.TransportProtocol} value
- * @return {!proto.api_container_api.Port} returns this
- */
-proto.api_container_api.Port.prototype.setTransportProtocol = function(value) {
-  return jspb.Message.setProto3EnumField(this, 2, value);
+ * @param {!Array<!proto.api_container_api.HttpHeader>} value
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
+*/
+proto.api_container_api.HttpRequestServiceResponse.prototype.setHeadersList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
 
 
 /**
- * optional string maybe_application_protocol = 3;
- * @return {string}
+ * @param {!proto.api_container_api.HttpHeader=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.HttpHeader}
  */
-proto.api_container_api.Port.prototype.getMaybeApplicationProtocol = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.HttpRequestServiceResponse.prototype.addHeaders = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.HttpHeader, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.Port} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
  */
-proto.api_container_api.Port.prototype.setMaybeApplicationProtocol = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.HttpRequestServiceResponse.prototype.clearHeadersList = function() {
+  return this.setHeadersList([]);
 };
 
 
 /**
- * optional string maybe_wait_timeout = 4;
+ * optional string body = 3;
  * @return {string}
  */
-proto.api_container_api.Port.prototype.getMaybeWaitTimeout = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.HttpRequestServiceResponse.prototype.getBody = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.Port} returns this
+ * @return {!proto.api_container_api.HttpRequestServiceResponse} returns this
  */
-proto.api_container_api.Port.prototype.setMaybeWaitTimeout = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.HttpRequestServiceResponse.prototype.setBody = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -1133,8 +9014,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceInfo.toObject(opt_includeInstance, this);
+proto.api_container_api.HttpHeader.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpHeader.toObject(opt_includeInstance, this);
 };
 
 
@@ -1143,20 +9024,14 @@ proto.api_container_api.ServiceInfo.prototype.toObject = function(opt_includeIns
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceInfo} msg The msg instance to transform.
+ * @param {!proto.api_container_api.HttpHeader} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
+proto.api_container_api.HttpHeader.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    privateIpAddr: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    privatePortsMap: (f = msg.getPrivatePortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    maybePublicIpAddr: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    maybePublicPortsMap: (f = msg.getMaybePublicPortsMap()) ? f.toObject(includeInstance, proto.api_container_api.Port.toObject) : [],
-    name: jspb.Message.getFieldWithDefault(msg, 6, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 7, ""),
-    serviceStatus: jspb.Message.getFieldWithDefault(msg, 8, 0)
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1170,23 +9045,23 @@ proto.api_container_api.ServiceInfo.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceInfo}
+ * @return {!proto.api_container_api.HttpHeader}
  */
-proto.api_container_api.ServiceInfo.deserializeBinary = function(bytes) {
+proto.api_container_api.HttpHeader.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceInfo;
-  return proto.api_container_api.ServiceInfo.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.HttpHeader;
+  return proto.api_container_api.HttpHeader.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceInfo} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.HttpHeader} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceInfo}
+ * @return {!proto.api_container_api.HttpHeader}
  */
-proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.HttpHeader.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1194,40 +9069,12 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPrivateIpAddr(value);
-      break;
-    case 3:
-      var value = msg.getPrivatePortsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
-         });
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMaybePublicIpAddr(value);
-      break;
-    case 5:
-      var value = msg.getMaybePublicPortsMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.Port.deserializeBinaryFromReader, "", new proto.api_container_api.Port());
-         });
-      break;
-    case 6:
       var value = /** @type {string} */ (reader.readString());
       msg.setName(value);
       break;
-    case 7:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
-      break;
-    case 8:
-      var value = /** @type {!proto.api_container_api.ServiceStatus} */ (reader.readEnum());
-      msg.setServiceStatus(value);
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -1242,9 +9089,9 @@ proto.api_container_api.ServiceInfo.deserializeBinaryFromReader = function(msg,
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
+proto.api_container_api.HttpHeader.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceInfo.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.HttpHeader.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1252,225 +9099,66 @@ proto.api_container_api.ServiceInfo.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceInfo} message
+ * @param {!proto.api_container_api.HttpHeader} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceInfo.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.HttpHeader.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPrivateIpAddr();
+  f = message.getValue();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getPrivatePortsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getMaybePublicIpAddr();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getMaybePublicPortsMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(5, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.Port.serializeBinaryToWriter);
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      6,
-      f
-    );
-  }
-  f = message.getShortenedUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      7,
-      f
-    );
-  }
-  f = message.getServiceStatus();
-  if (f !== 0.0) {
-    writer.writeEnum(
-      8,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string service_uuid = 1;
- * @return {string}
- */
-proto.api_container_api.ServiceInfo.prototype.getServiceUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.setServiceUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string private_ip_addr = 2;
- * @return {string}
- */
-proto.api_container_api.ServiceInfo.prototype.getPrivateIpAddr = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.setPrivateIpAddr = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-/**
- * map<string, Port> private_ports = 3;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
- */
-proto.api_container_api.ServiceInfo.prototype.getPrivatePortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
-      proto.api_container_api.Port));
-};
-
-
-/**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.clearPrivatePortsMap = function() {
-  this.getPrivatePortsMap().clear();
-  return this;};
-
-
-/**
- * optional string maybe_public_ip_addr = 4;
- * @return {string}
- */
-proto.api_container_api.ServiceInfo.prototype.getMaybePublicIpAddr = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.setMaybePublicIpAddr = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * map<string, Port> maybe_public_ports = 5;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.Port>}
- */
-proto.api_container_api.ServiceInfo.prototype.getMaybePublicPortsMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.Port>} */ (
-      jspb.Message.getMapField(this, 5, opt_noLazyCreate,
-      proto.api_container_api.Port));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.clearMaybePublicPortsMap = function() {
-  this.getMaybePublicPortsMap().clear();
-  return this;};
-
-
-/**
- * optional string name = 6;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceInfo.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 6, ""));
+proto.api_container_api.HttpHeader.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * @return {!proto.api_container_api.HttpHeader} returns this
  */
-proto.api_container_api.ServiceInfo.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 6, value);
+proto.api_container_api.HttpHeader.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string shortened_uuid = 7;
+ * optional string value = 2;
  * @return {string}
  */
-proto.api_container_api.ServiceInfo.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.HttpHeader.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
- */
-proto.api_container_api.ServiceInfo.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
-};
-
-
-/**
- * optional ServiceStatus service_status = 8;
- * @return {!proto.api_container_api.ServiceStatus}
- */
-proto.api_container_api.ServiceInfo.prototype.getServiceStatus = function() {
-  return /** @type {!proto.api_container_api.ServiceStatus} */ (jspb.Message.getFieldWithDefault(this, 8, 0));
-};
-
-
-/**
- * @param {!proto.api_container_api.ServiceStatus} value
- * @return {!proto.api_container_api.ServiceInfo} returns this
+ * @return {!proto.api_container_api.HttpHeader} returns this
  */
-proto.api_container_api.ServiceInfo.prototype.setServiceStatus = function(value) {
-  return jspb.Message.setProto3EnumField(this, 8, value);
+proto.api_container_api.HttpHeader.prototype.setValue = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.RunStarlarkScriptArgs.repeatedFields_ = [6];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -1486,8 +9174,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkScriptArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.HttpQueryParam.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.HttpQueryParam.toObject(opt_includeInstance, this);
 };
 
 
@@ -1496,18 +9184,14 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.toObject = function(opt_
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.HttpQueryParam} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.HttpQueryParam.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1521,23 +9205,23 @@ proto.api_container_api.RunStarlarkScriptArgs.toObject = function(includeInstanc
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.HttpQueryParam}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.HttpQueryParam.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkScriptArgs;
-  return proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.HttpQueryParam;
+  return proto.api_container_api.HttpQueryParam.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.HttpQueryParam} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs}
+ * @return {!proto.api_container_api.HttpQueryParam}
  */
-proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.HttpQueryParam.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1546,29 +9230,11 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedScript(value);
+      msg.setName(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
-      break;
-    case 4:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
-      break;
-    case 6:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
+      msg.setValue(value);
       break;
     default:
       reader.skipField();
@@ -1583,9 +9249,9 @@ proto.api_container_api.RunStarlarkScriptArgs.deserializeBinaryFromReader = func
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = function() {
+proto.api_container_api.HttpQueryParam.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.HttpQueryParam.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1593,253 +9259,302 @@ proto.api_container_api.RunStarlarkScriptArgs.prototype.serializeBinary = functi
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkScriptArgs} message
+ * @param {!proto.api_container_api.HttpQueryParam} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.HttpQueryParam.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedScript();
+  f = message.getName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSerializedParams();
+  f = message.getValue();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBool(
-      3,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeInt32(
-      4,
-      f
-    );
-  }
-  f = message.getMainFunctionName();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      6,
-      f
-    );
-  }
 };
 
 
 /**
- * optional string serialized_script = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedScript = function() {
+proto.api_container_api.HttpQueryParam.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @return {!proto.api_container_api.HttpQueryParam} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedScript = function(value) {
+proto.api_container_api.HttpQueryParam.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_params = 2;
+ * optional string value = 2;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getSerializedParams = function() {
+proto.api_container_api.HttpQueryParam.prototype.getValue = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @return {!proto.api_container_api.HttpQueryParam} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setSerializedParams = function(value) {
+proto.api_container_api.HttpQueryParam.prototype.setValue = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional bool dry_run = 3;
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 3, value);
+proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    data: msg.getData_asB64(),
+    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 3, undefined);
+proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StreamedDataChunk;
+  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StreamedDataChunk}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setData(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPreviousChunkHash(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.DataChunkMetadata;
+      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
+      msg.setMetadata(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional int32 parallelism = 4;
- * @return {number}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StreamedDataChunk} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 4, value);
+proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getData_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      1,
+      f
+    );
+  }
+  f = message.getPreviousChunkHash();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getMetadata();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * optional bytes data = 1;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 4, undefined);
+proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional bytes data = 1;
+ * This is a type-conversion wrapper around `getData()`
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getData()));
 };
 
 
 /**
- * optional string main_function_name = 5;
- * @return {string}
+ * optional bytes data = 1;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getData()`
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getData()));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {!(string|Uint8Array)} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
+  return jspb.Message.setProto3BytesField(this, 1, value);
 };
 
 
 /**
- * repeated KurtosisFeatureFlag experimental_features = 6;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ * optional string previous_chunk_hash = 2;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 6));
+proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 6, value || []);
+proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
+ * optional DataChunkMetadata metadata = 3;
+ * @return {?proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
+  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.RunStarlarkScriptArgs} returns this
- */
-proto.api_container_api.RunStarlarkScriptArgs.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+ * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
+*/
+proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
 };
 
 
-
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.StreamedDataChunk} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.repeatedFields_ = [11];
+proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
+  return this.setMetadata(undefined);
+};
 
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_ = [[3,4]];
 
 /**
- * @enum {number}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase = {
-  STARLARK_PACKAGE_CONTENT_NOT_SET: 0,
-  LOCAL: 3,
-  REMOTE: 4
+proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
-/**
- * @return {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getStarlarkPackageContentCase = function() {
-  return /** @type {proto.api_container_api.RunStarlarkPackageArgs.StarlarkPackageContentCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0]));
-};
+
 
 
 
@@ -1856,8 +9571,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.RunStarlarkPackageArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
 };
 
 
@@ -1866,22 +9581,13 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
   var f, obj = {
-    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    local: msg.getLocal_asB64(),
-    remote: jspb.Message.getBooleanFieldWithDefault(msg, 4, false),
-    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
-    dryRun: jspb.Message.getBooleanFieldWithDefault(msg, 6, false),
-    parallelism: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
-    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 9, ""),
-    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 10, ""),
-    experimentalFeaturesList: (f = jspb.Message.getRepeatedField(msg, 11)) == null ? undefined : f
+    name: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -1895,23 +9601,23 @@ proto.api_container_api.RunStarlarkPackageArgs.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.RunStarlarkPackageArgs;
-  return proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DataChunkMetadata;
+  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs}
+ * @return {!proto.api_container_api.DataChunkMetadata}
  */
-proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -1920,45 +9626,7 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPackageId(value);
-      break;
-    case 3:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setLocal(value);
-      break;
-    case 4:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setRemote(value);
-      break;
-    case 5:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedParams(value);
-      break;
-    case 6:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setDryRun(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setParallelism(value);
-      break;
-    case 8:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setClonePackage(value);
-      break;
-    case 9:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRelativePathToMainFile(value);
-      break;
-    case 10:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setMainFunctionName(value);
-      break;
-    case 11:
-      var values = /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (reader.isDelimited() ? reader.readPackedEnum() : [reader.readEnum()]);
-      for (var i = 0; i < values.length; i++) {
-        msg.addExperimentalFeatures(values[i]);
-      }
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -1973,9 +9641,9 @@ proto.api_container_api.RunStarlarkPackageArgs.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = function() {
+proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -1983,79 +9651,16 @@ proto.api_container_api.RunStarlarkPackageArgs.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.RunStarlarkPackageArgs} message
+ * @param {!proto.api_container_api.DataChunkMetadata} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPackageId();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
-  f = /** @type {!(string|Uint8Array)} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
-    writer.writeBytes(
-      3,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 4));
-  if (f != null) {
-    writer.writeBool(
-      4,
-      f
-    );
-  }
-  f = message.getSerializedParams();
-  if (f.length > 0) {
-    writer.writeString(
-      5,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 6));
-  if (f != null) {
-    writer.writeBool(
-      6,
-      f
-    );
-  }
-  f = /** @type {number} */ (jspb.Message.getField(message, 7));
-  if (f != null) {
-    writer.writeInt32(
-      7,
-      f
-    );
-  }
-  f = /** @type {boolean} */ (jspb.Message.getField(message, 8));
-  if (f != null) {
-    writer.writeBool(
-      8,
-      f
-    );
-  }
-  f = message.getRelativePathToMainFile();
-  if (f.length > 0) {
-    writer.writeString(
-      9,
-      f
-    );
-  }
-  f = message.getMainFunctionName();
-  if (f.length > 0) {
-    writer.writeString(
-      10,
-      f
-    );
-  }
-  f = message.getExperimentalFeaturesList();
-  if (f.length > 0) {
-    writer.writePackedEnum(
-      11,
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
       f
     );
   }
@@ -2063,348 +9668,473 @@ proto.api_container_api.RunStarlarkPackageArgs.serializeBinaryToWriter = functio
 
 
 /**
- * optional string package_id = 1;
+ * optional string name = 1;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getPackageId = function() {
+proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.DataChunkMetadata} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setPackageId = function(value) {
+proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * optional bytes local = 3;
- * @return {string}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
 
-/**
- * optional bytes local = 3;
- * This is a type-conversion wrapper around `getLocal()`
- * @return {string}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getLocal()));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * optional bytes local = 3;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getLocal()`
- * @return {!Uint8Array}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getLocal_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getLocal()));
+proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setLocal = function(value) {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearLocal = function() {
-  return jspb.Message.setOneofField(this, 3, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
+  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasLocal = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * optional bool remote = 4;
- * @return {boolean}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRemote = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 4, false));
+proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRemote = function(value) {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], value);
+proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearRemote = function() {
-  return jspb.Message.setOneofField(this, 4, proto.api_container_api.RunStarlarkPackageArgs.oneofGroups_[0], undefined);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasRemote = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_params = 5;
+ * optional string name = 2;
  * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getSerializedParams = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setSerializedParams = function(value) {
-  return jspb.Message.setProto3StringField(this, 5, value);
+proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
-/**
- * optional bool dry_run = 6;
- * @return {boolean}
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getDryRun = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 6, false));
-};
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setDryRun = function(value) {
-  return jspb.Message.setField(this, 6, value);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearDryRun = function() {
-  return jspb.Message.setField(this, 6, undefined);
+proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasDryRun = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
+  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional int32 parallelism = 7;
- * @return {number}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getParallelism = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setParallelism = function(value) {
-  return jspb.Message.setField(this, 7, value);
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearParallelism = function() {
-  return jspb.Message.setField(this, 7, undefined);
+proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasParallelism = function() {
-  return jspb.Message.getField(this, 7) != null;
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bool clone_package = 8;
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getClonePackage = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
+proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * @param {boolean} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setClonePackage = function(value) {
-  return jspb.Message.setField(this, 8, value);
-};
-
 
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
- */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearClonePackage = function() {
-  return jspb.Message.setField(this, 8, undefined);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.hasClonePackage = function() {
-  return jspb.Message.getField(this, 8) != null;
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional string relative_path_to_main_file = 9;
- * @return {string}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getRelativePathToMainFile = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 9, ""));
+proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setRelativePathToMainFile = function(value) {
-  return jspb.Message.setProto3StringField(this, 9, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
+  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional string main_function_name = 10;
- * @return {string}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getMainFunctionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 10, ""));
+proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUrl(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setMainFunctionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 10, value);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * repeated KurtosisFeatureFlag experimental_features = 11;
- * @return {!Array<!proto.api_container_api.KurtosisFeatureFlag>}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.getExperimentalFeaturesList = function() {
-  return /** @type {!Array<!proto.api_container_api.KurtosisFeatureFlag>} */ (jspb.Message.getRepeatedField(this, 11));
+proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getUrl();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.KurtosisFeatureFlag>} value
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * optional string url = 1;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.setExperimentalFeaturesList = function(value) {
-  return jspb.Message.setField(this, 11, value || []);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.api_container_api.KurtosisFeatureFlag} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.addExperimentalFeatures = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 11, value, opt_index);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.RunStarlarkPackageArgs} returns this
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.RunStarlarkPackageArgs.prototype.clearExperimentalFeaturesList = function() {
-  return this.setExperimentalFeaturesList([]);
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
-
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.api_container_api.StarlarkRunResponseLine.oneofGroups_ = [[1,2,3,4,5,6]];
-
 /**
- * @enum {number}
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase = {
-  RUN_RESPONSE_LINE_NOT_SET: 0,
-  INSTRUCTION: 1,
-  ERROR: 2,
-  PROGRESS_INFO: 3,
-  INSTRUCTION_RESULT: 4,
-  RUN_FINISHED_EVENT: 5,
-  WARNING: 6
+proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
-/**
- * @return {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunResponseLineCase = function() {
-  return /** @type {proto.api_container_api.StarlarkRunResponseLine.RunResponseLineCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0]));
-};
+
 
 
 
@@ -2421,8 +10151,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunResponseLine.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2431,18 +10161,13 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    instruction: (f = msg.getInstruction()) && proto.api_container_api.StarlarkInstruction.toObject(includeInstance, f),
-    error: (f = msg.getError()) && proto.api_container_api.StarlarkError.toObject(includeInstance, f),
-    progressInfo: (f = msg.getProgressInfo()) && proto.api_container_api.StarlarkRunProgress.toObject(includeInstance, f),
-    instructionResult: (f = msg.getInstructionResult()) && proto.api_container_api.StarlarkInstructionResult.toObject(includeInstance, f),
-    runFinishedEvent: (f = msg.getRunFinishedEvent()) && proto.api_container_api.StarlarkRunFinishedEvent.toObject(includeInstance, f),
-    warning: (f = msg.getWarning()) && proto.api_container_api.StarlarkWarning.toObject(includeInstance, f)
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2456,23 +10181,23 @@ proto.api_container_api.StarlarkRunResponseLine.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunResponseLine;
-  return proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
+  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunResponseLine}
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
  */
-proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2480,34 +10205,8 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstruction;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader);
-      msg.setInstruction(value);
-      break;
-    case 2:
-      var value = new proto.api_container_api.StarlarkError;
-      reader.readMessage(value,proto.api_container_api.StarlarkError.deserializeBinaryFromReader);
-      msg.setError(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkRunProgress;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader);
-      msg.setProgressInfo(value);
-      break;
-    case 4:
-      var value = new proto.api_container_api.StarlarkInstructionResult;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader);
-      msg.setInstructionResult(value);
-      break;
-    case 5:
-      var value = new proto.api_container_api.StarlarkRunFinishedEvent;
-      reader.readMessage(value,proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader);
-      msg.setRunFinishedEvent(value);
-      break;
-    case 6:
-      var value = new proto.api_container_api.StarlarkWarning;
-      reader.readMessage(value,proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader);
-      msg.setWarning(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -2522,9 +10221,9 @@ proto.api_container_api.StarlarkRunResponseLine.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = function() {
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2532,282 +10231,227 @@ proto.api_container_api.StarlarkRunResponseLine.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunResponseLine} message
+ * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInstruction();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter
-    );
-  }
-  f = message.getError();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.api_container_api.StarlarkError.serializeBinaryToWriter
-    );
-  }
-  f = message.getProgressInfo();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionResult();
-  if (f != null) {
-    writer.writeMessage(
-      4,
-      f,
-      proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter
-    );
-  }
-  f = message.getRunFinishedEvent();
-  if (f != null) {
-    writer.writeMessage(
-      5,
-      f,
-      proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter
-    );
-  }
-  f = message.getWarning();
-  if (f != null) {
-    writer.writeMessage(
-      6,
-      f,
-      proto.api_container_api.StarlarkWarning.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional StarlarkInstruction instruction = 1;
- * @return {?proto.api_container_api.StarlarkInstruction}
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstruction = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstruction} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstruction, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInstruction|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstruction = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
-
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstruction = function() {
-  return this.setInstruction(undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstruction = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional StarlarkError error = 2;
- * @return {?proto.api_container_api.StarlarkError}
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getError = function() {
-  return /** @type{?proto.api_container_api.StarlarkError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkError, 2));
+proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
-/**
- * @param {?proto.api_container_api.StarlarkError|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
 
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearError = function() {
-  return this.setError(undefined);
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasError = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * optional StarlarkRunProgress progress_info = 3;
- * @return {?proto.api_container_api.StarlarkRunProgress}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getProgressInfo = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunProgress} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunProgress, 3));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkRunProgress|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setProgressInfo = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
-};
-
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+  };
 
-/**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
- */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearProgressInfo = function() {
-  return this.setProgressInfo(undefined);
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasProgressInfo = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
+  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * optional StarlarkInstructionResult instruction_result = 4;
- * @return {?proto.api_container_api.StarlarkInstructionResult}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getInstructionResult = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionResult} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionResult, 4));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInstructionResult|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setInstructionResult = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 4, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceIdentifier(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSourcePath(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearInstructionResult = function() {
-  return this.setInstructionResult(undefined);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasInstructionResult = function() {
-  return jspb.Message.getField(this, 4) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getServiceIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getSourcePath();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional StarlarkRunFinishedEvent run_finished_event = 5;
- * @return {?proto.api_container_api.StarlarkRunFinishedEvent}
+ * optional string service_identifier = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getRunFinishedEvent = function() {
-  return /** @type{?proto.api_container_api.StarlarkRunFinishedEvent} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkRunFinishedEvent, 5));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkRunFinishedEvent|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setRunFinishedEvent = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 5, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearRunFinishedEvent = function() {
-  return this.setRunFinishedEvent(undefined);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * optional string source_path = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasRunFinishedEvent = function() {
-  return jspb.Message.getField(this, 5) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional StarlarkWarning warning = 6;
- * @return {?proto.api_container_api.StarlarkWarning}
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.getWarning = function() {
-  return /** @type{?proto.api_container_api.StarlarkWarning} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkWarning, 6));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkWarning|undefined} value
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
-*/
-proto.api_container_api.StarlarkRunResponseLine.prototype.setWarning = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 6, proto.api_container_api.StarlarkRunResponseLine.oneofGroups_[0], value);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunResponseLine} returns this
+ * optional string name = 3;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.clearWarning = function() {
-  return this.setWarning(undefined);
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
  */
-proto.api_container_api.StarlarkRunResponseLine.prototype.hasWarning = function() {
-  return jspb.Message.getField(this, 6) != null;
+proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -2827,8 +10471,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkWarning.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -2837,13 +10481,13 @@ proto.api_container_api.StarlarkWarning.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkWarning} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    warningMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -2857,23 +10501,23 @@ proto.api_container_api.StarlarkWarning.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.StarlarkWarning.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkWarning;
-  return proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
+  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkWarning} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkWarning}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
  */
-proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -2882,7 +10526,7 @@ proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(m
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setWarningMessage(value);
+      msg.setUuid(value);
       break;
     default:
       reader.skipField();
@@ -2897,9 +10541,9 @@ proto.api_container_api.StarlarkWarning.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkWarning.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -2907,13 +10551,13 @@ proto.api_container_api.StarlarkWarning.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkWarning} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getWarningMessage();
+  f = message.getUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -2924,31 +10568,24 @@ proto.api_container_api.StarlarkWarning.serializeBinaryToWriter = function(messa
 
 
 /**
- * optional string warning_message = 1;
+ * optional string uuid = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkWarning.prototype.getWarningMessage = function() {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkWarning} returns this
+ * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
  */
-proto.api_container_api.StarlarkWarning.prototype.setWarningMessage = function(value) {
+proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.StarlarkInstruction.repeatedFields_ = [3];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -2964,8 +10601,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstruction.toObject(opt_includeInstance, this);
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
 };
 
 
@@ -2974,18 +10611,14 @@ proto.api_container_api.StarlarkInstruction.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstruction} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance, msg) {
+proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
   var f, obj = {
-    position: (f = msg.getPosition()) && proto.api_container_api.StarlarkInstructionPosition.toObject(includeInstance, f),
-    instructionName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    argumentsList: jspb.Message.toObjectList(msg.getArgumentsList(),
-    proto.api_container_api.StarlarkInstructionArg.toObject, includeInstance),
-    executableInstruction: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    isSkipped: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
+    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -2999,23 +10632,23 @@ proto.api_container_api.StarlarkInstruction.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinary = function(bytes) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstruction;
-  return proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
+  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstruction} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstruction}
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3023,26 +10656,12 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInstructionPosition;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader);
-      msg.setPosition(value);
-      break;
-    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setInstructionName(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkInstructionArg;
-      reader.readMessage(value,proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader);
-      msg.addArguments(value);
+      msg.setFilename(value);
       break;
-    case 4:
+    case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setExecutableInstruction(value);
-      break;
-    case 5:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsSkipped(value);
+      msg.setFileuuid(value);
       break;
     default:
       reader.skipField();
@@ -3057,9 +10676,9 @@ proto.api_container_api.StarlarkInstruction.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function() {
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3067,46 +10686,23 @@ proto.api_container_api.StarlarkInstruction.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstruction} message
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPosition();
-  if (f != null) {
-    writer.writeMessage(
-      1,
-      f,
-      proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter
-    );
-  }
-  f = message.getInstructionName();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getArgumentsList();
-  if (f.length > 0) {
-    writer.writeRepeatedMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecutableInstruction();
+  f = message.getFilename();
   if (f.length > 0) {
     writer.writeString(
-      4,
+      1,
       f
     );
-  }
-  f = message.getIsSkipped();
-  if (f) {
-    writer.writeBool(
-      5,
+  }
+  f = message.getFileuuid();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
       f
     );
   }
@@ -3114,131 +10710,198 @@ proto.api_container_api.StarlarkInstruction.serializeBinaryToWriter = function(m
 
 
 /**
- * optional StarlarkInstructionPosition position = 1;
- * @return {?proto.api_container_api.StarlarkInstructionPosition}
+ * optional string fileName = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getPosition = function() {
-  return /** @type{?proto.api_container_api.StarlarkInstructionPosition} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInstructionPosition, 1));
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkInstructionPosition|undefined} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setPosition = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ */
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * optional string fileUuid = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearPosition = function() {
-  return this.setPosition(undefined);
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {string} value
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.hasPosition = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
+
 /**
- * optional string instruction_name = 2;
- * @return {string}
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.StarlarkInstruction.prototype.getInstructionName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
  */
-proto.api_container_api.StarlarkInstruction.prototype.setInstructionName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * repeated StarlarkInstructionArg arguments = 3;
- * @return {!Array<!proto.api_container_api.StarlarkInstructionArg>}
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.prototype.getArgumentsList = function() {
-  return /** @type{!Array<!proto.api_container_api.StarlarkInstructionArg>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkInstructionArg, 3));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
+    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * @param {!Array<!proto.api_container_api.StarlarkInstructionArg>} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
-*/
-proto.api_container_api.StarlarkInstruction.prototype.setArgumentsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 3, value);
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ */
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
+  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {!proto.api_container_api.StarlarkInstructionArg=} opt_value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
  */
-proto.api_container_api.StarlarkInstruction.prototype.addArguments = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 3, opt_value, proto.api_container_api.StarlarkInstructionArg, opt_index);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.addFileNamesAndUuids(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstruction.prototype.clearArgumentsList = function() {
-  return this.setArgumentsList([]);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * optional string executable_instruction = 4;
- * @return {string}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstruction.prototype.getExecutableInstruction = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFileNamesAndUuidsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      1,
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
+ * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
  */
-proto.api_container_api.StarlarkInstruction.prototype.setExecutableInstruction = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
 };
 
 
 /**
- * optional bool is_skipped = 5;
- * @return {boolean}
+ * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+*/
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.StarlarkInstruction.prototype.getIsSkipped = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstruction} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
  */
-proto.api_container_api.StarlarkInstruction.prototype.setIsSkipped = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 5, value);
+proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
+  return this.setFileNamesAndUuidsList([]);
 };
 
 
@@ -3258,8 +10921,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionResult.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
 };
 
 
@@ -3268,13 +10931,13 @@ proto.api_container_api.StarlarkInstructionResult.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionResult.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedInstructionResult: jspb.Message.getFieldWithDefault(msg, 1, "")
+    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3288,23 +10951,23 @@ proto.api_container_api.StarlarkInstructionResult.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionResult;
-  return proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
+  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionResult} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionResult}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
  */
-proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3312,8 +10975,9 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedInstructionResult(value);
+      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
+      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
+      msg.setFileNamesAndUuid(value);
       break;
     default:
       reader.skipField();
@@ -3325,54 +10989,81 @@ proto.api_container_api.StarlarkInstructionResult.deserializeBinaryFromReader =
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getFileNamesAndUuid();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
+ * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
+  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionResult} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.StarlarkInstructionResult.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getSerializedInstructionResult();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+ * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_instruction_result = 1;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.getSerializedInstructionResult = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
+  return this.setFileNamesAndUuid(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionResult} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInstructionResult.prototype.setSerializedInstructionResult = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -3388,8 +11079,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionArg.toObject(opt_includeInstance, this);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -3398,15 +11089,14 @@ proto.api_container_api.StarlarkInstructionArg.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The msg instance to transform.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstance, msg) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serializedArgValue: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    argName: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    isRepresentative: jspb.Message.getBooleanFieldWithDefault(msg, 3, false)
+    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
+    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3420,23 +11110,23 @@ proto.api_container_api.StarlarkInstructionArg.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinary = function(bytes) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionArg;
-  return proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
+  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionArg} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionArg}
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
  */
-proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3444,16 +11134,9 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedArgValue(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setArgName(value);
-      break;
-    case 3:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRepresentative(value);
+      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
+      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
+      msg.addFileDescriptions(value);
       break;
     default:
       reader.skipField();
@@ -3468,9 +11151,9 @@ proto.api_container_api.StarlarkInstructionArg.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = function() {
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3478,105 +11161,58 @@ proto.api_container_api.StarlarkInstructionArg.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionArg} message
+ * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionArg.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getSerializedArgValue();
+  f = message.getFileDescriptionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getIsRepresentative();
-  if (f) {
-    writer.writeBool(
-      3,
-      f
+      f,
+      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string serialized_arg_value = 1;
- * @return {string}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.getSerializedArgValue = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.setSerializedArgValue = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional string arg_name = 2;
- * @return {string}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.getArgName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.setArgName = function(value) {
-  return jspb.Message.setField(this, 2, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * repeated FileArtifactContentsFileDescription file_descriptions = 1;
+ * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.clearArgName = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkInstructionArg.prototype.hasArgName = function() {
-  return jspb.Message.getField(this, 2) != null;
+ * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+*/
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * optional bool is_representative = 3;
- * @return {boolean}
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.getIsRepresentative = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkInstructionArg} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
  */
-proto.api_container_api.StarlarkInstructionArg.prototype.setIsRepresentative = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 3, value);
+proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
+  return this.setFileDescriptionsList([]);
 };
 
 
@@ -3596,8 +11232,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInstructionPosition.toObject(opt_includeInstance, this);
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
 };
 
 
@@ -3606,15 +11242,15 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The msg instance to transform.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeInstance, msg) {
+proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    line: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    column: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -3628,23 +11264,23 @@ proto.api_container_api.StarlarkInstructionPosition.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinary = function(bytes) {
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInstructionPosition;
-  return proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
+  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInstructionPosition}
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
  */
-proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3653,15 +11289,15 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
+      msg.setPath(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setLine(value);
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setSize(value);
       break;
     case 3:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setColumn(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setTextPreview(value);
       break;
     default:
       reader.skipField();
@@ -3676,9 +11312,9 @@ proto.api_container_api.StarlarkInstructionPosition.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3686,29 +11322,29 @@ proto.api_container_api.StarlarkInstructionPosition.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInstructionPosition} message
+ * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
+  f = message.getPath();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getLine();
+  f = message.getSize();
   if (f !== 0) {
-    writer.writeInt32(
+    writer.writeUint64(
       2,
       f
     );
   }
-  f = message.getColumn();
-  if (f !== 0) {
-    writer.writeInt32(
+  f = /** @type {string} */ (jspb.Message.getField(message, 3));
+  if (f != null) {
+    writer.writeString(
       3,
       f
     );
@@ -3717,89 +11353,80 @@ proto.api_container_api.StarlarkInstructionPosition.serializeBinaryToWriter = fu
 
 
 /**
- * optional string filename = 1;
+ * optional string path = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getFilename = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setFilename = function(value) {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional int32 line = 2;
+ * optional uint64 size = 2;
  * @return {number}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getLine = function() {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
   return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
  * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setLine = function(value) {
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
   return jspb.Message.setProto3IntField(this, 2, value);
 };
 
 
 /**
- * optional int32 column = 3;
- * @return {number}
+ * optional string text_preview = 3;
+ * @return {string}
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.getColumn = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkInstructionPosition} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.StarlarkInstructionPosition.prototype.setColumn = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
+  return jspb.Message.setField(this, 3, value);
 };
 
 
-
-/**
- * Oneof group definitions for this message. Each group defines the field
- * numbers belonging to that group. When of these fields' value is set, all
- * other fields in the group are cleared. During deserialization, if multiple
- * fields are encountered for a group, only the last value seen will be kept.
- * @private {!Array<!Array<number>>}
- * @const
- */
-proto.api_container_api.StarlarkError.oneofGroups_ = [[1,2,3]];
-
 /**
- * @enum {number}
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
  */
-proto.api_container_api.StarlarkError.ErrorCase = {
-  ERROR_NOT_SET: 0,
-  INTERPRETATION_ERROR: 1,
-  VALIDATION_ERROR: 2,
-  EXECUTION_ERROR: 3
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
+  return jspb.Message.setField(this, 3, undefined);
 };
 
+
 /**
- * @return {proto.api_container_api.StarlarkError.ErrorCase}
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkError.prototype.getErrorCase = function() {
-  return /** @type {proto.api_container_api.StarlarkError.ErrorCase} */(jspb.Message.computeOneofCase(this, proto.api_container_api.StarlarkError.oneofGroups_[0]));
+proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
 
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -3813,8 +11440,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkError.toObject(opt_includeInstance, this);
+proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -3823,15 +11450,13 @@ proto.api_container_api.StarlarkError.prototype.toObject = function(opt_includeI
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg) {
+proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
-    validationError: (f = msg.getValidationError()) && proto.api_container_api.StarlarkValidationError.toObject(includeInstance, f),
-    executionError: (f = msg.getExecutionError()) && proto.api_container_api.StarlarkExecutionError.toObject(includeInstance, f)
+    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
   };
 
   if (includeInstance) {
@@ -3845,23 +11470,23 @@ proto.api_container_api.StarlarkError.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.ConnectServicesArgs}
  */
-proto.api_container_api.StarlarkError.deserializeBinary = function(bytes) {
+proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkError;
-  return proto.api_container_api.StarlarkError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ConnectServicesArgs;
+  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkError}
+ * @return {!proto.api_container_api.ConnectServicesArgs}
  */
-proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -3869,19 +11494,8 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.StarlarkInterpretationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
-      msg.setInterpretationError(value);
-      break;
-    case 2:
-      var value = new proto.api_container_api.StarlarkValidationError;
-      reader.readMessage(value,proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader);
-      msg.setValidationError(value);
-      break;
-    case 3:
-      var value = new proto.api_container_api.StarlarkExecutionError;
-      reader.readMessage(value,proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader);
-      msg.setExecutionError(value);
+      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
+      msg.setConnect(value);
       break;
     default:
       reader.skipField();
@@ -3896,9 +11510,9 @@ proto.api_container_api.StarlarkError.deserializeBinaryFromReader = function(msg
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
+proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -3906,153 +11520,143 @@ proto.api_container_api.StarlarkError.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkError} message
+ * @param {!proto.api_container_api.ConnectServicesArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getInterpretationError();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getConnect();
+  if (f !== 0.0) {
+    writer.writeEnum(
       1,
-      f,
-      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
-    );
-  }
-  f = message.getValidationError();
-  if (f != null) {
-    writer.writeMessage(
-      2,
-      f,
-      proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter
-    );
-  }
-  f = message.getExecutionError();
-  if (f != null) {
-    writer.writeMessage(
-      3,
-      f,
-      proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * optional StarlarkInterpretationError interpretation_error = 1;
- * @return {?proto.api_container_api.StarlarkInterpretationError}
+ * optional Connect connect = 1;
+ * @return {!proto.api_container_api.Connect}
  */
-proto.api_container_api.StarlarkError.prototype.getInterpretationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
-};
-
-
-/**
- * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setInterpretationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 1, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
+  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * @param {!proto.api_container_api.Connect} value
+ * @return {!proto.api_container_api.ConnectServicesArgs} returns this
  */
-proto.api_container_api.StarlarkError.prototype.clearInterpretationError = function() {
-  return this.setInterpretationError(undefined);
+proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
+  return jspb.Message.setProto3EnumField(this, 1, value);
 };
 
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkError.prototype.hasInterpretationError = function() {
-  return jspb.Message.getField(this, 1) != null;
-};
-
 
-/**
- * optional StarlarkValidationError validation_error = 2;
- * @return {?proto.api_container_api.StarlarkValidationError}
- */
-proto.api_container_api.StarlarkError.prototype.getValidationError = function() {
-  return /** @type{?proto.api_container_api.StarlarkValidationError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkValidationError, 2));
-};
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * @param {?proto.api_container_api.StarlarkValidationError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setValidationError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 2, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.prototype.clearValidationError = function() {
-  return this.setValidationError(undefined);
-};
+proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
 
+  };
 
-/**
- * Returns whether this field is set.
- * @return {boolean}
- */
-proto.api_container_api.StarlarkError.prototype.hasValidationError = function() {
-  return jspb.Message.getField(this, 2) != null;
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
 };
+}
 
 
 /**
- * optional StarlarkExecutionError execution_error = 3;
- * @return {?proto.api_container_api.StarlarkExecutionError}
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.api_container_api.ConnectServicesResponse}
  */
-proto.api_container_api.StarlarkError.prototype.getExecutionError = function() {
-  return /** @type{?proto.api_container_api.StarlarkExecutionError} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkExecutionError, 3));
+proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.api_container_api.ConnectServicesResponse;
+  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
- * @param {?proto.api_container_api.StarlarkExecutionError|undefined} value
- * @return {!proto.api_container_api.StarlarkError} returns this
-*/
-proto.api_container_api.StarlarkError.prototype.setExecutionError = function(value) {
-  return jspb.Message.setOneofWrapperField(this, 3, proto.api_container_api.StarlarkError.oneofGroups_[0], value);
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.api_container_api.ConnectServicesResponse}
+ */
+proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StarlarkError} returns this
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkError.prototype.clearExecutionError = function() {
-  return this.setExecutionError(undefined);
+proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.ConnectServicesResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkError.prototype.hasExecutionError = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
 };
 
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -4066,8 +11670,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkInterpretationError.toObject(opt_includeInstance, this);
+proto.api_container_api.GetEnclaveSnapshotArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetEnclaveSnapshotArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4076,13 +11680,13 @@ proto.api_container_api.StarlarkInterpretationError.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetEnclaveSnapshotArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetEnclaveSnapshotArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    includePersistentDirectories: jspb.Message.getBooleanFieldWithDefault(msg, 1, false)
   };
 
   if (includeInstance) {
@@ -4096,23 +11700,23 @@ proto.api_container_api.StarlarkInterpretationError.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.GetEnclaveSnapshotArgs}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinary = function(bytes) {
+proto.api_container_api.GetEnclaveSnapshotArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkInterpretationError;
-  return proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetEnclaveSnapshotArgs;
+  return proto.api_container_api.GetEnclaveSnapshotArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkInterpretationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetEnclaveSnapshotArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkInterpretationError}
+ * @return {!proto.api_container_api.GetEnclaveSnapshotArgs}
  */
-proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetEnclaveSnapshotArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4120,8 +11724,8 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIncludePersistentDirectories(value);
       break;
     default:
       reader.skipField();
@@ -4136,9 +11740,9 @@ proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary = function() {
+proto.api_container_api.GetEnclaveSnapshotArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetEnclaveSnapshotArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4146,15 +11750,15 @@ proto.api_container_api.StarlarkInterpretationError.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkInterpretationError} message
+ * @param {!proto.api_container_api.GetEnclaveSnapshotArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetEnclaveSnapshotArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
-  if (f.length > 0) {
-    writer.writeString(
+  f = /** @type {boolean} */ (jspb.Message.getField(message, 1));
+  if (f != null) {
+    writer.writeBool(
       1,
       f
     );
@@ -4163,20 +11767,38 @@ proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter = fu
 
 
 /**
- * optional string error_message = 1;
- * @return {string}
+ * optional bool include_persistent_directories = 1;
+ * @return {boolean}
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetEnclaveSnapshotArgs.prototype.getIncludePersistentDirectories = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkInterpretationError} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetEnclaveSnapshotArgs} returns this
  */
-proto.api_container_api.StarlarkInterpretationError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetEnclaveSnapshotArgs.prototype.setIncludePersistentDirectories = function(value) {
+  return jspb.Message.setField(this, 1, value);
+};
+
+
+/**
+ * Clears the field making it undefined.
+ * @return {!proto.api_container_api.GetEnclaveSnapshotArgs} returns this
+ */
+proto.api_container_api.GetEnclaveSnapshotArgs.prototype.clearIncludePersistentDirectories = function() {
+  return jspb.Message.setField(this, 1, undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.GetEnclaveSnapshotArgs.prototype.hasIncludePersistentDirectories = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
@@ -4196,8 +11818,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkValidationError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkValidationError.toObject(opt_includeInstance, this);
+proto.api_container_api.RestoreEnclaveSnapshotResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RestoreEnclaveSnapshotResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -4206,13 +11828,14 @@ proto.api_container_api.StarlarkValidationError.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkValidationError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.toObject = function(includeInstance, msg) {
+proto.api_container_api.RestoreEnclaveSnapshotResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    serializedReplayScript: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    numRestoredFilesArtifacts: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -4226,23 +11849,23 @@ proto.api_container_api.StarlarkValidationError.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotResponse}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinary = function(bytes) {
+proto.api_container_api.RestoreEnclaveSnapshotResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkValidationError;
-  return proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RestoreEnclaveSnapshotResponse;
+  return proto.api_container_api.RestoreEnclaveSnapshotResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkValidationError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkValidationError}
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotResponse}
  */
-proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RestoreEnclaveSnapshotResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4251,7 +11874,11 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      msg.setSerializedReplayScript(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumRestoredFilesArtifacts(value);
       break;
     default:
       reader.skipField();
@@ -4266,9 +11893,9 @@ proto.api_container_api.StarlarkValidationError.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = function() {
+proto.api_container_api.RestoreEnclaveSnapshotResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RestoreEnclaveSnapshotResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4276,40 +11903,73 @@ proto.api_container_api.StarlarkValidationError.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkValidationError} message
+ * @param {!proto.api_container_api.RestoreEnclaveSnapshotResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkValidationError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RestoreEnclaveSnapshotResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getSerializedReplayScript();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
+  f = message.getNumRestoredFilesArtifacts();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string error_message = 1;
+ * optional string serialized_replay_script = 1;
  * @return {string}
  */
-proto.api_container_api.StarlarkValidationError.prototype.getErrorMessage = function() {
+proto.api_container_api.RestoreEnclaveSnapshotResponse.prototype.getSerializedReplayScript = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkValidationError} returns this
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotResponse} returns this
  */
-proto.api_container_api.StarlarkValidationError.prototype.setErrorMessage = function(value) {
+proto.api_container_api.RestoreEnclaveSnapshotResponse.prototype.setSerializedReplayScript = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
+/**
+ * optional uint32 num_restored_files_artifacts = 2;
+ * @return {number}
+ */
+proto.api_container_api.RestoreEnclaveSnapshotResponse.prototype.getNumRestoredFilesArtifacts = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.RestoreEnclaveSnapshotResponse} returns this
+ */
+proto.api_container_api.RestoreEnclaveSnapshotResponse.prototype.setNumRestoredFilesArtifacts = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RepartitionArgs.repeatedFields_ = [1,2];
+
 
 
 
@@ -4326,8 +11986,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkExecutionError.toObject(opt_includeInstance, this);
+proto.api_container_api.RepartitionArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RepartitionArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -4336,13 +11996,17 @@ proto.api_container_api.StarlarkExecutionError.prototype.toObject = function(opt
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RepartitionArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstance, msg) {
+proto.api_container_api.RepartitionArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    errorMessage: jspb.Message.getFieldWithDefault(msg, 1, "")
+    servicePartitionsList: jspb.Message.toObjectList(msg.getServicePartitionsList(),
+    proto.api_container_api.ServicePartition.toObject, includeInstance),
+    partitionConnectionsList: jspb.Message.toObjectList(msg.getPartitionConnectionsList(),
+    proto.api_container_api.PartitionConnection.toObject, includeInstance),
+    defaultConnection: (f = msg.getDefaultConnection()) && proto.api_container_api.PartitionConnectionInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4356,23 +12020,23 @@ proto.api_container_api.StarlarkExecutionError.toObject = function(includeInstan
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.RepartitionArgs}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinary = function(bytes) {
+proto.api_container_api.RepartitionArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkExecutionError;
-  return proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RepartitionArgs;
+  return proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkExecutionError} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RepartitionArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkExecutionError}
+ * @return {!proto.api_container_api.RepartitionArgs}
  */
-proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RepartitionArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4380,8 +12044,19 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setErrorMessage(value);
+      var value = new proto.api_container_api.ServicePartition;
+      reader.readMessage(value,proto.api_container_api.ServicePartition.deserializeBinaryFromReader);
+      msg.addServicePartitions(value);
+      break;
+    case 2:
+      var value = new proto.api_container_api.PartitionConnection;
+      reader.readMessage(value,proto.api_container_api.PartitionConnection.deserializeBinaryFromReader);
+      msg.addPartitionConnections(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.PartitionConnectionInfo;
+      reader.readMessage(value,proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader);
+      msg.setDefaultConnection(value);
       break;
     default:
       reader.skipField();
@@ -4396,9 +12071,9 @@ proto.api_container_api.StarlarkExecutionError.deserializeBinaryFromReader = fun
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = function() {
+proto.api_container_api.RepartitionArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RepartitionArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4406,47 +12081,152 @@ proto.api_container_api.StarlarkExecutionError.prototype.serializeBinary = funct
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkExecutionError} message
+ * @param {!proto.api_container_api.RepartitionArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkExecutionError.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RepartitionArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getErrorMessage();
+  f = message.getServicePartitionsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       1,
-      f
+      f,
+      proto.api_container_api.ServicePartition.serializeBinaryToWriter
+    );
+  }
+  f = message.getPartitionConnectionsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.api_container_api.PartitionConnection.serializeBinaryToWriter
+    );
+  }
+  f = message.getDefaultConnection();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * optional string error_message = 1;
- * @return {string}
+ * repeated ServicePartition service_partitions = 1;
+ * @return {!Array<!proto.api_container_api.ServicePartition>}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.getErrorMessage = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.RepartitionArgs.prototype.getServicePartitionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServicePartition>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServicePartition, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StarlarkExecutionError} returns this
+ * @param {!Array<!proto.api_container_api.ServicePartition>} value
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+*/
+proto.api_container_api.RepartitionArgs.prototype.setServicePartitionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.ServicePartition=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServicePartition}
  */
-proto.api_container_api.StarlarkExecutionError.prototype.setErrorMessage = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.RepartitionArgs.prototype.addServicePartitions = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServicePartition, opt_index);
 };
 
 
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+ */
+proto.api_container_api.RepartitionArgs.prototype.clearServicePartitionsList = function() {
+  return this.setServicePartitionsList([]);
+};
+
 
 /**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
+ * repeated PartitionConnection partition_connections = 2;
+ * @return {!Array<!proto.api_container_api.PartitionConnection>}
  */
-proto.api_container_api.StarlarkRunProgress.repeatedFields_ = [1];
+proto.api_container_api.RepartitionArgs.prototype.getPartitionConnectionsList = function() {
+  return /** @type{!Array<!proto.api_container_api.PartitionConnection>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.PartitionConnection, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.api_container_api.PartitionConnection>} value
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+*/
+proto.api_container_api.RepartitionArgs.prototype.setPartitionConnectionsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.PartitionConnection=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.PartitionConnection}
+ */
+proto.api_container_api.RepartitionArgs.prototype.addPartitionConnections = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.PartitionConnection, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+ */
+proto.api_container_api.RepartitionArgs.prototype.clearPartitionConnectionsList = function() {
+  return this.setPartitionConnectionsList([]);
+};
+
+
+/**
+ * optional PartitionConnectionInfo default_connection = 3;
+ * @return {?proto.api_container_api.PartitionConnectionInfo}
+ */
+proto.api_container_api.RepartitionArgs.prototype.getDefaultConnection = function() {
+  return /** @type{?proto.api_container_api.PartitionConnectionInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.PartitionConnectionInfo, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.PartitionConnectionInfo|undefined} value
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+*/
+proto.api_container_api.RepartitionArgs.prototype.setDefaultConnection = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.RepartitionArgs} returns this
+ */
+proto.api_container_api.RepartitionArgs.prototype.clearDefaultConnection = function() {
+  return this.setDefaultConnection(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.api_container_api.RepartitionArgs.prototype.hasDefaultConnection = function() {
+  return jspb.Message.getField(this, 3) != null;
+};
+
+
 
 
 
@@ -4463,8 +12243,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunProgress.toObject(opt_includeInstance, this);
+proto.api_container_api.ServicePartition.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServicePartition.toObject(opt_includeInstance, this);
 };
 
 
@@ -4473,15 +12253,14 @@ proto.api_container_api.StarlarkRunProgress.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServicePartition} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServicePartition.toObject = function(includeInstance, msg) {
   var f, obj = {
-    currentStepInfoList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
-    totalSteps: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    currentStepNumber: jspb.Message.getFieldWithDefault(msg, 3, 0)
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    partitionId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -4495,23 +12274,23 @@ proto.api_container_api.StarlarkRunProgress.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.ServicePartition}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinary = function(bytes) {
+proto.api_container_api.ServicePartition.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunProgress;
-  return proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServicePartition;
+  return proto.api_container_api.ServicePartition.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunProgress} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServicePartition} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunProgress}
+ * @return {!proto.api_container_api.ServicePartition}
  */
-proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServicePartition.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4520,15 +12299,11 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCurrentStepInfo(value);
+      msg.setServiceName(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setTotalSteps(value);
-      break;
-    case 3:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setCurrentStepNumber(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartitionId(value);
       break;
     default:
       reader.skipField();
@@ -4543,9 +12318,9 @@ proto.api_container_api.StarlarkRunProgress.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function() {
+proto.api_container_api.ServicePartition.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServicePartition.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4553,106 +12328,62 @@ proto.api_container_api.StarlarkRunProgress.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunProgress} message
+ * @param {!proto.api_container_api.ServicePartition} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunProgress.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServicePartition.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getCurrentStepInfoList();
+  f = message.getServiceName();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getTotalSteps();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getPartitionId();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getCurrentStepNumber();
-  if (f !== 0) {
-    writer.writeUint32(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * repeated string current_step_info = 1;
- * @return {!Array<string>}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepInfoList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
 
 /**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * optional string service_name = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepInfoList = function(value) {
-  return jspb.Message.setField(this, 1, value || []);
+proto.api_container_api.ServicePartition.prototype.getServiceName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.addCurrentStepInfo = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
- */
-proto.api_container_api.StarlarkRunProgress.prototype.clearCurrentStepInfoList = function() {
-  return this.setCurrentStepInfoList([]);
-};
-
-
-/**
- * optional uint32 total_steps = 2;
- * @return {number}
- */
-proto.api_container_api.StarlarkRunProgress.prototype.getTotalSteps = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @return {!proto.api_container_api.ServicePartition} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setTotalSteps = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.ServicePartition.prototype.setServiceName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 current_step_number = 3;
- * @return {number}
+ * optional string partition_id = 2;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunProgress.prototype.getCurrentStepNumber = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+proto.api_container_api.ServicePartition.prototype.getPartitionId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.StarlarkRunProgress} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.ServicePartition} returns this
  */
-proto.api_container_api.StarlarkRunProgress.prototype.setCurrentStepNumber = function(value) {
-  return jspb.Message.setProto3IntField(this, 3, value);
+proto.api_container_api.ServicePartition.prototype.setPartitionId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -4672,8 +12403,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StarlarkRunFinishedEvent.toObject(opt_includeInstance, this);
+proto.api_container_api.PartitionConnection.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionConnection.toObject(opt_includeInstance, this);
 };
 
 
@@ -4682,14 +12413,15 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PartitionConnection} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInstance, msg) {
+proto.api_container_api.PartitionConnection.toObject = function(includeInstance, msg) {
   var f, obj = {
-    isRunSuccessful: jspb.Message.getBooleanFieldWithDefault(msg, 1, false),
-    serializedOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
+    partition1: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    partition2: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    info: (f = msg.getInfo()) && proto.api_container_api.PartitionConnectionInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4703,23 +12435,23 @@ proto.api_container_api.StarlarkRunFinishedEvent.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.PartitionConnection}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinary = function(bytes) {
+proto.api_container_api.PartitionConnection.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StarlarkRunFinishedEvent;
-  return proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PartitionConnection;
+  return proto.api_container_api.PartitionConnection.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PartitionConnection} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent}
+ * @return {!proto.api_container_api.PartitionConnection}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PartitionConnection.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4727,12 +12459,17 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {boolean} */ (reader.readBool());
-      msg.setIsRunSuccessful(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPartition1(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSerializedOutput(value);
+      msg.setPartition2(value);
+      break;
+    case 3:
+      var value = new proto.api_container_api.PartitionConnectionInfo;
+      reader.readMessage(value,proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader);
+      msg.setInfo(value);
       break;
     default:
       reader.skipField();
@@ -4747,9 +12484,9 @@ proto.api_container_api.StarlarkRunFinishedEvent.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = function() {
+proto.api_container_api.PartitionConnection.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PartitionConnection.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -4757,71 +12494,98 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StarlarkRunFinishedEvent} message
+ * @param {!proto.api_container_api.PartitionConnection} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StarlarkRunFinishedEvent.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PartitionConnection.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getIsRunSuccessful();
-  if (f) {
-    writer.writeBool(
+  f = message.getPartition1();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
-  f = /** @type {string} */ (jspb.Message.getField(message, 2));
-  if (f != null) {
+  f = message.getPartition2();
+  if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
+  f = message.getInfo();
+  if (f != null) {
+    writer.writeMessage(
+      3,
+      f,
+      proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter
+    );
+  }
 };
 
 
 /**
- * optional bool is_run_successful = 1;
- * @return {boolean}
+ * optional string partition1 = 1;
+ * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getIsRunSuccessful = function() {
-  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 1, false));
+proto.api_container_api.PartitionConnection.prototype.getPartition1 = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {boolean} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.PartitionConnection} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setIsRunSuccessful = function(value) {
-  return jspb.Message.setProto3BooleanField(this, 1, value);
+proto.api_container_api.PartitionConnection.prototype.setPartition1 = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string serialized_output = 2;
+ * optional string partition2 = 2;
  * @return {string}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.getSerializedOutput = function() {
+proto.api_container_api.PartitionConnection.prototype.getPartition2 = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * @return {!proto.api_container_api.PartitionConnection} returns this
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.setSerializedOutput = function(value) {
-  return jspb.Message.setField(this, 2, value);
+proto.api_container_api.PartitionConnection.prototype.setPartition2 = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.StarlarkRunFinishedEvent} returns this
+ * optional PartitionConnectionInfo info = 3;
+ * @return {?proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput = function() {
-  return jspb.Message.setField(this, 2, undefined);
+proto.api_container_api.PartitionConnection.prototype.getInfo = function() {
+  return /** @type{?proto.api_container_api.PartitionConnectionInfo} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.PartitionConnectionInfo, 3));
+};
+
+
+/**
+ * @param {?proto.api_container_api.PartitionConnectionInfo|undefined} value
+ * @return {!proto.api_container_api.PartitionConnection} returns this
+*/
+proto.api_container_api.PartitionConnection.prototype.setInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 3, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.PartitionConnection} returns this
+ */
+proto.api_container_api.PartitionConnection.prototype.clearInfo = function() {
+  return this.setInfo(undefined);
 };
 
 
@@ -4829,8 +12593,8 @@ proto.api_container_api.StarlarkRunFinishedEvent.prototype.clearSerializedOutput
  * Returns whether this field is set.
  * @return {boolean}
  */
-proto.api_container_api.StarlarkRunFinishedEvent.prototype.hasSerializedOutput = function() {
-  return jspb.Message.getField(this, 2) != null;
+proto.api_container_api.PartitionConnection.prototype.hasInfo = function() {
+  return jspb.Message.getField(this, 3) != null;
 };
 
 
@@ -4850,8 +12614,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.PartitionConnectionInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PartitionConnectionInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -4860,13 +12624,17 @@ proto.api_container_api.GetServicesArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PartitionConnectionInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.PartitionConnectionInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifiersMap: (f = msg.getServiceIdentifiersMap()) ? f.toObject(includeInstance, undefined) : []
+    packetLossPercentage: jspb.Message.getFloatingPointFieldWithDefault(msg, 1, 0.0),
+    delayMs: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    jitterMs: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    delayCorrelation: jspb.Message.getFloatingPointFieldWithDefault(msg, 4, 0.0),
+    delayDistribution: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -4880,23 +12648,23 @@ proto.api_container_api.GetServicesArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.PartitionConnectionInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesArgs;
-  return proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PartitionConnectionInfo;
+  return proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PartitionConnectionInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesArgs}
+ * @return {!proto.api_container_api.PartitionConnectionInfo}
  */
-proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PartitionConnectionInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -4904,10 +12672,24 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceIdentifiersMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readBool, null, "", false);
-         });
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setPacketLossPercentage(value);
+      break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setDelayMs(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setJitterMs(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readFloat());
+      msg.setDelayCorrelation(value);
+      break;
+    case 5:
+      var value = /** @type {!proto.api_container_api.PacketDelayDistribution} */ (reader.readEnum());
+      msg.setDelayDistribution(value);
       break;
     default:
       reader.skipField();
@@ -4919,54 +12701,151 @@ proto.api_container_api.GetServicesArgs.deserializeBinaryFromReader = function(m
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.api_container_api.PartitionConnectionInfo.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.api_container_api.PartitionConnectionInfo} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.api_container_api.PartitionConnectionInfo.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getPacketLossPercentage();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      1,
+      f
+    );
+  }
+  f = message.getDelayMs();
+  if (f !== 0) {
+    writer.writeUint32(
+      2,
+      f
+    );
+  }
+  f = message.getJitterMs();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getDelayCorrelation();
+  if (f !== 0.0) {
+    writer.writeFloat(
+      4,
+      f
+    );
+  }
+  f = message.getDelayDistribution();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      5,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional float packet_loss_percentage = 1;
+ * @return {number}
+ */
+proto.api_container_api.PartitionConnectionInfo.prototype.getPacketLossPercentage = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 1, 0.0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
+ */
+proto.api_container_api.PartitionConnectionInfo.prototype.setPacketLossPercentage = function(value) {
+  return jspb.Message.setProto3FloatField(this, 1, value);
+};
+
+
+/**
+ * optional uint32 delay_ms = 2;
+ * @return {number}
+ */
+proto.api_container_api.PartitionConnectionInfo.prototype.getDelayMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
+ */
+proto.api_container_api.PartitionConnectionInfo.prototype.setDelayMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
+/**
+ * optional uint32 jitter_ms = 3;
+ * @return {number}
+ */
+proto.api_container_api.PartitionConnectionInfo.prototype.getJitterMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.PartitionConnectionInfo.prototype.setJitterMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional float delay_correlation = 4;
+ * @return {number}
  */
-proto.api_container_api.GetServicesArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getServiceIdentifiersMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeBool);
-  }
+proto.api_container_api.PartitionConnectionInfo.prototype.getDelayCorrelation = function() {
+  return /** @type {number} */ (jspb.Message.getFloatingPointFieldWithDefault(this, 4, 0.0));
 };
 
 
 /**
- * map<string, bool> service_identifiers = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,boolean>}
+ * @param {number} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
  */
-proto.api_container_api.GetServicesArgs.prototype.getServiceIdentifiersMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,boolean>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      null));
+proto.api_container_api.PartitionConnectionInfo.prototype.setDelayCorrelation = function(value) {
+  return jspb.Message.setProto3FloatField(this, 4, value);
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesArgs} returns this
+ * optional PacketDelayDistribution delay_distribution = 5;
+ * @return {!proto.api_container_api.PacketDelayDistribution}
  */
-proto.api_container_api.GetServicesArgs.prototype.clearServiceIdentifiersMap = function() {
-  this.getServiceIdentifiersMap().clear();
-  return this;};
+proto.api_container_api.PartitionConnectionInfo.prototype.getDelayDistribution = function() {
+  return /** @type {!proto.api_container_api.PacketDelayDistribution} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
 
 
+/**
+ * @param {!proto.api_container_api.PacketDelayDistribution} value
+ * @return {!proto.api_container_api.PartitionConnectionInfo} returns this
+ */
+proto.api_container_api.PartitionConnectionInfo.prototype.setDelayDistribution = function(value) {
+  return jspb.Message.setProto3EnumField(this, 5, value);
+};
 
 
 
@@ -4983,8 +12862,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GitHost.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GitHost.toObject(opt_includeInstance, this);
 };
 
 
@@ -4993,13 +12872,16 @@ proto.api_container_api.GetServicesResponse.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GitHost} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.GitHost.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceInfoMap: (f = msg.getServiceInfoMap()) ? f.toObject(includeInstance, proto.api_container_api.ServiceInfo.toObject) : []
+    host: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    cloneUrlPrefix: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    username: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    token: jspb.Message.getFieldWithDefault(msg, 4, "")
   };
 
   if (includeInstance) {
@@ -5013,23 +12895,23 @@ proto.api_container_api.GetServicesResponse.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.GitHost}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.GitHost.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetServicesResponse;
-  return proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GitHost;
+  return proto.api_container_api.GitHost.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GitHost} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetServicesResponse}
+ * @return {!proto.api_container_api.GitHost}
  */
-proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GitHost.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5037,10 +12919,20 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = msg.getServiceInfoMap();
-      reader.readMessage(value, function(message, reader) {
-        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readMessage, proto.api_container_api.ServiceInfo.deserializeBinaryFromReader, "", new proto.api_container_api.ServiceInfo());
-         });
+      var value = /** @type {string} */ (reader.readString());
+      msg.setHost(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setCloneUrlPrefix(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUsername(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setToken(value);
       break;
     default:
       reader.skipField();
@@ -5055,9 +12947,9 @@ proto.api_container_api.GetServicesResponse.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.GitHost.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GitHost.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5065,40 +12957,122 @@ proto.api_container_api.GetServicesResponse.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetServicesResponse} message
+ * @param {!proto.api_container_api.GitHost} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GitHost.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceInfoMap(true);
-  if (f && f.getLength() > 0) {
-    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeMessage, proto.api_container_api.ServiceInfo.serializeBinaryToWriter);
+  f = message.getHost();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getCloneUrlPrefix();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getUsername();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getToken();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
   }
 };
 
 
 /**
- * map<string, ServiceInfo> service_info = 1;
- * @param {boolean=} opt_noLazyCreate Do not create the map if
- * empty, instead returning `undefined`
- * @return {!jspb.Map<string,!proto.api_container_api.ServiceInfo>}
+ * optional string host = 1;
+ * @return {string}
  */
-proto.api_container_api.GetServicesResponse.prototype.getServiceInfoMap = function(opt_noLazyCreate) {
-  return /** @type {!jspb.Map<string,!proto.api_container_api.ServiceInfo>} */ (
-      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
-      proto.api_container_api.ServiceInfo));
+proto.api_container_api.GitHost.prototype.getHost = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * Clears values from the map. The map will be non-null.
- * @return {!proto.api_container_api.GetServicesResponse} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.GitHost} returns this
  */
-proto.api_container_api.GetServicesResponse.prototype.clearServiceInfoMap = function() {
-  this.getServiceInfoMap().clear();
-  return this;};
+proto.api_container_api.GitHost.prototype.setHost = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string clone_url_prefix = 2;
+ * @return {string}
+ */
+proto.api_container_api.GitHost.prototype.getCloneUrlPrefix = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GitHost} returns this
+ */
+proto.api_container_api.GitHost.prototype.setCloneUrlPrefix = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string username = 3;
+ * @return {string}
+ */
+proto.api_container_api.GitHost.prototype.getUsername = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GitHost} returns this
+ */
+proto.api_container_api.GitHost.prototype.setUsername = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string token = 4;
+ * @return {string}
+ */
+proto.api_container_api.GitHost.prototype.getToken = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GitHost} returns this
+ */
+proto.api_container_api.GitHost.prototype.setToken = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.repeatedFields_ = [3,4];
 
 
 
@@ -5116,8 +13090,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ServiceIdentifiers.toObject(opt_includeInstance, this);
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageTestsArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5126,15 +13100,18 @@ proto.api_container_api.ServiceIdentifiers.prototype.toObject = function(opt_inc
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance, msg) {
+proto.api_container_api.RunStarlarkPackageTestsArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    shortenedUuid: jspb.Message.getFieldWithDefault(msg, 3, "")
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    testNameFilter: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    locallyReplacedPackageIdsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f,
+    gitHostsList: jspb.Message.toObjectList(msg.getGitHostsList(),
+    proto.api_container_api.GitHost.toObject, includeInstance),
+    offline: jspb.Message.getBooleanFieldWithDefault(msg, 5, false)
   };
 
   if (includeInstance) {
@@ -5148,23 +13125,23 @@ proto.api_container_api.ServiceIdentifiers.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinary = function(bytes) {
+proto.api_container_api.RunStarlarkPackageTestsArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ServiceIdentifiers;
-  return proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RunStarlarkPackageTestsArgs;
+  return proto.api_container_api.RunStarlarkPackageTestsArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ServiceIdentifiers} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs}
  */
-proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RunStarlarkPackageTestsArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5173,15 +13150,24 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceUuid(value);
+      msg.setPackageId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setTestNameFilter(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setShortenedUuid(value);
+      msg.addLocallyReplacedPackageIds(value);
+      break;
+    case 4:
+      var value = new proto.api_container_api.GitHost;
+      reader.readMessage(value,proto.api_container_api.GitHost.deserializeBinaryFromReader);
+      msg.addGitHosts(value);
+      break;
+    case 5:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOffline(value);
       break;
     default:
       reader.skipField();
@@ -5196,9 +13182,9 @@ proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader = functio
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function() {
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RunStarlarkPackageTestsArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5206,87 +13192,177 @@ proto.api_container_api.ServiceIdentifiers.prototype.serializeBinary = function(
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ServiceIdentifiers} message
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RunStarlarkPackageTestsArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceUuid();
+  f = message.getPackageId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
+  f = message.getTestNameFilter();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getShortenedUuid();
+  f = message.getLocallyReplacedPackageIdsList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedString(
       3,
       f
     );
   }
+  f = message.getGitHostsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      4,
+      f,
+      proto.api_container_api.GitHost.serializeBinaryToWriter
+    );
+  }
+  f = message.getOffline();
+  if (f) {
+    writer.writeBool(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_uuid = 1;
+ * optional string package_id = 1;
  * @return {string}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getServiceUuid = function() {
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getPackageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string test_name_filter = 2;
+ * @return {string}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getTestNameFilter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setTestNameFilter = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * repeated string locally_replaced_package_ids = 3;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getLocallyReplacedPackageIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setLocallyReplacedPackageIdsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.addLocallyReplacedPackageIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+ */
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.clearLocallyReplacedPackageIdsList = function() {
+  return this.setLocallyReplacedPackageIdsList([]);
+};
+
+
+/**
+ * repeated GitHost git_hosts = 4;
+ * @return {!Array<!proto.api_container_api.GitHost>}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setServiceUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getGitHostsList = function() {
+  return /** @type{!Array<!proto.api_container_api.GitHost>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.GitHost, 4));
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * @param {!Array<!proto.api_container_api.GitHost>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
+*/
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setGitHostsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 4, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GitHost=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GitHost}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.addGitHosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 4, opt_value, proto.api_container_api.GitHost, opt_index);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.clearGitHostsList = function() {
+  return this.setGitHostsList([]);
 };
 
 
 /**
- * optional string shortened_uuid = 3;
- * @return {string}
+ * optional bool offline = 5;
+ * @return {boolean}
  */
-proto.api_container_api.ServiceIdentifiers.prototype.getShortenedUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.getOffline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 5, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ServiceIdentifiers} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsArgs} returns this
  */
-proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.RunStarlarkPackageTestsArgs.prototype.setOffline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 5, value);
 };
 
 
@@ -5296,7 +13372,8 @@ proto.api_container_api.ServiceIdentifiers.prototype.setShortenedUuid = function
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.repeatedFields_ = [1];
+proto.api_container_api.RunStarlarkPackageTestsResponse.repeatedFields_ = [1];
+
 
 
 
@@ -5313,8 +13390,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RunStarlarkPackageTestsResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -5323,14 +13400,14 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.RunStarlarkPackageTestsResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    allidentifiersList: jspb.Message.toObjectList(msg.getAllidentifiersList(),
-    proto.api_container_api.ServiceIdentifiers.toObject, includeInstance)
+    testResultsList: jspb.Message.toObjectList(msg.getTestResultsList(),
+    proto.api_container_api.StarlarkTestResult.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -5344,23 +13421,23 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.toObj
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse;
-  return proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RunStarlarkPackageTestsResponse;
+  return proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse}
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RunStarlarkPackageTestsResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5368,9 +13445,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.ServiceIdentifiers;
-      reader.readMessage(value,proto.api_container_api.ServiceIdentifiers.deserializeBinaryFromReader);
-      msg.addAllidentifiers(value);
+      var value = new proto.api_container_api.StarlarkTestResult;
+      reader.readMessage(value,proto.api_container_api.StarlarkTestResult.deserializeBinaryFromReader);
+      msg.addTestResults(value);
       break;
     default:
       reader.skipField();
@@ -5385,9 +13462,9 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.deser
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.serializeBinary = function() {
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RunStarlarkPackageTestsResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5395,69 +13472,62 @@ proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.proto
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} message
+ * @param {!proto.api_container_api.RunStarlarkPackageTestsResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RunStarlarkPackageTestsResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getAllidentifiersList();
+  f = message.getTestResultsList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.api_container_api.ServiceIdentifiers.serializeBinaryToWriter
+      proto.api_container_api.StarlarkTestResult.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated ServiceIdentifiers allIdentifiers = 1;
- * @return {!Array<!proto.api_container_api.ServiceIdentifiers>}
+ * repeated StarlarkTestResult test_results = 1;
+ * @return {!Array<!proto.api_container_api.StarlarkTestResult>}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.getAllidentifiersList = function() {
-  return /** @type{!Array<!proto.api_container_api.ServiceIdentifiers>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceIdentifiers, 1));
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.getTestResultsList = function() {
+  return /** @type{!Array<!proto.api_container_api.StarlarkTestResult>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.StarlarkTestResult, 1));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.ServiceIdentifiers>} value
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @param {!Array<!proto.api_container_api.StarlarkTestResult>} value
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse} returns this
 */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.setAllidentifiersList = function(value) {
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.setTestResultsList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.ServiceIdentifiers=} opt_value
+ * @param {!proto.api_container_api.StarlarkTestResult=} opt_value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.ServiceIdentifiers}
+ * @return {!proto.api_container_api.StarlarkTestResult}
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.addAllidentifiers = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.ServiceIdentifiers, opt_index);
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.addTestResults = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.StarlarkTestResult, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse} returns this
+ * @return {!proto.api_container_api.RunStarlarkPackageTestsResponse} returns this
  */
-proto.api_container_api.GetExistingAndHistoricalServiceIdentifiersResponse.prototype.clearAllidentifiersList = function() {
-  return this.setAllidentifiersList([]);
+proto.api_container_api.RunStarlarkPackageTestsResponse.prototype.clearTestResultsList = function() {
+  return this.setTestResultsList([]);
 };
 
 
 
-/**
- * List of repeated fields within this message type.
- * @private {!Array<number>}
- * @const
- */
-proto.api_container_api.ExecCommandArgs.repeatedFields_ = [2];
-
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5473,8 +13543,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StarlarkTestResult.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StarlarkTestResult.toObject(opt_includeInstance, this);
 };
 
 
@@ -5483,14 +13553,17 @@ proto.api_container_api.ExecCommandArgs.prototype.toObject = function(opt_includ
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StarlarkTestResult} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StarlarkTestResult.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    commandArgsList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
+    file: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    passed: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
+    failureMessage: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    durationMs: jspb.Message.getFieldWithDefault(msg, 5, 0)
   };
 
   if (includeInstance) {
@@ -5504,23 +13577,23 @@ proto.api_container_api.ExecCommandArgs.toObject = function(includeInstance, msg
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.StarlarkTestResult}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StarlarkTestResult.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandArgs;
-  return proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StarlarkTestResult;
+  return proto.api_container_api.StarlarkTestResult.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StarlarkTestResult} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandArgs}
+ * @return {!proto.api_container_api.StarlarkTestResult}
  */
-proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StarlarkTestResult.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5529,11 +13602,23 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setFile(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.addCommandArgs(value);
+      msg.setName(value);
+      break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPassed(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setFailureMessage(value);
+      break;
+    case 5:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setDurationMs(value);
       break;
     default:
       reader.skipField();
@@ -5548,9 +13633,9 @@ proto.api_container_api.ExecCommandArgs.deserializeBinaryFromReader = function(m
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StarlarkTestResult.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StarlarkTestResult.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5558,245 +13643,149 @@ proto.api_container_api.ExecCommandArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandArgs} message
+ * @param {!proto.api_container_api.StarlarkTestResult} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ExecCommandArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StarlarkTestResult.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getFile();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getCommandArgsList();
+  f = message.getName();
   if (f.length > 0) {
-    writer.writeRepeatedString(
+    writer.writeString(
       2,
       f
     );
   }
+  f = message.getPassed();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
+  f = message.getFailureMessage();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getDurationMs();
+  if (f !== 0) {
+    writer.writeUint64(
+      5,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string file = 1;
  * @return {string}
  */
-proto.api_container_api.ExecCommandArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.StarlarkTestResult.prototype.getFile = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
  */
-proto.api_container_api.ExecCommandArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.StarlarkTestResult.prototype.setFile = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * repeated string command_args = 2;
- * @return {!Array<string>}
- */
-proto.api_container_api.ExecCommandArgs.prototype.getCommandArgsList = function() {
-  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
-};
-
-
-/**
- * @param {!Array<string>} value
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.setCommandArgsList = function(value) {
-  return jspb.Message.setField(this, 2, value || []);
-};
-
-
-/**
- * @param {string} value
- * @param {number=} opt_index
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.addCommandArgs = function(value, opt_index) {
-  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
-};
-
-
-/**
- * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ExecCommandArgs} returns this
- */
-proto.api_container_api.ExecCommandArgs.prototype.clearCommandArgsList = function() {
-  return this.setCommandArgsList([]);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
- */
-proto.api_container_api.ExecCommandResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ExecCommandResponse.toObject(opt_includeInstance, this);
-};
-
-
-/**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ExecCommandResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.ExecCommandResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    exitCode: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    logOutput: jspb.Message.getFieldWithDefault(msg, 2, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
-};
-}
-
-
-/**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ExecCommandResponse}
- */
-proto.api_container_api.ExecCommandResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ExecCommandResponse;
-  return proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader(msg, reader);
-};
-
-
-/**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.ExecCommandResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ExecCommandResponse}
+ * optional string name = 2;
+ * @return {string}
  */
-proto.api_container_api.ExecCommandResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {number} */ (reader.readInt32());
-      msg.setExitCode(value);
-      break;
-    case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setLogOutput(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.StarlarkTestResult.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.StarlarkTestResult.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ExecCommandResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * optional bool passed = 3;
+ * @return {boolean}
  */
-proto.api_container_api.ExecCommandResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getExitCode();
-  if (f !== 0) {
-    writer.writeInt32(
-      1,
-      f
-    );
-  }
-  f = message.getLogOutput();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
+proto.api_container_api.StarlarkTestResult.prototype.getPassed = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 3, false));
 };
 
 
 /**
- * optional int32 exit_code = 1;
- * @return {number}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.getExitCode = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.StarlarkTestResult.prototype.setPassed = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 3, value);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * optional string failure_message = 4;
+ * @return {string}
  */
-proto.api_container_api.ExecCommandResponse.prototype.setExitCode = function(value) {
-  return jspb.Message.setProto3IntField(this, 1, value);
+proto.api_container_api.StarlarkTestResult.prototype.getFailureMessage = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
- * optional string log_output = 2;
- * @return {string}
+ * @param {string} value
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
  */
-proto.api_container_api.ExecCommandResponse.prototype.getLogOutput = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.StarlarkTestResult.prototype.setFailureMessage = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.ExecCommandResponse} returns this
+ * optional uint64 duration_ms = 5;
+ * @return {number}
  */
-proto.api_container_api.ExecCommandResponse.prototype.setLogOutput = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.StarlarkTestResult.prototype.getDurationMs = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.api_container_api.StarlarkTestResult} returns this
+ */
+proto.api_container_api.StarlarkTestResult.prototype.setDurationMs = function(value) {
+  return jspb.Message.setProto3IntField(this, 5, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.repeatedFields_ = [6,9];
+
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -5812,8 +13801,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunPlanArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -5822,19 +13811,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunPlanArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunPlanArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 4, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 7, "")
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    locallyReplacedPackageIdsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    format: jspb.Message.getFieldWithDefault(msg, 7, 0),
+    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    gitHostsList: jspb.Message.toObjectList(msg.getGitHostsList(),
+    proto.api_container_api.GitHost.toObject, includeInstance),
+    offline: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
   };
 
   if (includeInstance) {
@@ -5848,23 +13841,23 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunPlanArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunPlanArgs;
+  return proto.api_container_api.GetStarlarkRunPlanArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunPlanArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetStarlarkRunPlanArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -5873,31 +13866,44 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      msg.setPackageId(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedScript(value);
       break;
     case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      msg.setRelativePathToMainFile(value);
       break;
     case 4:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
       break;
     case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
       break;
     case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLocallyReplacedPackageIds(value);
       break;
     case 7:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      var value = /** @type {!proto.api_container_api.PlanFormat} */ (reader.readEnum());
+      msg.setFormat(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setClonePackage(value);
+      break;
+    case 9:
+      var value = new proto.api_container_api.GitHost;
+      reader.readMessage(value,proto.api_container_api.GitHost.deserializeBinaryFromReader);
+      msg.addGitHosts(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOffline(value);
       break;
     default:
       reader.skipField();
@@ -5912,9 +13918,9 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunPlanArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -5922,187 +13928,302 @@ proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.GetStarlarkRunPlanArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunPlanArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
+  f = message.getPackageId();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getSerializedScript();
+  if (f.length > 0) {
+    writer.writeString(
       2,
       f
     );
   }
-  f = message.getPath();
+  f = message.getRelativePathToMainFile();
   if (f.length > 0) {
     writer.writeString(
       3,
       f
     );
   }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getMainFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
       4,
       f
     );
   }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
       5,
       f
     );
   }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
+  f = message.getLocallyReplacedPackageIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
       6,
       f
     );
   }
-  f = message.getBodyText();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getFormat();
+  if (f !== 0.0) {
+    writer.writeEnum(
       7,
       f
     );
   }
+  f = message.getClonePackage();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getGitHostsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
+      f,
+      proto.api_container_api.GitHost.serializeBinaryToWriter
+    );
+  }
+  f = message.getOffline();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string service_identifier = 1;
+ * optional string package_id = 1;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getPackageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setPackageId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint32 port = 2;
- * @return {number}
+ * optional string serialized_script = 2;
+ * @return {string}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string relative_path_to_main_file = 3;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string main_function_name = 4;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+/**
+ * optional string serialized_params = 5;
+ * @return {string}
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
+};
+
+
+/**
+ * repeated string locally_replaced_package_ids = 6;
+ * @return {!Array<string>}
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getLocallyReplacedPackageIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
+};
+
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setLocallyReplacedPackageIdsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.addLocallyReplacedPackageIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.clearLocallyReplacedPackageIdsList = function() {
+  return this.setLocallyReplacedPackageIdsList([]);
 };
 
 
 /**
- * optional string path = 3;
- * @return {string}
+ * optional PlanFormat format = 7;
+ * @return {!proto.api_container_api.PlanFormat}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getFormat = function() {
+  return /** @type {!proto.api_container_api.PlanFormat} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {!proto.api_container_api.PlanFormat} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setFormat = function(value) {
+  return jspb.Message.setProto3EnumField(this, 7, value);
 };
 
 
 /**
- * optional uint32 initial_delay_milliseconds = 4;
- * @return {number}
+ * optional bool clone_package = 8;
+ * @return {boolean}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getClonePackage = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 4, value);
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setClonePackage = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
 };
 
 
 /**
- * optional uint32 retries = 5;
- * @return {number}
+ * repeated GitHost git_hosts = 9;
+ * @return {!Array<!proto.api_container_api.GitHost>}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getGitHostsList = function() {
+  return /** @type{!Array<!proto.api_container_api.GitHost>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.GitHost, 9));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+ * @param {!Array<!proto.api_container_api.GitHost>} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
+*/
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setGitHostsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 6;
- * @return {number}
+ * @param {!proto.api_container_api.GitHost=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GitHost}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.addGitHosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.api_container_api.GitHost, opt_index);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.clearGitHostsList = function() {
+  return this.setGitHostsList([]);
 };
 
 
 /**
- * optional string body_text = 7;
- * @return {string}
+ * optional bool offline = 10;
+ * @return {boolean}
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.getOffline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanArgs} returns this
  */
-proto.api_container_api.WaitForHttpGetEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 7, value);
+proto.api_container_api.GetStarlarkRunPlanArgs.prototype.setOffline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
 };
 
 
@@ -6122,8 +14243,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunPlanResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -6132,20 +14253,14 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.toObje
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunPlanResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunPlanResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    port: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    path: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    requestBody: jspb.Message.getFieldWithDefault(msg, 4, ""),
-    initialDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 5, 0),
-    retries: jspb.Message.getFieldWithDefault(msg, 6, 0),
-    retriesDelayMilliseconds: jspb.Message.getFieldWithDefault(msg, 7, 0),
-    bodyText: jspb.Message.getFieldWithDefault(msg, 8, "")
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    serializedPlan: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -6159,23 +14274,23 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.toObject = funct
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunPlanResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunPlanResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs;
-  return proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunPlanResponse;
+  return proto.api_container_api.GetStarlarkRunPlanResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunPlanResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunPlanResponse}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetStarlarkRunPlanResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6183,36 +14298,13 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setPort(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
-      break;
-    case 4:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setRequestBody(value);
-      break;
-    case 5:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setInitialDelayMilliseconds(value);
-      break;
-    case 6:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetries(value);
-      break;
-    case 7:
-      var value = /** @type {number} */ (reader.readUint32());
-      msg.setRetriesDelayMilliseconds(value);
-      break;
-    case 8:
       var value = /** @type {string} */ (reader.readString());
-      msg.setBodyText(value);
+      msg.setSerializedPlan(value);
       break;
     default:
       reader.skipField();
@@ -6227,9 +14319,9 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.deserializeBinar
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunPlanResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6237,216 +14329,94 @@ proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.serial
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} message
+ * @param {!proto.api_container_api.GetStarlarkRunPlanResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunPlanResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
-    );
-  }
-  f = message.getPort();
-  if (f !== 0) {
-    writer.writeUint32(
-      2,
-      f
-    );
-  }
-  f = message.getPath();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-  f = message.getRequestBody();
-  if (f.length > 0) {
-    writer.writeString(
-      4,
-      f
-    );
-  }
-  f = message.getInitialDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      5,
-      f
-    );
-  }
-  f = message.getRetries();
-  if (f !== 0) {
-    writer.writeUint32(
-      6,
-      f
-    );
-  }
-  f = message.getRetriesDelayMilliseconds();
-  if (f !== 0) {
-    writer.writeUint32(
-      7,
-      f
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
     );
   }
-  f = message.getBodyText();
+  f = message.getSerializedPlan();
   if (f.length > 0) {
-    writer.writeString(
-      8,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string service_identifier = 1;
- * @return {string}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
-
-/**
- * optional uint32 port = 2;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPort = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPort = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string path = 3;
- * @return {string}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getPath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setPath = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
-};
-
-
-/**
- * optional string request_body = 4;
- * @return {string}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRequestBody = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRequestBody = function(value) {
-  return jspb.Message.setProto3StringField(this, 4, value);
-};
-
-
-/**
- * optional uint32 initial_delay_milliseconds = 5;
- * @return {number}
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getInitialDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 5, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setInitialDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 5, value);
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional uint32 retries = 6;
- * @return {number}
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetries = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 6, 0));
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
- */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetries = function(value) {
-  return jspb.Message.setProto3IntField(this, 6, value);
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.GetStarlarkRunPlanResponse} returns this
+*/
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional uint32 retries_delay_milliseconds = 7;
- * @return {number}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetStarlarkRunPlanResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getRetriesDelayMilliseconds = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
 };
 
 
 /**
- * @param {number} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setRetriesDelayMilliseconds = function(value) {
-  return jspb.Message.setProto3IntField(this, 7, value);
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
 /**
- * optional string body_text = 8;
+ * optional string serialized_plan = 2;
  * @return {string}
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.getBodyText = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 8, ""));
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.getSerializedPlan = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunPlanResponse} returns this
  */
-proto.api_container_api.WaitForHttpPostEndpointAvailabilityArgs.prototype.setBodyText = function(value) {
-  return jspb.Message.setProto3StringField(this, 8, value);
+proto.api_container_api.GetStarlarkRunPlanResponse.prototype.setSerializedPlan = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs.repeatedFields_ = [6,9];
+
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6462,8 +14432,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StreamedDataChunk.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunDiffArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -6472,15 +14442,23 @@ proto.api_container_api.StreamedDataChunk.prototype.toObject = function(opt_incl
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StreamedDataChunk} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunDiffArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunDiffArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    data: msg.getData_asB64(),
-    previousChunkHash: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    metadata: (f = msg.getMetadata()) && proto.api_container_api.DataChunkMetadata.toObject(includeInstance, f)
+    packageId: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    serializedScript: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    relativePathToMainFile: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mainFunctionName: jspb.Message.getFieldWithDefault(msg, 4, ""),
+    serializedParams: jspb.Message.getFieldWithDefault(msg, 5, ""),
+    locallyReplacedPackageIdsList: (f = jspb.Message.getRepeatedField(msg, 6)) == null ? undefined : f,
+    clonePackage: jspb.Message.getBooleanFieldWithDefault(msg, 7, false),
+    prune: jspb.Message.getBooleanFieldWithDefault(msg, 8, false),
+    gitHostsList: jspb.Message.toObjectList(msg.getGitHostsList(),
+    proto.api_container_api.GitHost.toObject, includeInstance),
+    offline: jspb.Message.getBooleanFieldWithDefault(msg, 10, false)
   };
 
   if (includeInstance) {
@@ -6494,23 +14472,23 @@ proto.api_container_api.StreamedDataChunk.toObject = function(includeInstance, m
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunDiffArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StreamedDataChunk;
-  return proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunDiffArgs;
+  return proto.api_container_api.GetStarlarkRunDiffArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StreamedDataChunk} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunDiffArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StreamedDataChunk}
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs}
  */
-proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetStarlarkRunDiffArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6518,17 +14496,45 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!Uint8Array} */ (reader.readBytes());
-      msg.setData(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPackageId(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPreviousChunkHash(value);
+      msg.setSerializedScript(value);
       break;
     case 3:
-      var value = new proto.api_container_api.DataChunkMetadata;
-      reader.readMessage(value,proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader);
-      msg.setMetadata(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRelativePathToMainFile(value);
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setMainFunctionName(value);
+      break;
+    case 5:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setSerializedParams(value);
+      break;
+    case 6:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addLocallyReplacedPackageIds(value);
+      break;
+    case 7:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setClonePackage(value);
+      break;
+    case 8:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setPrune(value);
+      break;
+    case 9:
+      var value = new proto.api_container_api.GitHost;
+      reader.readMessage(value,proto.api_container_api.GitHost.deserializeBinaryFromReader);
+      msg.addGitHosts(value);
+      break;
+    case 10:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setOffline(value);
       break;
     default:
       reader.skipField();
@@ -6543,9 +14549,9 @@ proto.api_container_api.StreamedDataChunk.deserializeBinaryFromReader = function
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunDiffArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6553,265 +14559,314 @@ proto.api_container_api.StreamedDataChunk.prototype.serializeBinary = function()
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StreamedDataChunk} message
+ * @param {!proto.api_container_api.GetStarlarkRunDiffArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StreamedDataChunk.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunDiffArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getData_asU8();
+  f = message.getPackageId();
   if (f.length > 0) {
-    writer.writeBytes(
+    writer.writeString(
       1,
       f
     );
   }
-  f = message.getPreviousChunkHash();
+  f = message.getSerializedScript();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getMetadata();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getRelativePathToMainFile();
+  if (f.length > 0) {
+    writer.writeString(
       3,
+      f
+    );
+  }
+  f = message.getMainFunctionName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+  f = message.getSerializedParams();
+  if (f.length > 0) {
+    writer.writeString(
+      5,
+      f
+    );
+  }
+  f = message.getLocallyReplacedPackageIdsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      6,
+      f
+    );
+  }
+  f = message.getClonePackage();
+  if (f) {
+    writer.writeBool(
+      7,
+      f
+    );
+  }
+  f = message.getPrune();
+  if (f) {
+    writer.writeBool(
+      8,
+      f
+    );
+  }
+  f = message.getGitHostsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      9,
       f,
-      proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter
+      proto.api_container_api.GitHost.serializeBinaryToWriter
+    );
+  }
+  f = message.getOffline();
+  if (f) {
+    writer.writeBool(
+      10,
+      f
     );
   }
 };
 
 
 /**
- * optional bytes data = 1;
+ * optional string package_id = 1;
  * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getData = function() {
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getPackageId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * optional bytes data = 1;
- * This is a type-conversion wrapper around `getData()`
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setPackageId = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string serialized_script = 2;
  * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asB64 = function() {
-  return /** @type {string} */ (jspb.Message.bytesAsB64(
-      this.getData()));
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getSerializedScript = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
- * optional bytes data = 1;
- * Note that Uint8Array is not supported on all browsers.
- * @see http://caniuse.com/Uint8Array
- * This is a type-conversion wrapper around `getData()`
- * @return {!Uint8Array}
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
  */
-proto.api_container_api.StreamedDataChunk.prototype.getData_asU8 = function() {
-  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
-      this.getData()));
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setSerializedScript = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * @param {!(string|Uint8Array)} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * optional string relative_path_to_main_file = 3;
+ * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.setData = function(value) {
-  return jspb.Message.setProto3BytesField(this, 1, value);
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getRelativePathToMainFile = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
 };
 
 
 /**
- * optional string previous_chunk_hash = 2;
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setRelativePathToMainFile = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional string main_function_name = 4;
  * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getPreviousChunkHash = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getMainFunctionName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
  */
-proto.api_container_api.StreamedDataChunk.prototype.setPreviousChunkHash = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setMainFunctionName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
 };
 
 
 /**
- * optional DataChunkMetadata metadata = 3;
- * @return {?proto.api_container_api.DataChunkMetadata}
+ * optional string serialized_params = 5;
+ * @return {string}
  */
-proto.api_container_api.StreamedDataChunk.prototype.getMetadata = function() {
-  return /** @type{?proto.api_container_api.DataChunkMetadata} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.DataChunkMetadata, 3));
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getSerializedParams = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 5, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.DataChunkMetadata|undefined} value
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
-*/
-proto.api_container_api.StreamedDataChunk.prototype.setMetadata = function(value) {
-  return jspb.Message.setWrapperField(this, 3, value);
+ * @param {string} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setSerializedParams = function(value) {
+  return jspb.Message.setProto3StringField(this, 5, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.StreamedDataChunk} returns this
+ * repeated string locally_replaced_package_ids = 6;
+ * @return {!Array<string>}
  */
-proto.api_container_api.StreamedDataChunk.prototype.clearMetadata = function() {
-  return this.setMetadata(undefined);
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getLocallyReplacedPackageIdsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 6));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
  */
-proto.api_container_api.StreamedDataChunk.prototype.hasMetadata = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setLocallyReplacedPackageIdsList = function(value) {
+  return jspb.Message.setField(this, 6, value || []);
 };
 
 
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.addLocallyReplacedPackageIds = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 6, value, opt_index);
+};
+
 
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.clearLocallyReplacedPackageIdsList = function() {
+  return this.setLocallyReplacedPackageIdsList([]);
+};
 
 
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional bool clone_package = 7;
+ * @return {boolean}
  */
-proto.api_container_api.DataChunkMetadata.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DataChunkMetadata.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getClonePackage = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 7, false));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DataChunkMetadata} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
  */
-proto.api_container_api.DataChunkMetadata.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    name: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setClonePackage = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 7, value);
+};
 
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+
+/**
+ * optional bool prune = 8;
+ * @return {boolean}
+ */
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getPrune = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 8, false));
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DataChunkMetadata;
-  return proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setPrune = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 8, value);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.DataChunkMetadata} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DataChunkMetadata}
+ * repeated GitHost git_hosts = 9;
+ * @return {!Array<!proto.api_container_api.GitHost>}
  */
-proto.api_container_api.DataChunkMetadata.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getGitHostsList = function() {
+  return /** @type{!Array<!proto.api_container_api.GitHost>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.GitHost, 9));
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {!Array<!proto.api_container_api.GitHost>} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
+*/
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setGitHostsList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 9, value);
+};
+
+
+/**
+ * @param {!proto.api_container_api.GitHost=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.GitHost}
  */
-proto.api_container_api.DataChunkMetadata.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.addGitHosts = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 9, opt_value, proto.api_container_api.GitHost, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DataChunkMetadata} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
  */
-proto.api_container_api.DataChunkMetadata.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.clearGitHostsList = function() {
+  return this.setGitHostsList([]);
 };
 
 
 /**
- * optional string name = 1;
- * @return {string}
+ * optional bool offline = 10;
+ * @return {boolean}
  */
-proto.api_container_api.DataChunkMetadata.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.getOffline = function() {
+  return /** @type {boolean} */ (jspb.Message.getBooleanFieldWithDefault(this, 10, false));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.DataChunkMetadata} returns this
+ * @param {boolean} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffArgs} returns this
  */
-proto.api_container_api.DataChunkMetadata.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetStarlarkRunDiffArgs.prototype.setOffline = function(value) {
+  return jspb.Message.setProto3BooleanField(this, 10, value);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.ServiceChange.repeatedFields_ = [3];
+
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -6827,8 +14882,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.UploadFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceChange.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ServiceChange.toObject(opt_includeInstance, this);
 };
 
 
@@ -6837,14 +14892,15 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.toObject = functio
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ServiceChange} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.ServiceChange.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    changeType: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    reasonsList: (f = jspb.Message.getRepeatedField(msg, 3)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -6858,23 +14914,23 @@ proto.api_container_api.UploadFilesArtifactResponse.toObject = function(includeI
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.ServiceChange}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.ServiceChange.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.UploadFilesArtifactResponse;
-  return proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ServiceChange;
+  return proto.api_container_api.ServiceChange.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ServiceChange} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.UploadFilesArtifactResponse}
+ * @return {!proto.api_container_api.ServiceChange}
  */
-proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ServiceChange.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -6883,11 +14939,15 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setServiceName(value);
       break;
     case 2:
+      var value = /** @type {!proto.api_container_api.ServiceChangeType} */ (reader.readEnum());
+      msg.setChangeType(value);
+      break;
+    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.addReasons(value);
       break;
     default:
       reader.skipField();
@@ -6902,9 +14962,9 @@ proto.api_container_api.UploadFilesArtifactResponse.deserializeBinaryFromReader
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary = function() {
+proto.api_container_api.ServiceChange.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ServiceChange.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -6912,194 +14972,116 @@ proto.api_container_api.UploadFilesArtifactResponse.prototype.serializeBinary =
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.UploadFilesArtifactResponse} message
+ * @param {!proto.api_container_api.ServiceChange} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.UploadFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ServiceChange.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getServiceName();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getChangeType();
+  if (f !== 0.0) {
+    writer.writeEnum(
       2,
       f
     );
   }
+  f = message.getReasonsList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string uuid = 1;
+ * optional string service_name = 1;
  * @return {string}
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getUuid = function() {
+proto.api_container_api.ServiceChange.prototype.getServiceName = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
+ * @return {!proto.api_container_api.ServiceChange} returns this
  */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setUuid = function(value) {
+proto.api_container_api.ServiceChange.prototype.setServiceName = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
- */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.UploadFilesArtifactResponse} returns this
- */
-proto.api_container_api.UploadFilesArtifactResponse.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
-};
-
-
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
-/**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * optional ServiceChangeType change_type = 2;
+ * @return {!proto.api_container_api.ServiceChangeType}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.DownloadFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ServiceChange.prototype.getChangeType = function() {
+  return /** @type {!proto.api_container_api.ServiceChangeType} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {!proto.api_container_api.ServiceChangeType} value
+ * @return {!proto.api_container_api.ServiceChange} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    identifier: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+proto.api_container_api.ServiceChange.prototype.setChangeType = function(value) {
+  return jspb.Message.setProto3EnumField(this, 2, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * repeated string reasons = 3;
+ * @return {!Array<string>}
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.DownloadFilesArtifactArgs;
-  return proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.ServiceChange.prototype.getReasonsList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 3));
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs}
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.ServiceChange} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setIdentifier(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.ServiceChange.prototype.setReasonsList = function(value) {
+  return jspb.Message.setField(this, 3, value || []);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceChange} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.ServiceChange.prototype.addReasons = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 3, value, opt_index);
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.DownloadFilesArtifactArgs} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.ServiceChange} returns this
  */
-proto.api_container_api.DownloadFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.ServiceChange.prototype.clearReasonsList = function() {
+  return this.setReasonsList([]);
 };
 
 
-/**
- * optional string identifier = 1;
- * @return {string}
- */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.getIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.DownloadFilesArtifactArgs} returns this
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
  */
-proto.api_container_api.DownloadFilesArtifactArgs.prototype.setIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
-};
-
+proto.api_container_api.GetStarlarkRunDiffResponse.repeatedFields_ = [2];
 
 
 
@@ -7117,8 +15099,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.GetStarlarkRunDiffResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7127,14 +15109,17 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.toObject = function(
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.GetStarlarkRunDiffResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.GetStarlarkRunDiffResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    url: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 2, "")
+    interpretationError: (f = msg.getInterpretationError()) && proto.api_container_api.StarlarkInterpretationError.toObject(includeInstance, f),
+    serviceChangesList: jspb.Message.toObjectList(msg.getServiceChangesList(),
+    proto.api_container_api.ServiceChange.toObject, includeInstance),
+    instructionsToRunCount: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    instructionsToSkipCount: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -7148,23 +15133,23 @@ proto.api_container_api.StoreWebFilesArtifactArgs.toObject = function(includeIns
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.GetStarlarkRunDiffResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactArgs;
-  return proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.GetStarlarkRunDiffResponse;
+  return proto.api_container_api.GetStarlarkRunDiffResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.GetStarlarkRunDiffResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs}
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.GetStarlarkRunDiffResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7172,12 +15157,22 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUrl(value);
+      var value = new proto.api_container_api.StarlarkInterpretationError;
+      reader.readMessage(value,proto.api_container_api.StarlarkInterpretationError.deserializeBinaryFromReader);
+      msg.setInterpretationError(value);
       break;
     case 2:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      var value = new proto.api_container_api.ServiceChange;
+      reader.readMessage(value,proto.api_container_api.ServiceChange.deserializeBinaryFromReader);
+      msg.addServiceChanges(value);
+      break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInstructionsToRunCount(value);
+      break;
+    case 4:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setInstructionsToSkipCount(value);
       break;
     default:
       reader.skipField();
@@ -7192,9 +15187,9 @@ proto.api_container_api.StoreWebFilesArtifactArgs.deserializeBinaryFromReader =
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = function() {
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.GetStarlarkRunDiffResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7202,23 +15197,39 @@ proto.api_container_api.StoreWebFilesArtifactArgs.prototype.serializeBinary = fu
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactArgs} message
+ * @param {!proto.api_container_api.GetStarlarkRunDiffResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.GetStarlarkRunDiffResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUrl();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getInterpretationError();
+  if (f != null) {
+    writer.writeMessage(
       1,
-      f
+      f,
+      proto.api_container_api.StarlarkInterpretationError.serializeBinaryToWriter
     );
   }
-  f = message.getName();
+  f = message.getServiceChangesList();
   if (f.length > 0) {
-    writer.writeString(
+    writer.writeRepeatedMessage(
       2,
+      f,
+      proto.api_container_api.ServiceChange.serializeBinaryToWriter
+    );
+  }
+  f = message.getInstructionsToRunCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      3,
+      f
+    );
+  }
+  f = message.getInstructionsToSkipCount();
+  if (f !== 0) {
+    writer.writeUint32(
+      4,
       f
     );
   }
@@ -7226,174 +15237,118 @@ proto.api_container_api.StoreWebFilesArtifactArgs.serializeBinaryToWriter = func
 
 
 /**
- * optional string url = 1;
- * @return {string}
+ * optional StarlarkInterpretationError interpretation_error = 1;
+ * @return {?proto.api_container_api.StarlarkInterpretationError}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getUrl = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.getInterpretationError = function() {
+  return /** @type{?proto.api_container_api.StarlarkInterpretationError} */ (
+    jspb.Message.getWrapperField(this, proto.api_container_api.StarlarkInterpretationError, 1));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
- */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setUrl = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+ * @param {?proto.api_container_api.StarlarkInterpretationError|undefined} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse} returns this
+*/
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.setInterpretationError = function(value) {
+  return jspb.Message.setWrapperField(this, 1, value);
 };
 
 
 /**
- * optional string name = 2;
- * @return {string}
+ * Clears the message field making it undefined.
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.clearInterpretationError = function() {
+  return this.setInterpretationError(undefined);
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactArgs} returns this
+ * Returns whether this field is set.
+ * @return {boolean}
  */
-proto.api_container_api.StoreWebFilesArtifactArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.hasInterpretationError = function() {
+  return jspb.Message.getField(this, 1) != null;
 };
 
 
-
-
-
-if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
- * Creates an object representation of this proto.
- * Field names that are reserved in JavaScript and will be renamed to pb_name.
- * Optional fields that are not set will be set to undefined.
- * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
- * For the list of reserved names please see:
- *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
- * @param {boolean=} opt_includeInstance Deprecated. whether to include the
- *     JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @return {!Object}
+ * repeated ServiceChange service_changes = 2;
+ * @return {!Array<!proto.api_container_api.ServiceChange>}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreWebFilesArtifactResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.getServiceChangesList = function() {
+  return /** @type{!Array<!proto.api_container_api.ServiceChange>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.ServiceChange, 2));
 };
 
 
 /**
- * Static version of the {@see toObject} method.
- * @param {boolean|undefined} includeInstance Deprecated. Whether to include
- *     the JSPB instance for transitional soy proto support:
- *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The msg instance to transform.
- * @return {!Object}
- * @suppress {unusedLocalVariables} f is only used for nested messages
- */
-proto.api_container_api.StoreWebFilesArtifactResponse.toObject = function(includeInstance, msg) {
-  var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
-  };
-
-  if (includeInstance) {
-    obj.$jspbMessageInstance = msg;
-  }
-  return obj;
+ * @param {!Array<!proto.api_container_api.ServiceChange>} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse} returns this
+*/
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.setServiceChangesList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
 };
-}
 
 
 /**
- * Deserializes binary data (in protobuf wire format).
- * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * @param {!proto.api_container_api.ServiceChange=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.ServiceChange}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinary = function(bytes) {
-  var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreWebFilesArtifactResponse;
-  return proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader(msg, reader);
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.addServiceChanges = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.api_container_api.ServiceChange, opt_index);
 };
 
 
 /**
- * Deserializes binary data (in protobuf wire format) from the
- * given reader into the given message object.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} msg The message object to deserialize into.
- * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse}
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.deserializeBinaryFromReader = function(msg, reader) {
-  while (reader.nextField()) {
-    if (reader.isEndGroup()) {
-      break;
-    }
-    var field = reader.getFieldNumber();
-    switch (field) {
-    case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
-      break;
-    default:
-      reader.skipField();
-      break;
-    }
-  }
-  return msg;
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.clearServiceChangesList = function() {
+  return this.setServiceChangesList([]);
 };
 
 
 /**
- * Serializes the message to binary data (in protobuf wire format).
- * @return {!Uint8Array}
+ * optional uint32 instructions_to_run_count = 3;
+ * @return {number}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.serializeBinary = function() {
-  var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter(this, writer);
-  return writer.getResultBuffer();
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.getInstructionsToRunCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * Serializes the given message to binary data (in protobuf wire
- * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreWebFilesArtifactResponse} message
- * @param {!jspb.BinaryWriter} writer
- * @suppress {unusedLocalVariables} f is only used for nested messages
+ * @param {number} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.serializeBinaryToWriter = function(message, writer) {
-  var f = undefined;
-  f = message.getUuid();
-  if (f.length > 0) {
-    writer.writeString(
-      1,
-      f
-    );
-  }
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.setInstructionsToRunCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional string uuid = 1;
- * @return {string}
+ * optional uint32 instructions_to_skip_count = 4;
+ * @return {number}
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.getUuid = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.getInstructionsToSkipCount = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreWebFilesArtifactResponse} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.GetStarlarkRunDiffResponse} returns this
  */
-proto.api_container_api.StoreWebFilesArtifactResponse.prototype.setUuid = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.api_container_api.GetStarlarkRunDiffResponse.prototype.setInstructionsToSkipCount = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
 
 
-
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -7407,8 +15362,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.ResumeStarlarkRunArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ResumeStarlarkRunArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7417,15 +15372,14 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.toObject = f
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ResumeStarlarkRunArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.ResumeStarlarkRunArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    serviceIdentifier: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    sourcePath: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    name: jspb.Message.getFieldWithDefault(msg, 3, "")
+    startingLineIndex: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    runId: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7439,23 +15393,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.toObject = function(in
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.ResumeStarlarkRunArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.ResumeStarlarkRunArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceArgs;
-  return proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ResumeStarlarkRunArgs;
+  return proto.api_container_api.ResumeStarlarkRunArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ResumeStarlarkRunArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs}
+ * @return {!proto.api_container_api.ResumeStarlarkRunArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ResumeStarlarkRunArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7463,16 +15417,12 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setServiceIdentifier(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setStartingLineIndex(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setSourcePath(value);
-      break;
-    case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setName(value);
+      msg.setRunId(value);
       break;
     default:
       reader.skipField();
@@ -7487,9 +15437,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.deserializeBinaryFromR
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBinary = function() {
+proto.api_container_api.ResumeStarlarkRunArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ResumeStarlarkRunArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7497,93 +15447,69 @@ proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.serializeBin
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} message
+ * @param {!proto.api_container_api.ResumeStarlarkRunArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ResumeStarlarkRunArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getServiceIdentifier();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getStartingLineIndex();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
-    );
-  }
-  f = message.getSourcePath();
-  if (f.length > 0) {
-    writer.writeString(
-      2,
-      f
-    );
-  }
-  f = message.getName();
-  if (f.length > 0) {
-    writer.writeString(
-      3,
-      f
-    );
-  }
-};
-
-
-/**
- * optional string service_identifier = 1;
- * @return {string}
- */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getServiceIdentifier = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
-};
-
-
-/**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
- */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setServiceIdentifier = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+    );
+  }
+  f = message.getRunId();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
 /**
- * optional string source_path = 2;
- * @return {string}
+ * optional uint32 starting_line_index = 1;
+ * @return {number}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getSourcePath = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+proto.api_container_api.ResumeStarlarkRunArgs.prototype.getStartingLineIndex = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @param {number} value
+ * @return {!proto.api_container_api.ResumeStarlarkRunArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setSourcePath = function(value) {
-  return jspb.Message.setProto3StringField(this, 2, value);
+proto.api_container_api.ResumeStarlarkRunArgs.prototype.setStartingLineIndex = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string name = 3;
+ * optional string run_id = 2;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.getName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.ResumeStarlarkRunArgs.prototype.getRunId = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceArgs} returns this
+ * @return {!proto.api_container_api.ResumeStarlarkRunArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceArgs.prototype.setName = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.api_container_api.ResumeStarlarkRunArgs.prototype.setRunId = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 
 
 
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -7597,8 +15523,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.CancelStarlarkRunArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.CancelStarlarkRunArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -7607,13 +15533,13 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.CancelStarlarkRunArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.CancelStarlarkRunArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
+    runId: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -7627,23 +15553,23 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.toObject = functio
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.CancelStarlarkRunArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.CancelStarlarkRunArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.StoreFilesArtifactFromServiceResponse;
-  return proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.CancelStarlarkRunArgs;
+  return proto.api_container_api.CancelStarlarkRunArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.CancelStarlarkRunArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse}
+ * @return {!proto.api_container_api.CancelStarlarkRunArgs}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.CancelStarlarkRunArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7652,7 +15578,7 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setUuid(value);
+      msg.setRunId(value);
       break;
     default:
       reader.skipField();
@@ -7667,9 +15593,9 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.deserializeBinaryF
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializeBinary = function() {
+proto.api_container_api.CancelStarlarkRunArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.CancelStarlarkRunArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7677,13 +15603,13 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.serializ
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} message
+ * @param {!proto.api_container_api.CancelStarlarkRunArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.CancelStarlarkRunArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getUuid();
+  f = message.getRunId();
   if (f.length > 0) {
     writer.writeString(
       1,
@@ -7694,19 +15620,19 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.serializeBinaryToW
 
 
 /**
- * optional string uuid = 1;
+ * optional string run_id = 1;
  * @return {string}
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.getUuid = function() {
+proto.api_container_api.CancelStarlarkRunArgs.prototype.getRunId = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.StoreFilesArtifactFromServiceResponse} returns this
+ * @return {!proto.api_container_api.CancelStarlarkRunArgs} returns this
  */
-proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid = function(value) {
+proto.api_container_api.CancelStarlarkRunArgs.prototype.setRunId = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
@@ -7714,6 +15640,7 @@ proto.api_container_api.StoreFilesArtifactFromServiceResponse.prototype.setUuid
 
 
 
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -7727,8 +15654,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FilesArtifactNameAndUuid.toObject(opt_includeInstance, this);
+proto.api_container_api.PersistentDirectory.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.PersistentDirectory.toObject(opt_includeInstance, this);
 };
 
 
@@ -7737,14 +15664,14 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.toObject = function(o
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The msg instance to transform.
+ * @param {!proto.api_container_api.PersistentDirectory} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInstance, msg) {
+proto.api_container_api.PersistentDirectory.toObject = function(includeInstance, msg) {
   var f, obj = {
-    filename: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    fileuuid: jspb.Message.getFieldWithDefault(msg, 2, "")
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    persistentKey: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -7758,23 +15685,23 @@ proto.api_container_api.FilesArtifactNameAndUuid.toObject = function(includeInst
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.PersistentDirectory}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinary = function(bytes) {
+proto.api_container_api.PersistentDirectory.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FilesArtifactNameAndUuid;
-  return proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.PersistentDirectory;
+  return proto.api_container_api.PersistentDirectory.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.PersistentDirectory} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.PersistentDirectory}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.PersistentDirectory.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7783,11 +15710,11 @@ proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = f
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFilename(value);
+      msg.setServiceUuid(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setFileuuid(value);
+      msg.setPersistentKey(value);
       break;
     default:
       reader.skipField();
@@ -7802,9 +15729,9 @@ proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader = f
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = function() {
+proto.api_container_api.PersistentDirectory.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.PersistentDirectory.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7812,20 +15739,20 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.serializeBinary = fun
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid} message
+ * @param {!proto.api_container_api.PersistentDirectory} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.PersistentDirectory.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFilename();
+  f = message.getServiceUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getFileuuid();
+  f = message.getPersistentKey();
   if (f.length > 0) {
     writer.writeString(
       2,
@@ -7836,37 +15763,37 @@ proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter = funct
 
 
 /**
- * optional string fileName = 1;
+ * optional string service_uuid = 1;
  * @return {string}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFilename = function() {
+proto.api_container_api.PersistentDirectory.prototype.getServiceUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * @return {!proto.api_container_api.PersistentDirectory} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFilename = function(value) {
+proto.api_container_api.PersistentDirectory.prototype.setServiceUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional string fileUuid = 2;
+ * optional string persistent_key = 2;
  * @return {string}
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.getFileuuid = function() {
+proto.api_container_api.PersistentDirectory.prototype.getPersistentKey = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid} returns this
+ * @return {!proto.api_container_api.PersistentDirectory} returns this
  */
-proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = function(value) {
+proto.api_container_api.PersistentDirectory.prototype.setPersistentKey = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
@@ -7877,7 +15804,8 @@ proto.api_container_api.FilesArtifactNameAndUuid.prototype.setFileuuid = functio
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.repeatedFields_ = [1];
+proto.api_container_api.ListPersistentDirectoriesResponse.repeatedFields_ = [1];
+
 
 
 
@@ -7894,8 +15822,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.ListPersistentDirectoriesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.ListPersistentDirectoriesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -7904,14 +15832,14 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.toObjec
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.ListPersistentDirectoriesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.ListPersistentDirectoriesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuidsList: jspb.Message.toObjectList(msg.getFileNamesAndUuidsList(),
-    proto.api_container_api.FilesArtifactNameAndUuid.toObject, includeInstance)
+    persistentDirectoriesList: jspb.Message.toObjectList(msg.getPersistentDirectoriesList(),
+    proto.api_container_api.PersistentDirectory.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -7925,23 +15853,23 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.toObject = functi
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.ListPersistentDirectoriesResponse}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.ListPersistentDirectoriesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse;
-  return proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.ListPersistentDirectoriesResponse;
+  return proto.api_container_api.ListPersistentDirectoriesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.ListPersistentDirectoriesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse}
+ * @return {!proto.api_container_api.ListPersistentDirectoriesResponse}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.ListPersistentDirectoriesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -7949,9 +15877,9 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.addFileNamesAndUuids(value);
+      var value = new proto.api_container_api.PersistentDirectory;
+      reader.readMessage(value,proto.api_container_api.PersistentDirectory.deserializeBinaryFromReader);
+      msg.addPersistentDirectories(value);
       break;
     default:
       reader.skipField();
@@ -7966,9 +15894,9 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.deserializeBinary
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.ListPersistentDirectoriesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.ListPersistentDirectoriesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -7976,62 +15904,70 @@ proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.seriali
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} message
+ * @param {!proto.api_container_api.ListPersistentDirectoriesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.ListPersistentDirectoriesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuidsList();
+  f = message.getPersistentDirectoriesList();
   if (f.length > 0) {
     writer.writeRepeatedMessage(
       1,
       f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      proto.api_container_api.PersistentDirectory.serializeBinaryToWriter
     );
   }
 };
 
 
 /**
- * repeated FilesArtifactNameAndUuid file_names_and_uuids = 1;
- * @return {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>}
+ * repeated PersistentDirectory persistent_directories = 1;
+ * @return {!Array<!proto.api_container_api.PersistentDirectory>}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.getFileNamesAndUuidsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+proto.api_container_api.ListPersistentDirectoriesResponse.prototype.getPersistentDirectoriesList = function() {
+  return /** @type{!Array<!proto.api_container_api.PersistentDirectory>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.PersistentDirectory, 1));
 };
 
 
 /**
- * @param {!Array<!proto.api_container_api.FilesArtifactNameAndUuid>} value
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ * @param {!Array<!proto.api_container_api.PersistentDirectory>} value
+ * @return {!proto.api_container_api.ListPersistentDirectoriesResponse} returns this
 */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.setFileNamesAndUuidsList = function(value) {
+proto.api_container_api.ListPersistentDirectoriesResponse.prototype.setPersistentDirectoriesList = function(value) {
   return jspb.Message.setRepeatedWrapperField(this, 1, value);
 };
 
 
 /**
- * @param {!proto.api_container_api.FilesArtifactNameAndUuid=} opt_value
+ * @param {!proto.api_container_api.PersistentDirectory=} opt_value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.FilesArtifactNameAndUuid}
+ * @return {!proto.api_container_api.PersistentDirectory}
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.addFileNamesAndUuids = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FilesArtifactNameAndUuid, opt_index);
+proto.api_container_api.ListPersistentDirectoriesResponse.prototype.addPersistentDirectories = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.PersistentDirectory, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse} returns this
+ * @return {!proto.api_container_api.ListPersistentDirectoriesResponse} returns this
  */
-proto.api_container_api.ListFilesArtifactNamesAndUuidsResponse.prototype.clearFileNamesAndUuidsList = function() {
-  return this.setFileNamesAndUuidsList([]);
+proto.api_container_api.ListPersistentDirectoriesResponse.prototype.clearPersistentDirectoriesList = function() {
+  return this.setPersistentDirectoriesList([]);
 };
 
 
 
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.api_container_api.RemovePersistentDirectoriesArgs.repeatedFields_ = [2];
+
+
 
 
 if (jspb.Message.GENERATE_TO_OBJECT) {
@@ -8047,8 +15983,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.toObject(opt_includeInstance, this);
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemovePersistentDirectoriesArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8057,13 +15993,14 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(includeInstance, msg) {
+proto.api_container_api.RemovePersistentDirectoriesArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileNamesAndUuid: (f = msg.getFileNamesAndUuid()) && proto.api_container_api.FilesArtifactNameAndUuid.toObject(includeInstance, f)
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    persistentKeysList: (f = jspb.Message.getRepeatedField(msg, 2)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -8077,23 +16014,23 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesArgs}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinary = function(bytes) {
+proto.api_container_api.RemovePersistentDirectoriesArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsRequest;
-  return proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RemovePersistentDirectoriesArgs;
+  return proto.api_container_api.RemovePersistentDirectoriesArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest}
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesArgs}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RemovePersistentDirectoriesArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8101,9 +16038,12 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFro
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FilesArtifactNameAndUuid;
-      reader.readMessage(value,proto.api_container_api.FilesArtifactNameAndUuid.deserializeBinaryFromReader);
-      msg.setFileNamesAndUuid(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.addPersistentKeys(value);
       break;
     default:
       reader.skipField();
@@ -8118,9 +16058,9 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeBinary = function() {
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RemovePersistentDirectoriesArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8128,57 +16068,81 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsRequest} message
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RemovePersistentDirectoriesArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileNamesAndUuid();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.api_container_api.FilesArtifactNameAndUuid.serializeBinaryToWriter
+      f
+    );
+  }
+  f = message.getPersistentKeysList();
+  if (f.length > 0) {
+    writer.writeRepeatedString(
+      2,
+      f
     );
   }
 };
 
 
 /**
- * optional FilesArtifactNameAndUuid file_names_and_uuid = 1;
- * @return {?proto.api_container_api.FilesArtifactNameAndUuid}
+ * optional string service_uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.getFileNamesAndUuid = function() {
-  return /** @type{?proto.api_container_api.FilesArtifactNameAndUuid} */ (
-    jspb.Message.getWrapperField(this, proto.api_container_api.FilesArtifactNameAndUuid, 1));
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {?proto.api_container_api.FilesArtifactNameAndUuid|undefined} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
-*/
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.setFileNamesAndUuid = function(value) {
-  return jspb.Message.setWrapperField(this, 1, value);
+ * @param {string} value
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesArgs} returns this
+ */
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * Clears the message field making it undefined.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsRequest} returns this
+ * repeated string persistent_keys = 2;
+ * @return {!Array<string>}
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.clearFileNamesAndUuid = function() {
-  return this.setFileNamesAndUuid(undefined);
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.getPersistentKeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 2));
 };
 
 
 /**
- * Returns whether this field is set.
- * @return {boolean}
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesArgs} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNamesAndUuid = function() {
-  return jspb.Message.getField(this, 1) != null;
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.setPersistentKeysList = function(value) {
+  return jspb.Message.setField(this, 2, value || []);
+};
+
+
+/**
+ * @param {string} value
+ * @param {number=} opt_index
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesArgs} returns this
+ */
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.addPersistentKeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 2, value, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesArgs} returns this
+ */
+proto.api_container_api.RemovePersistentDirectoriesArgs.prototype.clearPersistentKeysList = function() {
+  return this.setPersistentKeysList([]);
 };
 
 
@@ -8188,7 +16152,8 @@ proto.api_container_api.InspectFilesArtifactContentsRequest.prototype.hasFileNam
  * @private {!Array<number>}
  * @const
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.repeatedFields_ = [1];
+proto.api_container_api.RemovePersistentDirectoriesResponse.repeatedFields_ = [1];
+
 
 
 
@@ -8205,8 +16170,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.RemovePersistentDirectoriesResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.RemovePersistentDirectoriesResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8215,14 +16180,13 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.toObject
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.RemovePersistentDirectoriesResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    fileDescriptionsList: jspb.Message.toObjectList(msg.getFileDescriptionsList(),
-    proto.api_container_api.FileArtifactContentsFileDescription.toObject, includeInstance)
+    removedPersistentKeysList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
   };
 
   if (includeInstance) {
@@ -8236,23 +16200,23 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.toObject = function
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.RemovePersistentDirectoriesResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.InspectFilesArtifactContentsResponse;
-  return proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.RemovePersistentDirectoriesResponse;
+  return proto.api_container_api.RemovePersistentDirectoriesResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse}
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesResponse}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.RemovePersistentDirectoriesResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8260,9 +16224,8 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFr
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.api_container_api.FileArtifactContentsFileDescription;
-      reader.readMessage(value,proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader);
-      msg.addFileDescriptions(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.addRemovedPersistentKeys(value);
       break;
     default:
       reader.skipField();
@@ -8277,9 +16240,9 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.deserializeBinaryFr
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serializeBinary = function() {
+proto.api_container_api.RemovePersistentDirectoriesResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.RemovePersistentDirectoriesResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8287,58 +16250,56 @@ proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.serialize
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.InspectFilesArtifactContentsResponse} message
+ * @param {!proto.api_container_api.RemovePersistentDirectoriesResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.RemovePersistentDirectoriesResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getFileDescriptionsList();
+  f = message.getRemovedPersistentKeysList();
   if (f.length > 0) {
-    writer.writeRepeatedMessage(
+    writer.writeRepeatedString(
       1,
-      f,
-      proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter
+      f
     );
   }
 };
 
 
 /**
- * repeated FileArtifactContentsFileDescription file_descriptions = 1;
- * @return {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>}
+ * repeated string removed_persistent_keys = 1;
+ * @return {!Array<string>}
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.getFileDescriptionsList = function() {
-  return /** @type{!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} */ (
-    jspb.Message.getRepeatedWrapperField(this, proto.api_container_api.FileArtifactContentsFileDescription, 1));
+proto.api_container_api.RemovePersistentDirectoriesResponse.prototype.getRemovedPersistentKeysList = function() {
+  return /** @type {!Array<string>} */ (jspb.Message.getRepeatedField(this, 1));
 };
 
-
-/**
- * @param {!Array<!proto.api_container_api.FileArtifactContentsFileDescription>} value
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
-*/
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.setFileDescriptionsList = function(value) {
-  return jspb.Message.setRepeatedWrapperField(this, 1, value);
+
+/**
+ * @param {!Array<string>} value
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesResponse} returns this
+ */
+proto.api_container_api.RemovePersistentDirectoriesResponse.prototype.setRemovedPersistentKeysList = function(value) {
+  return jspb.Message.setField(this, 1, value || []);
 };
 
 
 /**
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription=} opt_value
+ * @param {string} value
  * @param {number=} opt_index
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.addFileDescriptions = function(opt_value, opt_index) {
-  return jspb.Message.addToRepeatedWrapperField(this, 1, opt_value, proto.api_container_api.FileArtifactContentsFileDescription, opt_index);
+proto.api_container_api.RemovePersistentDirectoriesResponse.prototype.addRemovedPersistentKeys = function(value, opt_index) {
+  return jspb.Message.addToRepeatedField(this, 1, value, opt_index);
 };
 
 
 /**
  * Clears the list making it empty but non-null.
- * @return {!proto.api_container_api.InspectFilesArtifactContentsResponse} returns this
+ * @return {!proto.api_container_api.RemovePersistentDirectoriesResponse} returns this
  */
-proto.api_container_api.InspectFilesArtifactContentsResponse.prototype.clearFileDescriptionsList = function() {
-  return this.setFileDescriptionsList([]);
+proto.api_container_api.RemovePersistentDirectoriesResponse.prototype.clearRemovedPersistentKeysList = function() {
+  return this.setRemovedPersistentKeysList([]);
 };
 
 
@@ -8358,8 +16319,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.FileArtifactContentsFileDescription.toObject(opt_includeInstance, this);
+proto.api_container_api.DownloadPersistentDirectoryArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.DownloadPersistentDirectoryArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8368,15 +16329,14 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.toObject =
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The msg instance to transform.
+ * @param {!proto.api_container_api.DownloadPersistentDirectoryArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(includeInstance, msg) {
+proto.api_container_api.DownloadPersistentDirectoryArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    path: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    size: jspb.Message.getFieldWithDefault(msg, 2, 0),
-    textPreview: jspb.Message.getFieldWithDefault(msg, 3, "")
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    persistentKey: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -8390,23 +16350,23 @@ proto.api_container_api.FileArtifactContentsFileDescription.toObject = function(
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.DownloadPersistentDirectoryArgs}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinary = function(bytes) {
+proto.api_container_api.DownloadPersistentDirectoryArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.FileArtifactContentsFileDescription;
-  return proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.DownloadPersistentDirectoryArgs;
+  return proto.api_container_api.DownloadPersistentDirectoryArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.DownloadPersistentDirectoryArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription}
+ * @return {!proto.api_container_api.DownloadPersistentDirectoryArgs}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.DownloadPersistentDirectoryArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8415,15 +16375,11 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
     switch (field) {
     case 1:
       var value = /** @type {string} */ (reader.readString());
-      msg.setPath(value);
+      msg.setServiceUuid(value);
       break;
     case 2:
-      var value = /** @type {number} */ (reader.readUint64());
-      msg.setSize(value);
-      break;
-    case 3:
       var value = /** @type {string} */ (reader.readString());
-      msg.setTextPreview(value);
+      msg.setPersistentKey(value);
       break;
     default:
       reader.skipField();
@@ -8438,9 +16394,9 @@ proto.api_container_api.FileArtifactContentsFileDescription.deserializeBinaryFro
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeBinary = function() {
+proto.api_container_api.DownloadPersistentDirectoryArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.DownloadPersistentDirectoryArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8448,30 +16404,23 @@ proto.api_container_api.FileArtifactContentsFileDescription.prototype.serializeB
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.FileArtifactContentsFileDescription} message
+ * @param {!proto.api_container_api.DownloadPersistentDirectoryArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.DownloadPersistentDirectoryArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getPath();
+  f = message.getServiceUuid();
   if (f.length > 0) {
     writer.writeString(
       1,
       f
     );
   }
-  f = message.getSize();
-  if (f !== 0) {
-    writer.writeUint64(
-      2,
-      f
-    );
-  }
-  f = /** @type {string} */ (jspb.Message.getField(message, 3));
-  if (f != null) {
+  f = message.getPersistentKey();
+  if (f.length > 0) {
     writer.writeString(
-      3,
+      2,
       f
     );
   }
@@ -8479,74 +16428,38 @@ proto.api_container_api.FileArtifactContentsFileDescription.serializeBinaryToWri
 
 
 /**
- * optional string path = 1;
+ * optional string service_uuid = 1;
  * @return {string}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getPath = function() {
+proto.api_container_api.DownloadPersistentDirectoryArgs.prototype.getServiceUuid = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
+ * @return {!proto.api_container_api.DownloadPersistentDirectoryArgs} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setPath = function(value) {
+proto.api_container_api.DownloadPersistentDirectoryArgs.prototype.setServiceUuid = function(value) {
   return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
 /**
- * optional uint64 size = 2;
- * @return {number}
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getSize = function() {
-  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
-};
-
-
-/**
- * @param {number} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setSize = function(value) {
-  return jspb.Message.setProto3IntField(this, 2, value);
-};
-
-
-/**
- * optional string text_preview = 3;
+ * optional string persistent_key = 2;
  * @return {string}
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.getTextPreview = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.api_container_api.DownloadPersistentDirectoryArgs.prototype.getPersistentKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.setTextPreview = function(value) {
-  return jspb.Message.setField(this, 3, value);
-};
-
-
-/**
- * Clears the field making it undefined.
- * @return {!proto.api_container_api.FileArtifactContentsFileDescription} returns this
- */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.clearTextPreview = function() {
-  return jspb.Message.setField(this, 3, undefined);
-};
-
-
-/**
- * Returns whether this field is set.
- * @return {boolean}
+ * @return {!proto.api_container_api.DownloadPersistentDirectoryArgs} returns this
  */
-proto.api_container_api.FileArtifactContentsFileDescription.prototype.hasTextPreview = function() {
-  return jspb.Message.getField(this, 3) != null;
+proto.api_container_api.DownloadPersistentDirectoryArgs.prototype.setPersistentKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
@@ -8566,8 +16479,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesArgs.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -8576,13 +16489,15 @@ proto.api_container_api.ConnectServicesArgs.prototype.toObject = function(opt_in
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    connect: jspb.Message.getFieldWithDefault(msg, 1, 0)
+    serviceUuid: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    persistentKey: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    name: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -8596,23 +16511,23 @@ proto.api_container_api.ConnectServicesArgs.toObject = function(includeInstance,
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesArgs;
-  return proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs;
+  return proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesArgs} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesArgs}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs}
  */
-proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -8620,8 +16535,16 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {!proto.api_container_api.Connect} */ (reader.readEnum());
-      msg.setConnect(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceUuid(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setPersistentKey(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
       break;
     default:
       reader.skipField();
@@ -8636,9 +16559,9 @@ proto.api_container_api.ConnectServicesArgs.deserializeBinaryFromReader = functi
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8646,37 +16569,87 @@ proto.api_container_api.ConnectServicesArgs.prototype.serializeBinary = function
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesArgs} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesArgs.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getConnect();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getServiceUuid();
+  if (f.length > 0) {
+    writer.writeString(
       1,
       f
     );
   }
+  f = message.getPersistentKey();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
 /**
- * optional Connect connect = 1;
- * @return {!proto.api_container_api.Connect}
+ * optional string service_uuid = 1;
+ * @return {string}
  */
-proto.api_container_api.ConnectServicesArgs.prototype.getConnect = function() {
-  return /** @type {!proto.api_container_api.Connect} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.getServiceUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
 };
 
 
 /**
- * @param {!proto.api_container_api.Connect} value
- * @return {!proto.api_container_api.ConnectServicesArgs} returns this
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} returns this
  */
-proto.api_container_api.ConnectServicesArgs.prototype.setConnect = function(value) {
-  return jspb.Message.setProto3EnumField(this, 1, value);
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.setServiceUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string persistent_key = 2;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.getPersistentKey = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.setPersistentKey = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string name = 3;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs.prototype.setName = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
 };
 
 
@@ -8696,8 +16669,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.api_container_api.ConnectServicesResponse.toObject(opt_includeInstance, this);
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.toObject(opt_includeInstance, this);
 };
 
 
@@ -8706,13 +16679,13 @@ proto.api_container_api.ConnectServicesResponse.prototype.toObject = function(op
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The msg instance to transform.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesResponse.toObject = function(includeInstance, msg) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-
+    uuid: jspb.Message.getFieldWithDefault(msg, 1, "")
   };
 
   if (includeInstance) {
@@ -8726,29 +16699,33 @@ proto.api_container_api.ConnectServicesResponse.toObject = function(includeInsta
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse}
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinary = function(bytes) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.api_container_api.ConnectServicesResponse;
-  return proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse;
+  return proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.api_container_api.ConnectServicesResponse} msg The message object to deserialize into.
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.api_container_api.ConnectServicesResponse}
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse}
  */
-proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
     }
     var field = reader.getFieldNumber();
     switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setUuid(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -8762,9 +16739,9 @@ proto.api_container_api.ConnectServicesResponse.deserializeBinaryFromReader = fu
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = function() {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter(this, writer);
+  proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -8772,12 +16749,37 @@ proto.api_container_api.ConnectServicesResponse.prototype.serializeBinary = func
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.api_container_api.ConnectServicesResponse} message
+ * @param {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.api_container_api.ConnectServicesResponse.serializeBinaryToWriter = function(message, writer) {
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
+  f = message.getUuid();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string uuid = 1;
+ * @return {string}
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.prototype.getUuid = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse} returns this
+ */
+proto.api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse.prototype.setUuid = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
 };
 
 
@@ -8805,4 +16807,38 @@ proto.api_container_api.KurtosisFeatureFlag = {
   NO_INSTRUCTIONS_CACHING: 0
 };
 
+/**
+ * @enum {number}
+ */
+proto.api_container_api.PacketDelayDistribution = {
+  UNIFORM: 0,
+  NORMAL: 1
+};
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.ServiceHealthStatus = {
+  HEALTHY: 0,
+  UNHEALTHY: 1
+};
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.PlanFormat = {
+  YAML: 0,
+  JSON: 1
+};
+
+/**
+ * @enum {number}
+ */
+proto.api_container_api.ServiceChangeType = {
+  ADDED: 0,
+  UPDATED: 1,
+  RESTARTED: 2,
+  REMOVED: 3
+};
+
 goog.object.extend(exports, proto.api_container_api);
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { CancelStarlarkRunArgs, ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, DownloadPersistentDirectoryArgs, ExecCommandArgs, ExecCommandResponse, GetEnclaveSnapshotArgs, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunDiffArgs, GetStarlarkRunDiffResponse, GetStarlarkRunPlanArgs, GetStarlarkRunPlanResponse, HttpRequestServiceArgs, HttpRequestServiceResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, ListPersistentDirectoriesResponse, RemovePersistentDirectoriesArgs, RemovePersistentDirectoriesResponse, RepartitionArgs, RestoreEnclaveSnapshotResponse, ResumeStarlarkRunArgs, RunStarlarkPackageArgs, RunStarlarkPackageTestsArgs, RunStarlarkPackageTestsResponse, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromPersistentDirectoryArgs, StoreFilesArtifactFromPersistentDirectoryResponse, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -100,6 +100,17 @@ export declare const ApiContainerService: {
       readonly O: typeof Empty,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Sends an HTTP request to a port of a service from inside the enclave, and returns the response
+     *
+     * @generated from rpc api_container_api.ApiContainerService.HttpRequestService
+     */
+    readonly httpRequestService: {
+      readonly name: "HttpRequestService",
+      readonly I: typeof HttpRequestServiceArgs,
+      readonly O: typeof HttpRequestServiceResponse,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Uploads a files artifact to the Kurtosis File System
      *
@@ -173,6 +184,145 @@ export declare const ApiContainerService: {
       readonly O: typeof ConnectServicesResponse,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Streams back an archive containing the enclave plan, the enclave database, the files artifacts and optionally
+     * the persistent directories of the enclave, which can later be used to restore an equivalent enclave
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveSnapshot
+     */
+    readonly getEnclaveSnapshot: {
+      readonly name: "GetEnclaveSnapshot",
+      readonly I: typeof GetEnclaveSnapshotArgs,
+      readonly O: typeof StreamedDataChunk,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Restores the content of an enclave snapshot into this (empty) enclave. The returned script needs to be run
+     * to recreate the services of the snapshot
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RestoreEnclaveSnapshot
+     */
+    readonly restoreEnclaveSnapshot: {
+      readonly name: "RestoreEnclaveSnapshot",
+      readonly I: typeof StreamedDataChunk,
+      readonly O: typeof RestoreEnclaveSnapshotResponse,
+      readonly kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * Splits the services of the enclave into partitions and conditions the traffic between those partitions
+     *
+     * @generated from rpc api_container_api.ApiContainerService.Repartition
+     */
+    readonly repartition: {
+      readonly name: "Repartition",
+      readonly I: typeof RepartitionArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Runs the test functions of the *_test.star files of a package uploaded beforehand against a fake service network,
+     * without starting any container
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RunStarlarkPackageTests
+     */
+    readonly runStarlarkPackageTests: {
+      readonly name: "RunStarlarkPackageTests",
+      readonly I: typeof RunStarlarkPackageTestsArgs,
+      readonly O: typeof RunStarlarkPackageTestsResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Interprets a Starlark script or package without executing it, and returns the plan describing
+     * what running it against an empty enclave would do
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetStarlarkRunPlan
+     */
+    readonly getStarlarkRunPlan: {
+      readonly name: "GetStarlarkRunPlan",
+      readonly I: typeof GetStarlarkRunPlanArgs,
+      readonly O: typeof GetStarlarkRunPlanResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Interprets a Starlark script or package without executing it, and returns the changes running it would make to
+     * the services of the enclave given the instructions run in the enclave before
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetStarlarkRunDiff
+     */
+    readonly getStarlarkRunDiff: {
+      readonly name: "GetStarlarkRunDiff",
+      readonly I: typeof GetStarlarkRunDiffArgs,
+      readonly O: typeof GetStarlarkRunDiffResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Streams the response lines of the current Starlark run (or the last one if none is running) starting at the given
+     * line index, and keeps streaming until the run finishes. Lets clients reconnect to a run after a disconnect
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ResumeStarlarkRun
+     */
+    readonly resumeStarlarkRun: {
+      readonly name: "ResumeStarlarkRun",
+      readonly I: typeof ResumeStarlarkRunArgs,
+      readonly O: typeof StarlarkRunResponseLine,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Cancels the given Starlark run if it's still running. Runs aren't tied to the stream they were started with, so
+     * this is the way for clients to stop a run
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CancelStarlarkRun
+     */
+    readonly cancelStarlarkRun: {
+      readonly name: "CancelStarlarkRun",
+      readonly I: typeof CancelStarlarkRunArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the persistent directories of the enclave, including the ones of services that were removed since
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ListPersistentDirectories
+     */
+    readonly listPersistentDirectories: {
+      readonly name: "ListPersistentDirectories",
+      readonly I: typeof Empty,
+      readonly O: typeof ListPersistentDirectoriesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Removes persistent directories of a service, along with their content
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RemovePersistentDirectories
+     */
+    readonly removePersistentDirectories: {
+      readonly name: "RemovePersistentDirectories",
+      readonly I: typeof RemovePersistentDirectoriesArgs,
+      readonly O: typeof RemovePersistentDirectoriesResponse,
+      readonly kind: MethodKind.Unary,
+    },
+    /**
+     * Downloads the content of a persistent directory as a TAR
+     *
+     * @generated from rpc api_container_api.ApiContainerService.DownloadPersistentDirectory
+     */
+    readonly downloadPersistentDirectory: {
+      readonly name: "DownloadPersistentDirectory",
+      readonly I: typeof DownloadPersistentDirectoryArgs,
+      readonly O: typeof StreamedDataChunk,
+      readonly kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Stores the content of a persistent directory in a new files artifact, e.g. to seed other persistent directories
+     *
+     * @generated from rpc api_container_api.ApiContainerService.StoreFilesArtifactFromPersistentDirectory
+     */
+    readonly storeFilesArtifactFromPersistentDirectory: {
+      readonly name: "StoreFilesArtifactFromPersistentDirectory",
+      readonly I: typeof StoreFilesArtifactFromPersistentDirectoryArgs,
+      readonly O: typeof StoreFilesArtifactFromPersistentDirectoryResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
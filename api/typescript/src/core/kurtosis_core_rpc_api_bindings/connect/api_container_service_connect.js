@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, ExecCommandArgs, ExecCommandResponse, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, RunStarlarkPackageArgs, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
+import { CancelStarlarkRunArgs, ConnectServicesArgs, ConnectServicesResponse, DownloadFilesArtifactArgs, DownloadPersistentDirectoryArgs, ExecCommandArgs, ExecCommandResponse, GetEnclaveSnapshotArgs, GetExistingAndHistoricalServiceIdentifiersResponse, GetServicesArgs, GetServicesResponse, GetStarlarkRunDiffArgs, GetStarlarkRunDiffResponse, GetStarlarkRunPlanArgs, GetStarlarkRunPlanResponse, HttpRequestServiceArgs, HttpRequestServiceResponse, InspectFilesArtifactContentsRequest, InspectFilesArtifactContentsResponse, ListFilesArtifactNamesAndUuidsResponse, ListPersistentDirectoriesResponse, RemovePersistentDirectoriesArgs, RemovePersistentDirectoriesResponse, RepartitionArgs, RestoreEnclaveSnapshotResponse, ResumeStarlarkRunArgs, RunStarlarkPackageArgs, RunStarlarkPackageTestsArgs, RunStarlarkPackageTestsResponse, RunStarlarkScriptArgs, StarlarkRunResponseLine, StoreFilesArtifactFromPersistentDirectoryArgs, StoreFilesArtifactFromPersistentDirectoryResponse, StoreFilesArtifactFromServiceArgs, StoreFilesArtifactFromServiceResponse, StoreWebFilesArtifactArgs, StoreWebFilesArtifactResponse, StreamedDataChunk, UploadFilesArtifactResponse, WaitForHttpGetEndpointAvailabilityArgs, WaitForHttpPostEndpointAvailabilityArgs } from "./api_container_service_pb.js";
 import { Empty, MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -100,6 +100,17 @@ export const ApiContainerService = {
       O: Empty,
       kind: MethodKind.Unary,
     },
+    /**
+     * Sends an HTTP request to a port of a service from inside the enclave, and returns the response
+     *
+     * @generated from rpc api_container_api.ApiContainerService.HttpRequestService
+     */
+    httpRequestService: {
+      name: "HttpRequestService",
+      I: HttpRequestServiceArgs,
+      O: HttpRequestServiceResponse,
+      kind: MethodKind.Unary,
+    },
     /**
      * Uploads a files artifact to the Kurtosis File System
      *
@@ -173,6 +184,145 @@ export const ApiContainerService = {
       O: ConnectServicesResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Streams back an archive containing the enclave plan, the enclave database, the files artifacts and optionally
+     * the persistent directories of the enclave, which can later be used to restore an equivalent enclave
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetEnclaveSnapshot
+     */
+    getEnclaveSnapshot: {
+      name: "GetEnclaveSnapshot",
+      I: GetEnclaveSnapshotArgs,
+      O: StreamedDataChunk,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Restores the content of an enclave snapshot into this (empty) enclave. The returned script needs to be run
+     * to recreate the services of the snapshot
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RestoreEnclaveSnapshot
+     */
+    restoreEnclaveSnapshot: {
+      name: "RestoreEnclaveSnapshot",
+      I: StreamedDataChunk,
+      O: RestoreEnclaveSnapshotResponse,
+      kind: MethodKind.ClientStreaming,
+    },
+    /**
+     * Splits the services of the enclave into partitions and conditions the traffic between those partitions
+     *
+     * @generated from rpc api_container_api.ApiContainerService.Repartition
+     */
+    repartition: {
+      name: "Repartition",
+      I: RepartitionArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Runs the test functions of the *_test.star files of a package uploaded beforehand against a fake service network,
+     * without starting any container
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RunStarlarkPackageTests
+     */
+    runStarlarkPackageTests: {
+      name: "RunStarlarkPackageTests",
+      I: RunStarlarkPackageTestsArgs,
+      O: RunStarlarkPackageTestsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Interprets a Starlark script or package without executing it, and returns the plan describing
+     * what running it against an empty enclave would do
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetStarlarkRunPlan
+     */
+    getStarlarkRunPlan: {
+      name: "GetStarlarkRunPlan",
+      I: GetStarlarkRunPlanArgs,
+      O: GetStarlarkRunPlanResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Interprets a Starlark script or package without executing it, and returns the changes running it would make to
+     * the services of the enclave given the instructions run in the enclave before
+     *
+     * @generated from rpc api_container_api.ApiContainerService.GetStarlarkRunDiff
+     */
+    getStarlarkRunDiff: {
+      name: "GetStarlarkRunDiff",
+      I: GetStarlarkRunDiffArgs,
+      O: GetStarlarkRunDiffResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Streams the response lines of the current Starlark run (or the last one if none is running) starting at the given
+     * line index, and keeps streaming until the run finishes. Lets clients reconnect to a run after a disconnect
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ResumeStarlarkRun
+     */
+    resumeStarlarkRun: {
+      name: "ResumeStarlarkRun",
+      I: ResumeStarlarkRunArgs,
+      O: StarlarkRunResponseLine,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Cancels the given Starlark run if it's still running. Runs aren't tied to the stream they were started with, so
+     * this is the way for clients to stop a run
+     *
+     * @generated from rpc api_container_api.ApiContainerService.CancelStarlarkRun
+     */
+    cancelStarlarkRun: {
+      name: "CancelStarlarkRun",
+      I: CancelStarlarkRunArgs,
+      O: Empty,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the persistent directories of the enclave, including the ones of services that were removed since
+     *
+     * @generated from rpc api_container_api.ApiContainerService.ListPersistentDirectories
+     */
+    listPersistentDirectories: {
+      name: "ListPersistentDirectories",
+      I: Empty,
+      O: ListPersistentDirectoriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Removes persistent directories of a service, along with their content
+     *
+     * @generated from rpc api_container_api.ApiContainerService.RemovePersistentDirectories
+     */
+    removePersistentDirectories: {
+      name: "RemovePersistentDirectories",
+      I: RemovePersistentDirectoriesArgs,
+      O: RemovePersistentDirectoriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Downloads the content of a persistent directory as a TAR
+     *
+     * @generated from rpc api_container_api.ApiContainerService.DownloadPersistentDirectory
+     */
+    downloadPersistentDirectory: {
+      name: "DownloadPersistentDirectory",
+      I: DownloadPersistentDirectoryArgs,
+      O: StreamedDataChunk,
+      kind: MethodKind.ServerStreaming,
+    },
+    /**
+     * Stores the content of a persistent directory in a new files artifact, e.g. to seed other persistent directories
+     *
+     * @generated from rpc api_container_api.ApiContainerService.StoreFilesArtifactFromPersistentDirectory
+     */
+    storeFilesArtifactFromPersistentDirectory: {
+      name: "StoreFilesArtifactFromPersistentDirectory",
+      I: StoreFilesArtifactFromPersistentDirectoryArgs,
+      O: StoreFilesArtifactFromPersistentDirectoryResponse,
+      kind: MethodKind.Unary,
+    },
   }
 };
 
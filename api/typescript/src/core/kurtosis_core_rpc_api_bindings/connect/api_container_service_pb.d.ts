@@ -60,6 +60,76 @@ export declare enum KurtosisFeatureFlag {
   NO_INSTRUCTIONS_CACHING = 0,
 }
 
+/**
+ * @generated from enum api_container_api.PacketDelayDistribution
+ */
+export declare enum PacketDelayDistribution {
+  /**
+   * @generated from enum value: UNIFORM = 0;
+   */
+  UNIFORM = 0,
+
+  /**
+   * @generated from enum value: NORMAL = 1;
+   */
+  NORMAL = 1,
+}
+
+/**
+ * @generated from enum api_container_api.ServiceHealthStatus
+ */
+export declare enum ServiceHealthStatus {
+  /**
+   * @generated from enum value: HEALTHY = 0;
+   */
+  HEALTHY = 0,
+
+  /**
+   * @generated from enum value: UNHEALTHY = 1;
+   */
+  UNHEALTHY = 1,
+}
+
+/**
+ * @generated from enum api_container_api.PlanFormat
+ */
+export declare enum PlanFormat {
+  /**
+   * @generated from enum value: YAML = 0;
+   */
+  YAML = 0,
+
+  /**
+   * @generated from enum value: JSON = 1;
+   */
+  JSON = 1,
+}
+
+/**
+ * @generated from enum api_container_api.ServiceChangeType
+ */
+export declare enum ServiceChangeType {
+  /**
+   * @generated from enum value: ADDED = 0;
+   */
+  ADDED = 0,
+
+  /**
+   * @generated from enum value: UPDATED = 1;
+   */
+  UPDATED = 1,
+
+  /**
+   * @generated from enum value: RESTARTED = 2;
+   */
+  RESTARTED = 2,
+
+  /**
+   * @generated from enum value: REMOVED = 3;
+   */
+  REMOVED = 3,
+}
+
 /**
  * ==============================================================================================
  *                           Shared Objects (Used By Multiple Endpoints)
@@ -191,6 +261,13 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
    */
   serviceStatus: ServiceStatus;
 
+  /**
+   * Only set for the services having a liveness check or a restart policy
+   *
+   * @generated from field: optional api_container_api.ServiceHealth health = 9;
+   */
+  health?: ServiceHealth;
+
   constructor(data?: PartialMessage<ServiceInfo>);
 
   static readonly runtime: typeof proto3;
@@ -206,6 +283,53 @@ export declare class ServiceInfo extends Message<ServiceInfo> {
   static equals(a: ServiceInfo | PlainMessage<ServiceInfo> | undefined, b: ServiceInfo | PlainMessage<ServiceInfo> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.ServiceHealth
+ */
+export declare class ServiceHealth extends Message<ServiceHealth> {
+  /**
+   * Unhealthy as long as the last liveness check, or the last check of the container of the service, failed
+   *
+   * @generated from field: api_container_api.ServiceHealthStatus status = 1;
+   */
+  status: ServiceHealthStatus;
+
+  /**
+   * Number of times the service was restarted by its restart policy
+   *
+   * @generated from field: uint32 num_restarts = 2;
+   */
+  numRestarts: number;
+
+  /**
+   * Empty if the service never failed
+   *
+   * @generated from field: string last_failure_reason = 3;
+   */
+  lastFailureReason: string;
+
+  /**
+   * 0 if the service never failed
+   *
+   * @generated from field: int64 last_failure_timestamp_ms = 4;
+   */
+  lastFailureTimestampMs: bigint;
+
+  constructor(data?: PartialMessage<ServiceHealth>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceHealth";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceHealth;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceHealth;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceHealth;
+
+  static equals(a: ServiceHealth | PlainMessage<ServiceHealth> | undefined, b: ServiceHealth | PlainMessage<ServiceHealth> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.RunStarlarkScriptArgs
  */
@@ -246,6 +370,20 @@ export declare class RunStarlarkScriptArgs extends Message<RunStarlarkScriptArgs
    */
   experimentalFeatures: KurtosisFeatureFlag[];
 
+  /**
+   * The git hosts the packages can be cloned from on top of GitHub, for this run only
+   *
+   * @generated from field: repeated api_container_api.GitHost git_hosts = 7;
+   */
+  gitHosts: GitHost[];
+
+  /**
+   * If true, packages are never cloned and must be in the package cache. Defaults to false
+   *
+   * @generated from field: bool offline = 8;
+   */
+  offline: boolean;
+
   constructor(data?: PartialMessage<RunStarlarkScriptArgs>);
 
   static readonly runtime: typeof proto3;
@@ -346,6 +484,36 @@ export declare class RunStarlarkPackageArgs extends Message<RunStarlarkPackageAr
    */
   experimentalFeatures: KurtosisFeatureFlag[];
 
+  /**
+   * The packages replaced by a local copy, which must have been uploaded with UploadStarlarkPackage prior to calling
+   * RunStarlarkPackage. Every locator pointing into one of them resolves to the local copy rather than to GitHub
+   *
+   * @generated from field: repeated string locally_replaced_package_ids = 12;
+   */
+  locallyReplacedPackageIds: string[];
+
+  /**
+   * Whether the services and files artifacts present in the enclave but absent from the plan of the package should be
+   * removed once the package ran successfully. Defaults to false
+   *
+   * @generated from field: optional bool prune = 13;
+   */
+  prune?: boolean;
+
+  /**
+   * The git hosts the packages can be cloned from on top of GitHub, for this run only
+   *
+   * @generated from field: repeated api_container_api.GitHost git_hosts = 14;
+   */
+  gitHosts: GitHost[];
+
+  /**
+   * If true, packages are never cloned and must be in the package cache. Defaults to false
+   *
+   * @generated from field: bool offline = 15;
+   */
+  offline: boolean;
+
   constructor(data?: PartialMessage<RunStarlarkPackageArgs>);
 
   static readonly runtime: typeof proto3;
@@ -408,6 +576,24 @@ export declare class StarlarkRunResponseLine extends Message<StarlarkRunResponse
      */
     value: StarlarkWarning;
     case: "warning";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkInstructionMetrics instruction_metrics = 7;
+     */
+    value: StarlarkInstructionMetrics;
+    case: "instructionMetrics";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkRunStartedEvent run_started_event = 8;
+     */
+    value: StarlarkRunStartedEvent;
+    case: "runStartedEvent";
+  } | {
+    /**
+     * @generated from field: api_container_api.StarlarkInfo info = 9;
+     */
+    value: StarlarkInfo;
+    case: "info";
   } | { case: undefined; value?: undefined };
 
   constructor(data?: PartialMessage<StarlarkRunResponseLine>);
@@ -449,6 +635,33 @@ export declare class StarlarkWarning extends Message<StarlarkWarning> {
   static equals(a: StarlarkWarning | PlainMessage<StarlarkWarning> | undefined, b: StarlarkWarning | PlainMessage<StarlarkWarning> | undefined): boolean;
 }
 
+/**
+ * Information about the run that isn't the output of an instruction, like the services and files artifacts pruned
+ * at the end of the run
+ *
+ * @generated from message api_container_api.StarlarkInfo
+ */
+export declare class StarlarkInfo extends Message<StarlarkInfo> {
+  /**
+   * @generated from field: string info_message = 1;
+   */
+  infoMessage: string;
+
+  constructor(data?: PartialMessage<StarlarkInfo>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkInfo";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkInfo;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkInfo;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkInfo;
+
+  static equals(a: StarlarkInfo | PlainMessage<StarlarkInfo> | undefined, b: StarlarkInfo | PlainMessage<StarlarkInfo> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.StarlarkInstruction
  */
@@ -517,6 +730,59 @@ export declare class StarlarkInstructionResult extends Message<StarlarkInstructi
   static equals(a: StarlarkInstructionResult | PlainMessage<StarlarkInstructionResult> | undefined, b: StarlarkInstructionResult | PlainMessage<StarlarkInstructionResult> | undefined): boolean;
 }
 
+/**
+ * Sent once an instruction is done executing, right before its result or error
+ *
+ * @generated from message api_container_api.StarlarkInstructionMetrics
+ */
+export declare class StarlarkInstructionMetrics extends Message<StarlarkInstructionMetrics> {
+  /**
+   * Unix timestamps, in milliseconds
+   *
+   * @generated from field: int64 start_timestamp_ms = 1;
+   */
+  startTimestampMs: bigint;
+
+  /**
+   * @generated from field: int64 end_timestamp_ms = 2;
+   */
+  endTimestampMs: bigint;
+
+  /**
+   * @generated from field: uint64 duration_ms = 3;
+   */
+  durationMs: bigint;
+
+  /**
+   * Time spent pulling the container images this instruction was the first to require. Images are pulled before the
+   * execution starts, so this is not included in duration_ms
+   *
+   * @generated from field: uint64 image_pull_duration_ms = 4;
+   */
+  imagePullDurationMs: bigint;
+
+  /**
+   * Number of times a recipe, readiness check or other operation had to be retried before succeeding or timing out
+   *
+   * @generated from field: uint32 num_retries = 5;
+   */
+  numRetries: number;
+
+  constructor(data?: PartialMessage<StarlarkInstructionMetrics>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkInstructionMetrics";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkInstructionMetrics;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkInstructionMetrics;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkInstructionMetrics;
+
+  static equals(a: StarlarkInstructionMetrics | PlainMessage<StarlarkInstructionMetrics> | undefined, b: StarlarkInstructionMetrics | PlainMessage<StarlarkInstructionMetrics> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.StarlarkInstructionArg
  */
@@ -733,6 +999,34 @@ export declare class StarlarkRunProgress extends Message<StarlarkRunProgress> {
   static equals(a: StarlarkRunProgress | PlainMessage<StarlarkRunProgress> | undefined, b: StarlarkRunProgress | PlainMessage<StarlarkRunProgress> | undefined): boolean;
 }
 
+/**
+ * The first response line of every run
+ *
+ * @generated from message api_container_api.StarlarkRunStartedEvent
+ */
+export declare class StarlarkRunStartedEvent extends Message<StarlarkRunStartedEvent> {
+  /**
+   * Identifies the run, to resume streaming its response lines with ResumeStarlarkRun
+   *
+   * @generated from field: string run_id = 1;
+   */
+  runId: string;
+
+  constructor(data?: PartialMessage<StarlarkRunStartedEvent>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkRunStartedEvent";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkRunStartedEvent;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkRunStartedEvent;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkRunStartedEvent;
+
+  static equals(a: StarlarkRunStartedEvent | PlainMessage<StarlarkRunStartedEvent> | undefined, b: StarlarkRunStartedEvent | PlainMessage<StarlarkRunStartedEvent> | undefined): boolean;
+}
+
 /**
  * @generated from message api_container_api.StarlarkRunFinishedEvent
  */
@@ -1104,117 +1398,295 @@ export declare class WaitForHttpPostEndpointAvailabilityArgs extends Message<Wai
 
 /**
  * ==============================================================================================
- *                                          Streamed Data Chunk
+ *                                     HTTP Request Service
  * ==============================================================================================
  *
- * @generated from message api_container_api.StreamedDataChunk
+ * @generated from message api_container_api.HttpRequestServiceArgs
  */
-export declare class StreamedDataChunk extends Message<StreamedDataChunk> {
+export declare class HttpRequestServiceArgs extends Message<HttpRequestServiceArgs> {
   /**
-   * Chunk of the overall files artifact bytes
+   * The identifier of the service to send the request to
    *
-   * @generated from field: bytes data = 1;
+   * @generated from field: string service_identifier = 1;
    */
-  data: Uint8Array;
+  serviceIdentifier: string;
 
   /**
-   * Hash of the PREVIOUS chunk, or empty string is this is the first chunk
-   * Referencing the previous chunk via its hash allows Kurtosis to validate
-   * the consistency of the data in case some chunk were not received
+   * The ID of the port of the service to send the request to. For instance `http`
    *
-   * @generated from field: string previous_chunk_hash = 2;
+   * @generated from field: string port_id = 2;
    */
-  previousChunkHash: string;
+  portId: string;
 
   /**
-   * Additional metadata about the item being streamed
+   * The HTTP method of the request. Defaults to GET if empty
    *
-   * @generated from field: api_container_api.DataChunkMetadata metadata = 3;
+   * @generated from field: string method = 3;
    */
-  metadata?: DataChunkMetadata;
+  method: string;
 
-  constructor(data?: PartialMessage<StreamedDataChunk>);
+  /**
+   * The endpoint of the request, starting with a slash and optionally containing a query string. For instance `/health`
+   *
+   * @generated from field: string endpoint = 4;
+   */
+  endpoint: string;
+
+  /**
+   * The headers of the request. A header set multiple times gets the value of the last occurrence
+   *
+   * @generated from field: repeated api_container_api.HttpHeader headers = 5;
+   */
+  headers: HttpHeader[];
+
+  /**
+   * The query parameters added to the query string of the endpoint
+   *
+   * @generated from field: repeated api_container_api.HttpQueryParam query_params = 6;
+   */
+  queryParams: HttpQueryParam[];
+
+  /**
+   * @generated from field: string body = 7;
+   */
+  body: string;
+
+  /**
+   * Whether the request should be sent over HTTPS
+   *
+   * @generated from field: bool use_tls = 8;
+   */
+  useTls: boolean;
+
+  /**
+   * Whether to accept any certificate presented by the service when use_tls is true, like self-signed ones
+   *
+   * @generated from field: bool skip_tls_verify = 9;
+   */
+  skipTlsVerify: boolean;
+
+  constructor(data?: PartialMessage<HttpRequestServiceArgs>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "api_container_api.StreamedDataChunk";
+  static readonly typeName = "api_container_api.HttpRequestServiceArgs";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamedDataChunk;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HttpRequestServiceArgs;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamedDataChunk;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HttpRequestServiceArgs;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamedDataChunk;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HttpRequestServiceArgs;
 
-  static equals(a: StreamedDataChunk | PlainMessage<StreamedDataChunk> | undefined, b: StreamedDataChunk | PlainMessage<StreamedDataChunk> | undefined): boolean;
+  static equals(a: HttpRequestServiceArgs | PlainMessage<HttpRequestServiceArgs> | undefined, b: HttpRequestServiceArgs | PlainMessage<HttpRequestServiceArgs> | undefined): boolean;
 }
 
 /**
- * @generated from message api_container_api.DataChunkMetadata
+ * @generated from message api_container_api.HttpRequestServiceResponse
  */
-export declare class DataChunkMetadata extends Message<DataChunkMetadata> {
+export declare class HttpRequestServiceResponse extends Message<HttpRequestServiceResponse> {
   /**
-   * @generated from field: string name = 1;
+   * @generated from field: int32 status_code = 1;
    */
-  name: string;
+  statusCode: number;
 
-  constructor(data?: PartialMessage<DataChunkMetadata>);
+  /**
+   * The headers of the response. A header with multiple values appears once per value
+   *
+   * @generated from field: repeated api_container_api.HttpHeader headers = 2;
+   */
+  headers: HttpHeader[];
+
+  /**
+   * @generated from field: string body = 3;
+   */
+  body: string;
+
+  constructor(data?: PartialMessage<HttpRequestServiceResponse>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "api_container_api.DataChunkMetadata";
+  static readonly typeName = "api_container_api.HttpRequestServiceResponse";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DataChunkMetadata;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HttpRequestServiceResponse;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DataChunkMetadata;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HttpRequestServiceResponse;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DataChunkMetadata;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HttpRequestServiceResponse;
 
-  static equals(a: DataChunkMetadata | PlainMessage<DataChunkMetadata> | undefined, b: DataChunkMetadata | PlainMessage<DataChunkMetadata> | undefined): boolean;
+  static equals(a: HttpRequestServiceResponse | PlainMessage<HttpRequestServiceResponse> | undefined, b: HttpRequestServiceResponse | PlainMessage<HttpRequestServiceResponse> | undefined): boolean;
 }
 
 /**
- * ==============================================================================================
- *                                          Upload Files Artifact
- * ==============================================================================================
- *
- * @generated from message api_container_api.UploadFilesArtifactResponse
+ * @generated from message api_container_api.HttpHeader
  */
-export declare class UploadFilesArtifactResponse extends Message<UploadFilesArtifactResponse> {
+export declare class HttpHeader extends Message<HttpHeader> {
   /**
-   * UUID of the files artifact, for use when referencing it in the future
-   *
-   * @generated from field: string uuid = 1;
+   * @generated from field: string name = 1;
    */
-  uuid: string;
+  name: string;
 
   /**
-   * UUID of the files artifact, for use when referencing it in the future
-   *
-   * @generated from field: string name = 2;
+   * @generated from field: string value = 2;
    */
-  name: string;
+  value: string;
 
-  constructor(data?: PartialMessage<UploadFilesArtifactResponse>);
+  constructor(data?: PartialMessage<HttpHeader>);
 
   static readonly runtime: typeof proto3;
-  static readonly typeName = "api_container_api.UploadFilesArtifactResponse";
+  static readonly typeName = "api_container_api.HttpHeader";
   static readonly fields: FieldList;
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadFilesArtifactResponse;
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HttpHeader;
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadFilesArtifactResponse;
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HttpHeader;
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadFilesArtifactResponse;
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HttpHeader;
 
-  static equals(a: UploadFilesArtifactResponse | PlainMessage<UploadFilesArtifactResponse> | undefined, b: UploadFilesArtifactResponse | PlainMessage<UploadFilesArtifactResponse> | undefined): boolean;
+  static equals(a: HttpHeader | PlainMessage<HttpHeader> | undefined, b: HttpHeader | PlainMessage<HttpHeader> | undefined): boolean;
 }
 
 /**
- * ==============================================================================================
- *                                          Download Files Artifact
- * ==============================================================================================
- *
- * @generated from message api_container_api.DownloadFilesArtifactArgs
+ * @generated from message api_container_api.HttpQueryParam
+ */
+export declare class HttpQueryParam extends Message<HttpQueryParam> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string value = 2;
+   */
+  value: string;
+
+  constructor(data?: PartialMessage<HttpQueryParam>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.HttpQueryParam";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): HttpQueryParam;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): HttpQueryParam;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): HttpQueryParam;
+
+  static equals(a: HttpQueryParam | PlainMessage<HttpQueryParam> | undefined, b: HttpQueryParam | PlainMessage<HttpQueryParam> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                          Streamed Data Chunk
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.StreamedDataChunk
+ */
+export declare class StreamedDataChunk extends Message<StreamedDataChunk> {
+  /**
+   * Chunk of the overall files artifact bytes
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+
+  /**
+   * Hash of the PREVIOUS chunk, or empty string is this is the first chunk
+   * Referencing the previous chunk via its hash allows Kurtosis to validate
+   * the consistency of the data in case some chunk were not received
+   *
+   * @generated from field: string previous_chunk_hash = 2;
+   */
+  previousChunkHash: string;
+
+  /**
+   * Additional metadata about the item being streamed
+   *
+   * @generated from field: api_container_api.DataChunkMetadata metadata = 3;
+   */
+  metadata?: DataChunkMetadata;
+
+  constructor(data?: PartialMessage<StreamedDataChunk>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StreamedDataChunk";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StreamedDataChunk;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StreamedDataChunk;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StreamedDataChunk;
+
+  static equals(a: StreamedDataChunk | PlainMessage<StreamedDataChunk> | undefined, b: StreamedDataChunk | PlainMessage<StreamedDataChunk> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.DataChunkMetadata
+ */
+export declare class DataChunkMetadata extends Message<DataChunkMetadata> {
+  /**
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<DataChunkMetadata>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.DataChunkMetadata";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DataChunkMetadata;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DataChunkMetadata;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DataChunkMetadata;
+
+  static equals(a: DataChunkMetadata | PlainMessage<DataChunkMetadata> | undefined, b: DataChunkMetadata | PlainMessage<DataChunkMetadata> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                          Upload Files Artifact
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.UploadFilesArtifactResponse
+ */
+export declare class UploadFilesArtifactResponse extends Message<UploadFilesArtifactResponse> {
+  /**
+   * UUID of the files artifact, for use when referencing it in the future
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * UUID of the files artifact, for use when referencing it in the future
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<UploadFilesArtifactResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.UploadFilesArtifactResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UploadFilesArtifactResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UploadFilesArtifactResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UploadFilesArtifactResponse;
+
+  static equals(a: UploadFilesArtifactResponse | PlainMessage<UploadFilesArtifactResponse> | undefined, b: UploadFilesArtifactResponse | PlainMessage<UploadFilesArtifactResponse> | undefined): boolean;
+}
+
+/**
+ * ==============================================================================================
+ *                                          Download Files Artifact
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.DownloadFilesArtifactArgs
  */
 export declare class DownloadFilesArtifactArgs extends Message<DownloadFilesArtifactArgs> {
   /**
@@ -1556,3 +2028,926 @@ export declare class ConnectServicesResponse extends Message<ConnectServicesResp
   static equals(a: ConnectServicesResponse | PlainMessage<ConnectServicesResponse> | undefined, b: ConnectServicesResponse | PlainMessage<ConnectServicesResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message api_container_api.GetEnclaveSnapshotArgs
+ */
+export declare class GetEnclaveSnapshotArgs extends Message<GetEnclaveSnapshotArgs> {
+  /**
+   * Whether the content of the persistent directories of the enclave services should be added to the snapshot
+   *
+   * @generated from field: optional bool include_persistent_directories = 1;
+   */
+  includePersistentDirectories?: boolean;
+
+  constructor(data?: PartialMessage<GetEnclaveSnapshotArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetEnclaveSnapshotArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetEnclaveSnapshotArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetEnclaveSnapshotArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetEnclaveSnapshotArgs;
+
+  static equals(a: GetEnclaveSnapshotArgs | PlainMessage<GetEnclaveSnapshotArgs> | undefined, b: GetEnclaveSnapshotArgs | PlainMessage<GetEnclaveSnapshotArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RestoreEnclaveSnapshotResponse
+ */
+export declare class RestoreEnclaveSnapshotResponse extends Message<RestoreEnclaveSnapshotResponse> {
+  /**
+   * Starlark script recreating the services of the snapshot, to be run against the restored enclave
+   *
+   * @generated from field: string serialized_replay_script = 1;
+   */
+  serializedReplayScript: string;
+
+  /**
+   * Number of files artifacts restored from the snapshot
+   *
+   * @generated from field: uint32 num_restored_files_artifacts = 2;
+   */
+  numRestoredFilesArtifacts: number;
+
+  constructor(data?: PartialMessage<RestoreEnclaveSnapshotResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RestoreEnclaveSnapshotResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RestoreEnclaveSnapshotResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RestoreEnclaveSnapshotResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RestoreEnclaveSnapshotResponse;
+
+  static equals(a: RestoreEnclaveSnapshotResponse | PlainMessage<RestoreEnclaveSnapshotResponse> | undefined, b: RestoreEnclaveSnapshotResponse | PlainMessage<RestoreEnclaveSnapshotResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RepartitionArgs
+ */
+export declare class RepartitionArgs extends Message<RepartitionArgs> {
+  /**
+   * Partition of each service of the enclave. Services not listed here are placed in the default partition
+   *
+   * @generated from field: repeated api_container_api.ServicePartition service_partitions = 1;
+   */
+  servicePartitions: ServicePartition[];
+
+  /**
+   * Connections between pairs of partitions, overriding the default connection
+   *
+   * @generated from field: repeated api_container_api.PartitionConnection partition_connections = 2;
+   */
+  partitionConnections: PartitionConnection[];
+
+  /**
+   * Connection applied between partitions that don't have an explicit connection
+   *
+   * @generated from field: api_container_api.PartitionConnectionInfo default_connection = 3;
+   */
+  defaultConnection?: PartitionConnectionInfo;
+
+  constructor(data?: PartialMessage<RepartitionArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RepartitionArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RepartitionArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RepartitionArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RepartitionArgs;
+
+  static equals(a: RepartitionArgs | PlainMessage<RepartitionArgs> | undefined, b: RepartitionArgs | PlainMessage<RepartitionArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServicePartition
+ */
+export declare class ServicePartition extends Message<ServicePartition> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * @generated from field: string partition_id = 2;
+   */
+  partitionId: string;
+
+  constructor(data?: PartialMessage<ServicePartition>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServicePartition";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServicePartition;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServicePartition;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServicePartition;
+
+  static equals(a: ServicePartition | PlainMessage<ServicePartition> | undefined, b: ServicePartition | PlainMessage<ServicePartition> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.PartitionConnection
+ */
+export declare class PartitionConnection extends Message<PartitionConnection> {
+  /**
+   * @generated from field: string partition1 = 1;
+   */
+  partition1: string;
+
+  /**
+   * @generated from field: string partition2 = 2;
+   */
+  partition2: string;
+
+  /**
+   * @generated from field: api_container_api.PartitionConnectionInfo info = 3;
+   */
+  info?: PartitionConnectionInfo;
+
+  constructor(data?: PartialMessage<PartitionConnection>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.PartitionConnection";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PartitionConnection;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PartitionConnection;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PartitionConnection;
+
+  static equals(a: PartitionConnection | PlainMessage<PartitionConnection> | undefined, b: PartitionConnection | PlainMessage<PartitionConnection> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.PartitionConnectionInfo
+ */
+export declare class PartitionConnectionInfo extends Message<PartitionConnectionInfo> {
+  /**
+   * Percentage of the packets dropped, between 0 and 100
+   *
+   * @generated from field: float packet_loss_percentage = 1;
+   */
+  packetLossPercentage: number;
+
+  /**
+   * Average delay added to the packets, in milliseconds
+   *
+   * @generated from field: uint32 delay_ms = 2;
+   */
+  delayMs: number;
+
+  /**
+   * Jitter of the delay for a uniform distribution, or its standard deviation for a normal distribution
+   *
+   * @generated from field: uint32 jitter_ms = 3;
+   */
+  jitterMs: number;
+
+  /**
+   * Correlation between the delays of consecutive packets, between 0 and 100. Only used by the normal distribution
+   *
+   * @generated from field: float delay_correlation = 4;
+   */
+  delayCorrelation: number;
+
+  /**
+   * @generated from field: api_container_api.PacketDelayDistribution delay_distribution = 5;
+   */
+  delayDistribution: PacketDelayDistribution;
+
+  constructor(data?: PartialMessage<PartitionConnectionInfo>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.PartitionConnectionInfo";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PartitionConnectionInfo;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PartitionConnectionInfo;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PartitionConnectionInfo;
+
+  static equals(a: PartitionConnectionInfo | PlainMessage<PartitionConnectionInfo> | undefined, b: PartitionConnectionInfo | PlainMessage<PartitionConnectionInfo> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GitHost
+ */
+export declare class GitHost extends Message<GitHost> {
+  /**
+   * The first segment of the locators of the packages on this host, e.g. gitlab.com
+   *
+   * @generated from field: string host = 1;
+   */
+  host: string;
+
+  /**
+   * What the repositories are cloned from, e.g. https://gitlab.com or file:///srv/git. Defaults to https://<host>
+   *
+   * @generated from field: string clone_url_prefix = 2;
+   */
+  cloneUrlPrefix: string;
+
+  /**
+   * Credentials used to clone private repositories over HTTPS; the username defaults to "git"
+   *
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * @generated from field: string token = 4;
+   */
+  token: string;
+
+  constructor(data?: PartialMessage<GitHost>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GitHost";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GitHost;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GitHost;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GitHost;
+
+  static equals(a: GitHost | PlainMessage<GitHost> | undefined, b: GitHost | PlainMessage<GitHost> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RunStarlarkPackageTestsArgs
+ */
+export declare class RunStarlarkPackageTestsArgs extends Message<RunStarlarkPackageTestsArgs> {
+  /**
+   * The package must have been uploaded with UploadStarlarkPackage beforehand
+   *
+   * @generated from field: string package_id = 1;
+   */
+  packageId: string;
+
+  /**
+   * Only the tests whose name contains this string are run. All the tests are run if empty
+   *
+   * @generated from field: string test_name_filter = 2;
+   */
+  testNameFilter: string;
+
+  /**
+   * The dependencies of the package replaced by local packages, uploaded with UploadStarlarkPackage beforehand
+   *
+   * @generated from field: repeated string locally_replaced_package_ids = 3;
+   */
+  locallyReplacedPackageIds: string[];
+
+  /**
+   * The git hosts the packages can be cloned from on top of GitHub, for this run only
+   *
+   * @generated from field: repeated api_container_api.GitHost git_hosts = 4;
+   */
+  gitHosts: GitHost[];
+
+  /**
+   * If true, packages are never cloned and must be in the package cache. Defaults to false
+   *
+   * @generated from field: bool offline = 5;
+   */
+  offline: boolean;
+
+  constructor(data?: PartialMessage<RunStarlarkPackageTestsArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RunStarlarkPackageTestsArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunStarlarkPackageTestsArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunStarlarkPackageTestsArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunStarlarkPackageTestsArgs;
+
+  static equals(a: RunStarlarkPackageTestsArgs | PlainMessage<RunStarlarkPackageTestsArgs> | undefined, b: RunStarlarkPackageTestsArgs | PlainMessage<RunStarlarkPackageTestsArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RunStarlarkPackageTestsResponse
+ */
+export declare class RunStarlarkPackageTestsResponse extends Message<RunStarlarkPackageTestsResponse> {
+  /**
+   * @generated from field: repeated api_container_api.StarlarkTestResult test_results = 1;
+   */
+  testResults: StarlarkTestResult[];
+
+  constructor(data?: PartialMessage<RunStarlarkPackageTestsResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RunStarlarkPackageTestsResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RunStarlarkPackageTestsResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RunStarlarkPackageTestsResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RunStarlarkPackageTestsResponse;
+
+  static equals(a: RunStarlarkPackageTestsResponse | PlainMessage<RunStarlarkPackageTestsResponse> | undefined, b: RunStarlarkPackageTestsResponse | PlainMessage<RunStarlarkPackageTestsResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StarlarkTestResult
+ */
+export declare class StarlarkTestResult extends Message<StarlarkTestResult> {
+  /**
+   * The path of the test file, relative to the root of the package
+   *
+   * @generated from field: string file = 1;
+   */
+  file: string;
+
+  /**
+   * The name of the test function
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: bool passed = 3;
+   */
+  passed: boolean;
+
+  /**
+   * Why the test failed, empty if it passed
+   *
+   * @generated from field: string failure_message = 4;
+   */
+  failureMessage: string;
+
+  /**
+   * @generated from field: uint64 duration_ms = 5;
+   */
+  durationMs: bigint;
+
+  constructor(data?: PartialMessage<StarlarkTestResult>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StarlarkTestResult";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StarlarkTestResult;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StarlarkTestResult;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StarlarkTestResult;
+
+  static equals(a: StarlarkTestResult | PlainMessage<StarlarkTestResult> | undefined, b: StarlarkTestResult | PlainMessage<StarlarkTestResult> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunPlanArgs
+ */
+export declare class GetStarlarkRunPlanArgs extends Message<GetStarlarkRunPlanArgs> {
+  /**
+   * Empty for a standalone script
+   *
+   * @generated from field: string package_id = 1;
+   */
+  packageId: string;
+
+  /**
+   * The standalone script to interpret, when there's no package
+   *
+   * @generated from field: string serialized_script = 2;
+   */
+  serializedScript: string;
+
+  /**
+   * @generated from field: string relative_path_to_main_file = 3;
+   */
+  relativePathToMainFile: string;
+
+  /**
+   * @generated from field: string main_function_name = 4;
+   */
+  mainFunctionName: string;
+
+  /**
+   * @generated from field: string serialized_params = 5;
+   */
+  serializedParams: string;
+
+  /**
+   * The dependencies of the package replaced by local packages, uploaded with UploadStarlarkPackage beforehand
+   *
+   * @generated from field: repeated string locally_replaced_package_ids = 6;
+   */
+  locallyReplacedPackageIds: string[];
+
+  /**
+   * @generated from field: api_container_api.PlanFormat format = 7;
+   */
+  format: PlanFormat;
+
+  /**
+   * Whether the package should be cloned from its repository, or has been uploaded with UploadStarlarkPackage beforehand
+   *
+   * @generated from field: bool clone_package = 8;
+   */
+  clonePackage: boolean;
+
+  /**
+   * The git hosts the packages can be cloned from on top of GitHub, for this run only
+   *
+   * @generated from field: repeated api_container_api.GitHost git_hosts = 9;
+   */
+  gitHosts: GitHost[];
+
+  /**
+   * If true, packages are never cloned and must be in the package cache. Defaults to false
+   *
+   * @generated from field: bool offline = 10;
+   */
+  offline: boolean;
+
+  constructor(data?: PartialMessage<GetStarlarkRunPlanArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetStarlarkRunPlanArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStarlarkRunPlanArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStarlarkRunPlanArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStarlarkRunPlanArgs;
+
+  static equals(a: GetStarlarkRunPlanArgs | PlainMessage<GetStarlarkRunPlanArgs> | undefined, b: GetStarlarkRunPlanArgs | PlainMessage<GetStarlarkRunPlanArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunPlanResponse
+ */
+export declare class GetStarlarkRunPlanResponse extends Message<GetStarlarkRunPlanResponse> {
+  /**
+   * Set when the Starlark code couldn't be interpreted, in which case there's no plan
+   *
+   * @generated from field: api_container_api.StarlarkInterpretationError interpretation_error = 1;
+   */
+  interpretationError?: StarlarkInterpretationError;
+
+  /**
+   * @generated from field: string serialized_plan = 2;
+   */
+  serializedPlan: string;
+
+  constructor(data?: PartialMessage<GetStarlarkRunPlanResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetStarlarkRunPlanResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStarlarkRunPlanResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStarlarkRunPlanResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStarlarkRunPlanResponse;
+
+  static equals(a: GetStarlarkRunPlanResponse | PlainMessage<GetStarlarkRunPlanResponse> | undefined, b: GetStarlarkRunPlanResponse | PlainMessage<GetStarlarkRunPlanResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunDiffArgs
+ */
+export declare class GetStarlarkRunDiffArgs extends Message<GetStarlarkRunDiffArgs> {
+  /**
+   * Empty for a standalone script
+   *
+   * @generated from field: string package_id = 1;
+   */
+  packageId: string;
+
+  /**
+   * The standalone script to interpret, when there's no package
+   *
+   * @generated from field: string serialized_script = 2;
+   */
+  serializedScript: string;
+
+  /**
+   * @generated from field: string relative_path_to_main_file = 3;
+   */
+  relativePathToMainFile: string;
+
+  /**
+   * @generated from field: string main_function_name = 4;
+   */
+  mainFunctionName: string;
+
+  /**
+   * @generated from field: string serialized_params = 5;
+   */
+  serializedParams: string;
+
+  /**
+   * The dependencies of the package replaced by local packages, uploaded with UploadStarlarkPackage beforehand
+   *
+   * @generated from field: repeated string locally_replaced_package_ids = 6;
+   */
+  locallyReplacedPackageIds: string[];
+
+  /**
+   * Whether the package should be cloned from its repository, or has been uploaded with UploadStarlarkPackage beforehand
+   *
+   * @generated from field: bool clone_package = 7;
+   */
+  clonePackage: boolean;
+
+  /**
+   * Whether the services the run would prune from the enclave should be part of the diff
+   *
+   * @generated from field: bool prune = 8;
+   */
+  prune: boolean;
+
+  /**
+   * The git hosts the packages can be cloned from on top of GitHub, for this run only
+   *
+   * @generated from field: repeated api_container_api.GitHost git_hosts = 9;
+   */
+  gitHosts: GitHost[];
+
+  /**
+   * If true, packages are never cloned and must be in the package cache. Defaults to false
+   *
+   * @generated from field: bool offline = 10;
+   */
+  offline: boolean;
+
+  constructor(data?: PartialMessage<GetStarlarkRunDiffArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetStarlarkRunDiffArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStarlarkRunDiffArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStarlarkRunDiffArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStarlarkRunDiffArgs;
+
+  static equals(a: GetStarlarkRunDiffArgs | PlainMessage<GetStarlarkRunDiffArgs> | undefined, b: GetStarlarkRunDiffArgs | PlainMessage<GetStarlarkRunDiffArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ServiceChange
+ */
+export declare class ServiceChange extends Message<ServiceChange> {
+  /**
+   * @generated from field: string service_name = 1;
+   */
+  serviceName: string;
+
+  /**
+   * @generated from field: api_container_api.ServiceChangeType change_type = 2;
+   */
+  changeType: ServiceChangeType;
+
+  /**
+   * Why the service gets updated or restarted
+   *
+   * @generated from field: repeated string reasons = 3;
+   */
+  reasons: string[];
+
+  constructor(data?: PartialMessage<ServiceChange>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ServiceChange";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServiceChange;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServiceChange;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServiceChange;
+
+  static equals(a: ServiceChange | PlainMessage<ServiceChange> | undefined, b: ServiceChange | PlainMessage<ServiceChange> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunDiffResponse
+ */
+export declare class GetStarlarkRunDiffResponse extends Message<GetStarlarkRunDiffResponse> {
+  /**
+   * Set when the Starlark code couldn't be interpreted, in which case there's no diff
+   *
+   * @generated from field: api_container_api.StarlarkInterpretationError interpretation_error = 1;
+   */
+  interpretationError?: StarlarkInterpretationError;
+
+  /**
+   * The changes to the services of the enclave, in the order the instructions making them run
+   *
+   * @generated from field: repeated api_container_api.ServiceChange service_changes = 2;
+   */
+  serviceChanges: ServiceChange[];
+
+  /**
+   * The number of instructions that would run
+   *
+   * @generated from field: uint32 instructions_to_run_count = 3;
+   */
+  instructionsToRunCount: number;
+
+  /**
+   * The number of instructions that would be skipped as they already ran in the enclave
+   *
+   * @generated from field: uint32 instructions_to_skip_count = 4;
+   */
+  instructionsToSkipCount: number;
+
+  constructor(data?: PartialMessage<GetStarlarkRunDiffResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.GetStarlarkRunDiffResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetStarlarkRunDiffResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetStarlarkRunDiffResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetStarlarkRunDiffResponse;
+
+  static equals(a: GetStarlarkRunDiffResponse | PlainMessage<GetStarlarkRunDiffResponse> | undefined, b: GetStarlarkRunDiffResponse | PlainMessage<GetStarlarkRunDiffResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ResumeStarlarkRunArgs
+ */
+export declare class ResumeStarlarkRunArgs extends Message<ResumeStarlarkRunArgs> {
+  /**
+   * The index of the first response line to stream, i.e. the number of lines the client already received
+   *
+   * @generated from field: uint32 starting_line_index = 1;
+   */
+  startingLineIndex: number;
+
+  /**
+   * The run to resume, as sent in the StarlarkRunStartedEvent the run started with
+   *
+   * @generated from field: string run_id = 2;
+   */
+  runId: string;
+
+  constructor(data?: PartialMessage<ResumeStarlarkRunArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ResumeStarlarkRunArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResumeStarlarkRunArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResumeStarlarkRunArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResumeStarlarkRunArgs;
+
+  static equals(a: ResumeStarlarkRunArgs | PlainMessage<ResumeStarlarkRunArgs> | undefined, b: ResumeStarlarkRunArgs | PlainMessage<ResumeStarlarkRunArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.CancelStarlarkRunArgs
+ */
+export declare class CancelStarlarkRunArgs extends Message<CancelStarlarkRunArgs> {
+  /**
+   * The run to cancel, as sent in the StarlarkRunStartedEvent the run started with
+   *
+   * @generated from field: string run_id = 1;
+   */
+  runId: string;
+
+  constructor(data?: PartialMessage<CancelStarlarkRunArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.CancelStarlarkRunArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CancelStarlarkRunArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CancelStarlarkRunArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CancelStarlarkRunArgs;
+
+  static equals(a: CancelStarlarkRunArgs | PlainMessage<CancelStarlarkRunArgs> | undefined, b: CancelStarlarkRunArgs | PlainMessage<CancelStarlarkRunArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.PersistentDirectory
+ */
+export declare class PersistentDirectory extends Message<PersistentDirectory> {
+  /**
+   * UUID of the service the persistent directory was created for, which may have been removed since
+   *
+   * @generated from field: string service_uuid = 1;
+   */
+  serviceUuid: string;
+
+  /**
+   * @generated from field: string persistent_key = 2;
+   */
+  persistentKey: string;
+
+  constructor(data?: PartialMessage<PersistentDirectory>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.PersistentDirectory";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): PersistentDirectory;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): PersistentDirectory;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): PersistentDirectory;
+
+  static equals(a: PersistentDirectory | PlainMessage<PersistentDirectory> | undefined, b: PersistentDirectory | PlainMessage<PersistentDirectory> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.ListPersistentDirectoriesResponse
+ */
+export declare class ListPersistentDirectoriesResponse extends Message<ListPersistentDirectoriesResponse> {
+  /**
+   * @generated from field: repeated api_container_api.PersistentDirectory persistent_directories = 1;
+   */
+  persistentDirectories: PersistentDirectory[];
+
+  constructor(data?: PartialMessage<ListPersistentDirectoriesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.ListPersistentDirectoriesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListPersistentDirectoriesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListPersistentDirectoriesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListPersistentDirectoriesResponse;
+
+  static equals(a: ListPersistentDirectoriesResponse | PlainMessage<ListPersistentDirectoriesResponse> | undefined, b: ListPersistentDirectoriesResponse | PlainMessage<ListPersistentDirectoriesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RemovePersistentDirectoriesArgs
+ */
+export declare class RemovePersistentDirectoriesArgs extends Message<RemovePersistentDirectoriesArgs> {
+  /**
+   * @generated from field: string service_uuid = 1;
+   */
+  serviceUuid: string;
+
+  /**
+   * @generated from field: repeated string persistent_keys = 2;
+   */
+  persistentKeys: string[];
+
+  constructor(data?: PartialMessage<RemovePersistentDirectoriesArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RemovePersistentDirectoriesArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemovePersistentDirectoriesArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemovePersistentDirectoriesArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemovePersistentDirectoriesArgs;
+
+  static equals(a: RemovePersistentDirectoriesArgs | PlainMessage<RemovePersistentDirectoriesArgs> | undefined, b: RemovePersistentDirectoriesArgs | PlainMessage<RemovePersistentDirectoriesArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.RemovePersistentDirectoriesResponse
+ */
+export declare class RemovePersistentDirectoriesResponse extends Message<RemovePersistentDirectoriesResponse> {
+  /**
+   * @generated from field: repeated string removed_persistent_keys = 1;
+   */
+  removedPersistentKeys: string[];
+
+  constructor(data?: PartialMessage<RemovePersistentDirectoriesResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.RemovePersistentDirectoriesResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemovePersistentDirectoriesResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemovePersistentDirectoriesResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemovePersistentDirectoriesResponse;
+
+  static equals(a: RemovePersistentDirectoriesResponse | PlainMessage<RemovePersistentDirectoriesResponse> | undefined, b: RemovePersistentDirectoriesResponse | PlainMessage<RemovePersistentDirectoriesResponse> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.DownloadPersistentDirectoryArgs
+ */
+export declare class DownloadPersistentDirectoryArgs extends Message<DownloadPersistentDirectoryArgs> {
+  /**
+   * @generated from field: string service_uuid = 1;
+   */
+  serviceUuid: string;
+
+  /**
+   * @generated from field: string persistent_key = 2;
+   */
+  persistentKey: string;
+
+  constructor(data?: PartialMessage<DownloadPersistentDirectoryArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.DownloadPersistentDirectoryArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): DownloadPersistentDirectoryArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): DownloadPersistentDirectoryArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): DownloadPersistentDirectoryArgs;
+
+  static equals(a: DownloadPersistentDirectoryArgs | PlainMessage<DownloadPersistentDirectoryArgs> | undefined, b: DownloadPersistentDirectoryArgs | PlainMessage<DownloadPersistentDirectoryArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs
+ */
+export declare class StoreFilesArtifactFromPersistentDirectoryArgs extends Message<StoreFilesArtifactFromPersistentDirectoryArgs> {
+  /**
+   * @generated from field: string service_uuid = 1;
+   */
+  serviceUuid: string;
+
+  /**
+   * @generated from field: string persistent_key = 2;
+   */
+  persistentKey: string;
+
+  /**
+   * The name of the files artifact, which must not be used yet
+   *
+   * @generated from field: string name = 3;
+   */
+  name: string;
+
+  constructor(data?: PartialMessage<StoreFilesArtifactFromPersistentDirectoryArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StoreFilesArtifactFromPersistentDirectoryArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StoreFilesArtifactFromPersistentDirectoryArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StoreFilesArtifactFromPersistentDirectoryArgs;
+
+  static equals(a: StoreFilesArtifactFromPersistentDirectoryArgs | PlainMessage<StoreFilesArtifactFromPersistentDirectoryArgs> | undefined, b: StoreFilesArtifactFromPersistentDirectoryArgs | PlainMessage<StoreFilesArtifactFromPersistentDirectoryArgs> | undefined): boolean;
+}
+
+/**
+ * @generated from message api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse
+ */
+export declare class StoreFilesArtifactFromPersistentDirectoryResponse extends Message<StoreFilesArtifactFromPersistentDirectoryResponse> {
+  /**
+   * UUID of the files artifact, for use when referencing it in the future
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  constructor(data?: PartialMessage<StoreFilesArtifactFromPersistentDirectoryResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StoreFilesArtifactFromPersistentDirectoryResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StoreFilesArtifactFromPersistentDirectoryResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StoreFilesArtifactFromPersistentDirectoryResponse;
+
+  static equals(a: StoreFilesArtifactFromPersistentDirectoryResponse | PlainMessage<StoreFilesArtifactFromPersistentDirectoryResponse> | undefined, b: StoreFilesArtifactFromPersistentDirectoryResponse | PlainMessage<StoreFilesArtifactFromPersistentDirectoryResponse> | undefined): boolean;
+}
+
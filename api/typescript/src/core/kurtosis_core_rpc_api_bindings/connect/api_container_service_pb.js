@@ -40,6 +40,52 @@ export const KurtosisFeatureFlag = proto3.makeEnum(
   ],
 );
 
+/**
+ * @generated from enum api_container_api.PacketDelayDistribution
+ */
+export const PacketDelayDistribution = proto3.makeEnum(
+  "api_container_api.PacketDelayDistribution",
+  [
+    {no: 0, name: "UNIFORM"},
+    {no: 1, name: "NORMAL"},
+  ],
+);
+
+/**
+ * @generated from enum api_container_api.ServiceHealthStatus
+ */
+export const ServiceHealthStatus = proto3.makeEnum(
+  "api_container_api.ServiceHealthStatus",
+  [
+    {no: 0, name: "HEALTHY"},
+    {no: 1, name: "UNHEALTHY"},
+  ],
+);
+
+/**
+ * @generated from enum api_container_api.PlanFormat
+ */
+export const PlanFormat = proto3.makeEnum(
+  "api_container_api.PlanFormat",
+  [
+    {no: 0, name: "YAML"},
+    {no: 1, name: "JSON"},
+  ],
+);
+
+/**
+ * @generated from enum api_container_api.ServiceChangeType
+ */
+export const ServiceChangeType = proto3.makeEnum(
+  "api_container_api.ServiceChangeType",
+  [
+    {no: 0, name: "ADDED"},
+    {no: 1, name: "UPDATED"},
+    {no: 2, name: "RESTARTED"},
+    {no: 3, name: "REMOVED"},
+  ],
+);
+
 /**
  * ==============================================================================================
  *                           Shared Objects (Used By Multiple Endpoints)
@@ -83,6 +129,20 @@ export const ServiceInfo = proto3.makeMessageType(
     { no: 6, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 7, name: "shortened_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 8, name: "service_status", kind: "enum", T: proto3.getEnumType(ServiceStatus) },
+    { no: 9, name: "health", kind: "message", T: ServiceHealth, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceHealth
+ */
+export const ServiceHealth = proto3.makeMessageType(
+  "api_container_api.ServiceHealth",
+  () => [
+    { no: 1, name: "status", kind: "enum", T: proto3.getEnumType(ServiceHealthStatus) },
+    { no: 2, name: "num_restarts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "last_failure_reason", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "last_failure_timestamp_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
   ],
 );
 
@@ -98,6 +158,8 @@ export const RunStarlarkScriptArgs = proto3.makeMessageType(
     { no: 4, name: "parallelism", kind: "scalar", T: 5 /* ScalarType.INT32 */, opt: true },
     { no: 5, name: "main_function_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 6, name: "experimental_features", kind: "enum", T: proto3.getEnumType(KurtosisFeatureFlag), repeated: true },
+    { no: 7, name: "git_hosts", kind: "message", T: GitHost, repeated: true },
+    { no: 8, name: "offline", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -117,6 +179,10 @@ export const RunStarlarkPackageArgs = proto3.makeMessageType(
     { no: 9, name: "relative_path_to_main_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 10, name: "main_function_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 11, name: "experimental_features", kind: "enum", T: proto3.getEnumType(KurtosisFeatureFlag), repeated: true },
+    { no: 12, name: "locally_replaced_package_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 13, name: "prune", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+    { no: 14, name: "git_hosts", kind: "message", T: GitHost, repeated: true },
+    { no: 15, name: "offline", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ],
 );
 
@@ -136,6 +202,9 @@ export const StarlarkRunResponseLine = proto3.makeMessageType(
     { no: 4, name: "instruction_result", kind: "message", T: StarlarkInstructionResult, oneof: "run_response_line" },
     { no: 5, name: "run_finished_event", kind: "message", T: StarlarkRunFinishedEvent, oneof: "run_response_line" },
     { no: 6, name: "warning", kind: "message", T: StarlarkWarning, oneof: "run_response_line" },
+    { no: 7, name: "instruction_metrics", kind: "message", T: StarlarkInstructionMetrics, oneof: "run_response_line" },
+    { no: 8, name: "run_started_event", kind: "message", T: StarlarkRunStartedEvent, oneof: "run_response_line" },
+    { no: 9, name: "info", kind: "message", T: StarlarkInfo, oneof: "run_response_line" },
   ],
 );
 
@@ -149,6 +218,19 @@ export const StarlarkWarning = proto3.makeMessageType(
   ],
 );
 
+/**
+ * Information about the run that isn't the output of an instruction, like the services and files artifacts pruned
+ * at the end of the run
+ *
+ * @generated from message api_container_api.StarlarkInfo
+ */
+export const StarlarkInfo = proto3.makeMessageType(
+  "api_container_api.StarlarkInfo",
+  () => [
+    { no: 1, name: "info_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * @generated from message api_container_api.StarlarkInstruction
  */
@@ -173,6 +255,22 @@ export const StarlarkInstructionResult = proto3.makeMessageType(
   ],
 );
 
+/**
+ * Sent once an instruction is done executing, right before its result or error
+ *
+ * @generated from message api_container_api.StarlarkInstructionMetrics
+ */
+export const StarlarkInstructionMetrics = proto3.makeMessageType(
+  "api_container_api.StarlarkInstructionMetrics",
+  () => [
+    { no: 1, name: "start_timestamp_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 2, name: "end_timestamp_ms", kind: "scalar", T: 3 /* ScalarType.INT64 */ },
+    { no: 3, name: "duration_ms", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "image_pull_duration_ms", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 5, name: "num_retries", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
 /**
  * @generated from message api_container_api.StarlarkInstructionArg
  */
@@ -251,6 +349,18 @@ export const StarlarkRunProgress = proto3.makeMessageType(
   ],
 );
 
+/**
+ * The first response line of every run
+ *
+ * @generated from message api_container_api.StarlarkRunStartedEvent
+ */
+export const StarlarkRunStartedEvent = proto3.makeMessageType(
+  "api_container_api.StarlarkRunStartedEvent",
+  () => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * @generated from message api_container_api.StarlarkRunFinishedEvent
  */
@@ -377,6 +487,62 @@ export const WaitForHttpPostEndpointAvailabilityArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                     HTTP Request Service
+ * ==============================================================================================
+ *
+ * @generated from message api_container_api.HttpRequestServiceArgs
+ */
+export const HttpRequestServiceArgs = proto3.makeMessageType(
+  "api_container_api.HttpRequestServiceArgs",
+  () => [
+    { no: 1, name: "service_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "port_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "method", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "endpoint", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "headers", kind: "message", T: HttpHeader, repeated: true },
+    { no: 6, name: "query_params", kind: "message", T: HttpQueryParam, repeated: true },
+    { no: 7, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "use_tls", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "skip_tls_verify", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.HttpRequestServiceResponse
+ */
+export const HttpRequestServiceResponse = proto3.makeMessageType(
+  "api_container_api.HttpRequestServiceResponse",
+  () => [
+    { no: 1, name: "status_code", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "headers", kind: "message", T: HttpHeader, repeated: true },
+    { no: 3, name: "body", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.HttpHeader
+ */
+export const HttpHeader = proto3.makeMessageType(
+  "api_container_api.HttpHeader",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.HttpQueryParam
+ */
+export const HttpQueryParam = proto3.makeMessageType(
+  "api_container_api.HttpQueryParam",
+  () => [
+    { no: 1, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                          Streamed Data Chunk
@@ -550,3 +716,294 @@ export const ConnectServicesResponse = proto3.makeMessageType(
   [],
 );
 
+/**
+ * @generated from message api_container_api.GetEnclaveSnapshotArgs
+ */
+export const GetEnclaveSnapshotArgs = proto3.makeMessageType(
+  "api_container_api.GetEnclaveSnapshotArgs",
+  () => [
+    { no: 1, name: "include_persistent_directories", kind: "scalar", T: 8 /* ScalarType.BOOL */, opt: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RestoreEnclaveSnapshotResponse
+ */
+export const RestoreEnclaveSnapshotResponse = proto3.makeMessageType(
+  "api_container_api.RestoreEnclaveSnapshotResponse",
+  () => [
+    { no: 1, name: "serialized_replay_script", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "num_restored_files_artifacts", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RepartitionArgs
+ */
+export const RepartitionArgs = proto3.makeMessageType(
+  "api_container_api.RepartitionArgs",
+  () => [
+    { no: 1, name: "service_partitions", kind: "message", T: ServicePartition, repeated: true },
+    { no: 2, name: "partition_connections", kind: "message", T: PartitionConnection, repeated: true },
+    { no: 3, name: "default_connection", kind: "message", T: PartitionConnectionInfo },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServicePartition
+ */
+export const ServicePartition = proto3.makeMessageType(
+  "api_container_api.ServicePartition",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "partition_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.PartitionConnection
+ */
+export const PartitionConnection = proto3.makeMessageType(
+  "api_container_api.PartitionConnection",
+  () => [
+    { no: 1, name: "partition1", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "partition2", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "info", kind: "message", T: PartitionConnectionInfo },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.PartitionConnectionInfo
+ */
+export const PartitionConnectionInfo = proto3.makeMessageType(
+  "api_container_api.PartitionConnectionInfo",
+  () => [
+    { no: 1, name: "packet_loss_percentage", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 2, name: "delay_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 3, name: "jitter_ms", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "delay_correlation", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 5, name: "delay_distribution", kind: "enum", T: proto3.getEnumType(PacketDelayDistribution) },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GitHost
+ */
+export const GitHost = proto3.makeMessageType(
+  "api_container_api.GitHost",
+  () => [
+    { no: 1, name: "host", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "clone_url_prefix", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "username", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RunStarlarkPackageTestsArgs
+ */
+export const RunStarlarkPackageTestsArgs = proto3.makeMessageType(
+  "api_container_api.RunStarlarkPackageTestsArgs",
+  () => [
+    { no: 1, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "test_name_filter", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "locally_replaced_package_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 4, name: "git_hosts", kind: "message", T: GitHost, repeated: true },
+    { no: 5, name: "offline", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RunStarlarkPackageTestsResponse
+ */
+export const RunStarlarkPackageTestsResponse = proto3.makeMessageType(
+  "api_container_api.RunStarlarkPackageTestsResponse",
+  () => [
+    { no: 1, name: "test_results", kind: "message", T: StarlarkTestResult, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StarlarkTestResult
+ */
+export const StarlarkTestResult = proto3.makeMessageType(
+  "api_container_api.StarlarkTestResult",
+  () => [
+    { no: 1, name: "file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "passed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "failure_message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "duration_ms", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunPlanArgs
+ */
+export const GetStarlarkRunPlanArgs = proto3.makeMessageType(
+  "api_container_api.GetStarlarkRunPlanArgs",
+  () => [
+    { no: 1, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "serialized_script", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "relative_path_to_main_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "main_function_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "serialized_params", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "locally_replaced_package_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "format", kind: "enum", T: proto3.getEnumType(PlanFormat) },
+    { no: 8, name: "clone_package", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "git_hosts", kind: "message", T: GitHost, repeated: true },
+    { no: 10, name: "offline", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunPlanResponse
+ */
+export const GetStarlarkRunPlanResponse = proto3.makeMessageType(
+  "api_container_api.GetStarlarkRunPlanResponse",
+  () => [
+    { no: 1, name: "interpretation_error", kind: "message", T: StarlarkInterpretationError },
+    { no: 2, name: "serialized_plan", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunDiffArgs
+ */
+export const GetStarlarkRunDiffArgs = proto3.makeMessageType(
+  "api_container_api.GetStarlarkRunDiffArgs",
+  () => [
+    { no: 1, name: "package_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "serialized_script", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "relative_path_to_main_file", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "main_function_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "serialized_params", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "locally_replaced_package_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 7, name: "clone_package", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 8, name: "prune", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "git_hosts", kind: "message", T: GitHost, repeated: true },
+    { no: 10, name: "offline", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ServiceChange
+ */
+export const ServiceChange = proto3.makeMessageType(
+  "api_container_api.ServiceChange",
+  () => [
+    { no: 1, name: "service_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "change_type", kind: "enum", T: proto3.getEnumType(ServiceChangeType) },
+    { no: 3, name: "reasons", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.GetStarlarkRunDiffResponse
+ */
+export const GetStarlarkRunDiffResponse = proto3.makeMessageType(
+  "api_container_api.GetStarlarkRunDiffResponse",
+  () => [
+    { no: 1, name: "interpretation_error", kind: "message", T: StarlarkInterpretationError },
+    { no: 2, name: "service_changes", kind: "message", T: ServiceChange, repeated: true },
+    { no: 3, name: "instructions_to_run_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "instructions_to_skip_count", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ResumeStarlarkRunArgs
+ */
+export const ResumeStarlarkRunArgs = proto3.makeMessageType(
+  "api_container_api.ResumeStarlarkRunArgs",
+  () => [
+    { no: 1, name: "starting_line_index", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.CancelStarlarkRunArgs
+ */
+export const CancelStarlarkRunArgs = proto3.makeMessageType(
+  "api_container_api.CancelStarlarkRunArgs",
+  () => [
+    { no: 1, name: "run_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.PersistentDirectory
+ */
+export const PersistentDirectory = proto3.makeMessageType(
+  "api_container_api.PersistentDirectory",
+  () => [
+    { no: 1, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "persistent_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.ListPersistentDirectoriesResponse
+ */
+export const ListPersistentDirectoriesResponse = proto3.makeMessageType(
+  "api_container_api.ListPersistentDirectoriesResponse",
+  () => [
+    { no: 1, name: "persistent_directories", kind: "message", T: PersistentDirectory, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RemovePersistentDirectoriesArgs
+ */
+export const RemovePersistentDirectoriesArgs = proto3.makeMessageType(
+  "api_container_api.RemovePersistentDirectoriesArgs",
+  () => [
+    { no: 1, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "persistent_keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.RemovePersistentDirectoriesResponse
+ */
+export const RemovePersistentDirectoriesResponse = proto3.makeMessageType(
+  "api_container_api.RemovePersistentDirectoriesResponse",
+  () => [
+    { no: 1, name: "removed_persistent_keys", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.DownloadPersistentDirectoryArgs
+ */
+export const DownloadPersistentDirectoryArgs = proto3.makeMessageType(
+  "api_container_api.DownloadPersistentDirectoryArgs",
+  () => [
+    { no: 1, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "persistent_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs
+ */
+export const StoreFilesArtifactFromPersistentDirectoryArgs = proto3.makeMessageType(
+  "api_container_api.StoreFilesArtifactFromPersistentDirectoryArgs",
+  () => [
+    { no: 1, name: "service_uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "persistent_key", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
+/**
+ * @generated from message api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse
+ */
+export const StoreFilesArtifactFromPersistentDirectoryResponse = proto3.makeMessageType(
+  "api_container_api.StoreFilesArtifactFromPersistentDirectoryResponse",
+  () => [
+    { no: 1, name: "uuid", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
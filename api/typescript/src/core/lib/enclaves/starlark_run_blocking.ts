@@ -2,6 +2,7 @@ import {Readable} from "stream";
 import {
     StarlarkExecutionError,
     StarlarkInstruction,
+    StarlarkInstructionMetrics,
     StarlarkInterpretationError, StarlarkRunResponseLine, StarlarkValidationError
 } from "../../kurtosis_core_rpc_api_bindings/api_container_service_pb";
 
@@ -11,6 +12,7 @@ export class StarlarkRunResult {
     constructor(
         public readonly runOutput: string,
         public readonly instructions: Array<StarlarkInstruction>,
+        public readonly instructionsMetrics: Array<StarlarkInstructionMetrics>,
         public readonly interpretationError: StarlarkInterpretationError | undefined,
         public readonly validationErrors: Array<StarlarkValidationError>,
         public readonly executionError: StarlarkExecutionError | undefined
@@ -23,6 +25,7 @@ export async function readStreamContentUntilClosed(responseLines: Readable): Pro
     let validationErrors: Array<StarlarkValidationError> = []
     let executionError: StarlarkExecutionError | undefined
     let instructions: Array<StarlarkInstruction> = []
+    let instructionsMetrics: Array<StarlarkInstructionMetrics> = []
 
     return new Promise((resolve, error) => {
         responseLines.on('data', (responseLine: StarlarkRunResponseLine) => {
@@ -30,6 +33,8 @@ export async function readStreamContentUntilClosed(responseLines: Readable): Pro
                 instructions.push(responseLine.getInstruction()!)
             } else if (responseLine.getInstructionResult() !== undefined) {
                 scriptOutput += responseLine.getInstructionResult()?.getSerializedInstructionResult() + STARLARK_RUN_OUTPUT_LINE_SPLIT
+            } else if (responseLine.getInstructionMetrics() !== undefined) {
+                instructionsMetrics.push(responseLine.getInstructionMetrics()!)
             } else if (responseLine.getError() !== undefined) {
                 if (responseLine.getError()?.getInterpretationError() !== undefined) {
                     interpretationError = responseLine.getError()?.getInterpretationError()
@@ -54,7 +59,7 @@ export async function readStreamContentUntilClosed(responseLines: Readable): Pro
         responseLines.on('end', function () {
             if (!responseLines.destroyed) {
                 responseLines.destroy();
-                resolve(new StarlarkRunResult(scriptOutput, instructions, interpretationError, validationErrors, executionError))
+                resolve(new StarlarkRunResult(scriptOutput, instructions, instructionsMetrics, interpretationError, validationErrors, executionError))
             }
         });
     })
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import { Empty, MethodKind } from "@bufbuild/protobuf";
-import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetServiceLogsArgs, GetServiceLogsResponse, StopEnclaveArgs } from "./engine_service_pb.js";
+import { CleanArgs, CleanResponse, CreateEnclaveArgs, CreateEnclaveResponse, DestroyEnclaveArgs, GetEnclavesResponse, GetEngineInfoResponse, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetLogsStorageUsageResponse, GetServiceLogsArgs, GetServiceLogsResponse, StartEnclaveArgs, StopEnclaveArgs } from "./engine_service_pb.js";
 
 /**
  * @generated from service engine_api.EngineService
@@ -70,6 +70,17 @@ export declare const EngineService: {
       readonly O: typeof Empty,
       readonly kind: MethodKind.Unary,
     },
+    /**
+     * Starts a stopped enclave again, restoring its services to the state they had when it was stopped
+     *
+     * @generated from rpc engine_api.EngineService.StartEnclave
+     */
+    readonly startEnclave: {
+      readonly name: "StartEnclave",
+      readonly I: typeof StartEnclaveArgs,
+      readonly O: typeof Empty,
+      readonly kind: MethodKind.Unary,
+    },
     /**
      * Destroys an enclave, removing all artifacts associated with it
      *
@@ -103,6 +114,17 @@ export declare const EngineService: {
       readonly O: typeof GetServiceLogsResponse,
       readonly kind: MethodKind.ServerStreaming,
     },
+    /**
+     * Returns the disk space taken by the stored service logs
+     *
+     * @generated from rpc engine_api.EngineService.GetLogsStorageUsage
+     */
+    readonly getLogsStorageUsage: {
+      readonly name: "GetLogsStorageUsage",
+      readonly I: typeof Empty,
+      readonly O: typeof GetLogsStorageUsageResponse,
+      readonly kind: MethodKind.Unary,
+    },
   }
 };
 
@@ -127,6 +127,13 @@ export declare class GetEngineInfoResponse extends Message<GetEngineInfoResponse
    */
   engineVersion: string;
 
+  /**
+   * The state of the enclave pool, only set if the engine runs with it
+   *
+   * @generated from field: optional engine_api.EnclavePoolInfo enclave_pool_info = 2;
+   */
+  enclavePoolInfo?: EnclavePoolInfo;
+
   constructor(data?: PartialMessage<GetEngineInfoResponse>);
 
   static readonly runtime: typeof proto3;
@@ -142,6 +149,53 @@ export declare class GetEngineInfoResponse extends Message<GetEngineInfoResponse
   static equals(a: GetEngineInfoResponse | PlainMessage<GetEngineInfoResponse> | undefined, b: GetEngineInfoResponse | PlainMessage<GetEngineInfoResponse> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.EnclavePoolInfo
+ */
+export declare class EnclavePoolInfo extends Message<EnclavePoolInfo> {
+  /**
+   * The number of idle enclaves the pool keeps ready to be used
+   *
+   * @generated from field: uint32 pool_size = 1;
+   */
+  poolSize: number;
+
+  /**
+   * The API container version of the idle enclaves, only enclaves requested with it can be taken from the pool
+   *
+   * @generated from field: string api_container_version = 2;
+   */
+  apiContainerVersion: string;
+
+  /**
+   * The number of idle enclaves currently ready to be used, the rest are being created
+   *
+   * @generated from field: uint32 num_idle_enclaves = 3;
+   */
+  numIdleEnclaves: number;
+
+  /**
+   * The number of idle enclaves from previous engine runs that were adopted by the pool when the engine started
+   *
+   * @generated from field: uint32 num_adopted_idle_enclaves = 4;
+   */
+  numAdoptedIdleEnclaves: number;
+
+  constructor(data?: PartialMessage<EnclavePoolInfo>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.EnclavePoolInfo";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): EnclavePoolInfo;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): EnclavePoolInfo;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): EnclavePoolInfo;
+
+  static equals(a: EnclavePoolInfo | PlainMessage<EnclavePoolInfo> | undefined, b: EnclavePoolInfo | PlainMessage<EnclavePoolInfo> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                        Create Enclave
@@ -177,6 +231,13 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
    */
   mode: EnclaveMode;
 
+  /**
+   * Settings of the enclave only available for Kubernetes
+   *
+   * @generated from field: optional engine_api.KubernetesEnclaveSettings kubernetes_settings = 5;
+   */
+  kubernetesSettings?: KubernetesEnclaveSettings;
+
   constructor(data?: PartialMessage<CreateEnclaveArgs>);
 
   static readonly runtime: typeof proto3;
@@ -192,6 +253,53 @@ export declare class CreateEnclaveArgs extends Message<CreateEnclaveArgs> {
   static equals(a: CreateEnclaveArgs | PlainMessage<CreateEnclaveArgs> | undefined, b: CreateEnclaveArgs | PlainMessage<CreateEnclaveArgs> | undefined): boolean;
 }
 
+/**
+ * @generated from message engine_api.KubernetesEnclaveSettings
+ */
+export declare class KubernetesEnclaveSettings extends Message<KubernetesEnclaveSettings> {
+  /**
+   * Labels added to the namespace of the enclave
+   *
+   * @generated from field: map<string, string> namespace_labels = 1;
+   */
+  namespaceLabels: { [key: string]: string };
+
+  /**
+   * Annotations added to the namespace of the enclave
+   *
+   * @generated from field: map<string, string> namespace_annotations = 2;
+   */
+  namespaceAnnotations: { [key: string]: string };
+
+  /**
+   * Node selectors of all the services of the enclave, which the node selectors of a service add to or override
+   *
+   * @generated from field: map<string, string> default_node_selectors = 3;
+   */
+  defaultNodeSelectors: { [key: string]: string };
+
+  /**
+   * Service account the services of the enclave run with, created in the namespace of the enclave
+   *
+   * @generated from field: string service_account_name = 4;
+   */
+  serviceAccountName: string;
+
+  constructor(data?: PartialMessage<KubernetesEnclaveSettings>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.KubernetesEnclaveSettings";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KubernetesEnclaveSettings;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KubernetesEnclaveSettings;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KubernetesEnclaveSettings;
+
+  static equals(a: KubernetesEnclaveSettings | PlainMessage<KubernetesEnclaveSettings> | undefined, b: KubernetesEnclaveSettings | PlainMessage<KubernetesEnclaveSettings> | undefined): boolean;
+}
+
 /**
  * @generated from message engine_api.CreateEnclaveResponse
  */
@@ -499,6 +607,36 @@ export declare class StopEnclaveArgs extends Message<StopEnclaveArgs> {
   static equals(a: StopEnclaveArgs | PlainMessage<StopEnclaveArgs> | undefined, b: StopEnclaveArgs | PlainMessage<StopEnclaveArgs> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                       Start Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StartEnclaveArgs
+ */
+export declare class StartEnclaveArgs extends Message<StartEnclaveArgs> {
+  /**
+   * The identifier(uuid, shortened uuid, name) of the Kurtosis enclave to start
+   *
+   * @generated from field: string enclave_identifier = 1;
+   */
+  enclaveIdentifier: string;
+
+  constructor(data?: PartialMessage<StartEnclaveArgs>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.StartEnclaveArgs";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartEnclaveArgs;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartEnclaveArgs;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartEnclaveArgs;
+
+  static equals(a: StartEnclaveArgs | PlainMessage<StartEnclaveArgs> | undefined, b: StartEnclaveArgs | PlainMessage<StartEnclaveArgs> | undefined): boolean;
+}
+
 /**
  * ==============================================================================================
  *                                       Destroy Enclave
@@ -650,6 +788,27 @@ export declare class GetServiceLogsArgs extends Message<GetServiceLogsArgs> {
    */
   conjunctiveFilters: LogLineFilter[];
 
+  /**
+   * If set, only the log lines logged at or after this time will be returned
+   *
+   * @generated from field: google.protobuf.Timestamp since = 5;
+   */
+  since?: Timestamp;
+
+  /**
+   * If set, only the log lines logged before this time will be returned
+   *
+   * @generated from field: google.protobuf.Timestamp until = 6;
+   */
+  until?: Timestamp;
+
+  /**
+   * If greater than zero, only the last N log lines of each service will be returned (after applying the time bounds and the filters)
+   *
+   * @generated from field: uint32 num_log_lines = 7;
+   */
+  numLogLines: number;
+
   constructor(data?: PartialMessage<GetServiceLogsArgs>);
 
   static readonly runtime: typeof proto3;
@@ -700,8 +859,6 @@ export declare class GetServiceLogsResponse extends Message<GetServiceLogsRespon
 }
 
 /**
- * TODO add timestamp as well, for when we do timestamp-handling on the client side
- *
  * @generated from message engine_api.LogLine
  */
 export declare class LogLine extends Message<LogLine> {
@@ -710,6 +867,13 @@ export declare class LogLine extends Message<LogLine> {
    */
   line: string[];
 
+  /**
+   * The time each line was logged at, index-aligned with the lines
+   *
+   * @generated from field: repeated google.protobuf.Timestamp timestamp = 2;
+   */
+  timestamp: Timestamp[];
+
   constructor(data?: PartialMessage<LogLine>);
 
   static readonly runtime: typeof proto3;
@@ -754,3 +918,40 @@ export declare class LogLineFilter extends Message<LogLineFilter> {
   static equals(a: LogLineFilter | PlainMessage<LogLineFilter> | undefined, b: LogLineFilter | PlainMessage<LogLineFilter> | undefined): boolean;
 }
 
+/**
+ * ==============================================================================================
+ *                                   Get Logs Storage Usage
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetLogsStorageUsageResponse
+ */
+export declare class GetLogsStorageUsageResponse extends Message<GetLogsStorageUsageResponse> {
+  /**
+   * The disk space, in bytes, taken by all the stored service logs
+   *
+   * @generated from field: uint64 total_size_in_bytes = 1;
+   */
+  totalSizeInBytes: bigint;
+
+  /**
+   * The disk space, in bytes, taken by the stored service logs of each enclave, keyed by enclave UUID
+   *
+   * @generated from field: map<string, uint64> size_in_bytes_by_enclave_uuid = 2;
+   */
+  sizeInBytesByEnclaveUuid: { [key: string]: bigint };
+
+  constructor(data?: PartialMessage<GetLogsStorageUsageResponse>);
+
+  static readonly runtime: typeof proto3;
+  static readonly typeName = "engine_api.GetLogsStorageUsageResponse";
+  static readonly fields: FieldList;
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetLogsStorageUsageResponse;
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetLogsStorageUsageResponse;
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetLogsStorageUsageResponse;
+
+  static equals(a: GetLogsStorageUsageResponse | PlainMessage<GetLogsStorageUsageResponse> | undefined, b: GetLogsStorageUsageResponse | PlainMessage<GetLogsStorageUsageResponse> | undefined): boolean;
+}
+
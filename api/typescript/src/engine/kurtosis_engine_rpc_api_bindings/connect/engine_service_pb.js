@@ -75,6 +75,20 @@ export const GetEngineInfoResponse = proto3.makeMessageType(
   "engine_api.GetEngineInfoResponse",
   () => [
     { no: 1, name: "engine_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "enclave_pool_info", kind: "message", T: EnclavePoolInfo, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.EnclavePoolInfo
+ */
+export const EnclavePoolInfo = proto3.makeMessageType(
+  "engine_api.EnclavePoolInfo",
+  () => [
+    { no: 1, name: "pool_size", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 2, name: "api_container_version", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "num_idle_enclaves", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
+    { no: 4, name: "num_adopted_idle_enclaves", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ],
 );
 
@@ -92,6 +106,20 @@ export const CreateEnclaveArgs = proto3.makeMessageType(
     { no: 2, name: "api_container_version_tag", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 3, name: "api_container_log_level", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 4, name: "mode", kind: "enum", T: proto3.getEnumType(EnclaveMode) },
+    { no: 5, name: "kubernetes_settings", kind: "message", T: KubernetesEnclaveSettings, opt: true },
+  ],
+);
+
+/**
+ * @generated from message engine_api.KubernetesEnclaveSettings
+ */
+export const KubernetesEnclaveSettings = proto3.makeMessageType(
+  "engine_api.KubernetesEnclaveSettings",
+  () => [
+    { no: 1, name: "namespace_labels", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 2, name: "namespace_annotations", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 3, name: "default_node_selectors", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 9 /* ScalarType.STRING */} },
+    { no: 4, name: "service_account_name", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ],
 );
 
@@ -198,6 +226,20 @@ export const StopEnclaveArgs = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                       Start Enclave
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.StartEnclaveArgs
+ */
+export const StartEnclaveArgs = proto3.makeMessageType(
+  "engine_api.StartEnclaveArgs",
+  () => [
+    { no: 1, name: "enclave_identifier", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ],
+);
+
 /**
  * ==============================================================================================
  *                                       Destroy Enclave
@@ -261,6 +303,9 @@ export const GetServiceLogsArgs = proto3.makeMessageType(
     { no: 2, name: "service_uuid_set", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 8 /* ScalarType.BOOL */} },
     { no: 3, name: "follow_logs", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
     { no: 4, name: "conjunctive_filters", kind: "message", T: LogLineFilter, repeated: true },
+    { no: 5, name: "since", kind: "message", T: Timestamp },
+    { no: 6, name: "until", kind: "message", T: Timestamp },
+    { no: 7, name: "num_log_lines", kind: "scalar", T: 13 /* ScalarType.UINT32 */ },
   ],
 );
 
@@ -276,14 +321,13 @@ export const GetServiceLogsResponse = proto3.makeMessageType(
 );
 
 /**
- * TODO add timestamp as well, for when we do timestamp-handling on the client side
- *
  * @generated from message engine_api.LogLine
  */
 export const LogLine = proto3.makeMessageType(
   "engine_api.LogLine",
   () => [
     { no: 1, name: "line", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 2, name: "timestamp", kind: "message", T: Timestamp, repeated: true },
   ],
 );
 
@@ -298,3 +342,18 @@ export const LogLineFilter = proto3.makeMessageType(
   ],
 );
 
+/**
+ * ==============================================================================================
+ *                                   Get Logs Storage Usage
+ * ==============================================================================================
+ *
+ * @generated from message engine_api.GetLogsStorageUsageResponse
+ */
+export const GetLogsStorageUsageResponse = proto3.makeMessageType(
+  "engine_api.GetLogsStorageUsageResponse",
+  () => [
+    { no: 1, name: "total_size_in_bytes", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 2, name: "size_in_bytes_by_enclave_uuid", kind: "map", K: 9 /* ScalarType.STRING */, V: {kind: "scalar", T: 4 /* ScalarType.UINT64 */} },
+  ],
+);
+
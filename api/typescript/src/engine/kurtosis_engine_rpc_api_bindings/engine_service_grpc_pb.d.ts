@@ -13,9 +13,11 @@ interface IEngineServiceService extends grpc.ServiceDefinition<grpc.UntypedServi
   getEnclaves: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.MethodDefinition<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  startEnclave: grpc.MethodDefinition<engine_service_pb.StartEnclaveArgs, google_protobuf_empty_pb.Empty>;
   destroyEnclave: grpc.MethodDefinition<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
   clean: grpc.MethodDefinition<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.MethodDefinition<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  getLogsStorageUsage: grpc.MethodDefinition<google_protobuf_empty_pb.Empty, engine_service_pb.GetLogsStorageUsageResponse>;
 }
 
 export const EngineServiceService: IEngineServiceService;
@@ -26,9 +28,11 @@ export interface IEngineServiceServer extends grpc.UntypedServiceImplementation
   getEnclaves: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetEnclavesResponse>;
   getExistingAndHistoricalEnclaveIdentifiers: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse>;
   stopEnclave: grpc.handleUnaryCall<engine_service_pb.StopEnclaveArgs, google_protobuf_empty_pb.Empty>;
+  startEnclave: grpc.handleUnaryCall<engine_service_pb.StartEnclaveArgs, google_protobuf_empty_pb.Empty>;
   destroyEnclave: grpc.handleUnaryCall<engine_service_pb.DestroyEnclaveArgs, google_protobuf_empty_pb.Empty>;
   clean: grpc.handleUnaryCall<engine_service_pb.CleanArgs, engine_service_pb.CleanResponse>;
   getServiceLogs: grpc.handleServerStreamingCall<engine_service_pb.GetServiceLogsArgs, engine_service_pb.GetServiceLogsResponse>;
+  getLogsStorageUsage: grpc.handleUnaryCall<google_protobuf_empty_pb.Empty, engine_service_pb.GetLogsStorageUsageResponse>;
 }
 
 export class EngineServiceClient extends grpc.Client {
@@ -48,6 +52,9 @@ export class EngineServiceClient extends grpc.Client {
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   stopEnclave(argument: engine_service_pb.StopEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
+  startEnclave(argument: engine_service_pb.StartEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
   destroyEnclave(argument: engine_service_pb.DestroyEnclaveArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<google_protobuf_empty_pb.Empty>): grpc.ClientUnaryCall;
@@ -56,4 +63,7 @@ export class EngineServiceClient extends grpc.Client {
   clean(argument: engine_service_pb.CleanArgs, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.CleanResponse>): grpc.ClientUnaryCall;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadataOrOptions?: grpc.Metadata | grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
   getServiceLogs(argument: engine_service_pb.GetServiceLogsArgs, metadata?: grpc.Metadata | null, options?: grpc.CallOptions | null): grpc.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
+  getLogsStorageUsage(argument: google_protobuf_empty_pb.Empty, callback: grpc.requestCallback<engine_service_pb.GetLogsStorageUsageResponse>): grpc.ClientUnaryCall;
+  getLogsStorageUsage(argument: google_protobuf_empty_pb.Empty, metadataOrOptions: grpc.Metadata | grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetLogsStorageUsageResponse>): grpc.ClientUnaryCall;
+  getLogsStorageUsage(argument: google_protobuf_empty_pb.Empty, metadata: grpc.Metadata | null, options: grpc.CallOptions | null, callback: grpc.requestCallback<engine_service_pb.GetLogsStorageUsageResponse>): grpc.ClientUnaryCall;
 }
@@ -94,6 +94,17 @@ function deserialize_engine_api_GetExistingAndHistoricalEnclaveIdentifiersRespon
   return engine_service_pb.GetExistingAndHistoricalEnclaveIdentifiersResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_GetLogsStorageUsageResponse(arg) {
+  if (!(arg instanceof engine_service_pb.GetLogsStorageUsageResponse)) {
+    throw new Error('Expected argument of type engine_api.GetLogsStorageUsageResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_GetLogsStorageUsageResponse(buffer_arg) {
+  return engine_service_pb.GetLogsStorageUsageResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_GetServiceLogsArgs(arg) {
   if (!(arg instanceof engine_service_pb.GetServiceLogsArgs)) {
     throw new Error('Expected argument of type engine_api.GetServiceLogsArgs');
@@ -116,6 +127,17 @@ function deserialize_engine_api_GetServiceLogsResponse(buffer_arg) {
   return engine_service_pb.GetServiceLogsResponse.deserializeBinary(new Uint8Array(buffer_arg));
 }
 
+function serialize_engine_api_StartEnclaveArgs(arg) {
+  if (!(arg instanceof engine_service_pb.StartEnclaveArgs)) {
+    throw new Error('Expected argument of type engine_api.StartEnclaveArgs');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_engine_api_StartEnclaveArgs(buffer_arg) {
+  return engine_service_pb.StartEnclaveArgs.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
 function serialize_engine_api_StopEnclaveArgs(arg) {
   if (!(arg instanceof engine_service_pb.StopEnclaveArgs)) {
     throw new Error('Expected argument of type engine_api.StopEnclaveArgs');
@@ -203,6 +225,18 @@ stopEnclave: {
     responseSerialize: serialize_google_protobuf_Empty,
     responseDeserialize: deserialize_google_protobuf_Empty,
   },
+  // Starts a stopped enclave again, restoring its services to the state they had when it was stopped
+startEnclave: {
+    path: '/engine_api.EngineService/StartEnclave',
+    requestStream: false,
+    responseStream: false,
+    requestType: engine_service_pb.StartEnclaveArgs,
+    responseType: google_protobuf_empty_pb.Empty,
+    requestSerialize: serialize_engine_api_StartEnclaveArgs,
+    requestDeserialize: deserialize_engine_api_StartEnclaveArgs,
+    responseSerialize: serialize_google_protobuf_Empty,
+    responseDeserialize: deserialize_google_protobuf_Empty,
+  },
   // Destroys an enclave, removing all artifacts associated with it
 destroyEnclave: {
     path: '/engine_api.EngineService/DestroyEnclave',
@@ -239,6 +273,18 @@ getServiceLogs: {
     responseSerialize: serialize_engine_api_GetServiceLogsResponse,
     responseDeserialize: deserialize_engine_api_GetServiceLogsResponse,
   },
+  // Returns the disk space taken by the stored service logs
+getLogsStorageUsage: {
+    path: '/engine_api.EngineService/GetLogsStorageUsage',
+    requestStream: false,
+    responseStream: false,
+    requestType: google_protobuf_empty_pb.Empty,
+    responseType: engine_service_pb.GetLogsStorageUsageResponse,
+    requestSerialize: serialize_google_protobuf_Empty,
+    requestDeserialize: deserialize_google_protobuf_Empty,
+    responseSerialize: serialize_engine_api_GetLogsStorageUsageResponse,
+    responseDeserialize: deserialize_engine_api_GetLogsStorageUsageResponse,
+  },
 };
 
 exports.EngineServiceClient = grpc.makeGenericClientConstructor(EngineServiceService);
@@ -44,6 +44,13 @@ export class EngineServiceClient {
                response: google_protobuf_empty_pb.Empty) => void
   ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: google_protobuf_empty_pb.Empty) => void
+  ): grpcWeb.ClientReadableStream<google_protobuf_empty_pb.Empty>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata: grpcWeb.Metadata | undefined,
@@ -63,6 +70,13 @@ export class EngineServiceClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getLogsStorageUsage(
+    request: google_protobuf_empty_pb.Empty,
+    metadata: grpcWeb.Metadata | undefined,
+    callback: (err: grpcWeb.RpcError,
+               response: engine_service_pb.GetLogsStorageUsageResponse) => void
+  ): grpcWeb.ClientReadableStream<engine_service_pb.GetLogsStorageUsageResponse>;
+
 }
 
 export class EngineServicePromiseClient {
@@ -95,6 +109,11 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): Promise<google_protobuf_empty_pb.Empty>;
 
+  startEnclave(
+    request: engine_service_pb.StartEnclaveArgs,
+    metadata?: grpcWeb.Metadata
+  ): Promise<google_protobuf_empty_pb.Empty>;
+
   destroyEnclave(
     request: engine_service_pb.DestroyEnclaveArgs,
     metadata?: grpcWeb.Metadata
@@ -110,5 +129,10 @@ export class EngineServicePromiseClient {
     metadata?: grpcWeb.Metadata
   ): grpcWeb.ClientReadableStream<engine_service_pb.GetServiceLogsResponse>;
 
+  getLogsStorageUsage(
+    request: google_protobuf_empty_pb.Empty,
+    metadata?: grpcWeb.Metadata
+  ): Promise<engine_service_pb.GetLogsStorageUsageResponse>;
+
 }
 
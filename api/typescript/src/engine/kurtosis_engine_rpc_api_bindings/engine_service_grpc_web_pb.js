@@ -383,6 +383,67 @@ proto.engine_api.EngineServicePromiseClient.prototype.stopEnclave =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.engine_api.StartEnclaveArgs,
+ *   !proto.google.protobuf.Empty>}
+ */
+const methodDescriptor_EngineService_StartEnclave = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/StartEnclave',
+  grpc.web.MethodType.UNARY,
+  proto.engine_api.StartEnclaveArgs,
+  google_protobuf_empty_pb.Empty,
+  /**
+   * @param {!proto.engine_api.StartEnclaveArgs} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  google_protobuf_empty_pb.Empty.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.google.protobuf.Empty)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.google.protobuf.Empty>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.startEnclave =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave,
+      callback);
+};
+
+
+/**
+ * @param {!proto.engine_api.StartEnclaveArgs} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.google.protobuf.Empty>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.startEnclave =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/StartEnclave',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_StartEnclave);
+};
+
+
 /**
  * @const
  * @type {!grpc.web.MethodDescriptor<
@@ -561,5 +622,66 @@ proto.engine_api.EngineServicePromiseClient.prototype.getServiceLogs =
 };
 
 
+/**
+ * @const
+ * @type {!grpc.web.MethodDescriptor<
+ *   !proto.google.protobuf.Empty,
+ *   !proto.engine_api.GetLogsStorageUsageResponse>}
+ */
+const methodDescriptor_EngineService_GetLogsStorageUsage = new grpc.web.MethodDescriptor(
+  '/engine_api.EngineService/GetLogsStorageUsage',
+  grpc.web.MethodType.UNARY,
+  google_protobuf_empty_pb.Empty,
+  proto.engine_api.GetLogsStorageUsageResponse,
+  /**
+   * @param {!proto.google.protobuf.Empty} request
+   * @return {!Uint8Array}
+   */
+  function(request) {
+    return request.serializeBinary();
+  },
+  proto.engine_api.GetLogsStorageUsageResponse.deserializeBinary
+);
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>} metadata User defined
+ *     call metadata
+ * @param {function(?grpc.web.RpcError, ?proto.engine_api.GetLogsStorageUsageResponse)}
+ *     callback The callback function(error, response)
+ * @return {!grpc.web.ClientReadableStream<!proto.engine_api.GetLogsStorageUsageResponse>|undefined}
+ *     The XHR Node Readable Stream
+ */
+proto.engine_api.EngineServiceClient.prototype.getLogsStorageUsage =
+    function(request, metadata, callback) {
+  return this.client_.rpcCall(this.hostname_ +
+      '/engine_api.EngineService/GetLogsStorageUsage',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetLogsStorageUsage,
+      callback);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Empty} request The
+ *     request proto
+ * @param {?Object<string, string>=} metadata User defined
+ *     call metadata
+ * @return {!Promise<!proto.engine_api.GetLogsStorageUsageResponse>}
+ *     Promise that resolves to the response
+ */
+proto.engine_api.EngineServicePromiseClient.prototype.getLogsStorageUsage =
+    function(request, metadata) {
+  return this.client_.unaryCall(this.hostname_ +
+      '/engine_api.EngineService/GetLogsStorageUsage',
+      request,
+      metadata || {},
+      methodDescriptor_EngineService_GetLogsStorageUsage);
+};
+
+
 module.exports = proto.engine_api;
 
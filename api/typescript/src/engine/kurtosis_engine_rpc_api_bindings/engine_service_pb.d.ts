@@ -8,6 +8,11 @@ export class GetEngineInfoResponse extends jspb.Message {
   getEngineVersion(): string;
   setEngineVersion(value: string): GetEngineInfoResponse;
 
+  getEnclavePoolInfo(): EnclavePoolInfo | undefined;
+  setEnclavePoolInfo(value?: EnclavePoolInfo): GetEngineInfoResponse;
+  hasEnclavePoolInfo(): boolean;
+  clearEnclavePoolInfo(): GetEngineInfoResponse;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetEngineInfoResponse.AsObject;
   static toObject(includeInstance: boolean, msg: GetEngineInfoResponse): GetEngineInfoResponse.AsObject;
@@ -19,6 +24,37 @@ export class GetEngineInfoResponse extends jspb.Message {
 export namespace GetEngineInfoResponse {
   export type AsObject = {
     engineVersion: string,
+    enclavePoolInfo?: EnclavePoolInfo.AsObject,
+  }
+}
+
+export class EnclavePoolInfo extends jspb.Message {
+  getPoolSize(): number;
+  setPoolSize(value: number): EnclavePoolInfo;
+
+  getApiContainerVersion(): string;
+  setApiContainerVersion(value: string): EnclavePoolInfo;
+
+  getNumIdleEnclaves(): number;
+  setNumIdleEnclaves(value: number): EnclavePoolInfo;
+
+  getNumAdoptedIdleEnclaves(): number;
+  setNumAdoptedIdleEnclaves(value: number): EnclavePoolInfo;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): EnclavePoolInfo.AsObject;
+  static toObject(includeInstance: boolean, msg: EnclavePoolInfo): EnclavePoolInfo.AsObject;
+  static serializeBinaryToWriter(message: EnclavePoolInfo, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): EnclavePoolInfo;
+  static deserializeBinaryFromReader(message: EnclavePoolInfo, reader: jspb.BinaryReader): EnclavePoolInfo;
+}
+
+export namespace EnclavePoolInfo {
+  export type AsObject = {
+    poolSize: number,
+    apiContainerVersion: string,
+    numIdleEnclaves: number,
+    numAdoptedIdleEnclaves: number,
   }
 }
 
@@ -35,6 +71,11 @@ export class CreateEnclaveArgs extends jspb.Message {
   getMode(): EnclaveMode;
   setMode(value: EnclaveMode): CreateEnclaveArgs;
 
+  getKubernetesSettings(): KubernetesEnclaveSettings | undefined;
+  setKubernetesSettings(value?: KubernetesEnclaveSettings): CreateEnclaveArgs;
+  hasKubernetesSettings(): boolean;
+  clearKubernetesSettings(): CreateEnclaveArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): CreateEnclaveArgs.AsObject;
   static toObject(includeInstance: boolean, msg: CreateEnclaveArgs): CreateEnclaveArgs.AsObject;
@@ -49,6 +90,37 @@ export namespace CreateEnclaveArgs {
     apiContainerVersionTag: string,
     apiContainerLogLevel: string,
     mode: EnclaveMode,
+    kubernetesSettings?: KubernetesEnclaveSettings.AsObject,
+  }
+}
+
+export class KubernetesEnclaveSettings extends jspb.Message {
+  getNamespaceLabelsMap(): jspb.Map<string, string>;
+  clearNamespaceLabelsMap(): KubernetesEnclaveSettings;
+
+  getNamespaceAnnotationsMap(): jspb.Map<string, string>;
+  clearNamespaceAnnotationsMap(): KubernetesEnclaveSettings;
+
+  getDefaultNodeSelectorsMap(): jspb.Map<string, string>;
+  clearDefaultNodeSelectorsMap(): KubernetesEnclaveSettings;
+
+  getServiceAccountName(): string;
+  setServiceAccountName(value: string): KubernetesEnclaveSettings;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): KubernetesEnclaveSettings.AsObject;
+  static toObject(includeInstance: boolean, msg: KubernetesEnclaveSettings): KubernetesEnclaveSettings.AsObject;
+  static serializeBinaryToWriter(message: KubernetesEnclaveSettings, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): KubernetesEnclaveSettings;
+  static deserializeBinaryFromReader(message: KubernetesEnclaveSettings, reader: jspb.BinaryReader): KubernetesEnclaveSettings;
+}
+
+export namespace KubernetesEnclaveSettings {
+  export type AsObject = {
+    namespaceLabelsMap: Array<[string, string]>,
+    namespaceAnnotationsMap: Array<[string, string]>,
+    defaultNodeSelectorsMap: Array<[string, string]>,
+    serviceAccountName: string,
   }
 }
 
@@ -258,6 +330,24 @@ export namespace StopEnclaveArgs {
   }
 }
 
+export class StartEnclaveArgs extends jspb.Message {
+  getEnclaveIdentifier(): string;
+  setEnclaveIdentifier(value: string): StartEnclaveArgs;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): StartEnclaveArgs.AsObject;
+  static toObject(includeInstance: boolean, msg: StartEnclaveArgs): StartEnclaveArgs.AsObject;
+  static serializeBinaryToWriter(message: StartEnclaveArgs, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): StartEnclaveArgs;
+  static deserializeBinaryFromReader(message: StartEnclaveArgs, reader: jspb.BinaryReader): StartEnclaveArgs;
+}
+
+export namespace StartEnclaveArgs {
+  export type AsObject = {
+    enclaveIdentifier: string,
+  }
+}
+
 export class DestroyEnclaveArgs extends jspb.Message {
   getEnclaveIdentifier(): string;
   setEnclaveIdentifier(value: string): DestroyEnclaveArgs;
@@ -351,6 +441,19 @@ export class GetServiceLogsArgs extends jspb.Message {
   clearConjunctiveFiltersList(): GetServiceLogsArgs;
   addConjunctiveFilters(value?: LogLineFilter, index?: number): LogLineFilter;
 
+  getSince(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setSince(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceLogsArgs;
+  hasSince(): boolean;
+  clearSince(): GetServiceLogsArgs;
+
+  getUntil(): google_protobuf_timestamp_pb.Timestamp | undefined;
+  setUntil(value?: google_protobuf_timestamp_pb.Timestamp): GetServiceLogsArgs;
+  hasUntil(): boolean;
+  clearUntil(): GetServiceLogsArgs;
+
+  getNumLogLines(): number;
+  setNumLogLines(value: number): GetServiceLogsArgs;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): GetServiceLogsArgs.AsObject;
   static toObject(includeInstance: boolean, msg: GetServiceLogsArgs): GetServiceLogsArgs.AsObject;
@@ -365,6 +468,9 @@ export namespace GetServiceLogsArgs {
     serviceUuidSetMap: Array<[string, boolean]>,
     followLogs: boolean,
     conjunctiveFiltersList: Array<LogLineFilter.AsObject>,
+    since?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    until?: google_protobuf_timestamp_pb.Timestamp.AsObject,
+    numLogLines: number,
   }
 }
 
@@ -396,6 +502,11 @@ export class LogLine extends jspb.Message {
   clearLineList(): LogLine;
   addLine(value: string, index?: number): LogLine;
 
+  getTimestampList(): Array<google_protobuf_timestamp_pb.Timestamp>;
+  setTimestampList(value: Array<google_protobuf_timestamp_pb.Timestamp>): LogLine;
+  clearTimestampList(): LogLine;
+  addTimestamp(value?: google_protobuf_timestamp_pb.Timestamp, index?: number): google_protobuf_timestamp_pb.Timestamp;
+
   serializeBinary(): Uint8Array;
   toObject(includeInstance?: boolean): LogLine.AsObject;
   static toObject(includeInstance: boolean, msg: LogLine): LogLine.AsObject;
@@ -407,6 +518,7 @@ export class LogLine extends jspb.Message {
 export namespace LogLine {
   export type AsObject = {
     lineList: Array<string>,
+    timestampList: Array<google_protobuf_timestamp_pb.Timestamp.AsObject>,
   }
 }
 
@@ -432,6 +544,28 @@ export namespace LogLineFilter {
   }
 }
 
+export class GetLogsStorageUsageResponse extends jspb.Message {
+  getTotalSizeInBytes(): number;
+  setTotalSizeInBytes(value: number): GetLogsStorageUsageResponse;
+
+  getSizeInBytesByEnclaveUuidMap(): jspb.Map<string, number>;
+  clearSizeInBytesByEnclaveUuidMap(): GetLogsStorageUsageResponse;
+
+  serializeBinary(): Uint8Array;
+  toObject(includeInstance?: boolean): GetLogsStorageUsageResponse.AsObject;
+  static toObject(includeInstance: boolean, msg: GetLogsStorageUsageResponse): GetLogsStorageUsageResponse.AsObject;
+  static serializeBinaryToWriter(message: GetLogsStorageUsageResponse, writer: jspb.BinaryWriter): void;
+  static deserializeBinary(bytes: Uint8Array): GetLogsStorageUsageResponse;
+  static deserializeBinaryFromReader(message: GetLogsStorageUsageResponse, reader: jspb.BinaryReader): GetLogsStorageUsageResponse;
+}
+
+export namespace GetLogsStorageUsageResponse {
+  export type AsObject = {
+    totalSizeInBytes: number,
+    sizeInBytesByEnclaveUuidMap: Array<[string, number]>,
+  }
+}
+
 export enum EnclaveMode { 
   TEST = 0,
   PRODUCTION = 1,
@@ -38,14 +38,18 @@ goog.exportSymbol('proto.engine_api.EnclaveIdentifiers', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveInfo', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveMode', null, global);
 goog.exportSymbol('proto.engine_api.EnclaveNameAndUuid', null, global);
+goog.exportSymbol('proto.engine_api.EnclavePoolInfo', null, global);
 goog.exportSymbol('proto.engine_api.GetEnclavesResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetEngineInfoResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetExistingAndHistoricalEnclaveIdentifiersResponse', null, global);
+goog.exportSymbol('proto.engine_api.GetLogsStorageUsageResponse', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsArgs', null, global);
 goog.exportSymbol('proto.engine_api.GetServiceLogsResponse', null, global);
+goog.exportSymbol('proto.engine_api.KubernetesEnclaveSettings', null, global);
 goog.exportSymbol('proto.engine_api.LogLine', null, global);
 goog.exportSymbol('proto.engine_api.LogLineFilter', null, global);
 goog.exportSymbol('proto.engine_api.LogLineOperator', null, global);
+goog.exportSymbol('proto.engine_api.StartEnclaveArgs', null, global);
 goog.exportSymbol('proto.engine_api.StopEnclaveArgs', null, global);
 /**
  * Generated by JsPbCodeGenerator.
@@ -68,6 +72,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.GetEngineInfoResponse.displayName = 'proto.engine_api.GetEngineInfoResponse';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.EnclavePoolInfo = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.EnclavePoolInfo, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.EnclavePoolInfo.displayName = 'proto.engine_api.EnclavePoolInfo';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -89,6 +114,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.CreateEnclaveArgs.displayName = 'proto.engine_api.CreateEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.KubernetesEnclaveSettings = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.KubernetesEnclaveSettings, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.KubernetesEnclaveSettings.displayName = 'proto.engine_api.KubernetesEnclaveSettings';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -257,6 +303,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.StopEnclaveArgs.displayName = 'proto.engine_api.StopEnclaveArgs';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.engine_api.StartEnclaveArgs = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.StartEnclaveArgs, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.StartEnclaveArgs.displayName = 'proto.engine_api.StartEnclaveArgs';
+}
 /**
  * Generated by JsPbCodeGenerator.
  * @param {Array=} opt_data Optional initial data array, typically from a
@@ -425,6 +492,27 @@ if (goog.DEBUG && !COMPILED) {
    */
   proto.engine_api.LogLineFilter.displayName = 'proto.engine_api.LogLineFilter';
 }
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.GetLogsStorageUsageResponseessage}
+ * @constructor
+ */
+proto.engine_api.GetLogsStorageUsageResponse = function(opt_data) {
+  jspb.GetLogsStorageUsageResponseessage.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.engine_api.GetLogsStorageUsageResponse, jspb.GetLogsStorageUsageResponseessage);
+if (goog.DEBUG && !COMPILED) {
+  /**
+   * @public
+   * @override
+   */
+  proto.engine_api.GetLogsStorageUsageResponse.displayName = 'proto.engine_api.GetLogsStorageUsageResponse';
+}
 
 
 
@@ -457,7 +545,8 @@ proto.engine_api.GetEngineInfoResponse.prototype.toObject = function(opt_include
  */
 proto.engine_api.GetEngineInfoResponse.toObject = function(includeInstance, msg) {
   var f, obj = {
-    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, "")
+    engineVersion: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    enclavePoolInfo: (f = msg.getEnclavePoolInfo()) && proto.engine_api.EnclavePoolInfo.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -498,6 +587,11 @@ proto.engine_api.GetEngineInfoResponse.deserializeBinaryFromReader = function(ms
       var value = /** @type {string} */ (reader.readString());
       msg.setEngineVersion(value);
       break;
+    case 2:
+      var value = new proto.engine_api.EnclavePoolInfo;
+      reader.readMessage(value,proto.engine_api.EnclavePoolInfo.deserializeBinaryFromReader);
+      msg.setEnclavePoolInfo(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -534,6 +628,14 @@ proto.engine_api.GetEngineInfoResponse.serializeBinaryToWriter = function(messag
       f
     );
   }
+  f = message.getEnclavePoolInfo();
+  if (f != null) {
+    writer.writeMessage(
+      2,
+      f,
+      proto.engine_api.EnclavePoolInfo.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -555,6 +657,43 @@ proto.engine_api.GetEngineInfoResponse.prototype.setEngineVersion = function(val
 };
 
 
+/**
+ * optional EnclavePoolInfo enclave_pool_info = 2;
+ * @return {?proto.engine_api.EnclavePoolInfo}
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.getEnclavePoolInfo = function() {
+  return /** @type{?proto.engine_api.EnclavePoolInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclavePoolInfo, 2));
+};
+
+
+/**
+ * @param {?proto.engine_api.EnclavePoolInfo|undefined} value
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
+*/
+proto.engine_api.GetEngineInfoResponse.prototype.setEnclavePoolInfo = function(value) {
+  return jspb.Message.setWrapperField(this, 2, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetEngineInfoResponse} returns this
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.clearEnclavePoolInfo = function() {
+  return this.setEnclavePoolInfo(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetEngineInfoResponse.prototype.hasEnclavePoolInfo = function() {
+  return jspb.Message.getField(this, 2) != null;
+};
+
+
 
 
 
@@ -571,8 +710,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
+proto.engine_api.EnclavePoolInfo.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.EnclavePoolInfo.toObject(opt_includeInstance, this);
 };
 
 
@@ -581,16 +720,16 @@ proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInst
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
+ * @param {!proto.engine_api.EnclavePoolInfo} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
+proto.engine_api.EnclavePoolInfo.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
-    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
-    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
-    mode: jspb.Message.getFieldWithDefault(msg, 4, 0)
+    poolSize: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    apiContainerVersion: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    numIdleEnclaves: jspb.Message.getFieldWithDefault(msg, 3, 0),
+    numAdoptedIdleEnclaves: jspb.Message.getFieldWithDefault(msg, 4, 0)
   };
 
   if (includeInstance) {
@@ -604,23 +743,23 @@ proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.EnclavePoolInfo}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
+proto.engine_api.EnclavePoolInfo.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveArgs;
-  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.EnclavePoolInfo;
+  return proto.engine_api.EnclavePoolInfo.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
+ * @param {!proto.engine_api.EnclavePoolInfo} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveArgs}
+ * @return {!proto.engine_api.EnclavePoolInfo}
  */
-proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.EnclavePoolInfo.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -628,20 +767,20 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setEnclaveName(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setPoolSize(value);
       break;
     case 2:
       var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerVersionTag(value);
+      msg.setApiContainerVersion(value);
       break;
     case 3:
-      var value = /** @type {string} */ (reader.readString());
-      msg.setApiContainerLogLevel(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumIdleEnclaves(value);
       break;
     case 4:
-      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
-      msg.setMode(value);
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumAdoptedIdleEnclaves(value);
       break;
     default:
       reader.skipField();
@@ -656,9 +795,9 @@ proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, r
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
+proto.engine_api.EnclavePoolInfo.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
+  proto.engine_api.EnclavePoolInfo.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -666,36 +805,36 @@ proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveArgs} message
+ * @param {!proto.engine_api.EnclavePoolInfo} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.EnclavePoolInfo.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveName();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getPoolSize();
+  if (f !== 0) {
+    writer.writeUint32(
       1,
       f
     );
   }
-  f = message.getApiContainerVersionTag();
+  f = message.getApiContainerVersion();
   if (f.length > 0) {
     writer.writeString(
       2,
       f
     );
   }
-  f = message.getApiContainerLogLevel();
-  if (f.length > 0) {
-    writer.writeString(
+  f = message.getNumIdleEnclaves();
+  if (f !== 0) {
+    writer.writeUint32(
       3,
       f
     );
   }
-  f = message.getMode();
-  if (f !== 0.0) {
-    writer.writeEnum(
+  f = message.getNumAdoptedIdleEnclaves();
+  if (f !== 0) {
+    writer.writeUint32(
       4,
       f
     );
@@ -704,74 +843,74 @@ proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, w
 
 
 /**
- * optional string enclave_name = 1;
- * @return {string}
+ * optional uint32 pool_size = 1;
+ * @return {number}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+proto.engine_api.EnclavePoolInfo.prototype.getPoolSize = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.EnclavePoolInfo} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
-  return jspb.Message.setProto3StringField(this, 1, value);
+proto.engine_api.EnclavePoolInfo.prototype.setPoolSize = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
 };
 
 
 /**
- * optional string api_container_version_tag = 2;
+ * optional string api_container_version = 2;
  * @return {string}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
+proto.engine_api.EnclavePoolInfo.prototype.getApiContainerVersion = function() {
   return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
 };
 
 
 /**
  * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @return {!proto.engine_api.EnclavePoolInfo} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
+proto.engine_api.EnclavePoolInfo.prototype.setApiContainerVersion = function(value) {
   return jspb.Message.setProto3StringField(this, 2, value);
 };
 
 
 /**
- * optional string api_container_log_level = 3;
- * @return {string}
+ * optional uint32 num_idle_enclaves = 3;
+ * @return {number}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
-  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+proto.engine_api.EnclavePoolInfo.prototype.getNumIdleEnclaves = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
 };
 
 
 /**
- * @param {string} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.EnclavePoolInfo} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
-  return jspb.Message.setProto3StringField(this, 3, value);
+proto.engine_api.EnclavePoolInfo.prototype.setNumIdleEnclaves = function(value) {
+  return jspb.Message.setProto3IntField(this, 3, value);
 };
 
 
 /**
- * optional EnclaveMode mode = 4;
- * @return {!proto.engine_api.EnclaveMode}
+ * optional uint32 num_adopted_idle_enclaves = 4;
+ * @return {number}
  */
-proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
-  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+proto.engine_api.EnclavePoolInfo.prototype.getNumAdoptedIdleEnclaves = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
 };
 
 
 /**
- * @param {!proto.engine_api.EnclaveMode} value
- * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ * @param {number} value
+ * @return {!proto.engine_api.EnclavePoolInfo} returns this
  */
-proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
-  return jspb.Message.setProto3EnumField(this, 4, value);
+proto.engine_api.EnclavePoolInfo.prototype.setNumAdoptedIdleEnclaves = function(value) {
+  return jspb.Message.setProto3IntField(this, 4, value);
 };
 
 
@@ -791,8 +930,8 @@ if (jspb.Message.GENERATE_TO_OBJECT) {
  *     http://goto/soy-param-migration
  * @return {!Object}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
-  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+proto.engine_api.CreateEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveArgs.toObject(opt_includeInstance, this);
 };
 
 
@@ -801,13 +940,17 @@ proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_include
  * @param {boolean|undefined} includeInstance Deprecated. Whether to include
  *     the JSPB instance for transitional soy proto support:
  *     http://goto/soy-param-migration
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The msg instance to transform.
  * @return {!Object}
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+proto.engine_api.CreateEnclaveArgs.toObject = function(includeInstance, msg) {
   var f, obj = {
-    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+    enclaveName: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    apiContainerVersionTag: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    apiContainerLogLevel: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    mode: jspb.Message.getFieldWithDefault(msg, 4, 0),
+    kubernetesSettings: (f = msg.getKubernetesSettings()) && proto.engine_api.KubernetesEnclaveSettings.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -821,23 +964,23 @@ proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg)
 /**
  * Deserializes binary data (in protobuf wire format).
  * @param {jspb.ByteSource} bytes The bytes to deserialize.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.CreateEnclaveArgs}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+proto.engine_api.CreateEnclaveArgs.deserializeBinary = function(bytes) {
   var reader = new jspb.BinaryReader(bytes);
-  var msg = new proto.engine_api.CreateEnclaveResponse;
-  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+  var msg = new proto.engine_api.CreateEnclaveArgs;
+  return proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader(msg, reader);
 };
 
 
 /**
  * Deserializes binary data (in protobuf wire format) from the
  * given reader into the given message object.
- * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!proto.engine_api.CreateEnclaveArgs} msg The message object to deserialize into.
  * @param {!jspb.BinaryReader} reader The BinaryReader to use.
- * @return {!proto.engine_api.CreateEnclaveResponse}
+ * @return {!proto.engine_api.CreateEnclaveArgs}
  */
-proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+proto.engine_api.CreateEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
   while (reader.nextField()) {
     if (reader.isEndGroup()) {
       break;
@@ -845,9 +988,25 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
     var field = reader.getFieldNumber();
     switch (field) {
     case 1:
-      var value = new proto.engine_api.EnclaveInfo;
-      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
-      msg.setEnclaveInfo(value);
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerVersionTag(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setApiContainerLogLevel(value);
+      break;
+    case 4:
+      var value = /** @type {!proto.engine_api.EnclaveMode} */ (reader.readEnum());
+      msg.setMode(value);
+      break;
+    case 5:
+      var value = new proto.engine_api.KubernetesEnclaveSettings;
+      reader.readMessage(value,proto.engine_api.KubernetesEnclaveSettings.deserializeBinaryFromReader);
+      msg.setKubernetesSettings(value);
       break;
     default:
       reader.skipField();
@@ -862,9 +1021,9 @@ proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(ms
  * Serializes the message to binary data (in protobuf wire format).
  * @return {!Uint8Array}
  */
-proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+proto.engine_api.CreateEnclaveArgs.prototype.serializeBinary = function() {
   var writer = new jspb.BinaryWriter();
-  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter(this, writer);
   return writer.getResultBuffer();
 };
 
@@ -872,31 +1031,509 @@ proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
 /**
  * Serializes the given message to binary data (in protobuf wire
  * format), writing to the given BinaryWriter.
- * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!proto.engine_api.CreateEnclaveArgs} message
  * @param {!jspb.BinaryWriter} writer
  * @suppress {unusedLocalVariables} f is only used for nested messages
  */
-proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+proto.engine_api.CreateEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
   var f = undefined;
-  f = message.getEnclaveInfo();
-  if (f != null) {
-    writer.writeMessage(
+  f = message.getEnclaveName();
+  if (f.length > 0) {
+    writer.writeString(
       1,
-      f,
-      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+      f
     );
   }
-};
-
-
-/**
- * optional EnclaveInfo enclave_info = 1;
- * @return {?proto.engine_api.EnclaveInfo}
- */
-proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
-  return /** @type{?proto.engine_api.EnclaveInfo} */ (
-    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
-};
+  f = message.getApiContainerVersionTag();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getApiContainerLogLevel();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getMode();
+  if (f !== 0.0) {
+    writer.writeEnum(
+      4,
+      f
+    );
+  }
+  f = message.getKubernetesSettings();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      proto.engine_api.KubernetesEnclaveSettings.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_name = 1;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getEnclaveName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setEnclaveName = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string api_container_version_tag = 2;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerVersionTag = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerVersionTag = function(value) {
+  return jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string api_container_log_level = 3;
+ * @return {string}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getApiContainerLogLevel = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setApiContainerLogLevel = function(value) {
+  return jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional EnclaveMode mode = 4;
+ * @return {!proto.engine_api.EnclaveMode}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getMode = function() {
+  return /** @type {!proto.engine_api.EnclaveMode} */ (jspb.Message.getFieldWithDefault(this, 4, 0));
+};
+
+
+/**
+ * @param {!proto.engine_api.EnclaveMode} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.setMode = function(value) {
+  return jspb.Message.setProto3EnumField(this, 4, value);
+};
+
+
+/**
+ * optional KubernetesEnclaveSettings kubernetes_settings = 5;
+ * @return {?proto.engine_api.KubernetesEnclaveSettings}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.getKubernetesSettings = function() {
+  return /** @type{?proto.engine_api.KubernetesEnclaveSettings} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.KubernetesEnclaveSettings, 5));
+};
+
+
+/**
+ * @param {?proto.engine_api.KubernetesEnclaveSettings|undefined} value
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+*/
+proto.engine_api.CreateEnclaveArgs.prototype.setKubernetesSettings = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.CreateEnclaveArgs} returns this
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.clearKubernetesSettings = function() {
+  return this.setKubernetesSettings(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.CreateEnclaveArgs.prototype.hasKubernetesSettings = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.KubernetesEnclaveSettings.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.KubernetesEnclaveSettings} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.KubernetesEnclaveSettings.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    namespaceLabelsMap: (f = msg.getNamespaceLabelsMap()) ? f.toObject(includeInstance, undefined) : [],
+    namespaceAnnotationsMap: (f = msg.getNamespaceAnnotationsMap()) ? f.toObject(includeInstance, undefined) : [],
+    defaultNodeSelectorsMap: (f = msg.getDefaultNodeSelectorsMap()) ? f.toObject(includeInstance, undefined) : [],
+    serviceAccountName: jspb.Message.getFieldWithDefault(msg, 4, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.KubernetesEnclaveSettings}
+ */
+proto.engine_api.KubernetesEnclaveSettings.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.KubernetesEnclaveSettings;
+  return proto.engine_api.KubernetesEnclaveSettings.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.KubernetesEnclaveSettings} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.KubernetesEnclaveSettings}
+ */
+proto.engine_api.KubernetesEnclaveSettings.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = msg.getNamespaceLabelsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 2:
+      var value = msg.getNamespaceAnnotationsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 3:
+      var value = msg.getDefaultNodeSelectorsMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readString, null, "", "");
+         });
+      break;
+    case 4:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setServiceAccountName(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.KubernetesEnclaveSettings.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.KubernetesEnclaveSettings} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.KubernetesEnclaveSettings.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getNamespaceLabelsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(1, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getNamespaceAnnotationsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getDefaultNodeSelectorsMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(3, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeString);
+  }
+  f = message.getServiceAccountName();
+  if (f.length > 0) {
+    writer.writeString(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * map<string, string> namespace_labels = 1;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.getNamespaceLabelsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 1, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.KubernetesEnclaveSettings} returns this
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.clearNamespaceLabelsMap = function() {
+  this.getNamespaceLabelsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> namespace_annotations = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.getNamespaceAnnotationsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.KubernetesEnclaveSettings} returns this
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.clearNamespaceAnnotationsMap = function() {
+  this.getNamespaceAnnotationsMap().clear();
+  return this;};
+
+
+/**
+ * map<string, string> default_node_selectors = 3;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,string>}
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.getDefaultNodeSelectorsMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,string>} */ (
+      jspb.Message.getMapField(this, 3, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.KubernetesEnclaveSettings} returns this
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.clearDefaultNodeSelectorsMap = function() {
+  this.getDefaultNodeSelectorsMap().clear();
+  return this;};
+
+
+/**
+ * optional string service_account_name = 4;
+ * @return {string}
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.getServiceAccountName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 4, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.KubernetesEnclaveSettings} returns this
+ */
+proto.engine_api.KubernetesEnclaveSettings.prototype.setServiceAccountName = function(value) {
+  return jspb.Message.setProto3StringField(this, 4, value);
+};
+
+
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.CreateEnclaveResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveInfo: (f = msg.getEnclaveInfo()) && proto.engine_api.EnclaveInfo.toObject(includeInstance, f)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.CreateEnclaveResponse;
+  return proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.CreateEnclaveResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.CreateEnclaveResponse}
+ */
+proto.engine_api.CreateEnclaveResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = new proto.engine_api.EnclaveInfo;
+      reader.readMessage(value,proto.engine_api.EnclaveInfo.deserializeBinaryFromReader);
+      msg.setEnclaveInfo(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.CreateEnclaveResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.CreateEnclaveResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveInfo();
+  if (f != null) {
+    writer.writeMessage(
+      1,
+      f,
+      proto.engine_api.EnclaveInfo.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional EnclaveInfo enclave_info = 1;
+ * @return {?proto.engine_api.EnclaveInfo}
+ */
+proto.engine_api.CreateEnclaveResponse.prototype.getEnclaveInfo = function() {
+  return /** @type{?proto.engine_api.EnclaveInfo} */ (
+    jspb.Message.getWrapperField(this, proto.engine_api.EnclaveInfo, 1));
+};
 
 
 /**
@@ -2325,6 +2962,136 @@ proto.engine_api.StopEnclaveArgs.prototype.setEnclaveIdentifier = function(value
 
 
 
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.StartEnclaveArgs.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.StartEnclaveArgs.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StartEnclaveArgs.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    enclaveIdentifier: jspb.Message.getFieldWithDefault(msg, 1, "")
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.StartEnclaveArgs}
+ */
+proto.engine_api.StartEnclaveArgs.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.StartEnclaveArgs;
+  return proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.StartEnclaveArgs} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.StartEnclaveArgs}
+ */
+proto.engine_api.StartEnclaveArgs.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setEnclaveIdentifier(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.StartEnclaveArgs.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.StartEnclaveArgs} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.StartEnclaveArgs.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getEnclaveIdentifier();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string enclave_identifier = 1;
+ * @return {string}
+ */
+proto.engine_api.StartEnclaveArgs.prototype.getEnclaveIdentifier = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/**
+ * @param {string} value
+ * @return {!proto.engine_api.StartEnclaveArgs} returns this
+ */
+proto.engine_api.StartEnclaveArgs.prototype.setEnclaveIdentifier = function(value) {
+  return jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+
+
+
 if (jspb.Message.GENERATE_TO_OBJECT) {
 /**
  * Creates an object representation of this proto.
@@ -2945,7 +3712,10 @@ proto.engine_api.GetServiceLogsArgs.toObject = function(includeInstance, msg) {
     serviceUuidSetMap: (f = msg.getServiceUuidSetMap()) ? f.toObject(includeInstance, undefined) : [],
     followLogs: jspb.Message.getBooleanFieldWithDefault(msg, 3, false),
     conjunctiveFiltersList: jspb.Message.toObjectList(msg.getConjunctiveFiltersList(),
-    proto.engine_api.LogLineFilter.toObject, includeInstance)
+    proto.engine_api.LogLineFilter.toObject, includeInstance),
+    since: (f = msg.getSince()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    until: (f = msg.getUntil()) && google_protobuf_timestamp_pb.Timestamp.toObject(includeInstance, f),
+    numLogLines: jspb.Message.getFieldWithDefault(msg, 7, 0)
   };
 
   if (includeInstance) {
@@ -2996,10 +3766,24 @@ proto.engine_api.GetServiceLogsArgs.deserializeBinaryFromReader = function(msg,
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setFollowLogs(value);
       break;
-    case 4:
-      var value = new proto.engine_api.LogLineFilter;
-      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
-      msg.addConjunctiveFilters(value);
+    case 4:
+      var value = new proto.engine_api.LogLineFilter;
+      reader.readMessage(value,proto.engine_api.LogLineFilter.deserializeBinaryFromReader);
+      msg.addConjunctiveFilters(value);
+      break;
+    case 5:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setSince(value);
+      break;
+    case 6:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.setUntil(value);
+      break;
+    case 7:
+      var value = /** @type {number} */ (reader.readUint32());
+      msg.setNumLogLines(value);
       break;
     default:
       reader.skipField();
@@ -3056,6 +3840,29 @@ proto.engine_api.GetServiceLogsArgs.serializeBinaryToWriter = function(message,
       proto.engine_api.LogLineFilter.serializeBinaryToWriter
     );
   }
+  f = message.getSince();
+  if (f != null) {
+    writer.writeMessage(
+      5,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getUntil();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
+  f = message.getNumLogLines();
+  if (f !== 0) {
+    writer.writeUint32(
+      7,
+      f
+    );
+  }
 };
 
 
@@ -3155,6 +3962,98 @@ proto.engine_api.GetServiceLogsArgs.prototype.clearConjunctiveFiltersList = func
 };
 
 
+/**
+ * optional google.protobuf.Timestamp since = 5;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getSince = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 5));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setSince = function(value) {
+  return jspb.Message.setWrapperField(this, 5, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearSince = function() {
+  return this.setSince(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasSince = function() {
+  return jspb.Message.getField(this, 5) != null;
+};
+
+
+/**
+ * optional google.protobuf.Timestamp until = 6;
+ * @return {?proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getUntil = function() {
+  return /** @type{?proto.google.protobuf.Timestamp} */ (
+    jspb.Message.getWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 6));
+};
+
+
+/**
+ * @param {?proto.google.protobuf.Timestamp|undefined} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+*/
+proto.engine_api.GetServiceLogsArgs.prototype.setUntil = function(value) {
+  return jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+/**
+ * Clears the message field making it undefined.
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.clearUntil = function() {
+  return this.setUntil(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.hasUntil = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+/**
+ * optional uint32 num_log_lines = 7;
+ * @return {number}
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.getNumLogLines = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 7, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.GetServiceLogsArgs} returns this
+ */
+proto.engine_api.GetServiceLogsArgs.prototype.setNumLogLines = function(value) {
+  return jspb.Message.setProto3IntField(this, 7, value);
+};
+
+
 
 
 
@@ -3327,7 +4226,7 @@ proto.engine_api.GetServiceLogsResponse.prototype.clearNotFoundServiceUuidSetMap
  * @private {!Array<number>}
  * @const
  */
-proto.engine_api.LogLine.repeatedFields_ = [1];
+proto.engine_api.LogLine.repeatedFields_ = [1,2];
 
 
 
@@ -3360,7 +4259,9 @@ proto.engine_api.LogLine.prototype.toObject = function(opt_includeInstance) {
  */
 proto.engine_api.LogLine.toObject = function(includeInstance, msg) {
   var f, obj = {
-    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f
+    lineList: (f = jspb.Message.getRepeatedField(msg, 1)) == null ? undefined : f,
+    timestampList: jspb.Message.toObjectList(msg.getTimestampList(),
+    google_protobuf_timestamp_pb.Timestamp.toObject, includeInstance)
   };
 
   if (includeInstance) {
@@ -3401,6 +4302,11 @@ proto.engine_api.LogLine.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.addLine(value);
       break;
+    case 2:
+      var value = new google_protobuf_timestamp_pb.Timestamp;
+      reader.readMessage(value,google_protobuf_timestamp_pb.Timestamp.deserializeBinaryFromReader);
+      msg.addTimestamp(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3437,6 +4343,14 @@ proto.engine_api.LogLine.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getTimestampList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      google_protobuf_timestamp_pb.Timestamp.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -3477,6 +4391,44 @@ proto.engine_api.LogLine.prototype.clearLineList = function() {
 };
 
 
+/**
+ * repeated google.protobuf.Timestamp timestamp = 2;
+ * @return {!Array<!proto.google.protobuf.Timestamp>}
+ */
+proto.engine_api.LogLine.prototype.getTimestampList = function() {
+  return /** @type{!Array<!proto.google.protobuf.Timestamp>} */ (
+    jspb.Message.getRepeatedWrapperField(this, google_protobuf_timestamp_pb.Timestamp, 2));
+};
+
+
+/**
+ * @param {!Array<!proto.google.protobuf.Timestamp>} value
+ * @return {!proto.engine_api.LogLine} returns this
+*/
+proto.engine_api.LogLine.prototype.setTimestampList = function(value) {
+  return jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.google.protobuf.Timestamp=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.google.protobuf.Timestamp}
+ */
+proto.engine_api.LogLine.prototype.addTimestamp = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.google.protobuf.Timestamp, opt_index);
+};
+
+
+/**
+ * Clears the list making it empty but non-null.
+ * @return {!proto.engine_api.LogLine} returns this
+ */
+proto.engine_api.LogLine.prototype.clearTimestampList = function() {
+  return this.setTimestampList([]);
+};
+
+
 
 
 
@@ -3637,6 +4589,168 @@ proto.engine_api.LogLineFilter.prototype.setTextPattern = function(value) {
 };
 
 
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * Optional fields that are not set will be set to undefined.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     net/proto2/compiler/js/internal/generator.cc#kKeyword.
+ * @param {boolean=} opt_includeInstance Deprecated. whether to include the
+ *     JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.engine_api.GetLogsStorageUsageResponse.prototype.toObject = function(opt_includeInstance) {
+  return proto.engine_api.GetLogsStorageUsageResponse.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Deprecated. Whether to include
+ *     the JSPB instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.engine_api.GetLogsStorageUsageResponse} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetLogsStorageUsageResponse.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    totalSizeInBytes: jspb.Message.getFieldWithDefault(msg, 1, 0),
+    sizeInBytesByEnclaveUuidMap: (f = msg.getSizeInBytesByEnclaveUuidMap()) ? f.toObject(includeInstance, undefined) : []
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.engine_api.GetLogsStorageUsageResponse}
+ */
+proto.engine_api.GetLogsStorageUsageResponse.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.engine_api.GetLogsStorageUsageResponse;
+  return proto.engine_api.GetLogsStorageUsageResponse.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.engine_api.GetLogsStorageUsageResponse} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.engine_api.GetLogsStorageUsageResponse}
+ */
+proto.engine_api.GetLogsStorageUsageResponse.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setTotalSizeInBytes(value);
+      break;
+    case 2:
+      var value = msg.getSizeInBytesByEnclaveUuidMap();
+      reader.readMessage(value, function(message, reader) {
+        jspb.Map.deserializeBinary(message, reader, jspb.BinaryReader.prototype.readString, jspb.BinaryReader.prototype.readUint64, null, "", 0);
+         });
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.engine_api.GetLogsStorageUsageResponse.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.engine_api.GetLogsStorageUsageResponse.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.engine_api.GetLogsStorageUsageResponse} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.engine_api.GetLogsStorageUsageResponse.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getTotalSizeInBytes();
+  if (f !== 0) {
+    writer.writeUint64(
+      1,
+      f
+    );
+  }
+  f = message.getSizeInBytesByEnclaveUuidMap(true);
+  if (f && f.getLength() > 0) {
+    f.serializeBinary(2, writer, jspb.BinaryWriter.prototype.writeString, jspb.BinaryWriter.prototype.writeUint64);
+  }
+};
+
+
+/**
+ * optional uint64 total_size_in_bytes = 1;
+ * @return {number}
+ */
+proto.engine_api.GetLogsStorageUsageResponse.prototype.getTotalSizeInBytes = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 1, 0));
+};
+
+
+/**
+ * @param {number} value
+ * @return {!proto.engine_api.GetLogsStorageUsageResponse} returns this
+ */
+proto.engine_api.GetLogsStorageUsageResponse.prototype.setTotalSizeInBytes = function(value) {
+  return jspb.Message.setProto3IntField(this, 1, value);
+};
+
+
+/**
+ * map<string, uint64> size_in_bytes_by_enclave_uuid = 2;
+ * @param {boolean=} opt_noLazyCreate Do not create the map if
+ * empty, instead returning `undefined`
+ * @return {!jspb.Map<string,number>}
+ */
+proto.engine_api.GetLogsStorageUsageResponse.prototype.getSizeInBytesByEnclaveUuidMap = function(opt_noLazyCreate) {
+  return /** @type {!jspb.Map<string,number>} */ (
+      jspb.Message.getMapField(this, 2, opt_noLazyCreate,
+      null));
+};
+
+
+/**
+ * Clears values from the map. The map will be non-null.
+ * @return {!proto.engine_api.GetLogsStorageUsageResponse} returns this
+ */
+proto.engine_api.GetLogsStorageUsageResponse.prototype.clearSizeInBytesByEnclaveUuidMap = function() {
+  this.getSizeInBytesByEnclaveUuidMap().clear();
+  return this;};
+
+
+
 /**
  * @enum {number}
  */
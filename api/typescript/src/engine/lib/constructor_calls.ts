@@ -5,12 +5,15 @@ import {
     GetServiceLogsArgs,
     LogLineFilter,
     LogLineOperator,
+    StartEnclaveArgs,
     StopEnclaveArgs,
 } from "../kurtosis_engine_rpc_api_bindings/engine_service_pb";
 import * as jspb from "google-protobuf";
+import * as google_protobuf_timestamp_pb from "google-protobuf/google/protobuf/timestamp_pb";
 import {ServiceUUID} from "../../core/lib/services/service";
 import * as kurtosisCtx from "./kurtosis_context/log_line_filter";
 import * as kurtosisLogLineOperator from "./kurtosis_context/log_line_operator";
+import {LogLineRange} from "./kurtosis_context/log_line_range";
 
 // ====================================================================================================
 //                                    Kurtosis Context
@@ -33,6 +36,12 @@ export function newStopEnclaveArgs(enclaveIdentifier:string): DestroyEnclaveArgs
     return result;
 }
 
+export function newStartEnclaveArgs(enclaveIdentifier:string): StartEnclaveArgs {
+    const result: StartEnclaveArgs = new StartEnclaveArgs();
+    result.setEnclaveIdentifier(enclaveIdentifier);
+    return result;
+}
+
 export function newDestroyEnclaveArgs(enclaveIdentifier:string): DestroyEnclaveArgs {
     const result: DestroyEnclaveArgs = new DestroyEnclaveArgs();
     result.setEnclaveIdentifier(enclaveIdentifier);
@@ -50,6 +59,7 @@ export function newGetServiceLogsArgs(
         serviceUuids: Set<ServiceUUID>,
         shouldFollowLogs: boolean,
         logLineFilter: kurtosisCtx.LogLineFilter|undefined,
+        logLineRange: LogLineRange|undefined,
 ): GetServiceLogsArgs {
 
     const result: GetServiceLogsArgs = new GetServiceLogsArgs();
@@ -69,6 +79,18 @@ export function newGetServiceLogsArgs(
     }
 
     result.setConjunctiveFiltersList(grpcConjunctiveFilters)
+
+    if (logLineRange !== undefined) {
+        const since: Date | undefined = logLineRange.getSince();
+        if (since !== undefined) {
+            result.setSince(google_protobuf_timestamp_pb.Timestamp.fromDate(since));
+        }
+        const until: Date | undefined = logLineRange.getUntil();
+        if (until !== undefined) {
+            result.setUntil(google_protobuf_timestamp_pb.Timestamp.fromDate(until));
+        }
+        result.setNumLogLines(logLineRange.getNumLogLines());
+    }
     return result;
 }
 
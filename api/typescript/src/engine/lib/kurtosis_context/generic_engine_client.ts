@@ -7,8 +7,9 @@ import {
     DestroyEnclaveArgs,
     GetEnclavesResponse,
     GetEngineInfoResponse,
+    StartEnclaveArgs,
     StopEnclaveArgs,
-    GetServiceLogsArgs, GetExistingAndHistoricalEnclaveIdentifiersResponse,
+    GetServiceLogsArgs, GetExistingAndHistoricalEnclaveIdentifiersResponse, GetLogsStorageUsageResponse,
 } from "../../kurtosis_engine_rpc_api_bindings/engine_service_pb";
 import {Readable} from "stream";
 
@@ -17,8 +18,10 @@ export interface GenericEngineClient {
     createEnclaveResponse(args: CreateEnclaveArgs): Promise<Result<CreateEnclaveResponse, Error>>
     getEnclavesResponse(): Promise<Result<GetEnclavesResponse, Error>>
     stopEnclave(stopEnclaveArgs: StopEnclaveArgs): Promise<Result<null, Error>>
+    startEnclave(startEnclaveArgs: StartEnclaveArgs): Promise<Result<null, Error>>
     destroyEnclave(destroyEnclaveArgs: DestroyEnclaveArgs): Promise<Result<null, Error>>
     clean(cleanArgs: CleanArgs): Promise<Result<CleanResponse, Error>>
     getServiceLogs(getServiceLogsArgs: GetServiceLogsArgs): Promise<Result<Readable, Error>>
     getExistingAndHistoricalEnclaveIdentifiers(): Promise<Result<GetExistingAndHistoricalEnclaveIdentifiersResponse, Error>>
+    getLogsStorageUsage(): Promise<Result<GetLogsStorageUsageResponse, Error>>
 }
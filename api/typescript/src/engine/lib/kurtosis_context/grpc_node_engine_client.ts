@@ -6,6 +6,7 @@ import { Readable } from 'stream';
 import { ServiceUUID } from '../../../core/lib/services/service';
 import {
     GetExistingAndHistoricalEnclaveIdentifiersResponse,
+    GetLogsStorageUsageResponse,
     LogLine
 } from '../../kurtosis_engine_rpc_api_bindings/engine_service_pb';
 import { NO_ERROR_ENCOUNTERED_BUT_RESPONSE_FALSY_MSG } from '../consts';
@@ -22,6 +23,7 @@ import type {
     GetEngineInfoResponse,
     GetServiceLogsArgs,
     GetServiceLogsResponse,
+    StartEnclaveArgs,
     StopEnclaveArgs
 } from "../../kurtosis_engine_rpc_api_bindings/engine_service_pb";
 import type {ClientReadableStream, ServiceError} from "@grpc/grpc-js";
@@ -118,6 +120,25 @@ export class GrpcNodeEngineClient implements GenericEngineClient {
         return ok(null);
     }
 
+    public async startEnclave(startEnclaveArgs: StartEnclaveArgs): Promise<Result<null, Error>> {
+        const startEnclavePromise: Promise<Result<null, Error>> = new Promise((resolve, _unusedReject) => {
+            this.client.startEnclave(startEnclaveArgs, (error: ServiceError | null, _unusedResponse?: google_protobuf_empty_pb.Empty) => {
+                if (error === null) {
+                    resolve(ok(null));
+                } else {
+                    resolve(err(error));
+                }
+            })
+        });
+
+        const startEnclaveResult: Result<null, Error> = await startEnclavePromise;
+        if (startEnclaveResult.isErr()) {
+            return err(startEnclaveResult.error);
+        }
+
+        return ok(null);
+    }
+
     public async destroyEnclave(destroyEnclaveArgs: DestroyEnclaveArgs): Promise<Result<null, Error>> {
         const destroyEnclavePromise: Promise<Result<null, Error>> = new Promise((resolve, _unusedReject) => {
             this.client.destroyEnclave(destroyEnclaveArgs, {}, (error: ServiceError | null, _unusedResponse?: google_protobuf_empty_pb.Empty) => {
@@ -211,8 +232,11 @@ export class GrpcNodeEngineClient implements GenericEngineClient {
                     (ServiceLogLine, serviceUuidStr) => {
                         const serviceLogs: Array<ServiceLog> = Array<ServiceLog>();
 
-                        ServiceLogLine.getLineList().forEach((logLine:string) => {
-                            const serviceLog: ServiceLog = new ServiceLog(logLine)
+                        const logLineTimestamps = ServiceLogLine.getTimestampList();
+                        ServiceLogLine.getLineList().forEach((logLine:string, logLineIndex: number) => {
+                            //Engines that don't send timestamps leave the time of the log lines unknown
+                            const logLineTimestamp: Date | undefined = logLineIndex < logLineTimestamps.length ? logLineTimestamps[logLineIndex].toDate() : undefined;
+                            const serviceLog: ServiceLog = new ServiceLog(logLine, logLineTimestamp)
                             serviceLogs.push(serviceLog)
                         })
 
@@ -276,6 +300,30 @@ export class GrpcNodeEngineClient implements GenericEngineClient {
         return ok(getExistingAndHistoricalEnclaveIdentifiersResult.value);
     }
 
+    public async getLogsStorageUsage(): Promise<Result<GetLogsStorageUsageResponse, Error>>{
+        const emptyArg: google_protobuf_empty_pb.Empty = new google_protobuf_empty_pb.Empty()
+        const getLogsStorageUsagePromise: Promise<Result<GetLogsStorageUsageResponse, Error>> = new Promise((resolve, _unusedReject) => {
+            this.client.getLogsStorageUsage(emptyArg, (error: ServiceError | null, response?: GetLogsStorageUsageResponse) => {
+                if (error === null) {
+                    if (!response) {
+                        resolve(err(new Error(NO_ERROR_ENCOUNTERED_BUT_RESPONSE_FALSY_MSG)));
+                    } else {
+                        resolve(ok(response!));
+                    }
+                } else {
+                    resolve(err(error));
+                }
+            })
+        });
+
+        const getLogsStorageUsageResult: Result<GetLogsStorageUsageResponse, Error> = await getLogsStorageUsagePromise;
+        if (getLogsStorageUsageResult.isErr()) {
+            return err(getLogsStorageUsageResult.error)
+        }
+
+        return ok(getLogsStorageUsageResult.value);
+    }
+
     private createNewServiceLogsReadable(streamServiceLogsResponse: ClientReadableStream<GetServiceLogsResponse>): Readable {
         const serviceLogsReadable: Readable = new Readable({
             objectMode: true, //setting object mode is to allow pass objects in the readable.push() method
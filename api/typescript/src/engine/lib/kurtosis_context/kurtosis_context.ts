@@ -18,6 +18,7 @@ import {
     EnclaveInfo,
     GetEnclavesResponse,
     GetEngineInfoResponse,
+    StartEnclaveArgs,
     StopEnclaveArgs,
     GetServiceLogsArgs, EnclaveNameAndUuid,
 } from "../../kurtosis_engine_rpc_api_bindings/engine_service_pb";
@@ -26,12 +27,15 @@ import {
     newCreateEnclaveArgs,
     newDestroyEnclaveArgs,
     newGetServiceLogsArgs,
+    newStartEnclaveArgs,
     newStopEnclaveArgs
 } from "../constructor_calls";
 import {Readable} from "stream";
 import {LogLineFilter} from "./log_line_filter";
+import {LogLineRange} from "./log_line_range";
 import {Enclaves} from "./enclaves";
 import {EnclaveIdentifiers} from "./enclave_identifiers";
+import {LogsStorageUsage} from "./logs_storage_usage";
 
 const LOCAL_HOSTNAME: string = "localhost";
 
@@ -199,6 +203,17 @@ export class KurtosisContext {
         return ok(null)
     }
 
+    // Docs available at https://docs.kurtosis.com/sdk/#startenclavestring-enclaveidentifier
+    public async startEnclave(enclaveIdentifier: string): Promise<Result<null, Error>>{
+        const startEnclaveArgs: StartEnclaveArgs = newStartEnclaveArgs(enclaveIdentifier)
+        const startEnclaveResult = await this.client.startEnclave(startEnclaveArgs)
+        if(startEnclaveResult.isErr()){
+            return err(startEnclaveResult.error)
+        }
+
+        return ok(null)
+    }
+
     // Docs available at https://docs.kurtosis.com/sdk/#destroyenclavestring-enclaveidentifier
     public async destroyEnclave(enclaveIdentifier: string): Promise<Result<null, Error>>{
         const destroyEnclaveArgs: DestroyEnclaveArgs = newDestroyEnclaveArgs(enclaveIdentifier);
@@ -236,14 +251,14 @@ export class KurtosisContext {
     //      //insert your code here
     //})
     //You can cancel receiving the stream from the service calling serviceLogsReadable.destroy()
-    // Docs available at https://docs.kurtosis.com/sdk#getservicelogsstring-enclaveidentifier-setserviceuuid-serviceuuids-boolean-shouldfollowlogs-loglinefilter-loglinefilter---servicelogsstreamcontent-servicelogsstreamcontent
-    public async getServiceLogs(enclaveIdentifier: string, serviceUuids: Set<ServiceUUID>, shouldFollowLogs: boolean, logLineFilter: LogLineFilter|undefined): Promise<Result<Readable, Error>> {
+    // Docs available at https://docs.kurtosis.com/sdk#getservicelogsstring-enclaveidentifier-setserviceuuid-serviceuuids-boolean-shouldfollowlogs-loglinefilter-loglinefilter-loglinerange-loglinerange---servicelogsstreamcontent-servicelogsstreamcontent
+    public async getServiceLogs(enclaveIdentifier: string, serviceUuids: Set<ServiceUUID>, shouldFollowLogs: boolean, logLineFilter: LogLineFilter|undefined, logLineRange: LogLineRange|undefined = undefined): Promise<Result<Readable, Error>> {
         let getServiceLogsArgs: GetServiceLogsArgs;
 
         try {
-            getServiceLogsArgs = newGetServiceLogsArgs(enclaveIdentifier, serviceUuids, shouldFollowLogs, logLineFilter);
+            getServiceLogsArgs = newGetServiceLogsArgs(enclaveIdentifier, serviceUuids, shouldFollowLogs, logLineFilter, logLineRange);
         } catch(error) {
-            return err(new Error(`An error occurred getting the get service logs arguments for enclave identifier '${enclaveIdentifier}', service UUIDs '${serviceUuids}', with should follow value '${shouldFollowLogs}' and log line filter '${logLineFilter}'. Error:\n${error}`));
+            return err(new Error(`An error occurred getting the get service logs arguments for enclave identifier '${enclaveIdentifier}', service UUIDs '${serviceUuids}', with should follow value '${shouldFollowLogs}', log line filter '${logLineFilter}' and log line range '${logLineRange}'. Error:\n${error}`));
         }
 
         const streamServiceLogsResult = await this.client.getServiceLogs(getServiceLogsArgs);
@@ -267,6 +282,16 @@ export class KurtosisContext {
         return ok(new EnclaveIdentifiers(getExistingAndHistoricalEnclaveIdentifiersValue.getAllidentifiersList()))
     }
 
+    // Docs available at https://docs.kurtosis.com/sdk#getlogsstorageusage---logsstorageusage-logsstorageusage
+    public async getLogsStorageUsage(): Promise<Result<LogsStorageUsage, Error>> {
+        const getLogsStorageUsageResponseResult = await this.client.getLogsStorageUsage();
+        if (getLogsStorageUsageResponseResult.isErr()) {
+            return err(getLogsStorageUsageResponseResult.error);
+        }
+
+        return ok(new LogsStorageUsage(getLogsStorageUsageResponseResult.value))
+    }
+
     // ====================================================================================================
     //                                       Private helper functions
     // ====================================================================================================
@@ -0,0 +1,36 @@
+const ALL_LOG_LINES: number = 0;
+
+// Narrows down which of the stored log lines of the services are returned
+export class LogLineRange {
+
+    // Only the log lines logged at or after this time are returned, undefined means no lower bound
+    private since: Date | undefined = undefined;
+    // Only the log lines logged before this time are returned, undefined means no upper bound
+    private until: Date | undefined = undefined;
+    // Only the last N log lines are returned, zero means all of them
+    private numLogLines: number = ALL_LOG_LINES;
+
+    public getSince(): Date | undefined {
+        return this.since;
+    }
+
+    public getUntil(): Date | undefined {
+        return this.until;
+    }
+
+    public getNumLogLines(): number {
+        return this.numLogLines;
+    }
+
+    public static NewLogLineRange(since: Date | undefined, until: Date | undefined, numLogLines: number): LogLineRange {
+        const logLineRange: LogLineRange = new LogLineRange();
+        logLineRange.since = since;
+        logLineRange.until = until;
+        logLineRange.numLogLines = numLogLines;
+        return logLineRange;
+    }
+
+    public static NewLastLogLinesRange(numLogLines: number): LogLineRange {
+        return this.NewLogLineRange(undefined, undefined, numLogLines);
+    }
+}
@@ -0,0 +1,17 @@
+import {EnclaveUUID} from "../../../core/lib/enclaves/enclave_context";
+import kurtosis_engine_rpc_api_bindings =  require("../../kurtosis_engine_rpc_api_bindings/engine_service_pb");
+
+// Docs available at https://docs.kurtosis.com/sdk#logsstorageusage
+export class LogsStorageUsage {
+    public readonly totalSizeInBytes: number;
+    // Also contains the enclaves that were destroyed but whose logs haven't been removed yet
+    public readonly sizeInBytesByEnclaveUuid: Map<EnclaveUUID, number>;
+
+    constructor(response: kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse) {
+        this.totalSizeInBytes = response.getTotalSizeInBytes();
+        this.sizeInBytesByEnclaveUuid = new Map<EnclaveUUID, number>();
+        response.getSizeInBytesByEnclaveUuidMap().forEach((sizeInBytes: number, enclaveUuid: string) => {
+            this.sizeInBytesByEnclaveUuid.set(enclaveUuid, sizeInBytes);
+        });
+    }
+}
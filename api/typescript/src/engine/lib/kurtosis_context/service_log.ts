@@ -1,13 +1,19 @@
 //This is an object to represent a simple log line information
 export class ServiceLog {
-    //private readonly lineTime: Date; //TODO add the time from loki logs result
     private readonly content: string;
+    //Undefined when the time the line was logged at is unknown
+    private readonly timestamp: Date | undefined;
 
-    constructor(content: string) {
+    constructor(content: string, timestamp: Date | undefined = undefined) {
         this.content = content;
+        this.timestamp = timestamp;
     }
 
     public getContent():string {
         return this.content;
     }
+
+    public getTimestamp(): Date | undefined {
+        return this.timestamp;
+    }
 }
@@ -19,5 +19,7 @@ export { KurtosisContext, DEFAULT_GRPC_ENGINE_SERVER_PORT_NUM } from "./engine/l
 export {ServiceLogsStreamContent} from "./engine/lib/kurtosis_context/service_logs_stream_content";
 export {ServiceLog} from "./engine/lib/kurtosis_context/service_log";
 export { LogLineFilter } from "./engine/lib/kurtosis_context/log_line_filter";
+export { LogLineRange } from "./engine/lib/kurtosis_context/log_line_range";
+export { LogsStorageUsage } from "./engine/lib/kurtosis_context/logs_storage_usage";
 
 export { EnclaveAPIContainerHostMachineInfo } from "./engine/kurtosis_engine_rpc_api_bindings/engine_service_pb"
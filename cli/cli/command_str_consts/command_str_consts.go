@@ -11,66 +11,80 @@ import (
 var KurtosisCmdStr = path.Base(os.Args[0])
 
 const (
-	Analytics               = "analytics"
-	CleanCmdStr             = "clean"
-	CloudAddCmdStr          = "add"
-	CloudCmdStr             = "cloud"
-	CloudLoadCmdStr         = "load"
-	ClusterCmdStr           = "cluster"
-	ClusterSetCmdStr        = "set"
-	ClusterGetCmdStr        = "get"
-	ClusterLsCmdStr         = "ls"
-	ContextCmdStr           = "context"
-	ContextAddCmdStr        = "add"
-	ContextLsCmdStr         = "ls"
-	ContextRmCmdStr         = "rm"
-	ContextSwitchCmdStr     = "switch"
-	DiscordCmdStr           = "discord"
-	DocsCmdStr              = "docs"
-	EnclaveCmdStr           = "enclave"
-	EnclaveInspectCmdStr    = "inspect"
-	EnclaveLsCmdStr         = "ls"
-	EnclaveAddCmdStr        = "add"
-	EnclaveStopCmdStr       = "stop"
-	EnclaveRmCmdStr         = "rm"
-	EnclaveDumpCmdStr       = "dump"
-	EngineCmdStr            = "engine"
-	EngineLogsCmdStr        = "logs"
-	EngineStartCmdStr       = "start"
-	EngineStatusCmdStr      = "status"
-	EngineStopCmdStr        = "stop"
-	EngineRestartCmdStr     = "restart"
-	FeedbackCmdStr          = "feedback"
-	FilesCmdStr             = "files"
-	FilesUploadCmdStr       = "upload"
-	FilesInspectCmdStr      = "inspect"
-	FilesDownloadCmdStr     = "download"
-	FilesStoreWebCmdStr     = "storeweb"
-	FilesStoreServiceCmdStr = "storeservice"
-	FilesRenderTemplate     = "rendertemplate"
-	KurtosisDumpCmdStr      = "dump"
-	PortalCmdStr            = "portal"
-	PortalStartCmdStr       = "start"
-	PortalStatusCmdStr      = "status"
-	PortalStopCmdStr        = "stop"
-	ServiceCmdStr           = "service"
-	ServiceAddCmdStr        = "add"
-	ServiceExecCmdStr       = "exec"
-	ServiceLogsCmdStr       = "logs"
-	ServiceRmCmdStr         = "rm"
-	ServiceShellCmdStr      = "shell"
-	ServiceStartCmdStr      = "start"
-	ServiceStopCmdStr       = "stop"
-	StarlarkRunCmdStr       = "run"
-	TwitterCmdStr           = "twitter"
-	ConfigCmdStr            = "config"
-	PathCmdStr              = "path"
-	VersionCmdStr           = "version"
-	ImportCmdStr            = "import"
-	GatewayCmdStr           = "gateway"
-	PortCmdStr              = "port"
-	PortPrintCmdStr         = "print"
-	WebCmdStr               = "web"
+	Analytics                        = "analytics"
+	CleanCmdStr                      = "clean"
+	CloudAddCmdStr                   = "add"
+	CloudCmdStr                      = "cloud"
+	CloudLoadCmdStr                  = "load"
+	ClusterCmdStr                    = "cluster"
+	ClusterSetCmdStr                 = "set"
+	ClusterGetCmdStr                 = "get"
+	ClusterLsCmdStr                  = "ls"
+	ContextCmdStr                    = "context"
+	ContextAddCmdStr                 = "add"
+	ContextLsCmdStr                  = "ls"
+	ContextRmCmdStr                  = "rm"
+	ContextSwitchCmdStr              = "switch"
+	ContextGitHostCmdStr             = "git-host"
+	ContextGitHostAddCmdStr          = "add"
+	ContextGitHostRmCmdStr           = "rm"
+	DiscordCmdStr                    = "discord"
+	DocsCmdStr                       = "docs"
+	EnclaveCmdStr                    = "enclave"
+	EnclaveInspectCmdStr             = "inspect"
+	EnclaveLsCmdStr                  = "ls"
+	EnclaveAddCmdStr                 = "add"
+	EnclaveStopCmdStr                = "stop"
+	EnclaveStartCmdStr               = "start"
+	EnclaveRmCmdStr                  = "rm"
+	EnclaveDumpCmdStr                = "dump"
+	EnclavePersistentDirCmdStr       = "persistent-dir"
+	EnclavePersistentDirLsCmdStr     = "ls"
+	EnclavePersistentDirRmCmdStr     = "rm"
+	EnclavePersistentDirExportCmdStr = "export"
+	EnclaveSnapshotCmdStr            = "snapshot"
+	EnclaveRestoreCmdStr             = "restore"
+	EngineCmdStr                     = "engine"
+	EngineLogsCmdStr                 = "logs"
+	EngineStartCmdStr                = "start"
+	EngineStatusCmdStr               = "status"
+	EngineStopCmdStr                 = "stop"
+	EngineRestartCmdStr              = "restart"
+	FeedbackCmdStr                   = "feedback"
+	FilesCmdStr                      = "files"
+	FilesUploadCmdStr                = "upload"
+	FilesInspectCmdStr               = "inspect"
+	FilesDownloadCmdStr              = "download"
+	FilesStoreWebCmdStr              = "storeweb"
+	FilesStoreServiceCmdStr          = "storeservice"
+	FilesRenderTemplate              = "rendertemplate"
+	KurtosisDumpCmdStr               = "dump"
+	PackageCmdStr                    = "package"
+	PackageLockCmdStr                = "lock"
+	PackageUpdateCmdStr              = "update"
+	PortalCmdStr                     = "portal"
+	PortalStartCmdStr                = "start"
+	PortalStatusCmdStr               = "status"
+	PortalStopCmdStr                 = "stop"
+	ServiceCmdStr                    = "service"
+	ServiceAddCmdStr                 = "add"
+	ServiceExecCmdStr                = "exec"
+	ServiceLogsCmdStr                = "logs"
+	ServiceRmCmdStr                  = "rm"
+	ServiceShellCmdStr               = "shell"
+	ServiceStartCmdStr               = "start"
+	ServiceStopCmdStr                = "stop"
+	StarlarkRunCmdStr                = "run"
+	TestCmdStr                       = "test"
+	TwitterCmdStr                    = "twitter"
+	ConfigCmdStr                     = "config"
+	PathCmdStr                       = "path"
+	VersionCmdStr                    = "version"
+	ImportCmdStr                     = "import"
+	GatewayCmdStr                    = "gateway"
+	PortCmdStr                       = "port"
+	PortPrintCmdStr                  = "print"
+	WebCmdStr                        = "web"
 )
 
 // TODO: added constant error message here, can we move to another file later.
@@ -26,6 +26,16 @@ const (
 	enclaveNameFlagKey           = "name"
 	enclaveProductionModeFlagKey = "production"
 
+	kubernetesNamespaceLabelsFlagKey      = "k8s-namespace-labels"
+	kubernetesNamespaceAnnotationsFlagKey = "k8s-namespace-annotations"
+	kubernetesNodeSelectorsFlagKey        = "k8s-node-selectors"
+	kubernetesServiceAccountFlagKey       = "k8s-service-account"
+
+	keyValueDelimiter             = "="
+	keyValueDeclarationsDelimiter = ","
+
+	expectedNumberKeyValueComponentsInDeclaration = 2
+
 	// Signifies that an enclave name should be auto-generated
 	autogenerateEnclaveNameKeyword = ""
 
@@ -76,6 +86,46 @@ var EnclaveAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCo
 			Type:      flags.FlagType_Bool,
 			Default:   "false",
 		},
+		{
+			Key: kubernetesNamespaceLabelsFlagKey,
+			Usage: fmt.Sprintf(
+				"Labels added to the namespace of the enclave, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\" (only available for Kubernetes)",
+				keyValueDelimiter,
+				keyValueDeclarationsDelimiter,
+				keyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key: kubernetesNamespaceAnnotationsFlagKey,
+			Usage: fmt.Sprintf(
+				"Annotations added to the namespace of the enclave, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\" (only available for Kubernetes)",
+				keyValueDelimiter,
+				keyValueDeclarationsDelimiter,
+				keyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key: kubernetesNodeSelectorsFlagKey,
+			Usage: fmt.Sprintf(
+				"Node selectors of all the services of the enclave, in the form \"KEY1%vVALUE1%vKEY2%vVALUE2\"; the node selectors "+
+					"set on a service add to or override them (only available for Kubernetes)",
+				keyValueDelimiter,
+				keyValueDeclarationsDelimiter,
+				keyValueDelimiter,
+			),
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
+		{
+			Key:     kubernetesServiceAccountFlagKey,
+			Usage:   "Service account the services of the enclave run with, created in the namespace of the enclave (only available for Kubernetes)",
+			Type:    flags.FlagType_String,
+			Default: "",
+		},
 	},
 }
 
@@ -134,11 +184,17 @@ func run(
 		mode = kurtosis_engine_rpc_api_bindings.EnclaveMode_PRODUCTION
 	}
 
+	kubernetesSettings, err := getKubernetesSettings(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Kubernetes settings of the enclave")
+	}
+
 	createEnclaveArgs := &kurtosis_engine_rpc_api_bindings.CreateEnclaveArgs{
 		EnclaveName:            enclaveName,
 		ApiContainerVersionTag: apiContainerVersion,
 		ApiContainerLogLevel:   kurtosisLogLevelStr,
 		Mode:                   mode,
+		KubernetesSettings:     kubernetesSettings,
 	}
 	createdEnclaveResponse, err := engineClient.CreateEnclave(ctx, createEnclaveArgs)
 	if err != nil {
@@ -152,3 +208,68 @@ func run(
 
 	return nil
 }
+
+// getKubernetesSettings returns nil if none of the Kubernetes settings flags is set
+func getKubernetesSettings(flags *flags.ParsedFlags) (*kurtosis_engine_rpc_api_bindings.KubernetesEnclaveSettings, error) {
+	keyValuePairsByFlagKey := map[string]map[string]string{}
+	for _, flagKey := range []string{kubernetesNamespaceLabelsFlagKey, kubernetesNamespaceAnnotationsFlagKey, kubernetesNodeSelectorsFlagKey} {
+		keyValuePairsStr, err := flags.GetString(flagKey)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the value of flag with key '%v'; this is a bug in Kurtosis", flagKey)
+		}
+		keyValuePairs, err := parseKeyValuePairsStr(keyValuePairsStr)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing the value '%v' of flag '%v'", keyValuePairsStr, flagKey)
+		}
+		keyValuePairsByFlagKey[flagKey] = keyValuePairs
+	}
+
+	serviceAccountName, err := flags.GetString(kubernetesServiceAccountFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service account using flag with key '%v'; this is a bug in Kurtosis", kubernetesServiceAccountFlagKey)
+	}
+
+	namespaceLabels := keyValuePairsByFlagKey[kubernetesNamespaceLabelsFlagKey]
+	namespaceAnnotations := keyValuePairsByFlagKey[kubernetesNamespaceAnnotationsFlagKey]
+	defaultNodeSelectors := keyValuePairsByFlagKey[kubernetesNodeSelectorsFlagKey]
+	if len(namespaceLabels) == 0 && len(namespaceAnnotations) == 0 && len(defaultNodeSelectors) == 0 && serviceAccountName == "" {
+		return nil, nil
+	}
+	return &kurtosis_engine_rpc_api_bindings.KubernetesEnclaveSettings{
+		NamespaceLabels:      namespaceLabels,
+		NamespaceAnnotations: namespaceAnnotations,
+		DefaultNodeSelectors: defaultNodeSelectors,
+		ServiceAccountName:   serviceAccountName,
+	}, nil
+}
+
+func parseKeyValuePairsStr(keyValuePairsStr string) (map[string]string, error) {
+	result := map[string]string{}
+	if keyValuePairsStr == "" {
+		return result, nil
+	}
+
+	for _, keyValueDeclarationStr := range strings.Split(keyValuePairsStr, keyValueDeclarationsDelimiter) {
+		if len(strings.TrimSpace(keyValueDeclarationStr)) == 0 {
+			continue
+		}
+
+		keyValueComponents := strings.SplitN(keyValueDeclarationStr, keyValueDelimiter, expectedNumberKeyValueComponentsInDeclaration)
+		if len(keyValueComponents) < expectedNumberKeyValueComponentsInDeclaration {
+			return nil, stacktrace.NewError("Declaration string '%v' must be of the form KEY1%vVALUE1", keyValueDeclarationStr, keyValueDelimiter)
+		}
+		key := keyValueComponents[0]
+		value := keyValueComponents[1]
+
+		if preexistingValue, found := result[key]; found {
+			return nil, stacktrace.NewError(
+				"Cannot assign key '%v' to value '%v' because the key has previously been assigned to value '%v'",
+				key,
+				value,
+				preexistingValue,
+			)
+		}
+		result[key] = value
+	}
+	return result, nil
+}
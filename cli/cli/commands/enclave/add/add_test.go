@@ -0,0 +1,34 @@
+package add
+
+import (
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseKeyValuePairsStr_MultiplePairsAreOkay(t *testing.T) {
+	keyValuePairs, err := parseKeyValuePairsStr("pool=load-testing,team=infra")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"pool": "load-testing", "team": "infra"}, keyValuePairs)
+}
+
+func TestParseKeyValuePairsStr_EqualSignInValueIsOkay(t *testing.T) {
+	keyValuePairs, err := parseKeyValuePairsStr("example.com/description=a=b")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"example.com/description": "a=b"}, keyValuePairs)
+}
+
+func TestParseKeyValuePairsStr_EmptyDeclarations(t *testing.T) {
+	keyValuePairs, err := parseKeyValuePairsStr("pool=load-testing,, ,")
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"pool": "load-testing"}, keyValuePairs)
+}
+
+func TestParseKeyValuePairsStr_DuplicateKeysError(t *testing.T) {
+	_, err := parseKeyValuePairsStr("pool=load-testing,pool=default")
+	require.Error(t, err)
+}
+
+func TestParseKeyValuePairsStr_MissingValueError(t *testing.T) {
+	_, err := parseKeyValuePairsStr("pool")
+	require.Error(t, err)
+}
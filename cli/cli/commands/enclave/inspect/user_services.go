@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"sort"
 	"strings"
+	"time"
 )
 
 const (
@@ -22,16 +23,23 @@ const (
 	userServiceNameColHeader      = "Name"
 	userServicePortsColHeader     = "Ports"
 	userServiceStatusColHeader    = "Status"
+	userServiceHealthColHeader    = "Health"
 	defaultEmptyIPAddrForServices = ""
 	emptyApplicationProtocol      = ""
 	missingPortPlaceholder        = "<none>"
 	linkDelimeter                 = "://"
 	defaultEmptyIPAddrForAPIC     = ""
+	notMonitoredHealthPlaceholder = "-"
+	numRestartsFormat             = "%s (%d restarts)"
+	lastFailureFormat             = "Last failure of service '%s' at %s: %s"
 )
 
 var (
 	colorizeRunning = color.New(color.FgGreen).SprintFunc()
 	colorizeStopped = color.New(color.FgYellow).SprintFunc()
+
+	colorizeHealthy   = color.New(color.FgGreen).SprintFunc()
+	colorizeUnhealthy = color.New(color.FgRed).SprintFunc()
 )
 
 func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext, enclaveInfo *kurtosis_engine_rpc_api_bindings.EnclaveInfo, showFullUuids bool, isAPIContainerRunning bool) error {
@@ -49,7 +57,9 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		userServiceNameColHeader,
 		userServicePortsColHeader,
 		userServiceStatusColHeader,
+		userServiceHealthColHeader,
 	)
+	lastFailures := []string{}
 	sortedUserServices := getSortedUserServiceSliceFromUserServiceMap(userServices)
 	for _, userService := range sortedUserServices {
 		serviceIdStr := userService.GetName()
@@ -61,6 +71,10 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 
 		serviceStatus := userService.GetServiceStatus()
 		serviceStatusStr := colorizeServiceStatus(serviceStatus)
+		serviceHealthStr := getServiceHealthString(userService.GetHealth())
+		if lastFailure := getServiceLastFailureString(userService); lastFailure != "" {
+			lastFailures = append(lastFailures, lastFailure)
+		}
 
 		portBindingLines, err := getUserServicePortBindingStrings(userService)
 		if err != nil {
@@ -69,7 +83,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		firstPortBindingLine := portBindingLines[0]
 		additionalPortBindingLines := portBindingLines[1:]
 
-		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, serviceStatusStr); err != nil {
+		if err := tablePrinter.AddRow(uuidToPrint, serviceIdStr, firstPortBindingLine, serviceStatusStr, serviceHealthStr); err != nil {
 			return stacktrace.Propagate(
 				err,
 				"An error occurred adding row for user service with UUID '%v' to the table printer",
@@ -78,7 +92,7 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		}
 
 		for _, additionalPortBindingLine := range additionalPortBindingLines {
-			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, ""); err != nil {
+			if err := tablePrinter.AddRow("", "", additionalPortBindingLine, "", ""); err != nil {
 				return stacktrace.Propagate(
 					err,
 					"An error occurred adding additional port binding row '%v' for user service with UUID '%v' to the table printer",
@@ -89,6 +103,9 @@ func printUserServices(ctx context.Context, _ *kurtosis_context.KurtosisContext,
 		}
 	}
 	tablePrinter.Print()
+	for _, lastFailure := range lastFailures {
+		fmt.Println(lastFailure)
+	}
 
 	return nil
 }
@@ -212,3 +229,31 @@ func colorizeServiceStatus(serviceStatus kurtosis_core_rpc_api_bindings.ServiceS
 		return serviceStatusStr
 	}
 }
+
+// getServiceHealthString returns a placeholder for the services whose health is not monitored
+func getServiceHealthString(maybeHealth *kurtosis_core_rpc_api_bindings.ServiceHealth) string {
+	if maybeHealth == nil {
+		return notMonitoredHealthPlaceholder
+	}
+	healthStatusStr := kurtosis_core_rpc_api_bindings.ServiceHealthStatus_name[int32(maybeHealth.GetStatus())]
+	switch maybeHealth.GetStatus() {
+	case kurtosis_core_rpc_api_bindings.ServiceHealthStatus_HEALTHY:
+		healthStatusStr = colorizeHealthy(healthStatusStr)
+	case kurtosis_core_rpc_api_bindings.ServiceHealthStatus_UNHEALTHY:
+		healthStatusStr = colorizeUnhealthy(healthStatusStr)
+	}
+	if maybeHealth.GetNumRestarts() == 0 {
+		return healthStatusStr
+	}
+	return fmt.Sprintf(numRestartsFormat, healthStatusStr, maybeHealth.GetNumRestarts())
+}
+
+// getServiceLastFailureString returns an empty string if the service never failed
+func getServiceLastFailureString(userService *kurtosis_core_rpc_api_bindings.ServiceInfo) string {
+	health := userService.GetHealth()
+	if health.GetLastFailureReason() == "" {
+		return ""
+	}
+	lastFailureTime := time.UnixMilli(health.GetLastFailureTimestampMs()).Format(time.RFC3339)
+	return fmt.Sprintf(lastFailureFormat, userService.GetName(), lastFailureTime, health.GetLastFailureReason())
+}
@@ -0,0 +1,36 @@
+package inspect
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetServiceHealthString_NotMonitored(t *testing.T) {
+	require.Equal(t, notMonitoredHealthPlaceholder, getServiceHealthString(nil))
+}
+
+func TestGetServiceHealthString_WithRestarts(t *testing.T) {
+	health := binding_constructors.NewServiceHealth(kurtosis_core_rpc_api_bindings.ServiceHealthStatus_UNHEALTHY, 2, "Connection refused", 1700000000000)
+	healthStr := getServiceHealthString(health)
+	require.Contains(t, healthStr, "UNHEALTHY")
+	require.Contains(t, healthStr, "(2 restarts)")
+}
+
+func TestGetServiceLastFailureString(t *testing.T) {
+	neverFailedService := &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		Name:   "healthy-service",
+		Health: binding_constructors.NewServiceHealth(kurtosis_core_rpc_api_bindings.ServiceHealthStatus_HEALTHY, 0, "", 0),
+	}
+	require.Empty(t, getServiceLastFailureString(neverFailedService))
+
+	failedService := &kurtosis_core_rpc_api_bindings.ServiceInfo{
+		Name:   "failed-service",
+		Health: binding_constructors.NewServiceHealth(kurtosis_core_rpc_api_bindings.ServiceHealthStatus_UNHEALTHY, 1, "Connection refused", 1700000000000),
+	}
+	lastFailureStr := getServiceLastFailureString(failedService)
+	require.Contains(t, lastFailureStr, "failed-service")
+	require.Contains(t, lastFailureStr, "Connection refused")
+}
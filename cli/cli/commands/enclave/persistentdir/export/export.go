@@ -0,0 +1,168 @@
+package export
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"os"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	// not a service identifier arg, as the service may have been removed since
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = false
+
+	persistentKeyArgKey        = "persistent-key"
+	isPersistentKeyArgOptional = false
+	isPersistentKeyArgGreedy   = false
+
+	outputFlagKey = "output"
+	defaultOutput = ""
+
+	nameFlagKey = "name"
+	defaultName = ""
+
+	tarballExtension           = ".tar"
+	tarballFilePerms           = 0644
+	outputAndNameFlagsConflict = "Only one of the '--%v' and '--%v' flags can be set"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PersistentDirExportCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclavePersistentDirExportCmdStr,
+	ShortDescription: "Exports a persistent directory",
+	LongDescription: fmt.Sprintf(
+		"Exports the content of the persistent directory of the service, either to a tarball at the '--%v' path "+
+			"(defaulting to '<persistent-key>%v' in the current directory) or to a files artifact with the '--%v' name",
+		outputFlagKey,
+		tarballExtension,
+		nameFlagKey,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     outputFlagKey,
+			Usage:   "The path of the tarball to write the content of the persistent directory to",
+			Type:    flags.FlagType_String,
+			Default: defaultOutput,
+		},
+		{
+			Key:     nameFlagKey,
+			Usage:   "The name of the files artifact to export the content of the persistent directory to, instead of a tarball",
+			Type:    flags.FlagType_String,
+			Default: defaultName,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:        serviceIdentifierArgKey,
+			IsOptional: isServiceIdentifierArgOptional,
+			IsGreedy:   isServiceIdentifierArgGreedy,
+		},
+		{
+			Key:        persistentKeyArgKey,
+			IsOptional: isPersistentKeyArgOptional,
+			IsGreedy:   isPersistentKeyArgGreedy,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier using arg key '%v'", serviceIdentifierArgKey)
+	}
+	persistentKey, err := args.GetNonGreedyArg(persistentKeyArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent key using arg key '%v'", persistentKeyArgKey)
+	}
+	outputPath, err := flags.GetString(outputFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", outputFlagKey)
+	}
+	artifactName, err := flags.GetString(nameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", nameFlagKey)
+	}
+	if outputPath != defaultOutput && artifactName != defaultName {
+		return stacktrace.NewError(outputAndNameFlagsConflict, outputFlagKey, nameFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	serviceIdentifiers, err := enclaveCtx.GetExistingAndHistoricalServiceIdentifiers(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the existing and historical service identifiers of enclave '%v'", enclaveIdentifier)
+	}
+	serviceUuidStr, err := serviceIdentifiers.GetServiceUuidForIdentifier(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service UUID for identifier '%v'", serviceIdentifier)
+	}
+	serviceUuid := services.ServiceUUID(serviceUuidStr)
+
+	if artifactName == defaultName {
+		if outputPath == defaultOutput {
+			outputPath = persistentKey + tarballExtension
+		}
+		tarballContent, err := enclaveCtx.DownloadPersistentDirectory(ctx, serviceUuid, persistentKey)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred downloading persistent directory '%v' of service '%v'", persistentKey, serviceIdentifier)
+		}
+		if err := os.WriteFile(outputPath, tarballContent, tarballFilePerms); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing persistent directory '%v' of service '%v' to '%v'", persistentKey, serviceIdentifier, outputPath)
+		}
+		logrus.Infof("Exported persistent directory '%v' of service '%v' to '%v'", persistentKey, serviceIdentifier, outputPath)
+		return nil
+	}
+
+	filesArtifactUuid, err := enclaveCtx.StoreFilesArtifactFromPersistentDirectory(ctx, serviceUuid, persistentKey, artifactName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' of service '%v' to files artifact '%v'", persistentKey, serviceIdentifier, artifactName)
+	}
+	logrus.Infof("Exported persistent directory '%v' of service '%v' to files artifact '%v' with UUID: %v", persistentKey, serviceIdentifier, artifactName, filesArtifactUuid)
+	return nil
+}
@@ -0,0 +1,102 @@
+package ls
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	serviceUuidColumnHeader   = "Service UUID"
+	serviceNameColumnHeader   = "Service Name"
+	persistentKeyColumnHeader = "Persistent Key"
+
+	// persistent directories outlive their service, which may have been removed since
+	removedServiceName = "<removed>"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PersistentDirLsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclavePersistentDirLsCmdStr,
+	ShortDescription:          "Lists persistent directories",
+	LongDescription:           "Lists the persistent keys of the persistent directories of the enclave, along with the service they were created for",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	// Sorted by service UUID and persistent key
+	persistentDirectories, err := enclaveCtx.GetPersistentDirectories(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", enclaveIdentifier)
+	}
+
+	serviceNamesAndUuids, err := enclaveCtx.GetServices()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveIdentifier)
+	}
+	serviceUuidsToNames := map[string]string{}
+	for serviceName, serviceUuid := range serviceNamesAndUuids {
+		serviceUuidsToNames[string(serviceUuid)] = string(serviceName)
+	}
+
+	tablePrinter := output_printers.NewTablePrinter(serviceUuidColumnHeader, serviceNameColumnHeader, persistentKeyColumnHeader)
+	for _, persistentDirectory := range persistentDirectories {
+		serviceUuid := persistentDirectory.GetServiceUuid()
+		serviceName, found := serviceUuidsToNames[serviceUuid]
+		if !found {
+			serviceName = removedServiceName
+		}
+		if err := tablePrinter.AddRow(serviceUuid, serviceName, persistentDirectory.GetPersistentKey()); err != nil {
+			return stacktrace.NewError("An error occurred adding row for persistent directory '%v' of service '%v' to the table printer", persistentDirectory.GetPersistentKey(), serviceUuid)
+		}
+	}
+	tablePrinter.Print()
+	return nil
+}
@@ -0,0 +1,24 @@
+package persistentdir
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/persistentdir/export"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/persistentdir/ls"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/persistentdir/rm"
+	"github.com/spf13/cobra"
+)
+
+// PersistentDirCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var PersistentDirCmd = &cobra.Command{
+	Use:   command_str_consts.EnclavePersistentDirCmdStr,
+	Short: "Manage the persistent directories of an enclave",
+	Long:  "Contains actions for managing the persistent directories of an enclave, which outlive the services they were created for",
+	RunE:  nil,
+}
+
+func init() {
+	PersistentDirCmd.AddCommand(ls.PersistentDirLsCmd.MustGetCobraCommand())
+	PersistentDirCmd.AddCommand(rm.PersistentDirRmCmd.MustGetCobraCommand())
+	PersistentDirCmd.AddCommand(export.PersistentDirExportCmd.MustGetCobraCommand())
+}
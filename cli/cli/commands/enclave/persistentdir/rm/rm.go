@@ -0,0 +1,114 @@
+package rm
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	// not a service identifier arg, as the service may have been removed since
+	serviceIdentifierArgKey        = "service"
+	isServiceIdentifierArgOptional = false
+	isServiceIdentifierArgGreedy   = false
+
+	persistentKeysArgKey        = "persistent-keys"
+	isPersistentKeysArgOptional = false
+	isPersistentKeysArgGreedy   = true
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+)
+
+var PersistentDirRmCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.EnclavePersistentDirRmCmdStr,
+	ShortDescription:          "Removes persistent directories",
+	LongDescription:           "Removes the persistent directories of the service with the given persistent keys, along with their content",
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags:                     []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		{
+			Key:        serviceIdentifierArgKey,
+			IsOptional: isServiceIdentifierArgOptional,
+			IsGreedy:   isServiceIdentifierArgGreedy,
+		},
+		{
+			Key:        persistentKeysArgKey,
+			IsOptional: isPersistentKeysArgOptional,
+			IsGreedy:   isPersistentKeysArgGreedy,
+		},
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	_ *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	serviceIdentifier, err := args.GetNonGreedyArg(serviceIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service identifier using arg key '%v'", serviceIdentifierArgKey)
+	}
+	persistentKeyStrs, err := args.GetGreedyArg(persistentKeysArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the persistent keys using arg key '%v'", persistentKeysArgKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	serviceIdentifiers, err := enclaveCtx.GetExistingAndHistoricalServiceIdentifiers(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the existing and historical service identifiers of enclave '%v'", enclaveIdentifier)
+	}
+	serviceUuidStr, err := serviceIdentifiers.GetServiceUuidForIdentifier(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service UUID for identifier '%v'", serviceIdentifier)
+	}
+	serviceUuid := services.ServiceUUID(serviceUuidStr)
+
+	// Persistent directories that couldn't be removed make the whole call fail, listing the ones that were removed
+	removedPersistentKeys, err := enclaveCtx.RemovePersistentDirectories(ctx, serviceUuid, persistentKeyStrs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing persistent directories '%v' of service '%v'", persistentKeyStrs, serviceIdentifier)
+	}
+	for _, persistentKey := range removedPersistentKeys {
+		logrus.Infof("Removed persistent directory '%v' of service '%v'", persistentKey, serviceIdentifier)
+	}
+	return nil
+}
@@ -0,0 +1,142 @@
+package restore
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	enclave_consts "github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/enclave"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	command_args_run "github.com/kurtosis-tech/kurtosis/cli/cli/command_args/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	_run "github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	snapshotFilepathArgKey        = "snapshot-filepath"
+	isSnapshotFilepathArgOptional = false
+	defaultSnapshotFilepath       = ""
+
+	enclaveNameFlagKey = "name"
+	// Signifies that an enclave name should be auto-generated
+	autogenerateEnclaveNameKeyword = ""
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	replayScriptMainFunctionName = ""
+	noReplayScriptParams         = "{}"
+	doNotDryRun                  = false
+	noParallelism                = 1
+	notOffline                   = false
+)
+
+// EnclaveRestoreCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var EnclaveRestoreCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveRestoreCmdStr,
+	ShortDescription: "Restores an enclave from a snapshot",
+	LongDescription: fmt.Sprintf(
+		"Creates a new enclave from a snapshot taken with '%v %v %v', restoring its files artifacts, runtime values "+
+			"and persistent directories, then recreating its services by replaying the enclave plan. The snapshot can be "+
+			"restored on a different backend than the one it was taken on",
+		command_str_consts.KurtosisCmdStr,
+		command_str_consts.EnclaveCmdStr,
+		command_str_consts.EnclaveSnapshotCmdStr,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:       enclaveNameFlagKey,
+			Shorthand: "n",
+			Default:   autogenerateEnclaveNameKeyword,
+			Usage: fmt.Sprintf(
+				"The name to give the restored enclave, which must match regex '%v' "+
+					"(emptystring will autogenerate an enclave name)",
+				enclave_consts.AllowedEnclaveNameCharsRegexStr,
+			),
+			Type: flags.FlagType_String,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewFilepathArg(
+			snapshotFilepathArgKey,
+			isSnapshotFilepathArgOptional,
+			defaultSnapshotFilepath,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	metricsClient metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	snapshotFilepath, err := args.GetNonGreedyArg(snapshotFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the snapshot filepath using arg key '%v'", snapshotFilepathArgKey)
+	}
+	enclaveName, err := flags.GetString(enclaveNameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting the enclave name using flag with key '%v'; this is a bug in Kurtosis ", enclaveNameFlagKey)
+	}
+
+	snapshotFile, err := os.Open(snapshotFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening snapshot file '%v'", snapshotFilepath)
+	}
+	defer snapshotFile.Close()
+	snapshotFileInfo, err := snapshotFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting snapshot file '%v'", snapshotFilepath)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+
+	logrus.Info("Creating new enclave to restore the snapshot into...")
+	enclaveCtx, err := kurtosisCtx.CreateEnclave(ctx, enclaveName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating an enclave with name '%v'", enclaveName)
+	}
+	subnetworkDisableBecauseItIsDeprecated := false
+	if err = metricsClient.TrackCreateEnclave(enclaveName, subnetworkDisableBecauseItIsDeprecated); err != nil {
+		logrus.Warn("An error occurred while logging the create enclave event")
+	}
+
+	logrus.Infof("Restoring snapshot '%v' into enclave '%v'...", snapshotFilepath, enclaveCtx.GetEnclaveName())
+	replayScript, err := enclaveCtx.RestoreEnclaveSnapshot(ctx, snapshotFile, uint64(snapshotFileInfo.Size()))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restoring snapshot '%v' into enclave '%v'", snapshotFilepath, enclaveCtx.GetEnclaveName())
+	}
+
+	logrus.Info("Recreating the services of the snapshot...")
+	responseLineChan, cancelFunc, err := enclaveCtx.RunStarlarkScript(ctx, replayScriptMainFunctionName, replayScript, noReplayScriptParams, doNotDryRun, noParallelism, []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}, []*kurtosis_core_rpc_api_bindings.GitHost{}, notOffline)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the script recreating the services of the snapshot")
+	}
+	if err := _run.ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, command_args_run.Brief, doNotDryRun); err != nil {
+		return stacktrace.Propagate(err, "An error occurred recreating the services of the snapshot in enclave '%v'", enclaveCtx.GetEnclaveName())
+	}
+
+	logrus.Infof("Restored snapshot '%v' into enclave '%v'", snapshotFilepath, enclaveCtx.GetEnclaveName())
+	return nil
+}
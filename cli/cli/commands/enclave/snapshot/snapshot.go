@@ -0,0 +1,125 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/enclave_id_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	enclaveIdentifierArgKey = "enclave"
+	isEnclaveIdArgOptional  = false
+	isEnclaveIdArgGreedy    = false
+
+	outputFilepathArgKey        = "output-filepath"
+	isOutputFilepathArgOptional = true
+	defaultOutputFilepath       = ""
+
+	includePersistentDirsFlagKey = "include-persistent-dirs"
+	defaultIncludePersistentDirs = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	defaultSnapshotFilenameFormat = "%s--%s.kurtosis-snapshot.tgz"
+	snapshotFilePerms             = 0644
+)
+
+// EnclaveSnapshotCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var EnclaveSnapshotCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:       command_str_consts.EnclaveSnapshotCmdStr,
+	ShortDescription: "Snapshots an enclave to a file",
+	LongDescription: fmt.Sprintf(
+		"Writes the enclave plan, the enclave database and all the files artifacts of the enclave to a single archive, "+
+			"optionally along with the content of its persistent directories. The archive can be restored with '%v %v %v'",
+		command_str_consts.KurtosisCmdStr,
+		command_str_consts.EnclaveCmdStr,
+		command_str_consts.EnclaveRestoreCmdStr,
+	),
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     includePersistentDirsFlagKey,
+			Usage:   "If set, the content of the persistent directories of the enclave services will be added to the snapshot",
+			Type:    flags.FlagType_Bool,
+			Default: defaultIncludePersistentDirs,
+		},
+	},
+	Args: []*args.ArgConfig{
+		enclave_id_arg.NewEnclaveIdentifierArg(
+			enclaveIdentifierArgKey,
+			engineClientCtxKey,
+			isEnclaveIdArgOptional,
+			isEnclaveIdArgGreedy,
+		),
+		file_system_path_arg.NewFilepathArg(
+			outputFilepathArgKey,
+			isOutputFilepathArgOptional,
+			defaultOutputFilepath,
+			file_system_path_arg.BypassDefaultValidationFunc,
+		),
+	},
+	RunFunc: run,
+}
+
+func run(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	enclaveIdentifier, err := args.GetNonGreedyArg(enclaveIdentifierArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave identifier using arg key '%v'", enclaveIdentifierArgKey)
+	}
+	outputFilepath, err := args.GetNonGreedyArg(outputFilepathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting output filepath using arg key '%v'", outputFilepathArgKey)
+	}
+	includePersistentDirs, err := flags.GetBool(includePersistentDirsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", includePersistentDirsFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating Kurtosis Context from local engine")
+	}
+	enclaveCtx, err := kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+	}
+
+	if outputFilepath == defaultOutputFilepath {
+		outputFilepath = fmt.Sprintf(defaultSnapshotFilenameFormat, enclaveCtx.GetEnclaveName(), enclaveCtx.GetEnclaveUuid())
+	}
+
+	logrus.Infof("Snapshotting enclave '%v'...", enclaveIdentifier)
+	snapshotContent, err := enclaveCtx.GetEnclaveSnapshot(ctx, includePersistentDirs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the snapshot of enclave '%v'", enclaveIdentifier)
+	}
+	if err := os.WriteFile(outputFilepath, snapshotContent, snapshotFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the snapshot of enclave '%v' to '%v'", enclaveIdentifier, outputFilepath)
+	}
+
+	logrus.Infof("Snapshotted enclave '%v' to '%v'", enclaveIdentifier, outputFilepath)
+	return nil
+}
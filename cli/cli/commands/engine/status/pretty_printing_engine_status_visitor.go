@@ -2,6 +2,7 @@ package status
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
@@ -9,16 +10,37 @@ import (
 
 const (
 	engineVersionInfoLabel = "Version"
+
+	enclavePoolInfoLabel                    = "Enclave pool"
+	enclavePoolSizeInfoLabel                = "Enclave pool size"
+	enclavePoolApiContainerVersionLabel     = "Enclave pool API container version"
+	enclavePoolIdleEnclavesInfoLabel        = "Idle enclaves ready"
+	enclavePoolAdoptedIdleEnclavesInfoLabel = "Idle enclaves adopted at startup"
+
+	enclavePoolNotActivatedInfoValue = "Not activated"
 )
 
 // Pretty printer of engine status that will compile-break any time a new engine status is added
 type prettyPrintingEngineStatusVisitor struct {
 	// Will only be filled in if the engine is running
 	maybeApiVersion string
+
+	shouldShowEnclavePool bool
+
+	// Will only be filled in if the engine is running with an enclave pool, and it was requested
+	maybeEnclavePoolInfo *kurtosis_engine_rpc_api_bindings.EnclavePoolInfo
 }
 
-func newPrettyPrintingEngineStatusVisitor(maybeApiVersion string) *prettyPrintingEngineStatusVisitor {
-	return &prettyPrintingEngineStatusVisitor{maybeApiVersion: maybeApiVersion}
+func newPrettyPrintingEngineStatusVisitor(
+	maybeApiVersion string,
+	shouldShowEnclavePool bool,
+	maybeEnclavePoolInfo *kurtosis_engine_rpc_api_bindings.EnclavePoolInfo,
+) *prettyPrintingEngineStatusVisitor {
+	return &prettyPrintingEngineStatusVisitor{
+		maybeApiVersion:       maybeApiVersion,
+		shouldShowEnclavePool: shouldShowEnclavePool,
+		maybeEnclavePoolInfo:  maybeEnclavePoolInfo,
+	}
 }
 
 func (p *prettyPrintingEngineStatusVisitor) VisitStopped() error {
@@ -36,8 +58,22 @@ func (p *prettyPrintingEngineStatusVisitor) VisitContainerRunningButServerNotRes
 func (p *prettyPrintingEngineStatusVisitor) VisitRunning() error {
 	keyValuePrinter := output_printers.NewKeyValuePrinter()
 	keyValuePrinter.AddPair(engineVersionInfoLabel, p.maybeApiVersion)
+	if p.shouldShowEnclavePool {
+		addEnclavePoolInfoPairs(keyValuePrinter, p.maybeEnclavePoolInfo)
+	}
 
 	out.PrintOutLn("A Kurtosis engine is running with the following info:")
 	keyValuePrinter.Print()
 	return nil
 }
+
+func addEnclavePoolInfoPairs(keyValuePrinter *output_printers.KeyValuePrinter, maybeEnclavePoolInfo *kurtosis_engine_rpc_api_bindings.EnclavePoolInfo) {
+	if maybeEnclavePoolInfo == nil {
+		keyValuePrinter.AddPair(enclavePoolInfoLabel, enclavePoolNotActivatedInfoValue)
+		return
+	}
+	keyValuePrinter.AddPair(enclavePoolSizeInfoLabel, fmt.Sprint(maybeEnclavePoolInfo.GetPoolSize()))
+	keyValuePrinter.AddPair(enclavePoolApiContainerVersionLabel, maybeEnclavePoolInfo.GetApiContainerVersion())
+	keyValuePrinter.AddPair(enclavePoolIdleEnclavesInfoLabel, fmt.Sprint(maybeEnclavePoolInfo.GetNumIdleEnclaves()))
+	keyValuePrinter.AddPair(enclavePoolAdoptedIdleEnclavesInfoLabel, fmt.Sprint(maybeEnclavePoolInfo.GetNumAdoptedIdleEnclaves()))
+}
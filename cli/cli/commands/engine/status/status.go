@@ -2,12 +2,21 @@ package status
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/engine_manager"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/spf13/cobra"
 )
 
+const (
+	showEnclavePoolFlag = "pool"
+
+	defaultShowEnclavePool = false
+)
+
+var showEnclavePool bool
+
 // StatusCmd Suppressing exhaustruct requirement because this struct has ~40 properties
 // nolint: exhaustruct
 var StatusCmd = &cobra.Command{
@@ -16,6 +25,15 @@ var StatusCmd = &cobra.Command{
 	RunE:  run,
 }
 
+func init() {
+	StatusCmd.Flags().BoolVar(
+		&showEnclavePool,
+		showEnclavePoolFlag,
+		defaultShowEnclavePool,
+		"Also reports the size and the state of the enclave pool of the running engine",
+	)
+}
+
 func run(cmd *cobra.Command, args []string) error {
 	ctx := context.Background()
 
@@ -28,7 +46,17 @@ func run(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting the Kurtosis engine status")
 	}
-	prettyPrintingStatusVisitor := newPrettyPrintingEngineStatusVisitor(maybeApiVersion)
+
+	var maybeEnclavePoolInfo *kurtosis_engine_rpc_api_bindings.EnclavePoolInfo
+	if showEnclavePool && status == engine_manager.EngineStatus_Running {
+		engineInfo, err := engineManager.GetEngineInfo(ctx)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the Kurtosis engine info for reporting the enclave pool state")
+		}
+		maybeEnclavePoolInfo = engineInfo.GetEnclavePoolInfo()
+	}
+
+	prettyPrintingStatusVisitor := newPrettyPrintingEngineStatusVisitor(maybeApiVersion, showEnclavePool, maybeEnclavePoolInfo)
 	if err := status.Accept(prettyPrintingStatusVisitor); err != nil {
 		return stacktrace.Propagate(err, "An error occurred printing the engine status")
 	}
@@ -0,0 +1,101 @@
+package add
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	contexts_config_api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang"
+	contexts_config_generated_api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	hostArgKey        = "host"
+	isHostArgOptional = false
+	isHostArgGreedy   = false
+
+	cloneUrlPrefixFlagKey = "clone-url-prefix"
+	usernameFlagKey       = "username"
+	tokenFlagKey          = "token"
+	emptyFlagDefault      = ""
+)
+
+var GitHostAddCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.ContextGitHostAddCmdStr,
+	ShortDescription: "Adds a git host to the current context",
+	LongDescription: "Adds a git host, e.g. gitlab.com, that the packages whose locators start with it are cloned " +
+		"from when running in the current context. A git host that was already added gets replaced",
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     cloneUrlPrefixFlagKey,
+			Usage:   "What the repositories are cloned from, e.g. https://gitlab.com or file:///srv/git. Defaults to https://<host>",
+			Type:    flags.FlagType_String,
+			Default: emptyFlagDefault,
+		},
+		{
+			Key:     usernameFlagKey,
+			Usage:   "The username used to clone private repositories over HTTPS. Defaults to 'git' when a token is set",
+			Type:    flags.FlagType_String,
+			Default: emptyFlagDefault,
+		},
+		{
+			Key:     tokenFlagKey,
+			Usage:   "The token used to clone private repositories over HTTPS",
+			Type:    flags.FlagType_String,
+			Default: emptyFlagDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		{
+			Key:        hostArgKey,
+			IsOptional: isHostArgOptional,
+			IsGreedy:   isHostArgGreedy,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	host, err := args.GetNonGreedyArg(hostArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy host arg '%v' but none was found; this is a bug in the Kurtosis CLI!", hostArgKey)
+	}
+	cloneUrlPrefix, err := flags.GetString(cloneUrlPrefixFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", cloneUrlPrefixFlagKey)
+	}
+	username, err := flags.GetString(usernameFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", usernameFlagKey)
+	}
+	token, err := flags.GetString(tokenFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", tokenFlagKey)
+	}
+
+	contextsConfigStore := store.GetContextsConfigStore()
+	currentContext, err := contextsConfigStore.GetCurrentContext()
+	if err != nil {
+		return stacktrace.Propagate(err, "Error retrieving the current context")
+	}
+
+	gitHosts := []*contexts_config_generated_api.GitHost{}
+	for _, gitHost := range currentContext.GetGitHosts() {
+		if gitHost.GetHost() != host {
+			gitHosts = append(gitHosts, gitHost)
+		}
+	}
+	gitHosts = append(gitHosts, contexts_config_api.NewGitHost(host, cloneUrlPrefix, username, token))
+
+	if err = contextsConfigStore.SetGitHosts(currentContext.GetUuid(), gitHosts); err != nil {
+		return stacktrace.Propagate(err, "Git host '%s' could not be added to context '%s'", host, currentContext.GetName())
+	}
+	logrus.Infof("Git host '%s' successfully added to context '%s'", host, currentContext.GetName())
+	return nil
+}
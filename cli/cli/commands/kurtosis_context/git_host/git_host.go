@@ -0,0 +1,22 @@
+package git_host
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/git_host/add"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/git_host/rm"
+	"github.com/spf13/cobra"
+)
+
+// GitHostCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var GitHostCmd = &cobra.Command{
+	Use:   command_str_consts.ContextGitHostCmdStr,
+	Short: "Manage the git hosts of the current Kurtosis context",
+	Long:  "Contains actions for managing the git hosts, on top of GitHub, that the packages run in the current Kurtosis context can be cloned from",
+	RunE:  nil,
+}
+
+func init() {
+	GitHostCmd.AddCommand(add.GitHostAddCmd.MustGetCobraCommand())
+	GitHostCmd.AddCommand(rm.GitHostRmCmd.MustGetCobraCommand())
+}
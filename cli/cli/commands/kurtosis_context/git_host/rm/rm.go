@@ -0,0 +1,68 @@
+package rm
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	contexts_config_generated_api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	hostArgKey        = "host"
+	isHostArgOptional = false
+	isHostArgGreedy   = false
+)
+
+var GitHostRmCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.ContextGitHostRmCmdStr,
+	ShortDescription: "Removes a git host from the current context",
+	LongDescription:  "Removes a git host from the current context, along with its credentials",
+	Flags:            []*flags.FlagConfig{},
+	Args: []*args.ArgConfig{
+		{
+			Key:        hostArgKey,
+			IsOptional: isHostArgOptional,
+			IsGreedy:   isHostArgGreedy,
+		},
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	host, err := args.GetNonGreedyArg(hostArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for non-greedy host arg '%v' but none was found; this is a bug in the Kurtosis CLI!", hostArgKey)
+	}
+
+	contextsConfigStore := store.GetContextsConfigStore()
+	currentContext, err := contextsConfigStore.GetCurrentContext()
+	if err != nil {
+		return stacktrace.Propagate(err, "Error retrieving the current context")
+	}
+
+	foundGitHostToRemove := false
+	gitHosts := []*contexts_config_generated_api.GitHost{}
+	for _, gitHost := range currentContext.GetGitHosts() {
+		if gitHost.GetHost() == host {
+			foundGitHostToRemove = true
+		} else {
+			gitHosts = append(gitHosts, gitHost)
+		}
+	}
+	if !foundGitHostToRemove {
+		return stacktrace.NewError("Context '%s' has no git host '%s'", currentContext.GetName(), host)
+	}
+
+	if err = contextsConfigStore.SetGitHosts(currentContext.GetUuid(), gitHosts); err != nil {
+		return stacktrace.Propagate(err, "Git host '%s' could not be removed from context '%s'", host, currentContext.GetName())
+	}
+	logrus.Infof("Git host '%s' successfully removed from context '%s'", host, currentContext.GetName())
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/add"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/context_switch"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/git_host"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/ls"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/kurtosis_context/rm"
 	"github.com/spf13/cobra"
@@ -22,4 +23,5 @@ func init() {
 	ContextCmd.AddCommand(ls.ContextLsCmd.MustGetCobraCommand())
 	ContextCmd.AddCommand(rm.ContextRmCmd.MustGetCobraCommand())
 	ContextCmd.AddCommand(context_switch.ContextSwitchCmd.MustGetCobraCommand())
+	ContextCmd.AddCommand(git_host.GitHostCmd)
 }
@@ -0,0 +1,64 @@
+package lock
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/package_dependency_resolver"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	packageDirpathArgKey        = "package-dirpath"
+	isPackageDirpathArgOptional = true
+	defaultPackageDirpath       = "."
+)
+
+var PackageLockCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackageLockCmdStr,
+	ShortDescription: fmt.Sprintf("Writes the %v of a package", package_lock.KurtosisLockFilename),
+	LongDescription: fmt.Sprintf(
+		"Resolves the dependencies declared in the kurtosis.yml of the package, including the transitive ones, to the commit their version points to "+
+			"and pins them in the %v next to it. Dependencies which are already locked at their declared version keep their commit; use '%v %v %v' to move them",
+		package_lock.KurtosisLockFilename,
+		command_str_consts.KurtosisCmdStr,
+		command_str_consts.PackageCmdStr,
+		command_str_consts.PackageUpdateCmdStr,
+	),
+	Flags: nil,
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewDirpathArg(
+			packageDirpathArgKey,
+			isPackageDirpathArgOptional,
+			defaultPackageDirpath,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, _ *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package dirpath using arg key '%v'", packageDirpathArgKey)
+	}
+
+	kurtosisLock, err := package_dependency_resolver.LockPackageDependencies(packageDirpath, doNotUpdate)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred locking the dependencies of the package at '%v'", packageDirpath)
+	}
+	logrus.Infof("Locked %d dependencies of the package at '%v'", len(kurtosisLock.Dependencies), packageDirpath)
+	return nil
+}
+
+func doNotUpdate(_ string) bool {
+	return false
+}
@@ -0,0 +1,22 @@
+package _package
+
+import (
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/lock"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/package/update"
+	"github.com/spf13/cobra"
+)
+
+// PackageCmd Suppressing exhaustruct requirement because this struct has ~40 properties
+// nolint: exhaustruct
+var PackageCmd = &cobra.Command{
+	Use:   command_str_consts.PackageCmdStr,
+	Short: "Manage the dependencies of a package",
+	Long:  "Contains actions for locking and updating the dependencies declared in the kurtosis.yml of a Starlark package",
+	RunE:  nil,
+}
+
+func init() {
+	PackageCmd.AddCommand(lock.PackageLockCmd.MustGetCobraCommand())
+	PackageCmd.AddCommand(update.PackageUpdateCmd.MustGetCobraCommand())
+}
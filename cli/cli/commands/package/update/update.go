@@ -0,0 +1,89 @@
+package update
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/package_dependency_resolver"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"strings"
+)
+
+const (
+	packageDirpathArgKey        = "package-dirpath"
+	isPackageDirpathArgOptional = true
+	defaultPackageDirpath       = "."
+
+	dependenciesFlagKey          = "dependency"
+	defaultDependencies          = ""
+	dependenciesFlagSeparatorStr = ","
+)
+
+var PackageUpdateCmd = &lowlevel.LowlevelKurtosisCommand{
+	CommandStr:       command_str_consts.PackageUpdateCmdStr,
+	ShortDescription: fmt.Sprintf("Updates the dependencies locked in the %v of a package", package_lock.KurtosisLockFilename),
+	LongDescription: fmt.Sprintf(
+		"Resolves the version of the dependencies declared in the kurtosis.yml of the package again, e.g. to pick up the new commits of a branch, "+
+			"and pins the resulting commits in the %v next to it. All the dependencies get updated unless some are selected with the '%v' flag",
+		package_lock.KurtosisLockFilename,
+		dependenciesFlagKey,
+	),
+	Flags: []*flags.FlagConfig{
+		{
+			Key:     dependenciesFlagKey,
+			Usage:   fmt.Sprintf("The names of the dependencies to update, separated by '%v'. The other dependencies keep their locked commit", dependenciesFlagSeparatorStr),
+			Type:    flags.FlagType_String,
+			Default: defaultDependencies,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewDirpathArg(
+			packageDirpathArgKey,
+			isPackageDirpathArgOptional,
+			defaultPackageDirpath,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	PreValidationAndRunFunc:  nil,
+	RunFunc:                  run,
+	PostValidationAndRunFunc: nil,
+}
+
+func run(_ context.Context, flags *flags.ParsedFlags, args *args.ParsedArgs) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package dirpath using arg key '%v'", packageDirpathArgKey)
+	}
+	dependenciesStr, err := flags.GetString(dependenciesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", dependenciesFlagKey)
+	}
+
+	dependenciesToUpdate := map[string]bool{}
+	for _, dependency := range strings.Split(dependenciesStr, dependenciesFlagSeparatorStr) {
+		if trimmedDependency := strings.TrimSpace(dependency); trimmedDependency != "" {
+			dependenciesToUpdate[trimmedDependency] = true
+		}
+	}
+	shouldUpdate := func(packageName string) bool {
+		return len(dependenciesToUpdate) == 0 || dependenciesToUpdate[packageName]
+	}
+
+	kurtosisLock, err := package_dependency_resolver.LockPackageDependencies(packageDirpath, shouldUpdate)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred updating the dependencies of the package at '%v'", packageDirpath)
+	}
+	for dependency := range dependenciesToUpdate {
+		if _, found := kurtosisLock.GetLockedDependency(dependency); !found {
+			logrus.Warnf("Dependency '%v' passed with the '%v' flag isn't a dependency of the package; it was ignored", dependency, dependenciesFlagKey)
+		}
+	}
+	logrus.Infof("Updated the dependencies of the package at '%v'", packageDirpath)
+	return nil
+}
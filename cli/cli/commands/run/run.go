@@ -9,8 +9,10 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
@@ -22,8 +24,11 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/enclave/inspect"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/interactive_terminal_decider"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/output_printers"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/portal_manager"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/prompt_displayer"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/helpers/run_report"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/user_support_constants"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store"
@@ -70,6 +75,7 @@ const (
 	experimentalFeaturesSplitChar = ","
 
 	githubDomainPrefix          = "github.com/"
+	packageLocatorSeparator     = "/"
 	isNewEnclaveFlagWhenCreated = true
 	interruptChanBufferSize     = 5
 
@@ -91,8 +97,47 @@ const (
 
 	noConnectFlagKey = "no-connect"
 	noConnectDefault = "false"
+
+	reportFlagKey = "report"
+	// Signifies that no report should be written
+	noReportFilepath = ""
+
+	replaceFlagKey = "replace"
+	// Signifies that no package gets replaced by a local copy
+	noPackageReplacements          = ""
+	packageReplacementsSplitChar   = ","
+	packageReplacementSeparator    = "="
+	expectedPackageReplacementSize = 2
+
+	offlineFlagKey = "offline"
+	offlineDefault = "false"
+
+	diffFlagKey = "diff"
+	diffDefault = "false"
+	// Asked in interactive terminals when the run isn't a dry run
+	runConfirmationPrompt = "Run and make these changes to the enclave"
+
+	pruneFlagKey = "prune"
+	pruneDefault = "false"
+
+	outputPlanFlagKey = "output-plan"
+	// Signifies that the plan shouldn't be exported
+	noPlanFilepath     = ""
+	jsonPlanExtension  = ".json"
+	planFilePermission = 0644
+
+	// How many times in a row the CLI tries to resume streaming the output of a run after losing the connection to the enclave
+	maxResumeRunAttempts         = 5
+	timeBetweenResumeRunAttempts = 2 * time.Second
 )
 
+// resumeRunFunc streams the output of the run with the given ID again starting at the given response line, after losing
+// the connection
+type resumeRunFunc func(runId string, startingLineIndex uint32) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error)
+
+// cancelRunFunc stops the run with the given ID in the enclave, which keeps running it when the CLI stops reading its output
+type cancelRunFunc func(runId string) error
+
 var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.StarlarkRunCmdStr,
 	KurtosisBackendContextKey: kurtosisBackendCtxKey,
@@ -121,7 +166,7 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 		},
 		{
 			Key:     parallelismFlagKey,
-			Usage:   "The parallelism level to be used in Starlark commands that supports it",
+			Usage:   "The maximum number of independent Starlark instructions executed concurrently, also used by the Starlark commands that support parallelism",
 			Type:    flags.FlagType_Uint32,
 			Default: defaultParallelism,
 		},
@@ -179,6 +224,58 @@ var StarlarkRunCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:    flags.FlagType_Bool,
 			Default: noConnectDefault,
 		},
+		{
+			Key: reportFlagKey,
+			Usage: "If set, a report of the run containing each instruction's position, arguments, result, duration, " +
+				"skipped status and warnings, along with the output of the run, will be written to this filepath. " +
+				"The report is written as JUnit XML if the filepath ends with '.xml', and as JSON otherwise",
+			Type:    flags.FlagType_String,
+			Default: noReportFilepath,
+		},
+		{
+			Key: replaceFlagKey,
+			Usage: fmt.Sprintf("Set of packages to take from a local directory rather than from GitHub, for every import "+
+				"and file read of the run, on top of the ones declared in the 'replace' section of the kurtosis.yml. "+
+				"Replacing multiple packages can be done by separating them with a comma, i.e. "+
+				"'--%s github.com/author/package=../package,github.com/author/other=/path/to/other'. Relative paths "+
+				"are relative to the current working directory", replaceFlagKey),
+			Type:    flags.FlagType_String,
+			Default: noPackageReplacements,
+		},
+		{
+			Key: offlineFlagKey,
+			Usage: "If true, the packages are never cloned and are only taken from the package cache the enclaves " +
+				"share, which contains every package version run before. Not available on Kubernetes, where the enclaves " +
+				"don't share the cache. Default false",
+			Type:    flags.FlagType_Bool,
+			Default: offlineDefault,
+		},
+		{
+			Key: diffFlagKey,
+			Usage: "If true, the services the run would add, update, restart or remove in the enclave, and why, are " +
+				"shown before anything runs. In interactive terminals, the run then needs to be confirmed, unless it's " +
+				"a dry run. Default false",
+			Type:    flags.FlagType_Bool,
+			Default: diffDefault,
+		},
+		{
+			Key: pruneFlagKey,
+			Usage: "If true, the services and files artifacts present in the enclave but absent from the plan of the " +
+				"package are removed once the package ran successfully, so that the enclave only contains what the " +
+				"package creates. Dry runs only list them. Default false",
+			Type:    flags.FlagType_Bool,
+			Default: pruneDefault,
+		},
+		{
+			Key: outputPlanFlagKey,
+			Usage: fmt.Sprintf("If set along with '--%s', the plan describing the services, images, ports, files artifacts "+
+				"and environment variables the run would create, and the dependencies between its instructions, will "+
+				"be written to this filepath. Values only known once the run executes are references to the "+
+				"instructions producing them. The plan is written as JSON if the filepath ends with '%s', and as YAML "+
+				"otherwise", dryRunFlagKey, jsonPlanExtension),
+			Type:    flags.FlagType_String,
+			Default: noPlanFilepath,
+		},
 	},
 	Args: []*args.ArgConfig{
 		// TODO add a `Usage` description here when ArgConfig supports it
@@ -274,6 +371,39 @@ func run(
 		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", mainFunctionNameFlagKey)
 	}
 
+	reportFilepath, err := flags.GetString(reportFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", reportFlagKey)
+	}
+
+	packageReplaceOptions, err := parseReplaceFlag(flags)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred parsing the value of the '%v' flag", replaceFlagKey)
+	}
+
+	isOffline, err := flags.GetBool(offlineFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", offlineFlagKey)
+	}
+
+	showDiff, err := flags.GetBool(diffFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", diffFlagKey)
+	}
+
+	prune, err := flags.GetBool(pruneFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", pruneFlagKey)
+	}
+
+	planFilepath, err := flags.GetString(outputPlanFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", outputPlanFlagKey)
+	}
+	if planFilepath != noPlanFilepath && !dryRun {
+		return stacktrace.NewError("The '%v' flag can only be used along with the '%v' flag", outputPlanFlagKey, dryRunFlagKey)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -296,6 +426,8 @@ func run(
 		defer output_printers.PrintEnclaveName(enclaveCtx.GetEnclaveName())
 	}
 
+	gitHosts := GetCurrentContextGitHosts()
+
 	var responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
 	var cancelFunc context.CancelFunc
 	var errRunningKurtosis error
@@ -305,12 +437,10 @@ func run(
 		connect = kurtosis_core_rpc_api_bindings.Connect_NO_CONNECT
 	}
 
-	isRemotePackage := strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix)
+	isRemotePackage := isRemotePackageLocator(starlarkScriptOrPackagePath, gitHosts)
 	isStandAloneScript := false
 	packageOrScriptName := starlarkScriptOrPackagePath
-	if isRemotePackage {
-		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, relativePathToTheMainFile, mainFunctionName, serializedJsonArgs, dryRun, castedParallelism, experimentalFlags)
-	} else {
+	if !isRemotePackage {
 		fileOrDir, err := os.Stat(starlarkScriptOrPackagePath)
 		if err != nil {
 			return stacktrace.Propagate(err, "There was an error reading file or package from disk at '%v'", starlarkScriptOrPackagePath)
@@ -321,7 +451,12 @@ func run(
 			if !strings.HasSuffix(starlarkScriptOrPackagePath, starlarkExtension) {
 				return stacktrace.NewError("Expected a script with a '%s' extension but got file '%v' with a different extension", starlarkExtension, starlarkScriptOrPackagePath)
 			}
-			responseLineChan, cancelFunc, errRunningKurtosis = executeScript(ctx, enclaveCtx, starlarkScriptOrPackagePath, mainFunctionName, serializedJsonArgs, dryRun, castedParallelism, experimentalFlags)
+			if len(packageReplaceOptions) > 0 {
+				return stacktrace.NewError("The '%v' flag can only be used when running a package, but '%v' is a standalone script", replaceFlagKey, starlarkScriptOrPackagePath)
+			}
+			if prune {
+				return stacktrace.NewError("The '%v' flag can only be used when running a package, but '%v' is a standalone script", pruneFlagKey, starlarkScriptOrPackagePath)
+			}
 		} else {
 			// if the path is a file with `kurtosis.yml` at the end it's a module dir
 			// we remove the `kurtosis.yml` to get just the Dir containing the module
@@ -333,9 +468,27 @@ func run(
 			if err != nil {
 				return stacktrace.Propagate(err, "Tried parsing Kurtosis YML at '%v' to get package name but failed", starlarkScriptOrPackagePath)
 			}
-			responseLineChan, cancelFunc, errRunningKurtosis = executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, relativePathToTheMainFile, mainFunctionName, serializedJsonArgs, dryRun, castedParallelism, experimentalFlags)
 		}
 	}
+
+	if showDiff {
+		isRunConfirmed, err := printDiffAndConfirmRun(ctx, enclaveCtx, isRemotePackage, isStandAloneScript, starlarkScriptOrPackagePath, relativePathToTheMainFile, mainFunctionName, serializedJsonArgs, packageReplaceOptions, dryRun, prune, gitHosts, isOffline)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the changes the run would make to the enclave")
+		}
+		if !isRunConfirmed {
+			logrus.Info("Run cancelled")
+			return nil
+		}
+	}
+
+	if isRemotePackage {
+		responseLineChan, cancelFunc, errRunningKurtosis = executeRemotePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, relativePathToTheMainFile, mainFunctionName, serializedJsonArgs, dryRun, castedParallelism, experimentalFlags, packageReplaceOptions, prune, gitHosts, isOffline)
+	} else if isStandAloneScript {
+		responseLineChan, cancelFunc, errRunningKurtosis = executeScript(ctx, enclaveCtx, starlarkScriptOrPackagePath, mainFunctionName, serializedJsonArgs, dryRun, castedParallelism, experimentalFlags, gitHosts, isOffline)
+	} else {
+		responseLineChan, cancelFunc, errRunningKurtosis = executePackage(ctx, enclaveCtx, starlarkScriptOrPackagePath, relativePathToTheMainFile, mainFunctionName, serializedJsonArgs, dryRun, castedParallelism, experimentalFlags, packageReplaceOptions, prune, gitHosts, isOffline)
+	}
 	if errRunningKurtosis != nil {
 		return stacktrace.Propagate(errRunningKurtosis, "An error starting the Kurtosis code execution '%v'", starlarkScriptOrPackagePath)
 	}
@@ -345,7 +498,30 @@ func run(
 		logrus.Warn("An error occurred tracking kurtosis run event")
 	}
 
-	errRunningKurtosis = ReadAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun)
+	var reportBuilder *run_report.RunReportBuilder
+	if reportFilepath != noReportFilepath {
+		reportBuilder = run_report.NewRunReportBuilder(packageOrScriptName, enclaveCtx.GetEnclaveName(), dryRun, time.Now())
+	}
+	resumeRun := func(runId string, startingLineIndex uint32) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+		return enclaveCtx.ResumeStarlarkRun(ctx, runId, startingLineIndex)
+	}
+	cancelRun := func(runId string) error {
+		return enclaveCtx.CancelStarlarkRun(ctx, runId)
+	}
+	errRunningKurtosis = readAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun, reportBuilder, resumeRun, cancelRun)
+	if reportBuilder != nil {
+		if err = run_report.WriteToFile(reportBuilder.Build(time.Now()), reportFilepath); err != nil {
+			logrus.Errorf("An error occurred writing the report of the run to '%s':\n%v", reportFilepath, err)
+		} else {
+			logrus.Infof("Report of the run written to '%s'", reportFilepath)
+		}
+	}
+	if planFilepath != noPlanFilepath && errRunningKurtosis == nil {
+		if err = writePlan(ctx, enclaveCtx, isRemotePackage, isStandAloneScript, starlarkScriptOrPackagePath, relativePathToTheMainFile, mainFunctionName, serializedJsonArgs, packageReplaceOptions, planFilepath, gitHosts, isOffline); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing the plan of the run to '%s'", planFilepath)
+		}
+		logrus.Infof("Plan of the run written to '%s'", planFilepath)
+	}
 	var runStatusForMetrics bool
 	if errRunningKurtosis != nil {
 		runStatusForMetrics = runFailed
@@ -429,12 +605,12 @@ func run(
 //	Private Helper Functions
 //
 // ====================================================================================================
-func executeScript(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, scriptPath string, mainFunctionName string, serializedParams string, dryRun bool, parallelism int32, experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
+func executeScript(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, scriptPath string, mainFunctionName string, serializedParams string, dryRun bool, parallelism int32, experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag, gitHosts []*kurtosis_core_rpc_api_bindings.GitHost, isOffline bool) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	fileContentBytes, err := os.ReadFile(scriptPath)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Unable to read content of Starlark script file '%s'", scriptPath)
 	}
-	return enclaveCtx.RunStarlarkScript(ctx, mainFunctionName, string(fileContentBytes), serializedParams, dryRun, parallelism, experimentalFeatures)
+	return enclaveCtx.RunStarlarkScript(ctx, mainFunctionName, string(fileContentBytes), serializedParams, dryRun, parallelism, experimentalFeatures, gitHosts, isOffline)
 }
 
 func executePackage(
@@ -447,6 +623,10 @@ func executePackage(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
 	// we get the absolute path so that the logs make more sense
 	absolutePackagePath, err := filepath.Abs(packagePath)
@@ -455,7 +635,7 @@ func executePackage(
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while getting the absolute path for '%v'", packagePath)
 	}
-	return enclaveCtx.RunStarlarkPackage(ctx, packagePath, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures)
+	return enclaveCtx.RunStarlarkPackage(ctx, packagePath, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, packageReplaceOptions, prune, gitHosts, isOffline)
 }
 
 func executeRemotePackage(
@@ -468,13 +648,117 @@ func executeRemotePackage(
 	dryRun bool,
 	parallelism int32,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
+	packageReplaceOptions map[string]string,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
 ) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, context.CancelFunc, error) {
-	return enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures)
+	return enclaveCtx.RunStarlarkRemotePackage(ctx, packageId, relativePathToMainFile, mainFunctionName, serializedParams, dryRun, parallelism, experimentalFeatures, packageReplaceOptions, prune, gitHosts, isOffline)
+}
+
+// printDiffAndConfirmRun prints the changes the run would make to the services of the enclave and, in interactive
+// terminals, asks the user whether to go ahead with the run. Dry runs don't need to be confirmed
+func printDiffAndConfirmRun(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	isRemotePackage bool,
+	isStandAloneScript bool,
+	scriptOrPackagePath string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+	serializedParams string,
+	packageReplaceOptions map[string]string,
+	dryRun bool,
+	prune bool,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (bool, error) {
+	var runDiff *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse
+	var err error
+	if isRemotePackage {
+		runDiff, err = enclaveCtx.GetStarlarkRemotePackageDiff(ctx, scriptOrPackagePath, relativePathToMainFile, mainFunctionName, serializedParams, packageReplaceOptions, prune, gitHosts, isOffline)
+	} else if isStandAloneScript {
+		fileContentBytes, readErr := os.ReadFile(scriptOrPackagePath)
+		if readErr != nil {
+			return false, stacktrace.Propagate(readErr, "Unable to read content of Starlark script file '%s'", scriptOrPackagePath)
+		}
+		runDiff, err = enclaveCtx.GetStarlarkScriptDiff(ctx, mainFunctionName, string(fileContentBytes), serializedParams, gitHosts, isOffline)
+	} else {
+		runDiff, err = enclaveCtx.GetStarlarkPackageDiff(ctx, scriptOrPackagePath, relativePathToMainFile, mainFunctionName, serializedParams, packageReplaceOptions, prune, gitHosts, isOffline)
+	}
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the diff of '%s'", scriptOrPackagePath)
+	}
+	output_printers.PrintRunDiff(runDiff)
+
+	if dryRun || !interactive_terminal_decider.IsInteractiveTerminal() {
+		return true, nil
+	}
+	isRunConfirmed, err := prompt_displayer.DisplayYesNoPromptAndGetBooleanResult(runConfirmationPrompt)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred asking for the run to be confirmed")
+	}
+	return isRunConfirmed, nil
+}
+
+// writePlan gets the plan of the script or package from the API container and writes it to planFilepath, as JSON if
+// the filepath has a '.json' extension and as YAML otherwise
+func writePlan(
+	ctx context.Context,
+	enclaveCtx *enclaves.EnclaveContext,
+	isRemotePackage bool,
+	isStandAloneScript bool,
+	scriptOrPackagePath string,
+	relativePathToMainFile string,
+	mainFunctionName string,
+	serializedParams string,
+	packageReplaceOptions map[string]string,
+	planFilepath string,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) error {
+	format := kurtosis_core_rpc_api_bindings.PlanFormat_YAML
+	if strings.HasSuffix(planFilepath, jsonPlanExtension) {
+		format = kurtosis_core_rpc_api_bindings.PlanFormat_JSON
+	}
+
+	var serializedPlan string
+	var err error
+	if isRemotePackage {
+		serializedPlan, err = enclaveCtx.GetStarlarkRemotePackagePlan(ctx, scriptOrPackagePath, relativePathToMainFile, mainFunctionName, serializedParams, packageReplaceOptions, format, gitHosts, isOffline)
+	} else if isStandAloneScript {
+		fileContentBytes, readErr := os.ReadFile(scriptOrPackagePath)
+		if readErr != nil {
+			return stacktrace.Propagate(readErr, "Unable to read content of Starlark script file '%s'", scriptOrPackagePath)
+		}
+		serializedPlan, err = enclaveCtx.GetStarlarkScriptPlan(ctx, mainFunctionName, string(fileContentBytes), serializedParams, format, gitHosts, isOffline)
+	} else {
+		serializedPlan, err = enclaveCtx.GetStarlarkPackagePlan(ctx, scriptOrPackagePath, relativePathToMainFile, mainFunctionName, serializedParams, packageReplaceOptions, format, gitHosts, isOffline)
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the plan of '%s'", scriptOrPackagePath)
+	}
+
+	if err = os.WriteFile(planFilepath, []byte(serializedPlan), planFilePermission); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the plan to '%s'", planFilepath)
+	}
+	return nil
 }
 
 // ReadAndPrintResponseLinesUntilClosed TODO(victor.colombo): Extract this to somewhere reasonable
 func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool) error {
-	defer cancelFunc()
+	return readAndPrintResponseLinesUntilClosed(responseLineChan, cancelFunc, verbosity, dryRun, nil, nil, nil)
+}
+
+// If reportBuilder is not nil, every response line is also recorded in it
+// If resumeRun is not nil, it's used to keep streaming the output of the run if the connection to the enclave is lost
+// before the run finishes
+// If cancelRun is not nil, it's used to stop the run in the enclave when the user interrupts the execution
+func readAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, cancelFunc context.CancelFunc, verbosity command_args_run.Verbosity, dryRun bool, reportBuilder *run_report.RunReportBuilder, resumeRun resumeRunFunc, cancelRun cancelRunFunc) error {
+	// The cancel function changes every time the run gets resumed
+	defer func() {
+		cancelFunc()
+	}()
 
 	// This channel will receive a signal when the user presses an interrupt
 	interruptChan := make(chan os.Signal, interruptChanBufferSize)
@@ -488,10 +772,30 @@ func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 	defer printer.Stop()
 
 	isRunSuccessful := false // defaults to false such that we fail loudly if something unexpected happens
+	isRunFinished := false
+	// Sent in the first response line of the run, the run can't be resumed until it's received
+	runId := ""
+	numReceivedLines := uint32(0)
+	numResumeRunAttempts := 0
 	for {
 		select {
 		case responseLine, isChanOpen := <-responseLineChan:
 			if !isChanOpen {
+				if !isRunFinished && resumeRun != nil && runId != "" && numResumeRunAttempts < maxResumeRunAttempts {
+					numResumeRunAttempts++
+					logrus.Warnf("The connection to the enclave was lost before the run finished, resuming streaming its output (attempt %v of %v)...", numResumeRunAttempts, maxResumeRunAttempts)
+					time.Sleep(timeBetweenResumeRunAttempts)
+					resumedResponseLineChan, resumedCancelFunc, err := resumeRun(runId, numReceivedLines)
+					if err != nil {
+						logrus.Debugf("An error occurred resuming the run:\n%v", err)
+						resumedResponseLineChan = getClosedResponseLineChan()
+						resumedCancelFunc = func() {}
+					}
+					cancelFunc()
+					responseLineChan = resumedResponseLineChan
+					cancelFunc = resumedCancelFunc
+					continue
+				}
 				if !isRunSuccessful && !dryRun {
 					// This error thrown by the APIC is not informative right now as it just tells the user to look at errors
 					// in the above log. For this reason we're ignoring it and returning nil. This is exceptional to not clutter
@@ -500,20 +804,44 @@ func ReadAndPrintResponseLinesUntilClosed(responseLineChan <-chan *kurtosis_core
 				}
 				return nil
 			}
+			numReceivedLines++
+			// Receiving output means the connection is healthy again
+			numResumeRunAttempts = 0
+			if reportBuilder != nil {
+				reportBuilder.AddResponseLine(responseLine, time.Now())
+			}
 			err := printer.PrintKurtosisExecutionResponseLineToStdOut(responseLine, verbosity, dryRun)
 			if err != nil {
 				logrus.Errorf("An error occurred trying to write the output of Starlark execution to stdout. The script execution will continue, but the output printed here is incomplete. Error was: \n%s", err.Error())
 			}
+			if responseLine.GetRunStartedEvent() != nil {
+				runId = responseLine.GetRunStartedEvent().GetRunId()
+			}
 			// If the run finished, persist its status to the isRunSuccessful bool to throw an error and return a non-zero status code
 			if responseLine.GetRunFinishedEvent() != nil {
+				isRunFinished = true
 				isRunSuccessful = responseLine.GetRunFinishedEvent().GetIsRunSuccessful()
 			}
 		case <-interruptChan:
-			return stacktrace.NewError("User manually interrupted the execution, returning. Note that the execution will continue in the Kurtosis enclave")
+			if cancelRun == nil || runId == "" || isRunFinished {
+				return stacktrace.NewError("User manually interrupted the execution, returning. Note that the execution will continue in the Kurtosis enclave")
+			}
+			if err := cancelRun(runId); err != nil {
+				logrus.Debugf("An error occurred cancelling the run:\n%v", err)
+				return stacktrace.NewError("User manually interrupted the execution, returning. The run couldn't be cancelled, so the execution will continue in the Kurtosis enclave")
+			}
+			return stacktrace.NewError("User manually interrupted the execution, returning. The run was cancelled in the Kurtosis enclave and stops before its next instruction")
 		}
 	}
 }
 
+// getClosedResponseLineChan is used to try resuming the run again when resuming it failed
+func getClosedResponseLineChan() <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	closedResponseLineChan := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	close(closedResponseLineChan)
+	return closedResponseLineChan
+}
+
 func getOrCreateEnclaveContext(
 	ctx context.Context,
 	enclaveIdentifierOrName string,
@@ -602,6 +930,67 @@ func parseExperimentalFlag(flags *flags.ParsedFlags) ([]kurtosis_core_rpc_api_bi
 	return parsedExperimentalFeatures, nil
 }
 
+// parseReplaceFlag parses the packages to replace by a local copy for this run, mapped to the absolute path of the copy
+func parseReplaceFlag(flags *flags.ParsedFlags) (map[string]string, error) {
+	packageReplacementsStr, err := flags.GetString(replaceFlagKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the package replacements using flag key '%s'", replaceFlagKey)
+	}
+	return parsePackageReplacements(packageReplacementsStr)
+}
+
+func parsePackageReplacements(packageReplacementsStr string) (map[string]string, error) {
+	packageReplaceOptions := map[string]string{}
+	for _, packageReplacementStr := range strings.Split(packageReplacementsStr, packageReplacementsSplitChar) {
+		trimmedPackageReplacementStr := strings.TrimSpace(packageReplacementStr)
+		if trimmedPackageReplacementStr == "" {
+			continue
+		}
+		packageReplacement := strings.SplitN(trimmedPackageReplacementStr, packageReplacementSeparator, expectedPackageReplacementSize)
+		if len(packageReplacement) != expectedPackageReplacementSize || packageReplacement[0] == "" || packageReplacement[1] == "" {
+			return nil, stacktrace.NewError("Unable to parse '%s' as a package replacement; expected 'PACKAGE_ID%sLOCAL_PATH'", trimmedPackageReplacementStr, packageReplacementSeparator)
+		}
+		packageId := strings.TrimSpace(packageReplacement[0])
+		if _, found := packageReplaceOptions[packageId]; found {
+			return nil, stacktrace.NewError("Package '%s' is replaced more than once", packageId)
+		}
+		absoluteLocalPath, err := filepath.Abs(strings.TrimSpace(packageReplacement[1]))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the absolute path of the local copy of package '%s'", packageId)
+		}
+		packageReplaceOptions[packageId] = absoluteLocalPath
+	}
+	return packageReplaceOptions, nil
+}
+
+// GetCurrentContextGitHosts returns the git hosts of the current context, which the packages can be cloned from on top
+// of GitHub
+func GetCurrentContextGitHosts() []*kurtosis_core_rpc_api_bindings.GitHost {
+	currentContext, err := store.GetContextsConfigStore().GetCurrentContext()
+	if err != nil {
+		logrus.Warnf("Could not retrieve the current context, so the packages can only be cloned from GitHub. Turn on debug logging to see the actual error.")
+		logrus.Debugf("Error was: %v", err.Error())
+		return nil
+	}
+	gitHosts := []*kurtosis_core_rpc_api_bindings.GitHost{}
+	for _, gitHost := range currentContext.GetGitHosts() {
+		gitHosts = append(gitHosts, binding_constructors.NewGitHost(gitHost.GetHost(), gitHost.GetCloneUrlPrefix(), gitHost.GetUsername(), gitHost.GetToken()))
+	}
+	return gitHosts
+}
+
+func isRemotePackageLocator(starlarkScriptOrPackagePath string, gitHosts []*kurtosis_core_rpc_api_bindings.GitHost) bool {
+	if strings.HasPrefix(starlarkScriptOrPackagePath, githubDomainPrefix) {
+		return true
+	}
+	for _, gitHost := range gitHosts {
+		if strings.HasPrefix(starlarkScriptOrPackagePath, gitHost.GetHost()+packageLocatorSeparator) {
+			return true
+		}
+	}
+	return false
+}
+
 // isStandaloneScript returns true if the fileInfo points to a non `kurtosis.yml` regular file
 func isStandaloneScript(fileInfo os.FileInfo, kurtosisYMLFilePath string) bool {
 	return fileInfo.Mode().IsRegular() && fileInfo.Name() != kurtosisYMLFilePath
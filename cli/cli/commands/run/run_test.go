@@ -2,9 +2,13 @@ package run
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
 	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -32,3 +36,39 @@ func TestValidateArgs_invalid(t *testing.T) {
 	err = validatePackageArgs(testCtx, testParsedFlags, parsedArgs)
 	require.NotNil(t, err)
 }
+
+func TestParsePackageReplacements(t *testing.T) {
+	currentWorkingDirectory, err := os.Getwd()
+	require.Nil(t, err)
+
+	packageReplaceOptions, err := parsePackageReplacements(" github.com/author/package=../package, github.com/author/other=/path/to/other,")
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"github.com/author/package": filepath.Join(filepath.Dir(currentWorkingDirectory), "package"),
+		"github.com/author/other":   "/path/to/other",
+	}, packageReplaceOptions)
+
+	packageReplaceOptions, err = parsePackageReplacements("")
+	require.Nil(t, err)
+	require.Empty(t, packageReplaceOptions)
+}
+
+func TestParsePackageReplacements_invalid(t *testing.T) {
+	_, err := parsePackageReplacements("github.com/author/package")
+	require.NotNil(t, err)
+
+	_, err = parsePackageReplacements("github.com/author/package=")
+	require.NotNil(t, err)
+
+	_, err = parsePackageReplacements("github.com/author/package=../a,github.com/author/package=../b")
+	require.NotNil(t, err)
+}
+
+func TestIsRemotePackageLocator(t *testing.T) {
+	gitHosts := []*kurtosis_core_rpc_api_bindings.GitHost{binding_constructors.NewGitHost("gitlab.com", "", "", "")}
+	require.True(t, isRemotePackageLocator("github.com/author/package", gitHosts))
+	require.True(t, isRemotePackageLocator("gitlab.com/author/package", gitHosts))
+	require.False(t, isRemotePackageLocator("gitlab.com/author/package", nil))
+	require.False(t, isRemotePackageLocator("gitlab.company.com/author/package", gitHosts))
+	require.False(t, isRemotePackageLocator("./package", gitHosts))
+}
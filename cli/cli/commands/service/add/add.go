@@ -91,6 +91,7 @@ const (
 	defaultPortWaitTimeoutStr = "15s"
 
 	useDefaultMainFile = ""
+	notOffline         = false
 )
 
 var (
@@ -110,6 +111,7 @@ var (
 		serviceAddSpec,
 	)
 	noExperimentalFeature []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag
+	noGitHosts            []*kurtosis_core_rpc_api_bindings.GitHost
 )
 
 var ServiceAddCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -292,7 +294,7 @@ func run(
 	starlarkScript := fmt.Sprintf(`def run(plan):
 	plan.add_service(name = "%s", config = %s)
 `, serviceName, serviceConfigStarlark)
-	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, "{}", false, defaultParallelism, noExperimentalFeature)
+	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, "{}", false, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error has occurred when running Starlark to add service")
 	}
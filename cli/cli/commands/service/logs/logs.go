@@ -26,6 +26,7 @@ import (
 	"os"
 	"os/signal"
 	"strconv"
+	"time"
 )
 
 const (
@@ -41,8 +42,17 @@ const (
 	matchTextFilterFlagKey   = "match"
 	matchRegexFilterFlagKey  = "regex-match"
 	invertMatchFilterFlagKey = "invert-match"
+	sinceFlagKey             = "since"
+	untilFlagKey             = "until"
+	numLogLinesFlagKey       = "num"
+	showTimestampsFlagKey    = "timestamps"
 
 	defaultMatchTextOrRegexFilterFlagValue = ""
+	defaultSinceAndUntilFlagValue          = ""
+	defaultNumLogLinesFlagValue            = "0"
+
+	// The format used both for parsing the time bounds and for printing the log lines timestamps
+	logLineTimeFormat = time.RFC3339
 
 	kurtosisBackendCtxKey = "kurtosis-backend"
 	engineClientCtxKey    = "engine-client"
@@ -50,12 +60,16 @@ const (
 	interruptChanBufferSize = 5
 
 	commonInstructionInMatchFlags = "Important: " + matchTextFilterFlagKey + " and " + matchRegexFilterFlagKey + " flags cannot be used at the same time. You should either use one or the other."
+
+	commonInstructionInTimeBoundFlags = "It accepts either an RFC3339 timestamp (e.g. 2023-10-20T15:04:05Z) or a duration relative to now (e.g. 42m or 2h30m)."
 )
 
 var doNotFilterLogLines *kurtosis_context.LogLineFilter = nil
+var returnAllLogLines *kurtosis_context.LogLineRange = nil
 
 var defaultShouldFollowLogs = strconv.FormatBool(false)
 var defaultInvertMatchFilterFlagValue = strconv.FormatBool(false)
+var defaultShowTimestampsFlagValue = strconv.FormatBool(false)
 
 var ServiceLogsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
 	CommandStr:                command_str_consts.ServiceLogsCmdStr,
@@ -101,6 +115,36 @@ var ServiceLogsCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisC
 			Type:      flags.FlagType_Bool,
 			Default:   defaultInvertMatchFilterFlagValue,
 		},
+		{
+			Key: sinceFlagKey,
+			Usage: fmt.Sprintf(
+				"Show only the log lines logged at or after this time. %s",
+				commonInstructionInTimeBoundFlags,
+			),
+			Default: defaultSinceAndUntilFlagValue,
+		},
+		{
+			Key: untilFlagKey,
+			Usage: fmt.Sprintf(
+				"Show only the log lines logged before this time. %s",
+				commonInstructionInTimeBoundFlags,
+			),
+			Default: defaultSinceAndUntilFlagValue,
+		},
+		{
+			Key:       numLogLinesFlagKey,
+			Usage:     "Show only the last N log lines (after applying the time bounds and the filters), if followed the new ones will be shown as well. 0 shows all the log lines",
+			Shorthand: "n",
+			Type:      flags.FlagType_Uint32,
+			Default:   defaultNumLogLinesFlagValue,
+		},
+		{
+			Key:       showTimestampsFlagKey,
+			Usage:     "Prefix each log line with the time it was logged at",
+			Shorthand: "t",
+			Type:      flags.FlagType_Bool,
+			Default:   defaultShowTimestampsFlagValue,
+		},
 	},
 	Args: []*args.ArgConfig{
 		enclave_id_arg.NewHistoricalEnclaveIdentifiersArgWithValidationDisabled(
@@ -156,6 +200,31 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the invert match flag using key '%v'", invertMatchFilterFlagKey)
 	}
 
+	sinceStr, err := flags.GetString(sinceFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the since flag using key '%v'", sinceFlagKey)
+	}
+
+	untilStr, err := flags.GetString(untilFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the until flag using key '%v'", untilFlagKey)
+	}
+
+	numLogLines, err := flags.GetUint32(numLogLinesFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the number of log lines flag using key '%v'", numLogLinesFlagKey)
+	}
+
+	shouldShowTimestamps, err := flags.GetBool(showTimestampsFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the show timestamps flag using key '%v'", showTimestampsFlagKey)
+	}
+
+	logLineRange, err := getLogLineRangeFromRangeFlagValues(sinceStr, untilStr, numLogLines, time.Now())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log line range using these flag values '%s=%s', '%s=%s', '%s=%v'", sinceFlagKey, sinceStr, untilFlagKey, untilStr, numLogLinesFlagKey, numLogLines)
+	}
+
 	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
@@ -172,7 +241,7 @@ func run(
 		return stacktrace.Propagate(err, "An error occurred getting the log line filter using these filter flag values '%s=%s', '%s=%s', '%s=%v'", matchTextFilterFlagKey, matchTextStr, matchRegexFilterFlagKey, matchRegexStr, invertMatchFilterFlagKey, invertMatch)
 	}
 
-	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter)
+	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, userServiceUuids, shouldFollowLogs, logLineFilter, logLineRange)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred getting user service logs from user services with UUIDs '%+v' in enclave '%v' and with follow logs value '%v'", userServiceUuids, enclaveIdentifier, shouldFollowLogs)
 	}
@@ -203,7 +272,7 @@ func run(
 			}
 
 			for _, serviceLog := range userServiceLogs {
-				out.PrintOutLn(serviceLog.GetContent())
+				out.PrintOutLn(formatServiceLog(serviceLog, shouldShowTimestamps))
 			}
 		case <-interruptChan:
 			logrus.Debugf("Received signal interruption in service logs Kurtosis CLI command")
@@ -249,6 +318,57 @@ func getLogLineFilterFromFilterFlagValues(matchTextStr string, matchRegexStr str
 	)
 }
 
+func getLogLineRangeFromRangeFlagValues(sinceStr string, untilStr string, numLogLines uint32, now time.Time) (*kurtosis_context.LogLineRange, error) {
+	if sinceStr == defaultSinceAndUntilFlagValue && untilStr == defaultSinceAndUntilFlagValue && numLogLines == 0 {
+		return returnAllLogLines, nil
+	}
+
+	since, err := parseTimeBoundFlagValue(sinceStr, now)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the '%s' flag value '%s'", sinceFlagKey, sinceStr)
+	}
+
+	until, err := parseTimeBoundFlagValue(untilStr, now)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the '%s' flag value '%s'", untilFlagKey, untilStr)
+	}
+
+	if since != nil && until != nil && !since.Before(*until) {
+		return nil, stacktrace.NewError("The '%s' time '%v' has to be earlier than the '%s' time '%v'", sinceFlagKey, since.Format(logLineTimeFormat), untilFlagKey, until.Format(logLineTimeFormat))
+	}
+
+	return kurtosis_context.NewLogLineRange(since, until, numLogLines), nil
+}
+
+// Returns nil if the flag wasn't set, otherwise the time represented by either an RFC3339 timestamp or a duration back from [now]
+func parseTimeBoundFlagValue(timeBoundStr string, now time.Time) (*time.Time, error) {
+	if timeBoundStr == defaultSinceAndUntilFlagValue {
+		return nil, nil
+	}
+
+	if timeBound, err := time.Parse(logLineTimeFormat, timeBoundStr); err == nil {
+		return &timeBound, nil
+	}
+
+	durationBack, err := time.ParseDuration(timeBoundStr)
+	if err != nil {
+		return nil, stacktrace.NewError("'%s' is neither a valid RFC3339 timestamp nor a valid duration. %s", timeBoundStr, commonInstructionInTimeBoundFlags)
+	}
+	if durationBack < 0 {
+		return nil, stacktrace.NewError("The duration '%s' can't be negative, as it is counted back from now", timeBoundStr)
+	}
+	timeBound := now.Add(-durationBack)
+	return &timeBound, nil
+}
+
+func formatServiceLog(serviceLog *kurtosis_context.ServiceLog, shouldShowTimestamps bool) string {
+	// log lines whose time is unknown are printed as they are
+	if !shouldShowTimestamps || serviceLog.GetTimestamp().IsZero() {
+		return serviceLog.GetContent()
+	}
+	return fmt.Sprintf("%s %s", serviceLog.GetTimestamp().Format(logLineTimeFormat), serviceLog.GetContent())
+}
+
 // This function works makes the best effort to get the most accurate enclave uuid and service uuid for the passed values
 // defaults to assuming the passed value are uuids
 // this function will be a lot cleaner after the object ids are stored in a database
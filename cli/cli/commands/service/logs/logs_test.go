@@ -4,6 +4,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
 	"github.com/stretchr/testify/require"
 	"testing"
+	"time"
 )
 
 func TestDefiningLogLineFilterFromFlags_doNotFilter(t *testing.T) {
@@ -75,3 +76,60 @@ func TestDefiningLogLineFilterFromFlags_validInvertMatchRegex(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expectedLogLineFilter, logLineFilter)
 }
+
+func TestDefiningLogLineRangeFromFlags_returnAllLogLines(t *testing.T) {
+	logLineRange, err := getLogLineRangeFromRangeFlagValues("", "", 0, time.Now())
+	require.Nil(t, logLineRange)
+	require.NoError(t, err)
+}
+
+func TestDefiningLogLineRangeFromFlags_validTimestampsAndNumLogLines(t *testing.T) {
+	sinceStr := "2023-10-20T15:04:05Z"
+	untilStr := "2023-10-20T18:04:05+02:00"
+	numLogLines := uint32(10)
+	since, err := time.Parse(time.RFC3339, sinceStr)
+	require.NoError(t, err)
+	until, err := time.Parse(time.RFC3339, untilStr)
+	require.NoError(t, err)
+	expectedLogLineRange := kurtosis_context.NewLogLineRange(&since, &until, numLogLines)
+
+	logLineRange, err := getLogLineRangeFromRangeFlagValues(sinceStr, untilStr, numLogLines, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, expectedLogLineRange, logLineRange)
+}
+
+func TestDefiningLogLineRangeFromFlags_validRelativeDuration(t *testing.T) {
+	now := time.Now()
+	since := now.Add(-90 * time.Minute)
+	expectedLogLineRange := kurtosis_context.NewLogLineRange(&since, nil, 0)
+
+	logLineRange, err := getLogLineRangeFromRangeFlagValues("1h30m", "", 0, now)
+	require.NoError(t, err)
+	require.Equal(t, expectedLogLineRange, logLineRange)
+}
+
+func TestDefiningLogLineRangeFromFlags_onlyNumLogLines(t *testing.T) {
+	expectedLogLineRange := kurtosis_context.NewLogLineRange(nil, nil, 5)
+
+	logLineRange, err := getLogLineRangeFromRangeFlagValues("", "", 5, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, expectedLogLineRange, logLineRange)
+}
+
+func TestDefiningLogLineRangeFromFlags_isNotAllowedInvalidTime(t *testing.T) {
+	errContainStr := "is neither a valid RFC3339 timestamp nor a valid duration"
+
+	logLineRange, err := getLogLineRangeFromRangeFlagValues("yesterday", "", 0, time.Now())
+	require.Nil(t, logLineRange)
+	require.Error(t, err)
+	require.ErrorContains(t, err, errContainStr)
+}
+
+func TestDefiningLogLineRangeFromFlags_isNotAllowedSinceAfterUntil(t *testing.T) {
+	errContainStr := "has to be earlier than"
+
+	logLineRange, err := getLogLineRangeFromRangeFlagValues("10m", "20m", 0, time.Now())
+	require.Nil(t, logLineRange)
+	require.Error(t, err)
+	require.ErrorContains(t, err, errContainStr)
+}
@@ -40,10 +40,12 @@ def run(plan, args):
 	defaultParallelism = 4
 
 	useDefaultMainFile = ""
+	notOffline         = false
 )
 
 var (
 	noExperimentalFeature []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag
+	noGitHosts            []*kurtosis_core_rpc_api_bindings.GitHost
 )
 
 var ServiceStartCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -113,7 +115,7 @@ func run(
 
 func startServiceStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName) error {
 	serviceNameString := fmt.Sprintf(`{"service_name": "%s"}`, serviceName)
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, serviceNameString, doNotDryRun, defaultParallelism, noExperimentalFeature)
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, serviceNameString, doNotDryRun, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	if err != nil {
 		return stacktrace.Propagate(err, "An unexpected error occurred on Starlark for starting service")
 	}
@@ -39,10 +39,12 @@ def run(plan, args):
 	defaultParallelism = 4
 
 	useDefaultMainFile = ""
+	notOffline         = false
 )
 
 var (
 	noExperimentalFeature []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag
+	noGitHosts            []*kurtosis_core_rpc_api_bindings.GitHost
 )
 
 var ServiceStopCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
@@ -112,7 +114,7 @@ func run(
 
 func stopServiceStarlarkCommand(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName) error {
 	params := fmt.Sprintf(`{"service_name": "%s"}`, serviceName)
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, params, doNotDryRun, defaultParallelism, noExperimentalFeature)
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, params, doNotDryRun, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	if err != nil {
 		return stacktrace.Propagate(err, "An unexpected error occurred on Starlark for stopping service")
 	}
@@ -0,0 +1,183 @@
+package test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/engine_consuming_kurtosis_command"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/highlevel/file_system_path_arg"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/args"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_framework/lowlevel/flags"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/command_str_consts"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/commands/run"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	metrics_client "github.com/kurtosis-tech/metrics-library/golang/lib/client"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	packageDirpathArgKey        = "package-dirpath"
+	isPackageDirpathArgOptional = true
+	defaultPackageDirpath       = "."
+
+	enclaveIdentifierFlagKey = "enclave"
+	// Signifies that the tests run in a temporary enclave, destroyed once they're done
+	useTemporaryEnclaveKeyword = ""
+
+	runFlagKey = "run"
+	// Signifies that all the tests get run
+	runAllTests = ""
+
+	offlineFlagKey = "offline"
+	offlineDefault = "false"
+
+	kurtosisBackendCtxKey = "kurtosis-backend"
+	engineClientCtxKey    = "engine-client"
+
+	passedTestStatus = "PASS"
+	failedTestStatus = "FAIL"
+)
+
+var TestCmd = &engine_consuming_kurtosis_command.EngineConsumingKurtosisCommand{
+	CommandStr:                command_str_consts.TestCmdStr,
+	KurtosisBackendContextKey: kurtosisBackendCtxKey,
+	EngineClientContextKey:    engineClientCtxKey,
+	ShortDescription:          "Run the tests of a Starlark package",
+	LongDescription: "Runs every function whose name starts with 'test_' in the files ending with '_test.star' of a " +
+		"local package. The tests run against a fake network of services, so no container gets started: the plan " +
+		"they build is executed against fake services, whose responses to requests can be set with the 'testing' " +
+		"module the tests have access to. This makes them a fast way to check how the package parses its " +
+		"parameters and the shape of the plan it builds",
+	Flags: []*flags.FlagConfig{
+		{
+			Key: enclaveIdentifierFlagKey,
+			Usage: "The enclave identifier of the enclave the package gets uploaded to for its tests to run. If " +
+				"unset, a temporary enclave is created and destroyed once the tests are done",
+			Type:    flags.FlagType_String,
+			Default: useTemporaryEnclaveKeyword,
+		},
+		{
+			Key:     runFlagKey,
+			Usage:   "If set, only the tests whose name contains this string are run",
+			Type:    flags.FlagType_String,
+			Default: runAllTests,
+		},
+		{
+			Key: offlineFlagKey,
+			Usage: "If true, the dependencies of the package are never cloned and are only taken from the package " +
+				"cache the enclaves share. Default false",
+			Type:    flags.FlagType_Bool,
+			Default: offlineDefault,
+		},
+	},
+	Args: []*args.ArgConfig{
+		file_system_path_arg.NewDirpathArg(
+			packageDirpathArgKey,
+			isPackageDirpathArgOptional,
+			defaultPackageDirpath,
+			file_system_path_arg.DefaultValidationFunc,
+		),
+	},
+	RunFunc: runTests,
+}
+
+func runTests(
+	ctx context.Context,
+	_ backend_interface.KurtosisBackend,
+	_ kurtosis_engine_rpc_api_bindings.EngineServiceClient,
+	_ metrics_client.MetricsClient,
+	flags *flags.ParsedFlags,
+	args *args.ParsedArgs,
+) error {
+	packageDirpath, err := args.GetNonGreedyArg(packageDirpathArgKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the package dirpath using arg key '%v'", packageDirpathArgKey)
+	}
+
+	enclaveIdentifier, err := flags.GetString(enclaveIdentifierFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the enclave identifier using flag key '%s'", enclaveIdentifierFlagKey)
+	}
+
+	testNameFilter, err := flags.GetString(runFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", runFlagKey)
+	}
+
+	isOffline, err := flags.GetBool(offlineFlagKey)
+	if err != nil {
+		return stacktrace.Propagate(err, "Expected a value for the '%v' flag but failed to get it", offlineFlagKey)
+	}
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred connecting to the local Kurtosis engine")
+	}
+
+	var enclaveCtx *enclaves.EnclaveContext
+	if enclaveIdentifier == useTemporaryEnclaveKeyword {
+		logrus.Infof("Creating a temporary enclave for the tests to run inside...")
+		enclaveCtx, err = kurtosisCtx.CreateEnclave(ctx, useTemporaryEnclaveKeyword)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the enclave the tests run inside")
+		}
+		defer func() {
+			if err = kurtosisCtx.DestroyEnclave(ctx, enclaveCtx.GetEnclaveName()); err != nil {
+				logrus.Errorf("An error occurred destroying the temporary enclave '%v' the tests ran inside; you'll need to destroy it manually:\n%v", enclaveCtx.GetEnclaveName(), err)
+			}
+		}()
+	} else {
+		enclaveCtx, err = kurtosisCtx.GetEnclaveContext(ctx, enclaveIdentifier)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the enclave context for enclave '%v'", enclaveIdentifier)
+		}
+	}
+
+	testResults, err := enclaveCtx.RunStarlarkPackageTests(ctx, packageDirpath, testNameFilter, nil, run.GetCurrentContextGitHosts(), isOffline)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the tests of the package at '%v'", packageDirpath)
+	}
+	return printTestResults(testResults)
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+// printTestResults prints a line per test followed by a summary, returning an error if any test failed
+func printTestResults(testResults []*kurtosis_core_rpc_api_bindings.StarlarkTestResult) error {
+	if len(testResults) == 0 {
+		out.PrintOutLn("No test found")
+		return nil
+	}
+
+	numFailedTests := 0
+	for _, testResult := range testResults {
+		duration := time.Duration(testResult.GetDurationMs()) * time.Millisecond
+		status := passedTestStatus
+		if !testResult.GetPassed() {
+			status = failedTestStatus
+			numFailedTests++
+		}
+		out.PrintOutLn(fmt.Sprintf("%s\t%s\t%s (%v)", status, testResult.GetFile(), testResult.GetName(), duration))
+		if !testResult.GetPassed() {
+			out.PrintOutLn(fmt.Sprintf("\t%s", testResult.GetFailureMessage()))
+		}
+	}
+
+	summary := fmt.Sprintf("%d passed, %d failed", len(testResults)-numFailedTests, numFailedTests)
+	if numFailedTests > 0 {
+		out.PrintOutLn(fmt.Sprintf("%s\t%s", failedTestStatus, summary))
+		return stacktrace.NewError("%d of the %d tests of the package failed", numFailedTests, len(testResults))
+	}
+	out.PrintOutLn(fmt.Sprintf("%s\t%s", passedTestStatus, summary))
+	return nil
+}
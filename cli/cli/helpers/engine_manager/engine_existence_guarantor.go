@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/kurtosis_version"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"time"
 )
 
 const (
@@ -69,6 +70,11 @@ type engineExistenceGuarantor struct {
 	poolSize uint8
 
 	enclaveEnvVars string
+
+	logRetentionPeriod time.Duration
+
+	// Zero means the logs storage size isn't limited
+	logRetentionMaxSizeInMegabytes uint64
 }
 
 func newEngineExistenceGuarantorWithDefaultVersion(
@@ -83,6 +89,8 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 	onBastionHost bool,
 	poolSize uint8,
 	enclaveEnvVars string,
+	logRetentionPeriod time.Duration,
+	logRetentionMaxSizeInMegabytes uint64,
 ) *engineExistenceGuarantor {
 	return newEngineExistenceGuarantorWithCustomVersion(
 		ctx,
@@ -97,6 +105,8 @@ func newEngineExistenceGuarantorWithDefaultVersion(
 		onBastionHost,
 		poolSize,
 		enclaveEnvVars,
+		logRetentionPeriod,
+		logRetentionMaxSizeInMegabytes,
 	)
 }
 
@@ -113,6 +123,8 @@ func newEngineExistenceGuarantorWithCustomVersion(
 	onBastionHost bool,
 	poolSize uint8,
 	enclaveEnvVars string,
+	logRetentionPeriod time.Duration,
+	logRetentionMaxSizeInMegabytes uint64,
 ) *engineExistenceGuarantor {
 	return &engineExistenceGuarantor{
 		ctx:                                  ctx,
@@ -129,6 +141,8 @@ func newEngineExistenceGuarantorWithCustomVersion(
 		onBastionHost:                             onBastionHost,
 		poolSize:                                  poolSize,
 		enclaveEnvVars:                            enclaveEnvVars,
+		logRetentionPeriod:                        logRetentionPeriod,
+		logRetentionMaxSizeInMegabytes:            logRetentionMaxSizeInMegabytes,
 	}
 }
 
@@ -158,6 +172,8 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.onBastionHost,
 			guarantor.poolSize,
 			guarantor.enclaveEnvVars,
+			guarantor.logRetentionPeriod,
+			guarantor.logRetentionMaxSizeInMegabytes,
 		)
 	} else {
 		_, _, engineLaunchErr = guarantor.engineServerLauncher.LaunchWithCustomVersion(
@@ -171,6 +187,8 @@ func (guarantor *engineExistenceGuarantor) VisitStopped() error {
 			guarantor.onBastionHost,
 			guarantor.poolSize,
 			guarantor.enclaveEnvVars,
+			guarantor.logRetentionPeriod,
+			guarantor.logRetentionMaxSizeInMegabytes,
 		)
 	}
 	if engineLaunchErr != nil {
@@ -42,6 +42,7 @@ type EngineManager struct {
 	clusterConfig                             *resolved_config.KurtosisClusterConfig
 	onBastionHost                             bool
 	enclaveEnvVars                            string
+	logRetentionConfig                        *resolved_config.KurtosisLogRetentionConfig
 	// Make engine IP, port, and protocol configurable in the future
 }
 
@@ -98,9 +99,10 @@ func NewEngineManager(ctx context.Context) (*EngineManager, error) {
 		kurtosisBackend:   kurtosisBackend,
 		shouldSendMetrics: kurtosisConfig.GetShouldSendMetrics(),
 		engineServerKurtosisBackendConfigSupplier: engineBackendConfigSupplier,
-		clusterConfig:  clusterConfig,
-		onBastionHost:  onBastionHost,
-		enclaveEnvVars: enclaveEnvVars,
+		clusterConfig:      clusterConfig,
+		onBastionHost:      onBastionHost,
+		enclaveEnvVars:     enclaveEnvVars,
+		logRetentionConfig: kurtosisConfig.GetLogRetentionConfig(),
 	}, nil
 }
 
@@ -171,6 +173,33 @@ func (manager *EngineManager) GetEngineStatus(
 	return EngineStatus_Running, runningEngineIpAndPort, engineInfo.GetEngineVersion(), nil
 }
 
+// GetEngineInfo returns the info reported by the running engine, including the state of its enclave pool
+func (manager *EngineManager) GetEngineInfo(ctx context.Context) (*kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse, error) {
+	status, maybeHostMachinePortBinding, _, err := manager.GetEngineStatus(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving the Kurtosis engine status, which is necessary for getting the engine info")
+	}
+	if status != EngineStatus_Running {
+		return nil, stacktrace.NewError("The engine info can't be retrieved because the Kurtosis engine status is '%v'", status)
+	}
+
+	engineClient, engineClientCloseFunc, err := getEngineClientFromHostMachineIpAndPort(maybeHostMachinePortBinding)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred connecting to the running engine")
+	}
+	defer func() {
+		if err = engineClientCloseFunc(); err != nil {
+			logrus.Warnf("Error closing the engine client:\n'%v'", err)
+		}
+	}()
+
+	engineInfo, err := getEngineInfoWithTimeout(ctx, engineClient)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the engine info")
+	}
+	return engineInfo, nil
+}
+
 // StartEngineIdempotentlyWithDefaultVersion Starts an engine if one doesn't exist already, and returns a client to it
 func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(ctx context.Context, logLevel logrus.Level, poolSize uint8) (kurtosis_engine_rpc_api_bindings.EngineServiceClient, func() error, error) {
 	status, maybeHostMachinePortBinding, engineVersion, err := manager.GetEngineStatus(ctx)
@@ -191,6 +220,8 @@ func (manager *EngineManager) StartEngineIdempotentlyWithDefaultVersion(ctx cont
 		manager.onBastionHost,
 		poolSize,
 		manager.enclaveEnvVars,
+		manager.logRetentionConfig.Period,
+		manager.logRetentionConfig.MaxSizeInMegabytes,
 	)
 	// TODO Need to handle the Kubernetes case, where a gateway needs to be started after the engine is started but
 	//  before we can return an EngineClient
@@ -222,6 +253,8 @@ func (manager *EngineManager) StartEngineIdempotentlyWithCustomVersion(ctx conte
 		manager.onBastionHost,
 		poolSize,
 		manager.enclaveEnvVars,
+		manager.logRetentionConfig.Period,
+		manager.logRetentionConfig.MaxSizeInMegabytes,
 	)
 	engineClient, engineClientCloseFunc, err := manager.startEngineWithGuarantor(ctx, status, engineGuarantor)
 	if err != nil {
@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -30,6 +31,9 @@ const (
 	codeCommentPrefix = "# "
 
 	newlineChar = "\n"
+
+	numSlowestInstructionsToPrint = 5
+	slowestInstructionsHeader     = "Slowest instructions:"
 )
 
 var (
@@ -37,6 +41,7 @@ var (
 	colorizeResult           = color.New(color.FgWhite).SprintFunc()
 	colorizeError            = color.New(color.FgRed).SprintFunc()
 	colorizeWarning          = color.New(color.FgYellow).SprintFunc()
+	colorizeInfo             = color.New(color.FgWhite).SprintFunc()
 	colorizeRunSuccessfulMsg = color.New(color.FgGreen).SprintFunc()
 
 	colorizeProgressBarIsDone    = color.New(color.FgGreen).SprintFunc()
@@ -58,14 +63,25 @@ type ExecutionPrinter struct {
 
 	isSpinnerBeingUsed bool
 	spinner            *spinner.Spinner
+
+	// used to print a summary of the slowest instructions once the run is finished
+	lastInstruction      *kurtosis_core_rpc_api_bindings.StarlarkInstruction
+	executedInstructions []*executedInstruction
+}
+
+type executedInstruction struct {
+	instruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction
+	metrics     *kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics
 }
 
 func NewExecutionPrinter() *ExecutionPrinter {
 	return &ExecutionPrinter{
-		lock:               &sync.Mutex{},
-		isSpinnerBeingUsed: false,
-		spinner:            nil,
-		isStarted:          false,
+		lock:                 &sync.Mutex{},
+		isSpinnerBeingUsed:   false,
+		spinner:              nil,
+		isStarted:            false,
+		lastInstruction:      nil,
+		executedInstructions: []*executedInstruction{},
 	}
 }
 
@@ -106,6 +122,10 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 		return stacktrace.NewError("Cannot print with a non started printer")
 	}
 
+	if responseLine.GetInstruction() != nil {
+		printer.lastInstruction = responseLine.GetInstruction()
+	}
+
 	// process response payload
 	if responseLine.GetInstruction() != nil && verbosity != run.OutputOnly {
 		formattedInstruction := formatInstruction(responseLine.GetInstruction(), verbosity)
@@ -141,7 +161,20 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 			progressBarStr := formatProgressBar(progress.GetCurrentStepNumber(), progress.GetTotalSteps(), progressBarChar)
 			printer.spinner.Suffix = fmt.Sprintf("   %s %s", progressBarStr, progressMessageStr)
 		}
+	} else if responseLine.GetInstructionMetrics() != nil {
+		if printer.lastInstruction != nil {
+			printer.executedInstructions = append(printer.executedInstructions, &executedInstruction{
+				instruction: printer.lastInstruction,
+				metrics:     responseLine.GetInstructionMetrics(),
+			})
+		}
 	} else if responseLine.GetRunFinishedEvent() != nil {
+		if verbosity != run.OutputOnly && len(printer.executedInstructions) > 0 {
+			formattedSlowestInstructions := formatSlowestInstructions(printer.executedInstructions, numSlowestInstructionsToPrint)
+			if err := printer.printPersistentLineToStdOut(fmt.Sprintf("\n%s", formattedSlowestInstructions)); err != nil {
+				return stacktrace.Propagate(err, "Unable to print the summary of the slowest instructions. Summary was: \n%v", formattedSlowestInstructions)
+			}
+		}
 		formattedRunOutputMessage := formatRunOutput(responseLine.GetRunFinishedEvent(), dryRun)
 		formattedRunOutputMessageWithNewline := fmt.Sprintf("\n%s", formattedRunOutputMessage)
 		if err := printer.printPersistentLineToStdOut(formattedRunOutputMessageWithNewline); err != nil {
@@ -153,6 +186,12 @@ func (printer *ExecutionPrinter) PrintKurtosisExecutionResponseLineToStdOut(resp
 		if err := printer.printPersistentLineToStdOut(formattedRunWarningMessageWithNewline); err != nil {
 			return stacktrace.Propagate(err, "Error printing warning message: %v", formattedRunWarningMessage)
 		}
+	} else if responseLine.GetInfo() != nil {
+		formattedRunInfoMessage := formatInfo(responseLine.GetInfo().GetInfoMessage())
+		formattedRunInfoMessageWithNewline := fmt.Sprintf("\n%s", formattedRunInfoMessage)
+		if err := printer.printPersistentLineToStdOut(formattedRunInfoMessageWithNewline); err != nil {
+			return stacktrace.Propagate(err, "Error printing info message: %v", formattedRunInfoMessage)
+		}
 	}
 	return nil
 }
@@ -173,6 +212,10 @@ func formatWarning(warningMessage string) string {
 	return colorizeWarning(warningMessage)
 }
 
+func formatInfo(infoMessage string) string {
+	return colorizeInfo(infoMessage)
+}
+
 func formatInstruction(instruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction, verbosity run.Verbosity) string {
 	var serializedInstruction string
 	switch verbosity {
@@ -257,6 +300,49 @@ func formatProgressBar(currentStep uint32, totalSteps uint32, progressBarChar st
 	return fmt.Sprintf("%s%s", isDone, remaining)
 }
 
+// formatSlowestInstructions lists the maxNumInstructions instructions that took the longest to execute, along with the
+// time spent pulling their images and the number of retries they needed when relevant
+func formatSlowestInstructions(executedInstructions []*executedInstruction, maxNumInstructions int) string {
+	sortedInstructions := make([]*executedInstruction, len(executedInstructions))
+	copy(sortedInstructions, executedInstructions)
+	sort.SliceStable(sortedInstructions, func(i, j int) bool {
+		return sortedInstructions[i].metrics.GetDurationMs() > sortedInstructions[j].metrics.GetDurationMs()
+	})
+	if len(sortedInstructions) > maxNumInstructions {
+		sortedInstructions = sortedInstructions[:maxNumInstructions]
+	}
+
+	summaryLines := []string{slowestInstructionsHeader}
+	for _, slowInstruction := range sortedInstructions {
+		instruction := slowInstruction.instruction
+		metrics := slowInstruction.metrics
+		summaryLine := fmt.Sprintf(
+			"  %s\t%s at %s[%d:%d]",
+			formatDurationMs(metrics.GetDurationMs()),
+			instruction.GetInstructionName(),
+			instruction.GetPosition().GetFilename(),
+			instruction.GetPosition().GetLine(),
+			instruction.GetPosition().GetColumn(),
+		)
+		var details []string
+		if metrics.GetImagePullDurationMs() > 0 {
+			details = append(details, fmt.Sprintf("image pull: %s", formatDurationMs(metrics.GetImagePullDurationMs())))
+		}
+		if metrics.GetNumRetries() > 0 {
+			details = append(details, fmt.Sprintf("retries: %d", metrics.GetNumRetries()))
+		}
+		if len(details) > 0 {
+			summaryLine = fmt.Sprintf("%s (%s)", summaryLine, strings.Join(details, ", "))
+		}
+		summaryLines = append(summaryLines, summaryLine)
+	}
+	return strings.Join(summaryLines, newlineChar)
+}
+
+func formatDurationMs(durationMs uint64) string {
+	return (time.Duration(durationMs) * time.Millisecond).String()
+}
+
 func formatRunOutput(runFinishedEvent *kurtosis_core_rpc_api_bindings.StarlarkRunFinishedEvent, dryRun bool) string {
 	if !runFinishedEvent.GetIsRunSuccessful() {
 		if dryRun {
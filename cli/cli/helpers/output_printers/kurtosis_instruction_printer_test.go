@@ -156,3 +156,17 @@ func TestFormatRunOutput_Failure_ExecutedRun(t *testing.T) {
 	expectedMessage := `Error encountered running Starlark code.`
 	require.Equal(t, expectedMessage, message)
 }
+
+func TestFormatSlowestInstructions(t *testing.T) {
+	instruction := testInstruction()
+	executedInstructions := []*executedInstruction{
+		{instruction: instruction, metrics: binding_constructors.NewStarlarkInstructionMetrics(0, 15, 15, 0, 0)},
+		{instruction: instruction, metrics: binding_constructors.NewStarlarkInstructionMetrics(15, 12515, 12500, 10000, 3)},
+		{instruction: instruction, metrics: binding_constructors.NewStarlarkInstructionMetrics(12515, 14015, 1500, 0, 1)},
+	}
+	summary := formatSlowestInstructions(executedInstructions, 2)
+	expectedSummary := `Slowest instructions:
+  12.5s	my_instruction at dummyFile[12:4] (image pull: 10s, retries: 3)
+  1.5s	my_instruction at dummyFile[12:4] (retries: 1)`
+	require.Equal(t, expectedSummary, summary)
+}
@@ -0,0 +1,56 @@
+package output_printers
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/cli/cli/out"
+)
+
+const (
+	runDiffHeader    = "Changes to the services of the enclave:"
+	noServiceChanges = "No changes to the services of the enclave"
+	reasonIndent     = "      "
+)
+
+var (
+	serviceChangeSymbols = map[kurtosis_core_rpc_api_bindings.ServiceChangeType]string{
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_ADDED:     "+",
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_UPDATED:   "~",
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_RESTARTED: "*",
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_REMOVED:   "-",
+	}
+	serviceChangeColorizers = map[kurtosis_core_rpc_api_bindings.ServiceChangeType]func(a ...interface{}) string{
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_ADDED:     color.New(color.FgGreen).SprintFunc(),
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_UPDATED:   color.New(color.FgYellow).SprintFunc(),
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_RESTARTED: color.New(color.FgCyan).SprintFunc(),
+		kurtosis_core_rpc_api_bindings.ServiceChangeType_REMOVED:   color.New(color.FgRed).SprintFunc(),
+	}
+)
+
+// PrintRunDiff prints the changes a run would make to the services of the enclave, along with why they're made
+func PrintRunDiff(runDiff *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse) {
+	out.PrintOutLn(formatRunDiff(runDiff))
+}
+
+func formatRunDiff(runDiff *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse) string {
+	lines := []string{}
+	if len(runDiff.GetServiceChanges()) == 0 {
+		lines = append(lines, noServiceChanges)
+	} else {
+		lines = append(lines, runDiffHeader)
+	}
+	for _, serviceChange := range runDiff.GetServiceChanges() {
+		changeType := serviceChange.GetChangeType()
+		colorize := serviceChangeColorizers[changeType]
+		serviceChangeLine := fmt.Sprintf("  %s %s (%s)", serviceChangeSymbols[changeType], serviceChange.GetServiceName(), strings.ToLower(changeType.String()))
+		lines = append(lines, colorize(serviceChangeLine))
+		for _, reason := range serviceChange.GetReasons() {
+			lines = append(lines, reasonIndent+reason)
+		}
+	}
+	lines = append(lines, fmt.Sprintf("%d instruction(s) will run and %d instruction(s) will be skipped as they already ran in the enclave", runDiff.GetInstructionsToRunCount(), runDiff.GetInstructionsToSkipCount()))
+	return strings.Join(lines, newlineChar)
+}
@@ -0,0 +1,31 @@
+package output_printers
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormatRunDiff(t *testing.T) {
+	runDiff := binding_constructors.NewGetStarlarkRunDiffResponseFromServiceChanges([]*kurtosis_core_rpc_api_bindings.ServiceChange{
+		binding_constructors.NewServiceChange("db", kurtosis_core_rpc_api_bindings.ServiceChangeType_UPDATED, []string{"Its arguments changed"}),
+		binding_constructors.NewServiceChange("app", kurtosis_core_rpc_api_bindings.ServiceChangeType_ADDED, nil),
+		binding_constructors.NewServiceChange("old", kurtosis_core_rpc_api_bindings.ServiceChangeType_REMOVED, nil),
+	}, 3, 2)
+	expectedResult := `Changes to the services of the enclave:
+  ~ db (updated)
+      Its arguments changed
+  + app (added)
+  - old (removed)
+3 instruction(s) will run and 2 instruction(s) will be skipped as they already ran in the enclave`
+	require.Equal(t, expectedResult, formatRunDiff(runDiff))
+}
+
+func TestFormatRunDiff_NoChanges(t *testing.T) {
+	runDiff := binding_constructors.NewGetStarlarkRunDiffResponseFromServiceChanges(nil, 0, 4)
+	expectedResult := `No changes to the services of the enclave
+0 instruction(s) will run and 4 instruction(s) will be skipped as they already ran in the enclave`
+	require.Equal(t, expectedResult, formatRunDiff(runDiff))
+}
@@ -0,0 +1,292 @@
+package package_dependency_resolver
+
+import (
+	"fmt"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const (
+	githubDomain                        = "github.com"
+	packageNameSeparator                = "/"
+	packageNameRepositoryPathComponents = 3
+	githubCloneUrlFormat                = "https://%s.git"
+	defaultRemoteName                   = "origin"
+	repositoriesTmpDirPattern           = "kurtosis-package-dependencies-*"
+	kurtosisYamlFilename                = "kurtosis.yml"
+	rootPackageRequirer                 = "the package"
+	isNotBareClone                      = false
+)
+
+// RepositoryCloner clones the repository with the given name, e.g. 'github.com/author/repo', to the destination
+type RepositoryCloner func(repositoryName string, destinationDirpath string) (*git.Repository, error)
+
+// PackageDependencyResolver resolves the dependencies of a package, including the transitive ones, to the commit
+// their version points to, and builds the kurtosis.lock pinning them
+type PackageDependencyResolver struct {
+	cloneRepository RepositoryCloner
+}
+
+func NewPackageDependencyResolver() *PackageDependencyResolver {
+	return newPackageDependencyResolverWithCloner(cloneGithubRepository)
+}
+
+func newPackageDependencyResolverWithCloner(cloneRepository RepositoryCloner) *PackageDependencyResolver {
+	return &PackageDependencyResolver{
+		cloneRepository: cloneRepository,
+	}
+}
+
+type pendingDependency struct {
+	packageName string
+	version     string
+	requiredBy  string
+}
+
+// Resolve builds the lock of the dependencies declared in a kurtosis.yml. The dependencies of the existing lock which
+// are still declared at the same version keep their commit, unless shouldUpdate returns true for them, in which case
+// their version gets resolved again
+func (resolver *PackageDependencyResolver) Resolve(
+	declaredDependencies map[string]string,
+	existingLock *package_lock.KurtosisLock,
+	shouldUpdate func(packageName string) bool,
+) (*package_lock.KurtosisLock, error) {
+	repositoriesDirpath, err := os.MkdirTemp("", repositoriesTmpDirPattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the temporary directory to clone the dependencies in")
+	}
+	defer os.RemoveAll(repositoriesDirpath)
+
+	repositories := map[string]*git.Repository{}
+	repositoryCommits := map[string]string{}
+	requirers := map[string]string{}
+	resolvedLock := package_lock.NewKurtosisLock()
+
+	pendingDependencies := getSortedPendingDependencies(declaredDependencies, rootPackageRequirer)
+	for len(pendingDependencies) > 0 {
+		dependency := pendingDependencies[0]
+		pendingDependencies = pendingDependencies[1:]
+
+		if lockedDependency, found := resolvedLock.GetLockedDependency(dependency.packageName); found {
+			if lockedDependency.Version != dependency.version {
+				return nil, stacktrace.NewError(
+					"Dependency '%v' is required at version '%v' by %v but at version '%v' by %v; a package can only be used at one version",
+					dependency.packageName,
+					dependency.version,
+					dependency.requiredBy,
+					lockedDependency.Version,
+					requirers[dependency.packageName],
+				)
+			}
+			continue
+		}
+
+		repositoryName, relativePackagePath, err := splitPackageName(dependency.packageName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Dependency '%v' required by %v isn't a valid package name", dependency.packageName, dependency.requiredBy)
+		}
+		repository, found := repositories[repositoryName]
+		if !found {
+			logrus.Infof("Cloning '%v'...", repositoryName)
+			repository, err = resolver.cloneRepository(repositoryName, filepath.Join(repositoriesDirpath, repositoryName))
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred cloning repository '%v' of dependency '%v'", repositoryName, dependency.packageName)
+			}
+			repositories[repositoryName] = repository
+		}
+
+		commit, err := getCommitToLock(repository, dependency, existingLock, shouldUpdate)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred resolving version '%v' of dependency '%v'", dependency.version, dependency.packageName)
+		}
+		// the packages of a repository all get cloned at once, so they can't be used at different commits
+		if otherCommit, found := repositoryCommits[repositoryName]; found && otherCommit != commit {
+			return nil, stacktrace.NewError(
+				"Dependency '%v' resolved to commit '%v' but other packages of repository '%v' resolved to commit '%v'; packages of the same repository have to be used at the same commit",
+				dependency.packageName,
+				commit,
+				repositoryName,
+				otherCommit,
+			)
+		}
+		repositoryCommits[repositoryName] = commit
+
+		packageRootDirpath, err := checkoutPackage(repository, commit, relativePackagePath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred checking out commit '%v' of dependency '%v'", commit, dependency.packageName)
+		}
+		dependencyKurtosisYaml, err := enclaves.ParseKurtosisYaml(filepath.Join(packageRootDirpath, kurtosisYamlFilename))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Dependency '%v' at commit '%v' isn't a valid package", dependency.packageName, commit)
+		}
+		if dependencyKurtosisYaml.PackageName != dependency.packageName {
+			return nil, stacktrace.NewError("Dependency '%v' at commit '%v' is named '%v' in its %v", dependency.packageName, commit, dependencyKurtosisYaml.PackageName, kurtosisYamlFilename)
+		}
+		contentHash, err := package_lock.ComputePackageContentHash(packageRootDirpath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred computing the content hash of dependency '%v' at commit '%v'", dependency.packageName, commit)
+		}
+
+		resolvedLock.Dependencies[dependency.packageName] = package_lock.NewLockedDependency(dependency.version, commit, contentHash)
+		requirers[dependency.packageName] = dependency.requiredBy
+		transitiveRequirer := fmt.Sprintf("'%v'", dependency.packageName)
+		pendingDependencies = append(pendingDependencies, getSortedPendingDependencies(dependencyKurtosisYaml.Dependencies, transitiveRequirer)...)
+	}
+	return resolvedLock, nil
+}
+
+func getSortedPendingDependencies(dependencies map[string]string, requiredBy string) []*pendingDependency {
+	pendingDependencies := []*pendingDependency{}
+	for packageName, version := range dependencies {
+		pendingDependencies = append(pendingDependencies, &pendingDependency{
+			packageName: packageName,
+			version:     version,
+			requiredBy:  requiredBy,
+		})
+	}
+	sort.Slice(pendingDependencies, func(i, j int) bool {
+		return pendingDependencies[i].packageName < pendingDependencies[j].packageName
+	})
+	return pendingDependencies
+}
+
+// splitPackageName splits a package name like 'github.com/author/repo/sub/package' into the name of its repository,
+// 'github.com/author/repo', and its path inside the repository, 'sub/package'
+func splitPackageName(packageName string) (string, string, error) {
+	packageNameComponents := strings.Split(packageName, packageNameSeparator)
+	if len(packageNameComponents) < packageNameRepositoryPathComponents || packageNameComponents[0] != githubDomain {
+		return "", "", stacktrace.NewError("Package names are expected to look like '%v/author/repository', optionally followed by the path of the package in the repository, but got '%v'", githubDomain, packageName)
+	}
+	for _, component := range packageNameComponents {
+		if component == "" || component == "." || component == ".." {
+			return "", "", stacktrace.NewError("Package name '%v' contains an empty or relative path component", packageName)
+		}
+	}
+	repositoryName := strings.Join(packageNameComponents[:packageNameRepositoryPathComponents], packageNameSeparator)
+	relativePackagePath := strings.Join(packageNameComponents[packageNameRepositoryPathComponents:], packageNameSeparator)
+	return repositoryName, relativePackagePath, nil
+}
+
+func getCommitToLock(
+	repository *git.Repository,
+	dependency *pendingDependency,
+	existingLock *package_lock.KurtosisLock,
+	shouldUpdate func(packageName string) bool,
+) (string, error) {
+	lockedDependency, found := existingLock.GetLockedDependency(dependency.packageName)
+	if found && lockedDependency.Version == dependency.version && !shouldUpdate(dependency.packageName) {
+		return lockedDependency.Commit, nil
+	}
+	commit, err := resolveVersion(repository, dependency.version)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred resolving version '%v'", dependency.version)
+	}
+	return commit.String(), nil
+}
+
+// resolveVersion returns the commit a version points to, looking for a tag first, then for a branch and finally for a
+// commit. An empty version is the default branch of the repository
+func resolveVersion(repository *git.Repository, version string) (plumbing.Hash, error) {
+	if version == "" {
+		head, err := repository.Head()
+		if err != nil {
+			return plumbing.ZeroHash, stacktrace.Propagate(err, "An error occurred getting the default branch of the repository")
+		}
+		return head.Hash(), nil
+	}
+
+	candidateReferenceNames := []plumbing.ReferenceName{
+		plumbing.NewTagReferenceName(version),
+		plumbing.NewRemoteReferenceName(defaultRemoteName, version),
+		plumbing.NewBranchReferenceName(version),
+	}
+	for _, referenceName := range candidateReferenceNames {
+		reference, err := repository.Reference(referenceName, true)
+		if err != nil {
+			continue
+		}
+		// annotated tags point to a tag object rather than to the commit
+		if tagObject, err := repository.TagObject(reference.Hash()); err == nil {
+			commit, err := tagObject.Commit()
+			if err != nil {
+				return plumbing.ZeroHash, stacktrace.Propagate(err, "An error occurred getting the commit tag '%v' points to", version)
+			}
+			return commit.Hash, nil
+		}
+		return reference.Hash(), nil
+	}
+
+	commitHash, err := repository.ResolveRevision(plumbing.Revision(version))
+	if err != nil {
+		return plumbing.ZeroHash, stacktrace.Propagate(err, "Couldn't find a tag, a branch or a commit named '%v' in the repository", version)
+	}
+	if _, err = repository.CommitObject(*commitHash); err != nil {
+		return plumbing.ZeroHash, stacktrace.Propagate(err, "Version '%v' doesn't point to a commit of the repository", version)
+	}
+	return *commitHash, nil
+}
+
+// checkoutPackage checks out the commit in the repository and returns the directory of the package in it
+func checkoutPackage(repository *git.Repository, commit string, relativePackagePath string) (string, error) {
+	workTree, err := repository.Worktree()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the worktree of the repository")
+	}
+	checkoutOptions := &git.CheckoutOptions{
+		Hash:   plumbing.NewHash(commit),
+		Branch: "",
+		Create: false,
+		Force:  true,
+		Keep:   false,
+	}
+	if err = workTree.Checkout(checkoutOptions); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred checking out commit '%v'", commit)
+	}
+	return filepath.Join(workTree.Filesystem.Root(), filepath.FromSlash(relativePackagePath)), nil
+}
+
+func cloneGithubRepository(repositoryName string, destinationDirpath string) (*git.Repository, error) {
+	cloneUrl := fmt.Sprintf(githubCloneUrlFormat, repositoryName)
+	//nolint:exhaustruct
+	repository, err := git.PlainClone(destinationDirpath, isNotBareClone, &git.CloneOptions{
+		URL:      cloneUrl,
+		Progress: io.Discard,
+		Tags:     git.AllTags,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred cloning '%v'. Make sure that it exists and is a public repository", cloneUrl)
+	}
+	return repository, nil
+}
+
+// LockPackageDependencies resolves the dependencies declared in the kurtosis.yml of the package in the directory and
+// writes the resulting kurtosis.lock next to it. See Resolve for the dependencies which keep their locked commit
+func LockPackageDependencies(packageDirpath string, shouldUpdate func(packageName string) bool) (*package_lock.KurtosisLock, error) {
+	kurtosisYaml, err := enclaves.ParseKurtosisYaml(filepath.Join(packageDirpath, kurtosisYamlFilename))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the %v of the package at '%v'", kurtosisYamlFilename, packageDirpath)
+	}
+	kurtosisLockFilepath := filepath.Join(packageDirpath, package_lock.KurtosisLockFilename)
+	existingLock, _, err := package_lock.ParseKurtosisLock(kurtosisLockFilepath)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the existing %v of the package at '%v'", package_lock.KurtosisLockFilename, packageDirpath)
+	}
+
+	kurtosisLock, err := NewPackageDependencyResolver().Resolve(kurtosisYaml.Dependencies, existingLock, shouldUpdate)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resolving the dependencies of package '%v'", kurtosisYaml.PackageName)
+	}
+	if err = package_lock.WriteKurtosisLock(kurtosisLockFilepath, kurtosisLock); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred writing the %v of package '%v'", package_lock.KurtosisLockFilename, kurtosisYaml.PackageName)
+	}
+	return kurtosisLock, nil
+}
@@ -0,0 +1,223 @@
+package package_dependency_resolver
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	libRepositoryName      = "github.com/author/lib"
+	utilsRepositoryName    = "github.com/author/utils"
+	libSubPackageName      = "github.com/author/lib/sub"
+	testFilePerms          = 0644
+	testDirPerms           = 0755
+	libVersionTag          = "v1.0.0"
+	annotatedLibVersionTag = "v1.0.1"
+	featureBranch          = "feature"
+)
+
+var noUpdate = func(_ string) bool { return false }
+
+type testRepository struct {
+	t          *testing.T
+	repository *git.Repository
+	dirpath    string
+}
+
+func newTestRepository(t *testing.T) *testRepository {
+	dirpath := t.TempDir()
+	repository, err := git.PlainInit(dirpath, isNotBareClone)
+	require.NoError(t, err)
+	return &testRepository{
+		t:          t,
+		repository: repository,
+		dirpath:    dirpath,
+	}
+}
+
+func (repo *testRepository) commitFiles(files map[string]string) plumbing.Hash {
+	workTree, err := repo.repository.Worktree()
+	require.NoError(repo.t, err)
+	for relativeFilepath, content := range files {
+		absoluteFilepath := filepath.Join(repo.dirpath, relativeFilepath)
+		require.NoError(repo.t, os.MkdirAll(filepath.Dir(absoluteFilepath), testDirPerms))
+		require.NoError(repo.t, os.WriteFile(absoluteFilepath, []byte(content), testFilePerms))
+		_, err = workTree.Add(relativeFilepath)
+		require.NoError(repo.t, err)
+	}
+	//nolint:exhaustruct
+	commit, err := workTree.Commit("commit", &git.CommitOptions{
+		Author: newTestSignature(),
+	})
+	require.NoError(repo.t, err)
+	return commit
+}
+
+func newTestSignature() *object.Signature {
+	return &object.Signature{
+		Name:  "test",
+		Email: "test@example.com",
+		When:  time.Unix(0, 0),
+	}
+}
+
+func newTestResolver(repositories map[string]*testRepository) *PackageDependencyResolver {
+	return newPackageDependencyResolverWithCloner(func(repositoryName string, _ string) (*git.Repository, error) {
+		repo, found := repositories[repositoryName]
+		if !found {
+			return nil, stacktrace.NewError("Repository '%v' doesn't exist", repositoryName)
+		}
+		// a fresh clone is at the default branch, whatever the previous resolutions checked out
+		require.NoError(repo.t, repo.repository.Storer.SetReference(plumbing.NewSymbolicReference(plumbing.HEAD, plumbing.Master)))
+		workTree, err := repo.repository.Worktree()
+		require.NoError(repo.t, err)
+		//nolint:exhaustruct
+		require.NoError(repo.t, workTree.Checkout(&git.CheckoutOptions{
+			Branch: plumbing.Master,
+			Force:  true,
+		}))
+		return repo.repository, nil
+	})
+}
+
+func kurtosisYaml(packageName string, dependencies string) string {
+	return "name: \"" + packageName + "\"\n" + dependencies
+}
+
+func TestResolve_ResolvesTagsBranchesAndTransitiveDependencies(t *testing.T) {
+	utilsRepo := newTestRepository(t)
+	utilsFirstCommit := utilsRepo.commitFiles(map[string]string{"kurtosis.yml": kurtosisYaml(utilsRepositoryName, "")})
+	require.NoError(t, utilsRepo.repository.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(featureBranch), utilsFirstCommit)))
+	utilsRepo.commitFiles(map[string]string{"main.star": "a = 1"})
+
+	libRepo := newTestRepository(t)
+	libTaggedCommit := libRepo.commitFiles(map[string]string{
+		"kurtosis.yml": kurtosisYaml(libRepositoryName, "dependencies:\n  \""+utilsRepositoryName+"\": \""+featureBranch+"\"\n"),
+		"main.star":    "b = 2",
+	})
+	_, err := libRepo.repository.CreateTag(libVersionTag, libTaggedCommit, nil)
+	require.NoError(t, err)
+	libRepo.commitFiles(map[string]string{"main.star": "b = 3"})
+
+	resolver := newTestResolver(map[string]*testRepository{libRepositoryName: libRepo, utilsRepositoryName: utilsRepo})
+	lock, err := resolver.Resolve(map[string]string{libRepositoryName: libVersionTag}, package_lock.NewKurtosisLock(), noUpdate)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{libRepositoryName, utilsRepositoryName}, lock.GetSortedPackageNames())
+	require.Equal(t, libVersionTag, lock.Dependencies[libRepositoryName].Version)
+	require.Equal(t, libTaggedCommit.String(), lock.Dependencies[libRepositoryName].Commit)
+	require.Equal(t, featureBranch, lock.Dependencies[utilsRepositoryName].Version)
+	require.Equal(t, utilsFirstCommit.String(), lock.Dependencies[utilsRepositoryName].Commit)
+
+	expectedUtilsHash, err := package_lock.ComputePackageContentHash(utilsRepo.dirpath)
+	require.NoError(t, err)
+	require.Equal(t, expectedUtilsHash, lock.Dependencies[utilsRepositoryName].Hash)
+}
+
+func TestResolve_ResolvesAnnotatedTagsCommitsAndDefaultBranch(t *testing.T) {
+	libRepo := newTestRepository(t)
+	firstCommit := libRepo.commitFiles(map[string]string{
+		"kurtosis.yml":     kurtosisYaml(libRepositoryName, ""),
+		"sub/kurtosis.yml": kurtosisYaml(libSubPackageName, ""),
+	})
+	//nolint:exhaustruct
+	_, err := libRepo.repository.CreateTag(annotatedLibVersionTag, firstCommit, &git.CreateTagOptions{
+		Tagger:  newTestSignature(),
+		Message: "release",
+	})
+	require.NoError(t, err)
+	headCommit := libRepo.commitFiles(map[string]string{"main.star": "c = 4"})
+
+	resolver := newTestResolver(map[string]*testRepository{libRepositoryName: libRepo})
+	lock, err := resolver.Resolve(map[string]string{libRepositoryName: annotatedLibVersionTag}, package_lock.NewKurtosisLock(), noUpdate)
+	require.NoError(t, err)
+	require.Equal(t, firstCommit.String(), lock.Dependencies[libRepositoryName].Commit)
+
+	lock, err = resolver.Resolve(map[string]string{libRepositoryName: firstCommit.String()}, package_lock.NewKurtosisLock(), noUpdate)
+	require.NoError(t, err)
+	require.Equal(t, firstCommit.String(), lock.Dependencies[libRepositoryName].Commit)
+
+	lock, err = resolver.Resolve(map[string]string{libSubPackageName: ""}, package_lock.NewKurtosisLock(), noUpdate)
+	require.NoError(t, err)
+	require.Equal(t, headCommit.String(), lock.Dependencies[libSubPackageName].Commit)
+}
+
+func TestResolve_KeepsLockedCommitsUnlessUpdated(t *testing.T) {
+	libRepo := newTestRepository(t)
+	firstCommit := libRepo.commitFiles(map[string]string{"kurtosis.yml": kurtosisYaml(libRepositoryName, "")})
+	headCommit := libRepo.commitFiles(map[string]string{"main.star": "d = 5"})
+
+	existingLock := package_lock.NewKurtosisLock()
+	existingLock.Dependencies[libRepositoryName] = package_lock.NewLockedDependency("", firstCommit.String(), "")
+	resolver := newTestResolver(map[string]*testRepository{libRepositoryName: libRepo})
+
+	lock, err := resolver.Resolve(map[string]string{libRepositoryName: ""}, existingLock, noUpdate)
+	require.NoError(t, err)
+	require.Equal(t, firstCommit.String(), lock.Dependencies[libRepositoryName].Commit)
+	require.NotEmpty(t, lock.Dependencies[libRepositoryName].Hash)
+
+	lock, err = resolver.Resolve(map[string]string{libRepositoryName: ""}, existingLock, func(_ string) bool { return true })
+	require.NoError(t, err)
+	require.Equal(t, headCommit.String(), lock.Dependencies[libRepositoryName].Commit)
+}
+
+func TestResolve_FailsOnConflictingVersions(t *testing.T) {
+	utilsRepo := newTestRepository(t)
+	utilsCommit := utilsRepo.commitFiles(map[string]string{"kurtosis.yml": kurtosisYaml(utilsRepositoryName, "")})
+	require.NoError(t, utilsRepo.repository.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName(featureBranch), utilsCommit)))
+
+	libRepo := newTestRepository(t)
+	libRepo.commitFiles(map[string]string{
+		"kurtosis.yml": kurtosisYaml(libRepositoryName, "dependencies:\n  \""+utilsRepositoryName+"\": \""+featureBranch+"\"\n"),
+	})
+
+	resolver := newTestResolver(map[string]*testRepository{libRepositoryName: libRepo, utilsRepositoryName: utilsRepo})
+	_, err := resolver.Resolve(map[string]string{libRepositoryName: "", utilsRepositoryName: ""}, package_lock.NewKurtosisLock(), noUpdate)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a package can only be used at one version")
+}
+
+func TestResolve_FailsOnPackagesOfSameRepositoryAtDifferentCommits(t *testing.T) {
+	libRepo := newTestRepository(t)
+	firstCommit := libRepo.commitFiles(map[string]string{
+		"kurtosis.yml":     kurtosisYaml(libRepositoryName, ""),
+		"sub/kurtosis.yml": kurtosisYaml(libSubPackageName, ""),
+	})
+	libRepo.commitFiles(map[string]string{"main.star": "e = 6"})
+
+	resolver := newTestResolver(map[string]*testRepository{libRepositoryName: libRepo})
+	_, err := resolver.Resolve(map[string]string{libRepositoryName: "", libSubPackageName: firstCommit.String()}, package_lock.NewKurtosisLock(), noUpdate)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "packages of the same repository have to be used at the same commit")
+}
+
+func TestResolve_FailsOnUnknownVersion(t *testing.T) {
+	libRepo := newTestRepository(t)
+	libRepo.commitFiles(map[string]string{"kurtosis.yml": kurtosisYaml(libRepositoryName, "")})
+
+	resolver := newTestResolver(map[string]*testRepository{libRepositoryName: libRepo})
+	_, err := resolver.Resolve(map[string]string{libRepositoryName: "does-not-exist"}, package_lock.NewKurtosisLock(), noUpdate)
+	require.Error(t, err)
+}
+
+func TestSplitPackageName(t *testing.T) {
+	repositoryName, relativePackagePath, err := splitPackageName(libSubPackageName)
+	require.NoError(t, err)
+	require.Equal(t, libRepositoryName, repositoryName)
+	require.Equal(t, "sub", relativePackagePath)
+
+	_, _, err = splitPackageName("gitlab.com/author/lib")
+	require.Error(t, err)
+	_, _, err = splitPackageName("github.com/author")
+	require.Error(t, err)
+	_, _, err = splitPackageName("github.com/author/lib/../other")
+	require.Error(t, err)
+}
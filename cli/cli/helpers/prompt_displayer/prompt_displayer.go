@@ -1,6 +1,7 @@
 package prompt_displayer
 
 import (
+	"errors"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/manifoldco/promptui"
 	"github.com/sirupsen/logrus"
@@ -40,6 +41,32 @@ func DisplayConfirmationPromptAndGetBooleanResult(label string, defaultValue str
 	return userInput, nil
 }
 
+// DisplayYesNoPromptAndGetBooleanResult asks the user to answer yes or no to the question, no being the default
+func DisplayYesNoPromptAndGetBooleanResult(label string) (bool, error) {
+	prompt := promptui.Prompt{
+		Label:       label,
+		Default:     "",
+		AllowEdit:   false,
+		Validate:    nil,
+		Mask:        0,
+		HideEntered: false,
+		Templates:   nil,
+		IsConfirm:   true,
+		IsVimMode:   false,
+		Pointer:     nil,
+		Stdin:       nil,
+		Stdout:      nil,
+	}
+
+	if _, err := prompt.Run(); err != nil {
+		if errors.Is(err, promptui.ErrAbort) {
+			return false, nil
+		}
+		return false, stacktrace.Propagate(err, "An error occurred displaying the prompt")
+	}
+	return true, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -0,0 +1,256 @@
+package run_report
+
+import (
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+)
+
+type InstructionStatus string
+
+const (
+	InstructionStatus_Succeeded InstructionStatus = "succeeded"
+	InstructionStatus_Failed    InstructionStatus = "failed"
+	InstructionStatus_Skipped   InstructionStatus = "skipped"
+	// InstructionStatus_Planned is used for instructions that were only printed, i.e. when running with --dry-run
+	InstructionStatus_Planned InstructionStatus = "planned"
+)
+
+// RunReport is the structured summary of a `kurtosis run`, meant to be consumed by CI systems
+type RunReport struct {
+	ScriptOrPackage string `json:"script_or_package"`
+
+	EnclaveName string `json:"enclave_name"`
+
+	IsDryRun bool `json:"is_dry_run"`
+
+	IsRunSuccessful bool `json:"is_run_successful"`
+
+	StartedAt time.Time `json:"started_at"`
+
+	DurationMs int64 `json:"duration_ms"`
+
+	Instructions []*InstructionReport `json:"instructions"`
+
+	// Warnings and errors that were not raised while executing a specific instruction, like interpretation and
+	// validation errors
+	Warnings []string `json:"warnings"`
+	Errors   []string `json:"errors"`
+
+	// Information about the run that isn't the output of an instruction, like the services pruned at the end of the run
+	Infos []string `json:"infos"`
+
+	SerializedOutput string `json:"serialized_output,omitempty"`
+}
+
+type InstructionReport struct {
+	Index int `json:"index"`
+
+	InstructionName string `json:"instruction_name"`
+
+	Position *InstructionPosition `json:"position"`
+
+	Arguments []*InstructionArgument `json:"arguments"`
+
+	ExecutableInstruction string `json:"executable_instruction"`
+
+	Status InstructionStatus `json:"status"`
+
+	// True when the instruction was not executed because it had already been executed in the enclave by a previous run
+	IsSkipped bool `json:"is_skipped"`
+
+	SerializedResult string `json:"serialized_result,omitempty"`
+
+	Error string `json:"error,omitempty"`
+
+	Warnings []string `json:"warnings"`
+
+	// Measured by the enclave when it sends the metrics of the instruction. Otherwise, for instance for dry runs, it is
+	// measured client side, between the moment the instruction is received and the moment its result (or the next line
+	// of the run) is received
+	DurationMs int64 `json:"duration_ms"`
+
+	// Time spent pulling the container images this instruction was the first one to require
+	ImagePullDurationMs int64 `json:"image_pull_duration_ms"`
+
+	NumRetries uint32 `json:"num_retries"`
+}
+
+type InstructionPosition struct {
+	Filename string `json:"filename"`
+	Line     int32  `json:"line"`
+	Column   int32  `json:"column"`
+}
+
+type InstructionArgument struct {
+	// Empty for positional arguments
+	Name string `json:"name,omitempty"`
+
+	SerializedValue string `json:"serialized_value"`
+}
+
+// RunReportBuilder accumulates the response lines of a Starlark run into a RunReport
+type RunReportBuilder struct {
+	report *RunReport
+
+	currentInstruction           *InstructionReport
+	currentInstructionStartedAt  time.Time
+	hasCurrentInstructionMetrics bool
+}
+
+func NewRunReportBuilder(scriptOrPackage string, enclaveName string, isDryRun bool, startedAt time.Time) *RunReportBuilder {
+	return &RunReportBuilder{
+		report: &RunReport{
+			ScriptOrPackage:  scriptOrPackage,
+			EnclaveName:      enclaveName,
+			IsDryRun:         isDryRun,
+			IsRunSuccessful:  false, // defaults to false such that an interrupted run is reported as failed
+			StartedAt:        startedAt,
+			DurationMs:       0,
+			Instructions:     []*InstructionReport{},
+			Warnings:         []string{},
+			Errors:           []string{},
+			Infos:            []string{},
+			SerializedOutput: "",
+		},
+		currentInstruction:           nil,
+		currentInstructionStartedAt:  time.Time{},
+		hasCurrentInstructionMetrics: false,
+	}
+}
+
+func (builder *RunReportBuilder) AddResponseLine(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, receivedAt time.Time) {
+	// warnings are attached to the instruction being executed, any other line means it's done executing
+	if warning := responseLine.GetWarning(); warning != nil {
+		if builder.currentInstruction != nil {
+			builder.currentInstruction.Warnings = append(builder.currentInstruction.Warnings, warning.GetWarningMessage())
+		} else {
+			builder.report.Warnings = append(builder.report.Warnings, warning.GetWarningMessage())
+		}
+		return
+	}
+
+	// metrics are sent right before the result or the error of the instruction
+	if instructionMetrics := responseLine.GetInstructionMetrics(); instructionMetrics != nil {
+		if builder.currentInstruction != nil {
+			builder.currentInstruction.DurationMs = int64(instructionMetrics.GetDurationMs())
+			builder.currentInstruction.ImagePullDurationMs = int64(instructionMetrics.GetImagePullDurationMs())
+			builder.currentInstruction.NumRetries = instructionMetrics.GetNumRetries()
+			builder.hasCurrentInstructionMetrics = true
+		}
+		return
+	}
+
+	if instructionResult := responseLine.GetInstructionResult(); instructionResult != nil {
+		if builder.currentInstruction != nil {
+			builder.currentInstruction.SerializedResult = instructionResult.GetSerializedInstructionResult()
+		}
+		builder.completeCurrentInstruction(receivedAt)
+		return
+	}
+
+	if starlarkError := responseLine.GetError(); starlarkError != nil {
+		errorMessage := getErrorMessage(starlarkError)
+		if builder.currentInstruction != nil && starlarkError.GetExecutionError() != nil {
+			builder.currentInstruction.Error = errorMessage
+			builder.currentInstruction.Status = InstructionStatus_Failed
+		} else {
+			builder.report.Errors = append(builder.report.Errors, errorMessage)
+		}
+		builder.completeCurrentInstruction(receivedAt)
+		return
+	}
+
+	builder.completeCurrentInstruction(receivedAt)
+
+	if info := responseLine.GetInfo(); info != nil {
+		builder.report.Infos = append(builder.report.Infos, info.GetInfoMessage())
+		return
+	}
+
+	if instruction := responseLine.GetInstruction(); instruction != nil {
+		builder.startInstruction(instruction, receivedAt)
+		return
+	}
+
+	if runFinishedEvent := responseLine.GetRunFinishedEvent(); runFinishedEvent != nil {
+		builder.report.IsRunSuccessful = runFinishedEvent.GetIsRunSuccessful()
+		builder.report.SerializedOutput = runFinishedEvent.GetSerializedOutput()
+	}
+}
+
+// Build returns the report of the run. It can be called before the run finished, in which case the instruction being
+// executed is reported as failed
+func (builder *RunReportBuilder) Build(finishedAt time.Time) *RunReport {
+	if builder.currentInstruction != nil {
+		builder.currentInstruction.Status = InstructionStatus_Failed
+		builder.completeCurrentInstruction(finishedAt)
+	}
+	builder.report.DurationMs = finishedAt.Sub(builder.report.StartedAt).Milliseconds()
+	return builder.report
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func (builder *RunReportBuilder) startInstruction(instruction *kurtosis_core_rpc_api_bindings.StarlarkInstruction, receivedAt time.Time) {
+	arguments := []*InstructionArgument{}
+	for _, argument := range instruction.GetArguments() {
+		arguments = append(arguments, &InstructionArgument{
+			Name:            argument.GetArgName(),
+			SerializedValue: argument.GetSerializedArgValue(),
+		})
+	}
+
+	status := InstructionStatus_Succeeded
+	if instruction.GetIsSkipped() {
+		status = InstructionStatus_Skipped
+	} else if builder.report.IsDryRun {
+		status = InstructionStatus_Planned
+	}
+
+	builder.currentInstruction = &InstructionReport{
+		Index:           len(builder.report.Instructions),
+		InstructionName: instruction.GetInstructionName(),
+		Position: &InstructionPosition{
+			Filename: instruction.GetPosition().GetFilename(),
+			Line:     instruction.GetPosition().GetLine(),
+			Column:   instruction.GetPosition().GetColumn(),
+		},
+		Arguments:             arguments,
+		ExecutableInstruction: instruction.GetExecutableInstruction(),
+		Status:                status,
+		IsSkipped:             instruction.GetIsSkipped(),
+		SerializedResult:      "",
+		Error:                 "",
+		Warnings:              []string{},
+		DurationMs:            0,
+		ImagePullDurationMs:   0,
+		NumRetries:            0,
+	}
+	builder.currentInstructionStartedAt = receivedAt
+	builder.hasCurrentInstructionMetrics = false
+	builder.report.Instructions = append(builder.report.Instructions, builder.currentInstruction)
+}
+
+func (builder *RunReportBuilder) completeCurrentInstruction(completedAt time.Time) {
+	if builder.currentInstruction == nil {
+		return
+	}
+	if !builder.hasCurrentInstructionMetrics {
+		builder.currentInstruction.DurationMs = completedAt.Sub(builder.currentInstructionStartedAt).Milliseconds()
+	}
+	builder.currentInstruction = nil
+}
+
+func getErrorMessage(starlarkError *kurtosis_core_rpc_api_bindings.StarlarkError) string {
+	if interpretationError := starlarkError.GetInterpretationError(); interpretationError != nil {
+		return interpretationError.GetErrorMessage()
+	}
+	if validationError := starlarkError.GetValidationError(); validationError != nil {
+		return validationError.GetErrorMessage()
+	}
+	return starlarkError.GetExecutionError().GetErrorMessage()
+}
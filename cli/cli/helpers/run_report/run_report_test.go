@@ -0,0 +1,183 @@
+package run_report
+
+import (
+	"encoding/xml"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	scriptName  = "main.star"
+	enclaveName = "test-enclave"
+
+	isDryRun      = true
+	isExecutedRun = false
+	isSkipped     = true
+	isNotSkipped  = false
+)
+
+var startedAt = time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+
+func testInstruction(name string, line int32, isSkipped bool) *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+	return binding_constructors.NewStarlarkRunResponseLineFromInstruction(binding_constructors.NewStarlarkInstruction(
+		binding_constructors.NewStarlarkInstructionPosition(scriptName, line, 5),
+		name,
+		name+`(name="foo")`,
+		[]*kurtosis_core_rpc_api_bindings.StarlarkInstructionArg{
+			binding_constructors.NewStarlarkInstructionKwarg(`"foo"`, "name", true),
+		},
+		isSkipped,
+	))
+}
+
+func at(milliseconds int) time.Time {
+	return startedAt.Add(time.Duration(milliseconds) * time.Millisecond)
+}
+
+func TestRunReportBuilder_SuccessfulRun(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isExecutedRun, startedAt)
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromWarning("deprecated argument"), at(0))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo("Starting execution", 0, 2), at(10))
+	builder.AddResponseLine(testInstruction("add_service", 3, isNotSkipped), at(20))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromWarning("slow image pull"), at(100))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult("Service 'foo' added"), at(1020))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo("Instruction 2", 1, 2), at(1030))
+	builder.AddResponseLine(testInstruction("print", 4, isSkipped), at(1040))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(`{"foo": "bar"}`), at(1045))
+	report := builder.Build(at(1050))
+
+	require.True(t, report.IsRunSuccessful)
+	require.Equal(t, int64(1050), report.DurationMs)
+	require.Equal(t, `{"foo": "bar"}`, report.SerializedOutput)
+	require.Equal(t, []string{"deprecated argument"}, report.Warnings)
+	require.Empty(t, report.Errors)
+	require.Len(t, report.Instructions, 2)
+
+	addService := report.Instructions[0]
+	require.Equal(t, 0, addService.Index)
+	require.Equal(t, "add_service", addService.InstructionName)
+	require.Equal(t, &InstructionPosition{Filename: scriptName, Line: 3, Column: 5}, addService.Position)
+	require.Equal(t, []*InstructionArgument{{Name: "name", SerializedValue: `"foo"`}}, addService.Arguments)
+	require.Equal(t, InstructionStatus_Succeeded, addService.Status)
+	require.Equal(t, "Service 'foo' added", addService.SerializedResult)
+	require.Equal(t, []string{"slow image pull"}, addService.Warnings)
+	require.Equal(t, int64(1000), addService.DurationMs)
+
+	printInstruction := report.Instructions[1]
+	require.Equal(t, 1, printInstruction.Index)
+	require.True(t, printInstruction.IsSkipped)
+	require.Equal(t, InstructionStatus_Skipped, printInstruction.Status)
+	require.Equal(t, int64(5), printInstruction.DurationMs)
+}
+
+func TestRunReportBuilder_FailedRun(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isExecutedRun, startedAt)
+	builder.AddResponseLine(testInstruction("exec", 7, isNotSkipped), at(0))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromExecutionError(binding_constructors.NewStarlarkExecutionError("exit code 1")), at(300))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent(), at(310))
+	report := builder.Build(at(310))
+
+	require.False(t, report.IsRunSuccessful)
+	require.Empty(t, report.Errors)
+	require.Len(t, report.Instructions, 1)
+	require.Equal(t, InstructionStatus_Failed, report.Instructions[0].Status)
+	require.Equal(t, "exit code 1", report.Instructions[0].Error)
+	require.Equal(t, int64(300), report.Instructions[0].DurationMs)
+}
+
+func TestRunReportBuilder_InterpretationError(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isExecutedRun, startedAt)
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(binding_constructors.NewStarlarkInterpretationError("syntax error")), at(0))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent(), at(1))
+	report := builder.Build(at(1))
+
+	require.False(t, report.IsRunSuccessful)
+	require.Empty(t, report.Instructions)
+	require.Equal(t, []string{"syntax error"}, report.Errors)
+}
+
+func TestRunReportBuilder_InterruptedRun(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isExecutedRun, startedAt)
+	builder.AddResponseLine(testInstruction("wait", 2, isNotSkipped), at(0))
+	report := builder.Build(at(500))
+
+	require.False(t, report.IsRunSuccessful)
+	require.Equal(t, InstructionStatus_Failed, report.Instructions[0].Status)
+	require.Equal(t, int64(500), report.Instructions[0].DurationMs)
+}
+
+func TestRunReportBuilder_DryRun(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isDryRun, startedAt)
+	builder.AddResponseLine(testInstruction("add_service", 3, isNotSkipped), at(0))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(""), at(1))
+	report := builder.Build(at(1))
+
+	require.True(t, report.IsDryRun)
+	require.Equal(t, InstructionStatus_Planned, report.Instructions[0].Status)
+}
+
+func TestRunReportBuilder_UsesInstructionMetrics(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isExecutedRun, startedAt)
+	builder.AddResponseLine(testInstruction("add_service", 3, isNotSkipped), at(0))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionMetrics(binding_constructors.NewStarlarkInstructionMetrics(0, 900, 900, 4000, 2)), at(1000))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult("Service 'foo' added"), at(1000))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(""), at(1000))
+	report := builder.Build(at(1000))
+
+	require.Len(t, report.Instructions, 1)
+	require.Equal(t, InstructionStatus_Succeeded, report.Instructions[0].Status)
+	require.Equal(t, "Service 'foo' added", report.Instructions[0].SerializedResult)
+	require.Equal(t, int64(900), report.Instructions[0].DurationMs)
+	require.Equal(t, int64(4000), report.Instructions[0].ImagePullDurationMs)
+	require.Equal(t, uint32(2), report.Instructions[0].NumRetries)
+}
+
+func TestRunReportBuilder_InfosAreNotAttachedToInstructions(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isExecutedRun, startedAt)
+	builder.AddResponseLine(testInstruction("add_service", 3, isNotSkipped), at(0))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult("Service 'foo' added"), at(1000))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInfo("Service 'bar' pruned"), at(1010))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromRunSuccessEvent(""), at(1020))
+	report := builder.Build(at(1020))
+
+	require.True(t, report.IsRunSuccessful)
+	require.Equal(t, []string{"Service 'bar' pruned"}, report.Infos)
+	require.Len(t, report.Instructions, 1)
+	require.Equal(t, "Service 'foo' added", report.Instructions[0].SerializedResult)
+}
+
+func TestSerializeToJunitXml(t *testing.T) {
+	builder := NewRunReportBuilder(scriptName, enclaveName, isExecutedRun, startedAt)
+	builder.AddResponseLine(testInstruction("add_service", 3, isNotSkipped), at(0))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult("Service 'foo' added"), at(1500))
+	builder.AddResponseLine(testInstruction("print", 4, isSkipped), at(1500))
+	builder.AddResponseLine(testInstruction("exec", 5, isNotSkipped), at(1500))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromExecutionError(binding_constructors.NewStarlarkExecutionError("exit code 1")), at(2000))
+	builder.AddResponseLine(binding_constructors.NewStarlarkRunResponseLineFromRunFailureEvent(), at(2000))
+	report := builder.Build(at(2000))
+
+	serializedReport, err := SerializeToJunitXml(report)
+	require.NoError(t, err)
+
+	var testSuites junitTestSuites
+	require.NoError(t, xml.Unmarshal(serializedReport, &testSuites))
+	require.Len(t, testSuites.TestSuites, 1)
+	testSuite := testSuites.TestSuites[0]
+	require.Equal(t, scriptName, testSuite.Name)
+	require.Equal(t, 3, testSuite.Tests)
+	require.Equal(t, 1, testSuite.Failures)
+	require.Equal(t, 1, testSuite.Skipped)
+	require.Equal(t, "2.000", testSuite.Time)
+
+	require.Equal(t, "0 - add_service", testSuite.TestCases[0].Name)
+	require.Equal(t, "main.star:3:5", testSuite.TestCases[0].ClassName)
+	require.Equal(t, "1.500", testSuite.TestCases[0].Time)
+	require.Equal(t, "Service 'foo' added", testSuite.TestCases[0].SystemOut)
+	require.Nil(t, testSuite.TestCases[0].Failure)
+	require.NotNil(t, testSuite.TestCases[1].Skipped)
+	require.Equal(t, "exit code 1", testSuite.TestCases[2].Failure.Message)
+}
@@ -0,0 +1,164 @@
+package run_report
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	junitReportFileExtension = ".xml"
+
+	reportFilePerms = 0644
+
+	reportIndent = "  "
+
+	junitTestCaseNameFormat      = "%d - %s"
+	junitTestCaseClassNameFormat = "%s:%d:%d"
+	junitRunErrorsTestCaseName   = "run"
+	junitSecondsFormat           = "%.3f"
+	junitTimestampFormat         = "2006-01-02T15:04:05"
+	junitLinesSeparator          = "\n"
+	junitFailureType             = "error"
+	junitSkippedMessage          = "Instruction already executed in the enclave"
+	junitPlannedMessage          = "Instruction not executed as the run was a dry run"
+	millisecondsInSecond         = 1000
+)
+
+type junitTestSuites struct {
+	XMLName    xml.Name          `xml:"testsuites"`
+	TestSuites []*junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string           `xml:"name,attr"`
+	Tests     int              `xml:"tests,attr"`
+	Failures  int              `xml:"failures,attr"`
+	Skipped   int              `xml:"skipped,attr"`
+	Time      string           `xml:"time,attr"`
+	Timestamp string           `xml:"timestamp,attr"`
+	TestCases []*junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+	SystemErr string        `xml:"system-err,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Content string `xml:",chardata"`
+}
+
+// WriteToFile writes the report to the provided filepath, as JUnit XML if the file has a .xml extension and as
+// JSON otherwise
+func WriteToFile(report *RunReport, reportFilepath string) error {
+	var serializedReport []byte
+	var err error
+	if strings.EqualFold(filepath.Ext(reportFilepath), junitReportFileExtension) {
+		serializedReport, err = SerializeToJunitXml(report)
+	} else {
+		serializedReport, err = SerializeToJson(report)
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the run report for file '%s'", reportFilepath)
+	}
+	if err = os.WriteFile(reportFilepath, serializedReport, reportFilePerms); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the run report to '%s'", reportFilepath)
+	}
+	return nil
+}
+
+func SerializeToJson(report *RunReport) ([]byte, error) {
+	serializedReport, err := json.MarshalIndent(report, "", reportIndent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the run report to JSON")
+	}
+	return serializedReport, nil
+}
+
+// SerializeToJunitXml maps the run to a single test suite, each instruction being a test case. Errors that are not
+// attached to an instruction, like interpretation errors, are reported as an extra failed test case
+func SerializeToJunitXml(report *RunReport) ([]byte, error) {
+	testSuite := &junitTestSuite{
+		Name:      report.ScriptOrPackage,
+		Tests:     0,
+		Failures:  0,
+		Skipped:   0,
+		Time:      formatJunitSeconds(report.DurationMs),
+		Timestamp: report.StartedAt.Format(junitTimestampFormat),
+		TestCases: []*junitTestCase{},
+	}
+
+	for _, instruction := range report.Instructions {
+		testCase := &junitTestCase{
+			Name:      fmt.Sprintf(junitTestCaseNameFormat, instruction.Index, instruction.InstructionName),
+			ClassName: fmt.Sprintf(junitTestCaseClassNameFormat, instruction.Position.Filename, instruction.Position.Line, instruction.Position.Column),
+			Time:      formatJunitSeconds(instruction.DurationMs),
+			Failure:   nil,
+			Skipped:   nil,
+			SystemOut: instruction.SerializedResult,
+			SystemErr: strings.Join(instruction.Warnings, junitLinesSeparator),
+		}
+		switch instruction.Status {
+		case InstructionStatus_Failed:
+			testCase.Failure = &junitMessage{
+				Message: instruction.Error,
+				Type:    junitFailureType,
+				Content: instruction.ExecutableInstruction,
+			}
+			testSuite.Failures += 1
+		case InstructionStatus_Skipped:
+			testCase.Skipped = &junitMessage{Message: junitSkippedMessage, Type: "", Content: ""}
+			testSuite.Skipped += 1
+		case InstructionStatus_Planned:
+			testCase.Skipped = &junitMessage{Message: junitPlannedMessage, Type: "", Content: ""}
+			testSuite.Skipped += 1
+		case InstructionStatus_Succeeded:
+		}
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+	}
+
+	if len(report.Errors) > 0 {
+		runErrors := strings.Join(report.Errors, junitLinesSeparator)
+		testSuite.TestCases = append(testSuite.TestCases, &junitTestCase{
+			Name:      junitRunErrorsTestCaseName,
+			ClassName: report.ScriptOrPackage,
+			Time:      formatJunitSeconds(0),
+			Failure: &junitMessage{
+				Message: runErrors,
+				Type:    junitFailureType,
+				Content: runErrors,
+			},
+			Skipped:   nil,
+			SystemOut: "",
+			SystemErr: strings.Join(report.Warnings, junitLinesSeparator),
+		})
+		testSuite.Failures += 1
+	}
+	testSuite.Tests = len(testSuite.TestCases)
+
+	serializedReport, err := xml.MarshalIndent(&junitTestSuites{
+		XMLName:    xml.Name{Space: "", Local: ""},
+		TestSuites: []*junitTestSuite{testSuite},
+	}, "", reportIndent)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the run report to JUnit XML")
+	}
+	return append([]byte(xml.Header), serializedReport...), nil
+}
+
+func formatJunitSeconds(durationMs int64) string {
+	return fmt.Sprintf(junitSecondsFormat, float64(durationMs)/millisecondsInSecond)
+}
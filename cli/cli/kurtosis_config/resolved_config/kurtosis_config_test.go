@@ -3,10 +3,11 @@ package resolved_config
 import (
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/config_version"
 	"github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects"
-	v2 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v2"
+	v3 "github.com/kurtosis-tech/kurtosis/cli/cli/kurtosis_config/overrides_objects/v3"
 	"github.com/stretchr/testify/require"
 	"sort"
 	"testing"
+	"time"
 )
 
 /*
@@ -50,11 +51,12 @@ func TestNewKurtosisConfigFromRequiredFields_MetricsElectionIsSent(t *testing.T)
 }
 
 func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
-	_, err := NewKurtosisConfigFromOverrides(&v2.KurtosisConfigV2{
+	_, err := NewKurtosisConfigFromOverrides(&v3.KurtosisConfigV3{
 		ConfigVersion:     0,
 		ShouldSendMetrics: nil,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		LogRetention:      nil,
 	})
 	// You can not initialize a Kurtosis config with empty overrides - it needs at least `ShouldSendMetrics`
 	require.Error(t, err)
@@ -63,11 +65,12 @@ func TestNewKurtosisConfigEmptyOverrides(t *testing.T) {
 func TestNewKurtosisConfigJustMetrics(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v3.KurtosisConfigV3{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
 		CloudConfig:       nil,
+		LogRetention:      nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	// You can not initialize a Kurtosis config with empty originalOverrides - it needs at least `ShouldSendMetrics`
@@ -94,15 +97,16 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	version := config_version.ConfigVersion_v0
 	shouldSendMetrics := true
 	apiUrl := "test.com"
-	originalOverrides := v2.KurtosisConfigV2{
+	originalOverrides := v3.KurtosisConfigV3{
 		ConfigVersion:     version,
 		ShouldSendMetrics: &shouldSendMetrics,
 		KurtosisClusters:  nil,
-		CloudConfig: &v2.KurtosisCloudConfigV2{
+		CloudConfig: &v3.KurtosisCloudConfigV3{
 			ApiUrl:           &apiUrl,
 			Port:             nil,
 			CertificateChain: nil,
 		},
+		LogRetention: nil,
 	}
 	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
 	require.NoError(t, err)
@@ -112,3 +116,53 @@ func TestCloudConfigOverridesApiUrl(t *testing.T) {
 	require.Nil(t, overrides.CloudConfig.Port)
 	require.Nil(t, overrides.CloudConfig.CertificateChain)
 }
+
+func TestLogRetentionConfigDefaults(t *testing.T) {
+	config, err := NewKurtosisConfigFromRequiredFields(false)
+	require.NoError(t, err)
+
+	logRetentionConfig := config.GetLogRetentionConfig()
+	require.Equal(t, defaultLogRetentionPeriod, logRetentionConfig.Period)
+	require.Equal(t, defaultLogRetentionMaxSizeInMegabytes, logRetentionConfig.MaxSizeInMegabytes)
+}
+
+func TestLogRetentionConfigOverrides(t *testing.T) {
+	shouldSendMetrics := true
+	period := "72h"
+	maxSizeInMegabytes := uint64(500)
+	originalOverrides := v3.KurtosisConfigV3{
+		ConfigVersion:     config_version.ConfigVersion_v0,
+		ShouldSendMetrics: &shouldSendMetrics,
+		KurtosisClusters:  nil,
+		CloudConfig:       nil,
+		LogRetention: &v3.LogRetentionConfigV3{
+			Period:             &period,
+			MaxSizeInMegabytes: &maxSizeInMegabytes,
+		},
+	}
+	config, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+	require.NoError(t, err)
+
+	logRetentionConfig := config.GetLogRetentionConfig()
+	require.Equal(t, 72*time.Hour, logRetentionConfig.Period)
+	require.Equal(t, maxSizeInMegabytes, logRetentionConfig.MaxSizeInMegabytes)
+}
+
+func TestLogRetentionConfigInvalidPeriod(t *testing.T) {
+	shouldSendMetrics := true
+	for _, period := range []string{"two weeks", "0s", "-1h"} {
+		invalidPeriod := period
+		originalOverrides := v3.KurtosisConfigV3{
+			ConfigVersion:     config_version.ConfigVersion_v0,
+			ShouldSendMetrics: &shouldSendMetrics,
+			KurtosisClusters:  nil,
+			CloudConfig:       nil,
+			LogRetention: &v3.LogRetentionConfigV3{
+				Period:             &invalidPeriod,
+				MaxSizeInMegabytes: nil,
+			},
+		}
+		_, err := NewKurtosisConfigFromOverrides(&originalOverrides)
+		require.Error(t, err, "Expected an error for log retention period '%v'", invalidPeriod)
+	}
+}
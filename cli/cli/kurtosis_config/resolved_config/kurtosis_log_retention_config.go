@@ -0,0 +1,8 @@
+package resolved_config
+
+import "time"
+
+type KurtosisLogRetentionConfig struct {
+	Period             time.Duration
+	MaxSizeInMegabytes uint64
+}
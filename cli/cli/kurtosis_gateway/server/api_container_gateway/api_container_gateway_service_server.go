@@ -164,6 +164,15 @@ func (service *ApiContainerGatewayServiceServer) WaitForHttpPostEndpointAvailabi
 	return remoteApiContainerResponse, nil
 }
 
+func (service *ApiContainerGatewayServiceServer) HttpRequestService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs) (*kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.HttpRequestService(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+
+	return remoteApiContainerResponse, nil
+}
+
 func (service *ApiContainerGatewayServiceServer) UploadFilesArtifact(server kurtosis_core_rpc_api_bindings.ApiContainerService_UploadFilesArtifactServer) error {
 	client, err := service.remoteApiContainerClient.UploadFilesArtifact(server.Context())
 	if err != nil {
@@ -222,6 +231,115 @@ func (service *ApiContainerGatewayServiceServer) UploadStarlarkPackage(server ku
 	return nil
 }
 
+func (service *ApiContainerGatewayServiceServer) GetEnclaveSnapshot(args *kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_GetEnclaveSnapshotServer) error {
+	client, err := service.remoteApiContainerClient.GetEnclaveSnapshot(server.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := forwardDataChunkStream(client, server); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from GetEnclaveSnapshot on gateway")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RestoreEnclaveSnapshot(server kurtosis_core_rpc_api_bindings.ApiContainerService_RestoreEnclaveSnapshotServer) error {
+	client, err := service.remoteApiContainerClient.RestoreEnclaveSnapshot(server.Context())
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := forwardDataChunkStreamWithClose[*kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse](server, client); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from RestoreEnclaveSnapshot on gateway")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) Repartition(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RepartitionArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.Repartition(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RunStarlarkPackageTests(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RunStarlarkPackageTests(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetStarlarkRunPlan(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkRunPlan(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) GetStarlarkRunDiff(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.GetStarlarkRunDiff(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ResumeStarlarkRun(args *kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs, streamToWriteTo kurtosis_core_rpc_api_bindings.ApiContainerService_ResumeStarlarkRunServer) error {
+	logrus.Debugf("Resuming Starlark run from response line '%v'", args.GetStartingLineIndex())
+	streamToReadFrom, err := service.remoteApiContainerClient.ResumeStarlarkRun(streamToWriteTo.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred resuming the Starlark run")
+	}
+	if err := common.ForwardKurtosisExecutionStream[kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine](streamToReadFrom, streamToWriteTo); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from Kurtosis core back to the user while resuming the Starlark run")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) CancelStarlarkRun(ctx context.Context, args *kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs) (*emptypb.Empty, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.CancelStarlarkRun(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) ListPersistentDirectories(ctx context.Context, args *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.ListPersistentDirectories(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) RemovePersistentDirectories(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs) (*kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.RemovePersistentDirectories(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
+func (service *ApiContainerGatewayServiceServer) DownloadPersistentDirectory(args *kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_DownloadPersistentDirectoryServer) error {
+	client, err := service.remoteApiContainerClient.DownloadPersistentDirectory(server.Context(), args)
+	if err != nil {
+		return stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	if err := forwardDataChunkStream(client, server); err != nil {
+		return stacktrace.Propagate(err, "Error forwarding stream from DownloadPersistentDirectory on gateway")
+	}
+	return nil
+}
+
+func (service *ApiContainerGatewayServiceServer) StoreFilesArtifactFromPersistentDirectory(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs) (*kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse, error) {
+	remoteApiContainerResponse, err := service.remoteApiContainerClient.StoreFilesArtifactFromPersistentDirectory(ctx, args)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, errorCallingRemoteApiContainerFromGateway)
+	}
+	return remoteApiContainerResponse, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -178,6 +178,18 @@ func (service *EngineGatewayServiceServer) StopEnclave(ctx context.Context, args
 	return &emptypb.Empty{}, nil
 }
 
+func (service *EngineGatewayServiceServer) StartEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.StartEnclaveArgs) (*emptypb.Empty, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	if _, err := remoteEngineClient.StartEnclave(ctx, args); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling remote engine to start enclave '%v'", args.EnclaveIdentifier)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (service *EngineGatewayServiceServer) DestroyEnclave(ctx context.Context, args *kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs) (*emptypb.Empty, error) {
 	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
 	if err != nil {
@@ -221,6 +233,18 @@ func (service *EngineGatewayServiceServer) GetEngineInfo(ctx context.Context, em
 	return remoteEngineResponse, nil
 }
 
+func (service *EngineGatewayServiceServer) GetLogsStorageUsage(ctx context.Context, emptyArgs *emptypb.Empty) (*kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse, error) {
+	remoteEngineClient, err := service.engineClientSupplier.GetEngineClient()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Expected to be able to get a client for a live Kurtosis engine, instead a non nil error was returned")
+	}
+	remoteEngineResponse, err := remoteEngineClient.GetLogsStorageUsage(ctx, emptyArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs storage usage through the remote engine")
+	}
+	return remoteEngineResponse, nil
+}
+
 func (service *EngineGatewayServiceServer) GetServiceLogs(
 	args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs,
 	streamToWriteTo kurtosis_engine_rpc_api_bindings.EngineService_GetServiceLogsServer,
@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions/implementations/fluentbit"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/networking_sidecar_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/user_services_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
@@ -21,7 +22,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
 	"github.com/kurtosis-tech/stacktrace"
@@ -304,6 +307,33 @@ func (backend *DockerKurtosisBackend) CopyFilesFromUserService(
 	return user_service_functions.CopyFilesFromUserService(ctx, enclaveUuid, serviceUuid, srcPathOnContainer, output, backend.dockerManager)
 }
 
+func (backend *DockerKurtosisBackend) GetPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error) {
+	return user_service_functions.GetPersistentDirectories(ctx, enclaveUuid, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) DestroyPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKeys map[service_directory.DirectoryPersistentKey]bool,
+) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error) {
+	return user_service_functions.DestroyPersistentDirectories(ctx, enclaveUuid, serviceUuid, persistentKeys, backend.objAttrsProvider, backend.dockerManager)
+}
+
+// CopyFilesFromPersistentDirectory writes the content of the persistent directory as a tar stream to the output
+func (backend *DockerKurtosisBackend) CopyFilesFromPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+) error {
+	return user_service_functions.CopyFilesFromPersistentDirectory(ctx, enclaveUuid, serviceUuid, persistentKey, output, backend.objAttrsProvider, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -348,6 +378,50 @@ func (backend *DockerKurtosisBackend) DestroyUserServices(
 	return successfullyDestroyedServices, failedServices, nil
 }
 
+func (backend *DockerKurtosisBackend) CreateNetworkingSidecar(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error) {
+	networkingSidecar, err := networking_sidecar_functions.CreateNetworkingSidecar(ctx, enclaveUuid, serviceUuid, backend.objAttrsProvider, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the networking sidecar of service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	return networkingSidecar, nil
+}
+
+func (backend *DockerKurtosisBackend) GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	networkingSidecars, err := networking_sidecar_functions.GetNetworkingSidecars(ctx, enclaveUuid, filters, backend.dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecars of enclave '%v'", enclaveUuid)
+	}
+	return networkingSidecars, nil
+}
+
+func (backend *DockerKurtosisBackend) RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return networking_sidecar_functions.RunNetworkingSidecarExecCommands(ctx, enclaveUuid, networkingSidecarCommands, backend.dockerManager)
+}
+
+func (backend *DockerKurtosisBackend) DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return networking_sidecar_functions.DestroyNetworkingSidecars(ctx, enclaveUuid, filters, backend.dockerManager)
+}
+
 func (backend *DockerKurtosisBackend) CreateLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
 	logsAggregatorContainer := vector.NewVectorLogsAggregatorContainer() //Declaring the implementation
 
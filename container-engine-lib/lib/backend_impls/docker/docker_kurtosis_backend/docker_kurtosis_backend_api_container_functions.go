@@ -39,8 +39,11 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 	grpcPortNum uint16,
 	// The dirpath on the API container where the enclave data volume should be mounted
 	enclaveDataVolumeDirpath string,
+	// The dirpath on the API container where the package cache shared by all the enclaves should be mounted
+	packageCacheVolumeDirpath string,
 	ownIpAddressEnvVar string,
 	customEnvVars map[string]string,
+	enclaveEnvVars string,
 ) (*api_container.APIContainer, error) {
 	// Verify no API container already exists in the enclave
 	apiContainersInEnclaveFilters := &api_container.APIContainerFilters{
@@ -62,6 +65,12 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 		return nil, stacktrace.Propagate(err, "An error occurred getting the enclave data volume for enclave '%v'", enclaveUuid)
 	}
 
+	// The engine creates the package cache but engines started by a previous version of Kurtosis may not have
+	packageCacheVolumeName, err := shared_helpers.CreatePackageCacheVolume(ctx, backend.dockerManager, backend.objAttrsProvider)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the package cache volume")
+	}
+
 	// Get the Docker network ID where we'll start the new API container
 	enclaveNetwork, err := backend.getEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid)
 	if err != nil {
@@ -120,6 +129,8 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 		ipAddr,
 		consts.KurtosisInternalContainerGrpcPortId,
 		privateGrpcPortSpec,
+		api_container.GetImageVersionTag(image),
+		api_container.GetEnclaveEnvVarsHash(enclaveEnvVars),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting the object attributes for the API container")
@@ -139,7 +150,8 @@ func (backend *DockerKurtosisBackend) CreateAPIContainer(
 	}
 
 	volumeMounts := map[string]string{
-		enclaveDataVolumeName: enclaveDataVolumeDirpath,
+		enclaveDataVolumeName:  enclaveDataVolumeDirpath,
+		packageCacheVolumeName: packageCacheVolumeDirpath,
 	}
 
 	labelStrs := map[string]string{}
@@ -449,6 +461,9 @@ func getApiContainerObjectFromContainerInfo(
 		publicIpAddr,
 		publicGrpcPortSpec,
 		bridgeNetworkIpAddressAddr,
+		// API containers created by old Kurtosis versions don't have the labels, which leaves the version and hash empty
+		labels[label_key_consts.APIContainerImageVersionTagDockerLabelKey.GetString()],
+		labels[label_key_consts.APIContainerEnclaveEnvVarsHashDockerLabelKey.GetString()],
 	)
 
 	return result, nil
@@ -4,12 +4,14 @@ import (
 	"context"
 	"github.com/docker/docker/api/types/volume"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_operation_parallelizer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
@@ -24,6 +26,11 @@ const (
 
 	defaultHttpLogsCollectorPortNum = uint16(9712)
 	defaultTcpLogsCollectorPortNum  = uint16(9713)
+
+	// A restarted API container restores the services of its enclave before listening, so it's given more time than
+	// a new one to become available
+	maxWaitForRestartedApiContainerAvailabilityRetries         = 60
+	timeBetweenWaitForRestartedApiContainerAvailabilityRetries = 1 * time.Second
 )
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
@@ -35,7 +42,16 @@ type matchingNetworkInformation struct {
 	containers    []*types.Container
 }
 
-func (backend *DockerKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
+func (backend *DockerKurtosisBackend) CreateEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveName string,
+	kubernetesSettings *enclave.KubernetesSettings,
+) (*enclave.Enclave, error) {
+	if !kubernetesSettings.IsEmpty() {
+		return nil, stacktrace.NewError("Cannot create enclave '%v' with Kubernetes settings as they are only available for Kubernetes, not Docker", enclaveName)
+	}
+
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 
 	searchNetworkLabels := map[string]string{
@@ -276,6 +292,119 @@ func (backend *DockerKurtosisBackend) StopEnclaves(
 	return successfulEnclaveUuids, erroredEnclaveUuids, nil
 }
 
+// Starts enclaves matching the given filters that were stopped. The logs collector of each enclave is started first, so
+// that it collects the logs of the user services again, then its API container, which restarts the user services that
+// were running when the enclave was stopped
+func (backend *DockerKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	resultSuccessfulEnclaveUuids map[enclave.EnclaveUUID]bool,
+	resultErroredEnclaveUuids map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	matchingNetworkInfo, err := backend.getMatchingEnclaveNetworkInfo(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclave network info using filters '%+v'", filters)
+	}
+
+	erroredEnclaveUuids := map[enclave.EnclaveUUID]error{}
+	successfulEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	if len(matchingNetworkInfo) == 0 {
+		return successfulEnclaveUuids, erroredEnclaveUuids, nil
+	}
+
+	matchingEnclaveUuids := map[enclave.EnclaveUUID]bool{}
+	for enclaveUuid := range matchingNetworkInfo {
+		if err := logs_collector_functions.StartLogsCollector(ctx, enclaveUuid, backend.dockerManager); err != nil {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.Propagate(err, "An error occurred starting the logs collector of enclave '%v'", enclaveUuid)
+			continue
+		}
+		matchingEnclaveUuids[enclaveUuid] = true
+	}
+	if len(matchingEnclaveUuids) == 0 {
+		return successfulEnclaveUuids, erroredEnclaveUuids, nil
+	}
+	apiContainerFilters := &api_container.APIContainerFilters{
+		EnclaveIDs: matchingEnclaveUuids,
+		Statuses:   nil,
+	}
+	apiContainersByContainerId, err := backend.getMatchingApiContainers(ctx, apiContainerFilters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the API containers of the enclaves to start using filters '%+v'", apiContainerFilters)
+	}
+
+	// For all the enclaves to start, gather their API container
+	enclaveUuidsForContainerIdsToStart := map[string]enclave.EnclaveUUID{}
+	containerIdsToStart := map[string]bool{}
+	for containerId, apiContainer := range apiContainersByContainerId {
+		enclaveUuidsForContainerIdsToStart[containerId] = apiContainer.GetEnclaveID()
+		containerIdsToStart[containerId] = true
+	}
+
+	var startEnclaveContainerOperation docker_operation_parallelizer.DockerOperation = func(ctx context.Context, dockerManager *docker_manager.DockerManager, dockerObjectId string) error {
+		if err := dockerManager.StartContainer(ctx, dockerObjectId); err != nil {
+			return stacktrace.Propagate(err, "An error occurred starting API container with ID '%v'", dockerObjectId)
+		}
+		apiContainer, found := apiContainersByContainerId[dockerObjectId]
+		if !found {
+			return stacktrace.NewError("Expected to find API container with ID '%v' but none was found; this is a bug in Kurtosis", dockerObjectId)
+		}
+		if err := shared_helpers.WaitForPortAvailabilityUsingNetstat(
+			ctx,
+			dockerManager,
+			dockerObjectId,
+			apiContainer.GetPrivateGRPCPort(),
+			maxWaitForRestartedApiContainerAvailabilityRetries,
+			timeBetweenWaitForRestartedApiContainerAvailabilityRetries,
+		); err != nil {
+			return stacktrace.Propagate(err, "An error occurred waiting for the grpc port of the API container with ID '%v' to become available", dockerObjectId)
+		}
+		return nil
+	}
+
+	_, erroredContainerIds := docker_operation_parallelizer.RunDockerOperationInParallel(
+		ctx,
+		containerIdsToStart,
+		backend.dockerManager,
+		startEnclaveContainerOperation,
+	)
+
+	containerStartErrorStrsByEnclave := map[enclave.EnclaveUUID][]string{}
+	for erroredContainerId, startContainerErr := range erroredContainerIds {
+		containerEnclaveUuid, found := enclaveUuidsForContainerIdsToStart[erroredContainerId]
+		if !found {
+			return nil, nil, stacktrace.NewError("An error occurred starting container '%v' in an enclave we didn't request", erroredContainerId)
+		}
+		containerStartErrorStrsByEnclave[containerEnclaveUuid] = append(containerStartErrorStrsByEnclave[containerEnclaveUuid], startContainerErr.Error())
+	}
+
+	enclaveUuidsWithApiContainer := map[enclave.EnclaveUUID]bool{}
+	for _, enclaveUuid := range enclaveUuidsForContainerIdsToStart {
+		enclaveUuidsWithApiContainer[enclaveUuid] = true
+	}
+	for enclaveUuid := range matchingEnclaveUuids {
+		if _, found := enclaveUuidsWithApiContainer[enclaveUuid]; !found {
+			erroredEnclaveUuids[enclaveUuid] = stacktrace.NewError("Enclave '%v' can't be started as it has no API container", enclaveUuid)
+			continue
+		}
+		containerStartErrorStrs, found := containerStartErrorStrsByEnclave[enclaveUuid]
+		if !found || len(containerStartErrorStrs) == 0 {
+			successfulEnclaveUuids[enclaveUuid] = true
+			continue
+		}
+
+		errorStr := strings.Join(containerStartErrorStrs, "\n\n")
+		erroredEnclaveUuids[enclaveUuid] = stacktrace.NewError(
+			"One or more errors occurred starting the API container of enclave '%v':\n%v",
+			enclaveUuid,
+			errorStr,
+		)
+	}
+
+	return successfulEnclaveUuids, erroredEnclaveUuids, nil
+}
+
 func (backend *DockerKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
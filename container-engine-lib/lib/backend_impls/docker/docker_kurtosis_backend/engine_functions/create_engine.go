@@ -15,9 +15,6 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
-	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_remover"
-	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
-	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"time"
@@ -30,7 +27,6 @@ const (
 	maxWaitForEngineAvailabilityRetries         = 10
 	timeBetweenWaitForEngineAvailabilityRetries = 1 * time.Second
 	logsStorageDirpath                          = "/var/log/kurtosis/"
-	removeLogsWaitHours                         = 6 * time.Hour
 )
 
 func CreateEngine(
@@ -94,6 +90,11 @@ func CreateEngine(
 		return nil, stacktrace.Propagate(err, "An error occurred creating logs storage.")
 	}
 
+	// The package cache is shared by the API containers of all the enclaves and outlives the engine, like the logs
+	if _, err = shared_helpers.CreatePackageCacheVolume(ctx, dockerManager, objAttrsProvider); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the package cache.")
+	}
+
 	logsAggregatorContainer := vector.NewVectorLogsAggregatorContainer() // Declaring implementation
 	_, removeLogsAggregatorFunc, err := logs_aggregator_functions.CreateLogsAggregator(
 		ctx,
@@ -105,20 +106,6 @@ func CreateEngine(
 			"An error occurred attempting to create logging components for engine with GUID '%v' in Docker network with network id '%v'.", engineGuidStr, targetNetworkId)
 	}
 
-	// schedule log removal for log retention
-	go func() {
-		osFs := volume_filesystem.NewOsVolumeFilesystem()
-		realTime := logs_clock.NewRealClock()
-		logRemover := log_remover.NewLogRemover(osFs, realTime)
-		// do a first removal
-		logRemover.Run()
-
-		logRemovalTicker := time.NewTicker(removeLogsWaitHours)
-		for range logRemovalTicker.C {
-			logRemover.Run()
-		}
-	}()
-
 	shouldRemoveCentralizedLogComponents := true
 	defer func() {
 		if shouldRemoveCentralizedLogComponents {
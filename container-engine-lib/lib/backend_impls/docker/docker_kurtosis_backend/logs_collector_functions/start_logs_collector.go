@@ -0,0 +1,118 @@
+package logs_collector_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions/implementations/fluentbit"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"net"
+)
+
+const (
+	shouldShowStoppedUserServiceContainers = true
+)
+
+// StartLogsCollector starts the stopped logs collector of the enclave, if any, and waits for it to be available
+// The user service containers of the enclave send their logs to the address the logs collector had when they were
+// created, and Docker doesn't keep auto-assigned IP addresses across restarts, so the logs collector gets this address
+// back in the enclave network before being started
+func StartLogsCollector(ctx context.Context, enclaveUuid enclave.EnclaveUUID, dockerManager *docker_manager.DockerManager) error {
+	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while retrieving the network id for the enclave '%v'", enclaveUuid)
+	}
+
+	maybeLogsCollector, maybeLogsCollectorContainerId, err := getLogsCollectorObjectAndContainerId(ctx, enclaveUuid, enclaveNetwork, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs collector for enclave '%v'", enclaveUuid)
+	}
+	if maybeLogsCollectorContainerId == "" {
+		logrus.Warnf("Enclave '%v' has no logs collector to start; the logs of its services won't be collected", enclaveUuid)
+		return nil
+	}
+	if maybeLogsCollector.GetStatus() == container_status.ContainerStatus_Running {
+		return nil
+	}
+
+	maybeUserServicesLogsCollectorIpAddr, err := getLogsCollectorIpAddrUsedByUserServices(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs collector address the user services of enclave '%v' send their logs to", enclaveUuid)
+	}
+	if maybeUserServicesLogsCollectorIpAddr != nil {
+		if err := dockerManager.DisconnectContainerFromNetwork(ctx, maybeLogsCollectorContainerId, enclaveNetwork.GetId()); err != nil {
+			return stacktrace.Propagate(err, "An error occurred disconnecting logs collector container '%v' from the enclave network '%v'", maybeLogsCollectorContainerId, enclaveNetwork.GetId())
+		}
+		if err := dockerManager.ConnectContainerToNetwork(ctx, enclaveNetwork.GetId(), maybeLogsCollectorContainerId, maybeUserServicesLogsCollectorIpAddr, emptyAliasForLogsCollector); err != nil {
+			return stacktrace.Propagate(err, "An error occurred connecting logs collector container '%v' to the enclave network '%v' with IP address '%v'", maybeLogsCollectorContainerId, enclaveNetwork.GetId(), maybeUserServicesLogsCollectorIpAddr)
+		}
+	}
+
+	if err := dockerManager.StartContainer(ctx, maybeLogsCollectorContainerId); err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the logs collector container with ID '%v'", maybeLogsCollectorContainerId)
+	}
+
+	startedLogsCollector, _, err := getLogsCollectorObjectAndContainerId(ctx, enclaveUuid, enclaveNetwork, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs collector for enclave '%v' after starting it", enclaveUuid)
+	}
+	if startedLogsCollector == nil || startedLogsCollector.GetStatus() != container_status.ContainerStatus_Running {
+		return stacktrace.NewError("Expected the logs collector of enclave '%v' to be running after starting it, but it isn't", enclaveUuid)
+	}
+
+	logsCollectorAvailabilityChecker := fluentbit.NewFluentbitAvailabilityChecker(startedLogsCollector.GetBridgeNetworkIpAddress(), startedLogsCollector.GetPrivateHttpPort().GetNumber())
+	if err = logsCollectorAvailabilityChecker.WaitForAvailability(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while waiting for the logs collector of enclave '%v' to become available", enclaveUuid)
+	}
+	return nil
+}
+
+// If no user service sends its logs to the logs collector, returns nil
+func getLogsCollectorIpAddrUsedByUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, dockerManager *docker_manager.DockerManager) (net.IP, error) {
+	userServiceContainerSearchLabels := map[string]string{
+		label_key_consts.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		label_key_consts.ContainerTypeDockerLabelKey.GetString(): label_value_consts.UserServiceContainerTypeDockerLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveUuid),
+	}
+	userServiceContainers, err := dockerManager.GetContainersByLabels(ctx, userServiceContainerSearchLabels, shouldShowStoppedUserServiceContainers)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred fetching user service containers using labels: %+v", userServiceContainerSearchLabels)
+	}
+
+	for _, userServiceContainer := range userServiceContainers {
+		containerJson, err := dockerManager.InspectContainer(ctx, userServiceContainer.GetId())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred inspecting user service container '%v'", userServiceContainer.GetId())
+		}
+		if containerJson.HostConfig == nil {
+			continue
+		}
+		logsCollectorAddress, found := docker_manager.GetFluentdLoggingDriverAddress(containerJson.HostConfig.LogConfig)
+		if !found {
+			continue
+		}
+		logsCollectorIpAddr, err := getIpAddrFromLogsCollectorAddress(logsCollectorAddress)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector IP address user service container '%v' sends its logs to", userServiceContainer.GetId())
+		}
+		return logsCollectorIpAddr, nil
+	}
+	return nil, nil
+}
+
+func getIpAddrFromLogsCollectorAddress(logsCollectorAddress string) (net.IP, error) {
+	host, _, err := net.SplitHostPort(logsCollectorAddress)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred splitting logs collector address '%v' into host and port", logsCollectorAddress)
+	}
+	ipAddr := net.ParseIP(host)
+	if ipAddr == nil {
+		return nil, stacktrace.NewError("Couldn't parse host '%v' of logs collector address '%v' to an IP address", host, logsCollectorAddress)
+	}
+	return ipAddr, nil
+}
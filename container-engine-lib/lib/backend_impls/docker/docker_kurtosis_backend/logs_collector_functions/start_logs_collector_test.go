@@ -0,0 +1,23 @@
+package logs_collector_functions
+
+import (
+	"github.com/stretchr/testify/require"
+	"net"
+	"testing"
+)
+
+func TestGetIpAddrFromLogsCollectorAddress(t *testing.T) {
+	ipAddr, err := getIpAddrFromLogsCollectorAddress("172.16.0.3:9713")
+	require.NoError(t, err)
+	require.True(t, net.ParseIP("172.16.0.3").Equal(ipAddr))
+}
+
+func TestGetIpAddrFromLogsCollectorAddress_NoPort(t *testing.T) {
+	_, err := getIpAddrFromLogsCollectorAddress("172.16.0.3")
+	require.Error(t, err)
+}
+
+func TestGetIpAddrFromLogsCollectorAddress_HostnameIsNotAnIpAddress(t *testing.T) {
+	_, err := getIpAddrFromLogsCollectorAddress("logs-collector:9713")
+	require.Error(t, err)
+}
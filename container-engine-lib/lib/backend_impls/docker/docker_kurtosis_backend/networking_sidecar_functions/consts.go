@@ -0,0 +1,15 @@
+package networking_sidecar_functions
+
+const (
+	// This image ships the 'tc' and 'ip' binaries that are used to shape the traffic of the user service
+	networkingSidecarImageName = "kurtosistech/iproute2"
+
+	shouldFetchStoppedNetworkingSidecarContainers = true
+)
+
+// The sidecar does nothing on its own, it only needs to stay up so that commands can be executed in it
+var networkingSidecarEntrypointArgs = []string{
+	"tail",
+	"-f",
+	"/dev/null",
+}
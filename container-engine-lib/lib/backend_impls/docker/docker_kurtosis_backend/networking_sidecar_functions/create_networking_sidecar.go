@@ -0,0 +1,115 @@
+package networking_sidecar_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateNetworkingSidecar starts a container joining the network namespace of the user service container, with the
+// NET_ADMIN capability so that it can change the traffic control rules of the user service
+func CreateNetworkingSidecar(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) (*networking_sidecar.NetworkingSidecar, error) {
+	existingNetworkingSidecarFilters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	existingNetworkingSidecars, existingNetworkingSidecarContainers, err := getMatchingNetworkingSidecarObjsAndContainers(ctx, enclaveUuid, existingNetworkingSidecarFilters, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred checking whether service '%v' already has a networking sidecar", serviceUuid)
+	}
+	if existingNetworkingSidecar, found := existingNetworkingSidecars[serviceUuid]; found {
+		if existingNetworkingSidecar.GetStatus() == container_status.ContainerStatus_Running {
+			return existingNetworkingSidecar, nil
+		}
+		// a stopped sidecar is of no use, as the network namespace it was sharing is gone
+		if err := dockerManager.RemoveContainer(ctx, existingNetworkingSidecarContainers[serviceUuid].GetId()); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred removing the stopped networking sidecar of service '%v'", serviceUuid)
+		}
+	}
+
+	userServiceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	userServices, userServiceDockerResources, err := shared_helpers.GetMatchingUserServiceObjsAndDockerResourcesNoMutex(ctx, enclaveUuid, userServiceFilters, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service '%v'", serviceUuid)
+	}
+	userService, found := userServices[serviceUuid]
+	if !found {
+		return nil, stacktrace.NewError("No user service with UUID '%v' exists in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	if userService.GetStatus() != container_status.ContainerStatus_Running {
+		return nil, stacktrace.NewError("A networking sidecar can't be attached to service '%v' because it isn't running", serviceUuid)
+	}
+	userServiceContainerId := userServiceDockerResources[serviceUuid].ServiceContainer.GetId()
+
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
+	}
+	containerAttrs, err := enclaveObjAttrsProvider.ForNetworkingSidecarContainer(serviceUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecar container attributes for service '%v'", serviceUuid)
+	}
+	containerLabelStrs := map[string]string{}
+	for labelKey, labelValue := range containerAttrs.GetLabels() {
+		containerLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+
+	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		networkingSidecarImageName,
+		containerAttrs.GetName().GetString(),
+		enclaveNetwork.GetId(),
+	).WithAddedCapabilities(map[docker_manager.ContainerCapability]bool{
+		docker_manager.NetAdmin: true,
+	}).WithNetworkMode(
+		docker_manager.NewContainerNetworkMode(userServiceContainerId),
+	).WithEntrypointArgs(
+		networkingSidecarEntrypointArgs,
+	).WithLabels(
+		containerLabelStrs,
+	).Build()
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting the networking sidecar of service '%v'", serviceUuid)
+	}
+	shouldRemoveContainer := true
+	defer func() {
+		if !shouldRemoveContainer {
+			return
+		}
+		// Background context so the container gets removed even if the input context was cancelled
+		if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("Creating the networking sidecar of service '%v' didn't complete successfully so we tried to remove the container with ID '%v' but doing so threw an error:\n%v", serviceUuid, containerId, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
+		}
+	}()
+
+	networkingSidecar := networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, container_status.ContainerStatus_Running)
+	shouldRemoveContainer = false
+	return networkingSidecar, nil
+}
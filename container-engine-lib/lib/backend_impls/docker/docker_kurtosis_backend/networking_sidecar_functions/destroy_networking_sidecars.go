@@ -0,0 +1,33 @@
+package networking_sidecar_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	_, networkingSidecarContainers, err := getMatchingNetworkingSidecarObjsAndContainers(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecars of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+
+	successfulServiceUuids := map[service.ServiceUUID]bool{}
+	erroredServiceUuids := map[service.ServiceUUID]error{}
+	for serviceUuid, networkingSidecarContainer := range networkingSidecarContainers {
+		if err := dockerManager.RemoveContainer(ctx, networkingSidecarContainer.GetId()); err != nil {
+			erroredServiceUuids[serviceUuid] = stacktrace.Propagate(err, "An error occurred removing the networking sidecar container '%v' of service '%v'", networkingSidecarContainer.GetName(), serviceUuid)
+			continue
+		}
+		successfulServiceUuids[serviceUuid] = true
+	}
+	return successfulServiceUuids, erroredServiceUuids, nil
+}
@@ -0,0 +1,23 @@
+package networking_sidecar_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	networkingSidecars, _, err := getMatchingNetworkingSidecarObjsAndContainers(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecars of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	return networkingSidecars, nil
+}
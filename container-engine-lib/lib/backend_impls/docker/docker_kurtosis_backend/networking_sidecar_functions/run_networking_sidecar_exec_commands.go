@@ -0,0 +1,97 @@
+package networking_sidecar_functions
+
+import (
+	"bytes"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
+	"github.com/kurtosis-tech/stacktrace"
+	"reflect"
+)
+
+func RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarCommands map[service.ServiceUUID][]string,
+	dockerManager *docker_manager.DockerManager,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range networkingSidecarCommands {
+		serviceUuids[serviceUuid] = true
+	}
+	filters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: serviceUuids,
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	_, networkingSidecarContainers, err := getMatchingNetworkingSidecarObjsAndContainers(ctx, enclaveUuid, filters, dockerManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecars matching filters '%+v'", filters)
+	}
+
+	successfulExecs := map[service.ServiceUUID]*exec_result.ExecResult{}
+	failedExecs := map[service.ServiceUUID]error{}
+	execOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, command := range networkingSidecarCommands {
+		networkingSidecarContainer, found := networkingSidecarContainers[serviceUuid]
+		if !found {
+			failedExecs[serviceUuid] = stacktrace.NewError(
+				"Cannot execute command '%+v' in the networking sidecar of service '%v' because no running networking sidecar was found for it",
+				command,
+				serviceUuid,
+			)
+			continue
+		}
+		execOperations[operation_parallelizer.OperationID(serviceUuid)] = createExecOperation(ctx, serviceUuid, networkingSidecarContainer, command, dockerManager)
+	}
+
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(execOperations)
+	for operationId, operationResult := range successfulOperations {
+		serviceUuid := service.ServiceUUID(operationId)
+		execResult, ok := operationResult.(*exec_result.ExecResult)
+		if !ok {
+			return nil, nil, stacktrace.NewError("An error occurred processing the result of the exec command "+
+				"run in the networking sidecar of service '%s'. It seems the result object is of an unexpected type ('%v'). "+
+				"This is a Kurtosis internal bug.", serviceUuid, reflect.TypeOf(operationResult))
+		}
+		successfulExecs[serviceUuid] = execResult
+	}
+	for operationId, err := range failedOperations {
+		failedExecs[service.ServiceUUID(operationId)] = err
+	}
+	return successfulExecs, failedExecs, nil
+}
+
+func createExecOperation(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	networkingSidecarContainer *types.Container,
+	command []string,
+	dockerManager *docker_manager.DockerManager,
+) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		execOutputBuf := &bytes.Buffer{}
+		exitCode, err := dockerManager.RunExecCommand(ctx, networkingSidecarContainer.GetId(), command, execOutputBuf)
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"An error occurred executing command '%+v' in networking sidecar container '%v' of service '%v'",
+				command,
+				networkingSidecarContainer.GetName(),
+				serviceUuid,
+			)
+		}
+		return exec_result.NewExecResult(exitCode, execOutputBuf.String()), nil
+	}
+}
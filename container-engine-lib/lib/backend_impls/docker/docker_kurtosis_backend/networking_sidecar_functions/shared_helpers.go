@@ -0,0 +1,69 @@
+package networking_sidecar_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// getMatchingNetworkingSidecarObjsAndContainers returns the networking sidecars matching the filters, along with their
+// container, keyed by the UUID of the user service they're attached to
+func getMatchingNetworkingSidecarObjsAndContainers(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, map[service.ServiceUUID]*types.Container, error) {
+	searchLabels := map[string]string{
+		label_key_consts.AppIDDockerLabelKey.GetString():         label_value_consts.AppIDDockerLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDDockerLabelKey.GetString():   string(enclaveUuid),
+		label_key_consts.ContainerTypeDockerLabelKey.GetString(): label_value_consts.NetworkingSidecarContainerTypeDockerLabelValue.GetString(),
+	}
+	containers, err := dockerManager.GetContainersByLabels(ctx, searchLabels, shouldFetchStoppedNetworkingSidecarContainers)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecar containers of enclave '%v' by labels: %+v", enclaveUuid, searchLabels)
+	}
+
+	networkingSidecars := map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar{}
+	networkingSidecarContainers := map[service.ServiceUUID]*types.Container{}
+	for _, container := range containers {
+		serviceUuidStr, found := container.GetLabels()[label_key_consts.UserServiceGUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, nil, stacktrace.NewError("Found networking sidecar container '%v' that didn't have expected service GUID label '%v'", container.GetId(), label_key_consts.UserServiceGUIDDockerLabelKey.GetString())
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+		if filters != nil && len(filters.UserServiceUUIDs) > 0 {
+			if _, found := filters.UserServiceUUIDs[serviceUuid]; !found {
+				continue
+			}
+		}
+
+		containerStatus := container.GetStatus()
+		isContainerRunning, found := consts.IsContainerRunningDeterminer[containerStatus]
+		if !found {
+			// This should never happen because we enforce completeness in a unit test
+			return nil, nil, stacktrace.NewError("No is-running designation found for networking sidecar container status '%v'; this is a bug in Kurtosis!", containerStatus.String())
+		}
+		status := container_status.ContainerStatus_Stopped
+		if isContainerRunning {
+			status = container_status.ContainerStatus_Running
+		}
+		if filters != nil && len(filters.Statuses) > 0 {
+			if _, found := filters.Statuses[status]; !found {
+				continue
+			}
+		}
+
+		networkingSidecars[serviceUuid] = networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, status)
+		networkingSidecarContainers[serviceUuid] = container
+	}
+	return networkingSidecars, networkingSidecarContainers, nil
+}
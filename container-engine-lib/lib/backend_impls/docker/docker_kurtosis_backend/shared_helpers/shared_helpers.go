@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_port_spec_serializer"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
@@ -434,6 +435,28 @@ func GetEngineAndLogsComponentsNetwork(
 	return targetNetwork, nil
 }
 
+// CreatePackageCacheVolume creates the volume caching the Starlark packages across enclaves, returning its name. It is
+// idempotent as the volume usually exists already
+func CreatePackageCacheVolume(
+	ctx context.Context,
+	dockerManager *docker_manager.DockerManager,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+) (string, error) {
+	packageCacheAttrs, err := objAttrsProvider.ForPackageCacheVolume()
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred retrieving the package cache object attributes")
+	}
+	packageCacheVolumeName := packageCacheAttrs.GetName().GetString()
+	volumeLabelStrs := map[string]string{}
+	for labelKey, labelValue := range packageCacheAttrs.GetLabels() {
+		volumeLabelStrs[labelKey.GetString()] = labelValue.GetString()
+	}
+	if err = dockerManager.CreateVolume(ctx, packageCacheVolumeName, volumeLabelStrs); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred creating the package cache volume '%v'", packageCacheVolumeName)
+	}
+	return packageCacheVolumeName, nil
+}
+
 func DumpContainers(ctx context.Context, dockerManager *docker_manager.DockerManager, containers []*types.Container, outputDirpath string) error {
 	// Create output directory
 	if _, err := os.Stat(outputDirpath); !os.IsNotExist(err) {
@@ -2,29 +2,69 @@ package user_service_functions
 
 import (
 	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/free_ip_addr_tracker"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io"
 )
 
+const (
+	// We use this image and version because we already are using this in other projects so there is a high probability
+	// that the image is in the local machine's cache
+	persistentDirectoryReaderContainerImage        = "alpine:3.17"
+	persistentDirectoryReaderContainerNameFragment = "kurtosis-persistent-directory-reader"
+	persistentDirectoryReaderMountpoint            = "/persistent-directory"
+	persistentDirectoryReaderSleepSeconds          = 1800
+)
+
+// getOrCreatePersistentDirectories returns the volumes to mount on the service, mapped to the dirpath where they must be
+// mounted, along with the names of the volumes that got created by this call (as opposed to already existing ones).
+// Newly created volumes are seeded with their seed files artifact, if any. The seeding completes before this returns and
+// the volume gets removed if it fails, so an existing volume is always a seeded one and nothing needs to be tracked
 func getOrCreatePersistentDirectories(
 	ctx context.Context,
 	serviceUuid service.ServiceUUID,
 	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
-	serviceMountpointsToPersistentKey map[string]service_directory.DirectoryPersistentKey,
+	freeIpAddrProvider *free_ip_addr_tracker.FreeIpAddrTracker,
+	enclaveNetworkId string,
+	serviceMountpointsToPersistentDirectory map[string]service_directory.PersistentDirectory,
 	dockerManager *docker_manager.DockerManager,
-) (map[string]string, error) {
+) (map[string]string, map[string]bool, error) {
 	shouldDeleteVolumes := true
 	volumeNamesToRemoveIfFailure := map[string]bool{}
+	defer func() {
+		if !shouldDeleteVolumes {
+			return
+		}
+		for volumeNameStr := range volumeNamesToRemoveIfFailure {
+			// Background context so we still run this even if the input context was cancelled
+			if err := dockerManager.RemoveVolume(context.Background(), volumeNameStr); err != nil {
+				logrus.Warnf(
+					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume '%v' that we created, but doing so threw an error:\n%v",
+					volumeNameStr,
+					err,
+				)
+				logrus.Warnf("You'll need to clean up volume '%v' manually!", volumeNameStr)
+			}
+		}
+	}()
 	persistentDirectories := map[string]string{}
 
-	for serviceDirPath, persistentKey := range serviceMountpointsToPersistentKey {
+	for serviceDirPath, persistentDirectory := range serviceMountpointsToPersistentDirectory {
+		persistentKey := persistentDirectory.PersistentKey
 		volumeAttrs, err := objAttrsProvider.ForSinglePersistentDirectoryVolume(serviceUuid, persistentKey)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
+			return nil, nil, stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
 		}
 
 		volumeName := volumeAttrs.GetName().GetString()
@@ -35,18 +75,19 @@ func getOrCreatePersistentDirectories(
 
 		potentiallyExistingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+			return nil, nil, stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
 		}
 		if len(potentiallyExistingVolumes) == 1 {
 			// volume already existed for this enclave, return it and continue to the next one
 			persistentDirectories[volumeName] = serviceDirPath
 			continue
 		} else if len(potentiallyExistingVolumes) > 1 {
-			return nil, stacktrace.NewError("More than one volume with name '%s' exists in docker. This is unexpected", volumeName)
+			return nil, nil, stacktrace.NewError("More than one volume with name '%s' exists in docker. This is unexpected", volumeName)
 		}
 
+		// Docker volumes can't be sized and don't have storage classes so Size and StorageClass are ignored here
 		if err = dockerManager.CreateVolume(ctx, volumeName, volumeLabelsStrs); err != nil {
-			return nil, stacktrace.Propagate(
+			return nil, nil, stacktrace.Propagate(
 				err,
 				"An error occurred creating persistent directory volume '%s' for service '%v'",
 				persistentKey,
@@ -55,24 +96,196 @@ func getOrCreatePersistentDirectories(
 		}
 		volumeNamesToRemoveIfFailure[volumeName] = true
 		persistentDirectories[volumeName] = serviceDirPath
+
+		seedFilesArtifactExpansion := persistentDirectory.SeedFilesArtifactExpansion
+		if seedFilesArtifactExpansion == nil {
+			continue
+		}
+		expanderMountpointsToVolumeNames := map[string]string{}
+		for expanderDirpath := range seedFilesArtifactExpansion.ExpanderDirpathsToServiceDirpaths {
+			expanderMountpointsToVolumeNames[expanderDirpath] = volumeName
+		}
+		if err = runFilesArtifactsExpander(
+			ctx,
+			serviceUuid,
+			objAttrsProvider,
+			freeIpAddrProvider,
+			seedFilesArtifactExpansion.ExpanderImage,
+			seedFilesArtifactExpansion.ExpanderEnvVars,
+			enclaveNetworkId,
+			expanderMountpointsToVolumeNames,
+			dockerManager,
+		); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred seeding persistent directory '%s' for service '%v'", persistentKey, serviceUuid)
+		}
 	}
 
-	defer func() {
-		if !shouldDeleteVolumes {
-			return
+	shouldDeleteVolumes = false
+	return persistentDirectories, volumeNamesToRemoveIfFailure, nil
+}
+
+func GetPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	dockerManager *docker_manager.DockerManager,
+) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error) {
+	volumeSearchLabels := map[string]string{
+		label_key_consts.AppIDDockerLabelKey.GetString():       label_value_consts.AppIDDockerLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDDockerLabelKey.GetString(): string(enclaveUuid),
+		label_key_consts.VolumeTypeDockerLabelKey.GetString():  label_value_consts.PersistentDirectoryVolumeTypeDockerLabelValue.GetString(),
+	}
+	volumes, err := dockerManager.GetVolumesByLabels(ctx, volumeSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directory volumes of enclave '%v'", enclaveUuid)
+	}
+
+	result := map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool{}
+	for _, volume := range volumes {
+		serviceUuidStr, found := volume.Labels[label_key_consts.UserServiceGUIDDockerLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected persistent directory volume '%v' to have label '%v', but it didn't", volume.Name, label_key_consts.UserServiceGUIDDockerLabelKey.GetString())
 		}
-		for volumeNameStr := range volumeNamesToRemoveIfFailure {
-			// Background context so we still run this even if the input context was cancelled
-			if err := dockerManager.RemoveVolume(context.Background(), volumeNameStr); err != nil {
-				logrus.Warnf(
-					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume '%v' that we created, but doing so threw an error:\n%v",
-					volumeNameStr,
-					err,
-				)
-				logrus.Warnf("You'll need to clean up volume '%v' manually!", volumeNameStr)
-			}
+		persistentKeyStr, found := volume.Labels[label_key_consts.IDDockerLabelKey.GetString()]
+		if !found {
+			// volumes created by older versions of Kurtosis weren't labelled with their persistent key
+			logrus.Debugf("Skipping persistent directory volume '%v' as it doesn't have label '%v'", volume.Name, label_key_consts.IDDockerLabelKey.GetString())
+			continue
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+		if _, found := result[serviceUuid]; !found {
+			result[serviceUuid] = map[service_directory.DirectoryPersistentKey]bool{}
+		}
+		result[serviceUuid][service_directory.DirectoryPersistentKey(persistentKeyStr)] = true
+	}
+	return result, nil
+}
+
+func DestroyPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKeys map[service_directory.DirectoryPersistentKey]bool,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error) {
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
+	}
+
+	successfulPersistentKeys := map[service_directory.DirectoryPersistentKey]bool{}
+	erroredPersistentKeys := map[service_directory.DirectoryPersistentKey]error{}
+	for persistentKey := range persistentKeys {
+		volumeName, err := getExistingPersistentDirectoryVolumeName(ctx, serviceUuid, persistentKey, enclaveObjAttrsProvider, dockerManager)
+		if err != nil {
+			erroredPersistentKeys[persistentKey] = stacktrace.Propagate(err, "An error occurred getting the volume of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+			continue
+		}
+		if err := dockerManager.RemoveVolume(ctx, volumeName); err != nil {
+			erroredPersistentKeys[persistentKey] = stacktrace.Propagate(err, "An error occurred removing volume '%v' of persistent directory '%v' of service '%v'; note that the volume can't be removed while a service mounts it", volumeName, persistentKey, serviceUuid)
+			continue
+		}
+		successfulPersistentKeys[persistentKey] = true
+	}
+	return successfulPersistentKeys, erroredPersistentKeys, nil
+}
+
+// CopyFilesFromPersistentDirectory writes a TAR of the content of the persistent directory to the output. Docker can only
+// read volumes by mounting them in a container, so a short-lived reader container gets started for that
+func CopyFilesFromPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+	objAttrsProvider object_attributes_provider.DockerObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) error {
+	enclaveObjAttrsProvider, err := objAttrsProvider.ForEnclave(enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "Couldn't get an object attribute provider for enclave '%v'", enclaveUuid)
+	}
+
+	volumeName, err := getExistingPersistentDirectoryVolumeName(ctx, serviceUuid, persistentKey, enclaveObjAttrsProvider, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the volume of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+
+	enclaveNetwork, err := shared_helpers.GetEnclaveNetworkByEnclaveUuid(ctx, enclaveUuid, dockerManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the network of enclave '%v'", enclaveUuid)
+	}
+
+	readerContainerUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating a UUID for the persistent directory reader container")
+	}
+	readerContainerName := fmt.Sprintf("%v--%v", persistentDirectoryReaderContainerNameFragment, readerContainerUuid)
+
+	createAndStartArgs := docker_manager.NewCreateAndStartContainerArgsBuilder(
+		persistentDirectoryReaderContainerImage,
+		readerContainerName,
+		enclaveNetwork.GetId(),
+	).WithEntrypointArgs([]string{
+		"/bin/sh",
+		"-c",
+		fmt.Sprintf("sleep %v", persistentDirectoryReaderSleepSeconds),
+	}).WithVolumeMounts(map[string]string{
+		volumeName: persistentDirectoryReaderMountpoint,
+	}).Build()
+	containerId, _, err := dockerManager.CreateAndStartContainer(ctx, createAndStartArgs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred starting the persistent directory reader container with these args '%+v'", createAndStartArgs)
+	}
+	defer func() {
+		// Background context so the container gets removed even if the input context was cancelled
+		if err := dockerManager.RemoveContainer(context.Background(), containerId); err != nil {
+			logrus.Errorf("We tried to remove the persistent directory reader container with ID '%v' that we started, but doing so threw an error:\n%v", containerId, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the container with ID '%v'!!!!!!", containerId)
 		}
 	}()
-	shouldDeleteVolumes = false
-	return persistentDirectories, nil
+
+	srcPath := fmt.Sprintf(ignoreParentDirInArchiveSymbolFormat, persistentDirectoryReaderMountpoint)
+	tarStreamReadCloser, err := dockerManager.CopyFromContainer(ctx, containerId, srcPath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the content of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	defer tarStreamReadCloser.Close()
+
+	if _, err := io.Copy(output, tarStreamReadCloser); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the bytes of the TAR'd up content of persistent directory '%v' of service '%v' to the output", persistentKey, serviceUuid)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func getExistingPersistentDirectoryVolumeName(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	objAttrsProvider object_attributes_provider.DockerEnclaveObjectAttributesProvider,
+	dockerManager *docker_manager.DockerManager,
+) (string, error) {
+	// the volume name is derived from the service UUID and persistent key only, so it matches the existing volume's
+	volumeAttrs, err := objAttrsProvider.ForSinglePersistentDirectoryVolume(serviceUuid, persistentKey)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Error creating persistent directory labels for '%s'", persistentKey)
+	}
+	volumeName := volumeAttrs.GetName().GetString()
+
+	existingVolumes, err := dockerManager.GetVolumesByName(ctx, volumeName)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred checking for persistent volume existence")
+	}
+	if len(existingVolumes) == 0 {
+		return "", stacktrace.NewError("No persistent directory '%v' exists for service '%v'", persistentKey, serviceUuid)
+	}
+	if len(existingVolumes) > 1 {
+		return "", stacktrace.NewError("More than one volume with name '%s' exists in docker. This is unexpected", volumeName)
+	}
+	return volumeName, nil
 }
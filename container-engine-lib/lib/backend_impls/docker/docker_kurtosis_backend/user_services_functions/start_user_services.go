@@ -2,9 +2,11 @@ package user_service_functions
 
 import (
 	"context"
+	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/logs_collector_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -27,6 +29,11 @@ import (
 const (
 	unlimitedReplacements                = -1
 	skipAddingUserServiceToBridgeNetwork = true
+
+	userAndGroupSeparator = ":"
+	// The size of a tmpfs mount is unlimited when it isn't set
+	unlimitedTmpfsSizeMegabytes = 0
+	tmpfsSizeOptionFmtStr       = "size=%dm"
 )
 
 func RegisterUserServices(
@@ -531,6 +538,13 @@ func createStartServiceOperation(
 		cpuAllocationMillicpus := serviceConfig.GetCPUAllocationMillicpus()
 		memoryAllocationMegabytes := serviceConfig.GetMemoryAllocationMegabytes()
 		privateIPAddrPlaceholder := serviceConfig.GetPrivateIPAddrPlaceholder()
+		user := serviceConfig.GetUser()
+		userLabels := serviceConfig.GetLabels()
+		capabilities := serviceConfig.GetCapabilities()
+		sysctls := serviceConfig.GetSysctls()
+		ulimits := serviceConfig.GetUlimits()
+		tmpfsDirs := serviceConfig.GetTmpfsDirs()
+		shmSizeMegabytes := serviceConfig.GetShmSizeMegabytes()
 
 		// We replace the placeholder value with the actual private IP address
 		privateIPAddrStr := privateIpAddr.String()
@@ -582,11 +596,13 @@ func createStartServiceOperation(
 		}
 
 		if persistentDirectories != nil {
-			candidateVolumeMounts, err := getOrCreatePersistentDirectories(
+			candidateVolumeMounts, createdVolumeNames, err := getOrCreatePersistentDirectories(
 				ctx,
 				serviceUUID,
 				enclaveObjAttrsProvider,
-				persistentDirectories.ServiceDirpathToDirectoryPersistentKey,
+				freeIpAddrProvider,
+				enclaveNetworkId,
+				persistentDirectories.ServiceDirpathToPersistentDirectory,
 				dockerManager,
 			)
 			if err != nil {
@@ -594,7 +610,8 @@ func createStartServiceOperation(
 			}
 			defer func() {
 				if shouldDeleteVolumes {
-					for volumeName := range candidateVolumeMounts {
+					// the persistent directories that already existed are left untouched
+					for volumeName := range createdVolumeNames {
 						// Use background context, so we delete these even if input context was cancelled
 						if err := dockerManager.RemoveVolume(context.Background(), volumeName); err != nil {
 							logrus.Errorf("Starting the service failed so we tried to delete persistent directory volume '%v' that we created, but doing so threw an error:\n%v", volumeName, err)
@@ -616,6 +633,7 @@ func createStartServiceOperation(
 			serviceUUID,
 			privateIpAddr,
 			privatePorts,
+			userLabels,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred while trying to get the user service container attributes for user service with UUID '%v'", serviceUUID)
@@ -645,6 +663,23 @@ func createStartServiceOperation(
 			}
 		}
 
+		dockerTmpfsDirs := map[string]string{}
+		for dirpath, sizeMegabytes := range tmpfsDirs {
+			if _, found := volumeMounts[dirpath]; found {
+				return nil, stacktrace.NewError("Can't mount a tmpfs on path '%v' because a volume is already mounted on it", dirpath)
+			}
+			tmpfsOptions := ""
+			if sizeMegabytes != unlimitedTmpfsSizeMegabytes {
+				tmpfsOptions = fmt.Sprintf(tmpfsSizeOptionFmtStr, sizeMegabytes)
+			}
+			dockerTmpfsDirs[dirpath] = tmpfsOptions
+		}
+
+		addedCapabilities := map[docker_manager.ContainerCapability]bool{}
+		for _, capability := range capabilities {
+			addedCapabilities[docker_manager.ContainerCapability(capability)] = true
+		}
+
 		if logsCollectorAddress == "" {
 			return nil, stacktrace.NewError("Expected to have a logs collector server address value to send the user service logs, but it is empty")
 		}
@@ -681,7 +716,23 @@ func createStartServiceOperation(
 			volumeMounts,
 		).WithLoggingDriver(
 			fluentdLoggingDriverCnfg,
-		).WithRestartPolicy(restartPolicy)
+		).WithRestartPolicy(
+			restartPolicy,
+		).WithAddedCapabilities(
+			addedCapabilities,
+		).WithSysctls(
+			sysctls,
+		).WithUlimits(
+			ulimits,
+		).WithTmpfsDirs(
+			dockerTmpfsDirs,
+		).WithShmSizeMegabytes(
+			shmSizeMegabytes,
+		)
+
+		if user != nil {
+			createAndStartArgsBuilder.WithUser(getDockerUser(user))
+		}
 
 		if entrypointArgs != nil {
 			createAndStartArgsBuilder.WithEntrypointArgs(entrypointArgs)
@@ -828,3 +879,12 @@ func registerUserServices(
 
 	return successfulRegistrations, failedRegistrations, nil
 }
+
+// getDockerUser returns the user in the format of the --user Docker flag, i.e. uid[:gid]
+func getDockerUser(user *service.ServiceUser) string {
+	dockerUser := strconv.FormatUint(uint64(user.GetUID()), 10)
+	if gid, isGIDSet := user.GetGID(); isGIDSet {
+		dockerUser = dockerUser + userAndGroupSeparator + strconv.FormatUint(uint64(gid), 10)
+	}
+	return dockerUser
+}
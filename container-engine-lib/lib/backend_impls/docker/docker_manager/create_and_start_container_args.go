@@ -29,6 +29,11 @@ type CreateAndStartContainerArgs struct {
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
 	containerInitEnabled                     bool
 	restartPolicy                            RestartPolicy
+	user                                     string
+	sysctls                                  map[string]string
+	ulimits                                  map[string]uint64
+	tmpfsDirs                                map[string]string
+	shmSizeMegabytes                         uint64
 }
 
 // Builder for creating CreateAndStartContainerArgs object
@@ -55,6 +60,11 @@ type CreateAndStartContainerArgsBuilder struct {
 	skipAddingToBridgeNetworkIfStaticIpIsSet bool
 	containerInitEnabled                     bool
 	restartPolicy                            RestartPolicy
+	user                                     string
+	sysctls                                  map[string]string
+	ulimits                                  map[string]uint64
+	tmpfsDirs                                map[string]string
+	shmSizeMegabytes                         uint64
 }
 
 /*
@@ -88,6 +98,11 @@ func NewCreateAndStartContainerArgsBuilder(dockerImage string, name string, netw
 		skipAddingToBridgeNetworkIfStaticIpIsSet: false,
 		containerInitEnabled:                     false,
 		restartPolicy:                            NoRestart,
+		user:                                     "",
+		sysctls:                                  map[string]string{},
+		ulimits:                                  map[string]uint64{},
+		tmpfsDirs:                                map[string]string{},
+		shmSizeMegabytes:                         0,
 	}
 }
 
@@ -115,6 +130,11 @@ func (builder *CreateAndStartContainerArgsBuilder) Build() *CreateAndStartContai
 		skipAddingToBridgeNetworkIfStaticIpIsSet: builder.skipAddingToBridgeNetworkIfStaticIpIsSet,
 		containerInitEnabled:                     builder.containerInitEnabled,
 		restartPolicy:                            builder.restartPolicy,
+		user:                                     builder.user,
+		sysctls:                                  builder.sysctls,
+		ulimits:                                  builder.ulimits,
+		tmpfsDirs:                                builder.tmpfsDirs,
+		shmSizeMegabytes:                         builder.shmSizeMegabytes,
 	}
 }
 
@@ -249,3 +269,37 @@ func (builder *CreateAndStartContainerArgsBuilder) WithContainerInitEnabled(cont
 	builder.containerInitEnabled = containerInitEnabled
 	return builder
 }
+
+// The user the container runs as, corresponding to the --user Docker flag (e.g. "1000" or "1000:1000"); leave empty
+// to run as the default user of the image
+func (builder *CreateAndStartContainerArgsBuilder) WithUser(user string) *CreateAndStartContainerArgsBuilder {
+	builder.user = user
+	return builder
+}
+
+// Namespaced kernel parameters to set in the container, corresponding to the --sysctl Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithSysctls(sysctls map[string]string) *CreateAndStartContainerArgsBuilder {
+	builder.sysctls = sysctls
+	return builder
+}
+
+// Mapping of (ulimit name) -> (value) that will be set as both the soft and the hard limit of the container processes,
+// corresponding to the --ulimit Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithUlimits(ulimits map[string]uint64) *CreateAndStartContainerArgsBuilder {
+	builder.ulimits = ulimits
+	return builder
+}
+
+// Mapping of (mountpoint on container) -> (tmpfs mount options, e.g. "size=64m") of the tmpfs mounts to create on
+// container startup, corresponding to the --tmpfs Docker flag
+func (builder *CreateAndStartContainerArgsBuilder) WithTmpfsDirs(tmpfsDirs map[string]string) *CreateAndStartContainerArgsBuilder {
+	builder.tmpfsDirs = tmpfsDirs
+	return builder
+}
+
+// Corresponds to `--shm-size` in Docker in megabytes
+// 0 is the empty value, meaning if the value is 0, the Docker default is used
+func (builder *CreateAndStartContainerArgsBuilder) WithShmSizeMegabytes(shmSizeMegabytes uint64) *CreateAndStartContainerArgsBuilder {
+	builder.shmSizeMegabytes = shmSizeMegabytes
+	return builder
+}
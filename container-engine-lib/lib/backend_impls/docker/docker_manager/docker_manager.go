@@ -19,6 +19,7 @@ import (
 	"github.com/docker/docker/pkg/jsonmessage"
 	"github.com/docker/docker/pkg/stdcopy"
 	"github.com/docker/go-connections/nat"
+	"github.com/docker/go-units"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_kurtosis_backend/consts"
 	docker_manager_types "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/docker_manager/types"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
@@ -500,6 +501,7 @@ func (manager *DockerManager) CreateAndStartContainer(
 		args.cmdArgs,
 		args.envVariables,
 		args.labels,
+		args.user,
 	)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "Failed to configure container from service.")
@@ -515,7 +517,11 @@ func (manager *DockerManager) CreateAndStartContainer(
 		args.memoryAllocationMegabytes,
 		args.loggingDriverConfig,
 		args.containerInitEnabled,
-		args.restartPolicy)
+		args.restartPolicy,
+		args.sysctls,
+		args.ulimits,
+		args.tmpfsDirs,
+		args.shmSizeMegabytes)
 	if err != nil {
 		return "", nil, stacktrace.Propagate(err, "Failed to configure host to container mappings from service.")
 	}
@@ -1350,6 +1356,10 @@ func (manager *DockerManager) getContainerHostConfig(
 	loggingDriverConfig LoggingDriver,
 	useInit bool,
 	restartPolicy RestartPolicy,
+	sysctls map[string]string,
+	ulimits map[string]uint64,
+	tmpfsDirs map[string]string,
+	shmSizeMegabytes uint64,
 ) (hostConfig *container.HostConfig, err error) {
 
 	bindsList := make([]string, 0, len(bindMounts))
@@ -1463,6 +1473,14 @@ func (manager *DockerManager) getContainerHostConfig(
 		resources.MemorySwap = int64(memoryAllocationBytes)
 	}
 
+	for ulimitName, ulimitValue := range ulimits {
+		resources.Ulimits = append(resources.Ulimits, &units.Ulimit{
+			Name: ulimitName,
+			Soft: int64(ulimitValue),
+			Hard: int64(ulimitValue),
+		})
+	}
+
 	logConfig := container.LogConfig{
 		Type:   "",
 		Config: nil,
@@ -1507,11 +1525,11 @@ func (manager *DockerManager) getContainerHostConfig(
 		ReadonlyRootfs:  false,
 		SecurityOpt:     nil,
 		StorageOpt:      nil,
-		Tmpfs:           nil,
+		Tmpfs:           tmpfsDirs,
 		UTSMode:         "",
 		UsernsMode:      "",
-		ShmSize:         0,
-		Sysctls:         nil,
+		ShmSize:         int64(convertMegabytesToBytes(shmSizeMegabytes)),
+		Sysctls:         sysctls,
 		Runtime:         "",
 		ConsoleSize:     [2]uint{},
 		Isolation:       "",
@@ -1532,7 +1550,8 @@ func (manager *DockerManager) getContainerCfg(
 	entrypointArgs []string,
 	cmdArgs []string,
 	envVariables map[string]string,
-	labels map[string]string) (config *container.Config, err error) {
+	labels map[string]string,
+	user string) (config *container.Config, err error) {
 
 	envVariablesSlice := make([]string, 0, len(envVariables))
 	for key, val := range envVariables {
@@ -1542,7 +1561,7 @@ func (manager *DockerManager) getContainerCfg(
 	nodeConfigPtr := &container.Config{
 		Hostname:        "",
 		Domainname:      "",
-		User:            "",
+		User:            user,
 		AttachStdin:     isInteractiveMode, // Analogous to `-a STDIN` option to `docker run`
 		AttachStdout:    isInteractiveMode, // Analogous to `-a STDOUT` option to `docker run`
 		AttachStderr:    isInteractiveMode, // Analogous to `-a STDERR` option to `docker run`
@@ -46,3 +46,16 @@ func (config *fluentdLoggingDriver) GetLogConfig() container.LogConfig {
 func (config *fluentdLoggingDriver) getLabelsStr() string {
 	return strings.Join(config.labels, labelsSeparator)
 }
+
+// GetFluentdLoggingDriverAddress returns the address a container sends its logs to, or false if the container isn't
+// configured with the Fluentd logging driver
+func GetFluentdLoggingDriverAddress(logConfig container.LogConfig) (string, bool) {
+	if logConfig.Type != fluentdLoggingDriverTypeName {
+		return "", false
+	}
+	address, found := logConfig.Config[fluentdLoggingDriverAddressConfigKey]
+	if !found || address == "" {
+		return "", false
+	}
+	return address, true
+}
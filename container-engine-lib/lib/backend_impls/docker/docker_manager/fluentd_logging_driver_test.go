@@ -0,0 +1,27 @@
+package docker_manager
+
+import (
+	"github.com/docker/docker/api/types/container"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestGetFluentdLoggingDriverAddress(t *testing.T) {
+	logConfig := NewFluentdLoggingDriver("172.16.0.3:9713", []string{"com.kurtosistech.guid"}).GetLogConfig()
+
+	address, found := GetFluentdLoggingDriverAddress(logConfig)
+	require.True(t, found)
+	require.Equal(t, "172.16.0.3:9713", address)
+}
+
+func TestGetFluentdLoggingDriverAddress_OtherLoggingDriver(t *testing.T) {
+	logConfig := container.LogConfig{
+		Type: "json-file",
+		Config: map[string]string{
+			fluentdLoggingDriverAddressConfigKey: "172.16.0.3:9713",
+		},
+	}
+
+	_, found := GetFluentdLoggingDriverAddress(logConfig)
+	require.False(t, found)
+}
@@ -27,6 +27,7 @@ const (
 	persistentServiceDirectoryNameFragment = "service-persistent-directory"
 
 	artifactsExpanderContainerNameFragment = "files-artifacts-expander"
+	networkingSidecarContainerNameFragment = "networking-sidecar"
 	logsCollectorFragment                  = "kurtosis-logs-collector"
 	// The collector is per enclave so this is a suffix
 	logsCollectorVolumeFragment = logsCollectorFragment + "-vol"
@@ -39,16 +40,22 @@ type DockerEnclaveObjectAttributesProvider interface {
 		ipAddr net.IP,
 		privateGrpcPortId string,
 		privateGrpcPortSpec *port_spec.PortSpec,
+		imageVersionTag string,
+		enclaveEnvVarsHash string,
 	) (DockerObjectAttributes, error)
 	ForUserServiceContainer(
 		serviceName service.ServiceName,
 		serviceUuid service.ServiceUUID,
 		privateIpAddr net.IP,
 		privatePorts map[string]*port_spec.PortSpec,
+		userLabels map[string]string,
 	) (DockerObjectAttributes, error)
 	ForFilesArtifactsExpanderContainer(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
+	ForNetworkingSidecarContainer(
+		serviceUUID service.ServiceUUID,
+	) (DockerObjectAttributes, error)
 	ForSingleFilesArtifactExpansionVolume(
 		serviceUUID service.ServiceUUID,
 	) (DockerObjectAttributes, error)
@@ -153,6 +160,8 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForApiContainer(
 	ipAddr net.IP,
 	privateGrpcPortId string,
 	privateGrpcPortSpec *port_spec.PortSpec,
+	imageVersionTag string,
+	enclaveEnvVarsHash string,
 ) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForEnclaveObject(
 		[]string{
@@ -176,6 +185,21 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForApiContainer(
 	labels[label_key_consts.ContainerTypeDockerLabelKey] = label_value_consts.APIContainerContainerTypeDockerLabelValue
 	labels[label_key_consts.PrivateIPDockerLabelKey] = privateIpLabelValue
 
+	// Images without tag have no version to record
+	if imageVersionTag != "" {
+		imageVersionTagLabelValue, err := docker_label_value.CreateNewDockerLabelValue(imageVersionTag)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from API container image version tag '%v'", imageVersionTag)
+		}
+		labels[label_key_consts.APIContainerImageVersionTagDockerLabelKey] = imageVersionTagLabelValue
+	}
+
+	enclaveEnvVarsHashLabelValue, err := docker_label_value.CreateNewDockerLabelValue(enclaveEnvVarsHash)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value object from API container enclave env vars hash '%v'", enclaveEnvVarsHash)
+	}
+	labels[label_key_consts.APIContainerEnclaveEnvVarsHashDockerLabelKey] = enclaveEnvVarsHashLabelValue
+
 	usedPorts := map[string]*port_spec.PortSpec{
 		privateGrpcPortId: privateGrpcPortSpec,
 	}
@@ -203,6 +227,7 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 	serviceUuid service.ServiceUUID,
 	privateIpAddr net.IP,
 	privatePorts map[string]*port_spec.PortSpec,
+	userLabels map[string]string,
 ) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForUserServiceContainer(
 		serviceName,
@@ -235,6 +260,9 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForUserServiceContain
 	labels[label_key_consts.ContainerTypeDockerLabelKey] = label_value_consts.UserServiceContainerTypeDockerLabelValue
 	labels[label_key_consts.PortSpecsDockerLabelKey] = serializedPortsSpec
 	labels[label_key_consts.PrivateIPDockerLabelKey] = privateIpLabelValue
+	if err := addUserLabels(labels, userLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the labels set by the user to the user service container labels")
+	}
 
 	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
 	if err != nil {
@@ -320,9 +348,10 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForSinglePersistentDi
 		return nil, stacktrace.Propagate(err, "An error occurred creating the files artifact expansion volume name object using GUID '%v' and service GUID '%v'", guidStr, serviceUuidStr)
 	}
 
-	labels, err := provider.getLabelsForEnclaveObjectWithGUID(guidStr)
+	// the persistent key is the ID so that the persistent directories of an enclave can be listed by key
+	labels, err := provider.getLabelsForEnclaveObjectWithIDAndGUID(string(persistentKey), guidStr)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting labels for files artifact expansion volume with UUID '%v'", guidStr)
+		return nil, stacktrace.Propagate(err, "An error occurred getting labels for persistent directory volume with UUID '%v'", guidStr)
 	}
 
 	serviceUuidLabelValue, err := docker_label_value.CreateNewDockerLabelValue(serviceUuidStr)
@@ -384,6 +413,41 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) ForFilesArtifactsExpa
 	return objectAttributes, nil
 }
 
+// ForNetworkingSidecarContainer doesn't label the sidecar with a GUID as it's not a standalone enclave object: it's
+// only identified by the UUID of the user service it's attached to
+func (provider *dockerEnclaveObjectAttributesProviderImpl) ForNetworkingSidecarContainer(
+	serviceUUID service.ServiceUUID,
+) (
+	DockerObjectAttributes,
+	error,
+) {
+	serviceUuidStr := string(serviceUUID)
+
+	name, err := provider.getNameForEnclaveObject([]string{
+		networkingSidecarContainerNameFragment,
+		serviceUuidStr,
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the networking sidecar container name for service '%v'", serviceUuidStr)
+	}
+
+	labels := provider.getLabelsForEnclaveObject()
+
+	serviceUuidLabelValue, err := docker_label_value.CreateNewDockerLabelValue(serviceUuidStr)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Docker label value from service GUID string '%v'", serviceUuidStr)
+	}
+	labels[label_key_consts.UserServiceGUIDDockerLabelKey] = serviceUuidLabelValue
+	labels[label_key_consts.ContainerTypeDockerLabelKey] = label_value_consts.NetworkingSidecarContainerTypeDockerLabelValue
+
+	objectAttributes, err := newDockerObjectAttributesImpl(name, labels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the ObjectAttributesImpl with the name '%s' and labels '%+v'", name, labels)
+	}
+
+	return objectAttributes, nil
+}
+
 func (provider *dockerEnclaveObjectAttributesProviderImpl) ForLogsCollector(tcpPortId string, tcpPortSpec *port_spec.PortSpec, httpPortId string, httpPortSpec *port_spec.PortSpec) (DockerObjectAttributes, error) {
 	name, err := provider.getNameForEnclaveObject([]string{logsCollectorFragment})
 	if err != nil {
@@ -502,6 +566,26 @@ func (provider *dockerEnclaveObjectAttributesProviderImpl) getLabelsForEnclaveOb
 	return labels, nil
 }
 
+// addUserLabels adds the labels set by the user, which can't override the ones Kurtosis uses to track its objects
+func addUserLabels(labels map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue, userLabels map[string]string) error {
+	kurtosisLabelKeyStrs := getLabelKeyValuesAsStrings(labels)
+	for userLabelKeyStr, userLabelValueStr := range userLabels {
+		if _, found := kurtosisLabelKeyStrs[userLabelKeyStr]; found || label_key_consts.IsKurtosisLabelKey(userLabelKeyStr) {
+			return stacktrace.NewError("Label key '%v' is reserved for the labels Kurtosis puts on its objects", userLabelKeyStr)
+		}
+		userLabelKey, err := docker_label_key.CreateNewDockerLabelKey(userLabelKeyStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a Docker label key from label key string '%v'", userLabelKeyStr)
+		}
+		userLabelValue, err := docker_label_value.CreateNewDockerLabelValue(userLabelValueStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a Docker label value from label value string '%v'", userLabelValueStr)
+		}
+		labels[userLabelKey] = userLabelValue
+	}
+	return nil
+}
+
 func getLabelKeyValuesAsStrings(labels map[*docker_label_key.DockerLabelKey]*docker_label_value.DockerLabelValue) map[string]string {
 	result := map[string]string{}
 	for key, value := range labels {
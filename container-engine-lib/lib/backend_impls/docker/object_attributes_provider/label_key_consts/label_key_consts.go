@@ -2,6 +2,7 @@ package label_key_consts
 
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/docker/object_attributes_provider/docker_label_key"
+	"strings"
 )
 
 const (
@@ -38,6 +39,12 @@ const (
 
 	privateIpAddrLabelKeyStr = labelNamespaceStr + "private-ip"
 
+	// The version tag of the image the API container runs, used to know whether an API container can be reused
+	apiContainerImageVersionTagLabelKeyStr = labelNamespaceStr + "api-container-image-version-tag"
+
+	// The hash of the environment variables of the enclave the API container runs, used to know whether an API container can be reused
+	apiContainerEnclaveEnvVarsHashLabelKeyStr = labelNamespaceStr + "api-container-enclave-env-vars-hash"
+
 	// We create a duplicate of the enclave uuid and service uuid label key because:
 	// the logs aggregator (vector) needs the enclave uuid and service uuid label keys to create the filepath where logs are stored in persistent volume
 	// but vectors template syntax can't interpret the "com.kurtosistech." prefix, so we can't use the existing label keys
@@ -66,8 +73,16 @@ var EnclaveUUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enc
 var EnclaveNameDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enclaveNameLabelKeyStr)
 var EnclaveCreationTimeLabelKey = docker_label_key.MustCreateNewDockerLabelKey(enclaveCreationTime)
 var PrivateIPDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(privateIpAddrLabelKeyStr)
+var APIContainerImageVersionTagDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(apiContainerImageVersionTagLabelKeyStr)
+var APIContainerEnclaveEnvVarsHashDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(apiContainerEnclaveEnvVarsHashLabelKeyStr)
 var UserServiceGUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(userServiceGuidDockerLabelKeyStr)
 var LogsEnclaveUUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(logsEnclaveUuidLabelKeyStr)
 var LogsServiceUUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(logsServiceUuidDockerLabelKey)
 var LogsServiceShortUUIDDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(logsServiceShortUuidDockerLabelKey)
 var LogsServiceNameDockerLabelKey = docker_label_key.MustCreateNewDockerLabelKey(logsServiceNameDockerLabelKey)
+
+// IsKurtosisLabelKey returns whether the label key belongs to the Kurtosis label namespace, which is reserved for
+// the labels Kurtosis puts on its objects
+func IsKurtosisLabelKey(labelKeyStr string) bool {
+	return strings.HasPrefix(labelKeyStr, labelNamespaceStr)
+}
@@ -19,6 +19,12 @@ const (
 	// need a proxy  that will translate grpc-web requests before they hit the main GRPC server
 	KurtosisInternalContainerGrpcProxyPortSpecId = "grpc-proxy"
 	HttpApplicationProtocol                      = "http"
+
+	// The logs are stored in this directory of a single node, which the logs storage volumes of the logs aggregator and
+	// the engine are pinned to, mirroring the logs storage volume used in Docker
+	LogsStorageHostPath = "/kurtosis-logs-storage"
+	// Location of the logs storage inside the logs aggregator and engine containers
+	LogsStorageDirpath = "/var/log/kurtosis/"
 )
 
 // This maps a Kubernetes pod's phase to a binary "is the pod considered running?" determiner
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions/implementations/vector"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	kubernetes_manager_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager/consts"
@@ -23,6 +25,8 @@ import (
 const (
 	kurtosisEngineContainerName = "kurtosis-engine-container"
 
+	logsStorageVolumeName = "kurtosis-logs-storage"
+
 	maxWaitForEngineContainerAvailabilityRetries         = 30
 	timeBetweenWaitForEngineContainerAvailabilityRetries = 1 * time.Second
 	httpApplicationProtocol                              = "http"
@@ -120,7 +124,33 @@ func CreateEngine(
 		}
 	}()
 
-	enginePod, enginePodLabels, err := createEnginePod(ctx, namespaceName, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, privatePortSpecs, serviceAccount.Name, kubernetesManager)
+	// The logs aggregator picks the node storing the logs, which the engine pod then gets scheduled on to read them
+	logsAggregatorDeployment := vector.NewVectorLogsAggregatorDeployment() // Declaring implementation
+	_, removeLogsAggregatorFunc, err := logs_aggregator_functions.CreateLogsAggregator(
+		ctx,
+		logsAggregatorDeployment,
+		kubernetesManager,
+		objAttrsProvider,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred attempting to create logging components for engine with GUID '%v'", engineGuid)
+	}
+	shouldRemoveCentralizedLogComponents := true
+	defer func() {
+		// A nil removal func means that a pre-existing logs aggregator is being reused, which we must not remove
+		if shouldRemoveCentralizedLogComponents && removeLogsAggregatorFunc != nil {
+			removeLogsAggregatorFunc()
+		}
+	}()
+	logrus.Infof("Centralized logs components started.")
+
+	// The claim gets removed along with the engine namespace, so it needs no removal of its own
+	logsStorageVolumeClaim, err := logs_aggregator_functions.CreateLogsStorageVolumeClaim(ctx, namespaceName, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs storage volume claim of the engine")
+	}
+
+	enginePod, enginePodLabels, err := createEnginePod(ctx, namespaceName, engineAttributesProvider, imageOrgAndRepo, imageVersionTag, envVars, privatePortSpecs, serviceAccount.Name, logsStorageVolumeClaim.Name, kubernetesManager)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating the engine pod")
 	}
@@ -208,6 +238,7 @@ func CreateEngine(
 	shouldRemoveClusterRoleBinding = false
 	shouldRemovePod = false
 	shouldRemoveService = false
+	shouldRemoveCentralizedLogComponents = false
 	return resultEngine, nil
 }
 
@@ -299,6 +330,9 @@ func createEngineClusterRole(
 				kubernetes_manager_consts.ServicesKubernetesResource,
 				kubernetes_manager_consts.PersistentVolumesKubernetesResource,
 				kubernetes_manager_consts.PersistentVolumeClaimsKubernetesResource,
+				kubernetes_manager_consts.ConfigMapsKubernetesResource,
+				kubernetes_manager_consts.DaemonSetsKubernetesResource,
+				kubernetes_manager_consts.DeploymentsKubernetesResource,
 				kubernetes_manager_consts.JobsKubernetesResource, // Necessary so that we can give the API container the permission
 			},
 		},
@@ -311,6 +345,7 @@ func createEngineClusterRole(
 			},
 			Resources: []string{
 				kubernetes_manager_consts.NodesKubernetesResource,
+				kubernetes_manager_consts.StorageClassesKubernetesResource, // Necessary to keep the enclave data on the default storage class
 			},
 		},
 	}
@@ -366,6 +401,7 @@ func createEnginePod(
 	envVars map[string]string,
 	privatePorts map[string]*port_spec.PortSpec,
 	serviceAccountName string,
+	logsStorageVolumeClaimName string,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
 ) (*apiv1.Pod, map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, error) {
 	// Get Pod Attributes
@@ -408,10 +444,59 @@ func createEnginePod(
 			Image: containerImageAndTag,
 			Env:   engineContainerEnvVars,
 			Ports: containerPorts,
+			VolumeMounts: []apiv1.VolumeMount{
+				{
+					Name:             logsStorageVolumeName,
+					ReadOnly:         false,
+					MountPath:        consts.LogsStorageDirpath,
+					SubPath:          "",
+					MountPropagation: nil,
+					SubPathExpr:      "",
+				},
+			},
 		},
 	}
 
-	engineVolumes := []apiv1.Volume{}
+	// The logs aggregator writes the logs into the logs storage, and the engine reads them from it
+	engineVolumes := []apiv1.Volume{
+		{
+			Name: logsStorageVolumeName,
+			VolumeSource: apiv1.VolumeSource{
+				HostPath:             nil,
+				EmptyDir:             nil,
+				GCEPersistentDisk:    nil,
+				AWSElasticBlockStore: nil,
+				GitRepo:              nil,
+				Secret:               nil,
+				NFS:                  nil,
+				ISCSI:                nil,
+				Glusterfs:            nil,
+				PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: logsStorageVolumeClaimName,
+					ReadOnly:  false,
+				},
+				RBD:                  nil,
+				FlexVolume:           nil,
+				Cinder:               nil,
+				CephFS:               nil,
+				Flocker:              nil,
+				DownwardAPI:          nil,
+				FC:                   nil,
+				AzureFile:            nil,
+				ConfigMap:            nil,
+				VsphereVolume:        nil,
+				Quobyte:              nil,
+				AzureDisk:            nil,
+				PhotonPersistentDisk: nil,
+				Projected:            nil,
+				PortworxVolume:       nil,
+				ScaleIO:              nil,
+				StorageOS:            nil,
+				CSI:                  nil,
+				Ephemeral:            nil,
+			},
+		},
+	}
 	engineInitContainers := []apiv1.Container{}
 
 	// Create pods with engine containers and volumes in kubernetes
@@ -425,6 +510,10 @@ func createEnginePod(
 		engineContainers,
 		engineVolumes,
 		serviceAccountName,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred while creating the pod with name '%s' in namespace '%s' with image '%s'", enginePodName, namespace, containerImageAndTag)
@@ -2,6 +2,7 @@ package engine_functions
 
 import (
 	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/stacktrace"
@@ -68,5 +69,10 @@ func StopEngines(
 		successfulEngineGuids[engineGuid] = true
 	}
 
+	// Stop centralized logging components
+	if err := logs_aggregator_functions.DestroyLogsAggregator(ctx, kubernetesManager); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred removing the logging components.")
+	}
+
 	return successfulEngineGuids, erroredEngineGuids, nil
 }
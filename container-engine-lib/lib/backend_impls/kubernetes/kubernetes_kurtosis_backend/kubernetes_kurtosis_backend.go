@@ -3,6 +3,11 @@ package kubernetes_kurtosis_backend
 import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/engine_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_aggregator_functions/implementations/vector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/logs_collector_functions/implementations/fluentbit"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/networking_sidecar_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/user_services_functions"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
@@ -10,12 +15,15 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/compute_resources"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
@@ -393,6 +401,55 @@ func (backend *KubernetesKurtosisBackend) CopyFilesFromUserService(
 		backend.kubernetesManager)
 }
 
+func (backend *KubernetesKurtosisBackend) GetPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error) {
+	return user_services_functions.GetPersistentDirectories(
+		ctx,
+		enclaveUuid,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKeys map[service_directory.DirectoryPersistentKey]bool,
+) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error) {
+	return user_services_functions.DestroyPersistentDirectories(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		persistentKeys,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) CopyFilesFromPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+) error {
+	return user_services_functions.CopyFilesFromPersistentDirectory(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		persistentKey,
+		output,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
 func (backend *KubernetesKurtosisBackend) StopUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (resultSuccessfulGuids map[service.ServiceUUID]bool, resultErroredGuids map[service.ServiceUUID]error, resultErr error) {
 	return user_services_functions.StopUserServices(
 		ctx,
@@ -423,33 +480,178 @@ func (backend *KubernetesKurtosisBackend) GetAvailableCPUAndMemory(ctx context.C
 func (backend *KubernetesKurtosisBackend) GetLogsAggregator(
 	ctx context.Context,
 ) (*logs_aggregator.LogsAggregator, error) {
-	// TODO IMPLEMENT
-	return nil, stacktrace.NewError("Getting the logs aggregator isn't yet implemented on Kubernetes")
+	maybeLogsAggregator, err := logs_aggregator_functions.GetLogsAggregator(
+		ctx,
+		backend.kubernetesManager,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator")
+	}
+
+	return maybeLogsAggregator, nil
+}
+
+func (backend *KubernetesKurtosisBackend) CreateNetworkingSidecar(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error) {
+	return networking_sidecar_functions.CreateNetworkingSidecar(
+		ctx,
+		enclaveUuid,
+		serviceUuid,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	return networking_sidecar_functions.GetNetworkingSidecars(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarCommands map[service.ServiceUUID][]string,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return networking_sidecar_functions.RunNetworkingSidecarExecCommands(
+		ctx,
+		enclaveUuid,
+		networkingSidecarCommands,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
+}
+
+func (backend *KubernetesKurtosisBackend) DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+) (
+	map[service.ServiceUUID]bool,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	return networking_sidecar_functions.DestroyNetworkingSidecars(
+		ctx,
+		enclaveUuid,
+		filters,
+		backend.cliModeArgs,
+		backend.apiContainerModeArgs,
+		backend.engineServerModeArgs,
+		backend.kubernetesManager)
 }
 
 func (backend *KubernetesKurtosisBackend) CreateLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
-	// TODO IMPLEMENT
-	return nil, stacktrace.NewError("Creating the logs aggregator isn't yet implemented on Kubernetes")
+	logsAggregatorDeployment := vector.NewVectorLogsAggregatorDeployment() //Declaring the implementation
+
+	logsAggregator, _, err := logs_aggregator_functions.CreateLogsAggregator(
+		ctx,
+		logsAggregatorDeployment,
+		backend.kubernetesManager,
+		backend.objAttrsProvider,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator using the logs aggregator deployment '%+v'.", logsAggregatorDeployment)
+	}
+	return logsAggregator, nil
 }
 
 func (backend *KubernetesKurtosisBackend) DestroyLogsAggregator(ctx context.Context) error {
-	// TODO IMPLEMENT
-	return stacktrace.NewError("Destroying the logs aggregator isn't yet implemented on Kubernetes")
+	if err := logs_aggregator_functions.DestroyLogsAggregator(ctx, backend.kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the logs aggregator")
+	}
+
+	return nil
 }
 
-func (backend *KubernetesKurtosisBackend) CreateLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, logsCollectorHttpPortNumber uint16, logsCollectorTcpPortNumber uint16) (*logs_collector.LogsCollector, error) {
-	// TODO IMPLEMENT
-	return nil, stacktrace.NewError("Creating the logs collector isn't yet implemented on Kubernetes")
+func (backend *KubernetesKurtosisBackend) CreateLogsCollectorForEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	logsCollectorHttpPortNumber uint16,
+	logsCollectorTcpPortNumber uint16,
+) (
+	*logs_collector.LogsCollector,
+	error,
+) {
+	logsAggregator, err := backend.GetLogsAggregator(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator; the logs collector cannot be run without a logs aggregator")
+	}
+
+	if logsAggregator == nil || logsAggregator.GetStatus() != container_status.ContainerStatus_Running {
+		return nil, stacktrace.NewError("The logs aggregator is not running; the logs collector cannot be run without a running logs aggregator")
+	}
+
+	enclaveNamespaceName, err := backend.getEnclaveNamespaceName(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the namespace of enclave '%v'", enclaveUuid)
+	}
+
+	//Declaring the implementation
+	logsCollectorDaemonSet := fluentbit.NewFluentbitLogsCollectorDaemonSet()
+
+	logsCollector, err := logs_collector_functions.CreateLogsCollectorForEnclave(
+		ctx,
+		enclaveUuid,
+		enclaveNamespaceName,
+		logsCollectorTcpPortNumber,
+		logsCollectorHttpPortNumber,
+		logsCollectorDaemonSet,
+		logsAggregator,
+		backend.kubernetesManager,
+		backend.objAttrsProvider,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector using the '%v' TCP port number, the '%v' HTTP port number and the logs collector daemon set '%+v'", logsCollectorTcpPortNumber, logsCollectorHttpPortNumber, logsCollectorDaemonSet)
+	}
+
+	return logsCollector, nil
 }
 
+// If nothing is found returns nil
 func (backend *KubernetesKurtosisBackend) GetLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (*logs_collector.LogsCollector, error) {
-	// TODO IMPLEMENT
-	return nil, stacktrace.NewError("Creating the logs collector isn't yet implemented on Kubernetes")
+	enclaveNamespaceName, err := backend.getEnclaveNamespaceName(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the namespace of enclave '%v'", enclaveUuid)
+	}
+
+	maybeLogsCollector, err := logs_collector_functions.GetLogsCollectorForEnclave(
+		ctx,
+		enclaveNamespaceName,
+		backend.kubernetesManager,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector")
+	}
+
+	return maybeLogsCollector, nil
 }
 
 func (backend *KubernetesKurtosisBackend) DestroyLogsCollectorForEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID) error {
-	// TODO IMPLEMENT
-	return stacktrace.NewError("Destroy the logs collector for enclave isn't yet implemented on Kubernetes")
+	enclaveNamespaceName, err := backend.getEnclaveNamespaceName(ctx, enclaveUuid)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the namespace of enclave '%v'", enclaveUuid)
+	}
+
+	if err := logs_collector_functions.DestroyLogsCollector(ctx, enclaveNamespaceName, backend.kubernetesManager); err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the logs collector")
+	}
+
+	return nil
 }
 
 // ====================================================================================================
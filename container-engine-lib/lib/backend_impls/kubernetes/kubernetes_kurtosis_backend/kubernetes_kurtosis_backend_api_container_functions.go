@@ -2,6 +2,7 @@ package kubernetes_kurtosis_backend
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	kubernetes_manager_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager/consts"
@@ -36,12 +37,33 @@ const (
 	timeBetweenWaitForApiContainerContainerAvailabilityRetries = 1 * time.Second
 
 	enclaveDataDirVolumeName = "enclave-data"
+	// There's no volume shared across namespaces in Kubernetes, so each API container caches the packages on its own and
+	// packages can't be run offline
+	packageCacheVolumeName = "package-cache"
+
+	// The enclave data volume gets the default size of the volumes
+	enclaveDataVolumeClaimDefaultSize = int64(0)
+
+	// The definition of the API container pod is kept in the enclave namespace so that the pod can be created again
+	// when the enclave gets started after being stopped
+	apiContainerPodDefinitionConfigMapName = "kurtosis-api-container-pod-definition"
+	apiContainerPodDefinitionConfigMapKey  = "pod-definition.json"
 )
 
 var noWait *port_spec.Wait = nil
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
+// apiContainerPodDefinition is what's needed to create the API container pod again when its enclave gets started
+type apiContainerPodDefinition struct {
+	Name               string            `json:"name"`
+	Labels             map[string]string `json:"labels"`
+	Annotations        map[string]string `json:"annotations"`
+	Containers         []apiv1.Container `json:"containers"`
+	Volumes            []apiv1.Volume    `json:"volumes"`
+	ServiceAccountName string            `json:"serviceAccountName"`
+}
+
 // Any of these values being nil indicates that the resource doesn't exist
 type apiContainerKubernetesResources struct {
 	// Will never be nil because an API container is defined by its service
@@ -66,8 +88,10 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 	enclaveId enclave.EnclaveUUID,
 	grpcPortNum uint16,
 	enclaveDataVolumeDirpath string,
+	packageCacheVolumeDirpath string,
 	ownIpAddressEnvVar string,
 	customEnvVars map[string]string,
+	enclaveEnvVars string,
 ) (
 	*api_container.APIContainer,
 	error,
@@ -130,7 +154,9 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 		consts.KurtosisInternalContainerGrpcPortSpecId,
 		privateGrpcPortSpec,
 		consts.KurtosisInternalContainerGrpcProxyPortSpecId,
-		nil)
+		nil,
+		api_container.GetImageVersionTag(image),
+		api_container.GetEnclaveEnvVarsHash(enclaveEnvVars))
 	if err != nil {
 		return nil, stacktrace.Propagate(
 			err,
@@ -411,7 +437,25 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 		return nil, stacktrace.Propagate(err, "An error occurred getting container ports from the API container's private port specs")
 	}
 
-	apiContainerContainers, apiContainerVolumes, err := getApiContainerContainersAndVolumes(image, containerPorts, envVarsWithOwnIp, enclaveDataVolumeDirpath)
+	enclaveDataVolumeClaim, err := backend.createEnclaveDataVolumeClaim(ctx, enclaveNamespaceName, apiContainerPodLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the volume claim for the enclave data in namespace '%v'", enclaveNamespaceName)
+	}
+	enclaveDataVolumeClaimName := ""
+	if enclaveDataVolumeClaim != nil {
+		enclaveDataVolumeClaimName = enclaveDataVolumeClaim.GetName()
+	}
+	shouldRemoveEnclaveDataVolumeClaim := true
+	defer func() {
+		if shouldRemoveEnclaveDataVolumeClaim && enclaveDataVolumeClaim != nil {
+			if err := backend.kubernetesManager.RemovePersistentVolumeClaim(ctx, enclaveNamespaceName, enclaveDataVolumeClaimName); err != nil {
+				logrus.Errorf("Creating the API container didn't complete successfully, so we tried to delete volume claim '%v' in namespace '%v' that we created but an error was thrown:\n%v", enclaveDataVolumeClaimName, enclaveNamespaceName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove volume claim with name '%v'!!!!!!!", enclaveDataVolumeClaimName)
+			}
+		}
+	}()
+
+	apiContainerContainers, apiContainerVolumes, err := getApiContainerContainersAndVolumes(image, containerPorts, envVarsWithOwnIp, enclaveDataVolumeDirpath, enclaveDataVolumeClaimName, packageCacheVolumeDirpath)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting API containers and volumes")
 	}
@@ -429,6 +473,10 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 		apiContainerContainers,
 		apiContainerVolumes,
 		apiContainerServiceAccountName,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the pod with name '%s' in namespace '%s' with image '%s'", apiContainerPodName, enclaveNamespaceName, image)
@@ -473,6 +521,18 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 		return nil, stacktrace.Propagate(err, "An error occurred waiting for the API container grpc port '%v/%v' to become available", privateGrpcPortSpec.GetTransportProtocol(), privateGrpcPortSpec.GetNumber())
 	}
 
+	podDefinition := &apiContainerPodDefinition{
+		Name:               apiContainerPodName,
+		Labels:             apiContainerPodLabels,
+		Annotations:        apiContainerPodAnnotations,
+		Containers:         apiContainerContainers,
+		Volumes:            apiContainerVolumes,
+		ServiceAccountName: apiContainerServiceAccountName,
+	}
+	if err := backend.saveApiContainerPodDefinition(ctx, enclaveNamespaceName, podDefinition); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred saving the definition of the API container pod in namespace '%v'", enclaveNamespaceName)
+	}
+
 	shouldRemoveClusterRole = false
 	shouldRemoveClusterRoleBinding = false
 	shouldRemoveRoleBinding = false
@@ -480,6 +540,7 @@ func (backend *KubernetesKurtosisBackend) CreateAPIContainer(
 	shouldRemoveServiceAccount = false
 	shouldRemovePod = false
 	shouldRemoveService = false
+	shouldRemoveEnclaveDataVolumeClaim = false
 	return resultApiContainer, nil
 }
 
@@ -980,6 +1041,9 @@ func getApiContainerObjectsFromKubernetesResources(
 			publicIpAddr,
 			publicGrpcPortSpec,
 			nil,
+			// API containers created by old Kurtosis versions don't have the labels, which leaves the version and hash empty
+			kubernetesService.Labels[label_key_consts.APIContainerImageVersionTagKubernetesLabelKey.GetString()],
+			kubernetesService.Labels[label_key_consts.APIContainerEnclaveEnvVarsHashKubernetesLabelKey.GetString()],
 		)
 
 		result[enclaveId] = apiContainerObj
@@ -987,11 +1051,15 @@ func getApiContainerObjectsFromKubernetesResources(
 	return result, nil
 }
 
+// getApiContainerContainersAndVolumes keeps the enclave data in the given volume claim, or in an emptyDir volume only
+// living as long as the pod if the claim name is empty
 func getApiContainerContainersAndVolumes(
 	containerImageAndTag string,
 	containerPorts []apiv1.ContainerPort,
 	envVars map[string]string,
 	enclaveDataVolumeDirpath string,
+	enclaveDataVolumeClaimName string,
+	packageCacheVolumeDirpath string,
 ) (
 	resultContainers []apiv1.Container,
 	resultPodVolumes []apiv1.Volume,
@@ -1038,13 +1106,65 @@ func getApiContainerContainersAndVolumes(
 					Name:      enclaveDataDirVolumeName,
 					MountPath: enclaveDataVolumeDirpath,
 				},
+				{
+					Name:      packageCacheVolumeName,
+					MountPath: packageCacheVolumeDirpath,
+				},
 			},
 		},
 	}
 
+	var enclaveDataEmptyDir *apiv1.EmptyDirVolumeSource
+	var enclaveDataVolumeClaim *apiv1.PersistentVolumeClaimVolumeSource
+	if enclaveDataVolumeClaimName != "" {
+		enclaveDataVolumeClaim = &apiv1.PersistentVolumeClaimVolumeSource{
+			ClaimName: enclaveDataVolumeClaimName,
+			ReadOnly:  false,
+		}
+	} else {
+		enclaveDataEmptyDir = &apiv1.EmptyDirVolumeSource{
+			Medium:    "",
+			SizeLimit: nil,
+		}
+	}
+
 	volumes := []apiv1.Volume{
 		{
 			Name: enclaveDataDirVolumeName,
+			VolumeSource: apiv1.VolumeSource{
+				HostPath:              nil,
+				EmptyDir:              enclaveDataEmptyDir,
+				GCEPersistentDisk:     nil,
+				AWSElasticBlockStore:  nil,
+				GitRepo:               nil,
+				Secret:                nil,
+				NFS:                   nil,
+				ISCSI:                 nil,
+				Glusterfs:             nil,
+				PersistentVolumeClaim: enclaveDataVolumeClaim,
+				RBD:                   nil,
+				FlexVolume:            nil,
+				Cinder:                nil,
+				CephFS:                nil,
+				Flocker:               nil,
+				DownwardAPI:           nil,
+				FC:                    nil,
+				AzureFile:             nil,
+				ConfigMap:             nil,
+				VsphereVolume:         nil,
+				Quobyte:               nil,
+				AzureDisk:             nil,
+				PhotonPersistentDisk:  nil,
+				Projected:             nil,
+				PortworxVolume:        nil,
+				ScaleIO:               nil,
+				StorageOS:             nil,
+				CSI:                   nil,
+				Ephemeral:             nil,
+			},
+		},
+		{
+			Name: packageCacheVolumeName,
 			VolumeSource: apiv1.VolumeSource{
 				HostPath: nil,
 				EmptyDir: &apiv1.EmptyDirVolumeSource{
@@ -1085,6 +1205,95 @@ func getApiContainerContainersAndVolumes(
 	return containers, volumes, nil
 }
 
+// createEnclaveDataVolumeClaim keeps the enclave data on a volume provisioned by the default storage class of the
+// cluster, so that the data outlives the API container pod when the enclave gets stopped. It returns nil if the
+// cluster has no default storage class, in which case the enclave can't be started again once stopped
+func (backend *KubernetesKurtosisBackend) createEnclaveDataVolumeClaim(
+	ctx context.Context,
+	namespaceName string,
+	volumeClaimLabels map[string]string,
+) (*apiv1.PersistentVolumeClaim, error) {
+	defaultStorageClassName, err := backend.kubernetesManager.GetDefaultStorageClassName(ctx)
+	if err != nil {
+		logrus.Warnf("Couldn't get the default storage class of the cluster, so the data of the enclave in namespace '%v' won't outlive its API container pod. Error was:\n%v", namespaceName, err)
+		return nil, nil
+	}
+	if defaultStorageClassName == "" {
+		logrus.Warnf("The cluster has no default storage class, so the data of the enclave in namespace '%v' won't outlive its API container pod", namespaceName)
+		return nil, nil
+	}
+	volumeClaim, err := backend.kubernetesManager.CreatePersistentVolumeClaim(
+		ctx,
+		namespaceName,
+		enclaveDataDirVolumeName,
+		volumeClaimLabels,
+		enclaveDataVolumeClaimDefaultSize,
+		defaultStorageClassName,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating volume claim '%v' with storage class '%v' in namespace '%v'", enclaveDataDirVolumeName, defaultStorageClassName, namespaceName)
+	}
+	return volumeClaim, nil
+}
+
+func (backend *KubernetesKurtosisBackend) saveApiContainerPodDefinition(
+	ctx context.Context,
+	namespaceName string,
+	podDefinition *apiContainerPodDefinition,
+) error {
+	serializedPodDefinition, err := json.Marshal(podDefinition)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the definition of API container pod '%v'", podDefinition.Name)
+	}
+	configMapData := map[string]string{
+		apiContainerPodDefinitionConfigMapKey: string(serializedPodDefinition),
+	}
+	if _, err := backend.kubernetesManager.CreateConfigMap(ctx, namespaceName, apiContainerPodDefinitionConfigMapName, podDefinition.Labels, configMapData); err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating config map '%v' in namespace '%v'", apiContainerPodDefinitionConfigMapName, namespaceName)
+	}
+	return nil
+}
+
+// getApiContainerPodDefinition returns nil if the namespace has no API container pod definition, which is the case for
+// the enclaves created before the definition got saved
+func (backend *KubernetesKurtosisBackend) getApiContainerPodDefinition(
+	ctx context.Context,
+	namespaceName string,
+	enclaveUuid enclave.EnclaveUUID,
+) (*apiContainerPodDefinition, error) {
+	configMapLabels := getApiContainerMatchLabels()
+	configMapLabels[label_key_consts.EnclaveUUIDKubernetesLabelKey.GetString()] = string(enclaveUuid)
+	configMaps, err := backend.kubernetesManager.GetConfigMapsByLabels(ctx, namespaceName, configMapLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the config maps of the API container in namespace '%v'", namespaceName)
+	}
+	for _, configMap := range configMaps.Items {
+		if configMap.GetName() != apiContainerPodDefinitionConfigMapName {
+			continue
+		}
+		serializedPodDefinition, found := configMap.Data[apiContainerPodDefinitionConfigMapKey]
+		if !found {
+			return nil, stacktrace.NewError("Config map '%v' in namespace '%v' has no '%v' key", apiContainerPodDefinitionConfigMapName, namespaceName, apiContainerPodDefinitionConfigMapKey)
+		}
+		podDefinition := &apiContainerPodDefinition{} //nolint:exhaustruct
+		if err := json.Unmarshal([]byte(serializedPodDefinition), podDefinition); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the definition of the API container pod in namespace '%v'", namespaceName)
+		}
+		return podDefinition, nil
+	}
+	return nil, nil
+}
+
+// hasPersistentEnclaveData returns true if the enclave data is kept on a volume claim rather than in the pod
+func (podDefinition *apiContainerPodDefinition) hasPersistentEnclaveData() bool {
+	for _, volume := range podDefinition.Volumes {
+		if volume.Name == enclaveDataDirVolumeName {
+			return volume.PersistentVolumeClaim != nil
+		}
+	}
+	return false
+}
+
 func getApiContainerMatchLabels() map[string]string {
 	engineMatchLabels := map[string]string{
 		label_key_consts.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
@@ -8,6 +8,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
@@ -22,6 +23,14 @@ import (
 
 // TODO: MIGRATE THIS FOLDER TO USE STRUCTURE OF USER_SERVICE_FUNCTIONS MODULE
 
+const (
+	defaultHttpLogsCollectorPortNum = uint16(9712)
+	defaultTcpLogsCollectorPortNum  = uint16(9713)
+
+	maxWaitForRestartedApiContainerAvailabilityRetries         = 60
+	timeBetweenWaitForRestartedApiContainerAvailabilityRetries = 1 * time.Second
+)
+
 // Any of these values being nil indicates that the resource doesn't exist
 type enclaveKubernetesResources struct {
 	// Will never be nil because enclaves are defined by namespaces
@@ -51,6 +60,7 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
 	enclaveName string,
+	kubernetesSettings *enclave.KubernetesSettings,
 ) (
 	*enclave.Enclave,
 	error,
@@ -73,7 +83,7 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 
 	// Make Enclave attributes provider
 	enclaveObjAttrsProvider := backend.objAttrsProvider.ForEnclave(enclaveUuid)
-	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName)
+	enclaveNamespaceAttrs, err := enclaveObjAttrsProvider.ForEnclaveNamespace(creationTime, enclaveName, kubernetesSettings)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while trying to get the enclave network attributes for the enclave with ID '%v'", enclaveUuid)
 	}
@@ -96,6 +106,26 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 		}
 	}()
 
+	// The service account is removed along with the namespace
+	if kubernetesSettings != nil && kubernetesSettings.GetServiceAccountName() != "" {
+		serviceAccountName := kubernetesSettings.GetServiceAccountName()
+		if _, err := backend.kubernetesManager.CreateServiceAccount(ctx, serviceAccountName, enclaveNamespaceName, searchNamespaceLabels); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating service account '%v' for the services of enclave '%v'", serviceAccountName, enclaveUuid)
+		}
+	}
+
+	if _, err := backend.CreateLogsCollectorForEnclave(ctx, enclaveUuid, defaultHttpLogsCollectorPortNum, defaultTcpLogsCollectorPortNum); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector with TCP port number '%v' and HTTP port number '%v'", defaultTcpLogsCollectorPortNum, defaultHttpLogsCollectorPortNum)
+	}
+	shouldDeleteLogsCollector := true
+	defer func() {
+		if shouldDeleteLogsCollector {
+			if err := backend.DestroyLogsCollectorForEnclave(teardownContext, enclaveUuid); err != nil {
+				logrus.Errorf("Couldn't cleanup logs collector for enclave '%v' as the following error was thrown:\n%v", enclaveUuid, err)
+			}
+		}
+	}()
+
 	enclaveResources := &enclaveKubernetesResources{
 		namespace:           enclaveNamespace,
 		pods:                []apiv1.Pod{},
@@ -115,6 +145,7 @@ func (backend *KubernetesKurtosisBackend) CreateEnclave(
 		return nil, stacktrace.NewError("Successfully converted the new enclave's Kubernetes resources to an enclave object, but the resulting map didn't have an entry for enclave UUID '%v'", enclaveUuid)
 	}
 
+	shouldDeleteLogsCollector = false
 	shouldDeleteNamespace = false
 	return resultEnclave, nil
 }
@@ -246,6 +277,34 @@ func (backend *KubernetesKurtosisBackend) StopEnclaves(
 	return successfulEnclaveIds, erroredEnclaveIds, nil
 }
 
+// StartEnclaves creates the API container pod of each stopped enclave again over the enclave data kept in its volume
+// claim, and points the services of the enclave back to their pods. The API container then restarts the user services
+// it finds in the enclave data when it boots
+func (backend *KubernetesKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	map[enclave.EnclaveUUID]bool,
+	map[enclave.EnclaveUUID]error,
+	error,
+) {
+	_, matchingKubernetesResources, err := backend.getMatchingEnclaveObjectsAndKubernetesResources(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting enclaves and Kubernetes resources matching filters '%+v'", filters)
+	}
+
+	successfulEnclaveIds := map[enclave.EnclaveUUID]bool{}
+	erroredEnclaveIds := map[enclave.EnclaveUUID]error{}
+	for enclaveId, resources := range matchingKubernetesResources {
+		if err := backend.startEnclave(ctx, enclaveId, resources); err != nil {
+			erroredEnclaveIds[enclaveId] = stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveId)
+			continue
+		}
+		successfulEnclaveIds[enclaveId] = true
+	}
+	return successfulEnclaveIds, erroredEnclaveIds, nil
+}
+
 func (backend *KubernetesKurtosisBackend) DumpEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, outputDirpath string) error {
 	_, kubernetesResources, err := backend.getSingleEnclaveAndKubernetesResources(ctx, enclaveUuid)
 	if err != nil {
@@ -354,6 +413,132 @@ func (backend *KubernetesKurtosisBackend) DestroyEnclaves(
 //	Private Helper Methods
 //
 // ====================================================================================================
+func (backend *KubernetesKurtosisBackend) startEnclave(
+	ctx context.Context,
+	enclaveId enclave.EnclaveUUID,
+	resources *enclaveKubernetesResources,
+) error {
+	namespaceName := resources.namespace.GetName()
+
+	podDefinition, err := backend.getApiContainerPodDefinition(ctx, namespaceName, enclaveId)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the definition of the API container pod of enclave '%v'", enclaveId)
+	}
+	if podDefinition == nil {
+		return stacktrace.NewError("Enclave '%v' has no API container pod definition, so it can't be started; only the enclaves created by this version of Kurtosis or a later one can be started after being stopped", enclaveId)
+	}
+	if !podDefinition.hasPersistentEnclaveData() {
+		return stacktrace.NewError("Enclave '%v' can't be started as its data wasn't kept when it got stopped, because the cluster had no default storage class when the enclave was created", enclaveId)
+	}
+
+	for _, pod := range resources.pods {
+		if pod.GetName() == podDefinition.Name {
+			return stacktrace.NewError("Enclave '%v' can't be started as its API container pod '%v' already exists", enclaveId, podDefinition.Name)
+		}
+	}
+
+	if err := backend.restoreEnclaveServicesSelectors(ctx, namespaceName, enclaveId, podDefinition, resources.services); err != nil {
+		return stacktrace.Propagate(err, "An error occurred pointing the services of enclave '%v' back to their pods", enclaveId)
+	}
+
+	apiContainerInitContainers := []apiv1.Container{}
+	apiContainerPod, err := backend.kubernetesManager.CreatePod(
+		ctx,
+		namespaceName,
+		podDefinition.Name,
+		podDefinition.Labels,
+		podDefinition.Annotations,
+		apiContainerInitContainers,
+		podDefinition.Containers,
+		podDefinition.Volumes,
+		podDefinition.ServiceAccountName,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating API container pod '%v' in namespace '%v'", podDefinition.Name, namespaceName)
+	}
+	shouldRemovePod := true
+	defer func() {
+		if shouldRemovePod {
+			if err := backend.kubernetesManager.RemovePod(ctx, apiContainerPod); err != nil {
+				logrus.Errorf("Starting enclave '%v' didn't complete successfully, so we tried to delete API container pod '%v' that we created but an error was thrown:\n%v", enclaveId, podDefinition.Name, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove Kubernetes pod with name '%v'!!!!!!!", podDefinition.Name)
+			}
+		}
+	}()
+
+	apiContainers, err := backend.GetAPIContainers(ctx, &api_container.APIContainerFilters{
+		EnclaveIDs: map[enclave.EnclaveUUID]bool{
+			enclaveId: true,
+		},
+		Statuses: nil,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the API container of enclave '%v'", enclaveId)
+	}
+	apiContainer, found := apiContainers[enclaveId]
+	if !found {
+		return stacktrace.NewError("Couldn't find the API container of enclave '%v' after creating its pod", enclaveId)
+	}
+	privateGrpcPortSpec := apiContainer.GetPrivateGRPCPort()
+	if err := shared_helpers.WaitForPortAvailabilityUsingNetstat(
+		backend.kubernetesManager,
+		namespaceName,
+		podDefinition.Name,
+		kurtosisApiContainerContainerName,
+		privateGrpcPortSpec,
+		maxWaitForRestartedApiContainerAvailabilityRetries,
+		timeBetweenWaitForRestartedApiContainerAvailabilityRetries,
+	); err != nil {
+		return stacktrace.Propagate(err, "An error occurred waiting for the grpc port '%v/%v' of the API container of enclave '%v' to become available", privateGrpcPortSpec.GetTransportProtocol(), privateGrpcPortSpec.GetNumber(), enclaveId)
+	}
+
+	shouldRemovePod = false
+	return nil
+}
+
+// restoreEnclaveServicesSelectors sets the selectors of the services of the enclave that lost them back to the labels
+// of their pods
+func (backend *KubernetesKurtosisBackend) restoreEnclaveServicesSelectors(
+	ctx context.Context,
+	namespaceName string,
+	enclaveId enclave.EnclaveUUID,
+	podDefinition *apiContainerPodDefinition,
+	services []apiv1.Service,
+) error {
+	resourceTypeLabelKey := label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString()
+	for _, service := range services {
+		if len(service.Spec.Selector) > 0 {
+			continue
+		}
+		var selector map[string]string
+		switch service.Labels[resourceTypeLabelKey] {
+		case label_value_consts.APIContainerKurtosisResourceTypeKubernetesLabelValue.GetString():
+			selector = podDefinition.Labels
+		case label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString():
+			selector = map[string]string{
+				label_key_consts.AppIDKubernetesLabelKey.GetString():       label_value_consts.AppIDKubernetesLabelValue.GetString(),
+				label_key_consts.EnclaveUUIDKubernetesLabelKey.GetString(): string(enclaveId),
+				label_key_consts.GUIDKubernetesLabelKey.GetString():        service.Labels[label_key_consts.GUIDKubernetesLabelKey.GetString()],
+			}
+		default:
+			continue
+		}
+		serviceName := service.GetName()
+		updateConfigurator := func(updatesToApply *applyconfigurationsv1.ServiceApplyConfiguration) {
+			specUpdates := applyconfigurationsv1.ServiceSpec().WithSelector(selector)
+			updatesToApply.WithSpec(specUpdates)
+		}
+		if _, err := backend.kubernetesManager.UpdateService(ctx, namespaceName, serviceName, updateConfigurator); err != nil {
+			return stacktrace.Propagate(err, "An error occurred setting the selector of service '%v' in namespace '%v'", serviceName, namespaceName)
+		}
+	}
+	return nil
+}
+
 func (backend *KubernetesKurtosisBackend) getMatchingEnclaveObjectsAndKubernetesResources(
 	ctx context.Context,
 	filters *enclave.EnclaveFilters,
@@ -528,7 +713,14 @@ func (backend *KubernetesKurtosisBackend) createGetEnclaveResourcesOperation(
 		}
 
 		var pods []apiv1.Pod
-		pods = append(pods, podsList.Items...)
+		for _, pod := range podsList.Items {
+			// The logs collector pods carry the enclave labels but aren't part of the enclave's workload, so they
+			// mustn't count towards the enclave status
+			if pod.Labels[label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString()] == label_value_consts.LogsCollectorKurtosisResourceTypeKubernetesLabelValue.GetString() {
+				continue
+			}
+			pods = append(pods, pod)
+		}
 
 		var services []apiv1.Service
 		services = append(services, servicesList.Items...)
@@ -573,6 +765,11 @@ func getEnclaveObjectsFromKubernetesResources(
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting enclave status from enclave pods '%+v'", resourcesForEnclaveId.pods)
 		}
+		// A stopped enclave has no pods left but keeps the service of its API container, which tells it apart from an
+		// enclave whose API container hasn't been created yet
+		if enclaveStatus == enclave.EnclaveStatus_Empty && hasApiContainerService(resourcesForEnclaveId.services) {
+			enclaveStatus = enclave.EnclaveStatus_Stopped
+		}
 
 		enclaveCreationTime, err := getEnclaveCreationTimeFromEnclaveNamespace(resourcesForEnclaveId.namespace)
 		if err != nil {
@@ -613,6 +810,15 @@ func getEnclaveStatusFromEnclavePods(enclavePods []apiv1.Pod) (enclave.EnclaveSt
 	return resultEnclaveStatus, nil
 }
 
+func hasApiContainerService(enclaveServices []apiv1.Service) bool {
+	for _, enclaveService := range enclaveServices {
+		if enclaveService.Labels[label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString()] == label_value_consts.APIContainerKurtosisResourceTypeKubernetesLabelValue.GetString() {
+			return true
+		}
+	}
+	return false
+}
+
 func getEnclaveCreationTimeFromEnclaveNamespace(namespace *apiv1.Namespace) (*time.Time, error) {
 	namespaceAnnotations := namespace.Annotations
 
@@ -0,0 +1,83 @@
+package kubernetes_kurtosis_backend
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("a1b2c3d4e5f6")
+
+	testEnclaveDataDirpath  = "/kurtosis-data"
+	testPackageCacheDirpath = "/kurtosis-package-cache"
+	testVolumeClaimName     = "enclave-data"
+)
+
+func TestGetEnclaveObjectsFromKubernetesResources_NoPodsAndNoServicesIsEmpty(t *testing.T) {
+	enclaves, err := getEnclaveObjectsFromKubernetesResources(map[enclave.EnclaveUUID]*enclaveKubernetesResources{
+		testEnclaveUuid: newTestEnclaveResources(nil),
+	})
+	require.NoError(t, err)
+	require.Equal(t, enclave.EnclaveStatus_Empty, enclaves[testEnclaveUuid].GetStatus())
+}
+
+func TestGetEnclaveObjectsFromKubernetesResources_NoPodsButApiContainerServiceIsStopped(t *testing.T) {
+	apiContainerService := apiv1.Service{} //nolint:exhaustruct
+	apiContainerService.Labels = map[string]string{
+		label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.APIContainerKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+
+	enclaves, err := getEnclaveObjectsFromKubernetesResources(map[enclave.EnclaveUUID]*enclaveKubernetesResources{
+		testEnclaveUuid: newTestEnclaveResources([]apiv1.Service{apiContainerService}),
+	})
+	require.NoError(t, err)
+	require.Equal(t, enclave.EnclaveStatus_Stopped, enclaves[testEnclaveUuid].GetStatus())
+}
+
+func TestGetApiContainerContainersAndVolumes_EnclaveDataOnVolumeClaim(t *testing.T) {
+	_, volumes, err := getApiContainerContainersAndVolumes("kurtosistech/core", nil, nil, testEnclaveDataDirpath, testVolumeClaimName, testPackageCacheDirpath)
+	require.NoError(t, err)
+
+	podDefinition := &apiContainerPodDefinition{ //nolint:exhaustruct
+		Volumes: volumes,
+	}
+	require.True(t, podDefinition.hasPersistentEnclaveData())
+	for _, volume := range volumes {
+		if volume.Name != enclaveDataDirVolumeName {
+			continue
+		}
+		require.Nil(t, volume.EmptyDir)
+		require.Equal(t, testVolumeClaimName, volume.PersistentVolumeClaim.ClaimName)
+	}
+}
+
+func TestGetApiContainerContainersAndVolumes_EnclaveDataInPodWithoutVolumeClaim(t *testing.T) {
+	_, volumes, err := getApiContainerContainersAndVolumes("kurtosistech/core", nil, nil, testEnclaveDataDirpath, "", testPackageCacheDirpath)
+	require.NoError(t, err)
+
+	podDefinition := &apiContainerPodDefinition{ //nolint:exhaustruct
+		Volumes: volumes,
+	}
+	require.False(t, podDefinition.hasPersistentEnclaveData())
+}
+
+func newTestEnclaveResources(services []apiv1.Service) *enclaveKubernetesResources {
+	namespace := &apiv1.Namespace{}           //nolint:exhaustruct
+	namespace.ObjectMeta = metav1.ObjectMeta{ //nolint:exhaustruct
+		Name: "kt-test-enclave",
+	}
+	return &enclaveKubernetesResources{
+		namespace:           namespace,
+		pods:                nil,
+		services:            services,
+		persistentVolumes:   nil,
+		clusterRoles:        nil,
+		clusterRoleBindings: nil,
+	}
+}
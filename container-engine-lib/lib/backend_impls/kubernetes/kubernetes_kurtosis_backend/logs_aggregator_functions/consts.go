@@ -0,0 +1,8 @@
+package logs_aggregator_functions
+
+const (
+	defaultLogsListeningPortNum = uint16(9714)
+
+	// Kubernetes port names must be no longer than 15 characters
+	logsListeningPortId = "logs"
+)
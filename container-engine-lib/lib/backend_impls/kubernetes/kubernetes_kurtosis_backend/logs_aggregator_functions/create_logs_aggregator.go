@@ -0,0 +1,204 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+var noWait *port_spec.Wait = nil
+
+// Create logs aggregator idempotently, if existing logs aggregator is found, then it is returned
+func CreateLogsAggregator(
+	ctx context.Context,
+	logsAggregatorDeployment LogsAggregatorDeployment,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+) (
+	*logs_aggregator.LogsAggregator,
+	func(),
+	error,
+) {
+	preExistingLogsAggregatorResources, err := getLogsAggregatorKubernetesResources(ctx, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting logs aggregator Kubernetes resources.")
+	}
+	if preExistingLogsAggregatorResources.deployment != nil {
+		logrus.Warnf("Found existing logs aggregator; cannot start a new one.")
+		if err := rescheduleLogsAggregatorIfItsNodeIsGone(ctx, preExistingLogsAggregatorResources.deployment, kubernetesManager); err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred rescheduling the existing logs aggregator off the node it was on")
+		}
+		logsAggregatorObj, err := getLogsAggregatorObjectFromKubernetesResources(preExistingLogsAggregatorResources)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting existing logs aggregator.")
+		}
+		return logsAggregatorObj, nil, nil
+	}
+
+	logsAggregatorAttrs, err := objAttrsProvider.ForLogsAggregator()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator Kubernetes attributes.")
+	}
+	logsAggregatorName := logsAggregatorAttrs.GetName().GetString()
+	logsAggregatorLabels := shared_helpers.GetStringMapFromLabelMap(logsAggregatorAttrs.GetLabels())
+	logsAggregatorAnnotations := shared_helpers.GetStringMapFromAnnotationMap(logsAggregatorAttrs.GetAnnotations())
+
+	namespace := preExistingLogsAggregatorResources.namespace
+	if namespace == nil {
+		namespace, err = kubernetesManager.CreateNamespace(ctx, logsAggregatorName, logsAggregatorLabels, logsAggregatorAnnotations)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator namespace '%v'", logsAggregatorName)
+		}
+	}
+	shouldRemoveNamespace := true
+	defer func() {
+		if shouldRemoveNamespace {
+			if err := kubernetesManager.RemoveNamespace(ctx, namespace); err != nil {
+				logrus.Errorf("Creating the logs aggregator didn't complete successfully, so we tried to delete Kubernetes namespace '%v' that we created but an error was thrown:\n%v", namespace.Name, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove Kubernetes namespace with name '%v'!!!!!!!", namespace.Name)
+			}
+		}
+	}()
+
+	logsStorageVolumeClaim, err := CreateLogsStorageVolumeClaim(ctx, namespace.Name, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the logs storage volume claim of the logs aggregator in namespace '%v'", namespace.Name)
+	}
+	shouldRemoveLogsStorageVolumeClaim := true
+	defer func() {
+		if shouldRemoveLogsStorageVolumeClaim {
+			if err := kubernetesManager.RemovePersistentVolumeClaim(ctx, namespace.Name, logsStorageVolumeClaim.Name); err != nil {
+				logrus.Errorf("Creating the logs aggregator didn't complete successfully, so we tried to delete volume claim '%v' that we created but an error was thrown:\n%v", logsStorageVolumeClaim.Name, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove volume claim with name '%v' in namespace '%v'!!!!!!!", logsStorageVolumeClaim.Name, namespace.Name)
+			}
+		}
+	}()
+
+	deployment, removeLogsAggregatorDeploymentFunc, err := logsAggregatorDeployment.CreateAndStart(
+		ctx,
+		defaultLogsListeningPortNum,
+		logsListeningPortId,
+		namespace.Name,
+		logsStorageVolumeClaim.Name,
+		objAttrsProvider,
+		kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(
+			err,
+			"An error occurred creating the logs aggregator deployment in Kubernetes namespace '%v'",
+			namespace.Name,
+		)
+	}
+	shouldRemoveLogsAggregatorDeployment := true
+	defer func() {
+		if shouldRemoveLogsAggregatorDeployment {
+			removeLogsAggregatorDeploymentFunc()
+		}
+	}()
+
+	logsListeningPortSpec, err := port_spec.NewPortSpec(defaultLogsListeningPortNum, port_spec.TransportProtocol_TCP, consts.HttpApplicationProtocol, noWait)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator listening port spec using number '%v'", defaultLogsListeningPortNum)
+	}
+	servicePorts, err := shared_helpers.GetKubernetesServicePortsFromPrivatePortSpecs(map[string]*port_spec.PortSpec{
+		logsListeningPortId: logsListeningPortSpec,
+	})
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator service ports")
+	}
+
+	service, err := kubernetesManager.CreateService(
+		ctx,
+		namespace.Name,
+		logsAggregatorName,
+		logsAggregatorLabels,
+		logsAggregatorAnnotations,
+		deployment.Spec.Selector.MatchLabels,
+		apiv1.ServiceTypeClusterIP,
+		servicePorts,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator service '%v' in namespace '%v'", logsAggregatorName, namespace.Name)
+	}
+
+	logsAggregatorResources := &logsAggregatorKubernetesResources{
+		namespace:  namespace,
+		deployment: deployment,
+		service:    service,
+	}
+	logsAggregator, err := getLogsAggregatorObjectFromKubernetesResources(logsAggregatorResources)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting logs aggregator object from the Kubernetes resources just created.")
+	}
+
+	removeLogsAggregatorFunc := func() {
+		removeCtx := context.Background()
+		if err := kubernetesManager.RemoveNamespace(removeCtx, namespace); err != nil {
+			logrus.Errorf("Launching the logs aggregator didn't complete successfully so we tried to remove its namespace '%v', but doing so exited with an error:\n%v", namespace.Name, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove Kubernetes namespace with name '%v'!!!!!!!", namespace.Name)
+		}
+	}
+
+	shouldRemoveLogsAggregatorDeployment = false
+	shouldRemoveLogsStorageVolumeClaim = false
+	shouldRemoveNamespace = false
+	return logsAggregator, removeLogsAggregatorFunc, nil
+}
+
+// rescheduleLogsAggregatorIfItsNodeIsGone moves the logs aggregator to a new logs storage volume claim when the node the
+// logs were stored on left the cluster, which makes Kubernetes schedule the aggregator on the new node storing the logs
+func rescheduleLogsAggregatorIfItsNodeIsGone(
+	ctx context.Context,
+	deployment *appsv1.Deployment,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	podVolumes := deployment.Spec.Template.Spec.Volumes
+	logsStorageVolumeIndex := -1
+	for volumeIndex, podVolume := range podVolumes {
+		if podVolume.PersistentVolumeClaim != nil {
+			logsStorageVolumeIndex = volumeIndex
+		}
+	}
+	if logsStorageVolumeIndex == -1 {
+		// Created before the logs were stored through claims, and can only be moved by destroying it
+		logrus.Warnf("Logs aggregator deployment '%v' doesn't store the logs through a logs storage volume claim, so it can't be rescheduled when its node goes away", deployment.Name)
+		return nil
+	}
+	volumeClaimName := podVolumes[logsStorageVolumeIndex].PersistentVolumeClaim.ClaimName
+
+	volumeClaim, err := kubernetesManager.GetPersistentVolumeClaim(ctx, deployment.Namespace, volumeClaimName)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs storage volume claim '%v' of the logs aggregator", volumeClaimName)
+	}
+	isNodeGone, err := isLogsStorageNodeGone(ctx, volumeClaim, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred checking whether the node of logs storage volume claim '%v' is still part of the cluster", volumeClaimName)
+	}
+	if !isNodeGone {
+		return nil
+	}
+
+	newVolumeClaim, err := CreateLogsStorageVolumeClaim(ctx, deployment.Namespace, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a new logs storage volume claim for the logs aggregator")
+	}
+	podVolumes[logsStorageVolumeIndex].PersistentVolumeClaim.ClaimName = newVolumeClaim.Name
+	if _, err := kubernetesManager.UpdateDeployment(ctx, deployment); err != nil {
+		if removeErr := kubernetesManager.RemovePersistentVolumeClaim(ctx, deployment.Namespace, newVolumeClaim.Name); removeErr != nil {
+			logrus.Errorf("Moving the logs aggregator to volume claim '%v' didn't complete successfully, so we tried to delete the claim but an error was thrown:\n%v", newVolumeClaim.Name, removeErr)
+		}
+		return stacktrace.Propagate(err, "An error occurred moving logs aggregator deployment '%v' to logs storage volume claim '%v'", deployment.Name, newVolumeClaim.Name)
+	}
+	if err := kubernetesManager.RemovePersistentVolumeClaim(ctx, deployment.Namespace, volumeClaimName); err != nil {
+		logrus.Warnf("An error occurred removing logs storage volume claim '%v' which isn't used anymore:\n%v", volumeClaimName, err)
+	}
+	return nil
+}
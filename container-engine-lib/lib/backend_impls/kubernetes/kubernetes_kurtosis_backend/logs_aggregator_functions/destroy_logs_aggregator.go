@@ -0,0 +1,28 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+)
+
+// Destroys logs aggregator idempotently, returns nil if no logs aggregator was found
+// The logs themselves are kept on the node storing them so they survive engine restarts, see CreateLogsStorageVolumeClaim
+func DestroyLogsAggregator(ctx context.Context, kubernetesManager *kubernetes_manager.KubernetesManager) error {
+	logsAggregatorResources, err := getLogsAggregatorKubernetesResources(ctx, kubernetesManager)
+	if err != nil {
+		logrus.Warnf("Attempted to destroy logs aggregator but its Kubernetes resources couldn't be retrieved.")
+		return nil
+	}
+
+	if logsAggregatorResources.namespace == nil {
+		return nil
+	}
+
+	if err := kubernetesManager.RemoveNamespace(ctx, logsAggregatorResources.namespace); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the logs aggregator namespace '%v'", logsAggregatorResources.namespace.Name)
+	}
+
+	return nil
+}
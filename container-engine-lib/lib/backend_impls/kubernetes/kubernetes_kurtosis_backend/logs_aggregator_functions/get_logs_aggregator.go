@@ -0,0 +1,29 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Returns nil if no logs aggregator is found
+func GetLogsAggregator(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*logs_aggregator.LogsAggregator, error) {
+	logsAggregatorResources, err := getLogsAggregatorKubernetesResources(ctx, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator Kubernetes resources")
+	}
+	if logsAggregatorResources.deployment == nil {
+		return nil, nil
+	}
+
+	maybeLogsAggregatorObject, err := getLogsAggregatorObjectFromKubernetesResources(logsAggregatorResources)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator")
+	}
+
+	return maybeLogsAggregatorObject, nil
+}
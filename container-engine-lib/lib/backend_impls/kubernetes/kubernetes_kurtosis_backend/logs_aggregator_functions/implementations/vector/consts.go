@@ -0,0 +1,52 @@
+package vector
+
+const (
+	configDirpath = "/etc/vector/"
+
+	////////////////////////--VECTOR CONTAINER CONFIGURATION SECTION--/////////////////////////////
+	containerName  = "vector"
+	containerImage = "timberio/vector:0.31.0-debian"
+
+	configFilename = "vector.toml"
+	configFilepath = configDirpath + configFilename
+	binaryFilepath = "/usr/bin/vector"
+	configFileFlag = "-c"
+
+	configVolumeName      = "vector-config"
+	logsStorageVolumeName = "kurtosis-logs-storage"
+	////////////////////////--FINISH VECTOR CONTAINER CONFIGURATION SECTION--/////////////////////////////
+
+	////////////////////////--VECTOR CONFIGURATION SECTION--/////////////////////////////
+	fluentBitSourceId        = "\"fluent_bit\""
+	fluentBitSourceType      = "\"fluent\""
+	fluentBitSourceIpAddress = "0.0.0.0"
+
+	fileSinkIdSuffix = "file"
+	fileTypeId       = "\"file\""
+
+	// Unlike Docker, the config is mounted from a config map instead of being written with printf, so '%' isn't escaped
+	// See the Docker implementation for details on the file layout
+	baseLogsFilepathSuffix = "%Y/%U/"
+
+	uuidLogsFilename      = "{{ enclave_uuid }}/{{ service_uuid }}.json\""
+	nameLogsFilename      = "{{ enclave_uuid }}/{{ service_name }}.json\""
+	shortUUIDLogsFilename = "{{ enclave_uuid }}/{{ service_short_uuid }}.json\""
+
+	sourceConfigFileTemplateName = "srcVectorConfigFileTemplate"
+	sinkConfigFileTemplateName   = "sinkVectorConfigFileTemplate"
+
+	srcConfigFileTemplate = `
+[sources.{{ .Id }}]
+type = {{ .Type }}
+address = "{{ .Address }}"
+`
+	sinkConfigFileTemplate = `
+[sinks.{{ .Id }}]
+type = {{ .Type }}
+inputs = {{ .Inputs }}
+path = {{ .Filepath }}
+encoding.codec = "json"
+buffer.when_full = "block"
+`
+	////////////////////////--FINISH--VECTOR CONFIGURATION SECTION--/////////////////////////////
+)
@@ -0,0 +1,247 @@
+package vector
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// The logs storage volume claim already gets the logs aggregator scheduled on the node storing the logs
+var noAffinity *apiv1.Affinity = nil
+
+type vectorLogsAggregatorDeployment struct{}
+
+func NewVectorLogsAggregatorDeployment() *vectorLogsAggregatorDeployment {
+	return &vectorLogsAggregatorDeployment{}
+}
+
+func (vectorDeployment *vectorLogsAggregatorDeployment) CreateAndStart(
+	ctx context.Context,
+	logsListeningPortNumber uint16,
+	logsListeningPortId string,
+	targetNamespaceName string,
+	logsStorageVolumeClaimName string,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*appsv1.Deployment, func(), error) {
+	logsAggregatorAttrs, err := objAttrsProvider.ForLogsAggregator()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator Kubernetes attributes.")
+	}
+	logsAggregatorName := logsAggregatorAttrs.GetName().GetString()
+	logsAggregatorLabels := shared_helpers.GetStringMapFromLabelMap(logsAggregatorAttrs.GetLabels())
+
+	vectorConfigContentStr, err := newDefaultVectorConfig(logsListeningPortNumber).getConfigFileContent()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator's configuration content")
+	}
+
+	configMap, err := kubernetesManager.CreateConfigMap(
+		ctx,
+		targetNamespaceName,
+		logsAggregatorName,
+		logsAggregatorLabels,
+		map[string]string{
+			configFilename: vectorConfigContentStr,
+		},
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator config map in namespace '%v'", targetNamespaceName)
+	}
+	shouldRemoveConfigMap := true
+	defer func() {
+		if shouldRemoveConfigMap {
+			if err := kubernetesManager.RemoveConfigMap(ctx, targetNamespaceName, configMap.Name); err != nil {
+				logrus.Errorf("Creating the logs aggregator didn't complete successfully, so we tried to delete config map '%v' that we created but an error was thrown:\n%v", configMap.Name, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove config map with name '%v' in namespace '%v'!!!!!!!", configMap.Name, targetNamespaceName)
+			}
+		}
+	}()
+
+	containers := []apiv1.Container{
+		getVectorContainer(logsListeningPortNumber, logsListeningPortId),
+	}
+	volumes := getVectorVolumes(configMap.Name, logsStorageVolumeClaimName)
+
+	deployment, err := kubernetesManager.CreateDeployment(
+		ctx,
+		targetNamespaceName,
+		logsAggregatorName,
+		logsAggregatorLabels,
+		logsAggregatorLabels,
+		containers,
+		volumes,
+		noAffinity,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the logs aggregator deployment in namespace '%v'", targetNamespaceName)
+	}
+	removeDeploymentFunc := func() {
+		removeCtx := context.Background()
+		if err := kubernetesManager.RemoveDeployment(removeCtx, deployment); err != nil {
+			logrus.Errorf(
+				"Launching the logs aggregator deployment '%v' didn't complete successfully so we "+
+					"tried to remove the deployment we started, but doing so exited with an error:\n%v",
+				deployment.Name,
+				err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove the logs aggregator deployment '%v' in namespace '%v'!!!!!!", deployment.Name, targetNamespaceName)
+		}
+		if err := kubernetesManager.RemoveConfigMap(removeCtx, targetNamespaceName, configMap.Name); err != nil {
+			logrus.Errorf("Tried to remove the logs aggregator config map '%v' but doing so exited with an error:\n%v", configMap.Name, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove config map with name '%v' in namespace '%v'!!!!!!!", configMap.Name, targetNamespaceName)
+		}
+	}
+
+	shouldRemoveConfigMap = false
+	return deployment, removeDeploymentFunc, nil
+}
+
+func getVectorContainer(logsListeningPortNumber uint16, logsListeningPortId string) apiv1.Container {
+	return apiv1.Container{
+		Name:  containerName,
+		Image: containerImage,
+		Command: []string{
+			binaryFilepath,
+		},
+		Args: []string{
+			configFileFlag,
+			configFilepath,
+		},
+		WorkingDir: "",
+		Ports: []apiv1.ContainerPort{
+			{
+				Name:          logsListeningPortId,
+				HostPort:      0,
+				ContainerPort: int32(logsListeningPortNumber),
+				Protocol:      apiv1.ProtocolTCP,
+				HostIP:        "",
+			},
+		},
+		EnvFrom: nil,
+		Env:     nil,
+		Resources: apiv1.ResourceRequirements{
+			Limits:   nil,
+			Requests: nil,
+			Claims:   nil,
+		},
+		VolumeMounts: []apiv1.VolumeMount{
+			{
+				Name:             configVolumeName,
+				ReadOnly:         true,
+				MountPath:        configDirpath,
+				SubPath:          "",
+				MountPropagation: nil,
+				SubPathExpr:      "",
+			},
+			{
+				Name:             logsStorageVolumeName,
+				ReadOnly:         false,
+				MountPath:        consts.LogsStorageDirpath,
+				SubPath:          "",
+				MountPropagation: nil,
+				SubPathExpr:      "",
+			},
+		},
+		VolumeDevices:            nil,
+		LivenessProbe:            nil,
+		ReadinessProbe:           nil,
+		StartupProbe:             nil,
+		Lifecycle:                nil,
+		TerminationMessagePath:   "",
+		TerminationMessagePolicy: "",
+		ImagePullPolicy:          "",
+		SecurityContext:          nil,
+		Stdin:                    false,
+		StdinOnce:                false,
+		TTY:                      false,
+	}
+}
+
+func getVectorVolumes(configMapName string, logsStorageVolumeClaimName string) []apiv1.Volume {
+	return []apiv1.Volume{
+		{
+			Name: configVolumeName,
+			VolumeSource: apiv1.VolumeSource{
+				HostPath:              nil,
+				EmptyDir:              nil,
+				GCEPersistentDisk:     nil,
+				AWSElasticBlockStore:  nil,
+				GitRepo:               nil,
+				Secret:                nil,
+				NFS:                   nil,
+				ISCSI:                 nil,
+				Glusterfs:             nil,
+				PersistentVolumeClaim: nil,
+				RBD:                   nil,
+				FlexVolume:            nil,
+				Cinder:                nil,
+				CephFS:                nil,
+				Flocker:               nil,
+				DownwardAPI:           nil,
+				FC:                    nil,
+				AzureFile:             nil,
+				ConfigMap: &apiv1.ConfigMapVolumeSource{
+					LocalObjectReference: apiv1.LocalObjectReference{
+						Name: configMapName,
+					},
+					Items:       nil,
+					DefaultMode: nil,
+					Optional:    nil,
+				},
+				VsphereVolume:        nil,
+				Quobyte:              nil,
+				AzureDisk:            nil,
+				PhotonPersistentDisk: nil,
+				Projected:            nil,
+				PortworxVolume:       nil,
+				ScaleIO:              nil,
+				StorageOS:            nil,
+				CSI:                  nil,
+				Ephemeral:            nil,
+			},
+		},
+		{
+			Name: logsStorageVolumeName,
+			VolumeSource: apiv1.VolumeSource{
+				HostPath:             nil,
+				EmptyDir:             nil,
+				GCEPersistentDisk:    nil,
+				AWSElasticBlockStore: nil,
+				GitRepo:              nil,
+				Secret:               nil,
+				NFS:                  nil,
+				ISCSI:                nil,
+				Glusterfs:            nil,
+				PersistentVolumeClaim: &apiv1.PersistentVolumeClaimVolumeSource{
+					ClaimName: logsStorageVolumeClaimName,
+					ReadOnly:  false,
+				},
+				RBD:                  nil,
+				FlexVolume:           nil,
+				Cinder:               nil,
+				CephFS:               nil,
+				Flocker:              nil,
+				DownwardAPI:          nil,
+				FC:                   nil,
+				AzureFile:            nil,
+				ConfigMap:            nil,
+				VsphereVolume:        nil,
+				Quobyte:              nil,
+				AzureDisk:            nil,
+				PhotonPersistentDisk: nil,
+				Projected:            nil,
+				PortworxVolume:       nil,
+				ScaleIO:              nil,
+				StorageOS:            nil,
+				CSI:                  nil,
+				Ephemeral:            nil,
+			},
+		},
+	}
+}
@@ -0,0 +1,86 @@
+package vector
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"testing"
+)
+
+const (
+	testListeningPortNumber = uint16(9000)
+	testListeningPortId     = "logs-listening"
+	testConfigMapName       = "kurtosis-logs-aggregator"
+	testVolumeClaimName     = "kurtosis-logs-storage-abc123"
+)
+
+func TestGetConfigFileContent(t *testing.T) {
+	configFileContent, err := newDefaultVectorConfig(testListeningPortNumber).getConfigFileContent()
+	require.NoError(t, err)
+
+	expectedSource := `
+[sources."fluent_bit"]
+type = "fluent"
+address = "0.0.0.0:9000"
+`
+	require.Contains(t, configFileContent, expectedSource)
+
+	expectedSinkFilepathsById := map[string]string{
+		"uuid_file":       `"/var/log/kurtosis/%Y/%U/{{ enclave_uuid }}/{{ service_uuid }}.json"`,
+		"name_file":       `"/var/log/kurtosis/%Y/%U/{{ enclave_uuid }}/{{ service_name }}.json"`,
+		"short_uuid_file": `"/var/log/kurtosis/%Y/%U/{{ enclave_uuid }}/{{ service_short_uuid }}.json"`,
+	}
+	for sinkId, sinkFilepath := range expectedSinkFilepathsById {
+		expectedSink := `
+[sinks.` + sinkId + `]
+type = "file"
+inputs = ["fluent_bit"]
+path = ` + sinkFilepath + `
+encoding.codec = "json"
+buffer.when_full = "block"
+`
+		require.Contains(t, configFileContent, expectedSink)
+	}
+}
+
+func TestGetVectorContainer(t *testing.T) {
+	container := getVectorContainer(testListeningPortNumber, testListeningPortId)
+
+	require.Equal(t, containerName, container.Name)
+	require.Equal(t, containerImage, container.Image)
+	require.Equal(t, []string{"/usr/bin/vector"}, container.Command)
+	require.Equal(t, []string{"-c", "/etc/vector/vector.toml"}, container.Args)
+
+	require.Len(t, container.Ports, 1)
+	require.Equal(t, testListeningPortId, container.Ports[0].Name)
+	require.Equal(t, int32(testListeningPortNumber), container.Ports[0].ContainerPort)
+	require.Equal(t, apiv1.ProtocolTCP, container.Ports[0].Protocol)
+
+	require.Len(t, container.VolumeMounts, 2)
+	require.Equal(t, configVolumeName, container.VolumeMounts[0].Name)
+	require.Equal(t, "/etc/vector/", container.VolumeMounts[0].MountPath)
+	require.True(t, container.VolumeMounts[0].ReadOnly)
+	require.Equal(t, logsStorageVolumeName, container.VolumeMounts[1].Name)
+	require.Equal(t, consts.LogsStorageDirpath, container.VolumeMounts[1].MountPath)
+	require.False(t, container.VolumeMounts[1].ReadOnly)
+}
+
+func TestGetVectorVolumes(t *testing.T) {
+	volumes := getVectorVolumes(testConfigMapName, testVolumeClaimName)
+	require.Len(t, volumes, 2)
+
+	configVolume := volumes[0]
+	require.Equal(t, configVolumeName, configVolume.Name)
+	require.NotNil(t, configVolume.ConfigMap)
+	require.Equal(t, testConfigMapName, configVolume.ConfigMap.Name)
+	require.Nil(t, configVolume.PersistentVolumeClaim)
+
+	// The logs are written into the logs storage so that they survive the logs aggregator pod being restarted
+	logsStorageVolume := volumes[1]
+	require.Equal(t, logsStorageVolumeName, logsStorageVolume.Name)
+	require.NotNil(t, logsStorageVolume.PersistentVolumeClaim)
+	require.Equal(t, testVolumeClaimName, logsStorageVolume.PersistentVolumeClaim.ClaimName)
+	require.False(t, logsStorageVolume.PersistentVolumeClaim.ReadOnly)
+	require.Nil(t, logsStorageVolume.HostPath)
+	require.Nil(t, logsStorageVolume.ConfigMap)
+}
@@ -0,0 +1,23 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+type LogsAggregatorDeployment interface {
+	CreateAndStart(
+		ctx context.Context,
+		// This is the port that this LogsAggregatorDeployment will listen for logs on
+		// LogsCollectors should forward logs to this port
+		logsListeningPort uint16,
+		logsListeningPortId string,
+		targetNamespaceName string,
+		// The claim of the logs storage the logs get written to, see CreateLogsStorageVolumeClaim
+		logsStorageVolumeClaimName string,
+		objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+		kubernetesManager *kubernetes_manager.KubernetesManager,
+	) (*appsv1.Deployment, func(), error)
+}
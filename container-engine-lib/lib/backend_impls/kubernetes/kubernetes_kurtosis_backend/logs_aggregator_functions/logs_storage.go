@@ -0,0 +1,189 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	apiv1 "k8s.io/api/core/v1"
+	"sort"
+)
+
+const (
+	logsStorageVolumeNamePrefix = "kurtosis-logs-storage-"
+
+	// the claims are bound to the volumes created along with them, see CreatePersistentVolumeClaim
+	noStorageClass       = ""
+	defaultRequestedSize = 0
+)
+
+func getLogsStorageVolumeLabels() map[string]string {
+	return map[string]string{
+		label_key_consts.AppIDKubernetesLabelKey.GetString():              label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		label_key_consts.KurtosisVolumeTypeKubernetesLabelKey.GetString(): label_value_consts.LogsStorageVolumeTypeKubernetesLabelValue.GetString(),
+	}
+}
+
+// CreateLogsStorageVolumeClaim creates the claim the pods of the namespace mount the logs storage with. Kubernetes
+// namespaces can't share a claim, so each one gets its own volume, all of them pinned to the node storing the logs.
+// This way the logs aggregator writing the logs and the engine reading them always get scheduled on that node, even when
+// the engine gets restarted. The volumes outlive their claims to remember that node across engine restarts
+func CreateLogsStorageVolumeClaim(
+	ctx context.Context,
+	namespaceName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.PersistentVolumeClaim, error) {
+	logsStorageVolumeLabels := getLogsStorageVolumeLabels()
+	logsStorageVolumes, err := kubernetesManager.GetPersistentVolumesByLabels(ctx, logsStorageVolumeLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs storage volumes")
+	}
+	nodes, err := kubernetesManager.GetNodes(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the nodes the logs can be stored on")
+	}
+
+	nodeHostname, previousNodeHostname, err := getLogsStorageNodeHostname(logsStorageVolumes.Items, nodes.Items)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred choosing the node to store the logs on")
+	}
+	if previousNodeHostname != "" && previousNodeHostname != nodeHostname {
+		logrus.Warnf("Node '%v' storing the logs is no longer part of the cluster, so the logs are now stored on node '%v' and the logs stored so far can't be read anymore", previousNodeHostname, nodeHostname)
+	}
+
+	// The volumes whose claim was removed along with its namespace were only kept to find the node storing the logs
+	for _, logsStorageVolume := range logsStorageVolumes.Items {
+		if logsStorageVolume.Status.Phase == apiv1.VolumeBound {
+			continue
+		}
+		if err := kubernetesManager.RemovePersistentVolume(ctx, logsStorageVolume.Name); err != nil {
+			logrus.Warnf("An error occurred removing logs storage volume '%v' which isn't used anymore:\n%v", logsStorageVolume.Name, err)
+		}
+	}
+
+	volumeUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred generating a UUID for the logs storage volume")
+	}
+	// volumes and their claims have the same name, see CreatePersistentVolumeClaim
+	volumeName := logsStorageVolumeNamePrefix + uuid_generator.ShortenedUUIDString(volumeUuid)
+	if _, err := kubernetesManager.CreateNodePersistentVolume(ctx, volumeName, logsStorageVolumeLabels, consts.LogsStorageHostPath, nodeHostname); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating logs storage volume '%v' on node '%v'", volumeName, nodeHostname)
+	}
+	volumeClaim, err := kubernetesManager.CreatePersistentVolumeClaim(ctx, namespaceName, volumeName, logsStorageVolumeLabels, defaultRequestedSize, noStorageClass)
+	if err != nil {
+		if removeErr := kubernetesManager.RemovePersistentVolume(ctx, volumeName); removeErr != nil {
+			logrus.Errorf("Creating the logs storage volume claim didn't complete successfully, so we tried to remove volume '%v' that we created but an error was thrown:\n%v", volumeName, removeErr)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove persistent volume with name '%v'!!!!!!!", volumeName)
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred creating logs storage volume claim '%v' in namespace '%v'", volumeName, namespaceName)
+	}
+	return volumeClaim, nil
+}
+
+// isLogsStorageNodeGone returns true when the node the volume bound to the claim is pinned to is no longer part of the
+// cluster, in which case the pods mounting the claim can't run anymore
+func isLogsStorageNodeGone(
+	ctx context.Context,
+	volumeClaim *apiv1.PersistentVolumeClaim,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (bool, error) {
+	volume, err := kubernetesManager.GetPersistentVolume(ctx, volumeClaim.Spec.VolumeName)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the volume bound to logs storage volume claim '%v'", volumeClaim.Name)
+	}
+	nodes, err := kubernetesManager.GetNodes(ctx)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred getting the nodes of the cluster")
+	}
+	return !isNodeInCluster(getVolumeNodeHostname(*volume), nodes.Items), nil
+}
+
+// getLogsStorageNodeHostname returns the hostname of the node the newest logs storage volume is pinned to, as long as the
+// node is still part of the cluster. Otherwise, i.e. for the first engine or when the node was removed, it picks the first
+// node new pods can be scheduled on. The hostname of the node that stored the logs so far is returned too, empty if none
+func getLogsStorageNodeHostname(logsStorageVolumes []apiv1.PersistentVolume, nodes []apiv1.Node) (string, string, error) {
+	sortedLogsStorageVolumes := make([]apiv1.PersistentVolume, len(logsStorageVolumes))
+	copy(sortedLogsStorageVolumes, logsStorageVolumes)
+	sort.SliceStable(sortedLogsStorageVolumes, func(i, j int) bool {
+		return sortedLogsStorageVolumes[j].CreationTimestamp.Before(&sortedLogsStorageVolumes[i].CreationTimestamp)
+	})
+
+	previousNodeHostname := ""
+	for _, logsStorageVolume := range sortedLogsStorageVolumes {
+		volumeNodeHostname := getVolumeNodeHostname(logsStorageVolume)
+		if volumeNodeHostname == "" {
+			continue
+		}
+		if isNodeInCluster(volumeNodeHostname, nodes) {
+			return volumeNodeHostname, volumeNodeHostname, nil
+		}
+		if previousNodeHostname == "" {
+			previousNodeHostname = volumeNodeHostname
+		}
+	}
+
+	schedulableNodeHostnames := []string{}
+	for _, node := range nodes {
+		if isNodeSchedulable(node) {
+			schedulableNodeHostnames = append(schedulableNodeHostnames, getNodeHostname(node))
+		}
+	}
+	if len(schedulableNodeHostnames) == 0 {
+		return "", "", stacktrace.NewError("None of the '%v' nodes of the cluster can get new pods scheduled on", len(nodes))
+	}
+	sort.Strings(schedulableNodeHostnames)
+	return schedulableNodeHostnames[0], previousNodeHostname, nil
+}
+
+func getVolumeNodeHostname(volume apiv1.PersistentVolume) string {
+	if volume.Spec.NodeAffinity == nil || volume.Spec.NodeAffinity.Required == nil {
+		return ""
+	}
+	for _, nodeSelectorTerm := range volume.Spec.NodeAffinity.Required.NodeSelectorTerms {
+		for _, matchExpression := range nodeSelectorTerm.MatchExpressions {
+			if matchExpression.Key == apiv1.LabelHostname && matchExpression.Operator == apiv1.NodeSelectorOpIn && len(matchExpression.Values) == 1 {
+				return matchExpression.Values[0]
+			}
+		}
+	}
+	return ""
+}
+
+func isNodeInCluster(nodeHostname string, nodes []apiv1.Node) bool {
+	for _, node := range nodes {
+		if getNodeHostname(node) == nodeHostname {
+			return true
+		}
+	}
+	return false
+}
+
+// The hostname label is what the volumes are pinned with, and it's usually the same as the node name
+func getNodeHostname(node apiv1.Node) string {
+	if nodeHostname, found := node.Labels[apiv1.LabelHostname]; found {
+		return nodeHostname
+	}
+	return node.Name
+}
+
+func isNodeSchedulable(node apiv1.Node) bool {
+	if node.Spec.Unschedulable {
+		return false
+	}
+	for _, taint := range node.Spec.Taints {
+		if taint.Effect == apiv1.TaintEffectNoSchedule || taint.Effect == apiv1.TaintEffectNoExecute {
+			return false
+		}
+	}
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == apiv1.NodeReady {
+			return condition.Status == apiv1.ConditionTrue
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+package logs_aggregator_functions
+
+import (
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+	"time"
+)
+
+const (
+	firstNodeHostname  = "node-a"
+	secondNodeHostname = "node-b"
+	thirdNodeHostname  = "node-c"
+)
+
+var (
+	olderVolumeCreationTime = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	newerVolumeCreationTime = olderVolumeCreationTime.Add(time.Hour)
+)
+
+func TestGetLogsStorageNodeHostname_PicksFirstSchedulableNodeWhenNoLogsAreStoredYet(t *testing.T) {
+	nodes := []apiv1.Node{
+		newTestNode(thirdNodeHostname),
+		newTestNode(secondNodeHostname),
+	}
+
+	nodeHostname, previousNodeHostname, err := getLogsStorageNodeHostname(nil, nodes)
+	require.NoError(t, err)
+	require.Equal(t, secondNodeHostname, nodeHostname)
+	require.Empty(t, previousNodeHostname)
+}
+
+func TestGetLogsStorageNodeHostname_KeepsNodeStoringTheLogsWhenEngineIsRestarted(t *testing.T) {
+	// the engine restart can land on any node, but the logs stay on the node the previous volumes are pinned to
+	volumes := []apiv1.PersistentVolume{
+		newTestVolume(thirdNodeHostname, olderVolumeCreationTime),
+	}
+	nodes := []apiv1.Node{
+		newTestNode(firstNodeHostname),
+		newTestNode(secondNodeHostname),
+		newTestNode(thirdNodeHostname),
+	}
+
+	nodeHostname, previousNodeHostname, err := getLogsStorageNodeHostname(volumes, nodes)
+	require.NoError(t, err)
+	require.Equal(t, thirdNodeHostname, nodeHostname)
+	require.Equal(t, thirdNodeHostname, previousNodeHostname)
+}
+
+func TestGetLogsStorageNodeHostname_KeepsNodeOfNewestVolume(t *testing.T) {
+	volumes := []apiv1.PersistentVolume{
+		newTestVolume(firstNodeHostname, olderVolumeCreationTime),
+		newTestVolume(secondNodeHostname, newerVolumeCreationTime),
+	}
+	nodes := []apiv1.Node{
+		newTestNode(firstNodeHostname),
+		newTestNode(secondNodeHostname),
+	}
+
+	nodeHostname, _, err := getLogsStorageNodeHostname(volumes, nodes)
+	require.NoError(t, err)
+	require.Equal(t, secondNodeHostname, nodeHostname)
+}
+
+func TestGetLogsStorageNodeHostname_MovesToAnotherNodeWhenNodeStoringTheLogsIsGone(t *testing.T) {
+	volumes := []apiv1.PersistentVolume{
+		newTestVolume(firstNodeHostname, olderVolumeCreationTime),
+	}
+	nodes := []apiv1.Node{
+		newTestNode(thirdNodeHostname),
+		newTestNode(secondNodeHostname),
+	}
+
+	nodeHostname, previousNodeHostname, err := getLogsStorageNodeHostname(volumes, nodes)
+	require.NoError(t, err)
+	require.Equal(t, secondNodeHostname, nodeHostname)
+	require.Equal(t, firstNodeHostname, previousNodeHostname)
+}
+
+func TestGetLogsStorageNodeHostname_SkipsNodesThatCantGetPodsScheduled(t *testing.T) {
+	unschedulableNode := newTestNode(firstNodeHostname)
+	unschedulableNode.Spec.Unschedulable = true
+	taintedNode := newTestNode(secondNodeHostname)
+	taintedNode.Spec.Taints = []apiv1.Taint{
+		{
+			Key:       "node-role.kubernetes.io/control-plane",
+			Value:     "",
+			Effect:    apiv1.TaintEffectNoSchedule,
+			TimeAdded: nil,
+		},
+	}
+	notReadyNode := newTestNode(thirdNodeHostname)
+	notReadyNode.Status.Conditions[0].Status = apiv1.ConditionFalse
+	schedulableNode := newTestNode("node-d")
+
+	nodes := []apiv1.Node{unschedulableNode, taintedNode, notReadyNode, schedulableNode}
+	nodeHostname, _, err := getLogsStorageNodeHostname(nil, nodes)
+	require.NoError(t, err)
+	require.Equal(t, "node-d", nodeHostname)
+
+	_, _, err = getLogsStorageNodeHostname(nil, []apiv1.Node{unschedulableNode, taintedNode, notReadyNode})
+	require.Error(t, err)
+}
+
+func newTestNode(hostname string) apiv1.Node {
+	node := apiv1.Node{} //nolint:exhaustruct
+	node.Name = hostname
+	node.Labels = map[string]string{
+		apiv1.LabelHostname: hostname,
+	}
+	node.Status.Conditions = []apiv1.NodeCondition{
+		{ //nolint:exhaustruct
+			Type:   apiv1.NodeReady,
+			Status: apiv1.ConditionTrue,
+		},
+	}
+	return node
+}
+
+func newTestVolume(nodeHostname string, creationTime time.Time) apiv1.PersistentVolume {
+	volume := apiv1.PersistentVolume{} //nolint:exhaustruct
+	volume.Name = logsStorageVolumeNamePrefix + nodeHostname
+	volume.CreationTimestamp = metav1.NewTime(creationTime)
+	volume.Spec.NodeAffinity = &apiv1.VolumeNodeAffinity{
+		Required: &apiv1.NodeSelector{
+			NodeSelectorTerms: []apiv1.NodeSelectorTerm{
+				{
+					MatchExpressions: []apiv1.NodeSelectorRequirement{
+						{
+							Key:      apiv1.LabelHostname,
+							Operator: apiv1.NodeSelectorOpIn,
+							Values:   []string{nodeHostname},
+						},
+					},
+					MatchFields: nil,
+				},
+			},
+		},
+	}
+	return volume
+}
@@ -0,0 +1,112 @@
+package logs_aggregator_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/stacktrace"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	"net"
+)
+
+// Any of these values being nil indicates that the resource doesn't exist
+type logsAggregatorKubernetesResources struct {
+	namespace *apiv1.Namespace
+
+	deployment *appsv1.Deployment
+
+	service *apiv1.Service
+}
+
+func getLogsAggregatorMatchLabels() map[string]string {
+	return map[string]string{
+		label_key_consts.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.LogsAggregatorKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+}
+
+func getLogsAggregatorKubernetesResources(
+	ctx context.Context,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*logsAggregatorKubernetesResources, error) {
+	resources := &logsAggregatorKubernetesResources{
+		namespace:  nil,
+		deployment: nil,
+		service:    nil,
+	}
+
+	logsAggregatorMatchLabels := getLogsAggregatorMatchLabels()
+
+	namespaces, err := kubernetesManager.GetNamespacesByLabels(ctx, logsAggregatorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator namespace using labels '%+v'", logsAggregatorMatchLabels)
+	}
+	if len(namespaces.Items) == 0 {
+		return resources, nil
+	}
+	if len(namespaces.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs aggregator namespace; this is a bug in Kurtosis")
+	}
+	resources.namespace = &namespaces.Items[0]
+	namespaceName := resources.namespace.Name
+
+	deployments, err := kubernetesManager.GetDeploymentsByLabels(ctx, namespaceName, logsAggregatorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator deployment in namespace '%v'", namespaceName)
+	}
+	if len(deployments.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs aggregator deployment in namespace '%v'; this is a bug in Kurtosis", namespaceName)
+	}
+	if len(deployments.Items) == 1 {
+		resources.deployment = &deployments.Items[0]
+	}
+
+	services, err := kubernetesManager.GetServicesByLabels(ctx, namespaceName, logsAggregatorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs aggregator service in namespace '%v'", namespaceName)
+	}
+	if len(services.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs aggregator service in namespace '%v'; this is a bug in Kurtosis", namespaceName)
+	}
+	if len(services.Items) == 1 {
+		resources.service = &services.Items[0]
+	}
+
+	return resources, nil
+}
+
+func getLogsAggregatorObjectFromKubernetesResources(
+	resources *logsAggregatorKubernetesResources,
+) (*logs_aggregator.LogsAggregator, error) {
+	if resources.deployment == nil {
+		return nil, stacktrace.NewError("Cannot create a logs aggregator object when no Kubernetes deployment exists")
+	}
+
+	var privateIpAddr net.IP
+	logsAggregatorStatus := container_status.ContainerStatus_Stopped
+	if resources.deployment.Status.ReadyReplicas > 0 {
+		logsAggregatorStatus = container_status.ContainerStatus_Running
+
+		if resources.service == nil {
+			return nil, stacktrace.NewError("Expected the running logs aggregator to have a Kubernetes service but none was found")
+		}
+		// Logs collectors reach the aggregator through its service, which keeps the same IP when the pod gets rescheduled
+		privateIpAddrStr := resources.service.Spec.ClusterIP
+		privateIpAddr = net.ParseIP(privateIpAddrStr)
+		if privateIpAddr == nil {
+			return nil, stacktrace.NewError("Couldn't parse logs aggregator service cluster IP address string '%v' to an IP", privateIpAddrStr)
+		}
+	}
+
+	logsAggregatorObj := logs_aggregator.NewLogsAggregator(
+		logsAggregatorStatus,
+		privateIpAddr,
+		defaultLogsListeningPortNum,
+	)
+
+	return logsAggregatorObj, nil
+}
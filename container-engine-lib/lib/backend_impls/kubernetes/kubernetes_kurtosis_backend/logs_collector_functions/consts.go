@@ -0,0 +1,8 @@
+package logs_collector_functions
+
+//Centralized logs component port IDs
+
+const (
+	logsCollectorTcpPortId  = "tcp"
+	logsCollectorHttpPortId = "http"
+)
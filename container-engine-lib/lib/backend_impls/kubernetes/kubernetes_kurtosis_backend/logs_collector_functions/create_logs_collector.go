@@ -0,0 +1,79 @@
+package logs_collector_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+func CreateLogsCollectorForEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveNamespaceName string,
+	logsCollectorTcpPortNumber uint16,
+	logsCollectorHttpPortNumber uint16,
+	logsCollectorDaemonSet LogsCollectorDaemonSet,
+	logsAggregator *logs_aggregator.LogsAggregator,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+	objAttrsProvider object_attributes_provider.KubernetesObjectAttributesProvider,
+) (
+	*logs_collector.LogsCollector,
+	error,
+) {
+	preExistingLogsCollectorResources, err := getLogsCollectorKubernetesResources(ctx, enclaveNamespaceName, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting logs collector Kubernetes resources for given enclave '%v'", enclaveUuid)
+	}
+	if preExistingLogsCollectorResources.daemonSet != nil {
+		return nil, stacktrace.NewError("Found existing logs collector daemon set for enclave '%v'; cannot start a new one", enclaveUuid)
+	}
+
+	if logsAggregator.GetMaybePrivateIpAddr() == nil {
+		return nil, stacktrace.NewError("Expected the logs aggregator has private IP address but this is nil")
+	}
+
+	logsAggregatorHost := logsAggregator.GetMaybePrivateIpAddr().String()
+	logsAggregatorPortNum := logsAggregator.GetListeningPortNum()
+
+	daemonSet, removeLogsCollectorDaemonSetFunc, err := logsCollectorDaemonSet.CreateAndStart(
+		ctx,
+		logsAggregatorHost,
+		logsAggregatorPortNum,
+		logsCollectorTcpPortNumber,
+		logsCollectorHttpPortNumber,
+		logsCollectorTcpPortId,
+		logsCollectorHttpPortId,
+		enclaveNamespaceName,
+		objAttrsProvider.ForEnclave(enclaveUuid),
+		kubernetesManager,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(
+			err,
+			"An error occurred running the logs collector daemon set with logs aggregator host '%v', logs aggregator port '%v', TCP port number '%v' and HTTP port number '%v' in namespace '%v'",
+			logsAggregatorHost,
+			logsAggregatorPortNum,
+			logsCollectorTcpPortNumber,
+			logsCollectorHttpPortNumber,
+			enclaveNamespaceName,
+		)
+	}
+	shouldRemoveLogsCollectorDaemonSet := true
+	defer func() {
+		if shouldRemoveLogsCollectorDaemonSet {
+			removeLogsCollectorDaemonSetFunc()
+		}
+	}()
+
+	logsCollectorObj, err := getLogsCollectorObjectFromDaemonSet(daemonSet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector object from daemon set '%v'", daemonSet.Name)
+	}
+
+	shouldRemoveLogsCollectorDaemonSet = false
+	return logsCollectorObj, nil
+}
@@ -0,0 +1,53 @@
+package logs_collector_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Destroys every Kubernetes resource of the logs collector in the enclave namespace, returns nil if none is found
+func DestroyLogsCollector(
+	ctx context.Context,
+	enclaveNamespaceName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	logsCollectorResources, err := getLogsCollectorKubernetesResources(ctx, enclaveNamespaceName, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the logs collector Kubernetes resources in namespace '%v'", enclaveNamespaceName)
+	}
+
+	removeErrorsByResource := map[string]error{}
+	if logsCollectorResources.daemonSet != nil {
+		if err := kubernetesManager.RemoveDaemonSet(ctx, logsCollectorResources.daemonSet); err != nil {
+			removeErrorsByResource["daemon set"] = err
+		}
+	}
+	if logsCollectorResources.configMap != nil {
+		if err := kubernetesManager.RemoveConfigMap(ctx, enclaveNamespaceName, logsCollectorResources.configMap.Name); err != nil {
+			removeErrorsByResource["config map"] = err
+		}
+	}
+	if logsCollectorResources.roleBinding != nil {
+		if err := kubernetesManager.RemoveRoleBindings(ctx, logsCollectorResources.roleBinding); err != nil {
+			removeErrorsByResource["role binding"] = err
+		}
+	}
+	if logsCollectorResources.role != nil {
+		if err := kubernetesManager.RemoveRole(ctx, logsCollectorResources.role); err != nil {
+			removeErrorsByResource["role"] = err
+		}
+	}
+	if logsCollectorResources.serviceAccount != nil {
+		if err := kubernetesManager.RemoveServiceAccount(ctx, logsCollectorResources.serviceAccount); err != nil {
+			removeErrorsByResource["service account"] = err
+		}
+	}
+
+	if len(removeErrorsByResource) > 0 {
+		combinedErr := shared_helpers.BuildCombinedError(removeErrorsByResource, "logs collector resource removal")
+		return stacktrace.Propagate(combinedErr, "An error occurred removing the logs collector Kubernetes resources in namespace '%v'", enclaveNamespaceName)
+	}
+	return nil
+}
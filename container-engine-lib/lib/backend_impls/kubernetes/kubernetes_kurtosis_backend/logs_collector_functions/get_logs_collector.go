@@ -0,0 +1,30 @@
+package logs_collector_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// Returns nil if no logs collector is found in the enclave namespace
+func GetLogsCollectorForEnclave(
+	ctx context.Context,
+	enclaveNamespaceName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*logs_collector.LogsCollector, error) {
+	logsCollectorResources, err := getLogsCollectorKubernetesResources(ctx, enclaveNamespaceName, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector Kubernetes resources in namespace '%v'", enclaveNamespaceName)
+	}
+	if logsCollectorResources.daemonSet == nil {
+		return nil, nil
+	}
+
+	logsCollectorObj, err := getLogsCollectorObjectFromDaemonSet(logsCollectorResources.daemonSet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector object from daemon set '%v'", logsCollectorResources.daemonSet.Name)
+	}
+
+	return logsCollectorObj, nil
+}
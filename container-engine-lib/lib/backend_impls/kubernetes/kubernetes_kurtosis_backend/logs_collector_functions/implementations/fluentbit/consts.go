@@ -0,0 +1,140 @@
+package fluentbit
+
+const (
+	rootDirpath = "/fluent-bit"
+
+	////////////////////////--FLUENT BIT CONTAINER CONFIGURATION SECTION--/////////////////////////////
+	containerName  = "fluent-bit"
+	containerImage = "fluent/fluent-bit:1.9.7"
+
+	configDirpathInContainer  = rootDirpath + "/etc"
+	configFilename            = "fluent-bit.conf"
+	luaScriptFilename         = "kurtosis.lua"
+	configFilepathInContainer = configDirpathInContainer + "/" + configFilename
+	luaScriptFilepath         = configDirpathInContainer + "/" + luaScriptFilename
+	binaryFilepath            = rootDirpath + "/bin/fluent-bit"
+	configFileFlag            = "-c"
+
+	configVolumeName = "fluent-bit-config"
+
+	// The kubelet writes the logs of every container running on the node in here, and these are usually symlinks
+	// to the container runtime's own logs directory
+	nodeLogsDirpath             = "/var/log"
+	nodeDockerContainersDirpath = "/var/lib/docker/containers"
+	nodeLogsVolumeName          = "node-logs"
+	nodeDockerContainersVolume  = "node-docker-containers"
+
+	//these two values are used for configuring the filesystem buffer. See more here: https://docs.fluentbit.io/manual/administration/buffering-and-storage#filesystem-buffering-to-the-rescue
+	filesystemBufferStorageDirpath = rootDirpath + "/storage/"
+	inputFilesystemStorageType     = "filesystem"
+
+	// The storage lives on the node, one directory per enclave, so that neither the buffered logs nor the read offsets
+	// of the container logs files are lost if the logs collector pod gets restarted
+	storageHostDirpathFormat = "/kurtosis-logs-collector-storage/%v"
+	storageVolumeName        = "fluent-bit-storage"
+
+	configFileTemplateName = "fluentbitConfigFileTemplate"
+	configFileTemplate     = `
+[SERVICE]
+	log_level {{.Service.LogLevel}}
+	http_server {{.Service.HttpServerEnabled}}
+	http_listen {{.Service.HttpServerHost}}
+	http_port {{.Service.HttpServerPort}}
+	storage.path {{.Service.StoragePath}}
+[INPUT]
+	name {{.ContainerLogsInput.Name}}
+	path {{.ContainerLogsInput.Path}}
+	multiline.parser {{.ContainerLogsInput.MultilineParsers}}
+	tag {{.ContainerLogsInput.Tag}}
+	db {{.ContainerLogsInput.DbFilepath}}
+	skip_long_lines On
+	storage.type {{.ContainerLogsInput.StorageType}}
+[INPUT]
+	name {{.ForwardInput.Name}}
+	listen {{.ForwardInput.Listen}}
+	port {{.ForwardInput.Port}}
+	storage.type {{.ForwardInput.StorageType}}
+[FILTER]
+	name {{.KubernetesFilter.Name}}
+	match {{.KubernetesFilter.Match}}
+	kube_tag_prefix {{.KubernetesFilter.KubeTagPrefix}}
+	labels On
+	annotations Off
+	merge_log Off
+[FILTER]
+	name {{.LuaFilter.Name}}
+	match {{.LuaFilter.Match}}
+	script {{.LuaFilter.ScriptFilepath}}
+	call {{.LuaFilter.FunctionName}}
+[OUTPUT]
+	name {{.Output.Name}}
+	match {{.Output.Match}}
+	host {{.Output.Host}}
+	port {{.Output.Port}}
+`
+
+	healthCheckEndpointPath = "api/v1/health"
+	////////////////////////--FINISH FLUENT BIT CONTAINER CONFIGURATION SECTION--/////////////////////////////
+
+	////////////////////////--FLUENTBIT CONFIGURATION SECTION--/////////////////////////////
+	logLevel               = "info"
+	httpServerEnabledValue = "On"
+	httpServerLocalhost    = "0.0.0.0"
+	forwardInputName       = "forward"
+	forwardInputListenIP   = "0.0.0.0"
+	matchAllRegex          = "*"
+
+	tailInputName = "tail"
+	// Container log files are named '<pod name>_<namespace name>_<container name>-<container id>.log'
+	containerLogsFilepathFormat = "/var/log/containers/*_%v_*.log"
+	containerLogsDbFilepath     = filesystemBufferStorageDirpath + "containers.db"
+	multilineParsers            = "docker, cri"
+	containerLogsTagPrefix      = "kube."
+	containerLogsTag            = containerLogsTagPrefix + "*"
+	containerLogsMatch          = containerLogsTagPrefix + "*"
+	kubeTagPrefix               = containerLogsTagPrefix + "var.log.containers."
+
+	kubernetesFilterName = "kubernetes"
+
+	luaFilterName         = "lua"
+	luaFilterFunctionName = "add_kurtosis_fields"
+
+	// Must match the length of the shortened UUIDs, see uuid_generator.ShortenedUUIDString
+	shortenedUuidLength = 12
+
+	// fluentbit doesn't have a dedicated vector output plugin but vector added a source input plugin for fluentbit
+	// with the ability to pick up logs over fluentbit's forward output plugin, PR here: https://github.com/vectordotdev/vector/pull/7548
+	vectorOutputTypeName = "forward"
+	////////////////////////--FINISH FLUENTBIT CONFIGURATION SECTION--/////////////////////////////
+
+	////////////////////////--LUA SCRIPT SECTION--/////////////////////////////
+	luaScriptTemplateName = "fluentbitLuaScriptTemplate"
+	// Drops every record that doesn't come from a user service, and reshapes the remaining ones into the same
+	// record the Docker logs pipeline produces, so the logs aggregator stores them in the same files
+	// Returning 2 keeps the original timestamp of the record
+	luaScriptTemplate = `
+function {{.FunctionName}}(tag, timestamp, record)
+	local kubernetes = record["kubernetes"]
+	if kubernetes == nil or kubernetes["labels"] == nil then
+		return -1, 0, 0
+	end
+	local labels = kubernetes["labels"]
+	if labels["{{.ResourceTypeLabelKey}}"] ~= "{{.UserServiceResourceTypeLabelValue}}" then
+		return -1, 0, 0
+	end
+	local service_uuid = labels["{{.ServiceUuidLabelKey}}"]
+	if service_uuid == nil then
+		return -1, 0, 0
+	end
+	local new_record = {}
+	new_record["log"] = record["log"]
+	new_record["enclave_uuid"] = labels["{{.EnclaveUuidLabelKey}}"]
+	new_record["service_uuid"] = service_uuid
+	new_record["service_name"] = labels["{{.ServiceNameLabelKey}}"]
+	new_record["service_short_uuid"] = string.sub(service_uuid, 1, {{.ShortUuidLength}})
+	new_record["container_type"] = labels["{{.ResourceTypeLabelKey}}"]
+	return 2, timestamp, new_record
+end
+`
+	////////////////////////--FINISH LUA SCRIPT SECTION--/////////////////////////////
+)
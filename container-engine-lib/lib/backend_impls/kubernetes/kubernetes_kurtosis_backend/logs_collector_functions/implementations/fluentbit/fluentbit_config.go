@@ -0,0 +1,160 @@
+package fluentbit
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/stacktrace"
+	"text/template"
+)
+
+type FluentbitConfig struct {
+	Service            *Service
+	ContainerLogsInput *TailInput
+	ForwardInput       *ForwardInput
+	KubernetesFilter   *KubernetesFilter
+	LuaFilter          *LuaFilter
+	Output             *Output
+}
+
+type Service struct {
+	LogLevel          string
+	HttpServerEnabled string
+	HttpServerHost    string
+	HttpServerPort    uint16
+	StoragePath       string
+}
+
+type TailInput struct {
+	Name             string
+	Path             string
+	MultilineParsers string
+	Tag              string
+	DbFilepath       string
+	StorageType      string
+}
+
+type ForwardInput struct {
+	Name        string
+	Listen      string
+	Port        uint16
+	StorageType string
+}
+
+type KubernetesFilter struct {
+	Name          string
+	Match         string
+	KubeTagPrefix string
+}
+
+type LuaFilter struct {
+	Name           string
+	Match          string
+	ScriptFilepath string
+	FunctionName   string
+}
+
+type Output struct {
+	Name  string
+	Match string
+	Host  string
+	Port  uint16
+}
+
+type luaScriptValues struct {
+	FunctionName                      string
+	ResourceTypeLabelKey              string
+	UserServiceResourceTypeLabelValue string
+	ServiceUuidLabelKey               string
+	ServiceNameLabelKey               string
+	EnclaveUuidLabelKey               string
+	ShortUuidLength                   int
+}
+
+func newDefaultFluentbitConfigForKurtosisCentralizedLogs(
+	enclaveNamespaceName string,
+	logsAggregatorHost string,
+	logsAggregatorPort uint16,
+	tcpPortNumber uint16,
+	httpPortNumber uint16,
+) *FluentbitConfig {
+	return &FluentbitConfig{
+		Service: &Service{
+			LogLevel:          logLevel,
+			HttpServerEnabled: httpServerEnabledValue,
+			HttpServerHost:    httpServerLocalhost,
+			HttpServerPort:    httpPortNumber,
+			StoragePath:       filesystemBufferStorageDirpath,
+		},
+		ContainerLogsInput: &TailInput{
+			Name:             tailInputName,
+			Path:             fmt.Sprintf(containerLogsFilepathFormat, enclaveNamespaceName),
+			MultilineParsers: multilineParsers,
+			Tag:              containerLogsTag,
+			DbFilepath:       containerLogsDbFilepath,
+			StorageType:      inputFilesystemStorageType,
+		},
+		ForwardInput: &ForwardInput{
+			Name:        forwardInputName,
+			Listen:      forwardInputListenIP,
+			Port:        tcpPortNumber,
+			StorageType: inputFilesystemStorageType,
+		},
+		KubernetesFilter: &KubernetesFilter{
+			Name:          kubernetesFilterName,
+			Match:         containerLogsMatch,
+			KubeTagPrefix: kubeTagPrefix,
+		},
+		LuaFilter: &LuaFilter{
+			Name:           luaFilterName,
+			Match:          containerLogsMatch,
+			ScriptFilepath: luaScriptFilepath,
+			FunctionName:   luaFilterFunctionName,
+		},
+		Output: &Output{
+			Name:  vectorOutputTypeName,
+			Match: matchAllRegex,
+			Host:  logsAggregatorHost,
+			Port:  logsAggregatorPort,
+		},
+	}
+}
+
+func (cfg *FluentbitConfig) getConfigFileContent() (string, error) {
+	configFileTmpl, err := template.New(configFileTemplateName).Parse(configFileTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing the Fluentbit config file template")
+	}
+
+	templateStrBuffer := &bytes.Buffer{}
+	if err := configFileTmpl.Execute(templateStrBuffer, cfg); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred executing the Fluentbit config file template")
+	}
+
+	return templateStrBuffer.String(), nil
+}
+
+func (cfg *FluentbitConfig) getLuaScriptContent() (string, error) {
+	luaScriptTmpl, err := template.New(luaScriptTemplateName).Parse(luaScriptTemplate)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred parsing the Fluentbit Lua script template")
+	}
+
+	values := &luaScriptValues{
+		FunctionName:                      cfg.LuaFilter.FunctionName,
+		ResourceTypeLabelKey:              label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString(),
+		UserServiceResourceTypeLabelValue: label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+		ServiceUuidLabelKey:               label_key_consts.GUIDKubernetesLabelKey.GetString(),
+		ServiceNameLabelKey:               label_key_consts.IDKubernetesLabelKey.GetString(),
+		EnclaveUuidLabelKey:               label_key_consts.EnclaveUUIDKubernetesLabelKey.GetString(),
+		ShortUuidLength:                   shortenedUuidLength,
+	}
+
+	templateStrBuffer := &bytes.Buffer{}
+	if err := luaScriptTmpl.Execute(templateStrBuffer, values); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred executing the Fluentbit Lua script template")
+	}
+
+	return templateStrBuffer.String(), nil
+}
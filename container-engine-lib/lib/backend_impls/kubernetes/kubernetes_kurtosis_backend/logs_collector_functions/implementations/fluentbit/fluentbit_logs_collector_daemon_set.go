@@ -0,0 +1,359 @@
+package fluentbit
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	kubernetes_manager_consts "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager/consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+type fluentbitLogsCollectorDaemonSet struct{}
+
+func NewFluentbitLogsCollectorDaemonSet() *fluentbitLogsCollectorDaemonSet {
+	return &fluentbitLogsCollectorDaemonSet{}
+}
+
+func (fluentbitDaemonSet *fluentbitLogsCollectorDaemonSet) CreateAndStart(
+	ctx context.Context,
+	logsAggregatorHost string,
+	logsAggregatorPort uint16,
+	tcpPortNumber uint16,
+	httpPortNumber uint16,
+	logsCollectorTcpPortId string,
+	logsCollectorHttpPortId string,
+	targetNamespaceName string,
+	enclaveObjAttrsProvider object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*appsv1.DaemonSet, func(), error) {
+	logsCollectorAttrs, err := enclaveObjAttrsProvider.ForLogsCollector()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs collector Kubernetes attributes")
+	}
+	// The same name and labels are used for every Kubernetes resource of the logs collector
+	logsCollectorName := logsCollectorAttrs.GetName().GetString()
+	logsCollectorLabels := shared_helpers.GetStringMapFromLabelMap(logsCollectorAttrs.GetLabels())
+
+	fluentbitConfig := newDefaultFluentbitConfigForKurtosisCentralizedLogs(targetNamespaceName, logsAggregatorHost, logsAggregatorPort, tcpPortNumber, httpPortNumber)
+	configFileContentStr, err := fluentbitConfig.getConfigFileContent()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs collector's configuration content")
+	}
+	luaScriptContentStr, err := fluentbitConfig.getLuaScriptContent()
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the logs collector's Lua script content")
+	}
+
+	// The Kubernetes filter needs to read the pods' metadata to know which enclave and service the logs belong to
+	serviceAccount, err := kubernetesManager.CreateServiceAccount(ctx, logsCollectorName, targetNamespaceName, logsCollectorLabels)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating service account '%v' in namespace '%v'", logsCollectorName, targetNamespaceName)
+	}
+	shouldRemoveServiceAccount := true
+	defer func() {
+		if shouldRemoveServiceAccount {
+			if err := kubernetesManager.RemoveServiceAccount(ctx, serviceAccount); err != nil {
+				logrus.Errorf("Creating the logs collector didn't complete successfully, so we tried to delete service account '%v' in namespace '%v' that we created but an error was thrown:\n%v", logsCollectorName, targetNamespaceName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove service account with name '%v'!!!!!!!", logsCollectorName)
+			}
+		}
+	}()
+
+	rolePolicyRules := []rbacv1.PolicyRule{
+		{
+			Verbs: []string{
+				kubernetes_manager_consts.GetKubernetesVerb,
+				kubernetes_manager_consts.ListKubernetesVerb,
+				kubernetes_manager_consts.WatchKubernetesVerb,
+			},
+			APIGroups:       []string{rbacv1.APIGroupAll},
+			Resources:       []string{kubernetes_manager_consts.PodsKubernetesResource},
+			ResourceNames:   nil,
+			NonResourceURLs: nil,
+		},
+	}
+	role, err := kubernetesManager.CreateRole(ctx, logsCollectorName, targetNamespaceName, rolePolicyRules, logsCollectorLabels)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating role '%v' in namespace '%v'", logsCollectorName, targetNamespaceName)
+	}
+	shouldRemoveRole := true
+	defer func() {
+		if shouldRemoveRole {
+			if err := kubernetesManager.RemoveRole(ctx, role); err != nil {
+				logrus.Errorf("Creating the logs collector didn't complete successfully, so we tried to delete role '%v' in namespace '%v' that we created but an error was thrown:\n%v", logsCollectorName, targetNamespaceName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove role with name '%v'!!!!!!!", logsCollectorName)
+			}
+		}
+	}()
+
+	roleBindingSubjects := []rbacv1.Subject{
+		{
+			Kind:      rbacv1.ServiceAccountKind,
+			APIGroup:  "",
+			Name:      serviceAccount.Name,
+			Namespace: targetNamespaceName,
+		},
+	}
+	roleBindingRoleRef := rbacv1.RoleRef{
+		APIGroup: kubernetes_manager_consts.RbacAuthorizationApiGroup,
+		Kind:     kubernetes_manager_consts.RoleKubernetesResourceType,
+		Name:     role.Name,
+	}
+	roleBinding, err := kubernetesManager.CreateRoleBindings(ctx, logsCollectorName, targetNamespaceName, roleBindingSubjects, roleBindingRoleRef, logsCollectorLabels)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating role binding '%v' in namespace '%v'", logsCollectorName, targetNamespaceName)
+	}
+	shouldRemoveRoleBinding := true
+	defer func() {
+		if shouldRemoveRoleBinding {
+			if err := kubernetesManager.RemoveRoleBindings(ctx, roleBinding); err != nil {
+				logrus.Errorf("Creating the logs collector didn't complete successfully, so we tried to delete role binding '%v' in namespace '%v' that we created but an error was thrown:\n%v", logsCollectorName, targetNamespaceName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove role binding with name '%v'!!!!!!!", logsCollectorName)
+			}
+		}
+	}()
+
+	configMap, err := kubernetesManager.CreateConfigMap(
+		ctx,
+		targetNamespaceName,
+		logsCollectorName,
+		logsCollectorLabels,
+		map[string]string{
+			configFilename:    configFileContentStr,
+			luaScriptFilename: luaScriptContentStr,
+		},
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating config map '%v' in namespace '%v'", logsCollectorName, targetNamespaceName)
+	}
+	shouldRemoveConfigMap := true
+	defer func() {
+		if shouldRemoveConfigMap {
+			if err := kubernetesManager.RemoveConfigMap(ctx, targetNamespaceName, configMap.Name); err != nil {
+				logrus.Errorf("Creating the logs collector didn't complete successfully, so we tried to delete config map '%v' in namespace '%v' that we created but an error was thrown:\n%v", logsCollectorName, targetNamespaceName, err)
+				logrus.Errorf("ACTION REQUIRED: You'll need to manually remove config map with name '%v'!!!!!!!", logsCollectorName)
+			}
+		}
+	}()
+
+	containers := []apiv1.Container{
+		getFluentbitContainer(tcpPortNumber, httpPortNumber, logsCollectorTcpPortId, logsCollectorHttpPortId),
+	}
+	volumes := getFluentbitVolumes(configMap.Name, targetNamespaceName)
+
+	daemonSet, err := kubernetesManager.CreateDaemonSet(
+		ctx,
+		targetNamespaceName,
+		logsCollectorName,
+		logsCollectorLabels,
+		logsCollectorLabels,
+		containers,
+		volumes,
+		serviceAccount.Name,
+	)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating daemon set '%v' in namespace '%v'", logsCollectorName, targetNamespaceName)
+	}
+
+	removeLogsCollectorFunc := func() {
+		removeCtx := context.Background()
+		if err := kubernetesManager.RemoveDaemonSet(removeCtx, daemonSet); err != nil {
+			logrus.Errorf("Launching the logs collector didn't complete successfully so we tried to remove daemon set '%v' in namespace '%v', but doing so exited with an error:\n%v", daemonSet.Name, targetNamespaceName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove daemon set with name '%v'!!!!!!!", daemonSet.Name)
+		}
+		if err := kubernetesManager.RemoveConfigMap(removeCtx, targetNamespaceName, configMap.Name); err != nil {
+			logrus.Errorf("Launching the logs collector didn't complete successfully so we tried to remove config map '%v' in namespace '%v', but doing so exited with an error:\n%v", configMap.Name, targetNamespaceName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove config map with name '%v'!!!!!!!", configMap.Name)
+		}
+		if err := kubernetesManager.RemoveRoleBindings(removeCtx, roleBinding); err != nil {
+			logrus.Errorf("Launching the logs collector didn't complete successfully so we tried to remove role binding '%v' in namespace '%v', but doing so exited with an error:\n%v", roleBinding.Name, targetNamespaceName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove role binding with name '%v'!!!!!!!", roleBinding.Name)
+		}
+		if err := kubernetesManager.RemoveRole(removeCtx, role); err != nil {
+			logrus.Errorf("Launching the logs collector didn't complete successfully so we tried to remove role '%v' in namespace '%v', but doing so exited with an error:\n%v", role.Name, targetNamespaceName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove role with name '%v'!!!!!!!", role.Name)
+		}
+		if err := kubernetesManager.RemoveServiceAccount(removeCtx, serviceAccount); err != nil {
+			logrus.Errorf("Launching the logs collector didn't complete successfully so we tried to remove service account '%v' in namespace '%v', but doing so exited with an error:\n%v", serviceAccount.Name, targetNamespaceName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to manually remove service account with name '%v'!!!!!!!", serviceAccount.Name)
+		}
+	}
+
+	shouldRemoveConfigMap = false
+	shouldRemoveRoleBinding = false
+	shouldRemoveRole = false
+	shouldRemoveServiceAccount = false
+	return daemonSet, removeLogsCollectorFunc, nil
+}
+
+func getFluentbitContainer(
+	tcpPortNumber uint16,
+	httpPortNumber uint16,
+	logsCollectorTcpPortId string,
+	logsCollectorHttpPortId string,
+) apiv1.Container {
+	return apiv1.Container{
+		Name:  containerName,
+		Image: containerImage,
+		Command: []string{
+			binaryFilepath,
+		},
+		Args: []string{
+			configFileFlag,
+			configFilepathInContainer,
+		},
+		WorkingDir: "",
+		Ports: []apiv1.ContainerPort{
+			{
+				Name:          logsCollectorTcpPortId,
+				HostPort:      0,
+				ContainerPort: int32(tcpPortNumber),
+				Protocol:      apiv1.ProtocolTCP,
+				HostIP:        "",
+			},
+			{
+				Name:          logsCollectorHttpPortId,
+				HostPort:      0,
+				ContainerPort: int32(httpPortNumber),
+				Protocol:      apiv1.ProtocolTCP,
+				HostIP:        "",
+			},
+		},
+		EnvFrom: nil,
+		Env:     nil,
+		Resources: apiv1.ResourceRequirements{
+			Limits:   nil,
+			Requests: nil,
+			Claims:   nil,
+		},
+		VolumeMounts: []apiv1.VolumeMount{
+			newVolumeMount(configVolumeName, configDirpathInContainer, true),
+			newVolumeMount(storageVolumeName, filesystemBufferStorageDirpath, false),
+			newVolumeMount(nodeLogsVolumeName, nodeLogsDirpath, true),
+			newVolumeMount(nodeDockerContainersVolume, nodeDockerContainersDirpath, true),
+		},
+		VolumeDevices: nil,
+		LivenessProbe: nil,
+		ReadinessProbe: &apiv1.Probe{
+			ProbeHandler: apiv1.ProbeHandler{
+				Exec: nil,
+				HTTPGet: &apiv1.HTTPGetAction{
+					Path:        "/" + healthCheckEndpointPath,
+					Port:        intstr.FromInt(int(httpPortNumber)),
+					Host:        "",
+					Scheme:      apiv1.URISchemeHTTP,
+					HTTPHeaders: nil,
+				},
+				TCPSocket: nil,
+				GRPC:      nil,
+			},
+			InitialDelaySeconds:           0,
+			TimeoutSeconds:                0,
+			PeriodSeconds:                 0,
+			SuccessThreshold:              0,
+			FailureThreshold:              0,
+			TerminationGracePeriodSeconds: nil,
+		},
+		StartupProbe:             nil,
+		Lifecycle:                nil,
+		TerminationMessagePath:   "",
+		TerminationMessagePolicy: "",
+		ImagePullPolicy:          "",
+		SecurityContext:          nil,
+		Stdin:                    false,
+		StdinOnce:                false,
+		TTY:                      false,
+	}
+}
+
+func getFluentbitVolumes(configMapName string, enclaveNamespaceName string) []apiv1.Volume {
+	hostPathDirectoryOrCreate := apiv1.HostPathDirectoryOrCreate
+	hostPathUnset := apiv1.HostPathUnset
+
+	configMapVolumeSource := newEmptyVolumeSource()
+	configMapVolumeSource.ConfigMap = &apiv1.ConfigMapVolumeSource{
+		LocalObjectReference: apiv1.LocalObjectReference{
+			Name: configMapName,
+		},
+		Items:       nil,
+		DefaultMode: nil,
+		Optional:    nil,
+	}
+
+	storageVolumeSource := newEmptyVolumeSource()
+	storageVolumeSource.HostPath = &apiv1.HostPathVolumeSource{
+		Path: fmt.Sprintf(storageHostDirpathFormat, enclaveNamespaceName),
+		Type: &hostPathDirectoryOrCreate,
+	}
+
+	nodeLogsVolumeSource := newEmptyVolumeSource()
+	nodeLogsVolumeSource.HostPath = &apiv1.HostPathVolumeSource{
+		Path: nodeLogsDirpath,
+		Type: &hostPathUnset,
+	}
+
+	nodeDockerContainersVolumeSource := newEmptyVolumeSource()
+	nodeDockerContainersVolumeSource.HostPath = &apiv1.HostPathVolumeSource{
+		Path: nodeDockerContainersDirpath,
+		Type: &hostPathUnset,
+	}
+
+	return []apiv1.Volume{
+		{Name: configVolumeName, VolumeSource: configMapVolumeSource},
+		{Name: storageVolumeName, VolumeSource: storageVolumeSource},
+		{Name: nodeLogsVolumeName, VolumeSource: nodeLogsVolumeSource},
+		{Name: nodeDockerContainersVolume, VolumeSource: nodeDockerContainersVolumeSource},
+	}
+}
+
+func newVolumeMount(volumeName string, mountPath string, isReadOnly bool) apiv1.VolumeMount {
+	return apiv1.VolumeMount{
+		Name:             volumeName,
+		ReadOnly:         isReadOnly,
+		MountPath:        mountPath,
+		SubPath:          "",
+		MountPropagation: nil,
+		SubPathExpr:      "",
+	}
+}
+
+func newEmptyVolumeSource() apiv1.VolumeSource {
+	return apiv1.VolumeSource{
+		HostPath:              nil,
+		EmptyDir:              nil,
+		GCEPersistentDisk:     nil,
+		AWSElasticBlockStore:  nil,
+		GitRepo:               nil,
+		Secret:                nil,
+		NFS:                   nil,
+		ISCSI:                 nil,
+		Glusterfs:             nil,
+		PersistentVolumeClaim: nil,
+		RBD:                   nil,
+		FlexVolume:            nil,
+		Cinder:                nil,
+		CephFS:                nil,
+		Flocker:               nil,
+		DownwardAPI:           nil,
+		FC:                    nil,
+		AzureFile:             nil,
+		ConfigMap:             nil,
+		VsphereVolume:         nil,
+		Quobyte:               nil,
+		AzureDisk:             nil,
+		PhotonPersistentDisk:  nil,
+		Projected:             nil,
+		PortworxVolume:        nil,
+		ScaleIO:               nil,
+		StorageOS:             nil,
+		CSI:                   nil,
+		Ephemeral:             nil,
+	}
+}
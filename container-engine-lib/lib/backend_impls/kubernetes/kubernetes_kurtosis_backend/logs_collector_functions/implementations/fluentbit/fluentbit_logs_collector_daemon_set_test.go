@@ -0,0 +1,153 @@
+package fluentbit
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"testing"
+)
+
+const (
+	testEnclaveNamespaceName = "kt-test-enclave"
+	testAggregatorHost       = "kurtosis-logs-aggregator.kurtosis-engine.svc.cluster.local"
+	testAggregatorPort       = uint16(9000)
+	testTcpPortNumber        = uint16(9713)
+	testHttpPortNumber       = uint16(9712)
+	testTcpPortId            = "tcp"
+	testHttpPortId           = "http"
+	testConfigMapName        = "kurtosis-logs-collector"
+)
+
+func TestGetConfigFileContent(t *testing.T) {
+	fluentbitConfig := newDefaultFluentbitConfigForKurtosisCentralizedLogs(testEnclaveNamespaceName, testAggregatorHost, testAggregatorPort, testTcpPortNumber, testHttpPortNumber)
+	configFileContent, err := fluentbitConfig.getConfigFileContent()
+	require.NoError(t, err)
+
+	expectedConfigFileContent := `
+[SERVICE]
+	log_level info
+	http_server On
+	http_listen 0.0.0.0
+	http_port 9712
+	storage.path /fluent-bit/storage/
+[INPUT]
+	name tail
+	path /var/log/containers/*_kt-test-enclave_*.log
+	multiline.parser docker, cri
+	tag kube.*
+	db /fluent-bit/storage/containers.db
+	skip_long_lines On
+	storage.type filesystem
+[INPUT]
+	name forward
+	listen 0.0.0.0
+	port 9713
+	storage.type filesystem
+[FILTER]
+	name kubernetes
+	match kube.*
+	kube_tag_prefix kube.var.log.containers.
+	labels On
+	annotations Off
+	merge_log Off
+[FILTER]
+	name lua
+	match kube.*
+	script /fluent-bit/etc/kurtosis.lua
+	call add_kurtosis_fields
+[OUTPUT]
+	name forward
+	match *
+	host kurtosis-logs-aggregator.kurtosis-engine.svc.cluster.local
+	port 9000
+`
+	require.Equal(t, expectedConfigFileContent, configFileContent)
+}
+
+func TestGetLuaScriptContent(t *testing.T) {
+	fluentbitConfig := newDefaultFluentbitConfigForKurtosisCentralizedLogs(testEnclaveNamespaceName, testAggregatorHost, testAggregatorPort, testTcpPortNumber, testHttpPortNumber)
+	luaScriptContent, err := fluentbitConfig.getLuaScriptContent()
+	require.NoError(t, err)
+
+	resourceTypeLabelKey := label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString()
+	require.Contains(t, luaScriptContent, "function add_kurtosis_fields(tag, timestamp, record)")
+	require.Contains(t, luaScriptContent, `if labels["`+resourceTypeLabelKey+`"] ~= "`+label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString()+`" then`)
+	require.Contains(t, luaScriptContent, `local service_uuid = labels["`+label_key_consts.GUIDKubernetesLabelKey.GetString()+`"]`)
+	require.Contains(t, luaScriptContent, `new_record["enclave_uuid"] = labels["`+label_key_consts.EnclaveUUIDKubernetesLabelKey.GetString()+`"]`)
+	require.Contains(t, luaScriptContent, `new_record["service_name"] = labels["`+label_key_consts.IDKubernetesLabelKey.GetString()+`"]`)
+	require.Contains(t, luaScriptContent, `new_record["service_short_uuid"] = string.sub(service_uuid, 1, 12)`)
+	require.Contains(t, luaScriptContent, `new_record["container_type"] = labels["`+resourceTypeLabelKey+`"]`)
+	require.NotContains(t, luaScriptContent, "{{")
+}
+
+func TestGetFluentbitContainer(t *testing.T) {
+	container := getFluentbitContainer(testTcpPortNumber, testHttpPortNumber, testTcpPortId, testHttpPortId)
+
+	require.Equal(t, containerName, container.Name)
+	require.Equal(t, containerImage, container.Image)
+	require.Equal(t, []string{"/fluent-bit/bin/fluent-bit"}, container.Command)
+	require.Equal(t, []string{"-c", "/fluent-bit/etc/fluent-bit.conf"}, container.Args)
+
+	require.Len(t, container.Ports, 2)
+	require.Equal(t, testTcpPortId, container.Ports[0].Name)
+	require.Equal(t, int32(testTcpPortNumber), container.Ports[0].ContainerPort)
+	require.Equal(t, testHttpPortId, container.Ports[1].Name)
+	require.Equal(t, int32(testHttpPortNumber), container.Ports[1].ContainerPort)
+
+	expectedVolumeMounts := map[string]struct {
+		mountPath  string
+		isReadOnly bool
+	}{
+		configVolumeName:           {mountPath: "/fluent-bit/etc", isReadOnly: true},
+		storageVolumeName:          {mountPath: "/fluent-bit/storage/", isReadOnly: false},
+		nodeLogsVolumeName:         {mountPath: "/var/log", isReadOnly: true},
+		nodeDockerContainersVolume: {mountPath: "/var/lib/docker/containers", isReadOnly: true},
+	}
+	require.Len(t, container.VolumeMounts, len(expectedVolumeMounts))
+	for _, volumeMount := range container.VolumeMounts {
+		expectedVolumeMount, found := expectedVolumeMounts[volumeMount.Name]
+		require.True(t, found, "Unexpected volume mount '%v'", volumeMount.Name)
+		require.Equal(t, expectedVolumeMount.mountPath, volumeMount.MountPath)
+		require.Equal(t, expectedVolumeMount.isReadOnly, volumeMount.ReadOnly)
+	}
+
+	require.NotNil(t, container.ReadinessProbe)
+	require.NotNil(t, container.ReadinessProbe.HTTPGet)
+	require.Equal(t, "/api/v1/health", container.ReadinessProbe.HTTPGet.Path)
+	require.Equal(t, int(testHttpPortNumber), container.ReadinessProbe.HTTPGet.Port.IntValue())
+}
+
+func TestGetFluentbitVolumes(t *testing.T) {
+	volumes := getFluentbitVolumes(testConfigMapName, testEnclaveNamespaceName)
+	require.Len(t, volumes, 4)
+
+	volumesByName := map[string]apiv1.Volume{}
+	for _, volume := range volumes {
+		volumesByName[volume.Name] = volume
+	}
+
+	configVolume, found := volumesByName[configVolumeName]
+	require.True(t, found)
+	require.NotNil(t, configVolume.ConfigMap)
+	require.Equal(t, testConfigMapName, configVolume.ConfigMap.Name)
+
+	// Each enclave gets its own storage directory on the node, so logs collectors of different enclaves never share offsets
+	storageVolume, found := volumesByName[storageVolumeName]
+	require.True(t, found)
+	require.NotNil(t, storageVolume.HostPath)
+	require.Equal(t, "/kurtosis-logs-collector-storage/kt-test-enclave", storageVolume.HostPath.Path)
+	require.Equal(t, apiv1.HostPathDirectoryOrCreate, *storageVolume.HostPath.Type)
+
+	nodeLogsVolume, found := volumesByName[nodeLogsVolumeName]
+	require.True(t, found)
+	require.NotNil(t, nodeLogsVolume.HostPath)
+	require.Equal(t, "/var/log", nodeLogsVolume.HostPath.Path)
+	require.Equal(t, apiv1.HostPathUnset, *nodeLogsVolume.HostPath.Type)
+
+	dockerContainersVolume, found := volumesByName[nodeDockerContainersVolume]
+	require.True(t, found)
+	require.NotNil(t, dockerContainersVolume.HostPath)
+	require.Equal(t, "/var/lib/docker/containers", dockerContainersVolume.HostPath.Path)
+	require.Equal(t, apiv1.HostPathUnset, *dockerContainersVolume.HostPath.Type)
+}
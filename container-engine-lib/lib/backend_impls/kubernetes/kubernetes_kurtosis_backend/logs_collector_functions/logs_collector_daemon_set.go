@@ -0,0 +1,23 @@
+package logs_collector_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+type LogsCollectorDaemonSet interface {
+	CreateAndStart(
+		ctx context.Context,
+		logsAggregatorHost string,
+		logsAggregatorPort uint16,
+		tcpPortNumber uint16,
+		httpPortNumber uint16,
+		logsCollectorTcpPortId string,
+		logsCollectorHttpPortId string,
+		targetNamespaceName string,
+		enclaveObjAttrsProvider object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
+		kubernetesManager *kubernetes_manager.KubernetesManager,
+	) (*appsv1.DaemonSet, func(), error)
+}
@@ -0,0 +1,154 @@
+package logs_collector_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+	appsv1 "k8s.io/api/apps/v1"
+	apiv1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"net"
+)
+
+var (
+	// The logs collector reads the logs from the nodes' files rather than receiving them from the user services, so
+	// it's not reachable through an enclave network IP like its Docker counterpart
+	noEnclaveNetworkIpAddress net.IP = nil
+	noBridgeNetworkIpAddress  net.IP = nil
+	noWait                    *port_spec.Wait
+)
+
+// Any of these values being nil indicates that the resource doesn't exist
+type logsCollectorKubernetesResources struct {
+	serviceAccount *apiv1.ServiceAccount
+
+	role *rbacv1.Role
+
+	roleBinding *rbacv1.RoleBinding
+
+	configMap *apiv1.ConfigMap
+
+	daemonSet *appsv1.DaemonSet
+}
+
+func getLogsCollectorMatchLabels() map[string]string {
+	return map[string]string{
+		label_key_consts.AppIDKubernetesLabelKey.GetString():                label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.LogsCollectorKurtosisResourceTypeKubernetesLabelValue.GetString(),
+	}
+}
+
+func getLogsCollectorKubernetesResources(
+	ctx context.Context,
+	enclaveNamespaceName string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*logsCollectorKubernetesResources, error) {
+	resources := &logsCollectorKubernetesResources{
+		serviceAccount: nil,
+		role:           nil,
+		roleBinding:    nil,
+		configMap:      nil,
+		daemonSet:      nil,
+	}
+
+	logsCollectorMatchLabels := getLogsCollectorMatchLabels()
+
+	serviceAccounts, err := kubernetesManager.GetServiceAccountsByLabels(ctx, enclaveNamespaceName, logsCollectorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector service accounts in namespace '%v'", enclaveNamespaceName)
+	}
+	if len(serviceAccounts.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs collector service account in namespace '%v'; this is a bug in Kurtosis", enclaveNamespaceName)
+	}
+	if len(serviceAccounts.Items) == 1 {
+		resources.serviceAccount = &serviceAccounts.Items[0]
+	}
+
+	roles, err := kubernetesManager.GetRolesByLabels(ctx, enclaveNamespaceName, logsCollectorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector roles in namespace '%v'", enclaveNamespaceName)
+	}
+	if len(roles.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs collector role in namespace '%v'; this is a bug in Kurtosis", enclaveNamespaceName)
+	}
+	if len(roles.Items) == 1 {
+		resources.role = &roles.Items[0]
+	}
+
+	roleBindings, err := kubernetesManager.GetRoleBindingsByLabels(ctx, enclaveNamespaceName, logsCollectorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector role bindings in namespace '%v'", enclaveNamespaceName)
+	}
+	if len(roleBindings.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs collector role binding in namespace '%v'; this is a bug in Kurtosis", enclaveNamespaceName)
+	}
+	if len(roleBindings.Items) == 1 {
+		resources.roleBinding = &roleBindings.Items[0]
+	}
+
+	configMaps, err := kubernetesManager.GetConfigMapsByLabels(ctx, enclaveNamespaceName, logsCollectorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector config maps in namespace '%v'", enclaveNamespaceName)
+	}
+	if len(configMaps.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs collector config map in namespace '%v'; this is a bug in Kurtosis", enclaveNamespaceName)
+	}
+	if len(configMaps.Items) == 1 {
+		resources.configMap = &configMaps.Items[0]
+	}
+
+	daemonSets, err := kubernetesManager.GetDaemonSetsByLabels(ctx, enclaveNamespaceName, logsCollectorMatchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the logs collector daemon sets in namespace '%v'", enclaveNamespaceName)
+	}
+	if len(daemonSets.Items) > 1 {
+		return nil, stacktrace.NewError("Found more than one logs collector daemon set in namespace '%v'; this is a bug in Kurtosis", enclaveNamespaceName)
+	}
+	if len(daemonSets.Items) == 1 {
+		resources.daemonSet = &daemonSets.Items[0]
+	}
+
+	return resources, nil
+}
+
+func getLogsCollectorObjectFromDaemonSet(daemonSet *appsv1.DaemonSet) (*logs_collector.LogsCollector, error) {
+	logsCollectorStatus := container_status.ContainerStatus_Stopped
+	if daemonSet.Status.DesiredNumberScheduled > 0 && daemonSet.Status.NumberReady == daemonSet.Status.DesiredNumberScheduled {
+		logsCollectorStatus = container_status.ContainerStatus_Running
+	}
+
+	var privateTcpPortSpec *port_spec.PortSpec
+	var privateHttpPortSpec *port_spec.PortSpec
+	for _, container := range daemonSet.Spec.Template.Spec.Containers {
+		for _, containerPort := range container.Ports {
+			portSpec, err := port_spec.NewPortSpec(uint16(containerPort.ContainerPort), port_spec.TransportProtocol_TCP, "", noWait)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred creating the logs collector port spec for container port '%v'", containerPort.Name)
+			}
+			switch containerPort.Name {
+			case logsCollectorTcpPortId:
+				privateTcpPortSpec = portSpec
+			case logsCollectorHttpPortId:
+				privateHttpPortSpec = portSpec
+			}
+		}
+	}
+	if privateTcpPortSpec == nil || privateHttpPortSpec == nil {
+		return nil, stacktrace.NewError("Expected the logs collector daemon set '%v' to declare both the '%v' and '%v' ports, but at least one is missing", daemonSet.Name, logsCollectorTcpPortId, logsCollectorHttpPortId)
+	}
+
+	logsCollectorObj := logs_collector.NewLogsCollector(
+		logsCollectorStatus,
+		noEnclaveNetworkIpAddress,
+		noBridgeNetworkIpAddress,
+		privateTcpPortSpec,
+		privateHttpPortSpec,
+	)
+
+	return logsCollectorObj, nil
+}
@@ -0,0 +1,19 @@
+package networking_sidecar_functions
+
+const (
+	// On Kubernetes the networking sidecar is an ephemeral container of the user service pod, which shares the
+	// network namespace of the pod
+	networkingSidecarContainerName = "kurtosis-networking-sidecar"
+
+	// This image ships the 'tc' and 'ip' binaries that are used to shape the traffic of the user service
+	networkingSidecarImageName = "kurtosistech/iproute2"
+
+	netAdminCapability = "NET_ADMIN"
+)
+
+// The sidecar does nothing on its own, it only needs to stay up so that commands can be executed in it
+var networkingSidecarEntrypointArgs = []string{
+	"tail",
+	"-f",
+	"/dev/null",
+}
@@ -0,0 +1,241 @@
+package networking_sidecar_functions
+
+import (
+	"bytes"
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/concurrent_writer"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/operation_parallelizer"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	"reflect"
+)
+
+// CreateNetworkingSidecar adds an ephemeral container with the NET_ADMIN capability to the pod of the user service, so
+// that the traffic control rules of the pod can be changed
+func CreateNetworkingSidecar(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*networking_sidecar.NetworkingSidecar, error) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveUuid)
+	}
+
+	userServiceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	userServiceObjsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveUuid, userServiceFilters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service '%v'", serviceUuid)
+	}
+	userServiceObjAndResources, found := userServiceObjsAndResources[serviceUuid]
+	if !found {
+		return nil, stacktrace.NewError("No user service with UUID '%v' exists in enclave '%v'", serviceUuid, enclaveUuid)
+	}
+	userService := userServiceObjAndResources.Service
+	pod := userServiceObjAndResources.KubernetesResources.Pod
+	if userService == nil || pod == nil || userService.GetStatus() != container_status.ContainerStatus_Running {
+		return nil, stacktrace.NewError("A networking sidecar can't be attached to service '%v' because it isn't running", serviceUuid)
+	}
+
+	networkingSidecarContainer := apiv1.EphemeralContainer{
+		EphemeralContainerCommon: apiv1.EphemeralContainerCommon{
+			Name:                     networkingSidecarContainerName,
+			Image:                    networkingSidecarImageName,
+			Command:                  networkingSidecarEntrypointArgs,
+			Args:                     nil,
+			WorkingDir:               "",
+			Ports:                    nil,
+			EnvFrom:                  nil,
+			Env:                      nil,
+			Resources:                apiv1.ResourceRequirements{Limits: nil, Requests: nil, Claims: nil},
+			ResizePolicy:             nil,
+			VolumeMounts:             nil,
+			VolumeDevices:            nil,
+			LivenessProbe:            nil,
+			ReadinessProbe:           nil,
+			StartupProbe:             nil,
+			Lifecycle:                nil,
+			TerminationMessagePath:   "",
+			TerminationMessagePolicy: "",
+			ImagePullPolicy:          "",
+			SecurityContext: &apiv1.SecurityContext{
+				Capabilities: &apiv1.Capabilities{
+					Add:  []apiv1.Capability{netAdminCapability},
+					Drop: nil,
+				},
+				Privileged:               nil,
+				SELinuxOptions:           nil,
+				WindowsOptions:           nil,
+				RunAsUser:                nil,
+				RunAsGroup:               nil,
+				RunAsNonRoot:             nil,
+				ReadOnlyRootFilesystem:   nil,
+				AllowPrivilegeEscalation: nil,
+				ProcMount:                nil,
+				SeccompProfile:           nil,
+			},
+			Stdin:     false,
+			StdinOnce: false,
+			TTY:       false,
+		},
+		TargetContainerName: "",
+	}
+	if _, err := kubernetesManager.AddEphemeralContainerToPod(ctx, namespaceName, pod.Name, networkingSidecarContainer); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the networking sidecar to pod '%v' of service '%v'", pod.Name, serviceUuid)
+	}
+	return networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, container_status.ContainerStatus_Running), nil
+}
+
+func GetNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	networkingSidecars, _, err := getMatchingNetworkingSidecarObjsAndPods(ctx, enclaveUuid, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecars of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	return networkingSidecars, nil
+}
+
+func RunNetworkingSidecarExecCommands(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	networkingSidecarCommands map[service.ServiceUUID][]string,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (
+	map[service.ServiceUUID]*exec_result.ExecResult,
+	map[service.ServiceUUID]error,
+	error,
+) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveUuid)
+	}
+
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range networkingSidecarCommands {
+		serviceUuids[serviceUuid] = true
+	}
+	filters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: serviceUuids,
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	_, networkingSidecarPods, err := getMatchingNetworkingSidecarObjsAndPods(ctx, enclaveUuid, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecars matching filters '%+v'", filters)
+	}
+
+	successfulExecs := map[service.ServiceUUID]*exec_result.ExecResult{}
+	failedExecs := map[service.ServiceUUID]error{}
+	execOperations := map[operation_parallelizer.OperationID]operation_parallelizer.Operation{}
+	for serviceUuid, command := range networkingSidecarCommands {
+		pod, found := networkingSidecarPods[serviceUuid]
+		if !found {
+			failedExecs[serviceUuid] = stacktrace.NewError(
+				"Cannot execute command '%+v' in the networking sidecar of service '%v' because no running networking sidecar was found for it",
+				command,
+				serviceUuid,
+			)
+			continue
+		}
+		execOperations[operation_parallelizer.OperationID(serviceUuid)] = createExecOperation(ctx, namespaceName, serviceUuid, pod, command, kubernetesManager)
+	}
+
+	successfulOperations, failedOperations := operation_parallelizer.RunOperationsInParallel(execOperations)
+	for operationId, operationResult := range successfulOperations {
+		serviceUuid := service.ServiceUUID(operationId)
+		execResult, ok := operationResult.(*exec_result.ExecResult)
+		if !ok {
+			return nil, nil, stacktrace.NewError("An error occurred processing the result of the exec command "+
+				"run in the networking sidecar of service '%s'. It seems the result object is of an unexpected type ('%v'). "+
+				"This is a Kurtosis internal bug.", serviceUuid, reflect.TypeOf(operationResult))
+		}
+		successfulExecs[serviceUuid] = execResult
+	}
+	for operationId, err := range failedOperations {
+		failedExecs[service.ServiceUUID(operationId)] = err
+	}
+	return successfulExecs, failedExecs, nil
+}
+
+// DestroyNetworkingSidecars doesn't remove anything, as Kubernetes doesn't allow removing the ephemeral containers of a
+// pod. The networking sidecars go away along with the pod of their user service
+func DestroyNetworkingSidecars(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	networkingSidecars, _, err := getMatchingNetworkingSidecarObjsAndPods(ctx, enclaveUuid, filters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the networking sidecars of enclave '%v' matching filters '%+v'", enclaveUuid, filters)
+	}
+	successfulServiceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range networkingSidecars {
+		successfulServiceUuids[serviceUuid] = true
+	}
+	return successfulServiceUuids, map[service.ServiceUUID]error{}, nil
+}
+
+func createExecOperation(
+	ctx context.Context,
+	namespaceName string,
+	serviceUuid service.ServiceUUID,
+	pod *apiv1.Pod,
+	command []string,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) operation_parallelizer.Operation {
+	return func() (interface{}, error) {
+		outputBuffer := &bytes.Buffer{}
+		concurrentBuffer := concurrent_writer.NewConcurrentWriter(outputBuffer)
+		exitCode, err := kubernetesManager.RunExecCommandWithContext(
+			ctx,
+			namespaceName,
+			pod.Name,
+			networkingSidecarContainerName,
+			command,
+			concurrentBuffer,
+			concurrentBuffer,
+		)
+		if err != nil {
+			return nil, stacktrace.Propagate(
+				err,
+				"An error occurred executing command '%+v' in the networking sidecar of Kubernetes pod '%v' for service '%v'",
+				command,
+				pod.Name,
+				serviceUuid,
+			)
+		}
+		return exec_result.NewExecResult(exitCode, outputBuffer.String()), nil
+	}
+}
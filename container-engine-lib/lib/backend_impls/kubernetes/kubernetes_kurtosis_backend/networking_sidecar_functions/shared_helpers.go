@@ -0,0 +1,73 @@
+package networking_sidecar_functions
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+)
+
+// getMatchingNetworkingSidecarObjsAndPods returns the networking sidecars matching the filters, along with the pod of
+// the user service they're attached to, keyed by the UUID of the user service
+func getMatchingNetworkingSidecarObjsAndPods(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	filters *networking_sidecar.NetworkingSidecarFilters,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, map[service.ServiceUUID]*apiv1.Pod, error) {
+	userServiceFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	if filters != nil {
+		userServiceFilters.UUIDs = filters.UserServiceUUIDs
+	}
+	userServiceObjsAndResources, err := shared_helpers.GetMatchingUserServiceObjectsAndKubernetesResources(ctx, enclaveUuid, userServiceFilters, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting the user services of enclave '%v'", enclaveUuid)
+	}
+
+	networkingSidecars := map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar{}
+	networkingSidecarPods := map[service.ServiceUUID]*apiv1.Pod{}
+	for serviceUuid, userServiceObjAndResources := range userServiceObjsAndResources {
+		pod := userServiceObjAndResources.KubernetesResources.Pod
+		if pod == nil {
+			continue
+		}
+		hasNetworkingSidecar := false
+		for _, ephemeralContainer := range pod.Spec.EphemeralContainers {
+			if ephemeralContainer.Name == networkingSidecarContainerName {
+				hasNetworkingSidecar = true
+				break
+			}
+		}
+		if !hasNetworkingSidecar {
+			continue
+		}
+
+		status := container_status.ContainerStatus_Stopped
+		for _, containerStatus := range pod.Status.EphemeralContainerStatuses {
+			if containerStatus.Name == networkingSidecarContainerName && containerStatus.State.Running != nil {
+				status = container_status.ContainerStatus_Running
+			}
+		}
+		if filters != nil && len(filters.Statuses) > 0 {
+			if _, found := filters.Statuses[status]; !found {
+				continue
+			}
+		}
+
+		networkingSidecars[serviceUuid] = networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveUuid, status)
+		networkingSidecarPods[serviceUuid] = pod
+	}
+	return networkingSidecars, networkingSidecarPods, nil
+}
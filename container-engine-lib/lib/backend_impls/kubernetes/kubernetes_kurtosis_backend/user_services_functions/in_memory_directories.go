@@ -0,0 +1,117 @@
+package user_services_functions
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sort"
+)
+
+const (
+	// Kubernetes has no container option for tmpfs mounts or the size of /dev/shm, so both are done with memory-backed
+	// emptyDir volumes
+	inMemoryDirectoryVolumeNameFmtStr   = "in-memory-directory-%d"
+	isInMemoryDirectoryVolumeReadOnly   = false
+	sharedMemoryDirpath                 = "/dev/shm"
+	unlimitedInMemoryDirectorySizeLimit = 0
+)
+
+// Functions required to mount the tmpfs directories and the resized /dev/shm of a service
+func prepareInMemoryDirectoriesResources(
+	tmpfsDirs map[string]uint64,
+	shmSizeMegabytes uint64,
+	userServiceContainerVolumeMounts []apiv1.VolumeMount,
+) (
+	resultPodVolumes []apiv1.Volume,
+	resultUserServiceContainerVolumeMounts []apiv1.VolumeMount,
+	resultErr error,
+) {
+	dirpathsToSizeMegabytes := map[string]uint64{}
+	for dirpath, sizeMegabytes := range tmpfsDirs {
+		dirpathsToSizeMegabytes[dirpath] = sizeMegabytes
+	}
+	if shmSizeMegabytes != 0 {
+		if _, found := dirpathsToSizeMegabytes[sharedMemoryDirpath]; found {
+			return nil, nil, stacktrace.NewError("Both a tmpfs directory at '%v' and a shm size were set; only one of them can be used", sharedMemoryDirpath)
+		}
+		dirpathsToSizeMegabytes[sharedMemoryDirpath] = shmSizeMegabytes
+	}
+
+	mountedDirpaths := map[string]bool{}
+	for _, volumeMount := range userServiceContainerVolumeMounts {
+		mountedDirpaths[volumeMount.MountPath] = true
+	}
+
+	// Sorted so the volume names don't change between runs of the same service
+	sortedDirpaths := []string{}
+	for dirpath := range dirpathsToSizeMegabytes {
+		if mountedDirpaths[dirpath] {
+			return nil, nil, stacktrace.NewError("Directory '%v' can't be an in-memory directory as files are already mounted on it", dirpath)
+		}
+		sortedDirpaths = append(sortedDirpaths, dirpath)
+	}
+	sort.Strings(sortedDirpaths)
+
+	podVolumes := []apiv1.Volume{}
+	volumeMountsOnUserServiceContainer := []apiv1.VolumeMount{}
+	for volumeIndex, dirpath := range sortedDirpaths {
+		volumeName := fmt.Sprintf(inMemoryDirectoryVolumeNameFmtStr, volumeIndex)
+
+		var sizeLimit *resource.Quantity
+		if sizeMegabytes := dirpathsToSizeMegabytes[dirpath]; sizeMegabytes != unlimitedInMemoryDirectorySizeLimit {
+			sizeLimit = resource.NewQuantity(int64(convertMegabytesToBytes(sizeMegabytes)), resource.DecimalSI)
+		}
+
+		podVolume := apiv1.Volume{
+			Name: volumeName,
+			VolumeSource: apiv1.VolumeSource{
+				HostPath: nil,
+				EmptyDir: &apiv1.EmptyDirVolumeSource{
+					Medium:    apiv1.StorageMediumMemory,
+					SizeLimit: sizeLimit,
+				},
+				GCEPersistentDisk:     nil,
+				AWSElasticBlockStore:  nil,
+				GitRepo:               nil,
+				Secret:                nil,
+				NFS:                   nil,
+				ISCSI:                 nil,
+				Glusterfs:             nil,
+				PersistentVolumeClaim: nil,
+				RBD:                   nil,
+				FlexVolume:            nil,
+				Cinder:                nil,
+				CephFS:                nil,
+				Flocker:               nil,
+				DownwardAPI:           nil,
+				FC:                    nil,
+				AzureFile:             nil,
+				ConfigMap:             nil,
+				VsphereVolume:         nil,
+				Quobyte:               nil,
+				AzureDisk:             nil,
+				PhotonPersistentDisk:  nil,
+				Projected:             nil,
+				PortworxVolume:        nil,
+				ScaleIO:               nil,
+				StorageOS:             nil,
+				CSI:                   nil,
+				Ephemeral:             nil,
+			},
+		}
+		podVolumes = append(podVolumes, podVolume)
+
+		userServiceContainerMount := apiv1.VolumeMount{
+			Name:             volumeName,
+			ReadOnly:         isInMemoryDirectoryVolumeReadOnly,
+			MountPath:        dirpath,
+			SubPath:          "",
+			MountPropagation: nil,
+			SubPathExpr:      "",
+		}
+		volumeMountsOnUserServiceContainer = append(volumeMountsOnUserServiceContainer, userServiceContainerMount)
+	}
+
+	return podVolumes, volumeMountsOnUserServiceContainer, nil
+}
@@ -0,0 +1,66 @@
+package user_services_functions
+
+import (
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	"testing"
+)
+
+const (
+	testTmpfsDirpath              = "/scratch"
+	testTmpfsSizeMegabytes        = uint64(64)
+	testShmSizeMegabytes          = uint64(256)
+	testFilesArtifactMountDirpath = "/data"
+)
+
+func TestPrepareInMemoryDirectoriesResources_TmpfsAndShm(t *testing.T) {
+	tmpfsDirs := map[string]uint64{
+		testTmpfsDirpath: testTmpfsSizeMegabytes,
+	}
+	podVolumes, volumeMounts, err := prepareInMemoryDirectoriesResources(tmpfsDirs, testShmSizeMegabytes, nil)
+	require.NoError(t, err)
+	require.Len(t, podVolumes, 2)
+	require.Len(t, volumeMounts, 2)
+
+	// Volumes are sorted by dirpath
+	require.Equal(t, sharedMemoryDirpath, volumeMounts[0].MountPath)
+	require.Equal(t, testTmpfsDirpath, volumeMounts[1].MountPath)
+	for volumeIndex, podVolume := range podVolumes {
+		require.Equal(t, volumeMounts[volumeIndex].Name, podVolume.Name)
+		require.Equal(t, apiv1.StorageMediumMemory, podVolume.EmptyDir.Medium)
+	}
+	require.Equal(t, int64(convertMegabytesToBytes(testShmSizeMegabytes)), podVolumes[0].EmptyDir.SizeLimit.Value())
+	require.Equal(t, int64(convertMegabytesToBytes(testTmpfsSizeMegabytes)), podVolumes[1].EmptyDir.SizeLimit.Value())
+}
+
+func TestPrepareInMemoryDirectoriesResources_UnlimitedTmpfsHasNoSizeLimit(t *testing.T) {
+	tmpfsDirs := map[string]uint64{
+		testTmpfsDirpath: unlimitedInMemoryDirectorySizeLimit,
+	}
+	podVolumes, _, err := prepareInMemoryDirectoriesResources(tmpfsDirs, 0, nil)
+	require.NoError(t, err)
+	require.Len(t, podVolumes, 1)
+	require.Nil(t, podVolumes[0].EmptyDir.SizeLimit)
+}
+
+func TestPrepareInMemoryDirectoriesResources_FailsOnAlreadyMountedDirpath(t *testing.T) {
+	tmpfsDirs := map[string]uint64{
+		testFilesArtifactMountDirpath: testTmpfsSizeMegabytes,
+	}
+	existingVolumeMounts := []apiv1.VolumeMount{
+		{ //nolint:exhaustruct
+			Name:      filesArtifactExpansionVolumeName,
+			MountPath: testFilesArtifactMountDirpath,
+		},
+	}
+	_, _, err := prepareInMemoryDirectoriesResources(tmpfsDirs, 0, existingVolumeMounts)
+	require.Error(t, err)
+}
+
+func TestPrepareInMemoryDirectoriesResources_FailsOnTmpfsAndShmSizeOnDevShm(t *testing.T) {
+	tmpfsDirs := map[string]uint64{
+		sharedMemoryDirpath: testTmpfsSizeMegabytes,
+	}
+	_, _, err := prepareInMemoryDirectoriesResources(tmpfsDirs, testShmSizeMegabytes, nil)
+	require.Error(t, err)
+}
@@ -1,20 +1,55 @@
 package user_services_functions
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_kurtosis_backend/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/kubernetes_manager"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/uuid_generator"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"io"
 	apiv1 "k8s.io/api/core/v1"
+	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
+)
+
+const (
+	persistentDirectorySeederInitContainerNamePrefix = "persistent-directory-seeder-"
+
+	persistentDirectoryReaderContainerImage = "alpine:3.17"
+	persistentDirectoryReaderPodNamePrefix  = "kurtosis-persistent-directory-reader-"
+	persistentDirectoryReaderContainerName  = "persistent-directory-reader"
+	persistentDirectoryReaderMountpoint     = "/persistent-directory"
+	persistentDirectoryReaderSleepSeconds   = 1800
+	// the reader pod doesn't need any Kubernetes API access
+	persistentDirectoryReaderServiceAccountName = ""
+
+	persistentDirectorySeededAnnotationValue = "true"
 )
 
 type kubernetesVolumeWithClaim struct {
 	VolumeName string
 
 	VolumeClaimName string
+
+	// True if the claim got created when preparing the resources, false if it already existed
+	IsNewlyCreated bool
+
+	// False if the volume got dynamically provisioned from a storage class, in which case it gets removed by Kubernetes
+	// along with its claim
+	HasStaticVolume bool
+
+	// True if an init container of the pod seeds the volume, in which case the claim gets marked as seeded once the pod
+	// is up, see markPersistentDirectoriesSeeded
+	IsSeeding bool
 }
 
 func (volumeAndClaim *kubernetesVolumeWithClaim) GetVolume() *apiv1.Volume {
@@ -68,24 +103,55 @@ func (volumeAndClaim *kubernetesVolumeWithClaim) GetVolumeMount(mountPath string
 	}
 }
 
+// preparePersistentDirectoriesResources gets or creates the volumes and claims of the persistent directories, and
+// returns the init containers seeding the ones that have a seed files artifact and weren't seeded yet
 func preparePersistentDirectoriesResources(
 	ctx context.Context,
 	namespace string,
 	serviceUuid service.ServiceUUID,
 	objAttributeProviders object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
-	serviceMountpointsToPersistentKey map[string]service_directory.DirectoryPersistentKey,
+	serviceMountpointsToPersistentDirectory map[string]service_directory.PersistentDirectory,
 	kubernetesManager *kubernetes_manager.KubernetesManager,
-) (map[string]*kubernetesVolumeWithClaim, error) {
+) (map[string]*kubernetesVolumeWithClaim, []apiv1.Container, error) {
 	shouldDeleteVolumesAndClaimsCreated := true
 	volumesCreated := map[string]*apiv1.PersistentVolume{}
 	volumeClaimsCreated := map[string]*apiv1.PersistentVolumeClaim{}
+	defer func() {
+		if !shouldDeleteVolumesAndClaimsCreated {
+			return
+		}
+		for volumeClaimNameStr := range volumeClaimsCreated {
+			// Background context so we still run this even if the input context was cancelled
+			if err := kubernetesManager.RemovePersistentVolumeClaim(context.Background(), namespace, volumeClaimNameStr); err != nil {
+				logrus.Warnf(
+					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume claim '%v' that we created, but doing so threw an error:\n%v",
+					volumeClaimNameStr,
+					err,
+				)
+				logrus.Warnf("You'll need to clean up volume claim '%v' manually!", volumeClaimNameStr)
+			}
+		}
+		for volumeNameStr := range volumesCreated {
+			// Background context so we still run this even if the input context was cancelled
+			if err := kubernetesManager.RemovePersistentVolume(context.Background(), volumeNameStr); err != nil {
+				logrus.Warnf(
+					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume '%v' that we created, but doing so threw an error:\n%v",
+					volumeNameStr,
+					err,
+				)
+				logrus.Warnf("You'll need to clean up volume '%v' manually!", volumeNameStr)
+			}
+		}
+	}()
 
 	persistentVolumesAndClaims := map[string]*kubernetesVolumeWithClaim{}
+	seederInitContainers := []apiv1.Container{}
 
-	for dirPath, persistentKey := range serviceMountpointsToPersistentKey {
+	for dirPath, persistentDirectory := range serviceMountpointsToPersistentDirectory {
+		persistentKey := persistentDirectory.PersistentKey
 		volumeAttrs, err := objAttributeProviders.ForSinglePersistentDirectoryVolume(serviceUuid, persistentKey)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred creating the labels for persist service directory '%s'", persistentKey)
+			return nil, nil, stacktrace.Propagate(err, "An error occurred creating the labels for persist service directory '%s'", persistentKey)
 		}
 
 		volumeName := volumeAttrs.GetName().GetString()
@@ -93,60 +159,301 @@ func preparePersistentDirectoriesResources(
 		for key, value := range volumeAttrs.GetLabels() {
 			volumeLabelsStrs[key.GetString()] = value.GetString()
 		}
+		requestedSize := int64(persistentDirectory.Size)
 
-		var persistentVolume *apiv1.PersistentVolume
-		if persistentVolume, err = kubernetesManager.GetPersistentVolume(ctx, volumeName); err != nil {
-			persistentVolume, err = kubernetesManager.CreatePersistentVolume(ctx, namespace, volumeName, volumeLabelsStrs)
-			if err != nil {
-				return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume for '%s'", persistentKey)
+		// With a storage class, the volume gets dynamically provisioned when the claim gets created. Otherwise, we
+		// create a host path volume for the claim to bind to
+		hasStaticVolume := persistentDirectory.StorageClass == ""
+		if hasStaticVolume {
+			if _, err = kubernetesManager.GetPersistentVolume(ctx, volumeName); err != nil {
+				persistentVolume, err := kubernetesManager.CreatePersistentVolume(ctx, namespace, volumeName, volumeLabelsStrs, requestedSize)
+				if err != nil {
+					return nil, nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume for '%s'", persistentKey)
+				}
+				volumesCreated[persistentVolume.Name] = persistentVolume
 			}
-			volumesCreated[persistentVolume.Name] = persistentVolume
 		}
 
 		// For now, we have a 1:1 mapping between volume and volume claims, so it's fine giving it the same name
+		isNewlyCreated := false
 		var persistentVolumeClaim *apiv1.PersistentVolumeClaim
 		if persistentVolumeClaim, err = kubernetesManager.GetPersistentVolumeClaim(ctx, namespace, volumeName); err != nil {
-			persistentVolumeClaim, err = kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeName, volumeLabelsStrs)
+			persistentVolumeClaim, err = kubernetesManager.CreatePersistentVolumeClaim(ctx, namespace, volumeName, volumeLabelsStrs, requestedSize, persistentDirectory.StorageClass)
 			if err != nil {
-				return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume claim for '%s'", persistentKey)
+				return nil, nil, stacktrace.Propagate(err, "An error occurred creating the persistent volume claim for '%s'", persistentKey)
 			}
 			volumeClaimsCreated[persistentVolumeClaim.Name] = persistentVolumeClaim
+			isNewlyCreated = true
 		}
 
-		persistentVolumesAndClaims[dirPath] = &kubernetesVolumeWithClaim{
-			VolumeName:      persistentVolume.Name,
+		volumeAndClaim := &kubernetesVolumeWithClaim{
+			VolumeName:      volumeName,
 			VolumeClaimName: persistentVolumeClaim.Name,
+			IsNewlyCreated:  isNewlyCreated,
+			HasStaticVolume: hasStaticVolume,
+			IsSeeding:       false,
 		}
+		persistentVolumesAndClaims[dirPath] = volumeAndClaim
+
+		seedFilesArtifactExpansion := persistentDirectory.SeedFilesArtifactExpansion
+		if seedFilesArtifactExpansion == nil || isPersistentDirectorySeeded(persistentVolumeClaim) {
+			continue
+		}
+		// The seed gets expanded straight into the volume. If Kubernetes re-runs the init containers of the pod later
+		// on, the expander skips the volume as it won't be empty anymore
+		volumeAndClaim.IsSeeding = true
+		volumeMountsOnSeeder := []apiv1.VolumeMount{}
+		for expanderDirpath := range seedFilesArtifactExpansion.ExpanderDirpathsToServiceDirpaths {
+			volumeMountsOnSeeder = append(volumeMountsOnSeeder, *volumeAndClaim.GetVolumeMount(expanderDirpath))
+		}
+		seederInitContainer := getFilesArtifactExpansionInitContainerSpecs(
+			seedFilesArtifactExpansion.ExpanderImage,
+			seedFilesArtifactExpansion.ExpanderEnvVars,
+			volumeMountsOnSeeder,
+		)
+		seederInitContainer.Name = fmt.Sprintf("%v%v", persistentDirectorySeederInitContainerNamePrefix, len(seederInitContainers))
+		seederInitContainers = append(seederInitContainers, seederInitContainer)
 	}
 
-	defer func() {
-		if !shouldDeleteVolumesAndClaimsCreated {
-			return
+	shouldDeleteVolumesAndClaimsCreated = false
+	return persistentVolumesAndClaims, seederInitContainers, nil
+}
+
+// markPersistentDirectoriesSeeded records on the claims that the init containers of the pod seeded their volumes, so
+// that the pods created for the service later on don't seed them again. It's kept on the claim rather than in the volume
+// so that the persistent directory only holds what the seed and the service put into it
+func markPersistentDirectoriesSeeded(
+	ctx context.Context,
+	namespace string,
+	volumesWithClaims map[string]*kubernetesVolumeWithClaim,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	seededAnnotations := map[string]string{
+		kubernetes_annotation_key_consts.PersistentDirectorySeededAnnotationKey.GetString(): persistentDirectorySeededAnnotationValue,
+	}
+	for _, volumeAndClaim := range volumesWithClaims {
+		if !volumeAndClaim.IsSeeding {
+			continue
 		}
-		for volumeClaimNameStr := range volumeClaimsCreated {
-			// Background context so we still run this even if the input context was cancelled
-			if err := kubernetesManager.RemovePersistentVolumeClaim(context.Background(), namespace, volumeClaimNameStr); err != nil {
-				logrus.Warnf(
-					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume claim '%v' that we created, but doing so threw an error:\n%v",
-					volumeClaimNameStr,
-					err,
-				)
-				logrus.Warnf("You'll need to clean up volume claim '%v' manually!", volumeClaimNameStr)
-			}
+		if _, err := kubernetesManager.UpdatePersistentVolumeClaim(ctx, namespace, volumeAndClaim.VolumeClaimName, func(configuration *applyconfigurationsv1.PersistentVolumeClaimApplyConfiguration) {
+			configuration.WithAnnotations(seededAnnotations)
+		}); err != nil {
+			return stacktrace.Propagate(err, "An error occurred marking persistent volume claim '%v' as seeded", volumeAndClaim.VolumeClaimName)
 		}
-		for volumeNameStr := range volumesCreated {
-			// Background context so we still run this even if the input context was cancelled
-			if err := kubernetesManager.RemovePersistentVolumeClaim(context.Background(), namespace, volumeNameStr); err != nil {
-				logrus.Warnf(
-					"Creating persistent directory volumes didn't complete successfully so we tried to delete volume '%v' that we created, but doing so threw an error:\n%v",
-					volumeNameStr,
-					err,
-				)
-				logrus.Warnf("You'll need to clean up volume '%v' manually!", volumeNameStr)
+	}
+	return nil
+}
+
+func isPersistentDirectorySeeded(volumeClaim *apiv1.PersistentVolumeClaim) bool {
+	_, found := volumeClaim.Annotations[kubernetes_annotation_key_consts.PersistentDirectorySeededAnnotationKey.GetString()]
+	return found
+}
+
+func GetPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveUuid)
+	}
+
+	volumeClaimSearchLabels := map[string]string{
+		label_key_consts.AppIDKubernetesLabelKey.GetString():              label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDKubernetesLabelKey.GetString():        string(enclaveUuid),
+		label_key_consts.KurtosisVolumeTypeKubernetesLabelKey.GetString(): label_value_consts.PersistentDirectoryVolumeTypeKubernetesLabelValue.GetString(),
+	}
+	volumeClaims, err := kubernetesManager.GetPersistentVolumeClaimsByLabels(ctx, namespaceName, volumeClaimSearchLabels)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directory volume claims of enclave '%v'", enclaveUuid)
+	}
+
+	result := map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool{}
+	for _, volumeClaim := range volumeClaims.Items {
+		serviceUuidStr, found := volumeClaim.Labels[label_key_consts.UserServiceGUIDKubernetesLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected persistent directory volume claim '%v' to have label '%v', but it didn't", volumeClaim.Name, label_key_consts.UserServiceGUIDKubernetesLabelKey.GetString())
+		}
+		persistentKeyStr, found := volumeClaim.Labels[label_key_consts.IDKubernetesLabelKey.GetString()]
+		if !found {
+			return nil, stacktrace.NewError("Expected persistent directory volume claim '%v' to have label '%v', but it didn't", volumeClaim.Name, label_key_consts.IDKubernetesLabelKey.GetString())
+		}
+		serviceUuid := service.ServiceUUID(serviceUuidStr)
+		if _, found := result[serviceUuid]; !found {
+			result[serviceUuid] = map[service_directory.DirectoryPersistentKey]bool{}
+		}
+		result[serviceUuid][service_directory.DirectoryPersistentKey(persistentKeyStr)] = true
+	}
+	return result, nil
+}
+
+// DestroyPersistentDirectories removes the claims of the persistent directories, along with their volumes. Kubernetes
+// only finalizes the removal of a claim once no pod uses it anymore
+func DestroyPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKeys map[service_directory.DirectoryPersistentKey]bool,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error) {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveUuid)
+	}
+	enclaveObjAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEnclave(enclaveUuid)
+
+	successfulPersistentKeys := map[service_directory.DirectoryPersistentKey]bool{}
+	erroredPersistentKeys := map[service_directory.DirectoryPersistentKey]error{}
+	for persistentKey := range persistentKeys {
+		volumeClaim, err := getExistingPersistentDirectoryVolumeClaim(ctx, namespaceName, serviceUuid, persistentKey, enclaveObjAttributesProvider, kubernetesManager)
+		if err != nil {
+			erroredPersistentKeys[persistentKey] = stacktrace.Propagate(err, "An error occurred getting the volume claim of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+			continue
+		}
+		if err := kubernetesManager.RemovePersistentVolumeClaim(ctx, namespaceName, volumeClaim.Name); err != nil {
+			erroredPersistentKeys[persistentKey] = stacktrace.Propagate(err, "An error occurred removing volume claim '%v' of persistent directory '%v' of service '%v'", volumeClaim.Name, persistentKey, serviceUuid)
+			continue
+		}
+		// volumes and claims have the same name, unless the volume got dynamically provisioned in which case Kubernetes removes it
+		if _, err := kubernetesManager.GetPersistentVolume(ctx, volumeClaim.Name); err == nil {
+			if err := kubernetesManager.RemovePersistentVolume(ctx, volumeClaim.Name); err != nil {
+				erroredPersistentKeys[persistentKey] = stacktrace.Propagate(err, "An error occurred removing volume '%v' of persistent directory '%v' of service '%v'", volumeClaim.Name, persistentKey, serviceUuid)
+				continue
 			}
 		}
+		successfulPersistentKeys[persistentKey] = true
+	}
+	return successfulPersistentKeys, erroredPersistentKeys, nil
+}
+
+// CopyFilesFromPersistentDirectory writes a TAR of the content of the persistent directory to the output. A short-lived
+// reader pod mounting the volume claim gets started for that, so the claim must be mountable by a new pod (e.g. with
+// the 'ReadWriteOnce' access mode, on the node of the service using it if there's one)
+func CopyFilesFromPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+	cliModeArgs *shared_helpers.CliModeArgs,
+	apiContainerModeArgs *shared_helpers.ApiContainerModeArgs,
+	engineServerModeArgs *shared_helpers.EngineServerModeArgs,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) error {
+	namespaceName, err := shared_helpers.GetEnclaveNamespaceName(ctx, enclaveUuid, cliModeArgs, apiContainerModeArgs, engineServerModeArgs, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting namespace name for enclave '%v'", enclaveUuid)
+	}
+	enclaveObjAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider().ForEnclave(enclaveUuid)
+
+	volumeClaim, err := getExistingPersistentDirectoryVolumeClaim(ctx, namespaceName, serviceUuid, persistentKey, enclaveObjAttributesProvider, kubernetesManager)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the volume claim of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	volumeAndClaim := &kubernetesVolumeWithClaim{
+		VolumeName:      volumeClaim.Name,
+		VolumeClaimName: volumeClaim.Name,
+		IsNewlyCreated:  false,
+		HasStaticVolume: false,
+		IsSeeding:       false,
+	}
+
+	readerPodUuid, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating a UUID for the persistent directory reader pod")
+	}
+	readerPodName := persistentDirectoryReaderPodNamePrefix + uuid_generator.ShortenedUUIDString(readerPodUuid)
+	readerPodLabels := map[string]string{
+		label_key_consts.AppIDKubernetesLabelKey.GetString():       label_value_consts.AppIDKubernetesLabelValue.GetString(),
+		label_key_consts.EnclaveUUIDKubernetesLabelKey.GetString(): string(enclaveUuid),
+	}
+	readerContainers := []apiv1.Container{
+		{ //nolint:exhaustruct
+			Name:         persistentDirectoryReaderContainerName,
+			Image:        persistentDirectoryReaderContainerImage,
+			Command:      []string{"sh", "-c", fmt.Sprintf("sleep %v", persistentDirectoryReaderSleepSeconds)},
+			VolumeMounts: []apiv1.VolumeMount{*volumeAndClaim.GetVolumeMount(persistentDirectoryReaderMountpoint)},
+		},
+	}
+	readerPod, err := kubernetesManager.CreatePod(
+		ctx,
+		namespaceName,
+		readerPodName,
+		readerPodLabels,
+		map[string]string{},
+		[]apiv1.Container{},
+		readerContainers,
+		[]apiv1.Volume{*volumeAndClaim.GetVolume()},
+		persistentDirectoryReaderServiceAccountName,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the reader pod of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	defer func() {
+		// Background context so the pod gets removed even if the input context was cancelled
+		if err := kubernetesManager.RemovePod(context.Background(), readerPod); err != nil {
+			logrus.Errorf("We tried to remove the persistent directory reader pod '%v' that we started, but doing so threw an error:\n%v", readerPodName, err)
+			logrus.Errorf("ACTION REQUIRED: You'll need to remove pod '%v' in '%v' manually!!!", readerPodName, namespaceName)
+		}
 	}()
 
-	shouldDeleteVolumesAndClaimsCreated = false
-	return persistentVolumesAndClaims, nil
+	commandToRun := []string{"tar", "cf", "-", "-C", persistentDirectoryReaderMountpoint, "."}
+	stdErrOutput := &bytes.Buffer{}
+	exitCode, err := kubernetesManager.RunExecCommand(
+		namespaceName,
+		readerPodName,
+		persistentDirectoryReaderContainerName,
+		commandToRun,
+		output,
+		stdErrOutput,
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running command '%v' on the reader pod of persistent directory '%v' of service '%v'", commandToRun, persistentKey, serviceUuid)
+	}
+	if exitCode != tarSuccessExitCode {
+		return stacktrace.NewError(
+			"Command '%v' exited with non-%v exit code %v and the following STDERR:\n%v",
+			commandToRun,
+			tarSuccessExitCode,
+			exitCode,
+			stdErrOutput.String(),
+		)
+	}
+	return nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func getExistingPersistentDirectoryVolumeClaim(
+	ctx context.Context,
+	namespaceName string,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	objAttributeProviders object_attributes_provider.KubernetesEnclaveObjectAttributesProvider,
+	kubernetesManager *kubernetes_manager.KubernetesManager,
+) (*apiv1.PersistentVolumeClaim, error) {
+	// the claim name is derived from the enclave UUID, service UUID and persistent key only, so it matches the existing claim's
+	volumeAttrs, err := objAttributeProviders.ForSinglePersistentDirectoryVolume(serviceUuid, persistentKey)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the labels for persist service directory '%s'", persistentKey)
+	}
+	volumeClaim, err := kubernetesManager.GetPersistentVolumeClaim(ctx, namespaceName, volumeAttrs.GetName().GetString())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "No persistent directory '%v' exists for service '%v'", persistentKey, serviceUuid)
+	}
+	return volumeClaim, nil
 }
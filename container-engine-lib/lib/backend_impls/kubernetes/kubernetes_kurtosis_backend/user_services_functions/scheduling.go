@@ -0,0 +1,134 @@
+package user_services_functions
+
+import (
+	"encoding/json"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sort"
+)
+
+const (
+	// Services (anti-)affine to each other get scheduled on the same node, or on different nodes
+	serviceAffinityTopologyKey = apiv1.LabelHostname
+)
+
+// enclaveSchedulingDefaults are the scheduling settings of the enclave applying to all its user services, which get
+// stored in the enclave namespace annotations when the enclave gets created
+type enclaveSchedulingDefaults struct {
+	nodeSelectors map[string]string
+
+	// Empty means the default service account of the namespace
+	serviceAccountName string
+}
+
+func getEnclaveSchedulingDefaults(namespace *apiv1.Namespace) (*enclaveSchedulingDefaults, error) {
+	namespaceAnnotations := namespace.GetAnnotations()
+
+	nodeSelectors := map[string]string{}
+	if serializedNodeSelectors, found := namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveDefaultNodeSelectorsAnnotationKey.GetString()]; found {
+		if err := json.Unmarshal([]byte(serializedNodeSelectors), &nodeSelectors); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred deserializing the default node selectors '%v' of namespace '%v'", serializedNodeSelectors, namespace.GetName())
+		}
+	}
+
+	return &enclaveSchedulingDefaults{
+		nodeSelectors:      nodeSelectors,
+		serviceAccountName: namespaceAnnotations[kubernetes_annotation_key_consts.EnclaveServiceAccountNameAnnotationKey.GetString()],
+	}, nil
+}
+
+// getUserServicePodNodeSelector merges the node selectors of the service into the default ones of the enclave, the
+// ones of the service winning on the same key
+func getUserServicePodNodeSelector(enclaveDefaultNodeSelectors map[string]string, serviceNodeSelectors map[string]string) map[string]string {
+	if len(enclaveDefaultNodeSelectors) == 0 && len(serviceNodeSelectors) == 0 {
+		return nil
+	}
+	podNodeSelector := map[string]string{}
+	for key, value := range enclaveDefaultNodeSelectors {
+		podNodeSelector[key] = value
+	}
+	for key, value := range serviceNodeSelectors {
+		podNodeSelector[key] = value
+	}
+	return podNodeSelector
+}
+
+func getUserServicePodTolerations(tolerations []service.Toleration) []apiv1.Toleration {
+	if len(tolerations) == 0 {
+		return nil
+	}
+	podTolerations := []apiv1.Toleration{}
+	for _, toleration := range tolerations {
+		podTolerations = append(podTolerations, apiv1.Toleration{
+			Key:               toleration.Key,
+			Operator:          apiv1.TolerationOperator(toleration.Operator),
+			Value:             toleration.Value,
+			Effect:            apiv1.TaintEffect(toleration.Effect),
+			TolerationSeconds: toleration.TolerationSeconds,
+		})
+	}
+	return podTolerations
+}
+
+// getUserServicePodAffinity requires the pod to be scheduled on the same node as the pods of the affine services, and
+// on a different node than the pods of the anti-affine ones
+// The pod affinity terms only match pods in the namespace of the pod, so only services of the same enclave
+func getUserServicePodAffinity(affinityServiceNames []service.ServiceName, antiAffinityServiceNames []service.ServiceName) *apiv1.Affinity {
+	if len(affinityServiceNames) == 0 && len(antiAffinityServiceNames) == 0 {
+		return nil
+	}
+
+	var podAffinity *apiv1.PodAffinity
+	if len(affinityServiceNames) > 0 {
+		podAffinity = &apiv1.PodAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  getServicePodAffinityTerms(affinityServiceNames),
+			PreferredDuringSchedulingIgnoredDuringExecution: nil,
+		}
+	}
+
+	var podAntiAffinity *apiv1.PodAntiAffinity
+	if len(antiAffinityServiceNames) > 0 {
+		podAntiAffinity = &apiv1.PodAntiAffinity{
+			RequiredDuringSchedulingIgnoredDuringExecution:  getServicePodAffinityTerms(antiAffinityServiceNames),
+			PreferredDuringSchedulingIgnoredDuringExecution: nil,
+		}
+	}
+
+	return &apiv1.Affinity{
+		NodeAffinity:    nil,
+		PodAffinity:     podAffinity,
+		PodAntiAffinity: podAntiAffinity,
+	}
+}
+
+// One term per service, as all the required terms must be satisfied
+func getServicePodAffinityTerms(serviceNames []service.ServiceName) []apiv1.PodAffinityTerm {
+	// Sorted so the pod spec doesn't change between runs of the same service
+	sortedServiceNameStrs := []string{}
+	for _, serviceName := range serviceNames {
+		sortedServiceNameStrs = append(sortedServiceNameStrs, string(serviceName))
+	}
+	sort.Strings(sortedServiceNameStrs)
+
+	podAffinityTerms := []apiv1.PodAffinityTerm{}
+	for _, serviceNameStr := range sortedServiceNameStrs {
+		podAffinityTerms = append(podAffinityTerms, apiv1.PodAffinityTerm{
+			LabelSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					label_key_consts.KurtosisResourceTypeKubernetesLabelKey.GetString(): label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue.GetString(),
+					label_key_consts.IDKubernetesLabelKey.GetString():                   serviceNameStr,
+				},
+				MatchExpressions: nil,
+			},
+			Namespaces:        nil,
+			TopologyKey:       serviceAffinityTopologyKey,
+			NamespaceSelector: nil,
+		})
+	}
+	return podAffinityTerms
+}
@@ -0,0 +1,114 @@
+package user_services_functions
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+	apiv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"testing"
+)
+
+const (
+	testNodeSelectorKey       = "pool"
+	testEnclaveNodeSelector   = "default"
+	testServiceNodeSelector   = "load-testing"
+	testOtherNodeSelectorKey  = "zone"
+	testOtherNodeSelector     = "zone-a"
+	testServiceAccountName    = "load-tester"
+	testTolerationKey         = "dedicated"
+	testTolerationValue       = "load-testing"
+	testAffineServiceName     = service.ServiceName("database")
+	testAntiAffineServiceName = service.ServiceName("other-node")
+)
+
+func TestGetEnclaveSchedulingDefaults(t *testing.T) {
+	namespace := &apiv1.Namespace{ //nolint:exhaustruct
+		ObjectMeta: metav1.ObjectMeta{ //nolint:exhaustruct
+			Name: "kt-test",
+			Annotations: map[string]string{
+				kubernetes_annotation_key_consts.EnclaveDefaultNodeSelectorsAnnotationKey.GetString(): `{"pool":"default"}`,
+				kubernetes_annotation_key_consts.EnclaveServiceAccountNameAnnotationKey.GetString():   testServiceAccountName,
+			},
+		},
+	}
+	schedulingDefaults, err := getEnclaveSchedulingDefaults(namespace)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{testNodeSelectorKey: testEnclaveNodeSelector}, schedulingDefaults.nodeSelectors)
+	require.Equal(t, testServiceAccountName, schedulingDefaults.serviceAccountName)
+}
+
+func TestGetEnclaveSchedulingDefaults_NoAnnotations(t *testing.T) {
+	namespace := &apiv1.Namespace{} //nolint:exhaustruct
+	schedulingDefaults, err := getEnclaveSchedulingDefaults(namespace)
+	require.NoError(t, err)
+	require.Empty(t, schedulingDefaults.nodeSelectors)
+	require.Empty(t, schedulingDefaults.serviceAccountName)
+}
+
+func TestGetUserServicePodNodeSelector_ServiceOverridesEnclaveDefaults(t *testing.T) {
+	enclaveDefaultNodeSelectors := map[string]string{
+		testNodeSelectorKey:      testEnclaveNodeSelector,
+		testOtherNodeSelectorKey: testOtherNodeSelector,
+	}
+	serviceNodeSelectors := map[string]string{
+		testNodeSelectorKey: testServiceNodeSelector,
+	}
+	expectedNodeSelector := map[string]string{
+		testNodeSelectorKey:      testServiceNodeSelector,
+		testOtherNodeSelectorKey: testOtherNodeSelector,
+	}
+	require.Equal(t, expectedNodeSelector, getUserServicePodNodeSelector(enclaveDefaultNodeSelectors, serviceNodeSelectors))
+	require.Nil(t, getUserServicePodNodeSelector(nil, map[string]string{}))
+}
+
+func TestGetUserServicePodTolerations(t *testing.T) {
+	tolerationSeconds := int64(30)
+	tolerations := []service.Toleration{
+		service.NewToleration(testTolerationKey, service.TolerationOperator_Equal, testTolerationValue, service.TolerationEffect_NoSchedule, nil),
+		service.NewToleration(testTolerationKey, service.TolerationOperator_Exists, "", service.TolerationEffect_NoExecute, &tolerationSeconds),
+	}
+	expectedPodTolerations := []apiv1.Toleration{
+		{
+			Key:               testTolerationKey,
+			Operator:          apiv1.TolerationOpEqual,
+			Value:             testTolerationValue,
+			Effect:            apiv1.TaintEffectNoSchedule,
+			TolerationSeconds: nil,
+		},
+		{
+			Key:               testTolerationKey,
+			Operator:          apiv1.TolerationOpExists,
+			Value:             "",
+			Effect:            apiv1.TaintEffectNoExecute,
+			TolerationSeconds: &tolerationSeconds,
+		},
+	}
+	require.Equal(t, expectedPodTolerations, getUserServicePodTolerations(tolerations))
+	require.Nil(t, getUserServicePodTolerations(nil))
+}
+
+func TestGetUserServicePodAffinity(t *testing.T) {
+	affinity := getUserServicePodAffinity([]service.ServiceName{testAffineServiceName}, []service.ServiceName{testAntiAffineServiceName})
+	require.NotNil(t, affinity)
+	require.Nil(t, affinity.NodeAffinity)
+
+	affinityTerms := affinity.PodAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	require.Len(t, affinityTerms, 1)
+	require.Equal(t, apiv1.LabelHostname, affinityTerms[0].TopologyKey)
+	require.Equal(t, string(testAffineServiceName), affinityTerms[0].LabelSelector.MatchLabels[label_key_consts.IDKubernetesLabelKey.GetString()])
+
+	antiAffinityTerms := affinity.PodAntiAffinity.RequiredDuringSchedulingIgnoredDuringExecution
+	require.Len(t, antiAffinityTerms, 1)
+	require.Equal(t, apiv1.LabelHostname, antiAffinityTerms[0].TopologyKey)
+	require.Equal(t, string(testAntiAffineServiceName), antiAffinityTerms[0].LabelSelector.MatchLabels[label_key_consts.IDKubernetesLabelKey.GetString()])
+}
+
+func TestGetUserServicePodAffinity_NoServices(t *testing.T) {
+	require.Nil(t, getUserServicePodAffinity(nil, nil))
+
+	affinity := getUserServicePodAffinity(nil, []service.ServiceName{testAntiAffineServiceName})
+	require.Nil(t, affinity.PodAffinity)
+	require.NotNil(t, affinity.PodAntiAffinity)
+}
@@ -22,13 +22,12 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	applyconfigurationsv1 "k8s.io/client-go/applyconfigurations/core/v1"
+	"sort"
 	"strings"
 )
 
 const (
 	userServiceContainerName = "user-service-container"
-	// Our user services don't need service accounts
-	userServiceServiceAccountName = ""
 
 	megabytesToBytesFactor = 1_000_000
 
@@ -40,6 +39,9 @@ const (
 	unboundPortNumber = 1
 
 	unlimitedReplacements = -1
+
+	// Kubernetes capability names don't have the prefix Linux uses
+	linuxCapabilityPrefix = "CAP_"
 )
 
 // Completeness enforced via unit test
@@ -298,6 +300,23 @@ func createStartServiceOperation(
 		privateIPAddrPlaceholder := serviceConfig.GetPrivateIPAddrPlaceholder()
 		minCpuAllocationMilliCpus := serviceConfig.GetMinCPUAllocationMillicpus()
 		minMemoryAllocationMegabytes := serviceConfig.GetMinMemoryAllocationMegabytes()
+		user := serviceConfig.GetUser()
+		userLabels := serviceConfig.GetLabels()
+		capabilities := serviceConfig.GetCapabilities()
+		sysctls := serviceConfig.GetSysctls()
+		ulimits := serviceConfig.GetUlimits()
+		tmpfsDirs := serviceConfig.GetTmpfsDirs()
+		shmSizeMegabytes := serviceConfig.GetShmSizeMegabytes()
+		nodeSelectors := serviceConfig.GetNodeSelectors()
+		tolerations := serviceConfig.GetTolerations()
+		affinityServiceNames := serviceConfig.GetAffinityServiceNames()
+		antiAffinityServiceNames := serviceConfig.GetAntiAffinityServiceNames()
+
+		// Kubernetes leaves the ulimits to the container runtime of each node, so there's no way to set them per pod. The
+		// Starlark validation already rejects them, this guards the other ways services get started
+		if len(ulimits) > 0 {
+			return nil, stacktrace.NewError("Service with UUID '%v' sets ulimits, which aren't supported on Kubernetes", serviceUuid)
+		}
 
 		matchingObjectAndResources, found := servicesObjectsAndResources[serviceUuid]
 		if !found {
@@ -321,12 +340,20 @@ func createStartServiceOperation(
 		serviceRegistrationObj := matchingObjectAndResources.ServiceRegistration
 		serviceName := serviceRegistrationObj.GetName()
 
+		namespace, err := kubernetesManager.GetNamespace(ctx, namespaceName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting namespace '%v' of service '%s'", namespaceName, serviceName)
+		}
+		enclaveSchedulingDefaults, err := getEnclaveSchedulingDefaults(namespace)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the scheduling defaults of the enclave from namespace '%v'", namespaceName)
+		}
+
 		objectAttributesProvider := object_attributes_provider.GetKubernetesObjectAttributesProvider()
 		enclaveObjAttributesProvider := objectAttributesProvider.ForEnclave(enclaveUuid)
 
 		var podInitContainers []apiv1.Container
 		var podVolumes []apiv1.Volume
-		var err error
 		var userServiceContainerVolumeMounts []apiv1.VolumeMount
 		if filesArtifactsExpansion != nil {
 			podVolumes, userServiceContainerVolumeMounts, podInitContainers, err = prepareFilesArtifactsExpansionResources(
@@ -342,12 +369,13 @@ func createStartServiceOperation(
 		shouldDestroyPersistentVolumesAndClaims := true
 		createVolumesWithClaims := map[string]*kubernetesVolumeWithClaim{}
 		if persistentDirectories != nil {
-			createVolumesWithClaims, err = preparePersistentDirectoriesResources(
+			var persistentDirectorySeederInitContainers []apiv1.Container
+			createVolumesWithClaims, persistentDirectorySeederInitContainers, err = preparePersistentDirectoriesResources(
 				ctx,
 				namespaceName,
 				serviceUuid,
 				enclaveObjAttributesProvider,
-				persistentDirectories.ServiceDirpathToDirectoryPersistentKey,
+				persistentDirectories.ServiceDirpathToPersistentDirectory,
 				kubernetesManager)
 			if err != nil {
 				return nil, stacktrace.Propagate(err, "An error occurred creating the persistent volumes and claims requested for service '%s'", serviceName)
@@ -356,19 +384,34 @@ func createStartServiceOperation(
 				podVolumes = append(podVolumes, *volumeAndClaim.GetVolume())
 				userServiceContainerVolumeMounts = append(userServiceContainerVolumeMounts, *volumeAndClaim.GetVolumeMount(serviceMountDirPath))
 			}
+			podInitContainers = append(podInitContainers, persistentDirectorySeederInitContainers...)
 		}
+
+		inMemoryDirectoryVolumes, inMemoryDirectoryVolumeMounts, err := prepareInMemoryDirectoriesResources(tmpfsDirs, shmSizeMegabytes, userServiceContainerVolumeMounts)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred creating the in-memory volumes requested for service '%s'", serviceName)
+		}
+		podVolumes = append(podVolumes, inMemoryDirectoryVolumes...)
+		userServiceContainerVolumeMounts = append(userServiceContainerVolumeMounts, inMemoryDirectoryVolumeMounts...)
 		defer func() {
 			if !shouldDestroyPersistentVolumesAndClaims {
 				return
 			}
+			// the persistent directories that already existed are left untouched
 			for _, volumeAndClaim := range createVolumesWithClaims {
+				if !volumeAndClaim.IsNewlyCreated {
+					continue
+				}
 				volumeClaimName := volumeAndClaim.VolumeClaimName
 				volumeName := volumeAndClaim.VolumeName
 				if err := kubernetesManager.RemovePersistentVolumeClaim(ctx, namespaceName, volumeClaimName); err != nil {
 					logrus.Errorf("Starting service didn't complete successfully so we tried to remove the persistent volume claim we created but doing so threw an error:\n%v", err)
 					logrus.Errorf("ACTION REQUIRED: You'll need to remove persistent volume claim '%v' in '%v' manually!!!", volumeClaimName, namespaceName)
 				}
-				if err := kubernetesManager.RemovePersistentVolume(ctx, volumeAndClaim.VolumeName); err != nil {
+				if !volumeAndClaim.HasStaticVolume {
+					continue
+				}
+				if err := kubernetesManager.RemovePersistentVolume(ctx, volumeName); err != nil {
 					logrus.Errorf("Starting service didn't complete successfully so we tried to remove the persistent volume we created but doing so threw an error:\n%v", err)
 					logrus.Errorf("ACTION REQUIRED: You'll need to remove persistent volume '%v' manually!!!", volumeName)
 				}
@@ -376,7 +419,7 @@ func createStartServiceOperation(
 		}()
 
 		// Create the pod
-		podAttributes, err := enclaveObjAttributesProvider.ForUserServicePod(serviceUuid, serviceName, privatePorts)
+		podAttributes, err := enclaveObjAttributesProvider.ForUserServicePod(serviceUuid, serviceName, privatePorts, userLabels)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred getting attributes for new pod for service with UUID '%v'", serviceUuid)
 		}
@@ -393,6 +436,7 @@ func createStartServiceOperation(
 			memoryAllocationMegabytes,
 			minCpuAllocationMilliCpus,
 			minMemoryAllocationMegabytes,
+			getUserServiceContainerSecurityContext(user, capabilities),
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating the container specs for the user service pod with image '%v'", containerImageName)
@@ -408,7 +452,11 @@ func createStartServiceOperation(
 			podInitContainers,
 			podContainers,
 			podVolumes,
-			userServiceServiceAccountName,
+			enclaveSchedulingDefaults.serviceAccountName,
+			getUserServicePodSecurityContext(sysctls),
+			getUserServicePodNodeSelector(enclaveSchedulingDefaults.nodeSelectors, nodeSelectors),
+			getUserServicePodTolerations(tolerations),
+			getUserServicePodAffinity(affinityServiceNames, antiAffinityServiceNames),
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred creating pod '%v' using image '%v'", podName, containerImageName)
@@ -424,6 +472,11 @@ func createStartServiceOperation(
 			}
 		}()
 
+		// The pod being up means its init containers, seeding the persistent directories, completed
+		if err := markPersistentDirectoriesSeeded(ctx, namespaceName, createVolumesWithClaims, kubernetesManager); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred marking the persistent directories of service '%s' as seeded", serviceName)
+		}
+
 		updatedService, undoServiceUpdateFunc, err := updateServiceWhenContainerStarted(ctx, namespaceName, kubernetesService, privatePorts, kubernetesManager)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "An error occurred updating service '%v' to reflect its new ports: %+v", kubernetesService.GetName(), privatePorts)
@@ -519,6 +572,9 @@ func updateServiceWhenContainerStarted(
 			}
 			specUpdateToApply.WithPorts(portUpdateToApply)
 		}
+		// The selector is applied again so that it isn't dropped if it was last set through an update, like when a
+		// stopped enclave gets started
+		specUpdateToApply.WithSelector(kubernetesService.Spec.Selector)
 		updatesToApply.WithSpec(specUpdateToApply)
 
 		updatesToApply.WithAnnotations(newAnnotations)
@@ -588,6 +644,7 @@ func getUserServicePodContainerSpecs(
 	memoryAllocationMegabytes uint64,
 	minCpuAllocationMilliCpus uint64,
 	minMemoryAllocationMegabytes uint64,
+	securityContext *apiv1.SecurityContext,
 ) ([]apiv1.Container, error) {
 
 	var containerEnvVars []apiv1.EnvVar
@@ -643,6 +700,8 @@ func getUserServicePodContainerSpecs(
 			VolumeMounts: containerMounts,
 			Resources:    resourceRequirements,
 
+			SecurityContext: securityContext,
+
 			// NOTE: There are a bunch of other interesting Container options that we omitted for now but might
 			// want to specify in the future
 		},
@@ -651,6 +710,56 @@ func getUserServicePodContainerSpecs(
 	return containers, nil
 }
 
+// getUserServiceContainerSecurityContext returns nil when the service doesn't set the user or capabilities, leaving the
+// image defaults
+func getUserServiceContainerSecurityContext(user *service.ServiceUser, capabilities []string) *apiv1.SecurityContext {
+	if user == nil && len(capabilities) == 0 {
+		return nil
+	}
+	securityContext := &apiv1.SecurityContext{} //nolint:exhaustruct
+	if user != nil {
+		runAsUser := int64(user.GetUID())
+		securityContext.RunAsUser = &runAsUser
+		if gid, found := user.GetGID(); found {
+			runAsGroup := int64(gid)
+			securityContext.RunAsGroup = &runAsGroup
+		}
+	}
+	if len(capabilities) > 0 {
+		addedCapabilities := []apiv1.Capability{}
+		for _, capability := range capabilities {
+			addedCapabilities = append(addedCapabilities, apiv1.Capability(strings.TrimPrefix(capability, linuxCapabilityPrefix)))
+		}
+		securityContext.Capabilities = &apiv1.Capabilities{
+			Add:  addedCapabilities,
+			Drop: nil,
+		}
+	}
+	return securityContext
+}
+
+// getUserServicePodSecurityContext returns nil when the service doesn't set sysctls, as they're the only pod level
+// setting of the service
+func getUserServicePodSecurityContext(sysctls map[string]string) *apiv1.PodSecurityContext {
+	if len(sysctls) == 0 {
+		return nil
+	}
+	podSysctls := []apiv1.Sysctl{}
+	for name, value := range sysctls {
+		podSysctls = append(podSysctls, apiv1.Sysctl{
+			Name:  name,
+			Value: value,
+		})
+	}
+	// Sorted so the pod spec doesn't change between runs of the same service
+	sort.Slice(podSysctls, func(i, j int) bool {
+		return podSysctls[i].Name < podSysctls[j].Name
+	})
+	return &apiv1.PodSecurityContext{ //nolint:exhaustruct
+		Sysctls: podSysctls,
+	}
+}
+
 func getKubernetesServicePortsFromPrivatePortSpecs(privatePorts map[string]*port_spec.PortSpec) ([]apiv1.ServicePort, error) {
 	result := []apiv1.ServicePort{}
 	for portId, portSpec := range privatePorts {
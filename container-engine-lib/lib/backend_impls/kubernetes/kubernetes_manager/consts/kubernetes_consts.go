@@ -23,6 +23,10 @@ const (
 	NodesKubernetesResource                  = "nodes"
 	PersistentVolumesKubernetesResource      = "persistentvolumes"
 	PersistentVolumeClaimsKubernetesResource = "persistentvolumeclaims"
+	ConfigMapsKubernetesResource             = "configmaps"
+	DaemonSetsKubernetesResource             = "daemonsets"
+	DeploymentsKubernetesResource            = "deployments"
+	StorageClassesKubernetesResource         = "storageclasses"
 
 	ClusterRoleKubernetesResourceType = "ClusterRole"
 	RoleKubernetesResourceType        = "Role"
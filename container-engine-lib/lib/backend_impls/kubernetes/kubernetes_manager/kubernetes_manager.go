@@ -16,8 +16,10 @@ import (
 	"github.com/sirupsen/logrus"
 	terminal "golang.org/x/term"
 	"io"
+	appsv1 "k8s.io/api/apps/v1"
 	apiv1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
@@ -44,6 +46,12 @@ const (
 	podWaitForTerminationTimeout           = 5 * time.Minute
 	podWaitForTerminationTimeBetweenPolls  = 500 * time.Millisecond
 
+	ephemeralContainerWaitForAvailabilityTimeout          = 5 * time.Minute
+	ephemeralContainerWaitForAvailabilityTimeBetweenPolls = 500 * time.Millisecond
+
+	workloadWaitForAvailabilityTimeout          = 5 * time.Minute
+	workloadWaitForAvailabilityTimeBetweenPolls = 500 * time.Millisecond
+
 	// This is a container "reason" (machine-readable string) indicating that the container has some issue with
 	// pulling the image (usually, a typo in the image name or the image doesn't exist)
 	// Pods in this state don't really recover on their own
@@ -67,13 +75,17 @@ const (
 	shouldFollowContainerLogsWhenPrintingPodInfo = false
 	shouldAddTimestampsWhenPrintingPodInfo       = true
 
+	// The annotations marking the default storage class of the cluster, the beta one still being used by some providers
+	defaultStorageClassAnnotationKey     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultStorageClassAnnotationKey = "storageclass.beta.kubernetes.io/is-default-class"
+	defaultStorageClassAnnotationValue   = "true"
+
 	listOptionsTimeoutSeconds      int64 = 10
 	contextDeadlineExceeded              = "context deadline exceeded"
 	expectedStatusMessageSliceSize       = 6
 
 	volumeHostPathRootDirectory = "/kurtosis-persistent-service-data"
-	// TODO: Maybe pipe this to Starlark to let users choose the size of their persistent directories
-	//  The difficulty is that Docker doesn't have such a feature, so we would need somehow to hack it
+	// Used when no size is requested for the persistent directory
 	persistentVolumeDefaultSize                          int64 = 500 * 1024 * 1024 // 500Mb
 	waitForPersistentVolumeBoundTimeout                        = 30 * time.Second
 	waitForPersistentVolumeBoundInitialDelayMilliSeconds       = 100
@@ -93,6 +105,7 @@ var commandToRunWhenCreatingUserServiceShell = []string{
 
 var (
 	volumeStorageClassName = "kurtosis-local-storage"
+	singleReplica          = int32(1)
 	globalDeletePolicy     = metav1.DeletePropagationForeground
 	globalDeleteOptions    = metav1.DeleteOptions{
 		TypeMeta: metav1.TypeMeta{
@@ -115,6 +128,15 @@ var (
 		FieldManager:    fieldManager,
 		FieldValidation: "",
 	}
+	globalUpdateOptions = metav1.UpdateOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		DryRun:          nil,
+		FieldManager:    fieldManager,
+		FieldValidation: "",
+	}
 	globalGetOptions = metav1.GetOptions{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
@@ -284,11 +306,13 @@ func (manager *KubernetesManager) GetServicesByLabels(ctx context.Context, names
 
 // ---------------------------Volumes------------------------------------------------------------------------------
 
+// CreatePersistentVolume creates a host path persistent volume of the given size in bytes; a zero size means the default size
 func (manager *KubernetesManager) CreatePersistentVolume(
 	ctx context.Context,
 	namespace string,
 	volumeName string,
 	labels map[string]string,
+	requestedSize int64,
 ) (*apiv1.PersistentVolume, error) {
 	volumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumes()
 
@@ -339,7 +363,7 @@ func (manager *KubernetesManager) CreatePersistentVolume(
 		},
 		Spec: apiv1.PersistentVolumeSpec{
 			Capacity: apiv1.ResourceList{
-				apiv1.ResourceStorage: *resource.NewQuantity(persistentVolumeDefaultSize, resource.BinarySI),
+				apiv1.ResourceStorage: *resource.NewQuantity(getPersistentVolumeSize(requestedSize), resource.BinarySI),
 			},
 			PersistentVolumeSource: apiv1.PersistentVolumeSource{
 				GCEPersistentDisk:    nil,
@@ -441,14 +465,137 @@ func (manager *KubernetesManager) GetPersistentVolumesByLabels(ctx context.Conte
 	return &persistentVolumesNotMarkedForDeletionserviceList, nil
 }
 
+// CreateNodePersistentVolume creates a host path persistent volume pinned to the node with the given hostname, so that
+// the pods using it always get scheduled on that node. Several volumes can share the same host path, and the data stays
+// on the node when the volume gets removed
+func (manager *KubernetesManager) CreateNodePersistentVolume(
+	ctx context.Context,
+	volumeName string,
+	labels map[string]string,
+	hostPath string,
+	nodeHostname string,
+) (*apiv1.PersistentVolume, error) {
+	volumesClient := manager.kubernetesClientSet.CoreV1().PersistentVolumes()
+
+	hostPathType := apiv1.HostPathDirectoryOrCreate
+	persistentVolumeDefinition := apiv1.PersistentVolume{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            volumeName,
+			GenerateName:    "",
+			Namespace:       "",
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Spec: apiv1.PersistentVolumeSpec{
+			Capacity: apiv1.ResourceList{
+				apiv1.ResourceStorage: *resource.NewQuantity(persistentVolumeDefaultSize, resource.BinarySI),
+			},
+			PersistentVolumeSource: apiv1.PersistentVolumeSource{
+				GCEPersistentDisk:    nil,
+				AWSElasticBlockStore: nil,
+				HostPath: &apiv1.HostPathVolumeSource{
+					Path: hostPath,
+					Type: &hostPathType,
+				},
+				Glusterfs:            nil,
+				NFS:                  nil,
+				RBD:                  nil,
+				ISCSI:                nil,
+				Cinder:               nil,
+				CephFS:               nil,
+				FC:                   nil,
+				Flocker:              nil,
+				FlexVolume:           nil,
+				AzureFile:            nil,
+				VsphereVolume:        nil,
+				Quobyte:              nil,
+				AzureDisk:            nil,
+				PhotonPersistentDisk: nil,
+				PortworxVolume:       nil,
+				ScaleIO:              nil,
+				Local:                nil,
+				StorageOS:            nil,
+				CSI:                  nil,
+			},
+			AccessModes: []apiv1.PersistentVolumeAccessMode{
+				apiv1.ReadWriteOnce,
+			},
+			ClaimRef: nil,
+			// the volume outlives its claim so that the node it's pinned to can be found again
+			PersistentVolumeReclaimPolicy: apiv1.PersistentVolumeReclaimRetain,
+			StorageClassName:              volumeStorageClassName,
+			MountOptions:                  nil,
+			VolumeMode:                    nil,
+			NodeAffinity: &apiv1.VolumeNodeAffinity{
+				Required: &apiv1.NodeSelector{
+					NodeSelectorTerms: []apiv1.NodeSelectorTerm{
+						{
+							MatchExpressions: []apiv1.NodeSelectorRequirement{
+								{
+									Key:      apiv1.LabelHostname,
+									Operator: apiv1.NodeSelectorOpIn,
+									Values:   []string{nodeHostname},
+								},
+							},
+							MatchFields: nil,
+						},
+					},
+				},
+			},
+		},
+		Status: apiv1.PersistentVolumeStatus{
+			Phase:   "",
+			Message: "",
+			Reason:  "",
+		},
+	}
+
+	volume, err := volumesClient.Create(ctx, &persistentVolumeDefinition, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create volume '%s' on node '%s'", volumeName, nodeHostname)
+	}
+	return volume, nil
+}
+
+// CreatePersistentVolumeClaim creates a claim of the given size in bytes, a zero size meaning the default size. If no
+// storage class is passed, the claim gets bound to the persistent volume with the same name, which must have been created
+// beforehand. Otherwise, the volume gets dynamically provisioned by the storage class
 func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 	ctx context.Context,
 	namespace string,
 	volumeClaimName string,
 	labels map[string]string,
+	requestedSize int64,
+	storageClassName string,
 ) (*apiv1.PersistentVolumeClaim, error) {
 	volumeClaimsClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
 
+	isDynamicallyProvisioned := storageClassName != ""
+	// volume and their respective claims have the same name right now
+	volumeName := volumeClaimName
+	if isDynamicallyProvisioned {
+		// the storage class provisions the volume, naming it as it wants
+		volumeName = ""
+	} else {
+		storageClassName = volumeStorageClassName
+	}
+
 	volumeClaimsDefinition := apiv1.PersistentVolumeClaim{
 		TypeMeta: metav1.TypeMeta{
 			Kind:       "",
@@ -483,12 +630,12 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 				Requests: apiv1.ResourceList{
 					// we give each claim 100% of the corresponding volume. Since we have a 1:1 mapping between volumes
 					// and claims right now, it's the best we can do
-					apiv1.ResourceStorage: *resource.NewQuantity(persistentVolumeDefaultSize, resource.BinarySI),
+					apiv1.ResourceStorage: *resource.NewQuantity(getPersistentVolumeSize(requestedSize), resource.BinarySI),
 				},
 				Claims: nil,
 			},
-			VolumeName:       volumeClaimName, // volume and their respective claims have the same name right now
-			StorageClassName: &volumeStorageClassName,
+			VolumeName:       volumeName,
+			StorageClassName: &storageClassName,
 			VolumeMode:       nil,
 			DataSource:       nil,
 			DataSourceRef:    nil,
@@ -508,6 +655,12 @@ func (manager *KubernetesManager) CreatePersistentVolumeClaim(
 		return nil, stacktrace.Propagate(err, "Failed to create volume claim '%s'", volumeClaimName)
 	}
 
+	// Storage classes may only provision the volume once a pod uses the claim (i.e. the 'WaitForFirstConsumer' binding
+	// mode) so we can't wait for the binding here
+	if isDynamicallyProvisioned {
+		return volumeClaim, nil
+	}
+
 	// Wait for the PVC to become bound and return that object (which will have VolumeName filled out)
 	boundVolumeClaim, err := manager.waitForPersistentVolumeClaimBinding(ctx, namespace, volumeClaim.Name)
 	if err != nil {
@@ -548,6 +701,62 @@ func (manager *KubernetesManager) GetPersistentVolumeClaim(
 	return volumeClaim, nil
 }
 
+func (manager *KubernetesManager) UpdatePersistentVolumeClaim(
+	ctx context.Context,
+	namespace string,
+	volumeClaimName string,
+	// We use a configurator, rather than letting the user pass in their own PersistentVolumeClaimApplyConfiguration, so
+	// that we ensure they use the constructor (and don't do struct instantiation and forget to add the namespace, object
+	// name, etc. which would result in removing the object name)
+	updateConfigurator func(configuration *applyconfigurationsv1.PersistentVolumeClaimApplyConfiguration),
+) (*apiv1.PersistentVolumeClaim, error) {
+	updatesToApply := applyconfigurationsv1.PersistentVolumeClaim(volumeClaimName, namespace)
+	updateConfigurator(updatesToApply)
+
+	volumeClaimsClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
+
+	applyOpts := metav1.ApplyOptions{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		DryRun:       nil,
+		Force:        false,
+		FieldManager: fieldManager,
+	}
+	result, err := volumeClaimsClient.Apply(ctx, updatesToApply, applyOpts)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to update persistent volume claim '%v' in namespace '%v'", volumeClaimName, namespace)
+	}
+	return result, nil
+}
+
+func (manager *KubernetesManager) GetPersistentVolumeClaimsByLabels(ctx context.Context, namespace string, persistentVolumeClaimLabels map[string]string) (*apiv1.PersistentVolumeClaimList, error) {
+	persistentVolumeClaimsClient := manager.kubernetesClientSet.CoreV1().PersistentVolumeClaims(namespace)
+
+	listOptions := buildListOptionsFromLabels(persistentVolumeClaimLabels)
+	persistentVolumeClaimsResult, err := persistentVolumeClaimsClient.List(ctx, listOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to list persistent volume claims with labels '%+v' in namespace '%s'", persistentVolumeClaimLabels, namespace)
+	}
+
+	// Only return objects not tombstoned by Kubernetes
+	var persistentVolumeClaimsNotMarkedForDeletionList []apiv1.PersistentVolumeClaim
+	for _, persistentVolumeClaim := range persistentVolumeClaimsResult.Items {
+		deletionTimestamp := persistentVolumeClaim.GetObjectMeta().GetDeletionTimestamp()
+		if deletionTimestamp == nil {
+			persistentVolumeClaimsNotMarkedForDeletionList = append(persistentVolumeClaimsNotMarkedForDeletionList, persistentVolumeClaim)
+		}
+	}
+	persistentVolumeClaimsNotMarkedForDeletionClaimList := apiv1.PersistentVolumeClaimList{
+		Items:    persistentVolumeClaimsNotMarkedForDeletionList,
+		TypeMeta: persistentVolumeClaimsResult.TypeMeta,
+		ListMeta: persistentVolumeClaimsResult.ListMeta,
+	}
+
+	return &persistentVolumeClaimsNotMarkedForDeletionClaimList, nil
+}
+
 // ---------------------------namespaces------------------------------------------------------------------------------
 
 func (manager *KubernetesManager) CreateNamespace(
@@ -1142,6 +1351,10 @@ func (manager *KubernetesManager) CreatePod(
 	podContainers []apiv1.Container,
 	podVolumes []apiv1.Volume,
 	podServiceAccountName string,
+	podSecurityContext *apiv1.PodSecurityContext,
+	podNodeSelector map[string]string,
+	podTolerations []apiv1.Toleration,
+	podAffinity *apiv1.Affinity,
 ) (*apiv1.Pod, error) {
 	podClient := manager.kubernetesClientSet.CoreV1().Pods(namespaceName)
 
@@ -1174,7 +1387,7 @@ func (manager *KubernetesManager) CreatePod(
 		TerminationGracePeriodSeconds: nil,
 		ActiveDeadlineSeconds:         nil,
 		DNSPolicy:                     "",
-		NodeSelector:                  nil,
+		NodeSelector:                  podNodeSelector,
 		ServiceAccountName:            podServiceAccountName,
 		DeprecatedServiceAccount:      "",
 		AutomountServiceAccountToken:  nil,
@@ -1183,13 +1396,13 @@ func (manager *KubernetesManager) CreatePod(
 		HostPID:                       false,
 		HostIPC:                       false,
 		ShareProcessNamespace:         nil,
-		SecurityContext:               nil,
+		SecurityContext:               podSecurityContext,
 		ImagePullSecrets:              nil,
 		Hostname:                      "",
 		Subdomain:                     "",
-		Affinity:                      nil,
+		Affinity:                      podAffinity,
 		SchedulerName:                 "",
-		Tolerations:                   nil,
+		Tolerations:                   podTolerations,
 		HostAliases:                   nil,
 		PriorityClassName:             "",
 		Priority:                      nil,
@@ -1288,6 +1501,71 @@ func (manager *KubernetesManager) GetPod(ctx context.Context, namespace string,
 	return pod, nil
 }
 
+// AddEphemeralContainerToPod adds the ephemeral container to the pod, if the pod doesn't already have an ephemeral
+// container with this name, and waits for it to be running
+// NOTE: Kubernetes doesn't allow removing ephemeral containers, they live as long as the pod does
+func (manager *KubernetesManager) AddEphemeralContainerToPod(
+	ctx context.Context,
+	namespaceName string,
+	podName string,
+	ephemeralContainer apiv1.EphemeralContainer,
+) (*apiv1.Pod, error) {
+	pod, err := manager.GetPod(ctx, namespaceName, podName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting pod '%v' to add ephemeral container '%v' to it", podName, ephemeralContainer.Name)
+	}
+
+	isEphemeralContainerAlreadyAdded := false
+	for _, existingEphemeralContainer := range pod.Spec.EphemeralContainers {
+		if existingEphemeralContainer.Name == ephemeralContainer.Name {
+			isEphemeralContainerAlreadyAdded = true
+			break
+		}
+	}
+	if !isEphemeralContainerAlreadyAdded {
+		pod.Spec.EphemeralContainers = append(pod.Spec.EphemeralContainers, ephemeralContainer)
+		podClient := manager.kubernetesClientSet.CoreV1().Pods(namespaceName)
+		if _, err := podClient.UpdateEphemeralContainers(ctx, podName, pod, globalUpdateOptions); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred adding ephemeral container '%v' to pod '%v'", ephemeralContainer.Name, podName)
+		}
+	}
+
+	deadline := time.Now().Add(ephemeralContainerWaitForAvailabilityTimeout)
+	for time.Now().Before(deadline) {
+		pod, err = manager.GetPod(ctx, namespaceName, podName)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting pod '%v' while waiting for ephemeral container '%v' to start", podName, ephemeralContainer.Name)
+		}
+		for _, containerStatus := range pod.Status.EphemeralContainerStatuses {
+			if containerStatus.Name != ephemeralContainer.Name {
+				continue
+			}
+			if containerStatus.State.Running != nil {
+				return pod, nil
+			}
+			if containerStatus.State.Terminated != nil {
+				return nil, stacktrace.NewError(
+					"Ephemeral container '%v' of pod '%v' terminated with reason '%v' before it became available",
+					ephemeralContainer.Name,
+					podName,
+					containerStatus.State.Terminated.Reason,
+				)
+			}
+			if containerStatus.State.Waiting != nil && containerStatus.State.Waiting.Reason == imagePullBackOffContainerReason {
+				return nil, stacktrace.NewError(
+					"Ephemeral container '%v' of pod '%v' is stuck in state '%v' pulling image '%v'",
+					ephemeralContainer.Name,
+					podName,
+					imagePullBackOffContainerReason,
+					containerStatus.Image,
+				)
+			}
+		}
+		time.Sleep(ephemeralContainerWaitForAvailabilityTimeBetweenPolls)
+	}
+	return nil, stacktrace.NewError("Ephemeral container '%v' of pod '%v' did not become available after %v", ephemeralContainer.Name, podName, ephemeralContainerWaitForAvailabilityTimeout)
+}
+
 // GetContainerLogs gets the logs for a given container running inside the given pod in the give namespace
 // TODO We could upgrade this to get the logs of many containers at once just like kubectl does, see:
 //
@@ -1729,6 +2007,329 @@ func (manager *KubernetesManager) GetExecStream(ctx context.Context, pod *apiv1.
 		})
 }
 
+// ---------------------------nodes------------------------------------------------------------------------------------
+
+func (manager *KubernetesManager) GetNodes(ctx context.Context) (*apiv1.NodeList, error) {
+	nodesClient := manager.kubernetesClientSet.CoreV1().Nodes()
+	noLabels := map[string]string{}
+	nodes, err := nodesClient.List(ctx, buildListOptionsFromLabels(noLabels))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to list the nodes of the cluster")
+	}
+	return nodes, nil
+}
+
+// ---------------------------storage classes--------------------------------------------------------------------------
+
+// GetDefaultStorageClassName returns the name of the storage class provisioning the volumes of the claims not asking for
+// a specific one, an empty name meaning the cluster has no default storage class
+func (manager *KubernetesManager) GetDefaultStorageClassName(ctx context.Context) (string, error) {
+	storageClassesClient := manager.kubernetesClientSet.StorageV1().StorageClasses()
+	noLabels := map[string]string{}
+	storageClasses, err := storageClassesClient.List(ctx, buildListOptionsFromLabels(noLabels))
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Failed to list the storage classes of the cluster")
+	}
+	for _, storageClass := range storageClasses.Items {
+		if isDefaultStorageClass(storageClass) {
+			return storageClass.GetName(), nil
+		}
+	}
+	return "", nil
+}
+
+// ---------------------------config maps------------------------------------------------------------------------------
+
+func (manager *KubernetesManager) CreateConfigMap(
+	ctx context.Context,
+	namespace string,
+	name string,
+	labels map[string]string,
+	data map[string]string,
+) (*apiv1.ConfigMap, error) {
+	configMapsClient := manager.kubernetesClientSet.CoreV1().ConfigMaps(namespace)
+
+	configMapDefinition := &apiv1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			GenerateName:    "",
+			Namespace:       namespace,
+			SelfLink:        "",
+			UID:             "",
+			ResourceVersion: "",
+			Generation:      0,
+			CreationTimestamp: metav1.Time{
+				Time: time.Time{},
+			},
+			DeletionTimestamp:          nil,
+			DeletionGracePeriodSeconds: nil,
+			Labels:                     labels,
+			Annotations:                nil,
+			OwnerReferences:            nil,
+			Finalizers:                 nil,
+			ManagedFields:              nil,
+		},
+		Immutable:  nil,
+		Data:       data,
+		BinaryData: nil,
+	}
+
+	configMap, err := configMapsClient.Create(ctx, configMapDefinition, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create config map '%s' in namespace '%s'", name, namespace)
+	}
+	return configMap, nil
+}
+
+func (manager *KubernetesManager) GetConfigMapsByLabels(ctx context.Context, namespace string, configMapLabels map[string]string) (*apiv1.ConfigMapList, error) {
+	configMapsClient := manager.kubernetesClientSet.CoreV1().ConfigMaps(namespace)
+
+	listOptions := buildListOptionsFromLabels(configMapLabels)
+	configMaps, err := configMapsClient.List(ctx, listOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to list config maps with labels '%+v' in namespace '%s'", configMapLabels, namespace)
+	}
+
+	// Only return objects not tombstoned by Kubernetes
+	var configMapsNotMarkedForDeletionList []apiv1.ConfigMap
+	for _, configMap := range configMaps.Items {
+		deletionTimestamp := configMap.GetObjectMeta().GetDeletionTimestamp()
+		if deletionTimestamp == nil {
+			configMapsNotMarkedForDeletionList = append(configMapsNotMarkedForDeletionList, configMap)
+		}
+	}
+	configMapsNotMarkedForDeletion := apiv1.ConfigMapList{
+		Items:    configMapsNotMarkedForDeletionList,
+		TypeMeta: configMaps.TypeMeta,
+		ListMeta: configMaps.ListMeta,
+	}
+	return &configMapsNotMarkedForDeletion, nil
+}
+
+func (manager *KubernetesManager) RemoveConfigMap(ctx context.Context, namespace string, name string) error {
+	configMapsClient := manager.kubernetesClientSet.CoreV1().ConfigMaps(namespace)
+	if err := configMapsClient.Delete(ctx, name, globalDeleteOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the config map '%s' in namespace '%s'", name, namespace)
+	}
+	return nil
+}
+
+// ---------------------------daemon sets------------------------------------------------------------------------------
+
+func (manager *KubernetesManager) CreateDaemonSet(
+	ctx context.Context,
+	namespace string,
+	name string,
+	labels map[string]string,
+	podLabels map[string]string,
+	containers []apiv1.Container,
+	volumes []apiv1.Volume,
+	serviceAccountName string,
+) (*appsv1.DaemonSet, error) {
+	daemonSetsClient := manager.kubernetesClientSet.AppsV1().DaemonSets(namespace)
+
+	daemonSetDefinition := &appsv1.DaemonSet{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: getObjectMetaForWorkload(namespace, name, labels),
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels:      podLabels,
+				MatchExpressions: nil,
+			},
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: getObjectMetaForWorkload(namespace, "", podLabels),
+				Spec:       getPodSpecForWorkload(containers, volumes, serviceAccountName, nil),
+			},
+			UpdateStrategy: appsv1.DaemonSetUpdateStrategy{
+				Type:          "",
+				RollingUpdate: nil,
+			},
+			MinReadySeconds:      0,
+			RevisionHistoryLimit: nil,
+		},
+		Status: appsv1.DaemonSetStatus{
+			CurrentNumberScheduled: 0,
+			NumberMisscheduled:     0,
+			DesiredNumberScheduled: 0,
+			NumberReady:            0,
+			ObservedGeneration:     0,
+			UpdatedNumberScheduled: 0,
+			NumberAvailable:        0,
+			NumberUnavailable:      0,
+			CollisionCount:         nil,
+			Conditions:             nil,
+		},
+	}
+
+	if daemonSetDefinitionBytes, err := json.Marshal(daemonSetDefinition); err == nil {
+		logrus.Debugf("Going to start daemon set using the following JSON: %v", string(daemonSetDefinitionBytes))
+	}
+
+	daemonSet, err := daemonSetsClient.Create(ctx, daemonSetDefinition, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create daemon set '%s' in namespace '%s'", name, namespace)
+	}
+
+	availableDaemonSet, err := manager.waitForDaemonSetAvailability(ctx, namespace, daemonSet.Name)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for daemon set '%v' to become available", name)
+	}
+
+	return availableDaemonSet, nil
+}
+
+func (manager *KubernetesManager) GetDaemonSetsByLabels(ctx context.Context, namespace string, daemonSetLabels map[string]string) (*appsv1.DaemonSetList, error) {
+	daemonSetsClient := manager.kubernetesClientSet.AppsV1().DaemonSets(namespace)
+
+	listOptions := buildListOptionsFromLabels(daemonSetLabels)
+	daemonSets, err := daemonSetsClient.List(ctx, listOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to list daemon sets with labels '%+v' in namespace '%s'", daemonSetLabels, namespace)
+	}
+
+	// Only return objects not tombstoned by Kubernetes
+	var daemonSetsNotMarkedForDeletionList []appsv1.DaemonSet
+	for _, daemonSet := range daemonSets.Items {
+		deletionTimestamp := daemonSet.GetObjectMeta().GetDeletionTimestamp()
+		if deletionTimestamp == nil {
+			daemonSetsNotMarkedForDeletionList = append(daemonSetsNotMarkedForDeletionList, daemonSet)
+		}
+	}
+	daemonSetsNotMarkedForDeletionDaemonSetList := appsv1.DaemonSetList{
+		Items:    daemonSetsNotMarkedForDeletionList,
+		TypeMeta: daemonSets.TypeMeta,
+		ListMeta: daemonSets.ListMeta,
+	}
+	return &daemonSetsNotMarkedForDeletionDaemonSetList, nil
+}
+
+func (manager *KubernetesManager) RemoveDaemonSet(ctx context.Context, daemonSet *appsv1.DaemonSet) error {
+	daemonSetsClient := manager.kubernetesClientSet.AppsV1().DaemonSets(daemonSet.Namespace)
+	if err := daemonSetsClient.Delete(ctx, daemonSet.Name, globalDeleteOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing daemon set '%s' in namespace '%s'", daemonSet.Name, daemonSet.Namespace)
+	}
+	return nil
+}
+
+// ---------------------------deployments------------------------------------------------------------------------------
+
+func (manager *KubernetesManager) CreateDeployment(
+	ctx context.Context,
+	namespace string,
+	name string,
+	labels map[string]string,
+	podLabels map[string]string,
+	containers []apiv1.Container,
+	volumes []apiv1.Volume,
+	affinity *apiv1.Affinity,
+) (*appsv1.Deployment, error) {
+	deploymentsClient := manager.kubernetesClientSet.AppsV1().Deployments(namespace)
+
+	deploymentDefinition := &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "",
+			APIVersion: "",
+		},
+		ObjectMeta: getObjectMetaForWorkload(namespace, name, labels),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: &singleReplica,
+			Selector: &metav1.LabelSelector{
+				MatchLabels:      podLabels,
+				MatchExpressions: nil,
+			},
+			Template: apiv1.PodTemplateSpec{
+				ObjectMeta: getObjectMetaForWorkload(namespace, "", podLabels),
+				Spec:       getPodSpecForWorkload(containers, volumes, "", affinity),
+			},
+			// There's only one replica writing to the node's disk, so we don't want two of them running side by side
+			Strategy: appsv1.DeploymentStrategy{
+				Type:          appsv1.RecreateDeploymentStrategyType,
+				RollingUpdate: nil,
+			},
+			MinReadySeconds:         0,
+			RevisionHistoryLimit:    nil,
+			Paused:                  false,
+			ProgressDeadlineSeconds: nil,
+		},
+		Status: appsv1.DeploymentStatus{
+			ObservedGeneration:  0,
+			Replicas:            0,
+			UpdatedReplicas:     0,
+			ReadyReplicas:       0,
+			AvailableReplicas:   0,
+			UnavailableReplicas: 0,
+			Conditions:          nil,
+			CollisionCount:      nil,
+		},
+	}
+
+	if deploymentDefinitionBytes, err := json.Marshal(deploymentDefinition); err == nil {
+		logrus.Debugf("Going to start deployment using the following JSON: %v", string(deploymentDefinitionBytes))
+	}
+
+	deployment, err := deploymentsClient.Create(ctx, deploymentDefinition, globalCreateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create deployment '%s' in namespace '%s'", name, namespace)
+	}
+
+	availableDeployment, err := manager.waitForDeploymentAvailability(ctx, namespace, deployment.Name)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred waiting for deployment '%v' to become available", name)
+	}
+
+	return availableDeployment, nil
+}
+
+func (manager *KubernetesManager) GetDeploymentsByLabels(ctx context.Context, namespace string, deploymentLabels map[string]string) (*appsv1.DeploymentList, error) {
+	deploymentsClient := manager.kubernetesClientSet.AppsV1().Deployments(namespace)
+
+	listOptions := buildListOptionsFromLabels(deploymentLabels)
+	deployments, err := deploymentsClient.List(ctx, listOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to list deployments with labels '%+v' in namespace '%s'", deploymentLabels, namespace)
+	}
+
+	// Only return objects not tombstoned by Kubernetes
+	var deploymentsNotMarkedForDeletionList []appsv1.Deployment
+	for _, deployment := range deployments.Items {
+		deletionTimestamp := deployment.GetObjectMeta().GetDeletionTimestamp()
+		if deletionTimestamp == nil {
+			deploymentsNotMarkedForDeletionList = append(deploymentsNotMarkedForDeletionList, deployment)
+		}
+	}
+	deploymentsNotMarkedForDeletionDeploymentList := appsv1.DeploymentList{
+		Items:    deploymentsNotMarkedForDeletionList,
+		TypeMeta: deployments.TypeMeta,
+		ListMeta: deployments.ListMeta,
+	}
+	return &deploymentsNotMarkedForDeletionDeploymentList, nil
+}
+
+// UpdateDeployment replaces the spec of the deployment, rolling out new pods if the pod template changed
+func (manager *KubernetesManager) UpdateDeployment(ctx context.Context, deployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+	deploymentsClient := manager.kubernetesClientSet.AppsV1().Deployments(deployment.Namespace)
+	updatedDeployment, err := deploymentsClient.Update(ctx, deployment, globalUpdateOptions)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating deployment '%s' in namespace '%s'", deployment.Name, deployment.Namespace)
+	}
+	return updatedDeployment, nil
+}
+
+func (manager *KubernetesManager) RemoveDeployment(ctx context.Context, deployment *appsv1.Deployment) error {
+	deploymentsClient := manager.kubernetesClientSet.AppsV1().Deployments(deployment.Namespace)
+	if err := deploymentsClient.Delete(ctx, deployment.Name, globalDeleteOptions); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing deployment '%s' in namespace '%s'", deployment.Name, deployment.Namespace)
+	}
+	return nil
+}
+
 // TODO Delete this after 2022-08-01 if we're not using Jobs
 /*
 func (manager *KubernetesManager) CreateJobWithContainerAndVolume(ctx context.Context,
@@ -2029,6 +2630,128 @@ func (manager *KubernetesManager) waitForPodTermination(ctx context.Context, nam
 	)
 }
 
+func (manager *KubernetesManager) waitForDaemonSetAvailability(ctx context.Context, namespaceName string, daemonSetName string) (*appsv1.DaemonSet, error) {
+	daemonSetsClient := manager.kubernetesClientSet.AppsV1().DaemonSets(namespaceName)
+	deadline := time.Now().Add(workloadWaitForAvailabilityTimeout)
+	var latestDaemonSetStatus *appsv1.DaemonSetStatus
+	for time.Now().Before(deadline) {
+		daemonSet, err := daemonSetsClient.Get(ctx, daemonSetName, globalGetOptions)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting daemon set '%v' in namespace '%v'", daemonSetName, namespaceName)
+		}
+		latestDaemonSetStatus = &daemonSet.Status
+		// A daemon set is available once every node it should run on has a ready pod
+		if latestDaemonSetStatus.DesiredNumberScheduled > 0 && latestDaemonSetStatus.NumberReady == latestDaemonSetStatus.DesiredNumberScheduled {
+			return daemonSet, nil
+		}
+		time.Sleep(workloadWaitForAvailabilityTimeBetweenPolls)
+	}
+
+	return nil, stacktrace.NewError(
+		"Daemon set '%v' did not become available after %v; it has '%v' ready pods out of the '%v' desired",
+		daemonSetName,
+		workloadWaitForAvailabilityTimeout,
+		latestDaemonSetStatus.NumberReady,
+		latestDaemonSetStatus.DesiredNumberScheduled,
+	)
+}
+
+func (manager *KubernetesManager) waitForDeploymentAvailability(ctx context.Context, namespaceName string, deploymentName string) (*appsv1.Deployment, error) {
+	deploymentsClient := manager.kubernetesClientSet.AppsV1().Deployments(namespaceName)
+	deadline := time.Now().Add(workloadWaitForAvailabilityTimeout)
+	var latestDeploymentStatus *appsv1.DeploymentStatus
+	for time.Now().Before(deadline) {
+		deployment, err := deploymentsClient.Get(ctx, deploymentName, globalGetOptions)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting deployment '%v' in namespace '%v'", deploymentName, namespaceName)
+		}
+		latestDeploymentStatus = &deployment.Status
+		if latestDeploymentStatus.ReadyReplicas >= singleReplica {
+			return deployment, nil
+		}
+		time.Sleep(workloadWaitForAvailabilityTimeBetweenPolls)
+	}
+
+	return nil, stacktrace.NewError(
+		"Deployment '%v' did not become available after %v; it has '%v' ready replicas",
+		deploymentName,
+		workloadWaitForAvailabilityTimeout,
+		latestDeploymentStatus.ReadyReplicas,
+	)
+}
+
+func getObjectMetaForWorkload(namespace string, name string, labels map[string]string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:            name,
+		GenerateName:    "",
+		Namespace:       namespace,
+		SelfLink:        "",
+		UID:             "",
+		ResourceVersion: "",
+		Generation:      0,
+		CreationTimestamp: metav1.Time{
+			Time: time.Time{},
+		},
+		DeletionTimestamp:          nil,
+		DeletionGracePeriodSeconds: nil,
+		Labels:                     labels,
+		Annotations:                nil,
+		OwnerReferences:            nil,
+		Finalizers:                 nil,
+		ManagedFields:              nil,
+	}
+}
+
+// Pods managed by daemon sets and deployments must always be restarted by Kubernetes, unlike the pods Kurtosis creates directly
+func getPodSpecForWorkload(
+	containers []apiv1.Container,
+	volumes []apiv1.Volume,
+	serviceAccountName string,
+	affinity *apiv1.Affinity,
+) apiv1.PodSpec {
+	return apiv1.PodSpec{
+		Volumes:                       volumes,
+		InitContainers:                nil,
+		Containers:                    containers,
+		EphemeralContainers:           nil,
+		RestartPolicy:                 apiv1.RestartPolicyAlways,
+		TerminationGracePeriodSeconds: nil,
+		ActiveDeadlineSeconds:         nil,
+		DNSPolicy:                     "",
+		NodeSelector:                  nil,
+		ServiceAccountName:            serviceAccountName,
+		DeprecatedServiceAccount:      "",
+		AutomountServiceAccountToken:  nil,
+		NodeName:                      "",
+		HostNetwork:                   false,
+		HostPID:                       false,
+		HostIPC:                       false,
+		ShareProcessNamespace:         nil,
+		SecurityContext:               nil,
+		ImagePullSecrets:              nil,
+		Hostname:                      "",
+		Subdomain:                     "",
+		Affinity:                      affinity,
+		SchedulerName:                 "",
+		Tolerations:                   nil,
+		HostAliases:                   nil,
+		PriorityClassName:             "",
+		Priority:                      nil,
+		DNSConfig:                     nil,
+		ReadinessGates:                nil,
+		RuntimeClassName:              nil,
+		EnableServiceLinks:            nil,
+		PreemptionPolicy:              nil,
+		Overhead:                      nil,
+		TopologySpreadConstraints:     nil,
+		SetHostnameAsFQDN:             nil,
+		OS:                            nil,
+		HostUsers:                     nil,
+		SchedulingGates:               nil,
+		ResourceClaims:                nil,
+	}
+}
+
 func (manager *KubernetesManager) getPodInfoBlockStr(
 	ctx context.Context,
 	namespaceName string,
@@ -2169,6 +2892,19 @@ func getExitCodeFromStatusMessage(statusMessage string) (int32, error) {
 	return codeAsInt32, nil
 }
 
+func isDefaultStorageClass(storageClass storagev1.StorageClass) bool {
+	storageClassAnnotations := storageClass.GetAnnotations()
+	return storageClassAnnotations[defaultStorageClassAnnotationKey] == defaultStorageClassAnnotationValue ||
+		storageClassAnnotations[betaDefaultStorageClassAnnotationKey] == defaultStorageClassAnnotationValue
+}
+
+func getPersistentVolumeSize(requestedSize int64) int64 {
+	if requestedSize == 0 {
+		return persistentVolumeDefaultSize
+	}
+	return requestedSize
+}
+
 func buildListOptionsFromLabels(labelsMap map[string]string) metav1.ListOptions {
 	return metav1.ListOptions{
 		TypeMeta: metav1.TypeMeta{
@@ -29,7 +29,9 @@ type KubernetesApiContainerObjectAttributesProvider interface {
 		privateGrpcPortId string,
 		privateGrpcPortSpec *port_spec.PortSpec,
 		privateGrpcProxyPortId string,
-		privateGrpcProxyPortSpec *port_spec.PortSpec) (KubernetesObjectAttributes, error)
+		privateGrpcProxyPortSpec *port_spec.PortSpec,
+		imageVersionTag string,
+		enclaveEnvVarsHash string) (KubernetesObjectAttributes, error)
 	ForApiContainerServiceAccount() (KubernetesObjectAttributes, error)
 	ForApiContainerRole() (KubernetesObjectAttributes, error)
 	ForApiContainerClusterRole() (KubernetesObjectAttributes, error)
@@ -75,12 +77,30 @@ func (provider *kubernetesApiContainerObjectAttributesProviderImpl) ForApiContai
 	grpcPortSpec *port_spec.PortSpec,
 	grpcProxyPortId string,
 	grpcProxyPortSpec *port_spec.PortSpec,
+	imageVersionTag string,
+	enclaveEnvVarsHash string,
 ) (KubernetesObjectAttributes, error) {
 	labels, err := provider.getLabelsForApiContainerObject()
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "Failed to get labels for API container object in enclave with ID '%v'", provider.enclaveId)
 	}
 
+	// The version and the env vars hash are recorded on the service because an API container is defined by its service
+	// Images without tag have no version to record
+	if imageVersionTag != "" {
+		imageVersionTagLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(imageVersionTag)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "Failed to create Kubernetes label value from API container image version tag '%v'", imageVersionTag)
+		}
+		labels[label_key_consts.APIContainerImageVersionTagKubernetesLabelKey] = imageVersionTagLabelValue
+	}
+
+	enclaveEnvVarsHashLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(enclaveEnvVarsHash)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create Kubernetes label value from API container enclave env vars hash '%v'", enclaveEnvVarsHash)
+	}
+	labels[label_key_consts.APIContainerEnclaveEnvVarsHashKubernetesLabelKey] = enclaveEnvVarsHashLabelValue
+
 	usedPorts := map[string]*port_spec.PortSpec{
 		grpcPortId: grpcPortSpec,
 	}
@@ -3,6 +3,7 @@ package object_attributes_provider
 import (
 	"crypto/md5"
 	"encoding/hex"
+	"encoding/json"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_value"
@@ -17,6 +18,8 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"strings"
 	"time"
 )
 
@@ -24,10 +27,16 @@ const (
 	namespacePrefix = "kt"
 
 	persistentServiceDirectoryNameFragment = "service-persistent-directory"
+
+	logsCollectorName = "kurtosis-logs-collector"
 )
 
 type KubernetesEnclaveObjectAttributesProvider interface {
-	ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error)
+	ForEnclaveNamespace(
+		creationTime time.Time,
+		enclaveName string,
+		kubernetesSettings *enclave.KubernetesSettings,
+	) (KubernetesObjectAttributes, error)
 	ForApiContainer() KubernetesApiContainerObjectAttributesProvider
 	ForUserServiceService(
 		uuid service.ServiceUUID,
@@ -37,11 +46,13 @@ type KubernetesEnclaveObjectAttributesProvider interface {
 		uuid service.ServiceUUID,
 		id service.ServiceName,
 		privatePorts map[string]*port_spec.PortSpec,
+		userLabels map[string]string,
 	) (KubernetesObjectAttributes, error)
 	ForSinglePersistentDirectoryVolume(
 		serviceUUID service.ServiceUUID,
 		persistentKey service_directory.DirectoryPersistentKey,
 	) (KubernetesObjectAttributes, error)
+	ForLogsCollector() (KubernetesObjectAttributes, error)
 }
 
 // Private so it can't be instantiated
@@ -62,7 +73,11 @@ func GetKubernetesEnclaveObjectAttributesProvider(enclaveId enclave.EnclaveUUID)
 	return newKubernetesEnclaveObjectAttributesProviderImpl(enclaveId)
 }
 
-func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespace(creationTime time.Time, enclaveName string) (KubernetesObjectAttributes, error) {
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespace(
+	creationTime time.Time,
+	enclaveName string,
+	kubernetesSettings *enclave.KubernetesSettings,
+) (KubernetesObjectAttributes, error) {
 	// TODO: might need to revert this if we have multiple users on the same cluster (what if two people create enclaves with name test?)
 	name, err := getCompositeKubernetesObjectName([]string{
 		namespacePrefix,
@@ -98,6 +113,18 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForEnclaveNamespa
 		kubernetes_annotation_key_consts.EnclaveNameAnnotationKey:         enclaveNameAnnotationValue,
 	}
 
+	if kubernetesSettings != nil {
+		if err := addUserLabels(labels, kubernetesSettings.GetNamespaceLabels()); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred adding the labels set by the user to the enclave namespace labels")
+		}
+		if err := addUserAnnotations(customAnnotations, kubernetesSettings.GetNamespaceAnnotations()); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred adding the annotations set by the user to the enclave namespace annotations")
+		}
+		if err := addEnclaveSchedulingAnnotations(customAnnotations, kubernetesSettings); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred adding the enclave scheduling annotations to the enclave namespace annotations")
+		}
+	}
+
 	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, customAnnotations)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -151,6 +178,7 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServicePod
 	serviceUUID service.ServiceUUID,
 	serviceName service.ServiceName,
 	privatePorts map[string]*port_spec.PortSpec,
+	userLabels map[string]string,
 ) (KubernetesObjectAttributes, error) {
 	name, err := getKubernetesObjectName(serviceName)
 	if err != nil {
@@ -172,6 +200,9 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForUserServicePod
 		)
 	}
 	labels[label_key_consts.KurtosisResourceTypeKubernetesLabelKey] = label_value_consts.UserServiceKurtosisResourceTypeKubernetesLabelValue
+	if err := addUserLabels(labels, userLabels); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding the labels set by the user to the user service pod labels")
+	}
 
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{
 		kubernetes_annotation_key_consts.PortSpecsKubernetesAnnotationKey: serializedPortSpecsAnnotationValue,
@@ -201,6 +232,12 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersiste
 			persistentKeyHash,
 		)
 	}
+	serviceUuidLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(string(serviceUUID))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes label value from service UUID string '%v'", serviceUUID)
+	}
+	labels[label_key_consts.UserServiceGUIDKubernetesLabelKey] = serviceUuidLabelValue
+	labels[label_key_consts.KurtosisVolumeTypeKubernetesLabelKey] = label_value_consts.PersistentDirectoryVolumeTypeKubernetesLabelValue
 
 	//No userServiceService annotations.
 	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
@@ -217,6 +254,31 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForSinglePersiste
 	return objectAttributes, nil
 }
 
+// The same attributes are used for every Kubernetes object that makes up the logs collector (daemon set, config map,
+// service account, role and role binding) because there's only one of each per enclave
+func (provider *kubernetesEnclaveObjectAttributesProviderImpl) ForLogsCollector() (KubernetesObjectAttributes, error) {
+	name, err := kubernetes_object_name.CreateNewKubernetesObjectName(logsCollectorName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes object name from string '%v'", logsCollectorName)
+	}
+
+	labels, err := provider.getLabelsForEnclaveObject()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to get labels for the logs collector of enclave '%v'", provider.enclaveId)
+	}
+	labels[label_key_consts.KurtosisResourceTypeKubernetesLabelKey] = label_value_consts.LogsCollectorKurtosisResourceTypeKubernetesLabelValue
+
+	// No custom annotations for the logs collector
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "Failed to create logs collector object attributes")
+	}
+
+	return objectAttributes, nil
+}
+
 // ====================================================================================================
 //
 //	Private Helper Functions
@@ -280,6 +342,87 @@ func (provider *kubernetesEnclaveObjectAttributesProviderImpl) getLabelsForEncla
 	return labels, nil
 }
 
+// addUserLabels adds the labels set by the user, which can't override the ones Kurtosis uses to track its objects
+func addUserLabels(labels map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue, userLabels map[string]string) error {
+	kurtosisLabelKeyStrs := getLabelKeyValuesAsStrings(labels)
+	for userLabelKeyStr, userLabelValueStr := range userLabels {
+		if _, found := kurtosisLabelKeyStrs[userLabelKeyStr]; found || label_key_consts.IsKurtosisLabelKey(userLabelKeyStr) {
+			return stacktrace.NewError("Label key '%v' is reserved for the labels Kurtosis puts on its objects", userLabelKeyStr)
+		}
+		userLabelKey, err := kubernetes_label_key.CreateNewKubernetesLabelKey(userLabelKeyStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a Kubernetes label key from label key string '%v'", userLabelKeyStr)
+		}
+		userLabelValue, err := kubernetes_label_value.CreateNewKubernetesLabelValue(userLabelValueStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a Kubernetes label value from label value string '%v'", userLabelValueStr)
+		}
+		labels[userLabelKey] = userLabelValue
+	}
+	return nil
+}
+
+// addUserAnnotations adds the annotations set by the user, which can't override the ones Kurtosis uses
+func addUserAnnotations(
+	annotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue,
+	userAnnotations map[string]string,
+) error {
+	for userAnnotationKeyStr, userAnnotationValueStr := range userAnnotations {
+		if kubernetes_annotation_key_consts.IsKurtosisAnnotationKey(userAnnotationKeyStr) {
+			return stacktrace.NewError("Annotation key '%v' is reserved for the annotations Kurtosis puts on its objects", userAnnotationKeyStr)
+		}
+		userAnnotationKey, err := kubernetes_annotation_key.CreateNewKubernetesAnnotationKey(userAnnotationKeyStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a Kubernetes annotation key from annotation key string '%v'", userAnnotationKeyStr)
+		}
+		userAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(userAnnotationValueStr)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a Kubernetes annotation value from annotation value string '%v'", userAnnotationValueStr)
+		}
+		annotations[userAnnotationKey] = userAnnotationValue
+	}
+	return nil
+}
+
+// addEnclaveSchedulingAnnotations stores the defaults the user services of the enclave get scheduled with in the
+// namespace, so they can be read when the services get started by the API container
+func addEnclaveSchedulingAnnotations(
+	annotations map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue,
+	kubernetesSettings *enclave.KubernetesSettings,
+) error {
+	if defaultNodeSelectors := kubernetesSettings.GetDefaultNodeSelectors(); len(defaultNodeSelectors) > 0 {
+		for nodeSelectorKeyStr, nodeSelectorValueStr := range defaultNodeSelectors {
+			if _, err := kubernetes_label_key.CreateNewKubernetesLabelKey(nodeSelectorKeyStr); err != nil {
+				return stacktrace.Propagate(err, "Default node selector key '%v' isn't a valid Kubernetes label key", nodeSelectorKeyStr)
+			}
+			if _, err := kubernetes_label_value.CreateNewKubernetesLabelValue(nodeSelectorValueStr); err != nil {
+				return stacktrace.Propagate(err, "Default node selector value '%v' isn't a valid Kubernetes label value", nodeSelectorValueStr)
+			}
+		}
+		serializedDefaultNodeSelectors, err := json.Marshal(defaultNodeSelectors)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred serializing default node selectors '%+v'", defaultNodeSelectors)
+		}
+		defaultNodeSelectorsAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(string(serializedDefaultNodeSelectors))
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating Kubernetes annotation value from string '%v'", string(serializedDefaultNodeSelectors))
+		}
+		annotations[kubernetes_annotation_key_consts.EnclaveDefaultNodeSelectorsAnnotationKey] = defaultNodeSelectorsAnnotationValue
+	}
+
+	if serviceAccountName := kubernetesSettings.GetServiceAccountName(); serviceAccountName != "" {
+		if validationErrs := validation.IsDNS1123Subdomain(serviceAccountName); len(validationErrs) > 0 {
+			return stacktrace.NewError("Service account name '%v' isn't a valid Kubernetes object name:\n%v", serviceAccountName, strings.Join(validationErrs, "\n"))
+		}
+		serviceAccountNameAnnotationValue, err := kubernetes_annotation_value.CreateNewKubernetesAnnotationValue(serviceAccountName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating Kubernetes annotation value from string '%v'", serviceAccountName)
+		}
+		annotations[kubernetes_annotation_key_consts.EnclaveServiceAccountNameAnnotationKey] = serviceAccountNameAnnotationValue
+	}
+	return nil
+}
+
 func getLabelKeyValuesAsStrings(labels map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue) map[string]string {
 	result := map[string]string{}
 	for key, value := range labels {
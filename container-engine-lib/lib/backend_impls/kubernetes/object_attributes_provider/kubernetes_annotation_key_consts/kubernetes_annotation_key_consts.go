@@ -2,6 +2,7 @@ package kubernetes_annotation_key_consts
 
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
+	"strings"
 )
 
 const (
@@ -15,6 +16,11 @@ const (
 	enclaveCreationTimeKeyStr = labelKeyPrefixStr + "enclave-creation-time"
 
 	enclaveNameKeyStr = labelKeyPrefixStr + "enclave-name"
+
+	enclaveDefaultNodeSelectorsKeyStr = labelKeyPrefixStr + "enclave-default-node-selectors"
+
+	enclaveServiceAccountNameKeyStr = labelKeyPrefixStr + "enclave-service-account-name"
+	persistentDirectorySeededKeyStr = labelKeyPrefixStr + "persistent-directory-seeded"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -23,3 +29,14 @@ const (
 var PortSpecsKubernetesAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(portSpecsAnnotationKeyStr)
 var EnclaveCreationTimeAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveCreationTimeKeyStr)
 var EnclaveNameAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveNameKeyStr)
+var EnclaveDefaultNodeSelectorsAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveDefaultNodeSelectorsKeyStr)
+var EnclaveServiceAccountNameAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(enclaveServiceAccountNameKeyStr)
+
+// Set on the persistent volume claims of the persistent directories once their seed files artifact got expanded into them
+var PersistentDirectorySeededAnnotationKey = kubernetes_annotation_key.MustCreateNewKubernetesAnnotationKey(persistentDirectorySeededKeyStr)
+
+// IsKurtosisAnnotationKey returns whether the annotation key has the Kurtosis prefix, which is reserved for the
+// annotations Kurtosis puts on its objects
+func IsKurtosisAnnotationKey(annotationKeyStr string) bool {
+	return strings.HasPrefix(annotationKeyStr, labelKeyPrefixStr)
+}
@@ -7,16 +7,22 @@ import (
 )
 
 var labelKeyStrsToEnsure = map[string]string{
-	labelKeyPrefixStr:         "kurtosistech.com/",
-	portSpecsAnnotationKeyStr: "kurtosistech.com/ports",
-	enclaveCreationTimeKeyStr: "kurtosistech.com/enclave-creation-time",
-	enclaveNameKeyStr:         "kurtosistech.com/enclave-name",
+	labelKeyPrefixStr:                 "kurtosistech.com/",
+	portSpecsAnnotationKeyStr:         "kurtosistech.com/ports",
+	enclaveCreationTimeKeyStr:         "kurtosistech.com/enclave-creation-time",
+	enclaveNameKeyStr:                 "kurtosistech.com/enclave-name",
+	enclaveDefaultNodeSelectorsKeyStr: "kurtosistech.com/enclave-default-node-selectors",
+	enclaveServiceAccountNameKeyStr:   "kurtosistech.com/enclave-service-account-name",
+	persistentDirectorySeededKeyStr:   "kurtosistech.com/persistent-directory-seeded",
 }
 
 var labelKeysToEnsure = map[*kubernetes_annotation_key.KubernetesAnnotationKey]string{
-	PortSpecsKubernetesAnnotationKey: "kurtosistech.com/ports",
-	EnclaveCreationTimeAnnotationKey: "kurtosistech.com/enclave-creation-time",
-	EnclaveNameAnnotationKey:         "kurtosistech.com/enclave-name",
+	PortSpecsKubernetesAnnotationKey:         "kurtosistech.com/ports",
+	EnclaveCreationTimeAnnotationKey:         "kurtosistech.com/enclave-creation-time",
+	EnclaveNameAnnotationKey:                 "kurtosistech.com/enclave-name",
+	EnclaveDefaultNodeSelectorsAnnotationKey: "kurtosistech.com/enclave-default-node-selectors",
+	EnclaveServiceAccountNameAnnotationKey:   "kurtosistech.com/enclave-service-account-name",
+	PersistentDirectorySeededAnnotationKey:   "kurtosistech.com/persistent-directory-seeded",
 }
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! IMPORTANT !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -2,6 +2,7 @@ package label_key_consts
 
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"strings"
 )
 
 const (
@@ -30,6 +31,12 @@ const (
 
 	// As of 2022-05-17, these get attached to files artifact expansion volumes
 	userServiceGuidKeyStr = labelKeyPrefixStr + "user-service-guid"
+
+	// The version tag of the image the API container runs, used to know whether an API container can be reused
+	apiContainerImageVersionTagKeyStr = labelKeyPrefixStr + "api-container-image-version-tag"
+
+	// The hash of the environment variables of the enclave the API container runs, used to know whether an API container can be reused
+	apiContainerEnclaveEnvVarsHashKeyStr = labelKeyPrefixStr + "api-container-enclave-env-vars-hash"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -49,3 +56,11 @@ var GUIDKubernetesLabelKey = kubernetes_label_key.MustCreateNewKubernetesLabelKe
 var EnclaveUUIDKubernetesLabelKey = kubernetes_label_key.MustCreateNewKubernetesLabelKey(enclaveIdLabelKeyStr)
 var EnclaveNameKubernetesLabelKey = kubernetes_label_key.MustCreateNewKubernetesLabelKey(enclaveNameLabelKeyStr)
 var UserServiceGUIDKubernetesLabelKey = kubernetes_label_key.MustCreateNewKubernetesLabelKey(userServiceGuidKeyStr)
+var APIContainerImageVersionTagKubernetesLabelKey = kubernetes_label_key.MustCreateNewKubernetesLabelKey(apiContainerImageVersionTagKeyStr)
+var APIContainerEnclaveEnvVarsHashKubernetesLabelKey = kubernetes_label_key.MustCreateNewKubernetesLabelKey(apiContainerEnclaveEnvVarsHashKeyStr)
+
+// IsKurtosisLabelKey returns whether the label key has the Kurtosis label prefix, which is reserved for the labels
+// Kurtosis puts on its objects
+func IsKurtosisLabelKey(labelKeyStr string) bool {
+	return strings.HasPrefix(labelKeyStr, labelKeyPrefixStr)
+}
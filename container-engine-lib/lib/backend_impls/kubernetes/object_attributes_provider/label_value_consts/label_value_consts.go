@@ -18,9 +18,13 @@ const (
 	enclaveKurtosisResourceTypeLabelValueStr      = "enclave"
 	apiContainerKurtosisResourceTypeLabelValueStr = "api-container"
 	userServiceKurtosisResourceTypeLabelValueStr  = "user-service"
+	logsAggregatorResourceTypeLabelValueStr       = "kurtosis-logs-aggregator"
+	logsCollectorResourceTypeLabelValueStr        = "kurtosis-logs-collector"
 
 	enclaveDataVolumeTypeLabelValueStr             = "enclave-data"
 	filesArtifactsExpansionVolumeTypeLabelValueStr = "files-artifacts-expansion"
+	persistentDirectoryVolumeTypeLabelValueStr     = "persistent-directory"
+	logsStorageVolumeTypeLabelValueStr             = "logs-storage"
 )
 
 // !!!!!!!!!!!!!!!!!!!!!!!!!!!!!!!! DO NOT CHANGE THESE VALUES !!!!!!!!!!!!!!!!!!!!!!!!!!!!!
@@ -36,6 +40,10 @@ var EngineKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.Must
 var EnclaveKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(enclaveKurtosisResourceTypeLabelValueStr)
 var APIContainerKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(apiContainerKurtosisResourceTypeLabelValueStr)
 var UserServiceKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(userServiceKurtosisResourceTypeLabelValueStr)
+var LogsAggregatorKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(logsAggregatorResourceTypeLabelValueStr)
+var LogsCollectorKurtosisResourceTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(logsCollectorResourceTypeLabelValueStr)
 
 var EnclaveDataVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(enclaveDataVolumeTypeLabelValueStr)
 var FilesArtifactsExpansionVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(filesArtifactsExpansionVolumeTypeLabelValueStr)
+var PersistentDirectoryVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(persistentDirectoryVolumeTypeLabelValueStr)
+var LogsStorageVolumeTypeKubernetesLabelValue = kubernetes_label_value.MustCreateNewKubernetesLabelValue(logsStorageVolumeTypeLabelValueStr)
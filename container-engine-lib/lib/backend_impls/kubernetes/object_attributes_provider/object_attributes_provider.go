@@ -1,16 +1,28 @@
 package object_attributes_provider
 
 import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_annotation_value"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_key"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_label_value"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/kubernetes_object_name"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_key_consts"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_impls/kubernetes/object_attributes_provider/label_value_consts"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/engine"
 	"github.com/kurtosis-tech/stacktrace"
 	"strings"
 )
 
+const (
+	logsAggregatorName = "kurtosis-logs-aggregator"
+)
+
 type KubernetesObjectAttributesProvider interface {
 	ForEngine(guid engine.EngineGUID) KubernetesEngineObjectAttributesProvider
 	ForEnclave(enclaveId enclave.EnclaveUUID) KubernetesEnclaveObjectAttributesProvider
+	// The logs aggregator is shared by every enclave, so it's not tied to the engine or to an enclave
+	ForLogsAggregator() (KubernetesObjectAttributes, error)
 }
 
 func GetKubernetesObjectAttributesProvider() KubernetesObjectAttributesProvider {
@@ -32,6 +44,28 @@ func (provider *kubernetesObjectAttributesProviderImpl) ForEnclave(enclaveId enc
 	return GetKubernetesEnclaveObjectAttributesProvider(enclaveId)
 }
 
+func (provider *kubernetesObjectAttributesProviderImpl) ForLogsAggregator() (KubernetesObjectAttributes, error) {
+	name, err := kubernetes_object_name.CreateNewKubernetesObjectName(logsAggregatorName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a Kubernetes object name from string '%v'", logsAggregatorName)
+	}
+
+	labels := map[*kubernetes_label_key.KubernetesLabelKey]*kubernetes_label_value.KubernetesLabelValue{
+		label_key_consts.KurtosisResourceTypeKubernetesLabelKey: label_value_consts.LogsAggregatorKurtosisResourceTypeKubernetesLabelValue,
+	}
+
+	// No custom annotations for the logs aggregator
+	annotations := map[*kubernetes_annotation_key.KubernetesAnnotationKey]*kubernetes_annotation_value.KubernetesAnnotationValue{}
+
+	objectAttributes, err := newKubernetesObjectAttributesImpl(name, labels, annotations)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the Kubernetes object attributes with the name "+
+			"'%s' and labels '%+v', and annotations '%+v'", name.GetString(), labels, annotations)
+	}
+
+	return objectAttributes, nil
+}
+
 // Gets the name for an enclave object, making sure to put the enclave ID first and join using the standardized separator
 func getCompositeKubernetesObjectName(elems []string) (*kubernetes_object_name.KubernetesObjectName, error) {
 	nameStr := strings.Join(
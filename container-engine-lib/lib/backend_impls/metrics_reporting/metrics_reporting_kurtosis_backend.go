@@ -10,7 +10,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"io"
 	"time"
@@ -99,8 +101,13 @@ func (backend *MetricsReportingKurtosisBackend) DumpKurtosis(ctx context.Context
 	return nil
 }
 
-func (backend *MetricsReportingKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName)
+func (backend *MetricsReportingKurtosisBackend) CreateEnclave(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	enclaveName string,
+	kubernetesSettings *enclave.KubernetesSettings,
+) (*enclave.Enclave, error) {
+	result, err := backend.underlying.CreateEnclave(ctx, enclaveUuid, enclaveName, kubernetesSettings)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with UUID '%v'", enclaveUuid)
 	}
@@ -150,6 +157,21 @@ func (backend *MetricsReportingKurtosisBackend) StopEnclaves(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) StartEnclaves(
+	ctx context.Context,
+	filters *enclave.EnclaveFilters,
+) (
+	successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+	erroredEnclaveIds map[enclave.EnclaveUUID]error,
+	resultErr error,
+) {
+	successes, failures, err := backend.underlying.StartEnclaves(ctx, filters)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred starting enclaves using filters: %+v", filters)
+	}
+	return successes, failures, nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) DumpEnclave(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -182,8 +204,10 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 	enclaveUuid enclave.EnclaveUUID,
 	grpcPortNum uint16,
 	enclaveDataVolumeDirpath string,
+	packageCacheVolumeDirpath string,
 	ownIpEnvVar string,
 	customEnvVars map[string]string,
+	enclaveEnvVars string,
 ) (*api_container.APIContainer, error) {
 	if _, found := customEnvVars[ownIpEnvVar]; found {
 		return nil, stacktrace.NewError("Requested own IP environment variable '%v' conflicts with custom environment variable", ownIpEnvVar)
@@ -195,8 +219,10 @@ func (backend *MetricsReportingKurtosisBackend) CreateAPIContainer(
 		enclaveUuid,
 		grpcPortNum,
 		enclaveDataVolumeDirpath,
+		packageCacheVolumeDirpath,
 		ownIpEnvVar,
 		customEnvVars,
+		enclaveEnvVars,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -354,6 +380,55 @@ func (backend *MetricsReportingKurtosisBackend) CopyFilesFromUserService(
 	return nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) GetPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error) {
+	persistentDirectories, err := backend.underlying.GetPersistentDirectories(ctx, enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave with UUID '%v'", enclaveUuid)
+	}
+	return persistentDirectories, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) DestroyPersistentDirectories(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKeys map[service_directory.DirectoryPersistentKey]bool,
+) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error) {
+	successfulPersistentKeys, erroredPersistentKeys, err := backend.underlying.DestroyPersistentDirectories(ctx, enclaveUuid, serviceUuid, persistentKeys)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(
+			err,
+			"An error occurred destroying persistent directories '%+v' of user service with UUID '%v' in enclave with UUID '%v'",
+			persistentKeys,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return successfulPersistentKeys, erroredPersistentKeys, nil
+}
+
+func (backend *MetricsReportingKurtosisBackend) CopyFilesFromPersistentDirectory(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	output io.Writer,
+) error {
+	if err := backend.underlying.CopyFilesFromPersistentDirectory(ctx, enclaveUuid, serviceUuid, persistentKey, output); err != nil {
+		return stacktrace.Propagate(
+			err,
+			"An error occurred copying files from persistent directory '%v' of user service with UUID '%v' in enclave with UUID '%v'",
+			persistentKey,
+			serviceUuid,
+			enclaveUuid,
+		)
+	}
+	return nil
+}
+
 func (backend *MetricsReportingKurtosisBackend) StopUserServices(
 	ctx context.Context,
 	enclaveUuid enclave.EnclaveUUID,
@@ -386,6 +461,22 @@ func (backend *MetricsReportingKurtosisBackend) DestroyUserServices(
 	return successes, failures, nil
 }
 
+func (backend *MetricsReportingKurtosisBackend) CreateNetworkingSidecar(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error) {
+	return backend.underlying.CreateNetworkingSidecar(ctx, enclaveUuid, serviceUuid)
+}
+
+func (backend *MetricsReportingKurtosisBackend) GetNetworkingSidecars(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	return backend.underlying.GetNetworkingSidecars(ctx, enclaveUuid, filters)
+}
+
+func (backend *MetricsReportingKurtosisBackend) RunNetworkingSidecarExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, networkingSidecarCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+	return backend.underlying.RunNetworkingSidecarExecCommands(ctx, enclaveUuid, networkingSidecarCommands)
+}
+
+func (backend *MetricsReportingKurtosisBackend) DestroyNetworkingSidecars(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	return backend.underlying.DestroyNetworkingSidecars(ctx, enclaveUuid, filters)
+}
+
 func (backend *MetricsReportingKurtosisBackend) CreateLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error) {
 	return backend.underlying.CreateLogsAggregator(ctx)
 }
@@ -9,7 +9,9 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_aggregator"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/logs_collector"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"io"
 	"time"
 )
@@ -69,7 +71,13 @@ type KurtosisBackend interface {
 	DumpKurtosis(ctx context.Context, outputDirpath string) error
 
 	// Creates an enclave with the given enclave UUID
-	CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error)
+	// The Kubernetes settings are optional, and backends other than Kubernetes fail if any of them is set
+	CreateEnclave(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		enclaveName string,
+		kubernetesSettings *enclave.KubernetesSettings,
+	) (*enclave.Enclave, error)
 
 	// Update an enclave by UUID, it's only possible to udpate the name and creation time so far
 	// The newCreationTime param is optional, it won't be updated if the value is nil
@@ -99,6 +107,16 @@ type KurtosisBackend interface {
 		resultErr error,
 	)
 
+	// Starts enclaves matching the given filters that were stopped, bringing back their API container
+	StartEnclaves(
+		ctx context.Context,
+		filters *enclave.EnclaveFilters,
+	) (
+		successfulEnclaveIds map[enclave.EnclaveUUID]bool,
+		erroredEnclaveIds map[enclave.EnclaveUUID]error,
+		resultErr error,
+	)
+
 	// Dumps the contents of the given enclave to the given directory
 	// TODO add this to K8S
 	DumpEnclave(
@@ -123,10 +141,14 @@ type KurtosisBackend interface {
 		enclaveUuid enclave.EnclaveUUID,
 		grpcPortNum uint16,
 		enclaveDataVolumeDirpath string,
+		// The dirpath on the API container where the package cache shared by all the enclaves should be mounted
+		packageCacheVolumeDirpath string,
 		// The environment variable that the user is requesting to populate with the container's own IP address
 		// Must not conflict with the custom environment variables
 		ownIpAddressEnvVar string,
 		customEnvVars map[string]string,
+		// The environment variables of the enclave, which the API container records a hash of
+		enclaveEnvVars string,
 	) (
 		*api_container.APIContainer,
 		error,
@@ -290,6 +312,35 @@ type KurtosisBackend interface {
 		output io.Writer,
 	) error
 
+	// GetPersistentDirectories lists the persistent keys of the persistent directories of the enclave, by the UUID of
+	// the service they were created for. Persistent directories outlive their service, so the service may not exist anymore
+	GetPersistentDirectories(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+	) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error)
+
+	// DestroyPersistentDirectories removes the persistent directories of the service with the given persistent keys,
+	// along with their content
+	DestroyPersistentDirectories(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		persistentKeys map[service_directory.DirectoryPersistentKey]bool,
+	) (
+		successfulPersistentKeys map[service_directory.DirectoryPersistentKey]bool,
+		erroredPersistentKeys map[service_directory.DirectoryPersistentKey]error,
+		resultErr error,
+	)
+
+	// Copy the content of the persistent directory of the service, packaged as a TAR, and writes the bytes to the given output writer
+	CopyFilesFromPersistentDirectory(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+		persistentKey service_directory.DirectoryPersistentKey,
+		output io.Writer,
+	) error
+
 	// StopUserServices stops the user containers for the services matching the given filters
 	StopUserServices(
 		ctx context.Context,
@@ -312,6 +363,44 @@ type KurtosisBackend interface {
 		resultErr error, // Represents an error with the function itself, rather than the user services
 	)
 
+	// CreateNetworkingSidecar attaches a networking sidecar to the running user service, sharing its network stack.
+	// This is a no-op returning the existing sidecar if the service already has one
+	CreateNetworkingSidecar(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		serviceUuid service.ServiceUUID,
+	) (*networking_sidecar.NetworkingSidecar, error)
+
+	// GetNetworkingSidecars gets the networking sidecars matching the given filters, by the UUID of the user service
+	// they're attached to
+	GetNetworkingSidecars(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *networking_sidecar.NetworkingSidecarFilters,
+	) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error)
+
+	// RunNetworkingSidecarExecCommands executes a command inside the networking sidecar of each of the given user services
+	RunNetworkingSidecarExecCommands(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		networkingSidecarCommands map[service.ServiceUUID][]string,
+	) (
+		successfulNetworkingSidecarExecResults map[service.ServiceUUID]*exec_result.ExecResult,
+		erroredUserServiceUuids map[service.ServiceUUID]error,
+		resultErr error,
+	)
+
+	// DestroyNetworkingSidecars destroys the networking sidecars matching the given filters
+	DestroyNetworkingSidecars(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+		filters *networking_sidecar.NetworkingSidecarFilters,
+	) (
+		successfulUserServiceUuids map[service.ServiceUUID]bool, // "set" of UUIDs of the user services whose networking sidecar was successfully destroyed
+		erroredUserServiceUuids map[service.ServiceUUID]error, // "set" of UUIDs of the user services whose networking sidecar errored when destroying, with the error
+		resultErr error, // Represents an error with the function itself, rather than the networking sidecars
+	)
+
 	CreateLogsAggregator(ctx context.Context) (*logs_aggregator.LogsAggregator, error)
 
 	// Returns nil if logs aggregator was not found
@@ -22,8 +22,12 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	networking_sidecar "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
+
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	service_directory "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+
 	time "time"
 )
 
@@ -40,6 +44,52 @@ func (_m *MockKurtosisBackend) EXPECT() *MockKurtosisBackend_Expecter {
 	return &MockKurtosisBackend_Expecter{mock: &_m.Mock}
 }
 
+// CopyFilesFromPersistentDirectory provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, persistentKey, output
+func (_m *MockKurtosisBackend) CopyFilesFromPersistentDirectory(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, persistentKey service_directory.DirectoryPersistentKey, output io.Writer) error {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, persistentKey, output)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service_directory.DirectoryPersistentKey, io.Writer) error); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, persistentKey, output)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyFilesFromPersistentDirectory'
+type MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call struct {
+	*mock.Call
+}
+
+// CopyFilesFromPersistentDirectory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - persistentKey service_directory.DirectoryPersistentKey
+//   - output io.Writer
+func (_e *MockKurtosisBackend_Expecter) CopyFilesFromPersistentDirectory(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, persistentKey interface{}, output interface{}) *MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call {
+	return &MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call{Call: _e.mock.On("CopyFilesFromPersistentDirectory", ctx, enclaveUuid, serviceUuid, persistentKey, output)}
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, persistentKey service_directory.DirectoryPersistentKey, output io.Writer)) *MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(service_directory.DirectoryPersistentKey), args[4].(io.Writer))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call) Return(_a0 error) *MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, service_directory.DirectoryPersistentKey, io.Writer) error) *MockKurtosisBackend_CopyFilesFromPersistentDirectory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CopyFilesFromUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, srcPathOnService, output
 func (_m *MockKurtosisBackend) CopyFilesFromUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, srcPathOnService string, output io.Writer) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, srcPathOnService, output)
@@ -86,25 +136,25 @@ func (_c *MockKurtosisBackend_CopyFilesFromUserService_Call) RunAndReturn(run fu
 	return _c
 }
 
-// CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars
-func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string) (*api_container.APIContainer, error) {
-	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
+// CreateAPIContainer provides a mock function with given fields: ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, packageCacheVolumeDirpath, ownIpAddressEnvVar, customEnvVars, enclaveEnvVars
+func (_m *MockKurtosisBackend) CreateAPIContainer(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, packageCacheVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string, enclaveEnvVars string) (*api_container.APIContainer, error) {
+	ret := _m.Called(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, packageCacheVolumeDirpath, ownIpAddressEnvVar, customEnvVars, enclaveEnvVars)
 
 	var r0 *api_container.APIContainer
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, map[string]string) (*api_container.APIContainer, error)); ok {
-		return rf(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
+	if rf, ok := ret.Get(0).(func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, string, map[string]string, string) (*api_container.APIContainer, error)); ok {
+		return rf(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, packageCacheVolumeDirpath, ownIpAddressEnvVar, customEnvVars, enclaveEnvVars)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, map[string]string) *api_container.APIContainer); ok {
-		r0 = rf(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
+	if rf, ok := ret.Get(0).(func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, string, map[string]string, string) *api_container.APIContainer); ok {
+		r0 = rf(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, packageCacheVolumeDirpath, ownIpAddressEnvVar, customEnvVars, enclaveEnvVars)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*api_container.APIContainer)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, map[string]string) error); ok {
-		r1 = rf(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)
+	if rf, ok := ret.Get(1).(func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, string, map[string]string, string) error); ok {
+		r1 = rf(ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, packageCacheVolumeDirpath, ownIpAddressEnvVar, customEnvVars, enclaveEnvVars)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -123,15 +173,17 @@ type MockKurtosisBackend_CreateAPIContainer_Call struct {
 //   - enclaveUuid enclave.EnclaveUUID
 //   - grpcPortNum uint16
 //   - enclaveDataVolumeDirpath string
+//   - packageCacheVolumeDirpath string
 //   - ownIpAddressEnvVar string
 //   - customEnvVars map[string]string
-func (_e *MockKurtosisBackend_Expecter) CreateAPIContainer(ctx interface{}, image interface{}, enclaveUuid interface{}, grpcPortNum interface{}, enclaveDataVolumeDirpath interface{}, ownIpAddressEnvVar interface{}, customEnvVars interface{}) *MockKurtosisBackend_CreateAPIContainer_Call {
-	return &MockKurtosisBackend_CreateAPIContainer_Call{Call: _e.mock.On("CreateAPIContainer", ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, ownIpAddressEnvVar, customEnvVars)}
+//   - enclaveEnvVars string
+func (_e *MockKurtosisBackend_Expecter) CreateAPIContainer(ctx interface{}, image interface{}, enclaveUuid interface{}, grpcPortNum interface{}, enclaveDataVolumeDirpath interface{}, packageCacheVolumeDirpath interface{}, ownIpAddressEnvVar interface{}, customEnvVars interface{}, enclaveEnvVars interface{}) *MockKurtosisBackend_CreateAPIContainer_Call {
+	return &MockKurtosisBackend_CreateAPIContainer_Call{Call: _e.mock.On("CreateAPIContainer", ctx, image, enclaveUuid, grpcPortNum, enclaveDataVolumeDirpath, packageCacheVolumeDirpath, ownIpAddressEnvVar, customEnvVars, enclaveEnvVars)}
 }
 
-func (_c *MockKurtosisBackend_CreateAPIContainer_Call) Run(run func(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string)) *MockKurtosisBackend_CreateAPIContainer_Call {
+func (_c *MockKurtosisBackend_CreateAPIContainer_Call) Run(run func(ctx context.Context, image string, enclaveUuid enclave.EnclaveUUID, grpcPortNum uint16, enclaveDataVolumeDirpath string, packageCacheVolumeDirpath string, ownIpAddressEnvVar string, customEnvVars map[string]string, enclaveEnvVars string)) *MockKurtosisBackend_CreateAPIContainer_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(enclave.EnclaveUUID), args[3].(uint16), args[4].(string), args[5].(string), args[6].(map[string]string))
+		run(args[0].(context.Context), args[1].(string), args[2].(enclave.EnclaveUUID), args[3].(uint16), args[4].(string), args[5].(string), args[6].(string), args[7].(map[string]string), args[8].(string))
 	})
 	return _c
 }
@@ -141,30 +193,30 @@ func (_c *MockKurtosisBackend_CreateAPIContainer_Call) Return(_a0 *api_container
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateAPIContainer_Call) RunAndReturn(run func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, map[string]string) (*api_container.APIContainer, error)) *MockKurtosisBackend_CreateAPIContainer_Call {
+func (_c *MockKurtosisBackend_CreateAPIContainer_Call) RunAndReturn(run func(context.Context, string, enclave.EnclaveUUID, uint16, string, string, string, map[string]string, string) (*api_container.APIContainer, error)) *MockKurtosisBackend_CreateAPIContainer_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName
-func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string) (*enclave.Enclave, error) {
-	ret := _m.Called(ctx, enclaveUuid, enclaveName)
+// CreateEnclave provides a mock function with given fields: ctx, enclaveUuid, enclaveName, kubernetesSettings
+func (_m *MockKurtosisBackend) CreateEnclave(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, kubernetesSettings *enclave.KubernetesSettings) (*enclave.Enclave, error) {
+	ret := _m.Called(ctx, enclaveUuid, enclaveName, kubernetesSettings)
 
 	var r0 *enclave.Enclave
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)); ok {
-		return rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, *enclave.KubernetesSettings) (*enclave.Enclave, error)); ok {
+		return rf(ctx, enclaveUuid, enclaveName, kubernetesSettings)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string) *enclave.Enclave); ok {
-		r0 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, string, *enclave.KubernetesSettings) *enclave.Enclave); ok {
+		r0 = rf(ctx, enclaveUuid, enclaveName, kubernetesSettings)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*enclave.Enclave)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string) error); ok {
-		r1 = rf(ctx, enclaveUuid, enclaveName)
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, string, *enclave.KubernetesSettings) error); ok {
+		r1 = rf(ctx, enclaveUuid, enclaveName, kubernetesSettings)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -181,13 +233,14 @@ type MockKurtosisBackend_CreateEnclave_Call struct {
 //   - ctx context.Context
 //   - enclaveUuid enclave.EnclaveUUID
 //   - enclaveName string
-func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}) *MockKurtosisBackend_CreateEnclave_Call {
-	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName)}
+//   - kubernetesSettings *enclave.KubernetesSettings
+func (_e *MockKurtosisBackend_Expecter) CreateEnclave(ctx interface{}, enclaveUuid interface{}, enclaveName interface{}, kubernetesSettings interface{}) *MockKurtosisBackend_CreateEnclave_Call {
+	return &MockKurtosisBackend_CreateEnclave_Call{Call: _e.mock.On("CreateEnclave", ctx, enclaveUuid, enclaveName, kubernetesSettings)}
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, enclaveName string, kubernetesSettings *enclave.KubernetesSettings)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string))
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(string), args[3].(*enclave.KubernetesSettings))
 	})
 	return _c
 }
@@ -197,7 +250,7 @@ func (_c *MockKurtosisBackend_CreateEnclave_Call) Return(_a0 *enclave.Enclave, _
 	return _c
 }
 
-func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
+func (_c *MockKurtosisBackend_CreateEnclave_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, string, *enclave.KubernetesSettings) (*enclave.Enclave, error)) *MockKurtosisBackend_CreateEnclave_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -371,6 +424,62 @@ func (_c *MockKurtosisBackend_CreateLogsCollectorForEnclave_Call) RunAndReturn(r
 	return _c
 }
 
+// CreateNetworkingSidecar provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
+func (_m *MockKurtosisBackend) CreateNetworkingSidecar(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
+
+	var r0 *networking_sidecar.NetworkingSidecar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) *networking_sidecar.NetworkingSidecar); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*networking_sidecar.NetworkingSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_CreateNetworkingSidecar_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateNetworkingSidecar'
+type MockKurtosisBackend_CreateNetworkingSidecar_Call struct {
+	*mock.Call
+}
+
+// CreateNetworkingSidecar is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+func (_e *MockKurtosisBackend_Expecter) CreateNetworkingSidecar(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	return &MockKurtosisBackend_CreateNetworkingSidecar_Call{Call: _e.mock.On("CreateNetworkingSidecar", ctx, enclaveUuid, serviceUuid)}
+}
+
+func (_c *MockKurtosisBackend_CreateNetworkingSidecar_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID)) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateNetworkingSidecar_Call) Return(_a0 *networking_sidecar.NetworkingSidecar, _a1 error) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_CreateNetworkingSidecar_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID) (*networking_sidecar.NetworkingSidecar, error)) *MockKurtosisBackend_CreateNetworkingSidecar_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DestroyAPIContainers provides a mock function with given fields: ctx, filters
 func (_m *MockKurtosisBackend) DestroyAPIContainers(ctx context.Context, filters *api_container.APIContainerFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
 	ret := _m.Called(ctx, filters)
@@ -648,6 +757,137 @@ func (_c *MockKurtosisBackend_DestroyLogsCollectorForEnclave_Call) RunAndReturn(
 	return _c
 }
 
+// DestroyNetworkingSidecars provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) DestroyNetworkingSidecars(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]bool
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]bool); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) error); ok {
+		r2 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_DestroyNetworkingSidecars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyNetworkingSidecars'
+type MockKurtosisBackend_DestroyNetworkingSidecars_Call struct {
+	*mock.Call
+}
+
+// DestroyNetworkingSidecars is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *networking_sidecar.NetworkingSidecarFilters
+func (_e *MockKurtosisBackend_Expecter) DestroyNetworkingSidecars(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
+	return &MockKurtosisBackend_DestroyNetworkingSidecars_Call{Call: _e.mock.On("DestroyNetworkingSidecars", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_DestroyNetworkingSidecars_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters)) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*networking_sidecar.NetworkingSidecarFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyNetworkingSidecars_Call) Return(successfulUserServiceUuids map[service.ServiceUUID]bool, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
+	_c.Call.Return(successfulUserServiceUuids, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyNetworkingSidecars_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_DestroyNetworkingSidecars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DestroyPersistentDirectories provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, persistentKeys
+func (_m *MockKurtosisBackend) DestroyPersistentDirectories(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, persistentKeys map[service_directory.DirectoryPersistentKey]bool) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, serviceUuid, persistentKeys)
+
+	var r0 map[service_directory.DirectoryPersistentKey]bool
+	var r1 map[service_directory.DirectoryPersistentKey]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, map[service_directory.DirectoryPersistentKey]bool) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error)); ok {
+		return rf(ctx, enclaveUuid, serviceUuid, persistentKeys)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, map[service_directory.DirectoryPersistentKey]bool) map[service_directory.DirectoryPersistentKey]bool); ok {
+		r0 = rf(ctx, enclaveUuid, serviceUuid, persistentKeys)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service_directory.DirectoryPersistentKey]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, map[service_directory.DirectoryPersistentKey]bool) map[service_directory.DirectoryPersistentKey]error); ok {
+		r1 = rf(ctx, enclaveUuid, serviceUuid, persistentKeys)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service_directory.DirectoryPersistentKey]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, map[service_directory.DirectoryPersistentKey]bool) error); ok {
+		r2 = rf(ctx, enclaveUuid, serviceUuid, persistentKeys)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_DestroyPersistentDirectories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DestroyPersistentDirectories'
+type MockKurtosisBackend_DestroyPersistentDirectories_Call struct {
+	*mock.Call
+}
+
+// DestroyPersistentDirectories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - serviceUuid service.ServiceUUID
+//   - persistentKeys map[service_directory.DirectoryPersistentKey]bool
+func (_e *MockKurtosisBackend_Expecter) DestroyPersistentDirectories(ctx interface{}, enclaveUuid interface{}, serviceUuid interface{}, persistentKeys interface{}) *MockKurtosisBackend_DestroyPersistentDirectories_Call {
+	return &MockKurtosisBackend_DestroyPersistentDirectories_Call{Call: _e.mock.On("DestroyPersistentDirectories", ctx, enclaveUuid, serviceUuid, persistentKeys)}
+}
+
+func (_c *MockKurtosisBackend_DestroyPersistentDirectories_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, persistentKeys map[service_directory.DirectoryPersistentKey]bool)) *MockKurtosisBackend_DestroyPersistentDirectories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(service.ServiceUUID), args[3].(map[service_directory.DirectoryPersistentKey]bool))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyPersistentDirectories_Call) Return(successfulPersistentKeys map[service_directory.DirectoryPersistentKey]bool, erroredPersistentKeys map[service_directory.DirectoryPersistentKey]error, resultErr error) *MockKurtosisBackend_DestroyPersistentDirectories_Call {
+	_c.Call.Return(successfulPersistentKeys, erroredPersistentKeys, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_DestroyPersistentDirectories_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, service.ServiceUUID, map[service_directory.DirectoryPersistentKey]bool) (map[service_directory.DirectoryPersistentKey]bool, map[service_directory.DirectoryPersistentKey]error, error)) *MockKurtosisBackend_DestroyPersistentDirectories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DestroyUserServices provides a mock function with given fields: ctx, enclaveUuid, filters
 func (_m *MockKurtosisBackend) DestroyUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *service.ServiceFilters) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, filters)
@@ -1226,6 +1466,117 @@ func (_c *MockKurtosisBackend_GetLogsCollectorForEnclave_Call) RunAndReturn(run
 	return _c
 }
 
+// GetNetworkingSidecars provides a mock function with given fields: ctx, enclaveUuid, filters
+func (_m *MockKurtosisBackend) GetNetworkingSidecars(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error) {
+	ret := _m.Called(ctx, enclaveUuid, filters)
+
+	var r0 map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error)); ok {
+		return rf(ctx, enclaveUuid, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar); ok {
+		r0 = rf(ctx, enclaveUuid, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) error); ok {
+		r1 = rf(ctx, enclaveUuid, filters)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetNetworkingSidecars_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetNetworkingSidecars'
+type MockKurtosisBackend_GetNetworkingSidecars_Call struct {
+	*mock.Call
+}
+
+// GetNetworkingSidecars is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - filters *networking_sidecar.NetworkingSidecarFilters
+func (_e *MockKurtosisBackend_Expecter) GetNetworkingSidecars(ctx interface{}, enclaveUuid interface{}, filters interface{}) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	return &MockKurtosisBackend_GetNetworkingSidecars_Call{Call: _e.mock.On("GetNetworkingSidecars", ctx, enclaveUuid, filters)}
+}
+
+func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, filters *networking_sidecar.NetworkingSidecarFilters)) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(*networking_sidecar.NetworkingSidecarFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) Return(_a0 map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, _a1 error) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetNetworkingSidecars_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, *networking_sidecar.NetworkingSidecarFilters) (map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar, error)) *MockKurtosisBackend_GetNetworkingSidecars_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPersistentDirectories provides a mock function with given fields: ctx, enclaveUuid
+func (_m *MockKurtosisBackend) GetPersistentDirectories(ctx context.Context, enclaveUuid enclave.EnclaveUUID) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error) {
+	ret := _m.Called(ctx, enclaveUuid)
+
+	var r0 map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error)); ok {
+		return rf(ctx, enclaveUuid)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID) map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool); ok {
+		r0 = rf(ctx, enclaveUuid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID) error); ok {
+		r1 = rf(ctx, enclaveUuid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockKurtosisBackend_GetPersistentDirectories_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPersistentDirectories'
+type MockKurtosisBackend_GetPersistentDirectories_Call struct {
+	*mock.Call
+}
+
+// GetPersistentDirectories is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+func (_e *MockKurtosisBackend_Expecter) GetPersistentDirectories(ctx interface{}, enclaveUuid interface{}) *MockKurtosisBackend_GetPersistentDirectories_Call {
+	return &MockKurtosisBackend_GetPersistentDirectories_Call{Call: _e.mock.On("GetPersistentDirectories", ctx, enclaveUuid)}
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectories_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID)) *MockKurtosisBackend_GetPersistentDirectories_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectories_Call) Return(_a0 map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, _a1 error) *MockKurtosisBackend_GetPersistentDirectories_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_GetPersistentDirectories_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID) (map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool, error)) *MockKurtosisBackend_GetPersistentDirectories_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetShellOnUserService provides a mock function with given fields: ctx, enclaveUuid, serviceUuid
 func (_m *MockKurtosisBackend) GetShellOnUserService(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID) error {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid)
@@ -1522,6 +1873,71 @@ func (_c *MockKurtosisBackend_RemoveRegisteredUserServiceProcesses_Call) RunAndR
 	return _c
 }
 
+// RunNetworkingSidecarExecCommands provides a mock function with given fields: ctx, enclaveUuid, networkingSidecarCommands
+func (_m *MockKurtosisBackend) RunNetworkingSidecarExecCommands(ctx context.Context, enclaveUuid enclave.EnclaveUUID, networkingSidecarCommands map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+	ret := _m.Called(ctx, enclaveUuid, networkingSidecarCommands)
+
+	var r0 map[service.ServiceUUID]*exec_result.ExecResult
+	var r1 map[service.ServiceUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error)); ok {
+		return rf(ctx, enclaveUuid, networkingSidecarCommands)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) map[service.ServiceUUID]*exec_result.ExecResult); ok {
+		r0 = rf(ctx, enclaveUuid, networkingSidecarCommands)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[service.ServiceUUID]*exec_result.ExecResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) map[service.ServiceUUID]error); ok {
+		r1 = rf(ctx, enclaveUuid, networkingSidecarCommands)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[service.ServiceUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) error); ok {
+		r2 = rf(ctx, enclaveUuid, networkingSidecarCommands)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RunNetworkingSidecarExecCommands'
+type MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call struct {
+	*mock.Call
+}
+
+// RunNetworkingSidecarExecCommands is a helper method to define mock.On call
+//   - ctx context.Context
+//   - enclaveUuid enclave.EnclaveUUID
+//   - networkingSidecarCommands map[service.ServiceUUID][]string
+func (_e *MockKurtosisBackend_Expecter) RunNetworkingSidecarExecCommands(ctx interface{}, enclaveUuid interface{}, networkingSidecarCommands interface{}) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	return &MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call{Call: _e.mock.On("RunNetworkingSidecarExecCommands", ctx, enclaveUuid, networkingSidecarCommands)}
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call) Run(run func(ctx context.Context, enclaveUuid enclave.EnclaveUUID, networkingSidecarCommands map[service.ServiceUUID][]string)) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(enclave.EnclaveUUID), args[2].(map[service.ServiceUUID][]string))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call) Return(successfulNetworkingSidecarExecResults map[service.ServiceUUID]*exec_result.ExecResult, erroredUserServiceUuids map[service.ServiceUUID]error, resultErr error) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	_c.Call.Return(successfulNetworkingSidecarExecResults, erroredUserServiceUuids, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call) RunAndReturn(run func(context.Context, enclave.EnclaveUUID, map[service.ServiceUUID][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error)) *MockKurtosisBackend_RunNetworkingSidecarExecCommands_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunUserServiceExecCommandWithStreamedOutput provides a mock function with given fields: ctx, enclaveUuid, serviceUuid, cmd
 func (_m *MockKurtosisBackend) RunUserServiceExecCommandWithStreamedOutput(ctx context.Context, enclaveUuid enclave.EnclaveUUID, serviceUuid service.ServiceUUID, cmd []string) (chan string, chan *exec_result.ExecResult, error) {
 	ret := _m.Called(ctx, enclaveUuid, serviceUuid, cmd)
@@ -1653,6 +2069,70 @@ func (_c *MockKurtosisBackend_RunUserServiceExecCommands_Call) RunAndReturn(run
 	return _c
 }
 
+// StartEnclaves provides a mock function with given fields: ctx, filters
+func (_m *MockKurtosisBackend) StartEnclaves(ctx context.Context, filters *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error) {
+	ret := _m.Called(ctx, filters)
+
+	var r0 map[enclave.EnclaveUUID]bool
+	var r1 map[enclave.EnclaveUUID]error
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error)); ok {
+		return rf(ctx, filters)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]bool); ok {
+		r0 = rf(ctx, filters)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[enclave.EnclaveUUID]bool)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *enclave.EnclaveFilters) map[enclave.EnclaveUUID]error); ok {
+		r1 = rf(ctx, filters)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[enclave.EnclaveUUID]error)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, *enclave.EnclaveFilters) error); ok {
+		r2 = rf(ctx, filters)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// MockKurtosisBackend_StartEnclaves_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartEnclaves'
+type MockKurtosisBackend_StartEnclaves_Call struct {
+	*mock.Call
+}
+
+// StartEnclaves is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filters *enclave.EnclaveFilters
+func (_e *MockKurtosisBackend_Expecter) StartEnclaves(ctx interface{}, filters interface{}) *MockKurtosisBackend_StartEnclaves_Call {
+	return &MockKurtosisBackend_StartEnclaves_Call{Call: _e.mock.On("StartEnclaves", ctx, filters)}
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Run(run func(ctx context.Context, filters *enclave.EnclaveFilters)) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(*enclave.EnclaveFilters))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) Return(successfulEnclaveIds map[enclave.EnclaveUUID]bool, erroredEnclaveIds map[enclave.EnclaveUUID]error, resultErr error) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Return(successfulEnclaveIds, erroredEnclaveIds, resultErr)
+	return _c
+}
+
+func (_c *MockKurtosisBackend_StartEnclaves_Call) RunAndReturn(run func(context.Context, *enclave.EnclaveFilters) (map[enclave.EnclaveUUID]bool, map[enclave.EnclaveUUID]error, error)) *MockKurtosisBackend_StartEnclaves_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartRegisteredUserServices provides a mock function with given fields: ctx, enclaveUuid, services
 func (_m *MockKurtosisBackend) StartRegisteredUserServices(ctx context.Context, enclaveUuid enclave.EnclaveUUID, services map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
 	ret := _m.Called(ctx, enclaveUuid, services)
@@ -25,6 +25,14 @@ type APIContainer struct {
 	publicGrpcPort *port_spec.PortSpec
 
 	bridgeNetworkIpAddress net.IP
+
+	// The version tag of the image the API container runs, empty if unknown (API containers created by old Kurtosis
+	// versions don't record it)
+	imageVersionTag string
+
+	// The hash of the environment variables of the enclave, empty if unknown (API containers created by old Kurtosis
+	// versions don't record it)
+	enclaveEnvVarsHash string
 }
 
 func NewAPIContainer(
@@ -35,6 +43,8 @@ func NewAPIContainer(
 	publicIpAddr net.IP,
 	publicGrpcPort *port_spec.PortSpec,
 	bridgeNetworkIpAddress net.IP,
+	imageVersionTag string,
+	enclaveEnvVarsHash string,
 ) *APIContainer {
 	return &APIContainer{
 		enclaveId:              enclaveId,
@@ -44,6 +54,8 @@ func NewAPIContainer(
 		publicIpAddr:           publicIpAddr,
 		publicGrpcPort:         publicGrpcPort,
 		bridgeNetworkIpAddress: bridgeNetworkIpAddress,
+		imageVersionTag:        imageVersionTag,
+		enclaveEnvVarsHash:     enclaveEnvVarsHash,
 	}
 }
 
@@ -74,3 +86,11 @@ func (apiContainer *APIContainer) GetPublicIPAddress() net.IP {
 func (apiContainer *APIContainer) GetPublicGRPCPort() *port_spec.PortSpec {
 	return apiContainer.publicGrpcPort
 }
+
+func (apiContainer *APIContainer) GetImageVersionTag() string {
+	return apiContainer.imageVersionTag
+}
+
+func (apiContainer *APIContainer) GetEnclaveEnvVarsHash() string {
+	return apiContainer.enclaveEnvVarsHash
+}
@@ -0,0 +1,13 @@
+package api_container
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+)
+
+// GetEnclaveEnvVarsHash returns a hash of the environment variables of the enclave the API container runs, which is short
+// enough to be recorded as a label even though the environment variables can be arbitrarily long
+func GetEnclaveEnvVarsHash(enclaveEnvVars string) string {
+	hash := md5.Sum([]byte(enclaveEnvVars))
+	return hex.EncodeToString(hash[:])
+}
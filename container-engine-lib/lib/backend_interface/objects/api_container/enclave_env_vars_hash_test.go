@@ -0,0 +1,15 @@
+package api_container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetEnclaveEnvVarsHash(t *testing.T) {
+	require.Equal(t, GetEnclaveEnvVarsHash("FOO=bar"), GetEnclaveEnvVarsHash("FOO=bar"))
+	require.NotEqual(t, GetEnclaveEnvVarsHash("FOO=bar"), GetEnclaveEnvVarsHash("FOO=baz"))
+	require.NotEqual(t, GetEnclaveEnvVarsHash(""), GetEnclaveEnvVarsHash("FOO=bar"))
+	// Kubernetes label values can't be longer than 63 characters
+	require.LessOrEqual(t, len(GetEnclaveEnvVarsHash("FOO=bar")), 63)
+}
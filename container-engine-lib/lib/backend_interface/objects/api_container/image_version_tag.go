@@ -0,0 +1,25 @@
+package api_container
+
+import "strings"
+
+const (
+	imageDigestSeparator = "@"
+	imagePathSeparator   = "/"
+	imageTagSeparator    = ":"
+
+	noImageVersionTag = ""
+)
+
+// GetImageVersionTag returns the version tag of the given API container image, e.g. '0.85.3' for 'kurtosistech/core:0.85.3',
+// or an empty string if the image has no tag
+func GetImageVersionTag(image string) string {
+	imageWithoutDigest := strings.Split(image, imageDigestSeparator)[0]
+	// The registry host can contain a port, e.g. 'localhost:5000/kurtosistech/core', so the tag separator is looked for
+	// after the last path separator
+	lastPathSeparatorIdx := strings.LastIndex(imageWithoutDigest, imagePathSeparator)
+	lastTagSeparatorIdx := strings.LastIndex(imageWithoutDigest, imageTagSeparator)
+	if lastTagSeparatorIdx <= lastPathSeparatorIdx {
+		return noImageVersionTag
+	}
+	return imageWithoutDigest[lastTagSeparatorIdx+1:]
+}
@@ -0,0 +1,15 @@
+package api_container
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetImageVersionTag(t *testing.T) {
+	require.Equal(t, "0.85.3", GetImageVersionTag("kurtosistech/core:0.85.3"))
+	require.Equal(t, "0.85.3", GetImageVersionTag("localhost:5000/kurtosistech/core:0.85.3"))
+	require.Equal(t, "0.85.3", GetImageVersionTag("kurtosistech/core:0.85.3@sha256:a1b2c3"))
+	require.Empty(t, GetImageVersionTag("kurtosistech/core"))
+	require.Empty(t, GetImageVersionTag("localhost:5000/kurtosistech/core"))
+}
@@ -0,0 +1,58 @@
+package enclave
+
+// KubernetesSettings are the settings of an enclave only available for Kubernetes
+type KubernetesSettings struct {
+	// Labels added to the namespace of the enclave
+	namespaceLabels map[string]string
+
+	// Annotations added to the namespace of the enclave
+	namespaceAnnotations map[string]string
+
+	// Node selectors of all the services of the enclave, which the node selectors of a service add to or override
+	defaultNodeSelectors map[string]string
+
+	// Service account the services of the enclave run with, created in the namespace of the enclave
+	// Empty means the default service account of the namespace
+	serviceAccountName string
+}
+
+func NewKubernetesSettings(
+	namespaceLabels map[string]string,
+	namespaceAnnotations map[string]string,
+	defaultNodeSelectors map[string]string,
+	serviceAccountName string,
+) *KubernetesSettings {
+	return &KubernetesSettings{
+		namespaceLabels:      namespaceLabels,
+		namespaceAnnotations: namespaceAnnotations,
+		defaultNodeSelectors: defaultNodeSelectors,
+		serviceAccountName:   serviceAccountName,
+	}
+}
+
+func (settings *KubernetesSettings) GetNamespaceLabels() map[string]string {
+	return settings.namespaceLabels
+}
+
+func (settings *KubernetesSettings) GetNamespaceAnnotations() map[string]string {
+	return settings.namespaceAnnotations
+}
+
+func (settings *KubernetesSettings) GetDefaultNodeSelectors() map[string]string {
+	return settings.defaultNodeSelectors
+}
+
+func (settings *KubernetesSettings) GetServiceAccountName() string {
+	return settings.serviceAccountName
+}
+
+// IsEmpty returns true if the settings are nil or none of them is set, which is the same as not passing any
+func (settings *KubernetesSettings) IsEmpty() bool {
+	if settings == nil {
+		return true
+	}
+	return len(settings.namespaceLabels) == 0 &&
+		len(settings.namespaceAnnotations) == 0 &&
+		len(settings.defaultNodeSelectors) == 0 &&
+		settings.serviceAccountName == ""
+}
@@ -0,0 +1,36 @@
+package networking_sidecar
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+// NetworkingSidecar is a container sharing the network stack of a user service, which has the privileges to shape the
+// traffic going out of the service (i.e. to drop or delay packets) without the user service image having to ship any
+// networking tool
+type NetworkingSidecar struct {
+	serviceUuid service.ServiceUUID
+	enclaveUuid enclave.EnclaveUUID
+	status      container_status.ContainerStatus
+}
+
+func NewNetworkingSidecar(serviceUuid service.ServiceUUID, enclaveUuid enclave.EnclaveUUID, status container_status.ContainerStatus) *NetworkingSidecar {
+	return &NetworkingSidecar{
+		serviceUuid: serviceUuid,
+		enclaveUuid: enclaveUuid,
+		status:      status,
+	}
+}
+
+func (sidecar *NetworkingSidecar) GetServiceUuid() service.ServiceUUID {
+	return sidecar.serviceUuid
+}
+
+func (sidecar *NetworkingSidecar) GetEnclaveUuid() enclave.EnclaveUUID {
+	return sidecar.enclaveUuid
+}
+
+func (sidecar *NetworkingSidecar) GetStatus() container_status.ContainerStatus {
+	return sidecar.status
+}
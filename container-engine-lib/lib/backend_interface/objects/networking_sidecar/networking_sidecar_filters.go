@@ -0,0 +1,17 @@
+package networking_sidecar
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+// Selector for matching networking sidecars inside an enclave
+type NetworkingSidecarFilters struct {
+	// Disjunctive set of UUIDs of the user services the networking sidecars are attached to
+	// If nil or empty, will match all the networking sidecars of the enclave
+	UserServiceUUIDs map[service.ServiceUUID]bool
+
+	// Disjunctive set of statuses that returned networking sidecars must conform to
+	// If nil or empty, will match all statuses
+	Statuses map[container_status.ContainerStatus]bool
+}
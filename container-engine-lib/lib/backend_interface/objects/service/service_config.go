@@ -41,6 +41,42 @@ type privateServiceConfig struct {
 	MinCpuAllocationMilliCpus uint64
 
 	MinMemoryAllocationMegabytes uint64
+
+	// Leave as nil to run the container with the default user of its image
+	User *ServiceUser
+
+	// Added to the labels Kurtosis puts on the container; these can't use the Kurtosis label namespace
+	Labels map[string]string
+
+	// Linux capabilities added to the container, e.g. NET_ADMIN
+	Capabilities []string
+
+	// Namespaced kernel parameters set in the container, e.g. net.core.somaxconn
+	Sysctls map[string]string
+
+	// Resource limits of the container processes by name, e.g. nofile, where both the soft and hard limits are set
+	// to the value; only available for Docker
+	Ulimits map[string]uint64
+
+	// Mapping of dirpaths on the container to the size, in megabytes, of the in-memory filesystem mounted there;
+	// a zero size means no limit other than the backend default
+	TmpfsDirs map[string]uint64
+
+	// Size of /dev/shm; 0 means the backend default
+	ShmSizeMegabytes uint64
+
+	// Labels the nodes running the service must have, on top of the default ones of the enclave; only available for
+	// Kubernetes
+	NodeSelectors map[string]string
+
+	// Only available for Kubernetes
+	Tolerations []Toleration
+
+	// Services of the same enclave the service must run on the same node as; only available for Kubernetes
+	AffinityServiceNames []ServiceName
+
+	// Services of the same enclave the service must not run on the same node as; only available for Kubernetes
+	AntiAffinityServiceNames []ServiceName
 }
 
 func NewServiceConfig(
@@ -57,6 +93,17 @@ func NewServiceConfig(
 	privateIPAddrPlaceholder string,
 	minCpuMilliCores uint64,
 	minMemoryMegaBytes uint64,
+	user *ServiceUser,
+	labels map[string]string,
+	capabilities []string,
+	sysctls map[string]string,
+	ulimits map[string]uint64,
+	tmpfsDirs map[string]uint64,
+	shmSizeMegabytes uint64,
+	nodeSelectors map[string]string,
+	tolerations []Toleration,
+	affinityServiceNames []ServiceName,
+	antiAffinityServiceNames []ServiceName,
 ) *ServiceConfig {
 	internalServiceConfig := &privateServiceConfig{
 		ContainerImageName:        containerImageName,
@@ -73,6 +120,17 @@ func NewServiceConfig(
 		// The minimum resources specification is only available for kubernetes
 		MinCpuAllocationMilliCpus:    minCpuMilliCores,
 		MinMemoryAllocationMegabytes: minMemoryMegaBytes,
+		User:                         user,
+		Labels:                       labels,
+		Capabilities:                 capabilities,
+		Sysctls:                      sysctls,
+		Ulimits:                      ulimits,
+		TmpfsDirs:                    tmpfsDirs,
+		ShmSizeMegabytes:             shmSizeMegabytes,
+		NodeSelectors:                nodeSelectors,
+		Tolerations:                  tolerations,
+		AffinityServiceNames:         affinityServiceNames,
+		AntiAffinityServiceNames:     antiAffinityServiceNames,
 	}
 	return &ServiceConfig{internalServiceConfig}
 }
@@ -131,6 +189,56 @@ func (serviceConfig *ServiceConfig) GetMinMemoryAllocationMegabytes() uint64 {
 	return serviceConfig.privateServiceConfig.MinMemoryAllocationMegabytes
 }
 
+// GetUser returns nil if the container runs with the default user of its image
+func (serviceConfig *ServiceConfig) GetUser() *ServiceUser {
+	return serviceConfig.privateServiceConfig.User
+}
+
+func (serviceConfig *ServiceConfig) GetLabels() map[string]string {
+	return serviceConfig.privateServiceConfig.Labels
+}
+
+func (serviceConfig *ServiceConfig) GetCapabilities() []string {
+	return serviceConfig.privateServiceConfig.Capabilities
+}
+
+func (serviceConfig *ServiceConfig) GetSysctls() map[string]string {
+	return serviceConfig.privateServiceConfig.Sysctls
+}
+
+// only available for Docker
+func (serviceConfig *ServiceConfig) GetUlimits() map[string]uint64 {
+	return serviceConfig.privateServiceConfig.Ulimits
+}
+
+func (serviceConfig *ServiceConfig) GetTmpfsDirs() map[string]uint64 {
+	return serviceConfig.privateServiceConfig.TmpfsDirs
+}
+
+func (serviceConfig *ServiceConfig) GetShmSizeMegabytes() uint64 {
+	return serviceConfig.privateServiceConfig.ShmSizeMegabytes
+}
+
+// only available for Kubernetes
+func (serviceConfig *ServiceConfig) GetNodeSelectors() map[string]string {
+	return serviceConfig.privateServiceConfig.NodeSelectors
+}
+
+// only available for Kubernetes
+func (serviceConfig *ServiceConfig) GetTolerations() []Toleration {
+	return serviceConfig.privateServiceConfig.Tolerations
+}
+
+// only available for Kubernetes
+func (serviceConfig *ServiceConfig) GetAffinityServiceNames() []ServiceName {
+	return serviceConfig.privateServiceConfig.AffinityServiceNames
+}
+
+// only available for Kubernetes
+func (serviceConfig *ServiceConfig) GetAntiAffinityServiceNames() []ServiceName {
+	return serviceConfig.privateServiceConfig.AntiAffinityServiceNames
+}
+
 func (serviceConfig *ServiceConfig) MarshalJSON() ([]byte, error) {
 	return json.Marshal(serviceConfig.privateServiceConfig)
 }
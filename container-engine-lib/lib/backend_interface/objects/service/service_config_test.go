@@ -51,6 +51,17 @@ func TestServiceConfigMarshallers(t *testing.T) {
 	require.Equal(t, originalServiceConfig.GetPrivateIPAddrPlaceholder(), newServiceConfig.GetPrivateIPAddrPlaceholder())
 	require.Equal(t, originalServiceConfig.GetMinCPUAllocationMillicpus(), newServiceConfig.GetMinCPUAllocationMillicpus())
 	require.Equal(t, originalServiceConfig.GetMinMemoryAllocationMegabytes(), newServiceConfig.GetMinMemoryAllocationMegabytes())
+	require.Equal(t, originalServiceConfig.GetUser(), newServiceConfig.GetUser())
+	require.Equal(t, originalServiceConfig.GetLabels(), newServiceConfig.GetLabels())
+	require.Equal(t, originalServiceConfig.GetCapabilities(), newServiceConfig.GetCapabilities())
+	require.Equal(t, originalServiceConfig.GetSysctls(), newServiceConfig.GetSysctls())
+	require.Equal(t, originalServiceConfig.GetUlimits(), newServiceConfig.GetUlimits())
+	require.Equal(t, originalServiceConfig.GetTmpfsDirs(), newServiceConfig.GetTmpfsDirs())
+	require.Equal(t, originalServiceConfig.GetShmSizeMegabytes(), newServiceConfig.GetShmSizeMegabytes())
+	require.Equal(t, originalServiceConfig.GetNodeSelectors(), newServiceConfig.GetNodeSelectors())
+	require.Equal(t, originalServiceConfig.GetTolerations(), newServiceConfig.GetTolerations())
+	require.Equal(t, originalServiceConfig.GetAffinityServiceNames(), newServiceConfig.GetAffinityServiceNames())
+	require.Equal(t, originalServiceConfig.GetAntiAffinityServiceNames(), newServiceConfig.GetAntiAffinityServiceNames())
 }
 
 func getServiceConfigForTest(t *testing.T, imageName string) *ServiceConfig {
@@ -68,13 +79,47 @@ func getServiceConfigForTest(t *testing.T, imageName string) *ServiceConfig {
 		"IP-ADDRESS",
 		100,
 		512,
+		testUser(),
+		map[string]string{"team": "networking"},
+		[]string{"NET_ADMIN"},
+		map[string]string{"net.core.somaxconn": "1024"},
+		map[string]uint64{"nofile": 65536},
+		map[string]uint64{"/scratch": 64},
+		256,
+		map[string]string{"pool": "load-testing"},
+		testTolerations(),
+		[]ServiceName{"service-a"},
+		[]ServiceName{"service-b"},
 	)
 }
 
+func testTolerations() []Toleration {
+	tolerationSeconds := int64(60)
+	return []Toleration{
+		NewToleration("pool", TolerationOperator_Equal, "load-testing", TolerationEffect_NoSchedule, nil),
+		NewToleration("node.kubernetes.io/unreachable", TolerationOperator_Exists, "", TolerationEffect_NoExecute, &tolerationSeconds),
+	}
+}
+
+func testUser() *ServiceUser {
+	gid := uint32(1001)
+	return NewServiceUser(1000, &gid)
+}
+
 func testPersistentDirectory() *service_directory.PersistentDirectories {
-	persistentDirectoriesMap := map[string]service_directory.DirectoryPersistentKey{
-		"dirpath1": service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"),
-		"dirpath2": service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"),
+	persistentDirectoriesMap := map[string]service_directory.PersistentDirectory{
+		"dirpath1": {
+			PersistentKey:              service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"),
+			Size:                       service_directory.DirectoryPersistentSize(0),
+			StorageClass:               "",
+			SeedFilesArtifactExpansion: nil,
+		},
+		"dirpath2": {
+			PersistentKey:              service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"),
+			Size:                       service_directory.DirectoryPersistentSize(1024 * 1024),
+			StorageClass:               "standard",
+			SeedFilesArtifactExpansion: testFilesArtifactExpansion(),
+		},
 	}
 
 	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
@@ -0,0 +1,53 @@
+package service
+
+type TolerationOperator string
+
+const (
+	TolerationOperator_Equal  TolerationOperator = "Equal"
+	TolerationOperator_Exists TolerationOperator = "Exists"
+)
+
+type TolerationEffect string
+
+const (
+	// An empty effect tolerates the taints with any effect
+	TolerationEffect_Any              TolerationEffect = ""
+	TolerationEffect_NoSchedule       TolerationEffect = "NoSchedule"
+	TolerationEffect_PreferNoSchedule TolerationEffect = "PreferNoSchedule"
+	TolerationEffect_NoExecute        TolerationEffect = "NoExecute"
+)
+
+// Toleration lets the service be scheduled on the nodes having a matching taint; this is only available for Kubernetes
+type Toleration struct {
+	// An empty key with the Exists operator tolerates every taint
+	Key string
+
+	Operator TolerationOperator
+
+	// Must be empty with the Exists operator
+	Value string
+
+	Effect TolerationEffect
+
+	// How long the service keeps running on a node after it got a matching NoExecute taint; leave as nil to keep
+	// running forever
+	TolerationSeconds *int64
+}
+
+func NewToleration(key string, operator TolerationOperator, value string, effect TolerationEffect, tolerationSeconds *int64) Toleration {
+	return Toleration{
+		Key:               key,
+		Operator:          operator,
+		Value:             value,
+		Effect:            effect,
+		TolerationSeconds: tolerationSeconds,
+	}
+}
+
+func TolerationOperatorStrings() []string {
+	return []string{string(TolerationOperator_Equal), string(TolerationOperator_Exists)}
+}
+
+func TolerationEffectStrings() []string {
+	return []string{string(TolerationEffect_NoSchedule), string(TolerationEffect_PreferNoSchedule), string(TolerationEffect_NoExecute)}
+}
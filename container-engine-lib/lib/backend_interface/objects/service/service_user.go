@@ -0,0 +1,28 @@
+package service
+
+// ServiceUser is the user the container of a service runs as, instead of the default user of its image
+type ServiceUser struct {
+	UID uint32
+
+	// Leave as nil to run with the primary group of the user
+	GID *uint32
+}
+
+func NewServiceUser(uid uint32, gid *uint32) *ServiceUser {
+	return &ServiceUser{
+		UID: uid,
+		GID: gid,
+	}
+}
+
+func (user *ServiceUser) GetUID() uint32 {
+	return user.UID
+}
+
+// GetGID returns the group the container runs with, along with whether it was set
+func (user *ServiceUser) GetGID() (uint32, bool) {
+	if user.GID == nil {
+		return 0, false
+	}
+	return *user.GID, true
+}
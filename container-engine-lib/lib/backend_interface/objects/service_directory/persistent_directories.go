@@ -2,12 +2,31 @@ package service_directory
 
 type DirectoryPersistentKey string
 
+// DirectoryPersistentSize is the size of a persistent directory, in bytes
+type DirectoryPersistentSize int64
+
+type PersistentDirectory struct {
+	PersistentKey DirectoryPersistentKey
+
+	// The size of the volume backing the persistent directory; only honoured by backends that size their volumes (e.g. Kubernetes)
+	Size DirectoryPersistentSize
+
+	// The Kubernetes storage class used to dynamically provision the persistent directory volume; leave empty to use
+	// the backend default. Ignored by backends that don't have storage classes (e.g. Docker)
+	StorageClass string
+
+	// Leave as nil to create the persistent directory empty. Otherwise, the files artifacts expansion that populates the
+	// persistent directory once, right after its volume gets created. Its ExpanderDirpathsToServiceDirpaths has a single
+	// entry mapping the dirpath the expander expands the seed to, to the dirpath of the persistent directory on the service
+	SeedFilesArtifactExpansion *FilesArtifactsExpansion
+}
+
 type PersistentDirectories struct {
-	ServiceDirpathToDirectoryPersistentKey map[string]DirectoryPersistentKey
+	ServiceDirpathToPersistentDirectory map[string]PersistentDirectory
 }
 
-func NewPersistentDirectories(serviceDirpathToDirectoryPersistentKey map[string]DirectoryPersistentKey) *PersistentDirectories {
+func NewPersistentDirectories(serviceDirpathToPersistentDirectory map[string]PersistentDirectory) *PersistentDirectories {
 	return &PersistentDirectories{
-		ServiceDirpathToDirectoryPersistentKey: serviceDirpathToDirectoryPersistentKey,
+		ServiceDirpathToPersistentDirectory: serviceDirpathToPersistentDirectory,
 	}
 }
@@ -316,13 +316,34 @@ func getServiceConfigForTest(t *testing.T, imageName string) *service.ServiceCon
 		"IP-ADDRESS",
 		100,
 		512,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 }
 
 func testPersistentDirectory() *service_directory.PersistentDirectories {
-	persistentDirectoriesMap := map[string]service_directory.DirectoryPersistentKey{
-		"dirpath1": service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"),
-		"dirpath2": service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"),
+	persistentDirectoriesMap := map[string]service_directory.PersistentDirectory{
+		"dirpath1": {
+			PersistentKey:              service_directory.DirectoryPersistentKey("dirpath1_persistent_directory_key"),
+			Size:                       service_directory.DirectoryPersistentSize(0),
+			StorageClass:               "",
+			SeedFilesArtifactExpansion: nil,
+		},
+		"dirpath2": {
+			PersistentKey:              service_directory.DirectoryPersistentKey("dirpath2_persistent_directory_key"),
+			Size:                       service_directory.DirectoryPersistentSize(1024 * 1024),
+			StorageClass:               "standard",
+			SeedFilesArtifactExpansion: testFilesArtifactExpansion(),
+		},
 	}
 
 	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
@@ -50,3 +50,12 @@ func NewRemoteV0Context(
 		},
 	}
 }
+
+func NewGitHost(host string, cloneUrlPrefix string, username string, token string) *generated.GitHost {
+	return &generated.GitHost{
+		Host:           host,
+		CloneUrlPrefix: cloneUrlPrefix,
+		Username:       username,
+		Token:          token,
+	}
+}
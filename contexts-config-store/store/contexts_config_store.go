@@ -30,6 +30,10 @@ type ContextsConfigStore interface {
 	// RemoveContext removes the contexts passed as an argument.
 	// It does nothing if the contextUuid does not point to any known context.
 	RemoveContext(contextUuid *generated.ContextUuid) error
+
+	// SetGitHosts replaces the git hosts the packages can be cloned from when running in the context passed as an argument.
+	// It throws an error if the contextUuid does not point to any known context.
+	SetGitHosts(contextUuid *generated.ContextUuid, gitHosts []*generated.GitHost) error
 }
 
 func GetContextsConfigStore() ContextsConfigStore {
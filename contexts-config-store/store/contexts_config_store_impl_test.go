@@ -3,6 +3,7 @@ package store
 import (
 	"fmt"
 	api "github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang"
+	"github.com/kurtosis-tech/kurtosis/contexts-config-store/api/golang/generated"
 	"github.com/kurtosis-tech/kurtosis/contexts-config-store/store/persistence"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -226,3 +227,45 @@ func TestRemoveContext_NonExistingContext(t *testing.T) {
 	require.True(t, found)
 	storage.AssertNotCalled(t, persistMethod.Name, mock.Anything)
 }
+
+func TestSetGitHosts(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	gitHosts := []*generated.GitHost{api.NewGitHost("gitlab.com", "", "username", "token")}
+	otherLocalContextWithGitHosts := api.NewLocalOnlyContext(otherContextUuid, otherLocalContext.GetName())
+	otherLocalContextWithGitHosts.GitHosts = gitHosts
+	expectContextsConfigAfterUpdate := api.NewKurtosisContextsConfig(contextUuid, localContext, otherLocalContextWithGitHosts)
+	storage.EXPECT().PersistContextsConfig(mock.MatchedBy(func(contextsConfig *generated.KurtosisContextsConfig) bool {
+		return proto.Equal(expectContextsConfigAfterUpdate, contextsConfig)
+	})).Times(1).Return(nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetGitHosts(otherContextUuid, gitHosts)
+	require.NoError(t, err)
+	// the context loaded from the storage is left untouched
+	require.Empty(t, otherLocalContext.GetGitHosts())
+}
+
+func TestSetGitHosts_NonExistingContextFailure(t *testing.T) {
+	// Setup storage mock
+	storage := persistence.NewMockConfigPersistence(t)
+	contextsConfig := api.NewKurtosisContextsConfig(contextUuid, localContext)
+	storage.EXPECT().LoadContextsConfig().Return(contextsConfig, nil)
+
+	// Run test
+	testContextConfigStore := NewContextConfigStore(storage)
+	err := testContextConfigStore.SetGitHosts(otherContextUuid, nil)
+	require.Error(t, err)
+	expectedErr := fmt.Sprintf("Context with UUID '%s' does not exist in store. Known contexts are: '%s'",
+		otherContextUuid.GetValue(), contextUuid.GetValue())
+	require.Contains(t, err.Error(), expectedErr)
+
+	// Need to check the method exist first because if the method name changes in the future this test would do nothing
+	persistMethod, found := reflect.TypeOf(storage).MethodByName(persistMethodName)
+	require.True(t, found)
+	storage.AssertNotCalled(t, persistMethod.Name, mock.Anything)
+}
@@ -210,6 +210,49 @@ func (_c *MockContextsConfigStore_RemoveContext_Call) RunAndReturn(run func(*gen
 	return _c
 }
 
+// SetGitHosts provides a mock function with given fields: contextUuid, gitHosts
+func (_m *MockContextsConfigStore) SetGitHosts(contextUuid *generated.ContextUuid, gitHosts []*generated.GitHost) error {
+	ret := _m.Called(contextUuid, gitHosts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*generated.ContextUuid, []*generated.GitHost) error); ok {
+		r0 = rf(contextUuid, gitHosts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockContextsConfigStore_SetGitHosts_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetGitHosts'
+type MockContextsConfigStore_SetGitHosts_Call struct {
+	*mock.Call
+}
+
+// SetGitHosts is a helper method to define mock.On call
+//   - contextUuid *generated.ContextUuid
+//   - gitHosts []*generated.GitHost
+func (_e *MockContextsConfigStore_Expecter) SetGitHosts(contextUuid interface{}, gitHosts interface{}) *MockContextsConfigStore_SetGitHosts_Call {
+	return &MockContextsConfigStore_SetGitHosts_Call{Call: _e.mock.On("SetGitHosts", contextUuid, gitHosts)}
+}
+
+func (_c *MockContextsConfigStore_SetGitHosts_Call) Run(run func(contextUuid *generated.ContextUuid, gitHosts []*generated.GitHost)) *MockContextsConfigStore_SetGitHosts_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*generated.ContextUuid), args[1].([]*generated.GitHost))
+	})
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetGitHosts_Call) Return(_a0 error) *MockContextsConfigStore_SetGitHosts_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockContextsConfigStore_SetGitHosts_Call) RunAndReturn(run func(*generated.ContextUuid, []*generated.GitHost) error) *MockContextsConfigStore_SetGitHosts_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // SwitchContext provides a mock function with given fields: contextUuid
 func (_m *MockContextsConfigStore) SwitchContext(contextUuid *generated.ContextUuid) error {
 	ret := _m.Called(contextUuid)
@@ -29,6 +29,10 @@ type FilesArtifactExpansion struct {
 
 	// Directory on the files artifacts expander where the files artifact will be expanded into
 	DirPathToExpandTo string `json:"dirPathToExpandTo"`
+
+	// If true, the files artifact is only expanded into a directory that has no content, so that it doesn't overwrite it.
+	// lost+found and hidden entries don't count as content
+	ShouldSkipIfDirIsNotEmpty bool `json:"shouldSkipIfDirIsNotEmpty"`
 }
 
 func NewFilesArtifactsExpanderArgs(apiContainerIpAddress string, apiContainerPort uint16, filesArtifactExpansions []FilesArtifactExpansion) (*FilesArtifactsExpanderArgs, error) {
@@ -32,6 +32,10 @@ const (
 
 	forceColors   = true
 	fullTimestamp = true
+
+	// Created by mkfs at the root of freshly provisioned ext filesystems, so it doesn't count as content
+	lostAndFoundDirname = "lost+found"
+	hiddenEntryPrefix   = "."
 )
 
 func main() {
@@ -128,6 +132,16 @@ func createExpandFilesArtifactJob(ctx context.Context, apiContainerClient kurtos
 
 func expandFilesArtifact(ctx context.Context, apiContainerClient kurtosis_core_rpc_api_bindings.ApiContainerServiceClient, filesArtifactExpansion args.FilesArtifactExpansion) error {
 	artifactIdentifier := filesArtifactExpansion.FilesIdentifier
+	if filesArtifactExpansion.ShouldSkipIfDirIsNotEmpty {
+		hasContent, err := hasDirContent(filesArtifactExpansion.DirPathToExpandTo)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred checking whether directory '%v' has content", filesArtifactExpansion.DirPathToExpandTo)
+		}
+		if hasContent {
+			logrus.Infof("Skipping the expansion of files artifact '%v' as directory '%v' isn't empty", artifactIdentifier, filesArtifactExpansion.DirPathToExpandTo)
+			return nil
+		}
+	}
 	// Get the raw bytes of the file artifact
 	downloadRequestArgs := &kurtosis_core_rpc_api_bindings.DownloadFilesArtifactArgs{
 		Identifier: artifactIdentifier,
@@ -172,3 +186,20 @@ func expandFilesArtifact(ctx context.Context, apiContainerClient kurtosis_core_r
 	}
 	return nil
 }
+
+// hasDirContent returns true if the directory has content that doesn't come from the filesystem itself (lost+found and
+// hidden entries are ignored)
+func hasDirContent(dirpath string) (bool, error) {
+	dirEntries, err := os.ReadDir(dirpath)
+	if err != nil {
+		return false, stacktrace.Propagate(err, "An error occurred reading the content of directory '%v'", dirpath)
+	}
+	for _, dirEntry := range dirEntries {
+		entryName := dirEntry.Name()
+		if entryName == lostAndFoundDirname || strings.HasPrefix(entryName, hiddenEntryPrefix) {
+			continue
+		}
+		return true, nil
+	}
+	return false, nil
+}
@@ -5,4 +5,57 @@
 
 package main
 
-// empty test file
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testDirPermissions  = 0755
+	testFilePermissions = 0644
+)
+
+func TestHasDirContent_EmptyDir(t *testing.T) {
+	dirpath := t.TempDir()
+
+	hasContent, err := hasDirContent(dirpath)
+	require.NoError(t, err)
+	require.False(t, hasContent)
+}
+
+func TestHasDirContent_FreshVolumeWithOnlyLostAndFound(t *testing.T) {
+	dirpath := t.TempDir()
+	require.NoError(t, os.Mkdir(path.Join(dirpath, lostAndFoundDirname), testDirPermissions))
+
+	hasContent, err := hasDirContent(dirpath)
+	require.NoError(t, err)
+	require.False(t, hasContent)
+}
+
+func TestHasDirContent_OnlyHiddenEntries(t *testing.T) {
+	dirpath := t.TempDir()
+	require.NoError(t, os.Mkdir(path.Join(dirpath, lostAndFoundDirname), testDirPermissions))
+	require.NoError(t, os.WriteFile(path.Join(dirpath, ".placeholder"), []byte{}, testFilePermissions))
+
+	hasContent, err := hasDirContent(dirpath)
+	require.NoError(t, err)
+	require.False(t, hasContent)
+}
+
+func TestHasDirContent_ExistingContent(t *testing.T) {
+	dirpath := t.TempDir()
+	require.NoError(t, os.Mkdir(path.Join(dirpath, lostAndFoundDirname), testDirPermissions))
+	require.NoError(t, os.WriteFile(path.Join(dirpath, "data.db"), []byte("data"), testFilePermissions))
+
+	hasContent, err := hasDirContent(dirpath)
+	require.NoError(t, err)
+	require.True(t, hasContent)
+}
+
+func TestHasDirContent_MissingDir(t *testing.T) {
+	_, err := hasDirContent(path.Join(t.TempDir(), "does-not-exist"))
+	require.Error(t, err)
+}
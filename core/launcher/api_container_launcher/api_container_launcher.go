@@ -17,7 +17,8 @@ import (
 )
 
 const (
-	enclaveDataVolumeDirpath = "/kurtosis-data"
+	enclaveDataVolumeDirpath  = "/kurtosis-data"
+	packageCacheVolumeDirpath = "/kurtosis-package-cache"
 
 	// TODO This should come from the same logic that builds the server image!!!!!
 	containerImage = "kurtosistech/core"
@@ -79,6 +80,7 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		grpcPortNum,
 		string(enclaveUuid),
 		enclaveDataVolumeDirpath,
+		packageCacheVolumeDirpath,
 		kurtosisBackendType,
 		kurtosisBackendConfig,
 		enclaveEnvVars,
@@ -106,8 +108,10 @@ func (launcher ApiContainerLauncher) LaunchWithCustomVersion(
 		enclaveUuid,
 		grpcPortNum,
 		enclaveDataVolumeDirpath,
+		packageCacheVolumeDirpath,
 		ownIpAddressEnvvar,
 		envVars,
+		enclaveEnvVars,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred launching the API container")
@@ -30,6 +30,9 @@ type APIContainerArgs struct {
 	// The directory on the API container where the enclave data directory will have been mounted
 	EnclaveDataVolumeDirpath string `json:"enclaveDataVolume"`
 
+	// The directory on the API container where the package cache shared by all the enclaves will have been mounted
+	PackageCacheVolumeDirpath string `json:"packageCacheVolume"`
+
 	KurtosisBackendType KurtosisBackendType `json:"kurtosisBackendType"`
 
 	// Should be deserialized differently depending on value of KurtosisBackendType
@@ -80,21 +83,23 @@ func NewAPIContainerArgs(
 	grpcListenPortNum uint16,
 	enclaveUuid string,
 	enclaveDataVolumeDirpath string,
+	packageCacheVolumeDirpath string,
 	kurtosisBackendType KurtosisBackendType,
 	kurtosisBackendConfig interface{},
 	enclaveEnvVars string,
 	isProductionEnclave bool,
 ) (*APIContainerArgs, error) {
 	result := &APIContainerArgs{
-		Version:                  version,
-		LogLevel:                 logLevel,
-		GrpcListenPortNum:        grpcListenPortNum,
-		EnclaveUUID:              enclaveUuid,
-		EnclaveDataVolumeDirpath: enclaveDataVolumeDirpath,
-		KurtosisBackendType:      kurtosisBackendType,
-		KurtosisBackendConfig:    kurtosisBackendConfig,
-		EnclaveEnvVars:           enclaveEnvVars,
-		IsProductionEnclave:      isProductionEnclave,
+		Version:                   version,
+		LogLevel:                  logLevel,
+		GrpcListenPortNum:         grpcListenPortNum,
+		EnclaveUUID:               enclaveUuid,
+		EnclaveDataVolumeDirpath:  enclaveDataVolumeDirpath,
+		PackageCacheVolumeDirpath: packageCacheVolumeDirpath,
+		KurtosisBackendType:       kurtosisBackendType,
+		KurtosisBackendConfig:     kurtosisBackendConfig,
+		EnclaveEnvVars:            enclaveEnvVars,
+		IsProductionEnclave:       isProductionEnclave,
 	}
 
 	if err := result.validate(); err != nil {
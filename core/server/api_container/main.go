@@ -21,6 +21,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
@@ -114,7 +115,9 @@ func runMain() error {
 		return stacktrace.NewError("Kurtosis backend type is '%v' but cluster configuration parameters are null.", args.KurtosisBackendType_Kubernetes.String())
 	}
 
-	gitPackageContentProvider, err := enclaveDataDir.GetGitPackageContentProvider()
+	// Only Docker has a volume the API containers of all the enclaves can mount, see the backends' CreateAPIContainer
+	isPackageCacheShared := serverArgs.KurtosisBackendType == args.KurtosisBackendType_Docker
+	gitPackageContentProvider, err := enclaveDataDir.GetGitPackageContentProvider(serverArgs.PackageCacheVolumeDirpath, isPackageCacheShared)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred while creating the Git module content provider")
 	}
@@ -160,35 +163,50 @@ func runMain() error {
 		return stacktrace.NewError("Backend type '%v' was not recognized by API container.", serverArgs.KurtosisBackendType.String())
 	}
 
-	serviceNetwork, err := createServiceNetwork(kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb)
-	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred creating the service network")
-	}
-
 	starlarkValueSerde := createStarlarkValueSerde()
 	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(starlarkValueSerde, enclaveDb)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the runtime value store")
 	}
 
+	livenessCheckBuilder := add_service.NewLivenessCheckBuilder(runtimeValueStore, starlarkValueSerde)
+	serviceNetwork, err := createServiceNetwork(ctx, kurtosisBackend, enclaveDataDir, serverArgs, ownIpAddress, enclaveDb, livenessCheckBuilder)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the service network")
+	}
+
 	// Load the current enclave plan, in case the enclave is being restarted
 	enclavePlan, err := enclave_plan_persistence.Load(enclaveDb)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred loading stored enclave plan")
 	}
 
+	// Kubernetes leaves the ulimits to the container runtime of each node, see the backends' StartRegisteredUserServices
+	areUlimitsSupported := serverArgs.KurtosisBackendType == args.KurtosisBackendType_Docker
+
 	// TODO: Consolidate Interpreter, Validator and Executor into a single interface
 	startosisRunner := startosis_engine.NewStartosisRunner(
 		startosis_engine.NewStartosisInterpreter(serviceNetwork, gitPackageContentProvider, runtimeValueStore, starlarkValueSerde, serverArgs.EnclaveEnvVars),
-		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore),
-		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb))
+		startosis_engine.NewStartosisValidator(&kurtosisBackend, serviceNetwork, filesArtifactStore, areUlimitsSupported),
+		startosis_engine.NewStartosisExecutor(starlarkValueSerde, runtimeValueStore, enclavePlan, enclaveDb, serviceNetwork, filesArtifactStore))
+	startosisPackageTester := startosis_engine.NewStartosisPackageTester(
+		enclave.EnclaveUUID(serverArgs.EnclaveUUID),
+		serviceNetwork.GetApiContainerInfo(),
+		gitPackageContentProvider,
+		starlarkValueSerde,
+		serverArgs.EnclaveEnvVars,
+	)
 
 	//Creation of ApiContainerService
 	apiContainerService, err := server.NewApiContainerService(
 		filesArtifactStore,
 		serviceNetwork,
 		startosisRunner,
+		startosisPackageTester,
 		gitPackageContentProvider,
+		enclaveDb,
+		kurtosisBackend,
+		enclave.EnclaveUUID(serverArgs.EnclaveUUID),
 	)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error occurred creating the API container service")
@@ -214,11 +232,13 @@ func runMain() error {
 }
 
 func createServiceNetwork(
+	ctx context.Context,
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	args *args.APIContainerArgs,
 	ownIpAddress net.IP,
 	enclaveDb *enclave_db.EnclaveDB,
+	livenessCheckBuilder service_network.LivenessCheckBuilder,
 ) (service_network.ServiceNetwork, error) {
 	enclaveIdStr := args.EnclaveUUID
 	enclaveUuid := enclave.EnclaveUUID(enclaveIdStr)
@@ -235,11 +255,18 @@ func createServiceNetwork(
 		kurtosisBackend,
 		enclaveDataDir,
 		enclaveDb,
+		livenessCheckBuilder,
 	)
 
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the default service network")
 	}
+
+	// In case the enclave is being restarted, the services that were running when it was stopped are started again.
+	// The API container keeps booting if they can't be, so that the enclave can still be inspected and fixed
+	if err := serviceNetwork.RestoreServices(ctx); err != nil {
+		logrus.Errorf("An error occurred restoring the services of the enclave, they might not be running. Error was:\n%v", err)
+	}
 	return serviceNetwork, nil
 }
 
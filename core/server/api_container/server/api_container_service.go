@@ -18,6 +18,7 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 	"unicode"
@@ -25,10 +26,17 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/shared_utils"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/enclave_snapshot"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -48,11 +56,20 @@ const (
 	// See: https://stackoverflow.com/questions/34128872/google-protobuf-maximum-size/34186672
 	maxLogOutputSizeBytes = 10 * 1024 * 1024
 
+	// Same limit as for the exec logs, for the same reason
+	maxHttpResponseBodySizeBytes = 10 * 1024 * 1024
+
+	// The content type is passed as a header by the HttpRequestService endpoint
+	noHttpRequestContentType = ""
+
 	// The string returned by the API if a service's public IP address doesn't exist
 	missingPublicIpAddrStr = ""
 
 	defaultStartosisDryRun = false
 
+	// Only packages can be run in prune mode, scripts always run on top of whatever exists in the enclave
+	doNotPruneScript = false
+
 	// Overwrite existing module with new module, this allows user to iterate on an enclave with a
 	// given module
 	doOverwriteExistingModule = true
@@ -60,6 +77,24 @@ const (
 	emptyFileArtifactIdentifier = ""
 	unlimitedLineCount          = math.MaxInt
 	allFilePermissionsForOwner  = 0700
+
+	defaultIncludePersistentDirectoriesInSnapshot = false
+	enclaveSnapshotTmpFilePattern                 = "enclave-snapshot-*.tgz"
+	enclaveSnapshotChunkName                      = "enclave-snapshot"
+
+	minPercentage = float32(0)
+	maxPercentage = float32(100)
+
+	// Standalone scripts have no package root, hence no dependencies nor replaced packages
+	noPackageRootPath = ""
+
+	// Clients running Starlark get the run output from its first response line
+	noResponseLinesAlreadyReceived = 0
+
+	persistentDirectoryTmpFilePattern              = "persistent-directory-*.tar"
+	persistentDirectoryFilesArtifactTmpFilePattern = "persistent-directory-*.tgz"
+	doGzipPersistentDirectory                      = true
+	doNotGzipPersistentDirectory                   = false
 )
 
 // Guaranteed (by a unit test) to be a 1:1 mapping between API port protos and port spec protos
@@ -76,20 +111,39 @@ type ApiContainerService struct {
 
 	startosisRunner *startosis_engine.StartosisRunner
 
+	startosisPackageTester *startosis_engine.StartosisPackageTester
+
 	startosisModuleContentProvider startosis_packages.PackageContentProvider
+
+	enclaveDb *enclave_db.EnclaveDB
+
+	kurtosisBackend backend_interface.KurtosisBackend
+
+	enclaveUuid enclave.EnclaveUUID
+
+	currentStarlarkRunLog *currentStarlarkRunLog
 }
 
 func NewApiContainerService(
 	filesArtifactStore *enclave_data_directory.FilesArtifactStore,
 	serviceNetwork service_network.ServiceNetwork,
 	startosisRunner *startosis_engine.StartosisRunner,
+	startosisPackageTester *startosis_engine.StartosisPackageTester,
 	startosisModuleContentProvider startosis_packages.PackageContentProvider,
+	enclaveDb *enclave_db.EnclaveDB,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
 ) (*ApiContainerService, error) {
 	service := &ApiContainerService{
 		filesArtifactStore:             filesArtifactStore,
 		serviceNetwork:                 serviceNetwork,
 		startosisRunner:                startosisRunner,
+		startosisPackageTester:         startosisPackageTester,
 		startosisModuleContentProvider: startosisModuleContentProvider,
+		enclaveDb:                      enclaveDb,
+		kurtosisBackend:                kurtosisBackend,
+		enclaveUuid:                    enclaveUuid,
+		currentStarlarkRunLog:          newCurrentStarlarkRunLog(),
 	}
 
 	return service, nil
@@ -102,7 +156,23 @@ func (apicService ApiContainerService) RunStarlarkScript(args *kurtosis_core_rpc
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
 	mainFuncName := args.GetMainFunctionName()
 
-	apicService.runStarlark(parallelism, dryRun, startosis_constants.PackageIdPlaceholderForStandaloneScript, mainFuncName, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, serializedStarlarkScript, serializedParams, args.GetExperimentalFeatures(), stream)
+	if interpretationError := apicService.setUpPackageSources(args.GetGitHosts(), args.GetOffline()); interpretationError != nil {
+		if err := stream.SendMsg(binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError.ToAPIType())); err != nil {
+			return stacktrace.Propagate(err, "Error preparing for script execution and this error could not be sent through the output stream")
+		}
+		return nil
+	}
+
+	if interpretationError := apicService.startosisModuleContentProvider.SetUpRootPackage(noPackageRootPath, nil); interpretationError != nil {
+		if err := stream.SendMsg(binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError.ToAPIType())); err != nil {
+			return stacktrace.Propagate(err, "Error preparing for script execution and this error could not be sent through the output stream")
+		}
+		return nil
+	}
+
+	if err := apicService.runStarlark(parallelism, dryRun, doNotPruneScript, startosis_constants.PackageIdPlaceholderForStandaloneScript, mainFuncName, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, serializedStarlarkScript, serializedParams, args.GetExperimentalFeatures(), stream); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the Starlark script")
+	}
 	return nil
 }
 
@@ -174,9 +244,11 @@ func (apicService ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rp
 	packageId := args.GetPackageId()
 	parallelism := int(args.GetParallelism())
 	dryRun := shared_utils.GetOrDefaultBool(args.DryRun, defaultStartosisDryRun)
+	prune := args.GetPrune()
 	serializedParams := args.GetSerializedParams()
 	relativePathToMainFile := args.GetRelativePathToMainFile()
 	mainFuncName := args.GetMainFunctionName()
+	locallyReplacedPackageIds := args.GetLocallyReplacedPackageIds()
 
 	if relativePathToMainFile == "" {
 		relativePathToMainFile = startosis_constants.MainFileName
@@ -185,13 +257,19 @@ func (apicService ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rp
 	// TODO: remove this fork once everything uses the UploadStarlarkPackage endpoint prior to calling this
 	//  right now the TS SDK still uses the old deprecated behavior
 	var scriptWithRunFunction string
-	var interpretationError *startosis_errors.InterpretationError
+	interpretationError := apicService.setUpPackageSources(args.GetGitHosts(), args.GetOffline())
+	if interpretationError != nil {
+		if err := stream.SendMsg(binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError.ToAPIType())); err != nil {
+			return stacktrace.Propagate(err, "Error preparing for package execution and this error could not be sent through the output stream: '%s'", packageId)
+		}
+		return nil
+	}
 	if args.ClonePackage != nil {
-		scriptWithRunFunction, interpretationError = apicService.runStarlarkPackageSetup(packageId, args.GetClonePackage(), nil, relativePathToMainFile)
+		scriptWithRunFunction, interpretationError = apicService.runStarlarkPackageSetup(packageId, args.GetClonePackage(), nil, relativePathToMainFile, locallyReplacedPackageIds)
 	} else {
 		// old deprecated syntax in use
 		moduleContentIfLocal := args.GetLocal()
-		scriptWithRunFunction, interpretationError = apicService.runStarlarkPackageSetup(packageId, args.GetRemote(), moduleContentIfLocal, relativePathToMainFile)
+		scriptWithRunFunction, interpretationError = apicService.runStarlarkPackageSetup(packageId, args.GetRemote(), moduleContentIfLocal, relativePathToMainFile, locallyReplacedPackageIds)
 	}
 	if interpretationError != nil {
 		if err := stream.SendMsg(binding_constructors.NewStarlarkRunResponseLineFromInterpretationError(interpretationError.ToAPIType())); err != nil {
@@ -200,7 +278,9 @@ func (apicService ApiContainerService) RunStarlarkPackage(args *kurtosis_core_rp
 		return nil
 	}
 
-	apicService.runStarlark(parallelism, dryRun, packageId, mainFuncName, relativePathToMainFile, scriptWithRunFunction, serializedParams, args.ExperimentalFeatures, stream)
+	if err := apicService.runStarlark(parallelism, dryRun, prune, packageId, mainFuncName, relativePathToMainFile, scriptWithRunFunction, serializedParams, args.ExperimentalFeatures, stream); err != nil {
+		return stacktrace.Propagate(err, "An error occurred running Starlark package '%v'", packageId)
+	}
 	return nil
 }
 
@@ -280,6 +360,50 @@ func (apicService ApiContainerService) WaitForHttpPostEndpointAvailability(ctx c
 	return &emptypb.Empty{}, nil
 }
 
+func (apicService ApiContainerService) HttpRequestService(ctx context.Context, args *kurtosis_core_rpc_api_bindings.HttpRequestServiceArgs) (*kurtosis_core_rpc_api_bindings.HttpRequestServiceResponse, error) {
+	serviceIdentifier := args.ServiceIdentifier
+	method := args.Method
+	if method == "" {
+		method = http.MethodGet
+	}
+	headers := map[string]string{}
+	for _, header := range args.Headers {
+		headers[header.Name] = header.Value
+	}
+	queryParams := map[string]string{}
+	for _, queryParam := range args.QueryParams {
+		queryParams[queryParam.Name] = queryParam.Value
+	}
+	options := service_network.NewHttpRequestOptions(headers, queryParams, args.UseTls, args.SkipTlsVerify)
+	response, err := apicService.serviceNetwork.HttpRequestService(ctx, serviceIdentifier, args.PortId, method, noHttpRequestContentType, args.Endpoint, args.Body, options)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending HTTP request '%v %v' to port '%v' of service '%v'", method, args.Endpoint, args.PortId, serviceIdentifier)
+	}
+	defer response.Body.Close()
+
+	// one more byte than the limit is read to know whether the limit was exceeded
+	responseBody, err := io.ReadAll(io.LimitReader(response.Body, maxHttpResponseBodySizeBytes+1))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the body of the response to HTTP request '%v %v' sent to service '%v'", method, args.Endpoint, serviceIdentifier)
+	}
+	if len(responseBody) > maxHttpResponseBodySizeBytes {
+		return nil, stacktrace.NewError("The body of the response to HTTP request '%v %v' sent to service '%v' is bigger than the maximum size allowed by Kurtosis of %v bytes", method, args.Endpoint, serviceIdentifier, maxHttpResponseBodySizeBytes)
+	}
+
+	responseHeaders := []*kurtosis_core_rpc_api_bindings.HttpHeader{}
+	responseHeaderNames := make([]string, 0, len(response.Header))
+	for headerName := range response.Header {
+		responseHeaderNames = append(responseHeaderNames, headerName)
+	}
+	sort.Strings(responseHeaderNames)
+	for _, headerName := range responseHeaderNames {
+		for _, headerValue := range response.Header[headerName] {
+			responseHeaders = append(responseHeaders, binding_constructors.NewHttpHeader(headerName, headerValue))
+		}
+	}
+	return binding_constructors.NewHttpRequestServiceResponse(int32(response.StatusCode), responseHeaders, string(responseBody)), nil
+}
+
 func (apicService ApiContainerService) GetServices(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetServicesArgs) (*kurtosis_core_rpc_api_bindings.GetServicesResponse, error) {
 	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
 	filterServiceIdentifiers := args.ServiceIdentifiers
@@ -303,7 +427,7 @@ func (apicService ApiContainerService) GetServices(ctx context.Context, args *ku
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while fetching all services from the backend")
 	}
-	serviceInfos, err = getServiceInfosFromServiceObjs(allServices)
+	serviceInfos, err = getServiceInfosFromServiceObjs(allServices, apicService.serviceNetwork)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while converting the service obj into service info")
 	}
@@ -479,6 +603,310 @@ func (apicService ApiContainerService) ListFilesArtifactNamesAndUuids(_ context.
 	return &kurtosis_core_rpc_api_bindings.ListFilesArtifactNamesAndUuidsResponse{FileNamesAndUuids: filesArtifactNamesAndUuids}, nil
 }
 
+func (apicService ApiContainerService) GetEnclaveSnapshot(args *kurtosis_core_rpc_api_bindings.GetEnclaveSnapshotArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_GetEnclaveSnapshotServer) error {
+	includePersistentDirectories := shared_utils.GetOrDefaultBool(args.IncludePersistentDirectories, defaultIncludePersistentDirectoriesInSnapshot)
+
+	// The snapshot is written to a temporary file first as its size needs to be known before streaming it back
+	snapshotFile, err := os.CreateTemp("", enclaveSnapshotTmpFilePattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file to write the enclave snapshot to")
+	}
+	defer func() {
+		snapshotFile.Close()
+		os.Remove(snapshotFile.Name())
+	}()
+
+	if err := enclave_snapshot.WriteSnapshot(server.Context(), snapshotFile, apicService.enclaveDb, apicService.filesArtifactStore, apicService.kurtosisBackend, apicService.enclaveUuid, includePersistentDirectories); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave snapshot")
+	}
+	fileInfo, err := snapshotFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting enclave snapshot file at '%s'", snapshotFile.Name())
+	}
+	if _, err := snapshotFile.Seek(0, io.SeekStart); err != nil {
+		return stacktrace.Propagate(err, "An error occurred rewinding enclave snapshot file at '%s'", snapshotFile.Name())
+	}
+
+	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](server)
+	err = serverStream.SendData(
+		enclaveSnapshotChunkName,
+		snapshotFile,
+		uint64(fileInfo.Size()),
+		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.StreamedDataChunk, error) {
+			return &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
+				Data:              contentChunk,
+				PreviousChunkHash: previousChunkHash,
+				Metadata: &kurtosis_core_rpc_api_bindings.DataChunkMetadata{
+					Name: enclaveSnapshotChunkName,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending the enclave snapshot")
+	}
+	return nil
+}
+
+func (apicService ApiContainerService) RestoreEnclaveSnapshot(server kurtosis_core_rpc_api_bindings.ApiContainerService_RestoreEnclaveSnapshotServer) error {
+	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse](server)
+
+	err := serverStream.ReceiveData(
+		enclaveSnapshotChunkName,
+		func(dataChunk *kurtosis_core_rpc_api_bindings.StreamedDataChunk) ([]byte, string, error) {
+			return dataChunk.GetData(), dataChunk.GetPreviousChunkHash(), nil
+		},
+		func(assembledContent io.Reader) (*kurtosis_core_rpc_api_bindings.RestoreEnclaveSnapshotResponse, error) {
+			replayScript, numRestoredFilesArtifacts, err := enclave_snapshot.RestoreSnapshot(assembledContent, apicService.enclaveDb, apicService.filesArtifactStore)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred restoring the enclave snapshot")
+			}
+			return binding_constructors.NewRestoreEnclaveSnapshotResponse(replayScript, uint32(numRestoredFilesArtifacts)), nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred receiving the enclave snapshot")
+	}
+	return nil
+}
+
+func (apicService ApiContainerService) Repartition(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RepartitionArgs) (*emptypb.Empty, error) {
+	servicePartitions := map[service.ServiceName]partition_topology.PartitionID{}
+	for _, servicePartition := range args.GetServicePartitions() {
+		serviceName := service.ServiceName(servicePartition.GetServiceName())
+		if _, found := servicePartitions[serviceName]; found {
+			return nil, stacktrace.NewError("Service '%v' was assigned to more than one partition", serviceName)
+		}
+		servicePartitions[serviceName] = partition_topology.PartitionID(servicePartition.GetPartitionId())
+	}
+
+	partitionConnections := map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection{}
+	for _, apiPartitionConnection := range args.GetPartitionConnections() {
+		partitionA := partition_topology.PartitionID(apiPartitionConnection.GetPartition1())
+		partitionB := partition_topology.PartitionID(apiPartitionConnection.GetPartition2())
+		if partitionA == partitionB {
+			return nil, stacktrace.NewError("A connection can't be defined between partition '%v' and itself", partitionA)
+		}
+		connectionId := partition_topology.NewPartitionConnectionID(partitionA, partitionB)
+		if _, found := partitionConnections[connectionId]; found {
+			return nil, stacktrace.NewError("The connection between partitions '%v' and '%v' was defined more than once", partitionA, partitionB)
+		}
+		connection, err := transformApiPartitionConnectionInfo(apiPartitionConnection.GetInfo())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred validating the connection between partitions '%v' and '%v'", partitionA, partitionB)
+		}
+		partitionConnections[connectionId] = connection
+	}
+
+	defaultConnection, err := transformApiPartitionConnectionInfo(args.GetDefaultConnection())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred validating the default connection")
+	}
+
+	if err := apicService.serviceNetwork.Repartition(ctx, servicePartitions, partitionConnections, defaultConnection); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred repartitioning the services of the enclave")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService ApiContainerService) RunStarlarkPackageTests(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsArgs) (*kurtosis_core_rpc_api_bindings.RunStarlarkPackageTestsResponse, error) {
+	packageId := args.GetPackageId()
+	if interpretationError := apicService.setUpPackageSources(args.GetGitHosts(), args.GetOffline()); interpretationError != nil {
+		return nil, stacktrace.Propagate(interpretationError, "An error occurred setting up the sources of the dependencies of package '%s'", packageId)
+	}
+	// The package has been uploaded prior to this call
+	packageRootPathOnDisk, interpretationError := apicService.startosisModuleContentProvider.GetOnDiskAbsolutePackagePath(packageId)
+	if interpretationError != nil {
+		return nil, stacktrace.Propagate(interpretationError, "An error occurred getting the path of package '%s'", packageId)
+	}
+	if interpretationError = apicService.startosisModuleContentProvider.SetUpRootPackage(packageRootPathOnDisk, args.GetLocallyReplacedPackageIds()); interpretationError != nil {
+		return nil, stacktrace.Propagate(interpretationError, "An error occurred setting up the dependencies of package '%s'", packageId)
+	}
+	testResults, err := apicService.startosisPackageTester.RunTests(ctx, packageId, packageRootPathOnDisk, args.GetTestNameFilter())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred running the tests of package '%s'", packageId)
+	}
+	return binding_constructors.NewRunStarlarkPackageTestsResponse(testResults), nil
+}
+
+func (apicService ApiContainerService) GetStarlarkRunPlan(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanResponse, error) {
+	packageId, relativePathToMainFile, serializedStarlark, interpretationError := apicService.starlarkToInterpretSetup(args.GetPackageId(), args.GetClonePackage(), args.GetRelativePathToMainFile(), args.GetSerializedScript(), args.GetLocallyReplacedPackageIds(), args.GetGitHosts(), args.GetOffline())
+	if interpretationError != nil {
+		return binding_constructors.NewGetStarlarkRunPlanResponseFromInterpretationError(interpretationError.ToAPIType()), nil
+	}
+
+	plan, apiInterpretationError := apicService.startosisRunner.ExportPlan(ctx, packageId, args.GetMainFunctionName(), relativePathToMainFile, serializedStarlark, args.GetSerializedParams())
+	if apiInterpretationError != nil {
+		return binding_constructors.NewGetStarlarkRunPlanResponseFromInterpretationError(apiInterpretationError), nil
+	}
+
+	var serializedPlan string
+	var err error
+	switch args.GetFormat() {
+	case kurtosis_core_rpc_api_bindings.PlanFormat_JSON:
+		serializedPlan, err = plan.ToJson()
+	case kurtosis_core_rpc_api_bindings.PlanFormat_YAML:
+		serializedPlan, err = plan.ToYaml()
+	default:
+		return nil, stacktrace.NewError("Unrecognized plan format '%v'", args.GetFormat())
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred serializing the plan of '%s'", packageId)
+	}
+	return binding_constructors.NewGetStarlarkRunPlanResponseFromPlan(serializedPlan), nil
+}
+
+func (apicService ApiContainerService) GetStarlarkRunDiff(ctx context.Context, args *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffArgs) (*kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse, error) {
+	packageId, relativePathToMainFile, serializedStarlark, interpretationError := apicService.starlarkToInterpretSetup(args.GetPackageId(), args.GetClonePackage(), args.GetRelativePathToMainFile(), args.GetSerializedScript(), args.GetLocallyReplacedPackageIds(), args.GetGitHosts(), args.GetOffline())
+	if interpretationError != nil {
+		return binding_constructors.NewGetStarlarkRunDiffResponseFromInterpretationError(interpretationError.ToAPIType()), nil
+	}
+	return apicService.startosisRunner.DiffPlan(ctx, packageId, args.GetMainFunctionName(), relativePathToMainFile, serializedStarlark, args.GetSerializedParams(), args.GetPrune()), nil
+}
+
+func (apicService ApiContainerService) ResumeStarlarkRun(args *kurtosis_core_rpc_api_bindings.ResumeStarlarkRunArgs, stream kurtosis_core_rpc_api_bindings.ApiContainerService_ResumeStarlarkRunServer) error {
+	runLog, err := apicService.currentStarlarkRunLog.getRun(args.GetRunId())
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the Starlark run to resume in enclave '%v'", apicService.enclaveUuid)
+	}
+	streamStarlarkRunLog(runLog, int(args.GetStartingLineIndex()), stream)
+	return nil
+}
+
+func (apicService ApiContainerService) CancelStarlarkRun(_ context.Context, args *kurtosis_core_rpc_api_bindings.CancelStarlarkRunArgs) (*emptypb.Empty, error) {
+	runLog, err := apicService.currentStarlarkRunLog.getRun(args.GetRunId())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the Starlark run to cancel in enclave '%v'", apicService.enclaveUuid)
+	}
+	logrus.Infof("Cancelling Starlark run '%v' as requested by the client", args.GetRunId())
+	runLog.cancel()
+	return &emptypb.Empty{}, nil
+}
+
+func (apicService ApiContainerService) ListPersistentDirectories(ctx context.Context, _ *emptypb.Empty) (*kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse, error) {
+	persistentKeysByServiceUuid, err := apicService.kurtosisBackend.GetPersistentDirectories(ctx, apicService.enclaveUuid)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", apicService.enclaveUuid)
+	}
+	persistentDirectories := []*kurtosis_core_rpc_api_bindings.PersistentDirectory{}
+	for serviceUuid, persistentKeys := range persistentKeysByServiceUuid {
+		for persistentKey := range persistentKeys {
+			persistentDirectories = append(persistentDirectories, binding_constructors.NewPersistentDirectory(string(serviceUuid), string(persistentKey)))
+		}
+	}
+	sort.Slice(persistentDirectories, func(i, j int) bool {
+		if persistentDirectories[i].GetServiceUuid() != persistentDirectories[j].GetServiceUuid() {
+			return persistentDirectories[i].GetServiceUuid() < persistentDirectories[j].GetServiceUuid()
+		}
+		return persistentDirectories[i].GetPersistentKey() < persistentDirectories[j].GetPersistentKey()
+	})
+	return &kurtosis_core_rpc_api_bindings.ListPersistentDirectoriesResponse{PersistentDirectories: persistentDirectories}, nil
+}
+
+func (apicService ApiContainerService) RemovePersistentDirectories(ctx context.Context, args *kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs) (*kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse, error) {
+	serviceUuid := service.ServiceUUID(args.GetServiceUuid())
+	if strings.TrimSpace(string(serviceUuid)) == "" {
+		return nil, stacktrace.NewError("Cannot remove persistent directories of a service with an empty UUID")
+	}
+	persistentKeys := map[service_directory.DirectoryPersistentKey]bool{}
+	for _, persistentKey := range args.GetPersistentKeys() {
+		if strings.TrimSpace(persistentKey) == "" {
+			return nil, stacktrace.NewError("Cannot remove a persistent directory with an empty persistent key")
+		}
+		persistentKeys[service_directory.DirectoryPersistentKey(persistentKey)] = true
+	}
+
+	successfulPersistentKeys, erroredPersistentKeys, err := apicService.kurtosisBackend.DestroyPersistentDirectories(ctx, apicService.enclaveUuid, serviceUuid, persistentKeys)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred removing persistent directories '%v' of service '%v'", args.GetPersistentKeys(), serviceUuid)
+	}
+	removedPersistentKeys := []string{}
+	for persistentKey := range successfulPersistentKeys {
+		removedPersistentKeys = append(removedPersistentKeys, string(persistentKey))
+	}
+	sort.Strings(removedPersistentKeys)
+	if len(erroredPersistentKeys) > 0 {
+		removalErrorStrs := []string{}
+		for persistentKey, removalErr := range erroredPersistentKeys {
+			removalErrorStrs = append(removalErrorStrs, fmt.Sprintf("An error occurred removing persistent directory '%v':\n%v", persistentKey, removalErr))
+		}
+		sort.Strings(removalErrorStrs)
+		return nil, stacktrace.NewError(
+			"Failed to remove %v persistent directories of service '%v', while persistent directories '%v' were removed:\n%v",
+			len(erroredPersistentKeys),
+			serviceUuid,
+			removedPersistentKeys,
+			strings.Join(removalErrorStrs, "\n\n"),
+		)
+	}
+	return &kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesResponse{RemovedPersistentKeys: removedPersistentKeys}, nil
+}
+
+func (apicService ApiContainerService) DownloadPersistentDirectory(args *kurtosis_core_rpc_api_bindings.DownloadPersistentDirectoryArgs, server kurtosis_core_rpc_api_bindings.ApiContainerService_DownloadPersistentDirectoryServer) error {
+	serviceUuid := service.ServiceUUID(args.GetServiceUuid())
+	persistentKey := service_directory.DirectoryPersistentKey(args.GetPersistentKey())
+
+	// The content is written to a temporary file first as its size needs to be known before streaming it back
+	tarFile, err := apicService.writePersistentDirectoryToTmpFile(server.Context(), serviceUuid, persistentKey, persistentDirectoryTmpFilePattern, doNotGzipPersistentDirectory)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing persistent directory '%v' of service '%v' to a temporary file", persistentKey, serviceUuid)
+	}
+	defer func() {
+		tarFile.Close()
+		os.Remove(tarFile.Name())
+	}()
+	fileInfo, err := tarFile.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting persistent directory file at '%s'", tarFile.Name())
+	}
+
+	chunkName := string(persistentKey)
+	serverStream := grpc_file_streaming.NewServerStream[kurtosis_core_rpc_api_bindings.StreamedDataChunk, []byte](server)
+	err = serverStream.SendData(
+		chunkName,
+		tarFile,
+		uint64(fileInfo.Size()),
+		func(previousChunkHash string, contentChunk []byte) (*kurtosis_core_rpc_api_bindings.StreamedDataChunk, error) {
+			return &kurtosis_core_rpc_api_bindings.StreamedDataChunk{
+				Data:              contentChunk,
+				PreviousChunkHash: previousChunkHash,
+				Metadata: &kurtosis_core_rpc_api_bindings.DataChunkMetadata{
+					Name: chunkName,
+				},
+			}, nil
+		},
+	)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred sending persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	return nil
+}
+
+func (apicService ApiContainerService) StoreFilesArtifactFromPersistentDirectory(ctx context.Context, args *kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryArgs) (*kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse, error) {
+	serviceUuid := service.ServiceUUID(args.GetServiceUuid())
+	persistentKey := service_directory.DirectoryPersistentKey(args.GetPersistentKey())
+	artifactName := args.GetName()
+	if strings.TrimSpace(artifactName) == "" {
+		return nil, stacktrace.NewError("Cannot store persistent directory '%v' of service '%v' in a files artifact with an empty name", persistentKey, serviceUuid)
+	}
+
+	// Files artifacts are gzip'd TARs
+	tgzFile, err := apicService.writePersistentDirectoryToTmpFile(ctx, serviceUuid, persistentKey, persistentDirectoryFilesArtifactTmpFilePattern, doGzipPersistentDirectory)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred writing persistent directory '%v' of service '%v' to a temporary file", persistentKey, serviceUuid)
+	}
+	defer func() {
+		tgzFile.Close()
+		os.Remove(tgzFile.Name())
+	}()
+
+	filesArtifactUuid, err := apicService.filesArtifactStore.StoreFile(tgzFile, []byte{}, artifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred storing persistent directory '%v' of service '%v' in files artifact '%v'", persistentKey, serviceUuid, artifactName)
+	}
+	return &kurtosis_core_rpc_api_bindings.StoreFilesArtifactFromPersistentDirectoryResponse{Uuid: string(filesArtifactUuid)}, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper methods
@@ -632,7 +1060,8 @@ func (apicService ApiContainerService) getServiceInfoForIdentifier(ctx context.C
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred getting info for service '%v'", serviceIdentifier)
 	}
-	serviceInfo, err := getServiceInfoFromServiceObj(serviceObj)
+	maybeHealth, _ := apicService.serviceNetwork.GetServiceHealth(serviceObj.GetRegistration().GetName())
+	serviceInfo, err := getServiceInfoFromServiceObj(serviceObj, maybeHealth)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "an error occurred while converting service obj for service with id '%v' to service info", serviceIdentifier)
 	}
@@ -644,6 +1073,7 @@ func (apicService ApiContainerService) runStarlarkPackageSetup(
 	clonePackage bool,
 	moduleContentIfLocal []byte,
 	relativePathToMainFile string,
+	locallyReplacedPackageIds []string,
 ) (string, *startosis_errors.InterpretationError) {
 	var packageRootPathOnDisk string
 	var interpretationError *startosis_errors.InterpretationError
@@ -661,6 +1091,9 @@ func (apicService ApiContainerService) runStarlarkPackageSetup(
 	if interpretationError != nil {
 		return "", interpretationError
 	}
+	if interpretationError = apicService.startosisModuleContentProvider.SetUpRootPackage(packageRootPathOnDisk, locallyReplacedPackageIds); interpretationError != nil {
+		return "", interpretationError
+	}
 
 	pathToMainFile := path.Join(packageRootPathOnDisk, relativePathToMainFile)
 
@@ -676,9 +1109,49 @@ func (apicService ApiContainerService) runStarlarkPackageSetup(
 	return string(mainScriptToExecute), nil
 }
 
+// starlarkToInterpretSetup sets up the package to interpret, or the standalone script if there's no package, and
+// returns the package ID, the path to the main file and the Starlark code to interpret
+func (apicService ApiContainerService) starlarkToInterpretSetup(
+	packageId string,
+	clonePackage bool,
+	relativePathToMainFile string,
+	serializedScript string,
+	locallyReplacedPackageIds []string,
+	gitHosts []*kurtosis_core_rpc_api_bindings.GitHost,
+	isOffline bool,
+) (string, string, string, *startosis_errors.InterpretationError) {
+	if interpretationError := apicService.setUpPackageSources(gitHosts, isOffline); interpretationError != nil {
+		return "", "", "", interpretationError
+	}
+	if packageId == "" {
+		interpretationError := apicService.startosisModuleContentProvider.SetUpRootPackage(noPackageRootPath, nil)
+		return startosis_constants.PackageIdPlaceholderForStandaloneScript, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, serializedScript, interpretationError
+	}
+	if relativePathToMainFile == "" {
+		relativePathToMainFile = startosis_constants.MainFileName
+	}
+	serializedStarlark, interpretationError := apicService.runStarlarkPackageSetup(packageId, clonePackage, nil, relativePathToMainFile, locallyReplacedPackageIds)
+	return packageId, relativePathToMainFile, serializedStarlark, interpretationError
+}
+
+// setUpPackageSources makes the packages of the run about to start get cloned from the given git hosts on top of GitHub,
+// or only taken from the package cache when offline. Every run sets up its own sources, so none of them carries over
+// to the next runs
+func (apicService ApiContainerService) setUpPackageSources(apiGitHosts []*kurtosis_core_rpc_api_bindings.GitHost, isOffline bool) *startosis_errors.InterpretationError {
+	gitHosts := []*startosis_packages.GitHost{}
+	for _, apiGitHost := range apiGitHosts {
+		gitHosts = append(gitHosts, startosis_packages.NewGitHost(apiGitHost.GetHost(), apiGitHost.GetCloneUrlPrefix(), apiGitHost.GetUsername(), apiGitHost.GetToken()))
+	}
+	if err := apicService.startosisModuleContentProvider.ConfigurePackageSources(gitHosts, isOffline); err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "An error occurred setting up where the packages get cloned from")
+	}
+	return nil
+}
+
 func (apicService ApiContainerService) runStarlark(
 	parallelism int,
 	dryRun bool,
+	prune bool,
 	packageId string,
 	mainFunctionName string,
 	relativePathToMainFile string,
@@ -686,34 +1159,104 @@ func (apicService ApiContainerService) runStarlark(
 	serializedParams string,
 	experimentalFeatures []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag,
 	stream grpc.ServerStream,
-) {
-	responseLineStream := apicService.startosisRunner.Run(stream.Context(), dryRun, parallelism, packageId, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, experimentalFeatures)
-	for {
-		select {
-		case <-stream.Context().Done():
-			// TODO: maybe add the ability to kill the execution
-			logrus.Infof("Stream was closed by client. The script ouput won't be returned anymore but note that the execution won't be interrupted. There's currently no way to stop a Kurtosis script execution.")
-			return
-		case responseLine, isChanOpen := <-responseLineStream:
-			if !isChanOpen {
-				// Channel closed means that this function returned, so we won't receive any message through the stream anymore
-				// We expect the stream to be closed soon and the above case to exit that function
-				logrus.Info("Startosis script execution returned, no more output to stream.")
-				return
-			}
-			// in addition to send the msg to the RPC stream, we also print the lines to the APIC logs at debug level
+) error {
+	runId, err := uuid_generator.GenerateUUIDString()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred generating the ID of the Starlark run")
+	}
+	// The run isn't bound to the client stream so that it keeps going if the client disconnects, the client can then
+	// resume streaming its output with ResumeStarlarkRun, or stop it with CancelStarlarkRun
+	runCtx, cancelRun := context.WithCancel(context.Background())
+	runLog := newStarlarkRunLog(runId, cancelRun)
+	apicService.currentStarlarkRunLog.set(runLog)
+
+	responseLineStream := apicService.startosisRunner.Run(runCtx, dryRun, prune, parallelism, packageId, mainFunctionName, relativePathToMainFile, serializedStarlark, serializedParams, experimentalFeatures)
+	go func() {
+		for responseLine := range responseLineStream {
+			// in addition to keep the line in the run log for it to be streamed, we also print the lines to the APIC logs at debug level
 			logrus.Debugf("Received response line from Starlark runner: '%v'", responseLine)
-			if err := stream.SendMsg(responseLine); err != nil {
-				logrus.Errorf("Starlark response line sent through the channel but could not be forwarded to API Container client. Some log lines will not be returned to the user.\nResponse line was: \n%v. Error was: \n%v", responseLine, err.Error())
-			}
+			runLog.appendResponseLine(responseLine)
+		}
+		logrus.Info("Startosis script execution returned, no more output to stream.")
+		runLog.markRunFinished()
+		// releasing the resources of the run context
+		cancelRun()
+	}()
+
+	streamStarlarkRunLog(runLog, noResponseLinesAlreadyReceived, stream)
+	return nil
+}
+
+// writePersistentDirectoryToTmpFile writes the content of a persistent directory of a service to a TAR file, gzipped if asked
+// The returned file is rewound, and it's up to the caller to close and remove it
+func (apicService ApiContainerService) writePersistentDirectoryToTmpFile(
+	ctx context.Context,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	tmpFilePattern string,
+	shouldGzip bool,
+) (*os.File, error) {
+	if strings.TrimSpace(string(serviceUuid)) == "" {
+		return nil, stacktrace.NewError("Cannot get a persistent directory of a service with an empty UUID")
+	}
+	if strings.TrimSpace(string(persistentKey)) == "" {
+		return nil, stacktrace.NewError("Cannot get a persistent directory with an empty persistent key")
+	}
+
+	tmpFile, err := os.CreateTemp("", tmpFilePattern)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating a temporary file to write the persistent directory to")
+	}
+	shouldRemoveTmpFile := true
+	defer func() {
+		if shouldRemoveTmpFile {
+			tmpFile.Close()
+			os.Remove(tmpFile.Name())
 		}
+	}()
+
+	// The backend returns a plain TAR
+	var writer io.Writer = tmpFile
+	var gzipWriter *gzip.Writer
+	if shouldGzip {
+		gzipWriter = gzip.NewWriter(tmpFile)
+		writer = gzipWriter
+	}
+	if err := apicService.kurtosisBackend.CopyFilesFromPersistentDirectory(ctx, apicService.enclaveUuid, serviceUuid, persistentKey, writer); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred copying the content of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	if gzipWriter != nil {
+		if err := gzipWriter.Close(); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred closing the gzip writer of file '%v'", tmpFile.Name())
+		}
+	}
+	if _, err := tmpFile.Seek(0, io.SeekStart); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred rewinding persistent directory file at '%s'", tmpFile.Name())
+	}
+	shouldRemoveTmpFile = false
+	return tmpFile, nil
+}
+
+// streamStarlarkRunLog forwards the response lines of the run to the client until the run finishes or the client
+// closes the stream
+func streamStarlarkRunLog(runLog *starlarkRunLog, startingLineIndex int, stream grpc.ServerStream) {
+	sendResponseLine := func(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) error {
+		return stream.SendMsg(responseLine)
+	}
+	if err := runLog.follow(stream.Context(), startingLineIndex, sendResponseLine); err != nil {
+		logrus.Errorf("Starlark response lines could not be forwarded to API Container client. The client can resume streaming them with ResumeStarlarkRun. Error was: \n%v", err.Error())
+		return
+	}
+	if stream.Context().Err() != nil {
+		logrus.Infof("Stream was closed by client. The script output won't be returned anymore through this stream but note that the execution won't be interrupted. The client can resume streaming it with ResumeStarlarkRun.")
 	}
 }
 
-func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Service) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Service, serviceNetwork service_network.ServiceNetwork) (map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	serviceInfos := map[string]*kurtosis_core_rpc_api_bindings.ServiceInfo{}
 	for uuid, serviceObj := range services {
-		serviceInfo, err := getServiceInfoFromServiceObj(serviceObj)
+		maybeHealth, _ := serviceNetwork.GetServiceHealth(serviceObj.GetRegistration().GetName())
+		serviceInfo, err := getServiceInfoFromServiceObj(serviceObj, maybeHealth)
 		if err != nil {
 			return nil, stacktrace.Propagate(err, "there was an error converting the service obj for service with uuid '%v' and name '%v' to service info", uuid, serviceObj.GetRegistration().GetName())
 		}
@@ -722,7 +1265,8 @@ func getServiceInfosFromServiceObjs(services map[service.ServiceUUID]*service.Se
 	return serviceInfos, nil
 }
 
-func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
+// maybeHealth is nil if the health of the service is not monitored
+func getServiceInfoFromServiceObj(serviceObj *service.Service, maybeHealth *service_health.ServiceHealth) (*kurtosis_core_rpc_api_bindings.ServiceInfo, error) {
 	privatePorts := serviceObj.GetPrivatePorts()
 	privateIp := serviceObj.GetRegistration().GetPrivateIP()
 	maybePublicIp := serviceObj.GetMaybePublicIP()
@@ -759,6 +1303,7 @@ func getServiceInfoFromServiceObj(serviceObj *service.Service) (*kurtosis_core_r
 		publicIpAddrStr,
 		publicApiPorts,
 		serviceStatus,
+		convertServiceHealthToApiServiceHealth(maybeHealth),
 	)
 	return serviceInfoResponse, nil
 }
@@ -835,3 +1380,45 @@ func convertServiceStatusToServiceInfoStatus(serviceStatus service.ServiceStatus
 		return kurtosis_core_rpc_api_bindings.ServiceStatus_UNKNOWN, stacktrace.NewError("Failed to convert service status %v", serviceStatus)
 	}
 }
+
+func convertServiceHealthToApiServiceHealth(maybeHealth *service_health.ServiceHealth) *kurtosis_core_rpc_api_bindings.ServiceHealth {
+	if maybeHealth == nil {
+		return nil
+	}
+	status := kurtosis_core_rpc_api_bindings.ServiceHealthStatus_HEALTHY
+	if maybeHealth.GetStatus() == service_health.HealthStatus_Unhealthy {
+		status = kurtosis_core_rpc_api_bindings.ServiceHealthStatus_UNHEALTHY
+	}
+	var lastFailureTimestampMs int64
+	if !maybeHealth.GetLastFailureTime().IsZero() {
+		lastFailureTimestampMs = maybeHealth.GetLastFailureTime().UnixMilli()
+	}
+	return binding_constructors.NewServiceHealth(status, maybeHealth.GetNumRestarts(), maybeHealth.GetLastFailureReason(), lastFailureTimestampMs)
+}
+
+// transformApiPartitionConnectionInfo converts the connection sent over the API into a partition connection. A missing
+// connection is treated as unconditioned
+func transformApiPartitionConnectionInfo(apiConnectionInfo *kurtosis_core_rpc_api_bindings.PartitionConnectionInfo) (partition_topology.PartitionConnection, error) {
+	if apiConnectionInfo == nil {
+		return partition_topology.ConnectionAllowed, nil
+	}
+	packetLossPercentage := apiConnectionInfo.GetPacketLossPercentage()
+	if packetLossPercentage < minPercentage || packetLossPercentage > maxPercentage {
+		return partition_topology.PartitionConnection{}, stacktrace.NewError("Packet loss percentage should be between %v and %v but was '%v'", minPercentage, maxPercentage, packetLossPercentage)
+	}
+	delayCorrelation := apiConnectionInfo.GetDelayCorrelation()
+	if delayCorrelation < minPercentage || delayCorrelation > maxPercentage {
+		return partition_topology.PartitionConnection{}, stacktrace.NewError("Delay correlation should be between %v and %v but was '%v'", minPercentage, maxPercentage, delayCorrelation)
+	}
+
+	var delayDistribution partition_topology.PacketDelayDistribution
+	switch apiConnectionInfo.GetDelayDistribution() {
+	case kurtosis_core_rpc_api_bindings.PacketDelayDistribution_UNIFORM:
+		delayDistribution = partition_topology.NewUniformPacketDelayDistribution(apiConnectionInfo.GetDelayMs(), apiConnectionInfo.GetJitterMs())
+	case kurtosis_core_rpc_api_bindings.PacketDelayDistribution_NORMAL:
+		delayDistribution = partition_topology.NewNormalPacketDelayDistribution(apiConnectionInfo.GetDelayMs(), apiConnectionInfo.GetJitterMs(), delayCorrelation)
+	default:
+		return partition_topology.PartitionConnection{}, stacktrace.NewError("Unrecognized packet delay distribution '%v'", apiConnectionInfo.GetDelayDistribution())
+	}
+	return partition_topology.NewPartitionConnection(packetLossPercentage, delayDistribution), nil
+}
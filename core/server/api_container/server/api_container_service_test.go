@@ -6,12 +6,25 @@
 package server
 
 import (
+	"context"
+	"errors"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/emptypb"
 	"testing"
 )
 
+const (
+	testEnclaveUuid = enclave.EnclaveUUID("test-enclave-uuid")
+	testServiceUuid = service.ServiceUUID("test-service-uuid")
+)
+
 func TestOneToOneApiAndPortSpecProtoMapping(t *testing.T) {
 	// Ensure all port spec protos are covered
 	require.Equal(t, len(kurtosis_core_rpc_api_bindings.Port_TransportProtocol_name), len(apiContainerPortProtoToPortSpecPortProto))
@@ -35,3 +48,73 @@ func TestOneToOneApiAndPortSpecProtoMapping(t *testing.T) {
 		seenPortSpecProtos[portSpecProto] = apiPortProto
 	}
 }
+
+func TestListPersistentDirectories_SortedByServiceUuidAndPersistentKey(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	backend.EXPECT().GetPersistentDirectories(ctx, testEnclaveUuid).Return(map[service.ServiceUUID]map[service_directory.DirectoryPersistentKey]bool{
+		"service-b": {"data": true},
+		"service-a": {"logs": true, "data": true},
+	}, nil)
+	apicService := ApiContainerService{kurtosisBackend: backend, enclaveUuid: testEnclaveUuid} //nolint:exhaustruct
+
+	response, err := apicService.ListPersistentDirectories(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+
+	persistentDirectories := [][]string{}
+	for _, persistentDirectory := range response.GetPersistentDirectories() {
+		persistentDirectories = append(persistentDirectories, []string{persistentDirectory.GetServiceUuid(), persistentDirectory.GetPersistentKey()})
+	}
+	require.Equal(t, [][]string{{"service-a", "data"}, {"service-a", "logs"}, {"service-b", "data"}}, persistentDirectories)
+}
+
+func TestRemovePersistentDirectories_FailsListingRemovedPersistentKeys(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	persistentKeys := map[service_directory.DirectoryPersistentKey]bool{"data": true, "logs": true}
+	backend.EXPECT().DestroyPersistentDirectories(ctx, testEnclaveUuid, testServiceUuid, persistentKeys).Return(
+		map[service_directory.DirectoryPersistentKey]bool{"data": true},
+		map[service_directory.DirectoryPersistentKey]error{"logs": errors.New("volume in use")},
+		nil,
+	)
+	apicService := ApiContainerService{kurtosisBackend: backend, enclaveUuid: testEnclaveUuid} //nolint:exhaustruct
+
+	_, err := apicService.RemovePersistentDirectories(ctx, &kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs{
+		ServiceUuid:    string(testServiceUuid),
+		PersistentKeys: []string{"data", "logs"},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "persistent directories '[data]' were removed")
+	require.Contains(t, err.Error(), "An error occurred removing persistent directory 'logs':\nvolume in use")
+}
+
+func TestRemovePersistentDirectories_FailsForEmptyServiceUuid(t *testing.T) {
+	apicService := ApiContainerService{} //nolint:exhaustruct
+
+	_, err := apicService.RemovePersistentDirectories(context.Background(), &kurtosis_core_rpc_api_bindings.RemovePersistentDirectoriesArgs{
+		ServiceUuid:    "",
+		PersistentKeys: []string{"data"},
+	})
+	require.Error(t, err)
+}
+
+func TestGetStarlarkRunPlan_SetsUpThePackageSourcesOfTheRun(t *testing.T) {
+	packageContentProvider := startosis_packages.NewMockPackageContentProvider(t)
+	packageContentProvider.EXPECT().ConfigurePackageSources(
+		[]*startosis_packages.GitHost{startosis_packages.NewGitHost("gitlab.com", "https://gitlab.com", "user", "token")},
+		true,
+	).Return(errors.New("package cache not shared"))
+	apicService := ApiContainerService{startosisModuleContentProvider: packageContentProvider} //nolint:exhaustruct
+
+	response, err := apicService.GetStarlarkRunPlan(context.Background(), &kurtosis_core_rpc_api_bindings.GetStarlarkRunPlanArgs{ //nolint:exhaustruct
+		SerializedScript: "def run(plan):\n    pass",
+		GitHosts: []*kurtosis_core_rpc_api_bindings.GitHost{
+			{Host: "gitlab.com", CloneUrlPrefix: "https://gitlab.com", Username: "user", Token: "token"},
+		},
+		Offline: true,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, response.GetInterpretationError())
+	require.Contains(t, response.GetInterpretationError().GetErrorMessage(), "package cache not shared")
+	require.Empty(t, response.GetSerializedPlan())
+}
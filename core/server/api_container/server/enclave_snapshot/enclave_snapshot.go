@@ -0,0 +1,473 @@
+package enclave_snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	// Bump this whenever the layout of the snapshot archive changes in a non-backward compatible way
+	snapshotFormatVersion = 1
+
+	// The metadata entry is always written first, so that the files artifact entries can be restored while being read
+	metadataEntryName            = "metadata.json"
+	enclavePlanEntryName         = "enclave-plan.json"
+	enclaveDbEntryName           = "enclave-db.json"
+	filesArtifactEntryNameFormat = "files-artifacts/%s.tgz"
+
+	// Persistent directories are stored as files artifacts with a reserved name, which are then used to seed the
+	// persistent directories when the services get recreated
+	persistentDirectoryFilesArtifactNameFormat = "persistent-directory--%s--%s"
+	persistentDirectoryFilenameFormat          = "%s.tgz"
+
+	snapshotEntryPerms                = 0644
+	persistentDirectoryFilePerms      = 0644
+	persistentDirectoryOpenFlags      = os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	persistentDirectoriesTmpDirPrefix = "enclave-snapshot-persistent-directories-"
+)
+
+type snapshotMetadata struct {
+	FormatVersion int `json:"formatVersion"`
+
+	CreationTime time.Time `json:"creationTime"`
+
+	FilesArtifacts []*snapshotFilesArtifact `json:"filesArtifacts"`
+
+	PersistentDirectories []*snapshotPersistentDirectory `json:"persistentDirectories"`
+}
+
+type snapshotFilesArtifact struct {
+	Name string `json:"name"`
+
+	Md5 []byte `json:"md5"`
+
+	EntryName string `json:"entryName"`
+}
+
+type snapshotPersistentDirectory struct {
+	ServiceName string `json:"serviceName"`
+
+	PersistentKey string `json:"persistentKey"`
+
+	// name of the files artifact containing the content of the persistent directory
+	FilesArtifactName string `json:"filesArtifactName"`
+}
+
+// mapping between the snapshot archive entry name and the absolute path of the file to write in it
+type snapshotFileEntry struct {
+	entryName string
+
+	absoluteFilepath string
+}
+
+// WriteSnapshot writes a gzip'd TAR archive containing the enclave plan, the enclave database, all the files artifacts
+// and optionally the content of the persistent directories of the enclave to the output
+func WriteSnapshot(
+	ctx context.Context,
+	output io.Writer,
+	enclaveDb *enclave_db.EnclaveDB,
+	filesArtifactStore *enclave_data_directory.FilesArtifactStore,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	includePersistentDirectories bool,
+) error {
+	enclavePlan, err := enclave_plan_persistence.Load(enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred loading the enclave plan")
+	}
+	serializedEnclavePlan, err := json.Marshal(enclavePlan)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the enclave plan")
+	}
+
+	enclaveDbDump, err := dumpEnclaveDb(enclaveDb)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred dumping the content of the enclave database")
+	}
+	serializedEnclaveDbDump, err := json.Marshal(enclaveDbDump)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the content of the enclave database")
+	}
+
+	metadata := &snapshotMetadata{
+		FormatVersion:         snapshotFormatVersion,
+		CreationTime:          time.Now(),
+		FilesArtifacts:        []*snapshotFilesArtifact{},
+		PersistentDirectories: []*snapshotPersistentDirectory{},
+	}
+	fileEntries := []*snapshotFileEntry{}
+
+	filesArtifactNamesAndUuids := filesArtifactStore.GetFileNamesAndUuids()
+	sort.Slice(filesArtifactNamesAndUuids, func(i, j int) bool {
+		return filesArtifactNamesAndUuids[i].GetName() < filesArtifactNamesAndUuids[j].GetName()
+	})
+	for _, filesArtifactNameAndUuid := range filesArtifactNamesAndUuids {
+		filesArtifactUuid := string(filesArtifactNameAndUuid.GetUuid())
+		_, filesArtifact, filesArtifactMd5, found, err := filesArtifactStore.GetFile(filesArtifactUuid)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", filesArtifactUuid)
+		}
+		if !found {
+			return stacktrace.NewError("Files artifact '%v' was listed in the files artifact store but couldn't be found", filesArtifactUuid)
+		}
+		entryName := fmt.Sprintf(filesArtifactEntryNameFormat, filesArtifactUuid)
+		metadata.FilesArtifacts = append(metadata.FilesArtifacts, &snapshotFilesArtifact{
+			Name:      filesArtifactNameAndUuid.GetName(),
+			Md5:       filesArtifactMd5,
+			EntryName: entryName,
+		})
+		fileEntries = append(fileEntries, &snapshotFileEntry{
+			entryName:        entryName,
+			absoluteFilepath: filesArtifact.GetAbsoluteFilepath(),
+		})
+	}
+
+	if includePersistentDirectories {
+		tmpDirpath, err := os.MkdirTemp("", persistentDirectoriesTmpDirPrefix)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a temporary directory to export the persistent directories to")
+		}
+		defer os.RemoveAll(tmpDirpath)
+
+		persistentDirectories, persistentDirectoryFilesArtifacts, persistentDirectoryFileEntries, err := exportPersistentDirectories(ctx, kurtosisBackend, enclaveUuid, tmpDirpath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred exporting the persistent directories of enclave '%v'", enclaveUuid)
+		}
+		metadata.PersistentDirectories = persistentDirectories
+		metadata.FilesArtifacts = append(metadata.FilesArtifacts, persistentDirectoryFilesArtifacts...)
+		fileEntries = append(fileEntries, persistentDirectoryFileEntries...)
+	}
+
+	serializedMetadata, err := json.Marshal(metadata)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred serializing the snapshot metadata")
+	}
+
+	gzipWriter := gzip.NewWriter(output)
+	tarWriter := tar.NewWriter(gzipWriter)
+	if err := writeBytesEntry(tarWriter, metadataEntryName, serializedMetadata); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the snapshot metadata")
+	}
+	if err := writeBytesEntry(tarWriter, enclavePlanEntryName, serializedEnclavePlan); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the enclave plan to the snapshot")
+	}
+	if err := writeBytesEntry(tarWriter, enclaveDbEntryName, serializedEnclaveDbDump); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of the enclave database to the snapshot")
+	}
+	for _, fileEntry := range fileEntries {
+		if err := writeFileEntry(tarWriter, fileEntry.entryName, fileEntry.absoluteFilepath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred writing file '%v' to the snapshot", fileEntry.absoluteFilepath)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the snapshot TAR writer")
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the snapshot gzip writer")
+	}
+	return nil
+}
+
+// RestoreSnapshot restores the files artifacts and the runtime values of the snapshot read from the input into the
+// enclave, and returns the Starlark script recreating the services of the snapshotted enclave along with the number of
+// restored files artifacts.
+// Services aren't restored directly as they need to be started by the backend the enclave is running on, which might
+// be different from the one the snapshot was taken on
+func RestoreSnapshot(
+	input io.Reader,
+	enclaveDb *enclave_db.EnclaveDB,
+	filesArtifactStore *enclave_data_directory.FilesArtifactStore,
+) (string, int, error) {
+	currentEnclavePlan, err := enclave_plan_persistence.Load(enclaveDb)
+	if err != nil {
+		return "", 0, stacktrace.Propagate(err, "An error occurred loading the current enclave plan")
+	}
+	if currentEnclavePlan.Size() > 0 {
+		return "", 0, stacktrace.NewError("Snapshots can only be restored into empty enclaves, but this enclave already ran '%v' instructions", currentEnclavePlan.Size())
+	}
+
+	gzipReader, err := gzip.NewReader(input)
+	if err != nil {
+		return "", 0, stacktrace.Propagate(err, "An error occurred creating a gzip reader for the snapshot; is it a valid enclave snapshot?")
+	}
+	defer gzipReader.Close()
+	tarReader := tar.NewReader(gzipReader)
+
+	var metadata *snapshotMetadata
+	var enclavePlan *enclave_plan_persistence.EnclavePlan
+	var enclaveDbDump map[string]map[string][]byte
+	filesArtifactsByEntryName := map[string]*snapshotFilesArtifact{}
+	numRestoredFilesArtifacts := 0
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return "", 0, stacktrace.Propagate(err, "An error occurred reading the next entry of the snapshot")
+		}
+
+		switch header.Name {
+		case metadataEntryName:
+			metadata = new(snapshotMetadata)
+			if err := json.NewDecoder(tarReader).Decode(metadata); err != nil {
+				return "", 0, stacktrace.Propagate(err, "An error occurred deserializing the snapshot metadata")
+			}
+			if metadata.FormatVersion != snapshotFormatVersion {
+				return "", 0, stacktrace.NewError("The snapshot format version is '%v' but only version '%v' is supported by this version of Kurtosis", metadata.FormatVersion, snapshotFormatVersion)
+			}
+			for _, filesArtifact := range metadata.FilesArtifacts {
+				filesArtifactsByEntryName[filesArtifact.EntryName] = filesArtifact
+			}
+		case enclavePlanEntryName:
+			enclavePlan = new(enclave_plan_persistence.EnclavePlan)
+			if err := json.NewDecoder(tarReader).Decode(enclavePlan); err != nil {
+				return "", 0, stacktrace.Propagate(err, "An error occurred deserializing the snapshot enclave plan")
+			}
+		case enclaveDbEntryName:
+			if err := json.NewDecoder(tarReader).Decode(&enclaveDbDump); err != nil {
+				return "", 0, stacktrace.Propagate(err, "An error occurred deserializing the snapshot enclave database content")
+			}
+		default:
+			if metadata == nil {
+				return "", 0, stacktrace.NewError("Entry '%v' was found before the metadata in the snapshot; is it a valid enclave snapshot?", header.Name)
+			}
+			filesArtifact, found := filesArtifactsByEntryName[header.Name]
+			if !found {
+				return "", 0, stacktrace.NewError("Entry '%v' of the snapshot doesn't match any files artifact of the snapshot metadata", header.Name)
+			}
+			if _, err := filesArtifactStore.StoreFile(tarReader, filesArtifact.Md5, filesArtifact.Name); err != nil {
+				return "", 0, stacktrace.Propagate(err, "An error occurred storing files artifact '%v' from the snapshot", filesArtifact.Name)
+			}
+			numRestoredFilesArtifacts += 1
+		}
+	}
+
+	if metadata == nil || enclavePlan == nil || enclaveDbDump == nil {
+		return "", 0, stacktrace.NewError("The snapshot is incomplete, it should contain entries '%v', '%v' and '%v'", metadataEntryName, enclavePlanEntryName, enclaveDbEntryName)
+	}
+	if numRestoredFilesArtifacts != len(metadata.FilesArtifacts) {
+		return "", 0, stacktrace.NewError("The snapshot metadata references '%v' files artifacts but '%v' were found in it", len(metadata.FilesArtifacts), numRestoredFilesArtifacts)
+	}
+
+	// Only the runtime values are restored; service registrations, IP addresses and files artifacts are recreated
+	// while the replay script runs
+	if err := restoreEnclaveDbBuckets(enclaveDb, enclaveDbDump, runtime_value_store.GetPersistedBucketNames()); err != nil {
+		return "", 0, stacktrace.Propagate(err, "An error occurred restoring the runtime values of the snapshot")
+	}
+
+	replayScript := generateReplayScript(enclavePlan, metadata.PersistentDirectories)
+	return replayScript, numRestoredFilesArtifacts, nil
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func exportPersistentDirectories(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	destDirpath string,
+) ([]*snapshotPersistentDirectory, []*snapshotFilesArtifact, []*snapshotFileEntry, error) {
+	allServicesFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    nil,
+		Statuses: nil,
+	}
+	services, err := kurtosisBackend.GetUserServices(ctx, enclaveUuid, allServicesFilters)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the services of enclave '%v'", enclaveUuid)
+	}
+	persistentKeysByServiceUuid, err := kurtosisBackend.GetPersistentDirectories(ctx, enclaveUuid)
+	if err != nil {
+		return nil, nil, nil, stacktrace.Propagate(err, "An error occurred getting the persistent directories of enclave '%v'", enclaveUuid)
+	}
+
+	persistentDirectories := []*snapshotPersistentDirectory{}
+	filesArtifacts := []*snapshotFilesArtifact{}
+	fileEntries := []*snapshotFileEntry{}
+	for serviceUuid, persistentKeys := range persistentKeysByServiceUuid {
+		serviceObj, found := services[serviceUuid]
+		if !found {
+			logrus.Warnf("Persistent directories of service '%v' won't be added to the snapshot as this service doesn't exist in the enclave anymore", serviceUuid)
+			continue
+		}
+		serviceName := string(serviceObj.GetRegistration().GetName())
+		for persistentKey := range persistentKeys {
+			filesArtifactName := fmt.Sprintf(persistentDirectoryFilesArtifactNameFormat, serviceName, persistentKey)
+			filepath := path.Join(destDirpath, fmt.Sprintf(persistentDirectoryFilenameFormat, filesArtifactName))
+			if err := writePersistentDirectoryToFile(ctx, kurtosisBackend, enclaveUuid, serviceUuid, persistentKey, filepath); err != nil {
+				return nil, nil, nil, stacktrace.Propagate(err, "An error occurred exporting persistent directory '%v' of service '%v'", persistentKey, serviceName)
+			}
+			entryName := fmt.Sprintf(filesArtifactEntryNameFormat, filesArtifactName)
+			persistentDirectories = append(persistentDirectories, &snapshotPersistentDirectory{
+				ServiceName:       serviceName,
+				PersistentKey:     string(persistentKey),
+				FilesArtifactName: filesArtifactName,
+			})
+			filesArtifacts = append(filesArtifacts, &snapshotFilesArtifact{
+				Name:      filesArtifactName,
+				Md5:       []byte{},
+				EntryName: entryName,
+			})
+			fileEntries = append(fileEntries, &snapshotFileEntry{
+				entryName:        entryName,
+				absoluteFilepath: filepath,
+			})
+		}
+	}
+	return persistentDirectories, filesArtifacts, fileEntries, nil
+}
+
+func writePersistentDirectoryToFile(
+	ctx context.Context,
+	kurtosisBackend backend_interface.KurtosisBackend,
+	enclaveUuid enclave.EnclaveUUID,
+	serviceUuid service.ServiceUUID,
+	persistentKey service_directory.DirectoryPersistentKey,
+	filepath string,
+) error {
+	file, err := os.OpenFile(filepath, persistentDirectoryOpenFlags, persistentDirectoryFilePerms)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%v'", filepath)
+	}
+	defer file.Close()
+
+	// The backend returns a plain TAR while files artifacts are expected to be gzip'd
+	gzipWriter := gzip.NewWriter(file)
+	if err := kurtosisBackend.CopyFilesFromPersistentDirectory(ctx, enclaveUuid, serviceUuid, persistentKey, gzipWriter); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the content of persistent directory '%v' of service '%v'", persistentKey, serviceUuid)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return stacktrace.Propagate(err, "An error occurred closing the gzip writer of file '%v'", filepath)
+	}
+	return nil
+}
+
+func dumpEnclaveDb(enclaveDb *enclave_db.EnclaveDB) (map[string]map[string][]byte, error) {
+	enclaveDbDump := map[string]map[string][]byte{}
+	err := enclaveDb.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(bucketName []byte, bucket *bolt.Bucket) error {
+			bucketDump := map[string][]byte{}
+			err := bucket.ForEach(func(key []byte, value []byte) error {
+				// nested buckets have a nil value, they're not used by Kurtosis
+				if value == nil {
+					return nil
+				}
+				// values are only valid for the life of the transaction, so they need to be copied
+				bucketDump[string(key)] = append([]byte{}, value...)
+				return nil
+			})
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred dumping bucket '%v'", string(bucketName))
+			}
+			enclaveDbDump[string(bucketName)] = bucketDump
+			return nil
+		})
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred reading the enclave database")
+	}
+	return enclaveDbDump, nil
+}
+
+func restoreEnclaveDbBuckets(enclaveDb *enclave_db.EnclaveDB, enclaveDbDump map[string]map[string][]byte, bucketNamesToRestore []string) error {
+	err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		for _, bucketName := range bucketNamesToRestore {
+			bucketDump, found := enclaveDbDump[bucketName]
+			if !found {
+				continue
+			}
+			bucket, err := tx.CreateBucketIfNotExists([]byte(bucketName))
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred getting or creating bucket '%v'", bucketName)
+			}
+			for key, value := range bucketDump {
+				if err := bucket.Put([]byte(key), value); err != nil {
+					return stacktrace.Propagate(err, "An error occurred restoring key '%v' of bucket '%v'", key, bucketName)
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing to the enclave database")
+	}
+	return nil
+}
+
+func writeBytesEntry(tarWriter *tar.Writer, entryName string, content []byte) error {
+	header := newRegularFileEntryHeader(entryName, int64(len(content)), time.Now())
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of entry '%v'", entryName)
+	}
+	if _, err := tarWriter.Write(content); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of entry '%v'", entryName)
+	}
+	return nil
+}
+
+func writeFileEntry(tarWriter *tar.Writer, entryName string, filepath string) error {
+	file, err := os.Open(filepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening file '%v'", filepath)
+	}
+	defer file.Close()
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred inspecting file '%v'", filepath)
+	}
+
+	header := newRegularFileEntryHeader(entryName, fileInfo.Size(), fileInfo.ModTime())
+	if err := tarWriter.WriteHeader(header); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the header of entry '%v'", entryName)
+	}
+	if _, err := io.Copy(tarWriter, file); err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the content of entry '%v'", entryName)
+	}
+	return nil
+}
+
+func newRegularFileEntryHeader(entryName string, size int64, modTime time.Time) *tar.Header {
+	return &tar.Header{
+		Typeflag:   tar.TypeReg,
+		Name:       entryName,
+		Linkname:   "",
+		Size:       size,
+		Mode:       snapshotEntryPerms,
+		Uid:        0,
+		Gid:        0,
+		Uname:      "",
+		Gname:      "",
+		ModTime:    modTime,
+		AccessTime: time.Time{},
+		ChangeTime: time.Time{},
+		Devmajor:   0,
+		Devminor:   0,
+		Xattrs:     nil,
+		PAXRecords: nil,
+		Format:     tar.FormatUnknown,
+	}
+}
@@ -0,0 +1,120 @@
+package enclave_snapshot
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/test_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	testFilesArtifactName    = "static-files"
+	testFilesArtifactContent = "not really a TGZ but good enough for the store"
+
+	testRuntimeValueKey   = "abcd12a3948149d9afa2ef93abb4ec52"
+	testRuntimeValueValue = `{"ip_address": "172.16.0.4"}`
+
+	testEnclaveUuid = enclave.EnclaveUUID("enclave-uuid")
+)
+
+var testFilesArtifactMd5 = []byte("md5")
+
+func TestWriteAndRestoreSnapshot(t *testing.T) {
+	sourceEnclaveDb, closeSourceEnclaveDb := getEnclaveDbForTest(t)
+	defer closeSourceEnclaveDb()
+	sourceFilesArtifactStore := getFilesArtifactStoreForTest(t, sourceEnclaveDb)
+
+	_, err := sourceFilesArtifactStore.StoreFile(strings.NewReader(testFilesArtifactContent), testFilesArtifactMd5, testFilesArtifactName)
+	require.NoError(t, err)
+	enclavePlan := newEnclavePlanForTest(
+		newEnclavePlanInstructionForTest("upload_files", uploadFilesCode),
+		newEnclavePlanInstructionForTest("add_service", addServiceCode, "db"),
+	)
+	require.NoError(t, enclavePlan.Persist(sourceEnclaveDb))
+	runtimeValueBucketName := runtime_value_store.GetPersistedBucketNames()[0]
+	err = sourceEnclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists([]byte(runtimeValueBucketName))
+		require.NoError(t, err)
+		return bucket.Put([]byte(testRuntimeValueKey), []byte(testRuntimeValueValue))
+	})
+	require.NoError(t, err)
+
+	snapshot := &bytes.Buffer{}
+	doNotIncludePersistentDirectories := false
+	err = WriteSnapshot(context.Background(), snapshot, sourceEnclaveDb, sourceFilesArtifactStore, nil, testEnclaveUuid, doNotIncludePersistentDirectories)
+	require.NoError(t, err)
+
+	destEnclaveDb, closeDestEnclaveDb := getEnclaveDbForTest(t)
+	defer closeDestEnclaveDb()
+	destFilesArtifactStore := getFilesArtifactStoreForTest(t, destEnclaveDb)
+
+	replayScript, numRestoredFilesArtifacts, err := RestoreSnapshot(snapshot, destEnclaveDb, destFilesArtifactStore)
+	require.NoError(t, err)
+	require.Equal(t, 1, numRestoredFilesArtifacts)
+	require.Equal(t, "def run(plan):\n    plan."+addServiceCode+"\n", replayScript)
+
+	_, restoredFilesArtifact, restoredFilesArtifactMd5, found, err := destFilesArtifactStore.GetFile(testFilesArtifactName)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, testFilesArtifactMd5, restoredFilesArtifactMd5)
+	restoredFilesArtifactContent, err := os.ReadFile(restoredFilesArtifact.GetAbsoluteFilepath())
+	require.NoError(t, err)
+	require.Equal(t, testFilesArtifactContent, string(restoredFilesArtifactContent))
+
+	err = destEnclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket([]byte(runtimeValueBucketName))
+		require.NotNil(t, bucket)
+		require.Equal(t, testRuntimeValueValue, string(bucket.Get([]byte(testRuntimeValueKey))))
+		return nil
+	})
+	require.NoError(t, err)
+}
+
+func TestRestoreSnapshot_FailsOnNonEmptyEnclave(t *testing.T) {
+	enclaveDb, closeEnclaveDb := getEnclaveDbForTest(t)
+	defer closeEnclaveDb()
+	filesArtifactStore := getFilesArtifactStoreForTest(t, enclaveDb)
+
+	enclavePlan := newEnclavePlanForTest(
+		newEnclavePlanInstructionForTest("add_service", addServiceCode, "db"),
+	)
+	require.NoError(t, enclavePlan.Persist(enclaveDb))
+
+	_, _, err := RestoreSnapshot(strings.NewReader(""), enclaveDb, filesArtifactStore)
+	require.ErrorContains(t, err, "Snapshots can only be restored into empty enclaves")
+}
+
+func TestRestoreSnapshot_FailsOnInvalidSnapshot(t *testing.T) {
+	enclaveDb, closeEnclaveDb := getEnclaveDbForTest(t)
+	defer closeEnclaveDb()
+	filesArtifactStore := getFilesArtifactStoreForTest(t, enclaveDb)
+
+	_, _, err := RestoreSnapshot(strings.NewReader("not a snapshot"), enclaveDb, filesArtifactStore)
+	require.Error(t, err)
+}
+
+func getEnclaveDbForTest(t *testing.T) (*enclave_db.EnclaveDB, func()) {
+	enclaveDb, closer, err := test_helpers.CreateEnclaveDbForTesting()
+	require.NoError(t, err)
+	return enclaveDb, closer
+}
+
+func getFilesArtifactStoreForTest(t *testing.T, enclaveDb *enclave_db.EnclaveDB) *enclave_data_directory.FilesArtifactStore {
+	absDirpath := t.TempDir()
+	fileArtifactDb, err := file_artifacts_db.GetFileArtifactsDbForTesting(enclaveDb, map[string]string{})
+	require.NoError(t, err)
+	maxRetries := 3
+	return enclave_data_directory.NewFilesArtifactStoreForTesting(absDirpath, "", fileArtifactDb, maxRetries, func() string {
+		return "generated-name"
+	})
+}
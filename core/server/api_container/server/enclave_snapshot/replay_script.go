@@ -0,0 +1,97 @@
+package enclave_snapshot
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/store_service_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/tasks"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/upload_files"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	replayScriptHeader            = "def run(plan):\n"
+	replayScriptInstructionFormat = "    plan.%s\n"
+	replayScriptEmptyBody         = "    pass\n"
+
+	persistentKeyRegexpGroupIdx = 1
+)
+
+// Instructions producing files artifacts are not replayed, the files artifacts they produced are restored directly
+// from the snapshot instead
+var instructionTypesNotReplayed = map[string]bool{
+	upload_files.UploadFilesBuiltinName:              true,
+	render_templates.RenderTemplatesBuiltinName:      true,
+	store_service_files.StoreServiceFilesBuiltinName: true,
+	tasks.RunShBuiltinName:                           true,
+	tasks.RunPythonBuiltinName:                       true,
+}
+
+// Matches a persistent Directory, optionally already seeded with a files artifact, as serialized in the enclave plan
+var persistentDirectoryRegexp = regexp.MustCompile(fmt.Sprintf(
+	`%s\((?:%s="[^"]*", )?%s="([^"]*)"`,
+	directory.DirectoryTypeName,
+	directory.ArtifactNameAttr,
+	directory.PersistentKeyAttr,
+))
+
+// generateReplayScript turns the enclave plan into a Starlark script recreating the services of the enclave. The
+// persistent directories that were exported with the snapshot get seeded with their files artifact
+func generateReplayScript(enclavePlan *enclave_plan_persistence.EnclavePlan, persistentDirectories []*snapshotPersistentDirectory) string {
+	persistentDirectoriesByServiceName := map[string][]*snapshotPersistentDirectory{}
+	for _, persistentDirectory := range persistentDirectories {
+		persistentDirectoriesByServiceName[persistentDirectory.ServiceName] = append(persistentDirectoriesByServiceName[persistentDirectory.ServiceName], persistentDirectory)
+	}
+
+	replayScript := strings.Builder{}
+	replayScript.WriteString(replayScriptHeader)
+	numReplayedInstructions := 0
+	for _, instruction := range enclavePlan.GeneratePlan() {
+		if instructionTypesNotReplayed[instruction.Type] {
+			continue
+		}
+		starlarkCode := seedPersistentDirectories(instruction, persistentDirectoriesByServiceName)
+		replayScript.WriteString(fmt.Sprintf(replayScriptInstructionFormat, starlarkCode))
+		numReplayedInstructions += 1
+	}
+	if numReplayedInstructions == 0 {
+		replayScript.WriteString(replayScriptEmptyBody)
+	}
+	return replayScript.String()
+}
+
+func seedPersistentDirectories(instruction *enclave_plan_persistence.EnclavePlanInstruction, persistentDirectoriesByServiceName map[string][]*snapshotPersistentDirectory) string {
+	filesArtifactNamesByPersistentKey := map[string]map[string]bool{}
+	for _, serviceName := range instruction.ServiceNames {
+		for _, persistentDirectory := range persistentDirectoriesByServiceName[serviceName] {
+			if _, found := filesArtifactNamesByPersistentKey[persistentDirectory.PersistentKey]; !found {
+				filesArtifactNamesByPersistentKey[persistentDirectory.PersistentKey] = map[string]bool{}
+			}
+			filesArtifactNamesByPersistentKey[persistentDirectory.PersistentKey][persistentDirectory.FilesArtifactName] = true
+		}
+	}
+	if len(filesArtifactNamesByPersistentKey) == 0 {
+		return instruction.StarlarkCode
+	}
+
+	return persistentDirectoryRegexp.ReplaceAllStringFunc(instruction.StarlarkCode, func(persistentDirectoryStr string) string {
+		persistentKey := persistentDirectoryRegexp.FindStringSubmatch(persistentDirectoryStr)[persistentKeyRegexpGroupIdx]
+		filesArtifactNames := filesArtifactNamesByPersistentKey[persistentKey]
+		if len(filesArtifactNames) != 1 {
+			if len(filesArtifactNames) > 1 {
+				logrus.Warnf("Persistent directory '%v' is shared by several services of instruction '%v', it will be recreated empty", persistentKey, instruction.Uuid)
+			}
+			return persistentDirectoryStr
+		}
+		var filesArtifactName string
+		for name := range filesArtifactNames {
+			filesArtifactName = name
+		}
+		return fmt.Sprintf(`%s(%s="%s", %s="%s"`, directory.DirectoryTypeName, directory.ArtifactNameAttr, filesArtifactName, directory.PersistentKeyAttr, persistentKey)
+	})
+}
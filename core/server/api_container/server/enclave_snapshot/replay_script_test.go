@@ -0,0 +1,100 @@
+package enclave_snapshot
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	addServiceCode  = `add_service(name="db", config=ServiceConfig(image="postgres", files={"/data": Directory(persistent_key="db-data")}))`
+	uploadFilesCode = `upload_files(src="github.com/sample/sample-package/static", name="static-files")`
+	execCode        = `exec(service_name="db", recipe=ExecRecipe(command=["echo", "hello"]))`
+)
+
+func TestGenerateReplayScript_SkipsInstructionsProducingFilesArtifacts(t *testing.T) {
+	enclavePlan := newEnclavePlanForTest(
+		newEnclavePlanInstructionForTest("upload_files", uploadFilesCode),
+		newEnclavePlanInstructionForTest("add_service", addServiceCode, "db"),
+		newEnclavePlanInstructionForTest("exec", execCode),
+	)
+
+	replayScript := generateReplayScript(enclavePlan, []*snapshotPersistentDirectory{})
+	expectedReplayScript := "def run(plan):\n" +
+		"    plan." + addServiceCode + "\n" +
+		"    plan." + execCode + "\n"
+	require.Equal(t, expectedReplayScript, replayScript)
+}
+
+func TestGenerateReplayScript_EmptyPlan(t *testing.T) {
+	replayScript := generateReplayScript(newEnclavePlanForTest(), []*snapshotPersistentDirectory{})
+	require.Equal(t, "def run(plan):\n    pass\n", replayScript)
+}
+
+func TestGenerateReplayScript_SeedsPersistentDirectories(t *testing.T) {
+	enclavePlan := newEnclavePlanForTest(
+		newEnclavePlanInstructionForTest("add_service", addServiceCode, "db"),
+		newEnclavePlanInstructionForTest(
+			"add_service",
+			`add_service(name="cache", config=ServiceConfig(image="redis", files={"/data": Directory(artifact_name="seed", persistent_key="cache-data")}))`,
+			"cache",
+		),
+	)
+	persistentDirectories := []*snapshotPersistentDirectory{
+		{
+			ServiceName:       "db",
+			PersistentKey:     "db-data",
+			FilesArtifactName: "persistent-directory--db--db-data",
+		},
+		{
+			ServiceName:       "cache",
+			PersistentKey:     "cache-data",
+			FilesArtifactName: "persistent-directory--cache--cache-data",
+		},
+	}
+
+	replayScript := generateReplayScript(enclavePlan, persistentDirectories)
+	expectedReplayScript := "def run(plan):\n" +
+		`    plan.add_service(name="db", config=ServiceConfig(image="postgres", files={"/data": Directory(artifact_name="persistent-directory--db--db-data", persistent_key="db-data")}))` + "\n" +
+		`    plan.add_service(name="cache", config=ServiceConfig(image="redis", files={"/data": Directory(artifact_name="persistent-directory--cache--cache-data", persistent_key="cache-data")}))` + "\n"
+	require.Equal(t, expectedReplayScript, replayScript)
+}
+
+func TestGenerateReplayScript_DoesNotSeedPersistentDirectoriesOfOtherServices(t *testing.T) {
+	enclavePlan := newEnclavePlanForTest(
+		newEnclavePlanInstructionForTest("add_service", addServiceCode, "db"),
+	)
+	persistentDirectories := []*snapshotPersistentDirectory{
+		{
+			ServiceName:       "other-db",
+			PersistentKey:     "db-data",
+			FilesArtifactName: "persistent-directory--other-db--db-data",
+		},
+	}
+
+	replayScript := generateReplayScript(enclavePlan, persistentDirectories)
+	require.Equal(t, "def run(plan):\n    plan."+addServiceCode+"\n", replayScript)
+}
+
+func newEnclavePlanForTest(instructions ...*enclave_plan_persistence.EnclavePlanInstruction) *enclave_plan_persistence.EnclavePlan {
+	enclavePlan := enclave_plan_persistence.NewEnclavePlan()
+	for _, instruction := range instructions {
+		enclavePlan.AppendInstruction(instruction)
+	}
+	return enclavePlan
+}
+
+func newEnclavePlanInstructionForTest(instructionType string, starlarkCode string, serviceNames ...string) *enclave_plan_persistence.EnclavePlanInstruction {
+	if serviceNames == nil {
+		serviceNames = []string{}
+	}
+	return &enclave_plan_persistence.EnclavePlanInstruction{
+		Uuid:           instructionType + "-uuid",
+		Type:           instructionType,
+		StarlarkCode:   starlarkCode,
+		ReturnedValue:  "",
+		ServiceNames:   serviceNames,
+		FilesArtifacts: map[string][]byte{},
+	}
+}
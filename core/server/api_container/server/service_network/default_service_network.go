@@ -6,20 +6,29 @@
 package service_network
 
 import (
+	"archive/tar"
 	"bytes"
 	"compress/gzip"
 	"context"
+	"crypto/tls"
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/service_registration"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/grpc_request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/traffic_control"
 	"io"
 	"net"
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -34,6 +43,11 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 )
 
 const (
@@ -58,8 +72,24 @@ const (
 	serviceLogsFooter = "== FINISHED SERVICE '%s' LOGS ==================================="
 
 	scanPortTimeout = 200 * time.Millisecond
+
+	successfulTrafficControlCommandExitCode = 0
+
+	httpScheme  = "http"
+	httpsScheme = "https"
 )
 
+// insecureHttpClient is shared by all the HTTP requests skipping TLS verification, so that they reuse the connections
+// of a single transport instead of leaking the idle connections of a new one on each request
+var insecureHttpClient = &http.Client{ //nolint:exhaustruct
+	Transport: &http.Transport{ //nolint:exhaustruct
+		// Skipping verification is opted into explicitly by the user, to reach services using self-signed certificates
+		TLSClientConfig: &tls.Config{ //nolint:exhaustruct
+			InsecureSkipVerify: true,
+		},
+	},
+}
+
 type storeFilesArtifactResult struct {
 	err               error
 	filesArtifactUuid enclave_data_directory.FilesArtifactUUID
@@ -82,14 +112,29 @@ type DefaultServiceNetwork struct {
 
 	// This contains all service identifiers ever successfully created
 	serviceIdentifiersRepository *service_identifiers.ServiceIdentifiersRepository
+
+	topology *partition_topology.PartitionTopology
+
+	// Networking sidecars are only started once the topology has been changed for the first time, so that enclaves
+	// not using partitioning don't pay for it
+	isPartitioningEnabled bool
+
+	healthMonitor *service_health.ServiceHealthMonitor
+
+	healthConfigRepository *service_health.HealthConfigRepository
 }
 
+// LivenessCheckBuilder builds the liveness check of a service again from its serialized form, so that the monitoring
+// of the services resumes when the enclave restarts
+type LivenessCheckBuilder func(serviceNetwork ServiceNetwork, serializedLivenessCheck string) (service_health.LivenessCheck, error)
+
 func NewDefaultServiceNetwork(
 	enclaveUuid enclave.EnclaveUUID,
 	apiContainerInfo *ApiContainerInfo,
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveDataDir *enclave_data_directory.EnclaveDataDirectory,
 	enclaveDb *enclave_db.EnclaveDB,
+	livenessCheckBuilder LivenessCheckBuilder,
 ) (*DefaultServiceNetwork, error) {
 	serviceIdentifiersRepository, err := service_identifiers.GetOrCreateNewServiceIdentifiersRepository(enclaveDb)
 	if err != nil {
@@ -100,7 +145,21 @@ func NewDefaultServiceNetwork(
 		return nil, stacktrace.Propagate(err, "An error occurred while creating the service registration repository")
 	}
 
-	return &DefaultServiceNetwork{
+	healthConfigRepository, err := service_health.GetOrCreateNewHealthConfigRepository(enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while creating the service health config repository")
+	}
+
+	topology := partition_topology.NewPartitionTopology(partition_topology.ConnectionAllowed)
+	existingServiceNames, err := serviceRegistrationRepository.GetAllServiceNames()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the names of the services already registered in the enclave")
+	}
+	for serviceName := range existingServiceNames {
+		topology.AddService(serviceName, partition_topology.DefaultPartitionId)
+	}
+
+	network := &DefaultServiceNetwork{
 		enclaveUuid:      enclaveUuid,
 		apiContainerInfo: apiContainerInfo,
 		mutex:            &sync.Mutex{},
@@ -110,7 +169,54 @@ func NewDefaultServiceNetwork(
 
 		serviceRegistrationRepository: serviceRegistrationRepository,
 		serviceIdentifiersRepository:  serviceIdentifiersRepository,
-	}, nil
+
+		topology:              topology,
+		isPartitioningEnabled: false,
+
+		healthMonitor:          nil, // set right below as it calls back the network
+		healthConfigRepository: healthConfigRepository,
+	}
+	network.healthMonitor = service_health.NewServiceHealthMonitor(network.getServiceRunState, network.restartService)
+	if err := network.resumeServicesHealthMonitoring(livenessCheckBuilder); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred resuming the health monitoring of the services")
+	}
+	return network, nil
+}
+
+// RestoreServices starts again the services that were running when the enclave was stopped, so that restarting an
+// enclave brings its services back to the state they had. Services that were stopped beforehand stay stopped, and the
+// services failing to restart only get logged
+func (network *DefaultServiceNetwork) RestoreServices(ctx context.Context) error {
+	serviceIdentifiersToRestore, err := network.getServiceIdentifiersToRestore(ctx)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the services to restore")
+	}
+	if len(serviceIdentifiersToRestore) == 0 {
+		return nil
+	}
+
+	logrus.Infof("Restoring services '%v' that were running when the enclave was stopped", serviceIdentifiersToRestore)
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistrations := map[service.ServiceUUID]*service.ServiceRegistration{}
+	for _, serviceIdentifier := range serviceIdentifiersToRestore {
+		serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(serviceIdentifier)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while getting service registration for identifier '%v'", serviceIdentifier)
+		}
+		serviceRegistrations[serviceRegistration.GetUUID()] = serviceRegistration
+	}
+	erroredUuids, err := network.restartServicesUnlocked(ctx, serviceRegistrations)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restarting services '%v' to restore them", serviceIdentifiersToRestore)
+	}
+	// A service failing to restart mustn't prevent the enclave from coming back. Its registration stays started so that
+	// it gets restored again the next time the enclave is started
+	for serviceUuid, serviceErr := range erroredUuids {
+		logrus.Errorf("An error occurred restoring service '%v', its container isn't running. Error was:\n%v", serviceRegistrations[serviceUuid].GetName(), serviceErr)
+	}
+	return nil
 }
 
 // AddService creates and starts the service in their own container
@@ -142,7 +248,9 @@ func (network *DefaultServiceNetwork) AddService(
 // AddServices creates and starts the services in their own containers. It is a bulk operation, if a
 // single service fails to start, the entire batch is rolled back.
 //
-// This is a bulk operation that follows a sequential approach with no parallelization yet.
+// Only registering the services and recording them once started happen under the network lock. Their containers get
+// started outside of it, as that includes pulling their images and waiting for them to come up, so that independent
+// batches added concurrently don't wait on each other.
 // This function returns:
 //   - successfulService - mapping of successful service ids to service objects with info about that service when the
 //     entire batch of service could be started
@@ -158,8 +266,6 @@ func (network *DefaultServiceNetwork) AddServices(
 	map[service.ServiceName]error,
 	error,
 ) {
-	network.mutex.Lock()
-	defer network.mutex.Unlock()
 	batchSuccessfullyStarted := false
 	startedServices := map[service.ServiceName]*service.Service{}
 	failedServices := map[service.ServiceName]error{}
@@ -168,33 +274,17 @@ func (network *DefaultServiceNetwork) AddServices(
 		return startedServices, failedServices, nil
 	}
 
-	// Save the services currently running in enclave for later
-	currentlyRunningServicesInEnclave := map[service.ServiceName]bool{}
-	allServiceNamesFromServiceRegistrations, err := network.serviceRegistrationRepository.GetAllServiceNames()
-	if err != nil {
-		return nil, nil, stacktrace.Propagate(err, "An error occurred getting all service names from service registration repository")
-	}
-	for serviceName := range allServiceNamesFromServiceRegistrations {
-		currentlyRunningServicesInEnclave[serviceName] = true
-	}
-
-	// We register all the services one by one
-	serviceSuccessfullyRegistered := map[service.ServiceName]*service.ServiceRegistration{}
+	serviceSuccessfullyRegistered, failedServices := network.registerServices(ctx, serviceConfigs)
 	servicesToStart := map[service.ServiceUUID]*service.ServiceConfig{}
-	for serviceName, serviceConfig := range serviceConfigs {
-
-		serviceRegistration, err := network.registerService(ctx, serviceName)
-		if err != nil {
-			failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
-			continue
-		}
-		serviceSuccessfullyRegistered[serviceName] = serviceRegistration
-		servicesToStart[serviceRegistration.GetUUID()] = serviceConfig
+	for serviceName, serviceRegistration := range serviceSuccessfullyRegistered {
+		servicesToStart[serviceRegistration.GetUUID()] = serviceConfigs[serviceName]
 	}
 	defer func() {
 		if batchSuccessfullyStarted {
 			return
 		}
+		network.mutex.Lock()
+		defer network.mutex.Unlock()
 		for serviceName := range serviceSuccessfullyRegistered {
 			if err := network.unregisterService(ctx, serviceName); err != nil {
 				logrus.Errorf("Error unregistering service '%s' from the service network. Error was: %v", serviceName, err)
@@ -250,6 +340,8 @@ func (network *DefaultServiceNetwork) AddServices(
 		return nil, nil, stacktrace.NewError("This is a Kurtosis internal bug. The batch of services being started does not fit the number of services that were requested. (service started: '%v', requested: '%v')", result, requested)
 	}
 
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
 	for _, startedService := range startedServices {
 		serviceRegistration := startedService.GetRegistration()
 		serviceIdentifier := service_identifiers.NewServiceIdentifier(serviceRegistration.GetUUID(), serviceRegistration.GetName())
@@ -263,10 +355,46 @@ func (network *DefaultServiceNetwork) AddServices(
 		}
 	}
 
+	// New services land in the default partition
+	for serviceName := range startedServices {
+		network.topology.AddService(serviceName, partition_topology.DefaultPartitionId)
+	}
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		for serviceName := range startedServices {
+			network.topology.RemoveService(serviceName)
+		}
+		return nil, nil, stacktrace.Propagate(err, "An error occurred applying the network partitioning to the enclave after the services were started")
+	}
+
 	batchSuccessfullyStarted = true
 	return startedServices, map[service.ServiceName]error{}, nil
 }
 
+// registerServices registers the services one by one under the network lock, returning the registrations of the ones
+// that could be registered and the errors of the ones that couldn't
+func (network *DefaultServiceNetwork) registerServices(
+	ctx context.Context,
+	serviceConfigs map[service.ServiceName]*service.ServiceConfig,
+) (
+	map[service.ServiceName]*service.ServiceRegistration,
+	map[service.ServiceName]error,
+) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceSuccessfullyRegistered := map[service.ServiceName]*service.ServiceRegistration{}
+	failedServices := map[service.ServiceName]error{}
+	for serviceName := range serviceConfigs {
+		serviceRegistration, err := network.registerService(ctx, serviceName)
+		if err != nil {
+			failedServices[serviceName] = stacktrace.Propagate(err, "Failed registering service with name: '%s'", serviceName)
+			continue
+		}
+		serviceSuccessfullyRegistered[serviceName] = serviceRegistration
+	}
+	return serviceSuccessfullyRegistered, failedServices
+}
+
 func (network *DefaultServiceNetwork) UpdateService(ctx context.Context, serviceName service.ServiceName, updateServiceConfig *service.ServiceConfig) (*service.Service, error) {
 	serviceConfigMap := map[service.ServiceName]*service.ServiceConfig{
 		serviceName: updateServiceConfig,
@@ -313,6 +441,10 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 			serviceUuidToNameMap[serviceUuid] = serviceName
 		}
 	}
+	// The networking sidecars are bound to the containers being removed, new ones will be created for the new containers
+	if err := network.destroyNetworkingSidecarsUnlocked(ctx, serviceUuidsToRemove); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying the networking sidecars of the services being updated")
+	}
 	successfullyRemovedServices, failedRemovedServices, err := network.kurtosisBackend.RemoveRegisteredUserServiceProcesses(ctx, network.enclaveUuid, serviceUuidsToRemove)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "Unexpected error happened updating services")
@@ -381,6 +513,10 @@ func (network *DefaultServiceNetwork) UpdateServices(ctx context.Context, update
 		}
 		successfullyUpdatedService[serviceName] = newServiceObj
 	}
+
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred applying the network partitioning to the enclave after the services were updated")
+	}
 	return successfullyUpdatedService, failedServicesPool, nil
 }
 
@@ -402,6 +538,10 @@ func (network *DefaultServiceNetwork) RemoveService(
 	}
 	serviceUuid := serviceToRemove.GetUUID()
 
+	if err := network.destroyNetworkingSidecarsUnlocked(ctx, map[service.ServiceUUID]bool{serviceUuid: true}); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred destroying the networking sidecar of service '%v'", serviceUuid)
+	}
+
 	// We stop the service, rather than destroying it, so that we can keep logs around
 	stopServiceFilters := &service.ServiceFilters{
 		Names: nil,
@@ -422,6 +562,16 @@ func (network *DefaultServiceNetwork) RemoveService(
 		return "", stacktrace.Propagate(err, "An error occurred deleting the service registration for service '%v' from the repository", serviceName)
 	}
 
+	network.healthMonitor.StopMonitoring(serviceName)
+	if err := network.healthConfigRepository.Delete(serviceName); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred deleting the health config of service '%v' from the repository", serviceName)
+	}
+
+	network.topology.RemoveService(serviceName)
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred applying the network partitioning to the enclave after service '%v' was removed", serviceName)
+	}
+
 	return serviceUuid, nil
 }
 
@@ -492,6 +642,10 @@ func (network *DefaultServiceNetwork) StartServices(
 		erroredUuids[erroredUuid] = err
 	}
 
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return successfulUuids, erroredUuids, stacktrace.Propagate(err, "An error occurred applying the network partitioning to the enclave after the services were started")
+	}
+
 	return successfulUuids, erroredUuids, nil
 }
 
@@ -538,6 +692,10 @@ func (network *DefaultServiceNetwork) StopServices(
 		serviceNamesByUuid[serviceRegistration.GetUUID()] = serviceRegistration.GetName()
 	}
 
+	if err := network.destroyNetworkingSidecarsUnlocked(ctx, serviceUuids); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred destroying the networking sidecars of the services being stopped")
+	}
+
 	stopServiceFilters := &service.ServiceFilters{
 		Names:    nil,
 		UUIDs:    serviceUuids,
@@ -563,6 +721,10 @@ func (network *DefaultServiceNetwork) StopServices(
 		}
 	}
 
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return successfulUuids, erroredUuids, stacktrace.Propagate(err, "An error occurred applying the network partitioning to the enclave after the services were stopped")
+	}
+
 	return successfulUuids, erroredUuids, nil
 }
 
@@ -634,7 +796,7 @@ func (network *DefaultServiceNetwork) RunExecs(ctx context.Context, userServiceC
 	return successfulExecs, failedExecs, nil
 }
 
-func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string) (*http.Response, error) {
+func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, options *HttpRequestOptions) (*http.Response, error) {
 	logrus.Debugf("Making a request '%v' '%v' '%v' '%v' '%v' '%v'", serviceIdentifier, portId, method, contentType, endpoint, body)
 	userService, getServiceErr := network.GetService(ctx, serviceIdentifier)
 	if getServiceErr != nil {
@@ -644,21 +806,93 @@ func (network *DefaultServiceNetwork) HttpRequestService(ctx context.Context, se
 	if !found {
 		return nil, stacktrace.NewError("An error occurred when getting port '%v' from service '%v' for HTTP request", serviceIdentifier, portId)
 	}
-	url := fmt.Sprintf("http://%v:%v%v", userService.GetRegistration().GetPrivateIP(), port.GetNumber(), endpoint)
-	req, err := http.NewRequestWithContext(ctx, method, url, strings.NewReader(body))
+	scheme := httpScheme
+	if options != nil && options.GetUseTls() {
+		scheme = httpsScheme
+	}
+	requestUrl := fmt.Sprintf("%v://%v:%v%v", scheme, userService.GetRegistration().GetPrivateIP(), port.GetNumber(), endpoint)
+	req, err := http.NewRequestWithContext(ctx, method, requestUrl, strings.NewReader(body))
 	if err != nil {
-		return nil, stacktrace.NewError("An error occurred building HTTP request on service '%v', URL '%v'", userService, url)
+		return nil, stacktrace.NewError("An error occurred building HTTP request on service '%v', URL '%v'", userService, requestUrl)
 	}
 	if contentType != "" {
 		req.Header.Set("Content-Type", contentType)
 	}
-	resp, err := http.DefaultClient.Do(req)
+	client := http.DefaultClient
+	if options != nil {
+		for headerName, headerValue := range options.GetHeaders() {
+			req.Header.Set(headerName, headerValue)
+		}
+		if len(options.GetQueryParams()) > 0 {
+			query := req.URL.Query()
+			for paramName, paramValue := range options.GetQueryParams() {
+				query.Set(paramName, paramValue)
+			}
+			req.URL.RawQuery = query.Encode()
+		}
+		if options.GetUseTls() && options.GetSkipTlsVerify() {
+			client = insecureHttpClient
+		}
+	}
+	resp, err := client.Do(req)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred on HTTP request on service '%v', URL '%v'", userService, url)
+		return nil, stacktrace.Propagate(err, "An error occurred on HTTP request on service '%v', URL '%v'", userService, req.URL.String())
 	}
 	return resp, nil
 }
 
+func (network *DefaultServiceNetwork) GrpcRequestService(ctx context.Context, serviceIdentifier string, portId string, fullMethodName string, requestBody string, options *GrpcRequestOptions) (*grpc_request.GrpcResponse, error) {
+	logrus.Debugf("Making a gRPC request '%v' '%v' '%v' '%v'", serviceIdentifier, portId, fullMethodName, requestBody)
+	address, _, err := network.getServicePrivatePortAddress(ctx, serviceIdentifier, portId)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the address of port '%v' of service '%v' for gRPC request", portId, serviceIdentifier)
+	}
+	transportCredentials := insecure.NewCredentials()
+	var requestMetadata map[string]string
+	var descriptorSet *descriptorpb.FileDescriptorSet
+	if options != nil {
+		requestMetadata = options.GetMetadata()
+		if options.GetUseTls() {
+			transportCredentials = credentials.NewTLS(&tls.Config{ //nolint:exhaustruct
+				// Skipping verification is opted into explicitly by the user, to reach services using self-signed certificates
+				InsecureSkipVerify: options.GetSkipTlsVerify(),
+			})
+		}
+		if options.GetDescriptorSetArtifactName() != "" {
+			descriptorSet, err = network.getDescriptorSetFromFilesArtifact(options.GetDescriptorSetArtifactName(), options.GetDescriptorSetFilePath())
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred reading the gRPC descriptor set from files artifact '%v'", options.GetDescriptorSetArtifactName())
+			}
+		}
+	}
+	connection, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(transportCredentials))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the gRPC connection to service '%v' at '%v'", serviceIdentifier, address)
+	}
+	defer connection.Close()
+	response, err := grpc_request.CallUnaryMethod(ctx, connection, fullMethodName, requestBody, requestMetadata, descriptorSet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred calling gRPC method '%v' on service '%v' at '%v'", fullMethodName, serviceIdentifier, address)
+	}
+	return response, nil
+}
+
+func (network *DefaultServiceNetwork) ProbeServicePort(ctx context.Context, serviceIdentifier string, portId string, transportProtocol port_spec.TransportProtocol, dataToSend string, expectedData string, timeout time.Duration) (string, error) {
+	logrus.Debugf("Probing port '%v' of service '%v' using protocol '%v'", portId, serviceIdentifier, transportProtocol.String())
+	address, port, err := network.getServicePrivatePortAddress(ctx, serviceIdentifier, portId)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting the address of port '%v' of service '%v' for probing", portId, serviceIdentifier)
+	}
+	if port.GetTransportProtocol() != transportProtocol {
+		return "", stacktrace.NewError("Port '%v' of service '%v' uses transport protocol '%v' and can't be probed using '%v'", portId, serviceIdentifier, port.GetTransportProtocol().String(), transportProtocol.String())
+	}
+	receivedData, err := probePort(ctx, transportProtocol, address, dataToSend, expectedData, timeout)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "Probing port '%v' of service '%v' at '%v' failed", portId, serviceIdentifier, address)
+	}
+	return receivedData, nil
+}
+
 func (network *DefaultServiceNetwork) GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error) {
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
@@ -698,6 +932,25 @@ func (network *DefaultServiceNetwork) GetServices(ctx context.Context) (map[serv
 	return filteredServicesToRegisteredServices, nil
 }
 
+func (network *DefaultServiceNetwork) MonitorServiceHealth(serviceName service.ServiceName, healthConfig *service_health.HealthConfig) error {
+	if healthConfig == nil {
+		network.healthMonitor.StopMonitoring(serviceName)
+		if err := network.healthConfigRepository.Delete(serviceName); err != nil {
+			return stacktrace.Propagate(err, "An error occurred deleting the health config of service '%s' from the repository", serviceName)
+		}
+		return nil
+	}
+	if err := network.healthConfigRepository.Save(serviceName, healthConfig); err != nil {
+		return stacktrace.Propagate(err, "An error occurred saving the health config of service '%s' into the repository", serviceName)
+	}
+	network.healthMonitor.StartMonitoring(serviceName, healthConfig)
+	return nil
+}
+
+func (network *DefaultServiceNetwork) GetServiceHealth(serviceName service.ServiceName) (*service_health.ServiceHealth, bool) {
+	return network.healthMonitor.GetServiceHealth(serviceName)
+}
+
 func (network *DefaultServiceNetwork) GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error) {
 	network.mutex.Lock()
 	defer network.mutex.Unlock()
@@ -837,6 +1090,103 @@ func (network *DefaultServiceNetwork) GetUniqueNameForFileArtifact() (string, er
 	return filesArtifactStore.GenerateUniqueNameForFileArtifact(), nil
 }
 
+// SetConnection sets the conditions of the traffic flowing between the services of the two partitions
+func (network *DefaultServiceNetwork) SetConnection(
+	ctx context.Context,
+	partition1 partition_topology.PartitionID,
+	partition2 partition_topology.PartitionID,
+	connection partition_topology.PartitionConnection,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if err := network.topology.SetConnection(partition1, partition2, connection); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the connection between partitions '%v' and '%v'", partition1, partition2)
+	}
+	network.isPartitioningEnabled = true
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the connection between partitions '%v' and '%v'", partition1, partition2)
+	}
+	return nil
+}
+
+// UnsetConnection makes the traffic between the services of the two partitions follow the default connection again
+func (network *DefaultServiceNetwork) UnsetConnection(
+	ctx context.Context,
+	partition1 partition_topology.PartitionID,
+	partition2 partition_topology.PartitionID,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if err := network.topology.UnsetConnection(partition1, partition2); err != nil {
+		return stacktrace.Propagate(err, "An error occurred unsetting the connection between partitions '%v' and '%v'", partition1, partition2)
+	}
+	network.isPartitioningEnabled = true
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the removal of the connection between partitions '%v' and '%v'", partition1, partition2)
+	}
+	return nil
+}
+
+// SetDefaultConnection sets the conditions of the traffic between partitions that have no connection set
+func (network *DefaultServiceNetwork) SetDefaultConnection(ctx context.Context, connection partition_topology.PartitionConnection) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	network.topology.SetDefaultConnection(connection)
+	network.isPartitioningEnabled = true
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the default connection")
+	}
+	return nil
+}
+
+// SetServicePartition moves the service to the partition, which gets created if it didn't exist
+func (network *DefaultServiceNetwork) SetServicePartition(ctx context.Context, serviceName service.ServiceName, partitionId partition_topology.PartitionID) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if err := network.topology.SetServicePartition(serviceName, partitionId); err != nil {
+		return stacktrace.Propagate(err, "An error occurred moving service '%v' to partition '%v'", serviceName, partitionId)
+	}
+	network.isPartitioningEnabled = true
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the network partitioning after moving service '%v' to partition '%v'", serviceName, partitionId)
+	}
+	return nil
+}
+
+// Repartition replaces the whole partition topology of the enclave. Services that aren't given a partition go back to
+// the default partition
+func (network *DefaultServiceNetwork) Repartition(
+	ctx context.Context,
+	servicePartitions map[service.ServiceName]partition_topology.PartitionID,
+	partitionConnections map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection,
+	defaultConnection partition_topology.PartitionConnection,
+) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	for serviceName := range servicePartitions {
+		exists, err := network.serviceRegistrationRepository.Exist(serviceName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred checking whether service '%v' exists", serviceName)
+		}
+		if !exists {
+			return stacktrace.NewError("Service '%v' can't be assigned a partition because it doesn't exist in the enclave", serviceName)
+		}
+	}
+	if err := network.topology.Repartition(servicePartitions, partitionConnections, defaultConnection); err != nil {
+		return stacktrace.Propagate(err, "An error occurred repartitioning the enclave")
+	}
+	network.isPartitioningEnabled = true
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return stacktrace.Propagate(err, "An error occurred applying the new partitioning of the enclave")
+	}
+	return nil
+}
+
 func (network *DefaultServiceNetwork) GetApiContainerInfo() *ApiContainerInfo {
 	return network.apiContainerInfo
 }
@@ -850,6 +1200,287 @@ func (network *DefaultServiceNetwork) GetEnclaveUuid() enclave.EnclaveUUID {
 //	Private helper methods
 //
 // ====================================================================================================
+// updateTrafficControlUnlocked makes the traffic control rules of every running service match the partition
+// topology. Running services without conditioned connections only get a networking sidecar if they already had one,
+// in which case their rules are cleared
+func (network *DefaultServiceNetwork) updateTrafficControlUnlocked(ctx context.Context) error {
+	if !network.isPartitioningEnabled {
+		return nil
+	}
+
+	serviceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the service registrations of the enclave")
+	}
+	runningServiceRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+	for serviceName, serviceRegistration := range serviceRegistrations {
+		if serviceRegistration.GetStatus() == service.ServiceStatus_Started {
+			runningServiceRegistrations[serviceName] = serviceRegistration
+		}
+	}
+
+	runningNetworkingSidecarFilters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: nil,
+		Statuses: map[container_status.ContainerStatus]bool{
+			container_status.ContainerStatus_Running: true,
+		},
+	}
+	existingNetworkingSidecars, err := network.kurtosisBackend.GetNetworkingSidecars(ctx, network.enclaveUuid, runningNetworkingSidecarFilters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the networking sidecars of the enclave")
+	}
+
+	conditionedConnectionsByServiceName := network.topology.GetServicePartitionConnectionConfigByServiceName()
+	trafficControlCommands := map[service.ServiceUUID][]string{}
+	serviceNamesByUuid := map[service.ServiceUUID]service.ServiceName{}
+	for serviceName, serviceRegistration := range runningServiceRegistrations {
+		serviceUuid := serviceRegistration.GetUUID()
+		conditionedConnectionsByIp := map[string]partition_topology.PartitionConnection{}
+		for otherServiceName, connection := range conditionedConnectionsByServiceName[serviceName] {
+			otherServiceRegistration, found := runningServiceRegistrations[otherServiceName]
+			if !found {
+				continue
+			}
+			conditionedConnectionsByIp[otherServiceRegistration.GetPrivateIP().String()] = connection
+		}
+
+		_, hasNetworkingSidecar := existingNetworkingSidecars[serviceUuid]
+		if len(conditionedConnectionsByIp) == 0 && !hasNetworkingSidecar {
+			continue
+		}
+		if !hasNetworkingSidecar {
+			if _, err := network.kurtosisBackend.CreateNetworkingSidecar(ctx, network.enclaveUuid, serviceUuid); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating the networking sidecar of service '%v'", serviceName)
+			}
+		}
+		trafficControlCommands[serviceUuid] = traffic_control.GenerateTrafficControlCommand(conditionedConnectionsByIp)
+		serviceNamesByUuid[serviceUuid] = serviceName
+	}
+	if len(trafficControlCommands) == 0 {
+		return nil
+	}
+
+	successfulExecs, failedExecs, err := network.kurtosisBackend.RunNetworkingSidecarExecCommands(ctx, network.enclaveUuid, trafficControlCommands)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred running the traffic control commands in the networking sidecars")
+	}
+	for serviceUuid, execErr := range failedExecs {
+		return stacktrace.Propagate(execErr, "An error occurred running the traffic control command in the networking sidecar of service '%v'", serviceNamesByUuid[serviceUuid])
+	}
+	for serviceUuid, execResult := range successfulExecs {
+		if execResult.GetExitCode() != successfulTrafficControlCommandExitCode {
+			return stacktrace.NewError(
+				"The traffic control command '%v' run in the networking sidecar of service '%v' exited with code '%v' and output:\n%v",
+				trafficControlCommands[serviceUuid],
+				serviceNamesByUuid[serviceUuid],
+				execResult.GetExitCode(),
+				execResult.GetOutput(),
+			)
+		}
+	}
+	return nil
+}
+
+// destroyNetworkingSidecarsUnlocked destroys the networking sidecars of the services, if any
+func (network *DefaultServiceNetwork) destroyNetworkingSidecarsUnlocked(ctx context.Context, serviceUuids map[service.ServiceUUID]bool) error {
+	if !network.isPartitioningEnabled || len(serviceUuids) == 0 {
+		return nil
+	}
+	filters := &networking_sidecar.NetworkingSidecarFilters{
+		UserServiceUUIDs: serviceUuids,
+		Statuses:         nil,
+	}
+	_, erroredUuids, err := network.kurtosisBackend.DestroyNetworkingSidecars(ctx, network.enclaveUuid, filters)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred destroying the networking sidecars of services '%v'", serviceUuids)
+	}
+	for serviceUuid, destroyErr := range erroredUuids {
+		return stacktrace.Propagate(destroyErr, "An error occurred destroying the networking sidecar of service '%v'", serviceUuid)
+	}
+	return nil
+}
+
+// getServiceRunState returns whether the service is expected to be running, i.e. it was not stopped through the
+// service network, and whether its container is actually running
+func (network *DefaultServiceNetwork) getServiceRunState(ctx context.Context, serviceName service.ServiceName) (bool, bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	if err != nil {
+		return false, false, stacktrace.Propagate(err, "An error occurred getting the service registration for service '%s'", serviceName)
+	}
+	if serviceRegistration.GetStatus() != service.ServiceStatus_Started {
+		return false, false, nil
+	}
+
+	serviceUuid := serviceRegistration.GetUUID()
+	getServiceFilters := &service.ServiceFilters{
+		Names: nil,
+		UUIDs: map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		Statuses: nil,
+	}
+	matchingServices, err := network.kurtosisBackend.GetUserServices(ctx, network.enclaveUuid, getServiceFilters)
+	if err != nil {
+		return false, false, stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceName)
+	}
+	serviceObj, found := matchingServices[serviceUuid]
+	if !found {
+		return true, false, nil
+	}
+	return true, serviceObj.GetStatus() == container_status.ContainerStatus_Running, nil
+}
+
+// getServiceIdentifiersToRestore returns the names of the services registered as started whose container isn't running,
+// sorted alphabetically
+func (network *DefaultServiceNetwork) getServiceIdentifiersToRestore(ctx context.Context) ([]string, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistrations, err := network.serviceRegistrationRepository.GetAll()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the service registrations of the enclave")
+	}
+	startedServiceUuids := map[service.ServiceUUID]bool{}
+	for _, serviceRegistration := range serviceRegistrations {
+		if serviceRegistration.GetStatus() == service.ServiceStatus_Started {
+			startedServiceUuids[serviceRegistration.GetUUID()] = true
+		}
+	}
+	if len(startedServiceUuids) == 0 {
+		return nil, nil
+	}
+
+	getServiceFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    startedServiceUuids,
+		Statuses: nil,
+	}
+	matchingServices, err := network.kurtosisBackend.GetUserServices(ctx, network.enclaveUuid, getServiceFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the services registered as started")
+	}
+	serviceIdentifiersToRestore := []string{}
+	for serviceUuid, serviceObj := range matchingServices {
+		if serviceObj.GetStatus() == container_status.ContainerStatus_Running {
+			continue
+		}
+		serviceRegistration := serviceObj.GetRegistration()
+		if serviceRegistration == nil {
+			return nil, stacktrace.NewError("Expected service '%v' to have a registration but it didn't; this is a bug in Kurtosis", serviceUuid)
+		}
+		serviceIdentifiersToRestore = append(serviceIdentifiersToRestore, string(serviceRegistration.GetName()))
+	}
+	sort.Strings(serviceIdentifiersToRestore)
+	return serviceIdentifiersToRestore, nil
+}
+
+// restartServicesUnlocked stops and starts again the containers of the services without changing the status of their
+// registration, which stays started even if the service fails to restart. It returns the errors of the services that
+// failed to restart
+func (network *DefaultServiceNetwork) restartServicesUnlocked(
+	ctx context.Context,
+	serviceRegistrations map[service.ServiceUUID]*service.ServiceRegistration,
+) (
+	map[service.ServiceUUID]error,
+	error,
+) {
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceUuid := range serviceRegistrations {
+		serviceUuids[serviceUuid] = true
+	}
+
+	if err := network.destroyNetworkingSidecarsUnlocked(ctx, serviceUuids); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred destroying the networking sidecars of the services being restarted")
+	}
+
+	stopServiceFilters := &service.ServiceFilters{
+		Names:    nil,
+		UUIDs:    serviceUuids,
+		Statuses: nil,
+	}
+	stoppedUuids, erroredUuids, err := network.kurtosisBackend.StopUserServices(ctx, network.enclaveUuid, stopServiceFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred during the call to stop services")
+	}
+
+	serviceConfigs := map[service.ServiceUUID]*service.ServiceConfig{}
+	for stoppedUuid := range stoppedUuids {
+		serviceRegistration, found := serviceRegistrations[stoppedUuid]
+		if !found {
+			return nil, stacktrace.NewError("Service '%v' was stopped although it wasn't requested; this is a bug in Kurtosis", stoppedUuid)
+		}
+		serviceConfigs[stoppedUuid] = serviceRegistration.GetConfig()
+	}
+	if len(serviceConfigs) > 0 {
+		_, failedUuids, err := network.kurtosisBackend.StartRegisteredUserServices(ctx, network.enclaveUuid, serviceConfigs)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred during the call to start services")
+		}
+		for failedUuid, failedErr := range failedUuids {
+			erroredUuids[failedUuid] = failedErr
+		}
+	}
+
+	if err := network.updateTrafficControlUnlocked(ctx); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred applying the network partitioning to the enclave after the services were restarted")
+	}
+	return erroredUuids, nil
+}
+
+// resumeServicesHealthMonitoring monitors again the services that were monitored before the API container restarted. A
+// service whose liveness check can't be built again only gets its container checked
+func (network *DefaultServiceNetwork) resumeServicesHealthMonitoring(livenessCheckBuilder LivenessCheckBuilder) error {
+	healthConfigs, err := network.healthConfigRepository.GetAll()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the health configs of the services")
+	}
+	for serviceName, healthConfig := range healthConfigs {
+		var livenessCheck service_health.LivenessCheck
+		if serializedLivenessCheck := healthConfig.GetSerializedLivenessCheck(); serializedLivenessCheck != "" && livenessCheckBuilder != nil {
+			livenessCheck, err = livenessCheckBuilder(network, serializedLivenessCheck)
+			if err != nil {
+				logrus.Errorf("An error occurred building the liveness check '%s' of service '%s' again, only its container will be checked. Error was:\n%v", serializedLivenessCheck, serviceName, err)
+				livenessCheck = nil
+			}
+		}
+		resumedHealthConfig := service_health.NewHealthConfig(
+			livenessCheck,
+			healthConfig.GetSerializedLivenessCheck(),
+			healthConfig.GetCheckInterval(),
+			healthConfig.GetRestartPolicy(),
+			healthConfig.GetMaxRestarts(),
+		)
+		network.healthMonitor.StartMonitoring(serviceName, resumedHealthConfig)
+	}
+	return nil
+}
+
+// restartService restarts a service the health monitor found unhealthy. The registration of the service stays started
+// even if the service fails to come back, so that the monitor keeps checking it and retries the restart
+func (network *DefaultServiceNetwork) restartService(ctx context.Context, serviceName service.ServiceName) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	serviceRegistration, err := network.getServiceRegistrationForIdentifierUnlocked(string(serviceName))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while getting service registration for service '%s'", serviceName)
+	}
+	serviceUuid := serviceRegistration.GetUUID()
+	erroredUuids, err := network.restartServicesUnlocked(ctx, map[service.ServiceUUID]*service.ServiceRegistration{
+		serviceUuid: serviceRegistration,
+	})
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred restarting service '%s'", serviceName)
+	}
+	if serviceErr, found := erroredUuids[serviceUuid]; found {
+		return stacktrace.Propagate(serviceErr, "An error occurred restarting service '%s'", serviceName)
+	}
+	return nil
+}
+
 // registerService handles all the operations necessary to register a service before is can be started with
 // startRegisteredService. If something fails along the way, the function takes care of rolling back the previous
 // changes such that the enclave remains in the state before the call
@@ -1318,6 +1949,80 @@ func (network *DefaultServiceNetwork) getServiceNameForIdentifierUnlocked(servic
 	return "", stacktrace.NewError("Couldn't find a matching service name for identifier '%v'", serviceIdentifier)
 }
 
+func (network *DefaultServiceNetwork) getServicePrivatePortAddress(ctx context.Context, serviceIdentifier string, portId string) (string, *port_spec.PortSpec, error) {
+	userService, err := network.GetService(ctx, serviceIdentifier)
+	if err != nil {
+		return "", nil, stacktrace.Propagate(err, "An error occurred getting service '%v'", serviceIdentifier)
+	}
+	port, found := userService.GetPrivatePorts()[portId]
+	if !found {
+		return "", nil, stacktrace.NewError("Service '%v' has no port '%v'", serviceIdentifier, portId)
+	}
+	address := net.JoinHostPort(userService.GetRegistration().GetPrivateIP().String(), fmt.Sprintf("%v", port.GetNumber()))
+	return address, port, nil
+}
+
+// getDescriptorSetFromFilesArtifact reads the serialized FileDescriptorSet stored at filePath in the files artifact.
+// filePath can be empty if the files artifact contains a single file
+func (network *DefaultServiceNetwork) getDescriptorSetFromFilesArtifact(artifactName string, filePath string) (*descriptorpb.FileDescriptorSet, error) {
+	filesArtifactStore, err := network.enclaveDataDir.GetFilesArtifactStore()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the files artifact store")
+	}
+	_, filesArtifact, _, found, err := filesArtifactStore.GetFile(artifactName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting files artifact '%v'", artifactName)
+	}
+	if !found {
+		return nil, stacktrace.NewError("Files artifact '%v' does not exist", artifactName)
+	}
+	artifactFile, err := os.Open(filesArtifact.GetAbsoluteFilepath())
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening files artifact '%v'", artifactName)
+	}
+	defer artifactFile.Close()
+	gzipReader, err := gzip.NewReader(artifactFile)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the gzip reader of files artifact '%v'", artifactName)
+	}
+	defer gzipReader.Close()
+
+	var descriptorSetBytes []byte
+	numRegularFiles := 0
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading files artifact '%v'", artifactName)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		numRegularFiles++
+		if filePath != "" && path.Clean(header.Name) != path.Clean(filePath) {
+			continue
+		}
+		descriptorSetBytes, err = io.ReadAll(tarReader)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading file '%v' of files artifact '%v'", header.Name, artifactName)
+		}
+	}
+	if filePath == "" && numRegularFiles != 1 {
+		return nil, stacktrace.NewError("Files artifact '%v' contains %d files, the path of the descriptor set inside the files artifact must be provided", artifactName, numRegularFiles)
+	}
+	if descriptorSetBytes == nil {
+		return nil, stacktrace.NewError("File '%v' was not found in files artifact '%v'", filePath, artifactName)
+	}
+	descriptorSet := &descriptorpb.FileDescriptorSet{} //nolint:exhaustruct
+	if err := proto.Unmarshal(descriptorSetBytes, descriptorSet); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the content of files artifact '%v' as a serialized FileDescriptorSet", artifactName)
+	}
+	return descriptorSet, nil
+}
+
 func (network *DefaultServiceNetwork) getServiceLogs(
 	ctx context.Context,
 	serviceObj *service.Service,
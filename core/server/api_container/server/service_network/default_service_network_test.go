@@ -19,8 +19,13 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/networking_sidecar"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/traffic_control"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/pkg/errors"
@@ -82,6 +87,7 @@ func TestAddService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -163,6 +169,7 @@ func TestAddService_FailedToStart(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -257,6 +264,7 @@ func TestAddServices_Success(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -350,6 +358,7 @@ func TestAddServices_FailureRollsBackTheEntireBatch(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -501,6 +510,7 @@ func TestAddServices_FailedToRegisterService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -555,6 +565,7 @@ func TestStopService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -613,6 +624,7 @@ func TestStopService_StopUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -668,6 +680,7 @@ func TestStopService_ServiceAlreadyStopped(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -721,6 +734,7 @@ func TestStartService_Successful(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -775,6 +789,7 @@ func TestStartService_StartRegisteredUserServicesFailed(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -828,6 +843,7 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 	err = network.serviceRegistrationRepository.Save(serviceRegistration)
@@ -849,6 +865,288 @@ func TestStartService_ServiceAlreadyStarted(t *testing.T) {
 	require.Equal(t, serviceRegistration.GetStatus(), service.ServiceStatus_Started)
 }
 
+func TestRestoreServices_OnlyRestartsServicesRunningWhenEnclaveWasStopped(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceConfig := testServiceConfig(testContainerImageName)
+
+	// Service 1 was running when the enclave was stopped, so its container was killed along with the enclave
+	killedServiceName := testServiceNameFromInt(1)
+	killedServiceUuid := testServiceUuidFromInt(1)
+	killedServiceIp := testIpFromInt(1)
+	killedServiceRegistration := service.NewServiceRegistration(killedServiceName, killedServiceUuid, enclaveName, killedServiceIp, string(killedServiceName))
+	killedServiceRegistration.SetStatus(service.ServiceStatus_Started)
+	killedServiceRegistration.SetConfig(serviceConfig)
+	killedServiceObj := service.NewService(killedServiceRegistration, container_status.ContainerStatus_Stopped, map[string]*port_spec.PortSpec{}, killedServiceIp, map[string]*port_spec.PortSpec{})
+	restartedServiceObj := service.NewService(killedServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, killedServiceIp, map[string]*port_spec.PortSpec{})
+
+	// Service 2 had been stopped by the user before the enclave was stopped
+	stoppedServiceName := testServiceNameFromInt(2)
+	stoppedServiceUuid := testServiceUuidFromInt(2)
+	stoppedServiceRegistration := service.NewServiceRegistration(stoppedServiceName, stoppedServiceUuid, enclaveName, testIpFromInt(2), string(stoppedServiceName))
+	stoppedServiceRegistration.SetStatus(service.ServiceStatus_Stopped)
+	stoppedServiceRegistration.SetConfig(serviceConfig)
+
+	// Service 3 is still running
+	runningServiceName := testServiceNameFromInt(3)
+	runningServiceUuid := testServiceUuidFromInt(3)
+	runningServiceIp := testIpFromInt(3)
+	runningServiceRegistration := service.NewServiceRegistration(runningServiceName, runningServiceUuid, enclaveName, runningServiceIp, string(runningServiceName))
+	runningServiceRegistration.SetStatus(service.ServiceStatus_Started)
+	runningServiceRegistration.SetConfig(serviceConfig)
+	runningServiceObj := service.NewService(runningServiceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, runningServiceIp, map[string]*port_spec.PortSpec{})
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		nil,
+	)
+	require.Nil(t, err)
+	for _, serviceRegistration := range []*service.ServiceRegistration{killedServiceRegistration, stoppedServiceRegistration, runningServiceRegistration} {
+		require.NoError(t, network.serviceRegistrationRepository.Save(serviceRegistration))
+	}
+
+	backend.EXPECT().GetUserServices(
+		ctx,
+		enclaveName,
+		&service.ServiceFilters{
+			Names: nil,
+			UUIDs: map[service.ServiceUUID]bool{
+				killedServiceUuid:  true,
+				runningServiceUuid: true,
+			},
+			Statuses: nil,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			killedServiceUuid:  killedServiceObj,
+			runningServiceUuid: runningServiceObj,
+		},
+		nil,
+	)
+	backend.EXPECT().StopUserServices(
+		ctx,
+		enclaveName,
+		&service.ServiceFilters{
+			Names: nil,
+			UUIDs: map[service.ServiceUUID]bool{
+				killedServiceUuid: true,
+			},
+			Statuses: nil,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			killedServiceUuid: true,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(
+		ctx,
+		enclaveName,
+		map[service.ServiceUUID]*service.ServiceConfig{
+			killedServiceUuid: serviceConfig,
+		},
+	).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			killedServiceUuid: restartedServiceObj,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	err = network.RestoreServices(ctx)
+	require.NoError(t, err)
+
+	expectedStatuses := map[service.ServiceName]service.ServiceStatus{
+		killedServiceName:  service.ServiceStatus_Started,
+		stoppedServiceName: service.ServiceStatus_Stopped,
+		runningServiceName: service.ServiceStatus_Started,
+	}
+	for serviceName, expectedStatus := range expectedStatuses {
+		serviceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+		require.NoError(t, err)
+		require.Equal(t, expectedStatus, serviceRegistration.GetStatus())
+	}
+}
+
+func TestRestoreServices_ServiceFailingToRestartStaysStarted(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceConfig := testServiceConfig(testContainerImageName)
+
+	serviceName := testServiceNameFromInt(1)
+	serviceUuid := testServiceUuidFromInt(1)
+	serviceIp := testIpFromInt(1)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, serviceIp, string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+	serviceRegistration.SetConfig(serviceConfig)
+	serviceObj := service.NewService(serviceRegistration, container_status.ContainerStatus_Stopped, map[string]*port_spec.PortSpec{}, serviceIp, map[string]*port_spec.PortSpec{})
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		nil,
+	)
+	require.Nil(t, err)
+	require.NoError(t, network.serviceRegistrationRepository.Save(serviceRegistration))
+
+	serviceUuids := map[service.ServiceUUID]bool{
+		serviceUuid: true,
+	}
+	backend.EXPECT().GetUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{
+			serviceUuid: serviceObj,
+		},
+		nil,
+	)
+	backend.EXPECT().StopUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		serviceUuids,
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{},
+		map[service.ServiceUUID]error{
+			serviceUuid: stacktrace.NewError("Image '%s' can't be pulled", testContainerImageName),
+		},
+		nil,
+	)
+
+	// the enclave comes back even though the service couldn't be restored
+	err = network.RestoreServices(ctx)
+	require.NoError(t, err)
+
+	restoredServiceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, restoredServiceRegistration.GetStatus())
+}
+
+func TestRestartService_ServiceFailingToRestartStaysStarted(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceName := testServiceNameFromInt(1)
+	serviceUuid := testServiceUuidFromInt(1)
+	serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, testIpFromInt(1), string(serviceName))
+	serviceRegistration.SetStatus(service.ServiceStatus_Started)
+	serviceRegistration.SetConfig(testServiceConfig(testContainerImageName))
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		nil,
+	)
+	require.Nil(t, err)
+	require.NoError(t, network.serviceRegistrationRepository.Save(serviceRegistration))
+
+	backend.EXPECT().StopUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]bool{
+			serviceUuid: true,
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+	backend.EXPECT().StartRegisteredUserServices(ctx, enclaveName, mock.Anything).Times(1).Return(
+		map[service.ServiceUUID]*service.Service{},
+		map[service.ServiceUUID]error{
+			serviceUuid: stacktrace.NewError("Port '%s' is already allocated", "8080"),
+		},
+		nil,
+	)
+
+	err = network.restartService(ctx, serviceName)
+	require.Error(t, err)
+
+	// the service is still expected to run, so the health monitor retries restarting it on its next check
+	restartedServiceRegistration, err := network.serviceRegistrationRepository.Get(serviceName)
+	require.NoError(t, err)
+	require.Equal(t, service.ServiceStatus_Started, restartedServiceRegistration.GetStatus())
+}
+
+func TestNewDefaultServiceNetwork_ResumesServicesHealthMonitoring(t *testing.T) {
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	serviceName := testServiceNameFromInt(1)
+	serializedLivenessCheck := `ReadyCondition(recipe=ExecRecipe(command=["true"]), field="code", assertion="==", target_value=0)`
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	// the health config persisted before the API container restarted
+	healthConfigRepository, err := service_health.GetOrCreateNewHealthConfigRepository(enclaveDb)
+	require.NoError(t, err)
+	healthConfig := service_health.NewHealthConfig(nil, serializedLivenessCheck, time.Hour, service_health.RestartPolicy_Always, service_health.NoMaxRestarts)
+	require.NoError(t, healthConfigRepository.Save(serviceName, healthConfig))
+
+	var builtLivenessChecks []string
+	livenessCheckBuilder := func(_ ServiceNetwork, serializedLivenessCheck string) (service_health.LivenessCheck, error) {
+		builtLivenessChecks = append(builtLivenessChecks, serializedLivenessCheck)
+		return func(_ context.Context, _ service.ServiceName) error { return nil }, nil
+	}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		livenessCheckBuilder,
+	)
+	require.Nil(t, err)
+	defer network.healthMonitor.StopMonitoring(serviceName)
+
+	require.Equal(t, []string{serializedLivenessCheck}, builtLivenessChecks)
+	_, isMonitored := network.GetServiceHealth(serviceName)
+	require.True(t, isMonitored)
+
+	// not monitoring the service anymore forgets its health config
+	require.NoError(t, network.MonitorServiceHealth(serviceName, nil))
+	healthConfigs, err := healthConfigRepository.GetAll()
+	require.NoError(t, err)
+	require.Empty(t, healthConfigs)
+}
+
 func TestUpdateService(t *testing.T) {
 	ctx := context.Background()
 	backend := backend_interface.NewMockKurtosisBackend(t)
@@ -867,6 +1165,7 @@ func TestUpdateService(t *testing.T) {
 		backend,
 		unusedEnclaveDataDir,
 		enclaveDb,
+		nil,
 	)
 	require.Nil(t, err)
 
@@ -1013,6 +1312,72 @@ func TestUpdateService(t *testing.T) {
 	require.Nil(t, newFailedToBeRecreatedServiceRegistration.GetConfig())
 }
 
+func TestSetServicePartition_AppliesTrafficControl(t *testing.T) {
+	ctx := context.Background()
+	backend := backend_interface.NewMockKurtosisBackend(t)
+
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer os.Remove(file.Name())
+	require.Nil(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.Nil(t, err)
+	defer db.Close()
+	enclaveDb := &enclave_db.EnclaveDB{DB: db}
+
+	network, err := NewDefaultServiceNetwork(
+		enclaveName,
+		apiContainerInfo,
+		backend,
+		unusedEnclaveDataDir,
+		enclaveDb,
+		nil,
+	)
+	require.Nil(t, err)
+
+	serviceUuids := map[service.ServiceUUID]bool{}
+	for serviceInternalTestId := 1; serviceInternalTestId <= 2; serviceInternalTestId++ {
+		serviceName := testServiceNameFromInt(serviceInternalTestId)
+		serviceUuid := testServiceUuidFromInt(serviceInternalTestId)
+		serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, enclaveName, testIpFromInt(serviceInternalTestId), string(serviceName))
+		serviceRegistration.SetStatus(service.ServiceStatus_Started)
+		require.NoError(t, network.serviceRegistrationRepository.Save(serviceRegistration))
+		network.topology.AddService(serviceName, partition_topology.DefaultPartitionId)
+		serviceUuids[serviceUuid] = true
+	}
+
+	backend.EXPECT().GetNetworkingSidecars(ctx, enclaveName, mock.Anything).Times(2).Return(
+		map[service.ServiceUUID]*networking_sidecar.NetworkingSidecar{},
+		nil,
+	)
+	for serviceUuid := range serviceUuids {
+		backend.EXPECT().CreateNetworkingSidecar(ctx, enclaveName, serviceUuid).Times(1).Return(
+			networking_sidecar.NewNetworkingSidecar(serviceUuid, enclaveName, container_status.ContainerStatus_Running),
+			nil,
+		)
+	}
+	expectedCommands := map[service.ServiceUUID][]string{
+		testServiceUuidFromInt(1): traffic_control.GenerateTrafficControlCommand(map[string]partition_topology.PartitionConnection{
+			testIpFromInt(2).String(): partition_topology.ConnectionBlocked,
+		}),
+		testServiceUuidFromInt(2): traffic_control.GenerateTrafficControlCommand(map[string]partition_topology.PartitionConnection{
+			testIpFromInt(1).String(): partition_topology.ConnectionBlocked,
+		}),
+	}
+	backend.EXPECT().RunNetworkingSidecarExecCommands(ctx, enclaveName, expectedCommands).Times(1).Return(
+		map[service.ServiceUUID]*exec_result.ExecResult{
+			testServiceUuidFromInt(1): exec_result.NewExecResult(0, ""),
+			testServiceUuidFromInt(2): exec_result.NewExecResult(0, ""),
+		},
+		map[service.ServiceUUID]error{},
+		nil,
+	)
+
+	// All services are in the same partition so nothing needs to be applied yet
+	require.NoError(t, network.SetDefaultConnection(ctx, partition_topology.ConnectionBlocked))
+	require.NoError(t, network.SetServicePartition(ctx, testServiceNameFromInt(2), "other-partition"))
+	require.Error(t, network.SetServicePartition(ctx, testServiceNameFromInt(3), "other-partition"))
+}
+
 func TestScanPort(t *testing.T) {
 	localhost := net.ParseIP(localhostIPAddrStr)
 
@@ -1204,6 +1569,17 @@ func testServiceConfig(imageName string) *service.ServiceConfig {
 		"",
 		0,
 		0,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 }
 
@@ -0,0 +1,530 @@
+package service_network
+
+import (
+	"context"
+	"crypto/md5"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/grpc_request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	fakeServiceUuidFormat       = "%032x"
+	fakeFilesArtifactUuidFormat = "%032x"
+	fakeFilesArtifactNameFormat = "files-artifact-%d"
+
+	// the services get the IPs 10.0.0.2, 10.0.0.3, etc. in the order they're added
+	firstFakeServiceIpSuffix = 2
+	ipBytesBase              = 256
+
+	defaultFakeHttpStatusCode = http.StatusOK
+	defaultFakeExecExitCode   = 0
+	fakeExecCommandSeparator  = " "
+	emptyFakeResponseBody     = ""
+)
+
+var (
+	// an empty command matches all the commands run in the service
+	anyFakeExecCommand []string
+)
+
+type fakeRequestKey struct {
+	serviceName service.ServiceName
+	// the endpoint of the HTTP requests, the method of the gRPC requests, or the command of the execs
+	target string
+}
+
+// FakeServiceNetwork is an in-memory ServiceNetwork that doesn't start any container, so that Starlark packages can be
+// tested in a few milliseconds. Services get fake IP addresses in the order they're added, and requests sent to them
+// get the fake responses registered beforehand, or empty successful responses
+type FakeServiceNetwork struct {
+	mutex *sync.Mutex
+
+	enclaveUuid enclave.EnclaveUUID
+
+	apiContainerInfo *ApiContainerInfo
+
+	services map[service.ServiceName]*service.Service
+
+	historicalServiceIdentifiers service_identifiers.ServiceIdentifiers
+
+	filesArtifactUuids map[string]enclave_data_directory.FilesArtifactUUID
+
+	filesArtifactMd5s map[string][]byte
+
+	numberOfServicesAdded int
+
+	numberOfFilesArtifactsAdded int
+
+	fakeHttpResponses map[fakeRequestKey]*FakeHttpResponse
+
+	fakeGrpcResponses map[fakeRequestKey]*grpc_request.GrpcResponse
+
+	fakeExecResults map[fakeRequestKey]*exec_result.ExecResult
+}
+
+// FakeHttpResponse is what the HTTP requests sent to a service of the FakeServiceNetwork get
+type FakeHttpResponse struct {
+	statusCode int
+	body       string
+}
+
+func NewFakeHttpResponse(statusCode int, body string) *FakeHttpResponse {
+	return &FakeHttpResponse{
+		statusCode: statusCode,
+		body:       body,
+	}
+}
+
+func NewFakeServiceNetwork(enclaveUuid enclave.EnclaveUUID, apiContainerInfo *ApiContainerInfo) *FakeServiceNetwork {
+	return &FakeServiceNetwork{
+		mutex:                        &sync.Mutex{},
+		enclaveUuid:                  enclaveUuid,
+		apiContainerInfo:             apiContainerInfo,
+		services:                     map[service.ServiceName]*service.Service{},
+		historicalServiceIdentifiers: service_identifiers.ServiceIdentifiers{},
+		filesArtifactUuids:           map[string]enclave_data_directory.FilesArtifactUUID{},
+		filesArtifactMd5s:            map[string][]byte{},
+		numberOfServicesAdded:        0,
+		numberOfFilesArtifactsAdded:  0,
+		fakeHttpResponses:            map[fakeRequestKey]*FakeHttpResponse{},
+		fakeGrpcResponses:            map[fakeRequestKey]*grpc_request.GrpcResponse{},
+		fakeExecResults:              map[fakeRequestKey]*exec_result.ExecResult{},
+	}
+}
+
+// SetFakeHttpResponse sets the response of the HTTP requests sent to the endpoint of the service, whatever their port
+// and method
+func (network *FakeServiceNetwork) SetFakeHttpResponse(serviceName service.ServiceName, endpoint string, response *FakeHttpResponse) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	network.fakeHttpResponses[fakeRequestKey{serviceName: serviceName, target: endpoint}] = response
+}
+
+// SetFakeGrpcResponse sets the response of the calls to the gRPC method of the service, named like
+// 'package.Service/Method', whatever their port
+func (network *FakeServiceNetwork) SetFakeGrpcResponse(serviceName service.ServiceName, fullMethodName string, response *grpc_request.GrpcResponse) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	network.fakeGrpcResponses[fakeRequestKey{serviceName: serviceName, target: fullMethodName}] = response
+}
+
+// SetFakeExecResult sets the result of the command run in the service. An empty command sets the result of all the
+// commands that don't have a result of their own
+func (network *FakeServiceNetwork) SetFakeExecResult(serviceName service.ServiceName, command []string, result *exec_result.ExecResult) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	network.fakeExecResults[fakeRequestKey{serviceName: serviceName, target: strings.Join(command, fakeExecCommandSeparator)}] = result
+}
+
+func (network *FakeServiceNetwork) AddService(ctx context.Context, serviceName service.ServiceName, serviceConfig *service.ServiceConfig) (*service.Service, error) {
+	startedServices, failedServices, err := network.AddServices(ctx, map[service.ServiceName]*service.ServiceConfig{serviceName: serviceConfig}, 1)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred adding service '%s'", serviceName)
+	}
+	if serviceErr, found := failedServices[serviceName]; found {
+		return nil, stacktrace.Propagate(serviceErr, "An error occurred adding service '%s'", serviceName)
+	}
+	return startedServices[serviceName], nil
+}
+
+func (network *FakeServiceNetwork) AddServices(_ context.Context, serviceConfigs map[service.ServiceName]*service.ServiceConfig, _ int) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	startedServices := map[service.ServiceName]*service.Service{}
+	failedServices := map[service.ServiceName]error{}
+	// the services are added in the order of their names, so that they always get the same IPs
+	serviceNames := make([]service.ServiceName, 0, len(serviceConfigs))
+	for serviceName := range serviceConfigs {
+		serviceNames = append(serviceNames, serviceName)
+	}
+	sort.Slice(serviceNames, func(i, j int) bool { return serviceNames[i] < serviceNames[j] })
+	for _, serviceName := range serviceNames {
+		serviceConfig := serviceConfigs[serviceName]
+		if _, found := network.services[serviceName]; found {
+			failedServices[serviceName] = stacktrace.NewError("A service with name '%s' already exists in the enclave", serviceName)
+			continue
+		}
+		network.numberOfServicesAdded += 1
+		ipSuffix := network.numberOfServicesAdded - 1 + firstFakeServiceIpSuffix
+		serviceUuid := service.ServiceUUID(fmt.Sprintf(fakeServiceUuidFormat, network.numberOfServicesAdded))
+		registration := service.NewServiceRegistration(
+			serviceName,
+			serviceUuid,
+			network.enclaveUuid,
+			net.IPv4(10, 0, byte(ipSuffix/ipBytesBase), byte(ipSuffix%ipBytesBase)),
+			string(serviceName),
+		)
+		registration.SetConfig(serviceConfig)
+		startedService := service.NewService(registration, container_status.ContainerStatus_Running, serviceConfig.GetPrivatePorts(), nil, nil)
+		network.services[serviceName] = startedService
+		network.historicalServiceIdentifiers = append(network.historicalServiceIdentifiers, service_identifiers.NewServiceIdentifier(serviceUuid, serviceName))
+		startedServices[serviceName] = startedService
+	}
+	return startedServices, failedServices, nil
+}
+
+func (network *FakeServiceNetwork) UpdateService(ctx context.Context, serviceName service.ServiceName, updateServiceConfig *service.ServiceConfig) (*service.Service, error) {
+	updatedServices, failedServices, err := network.UpdateServices(ctx, map[service.ServiceName]*service.ServiceConfig{serviceName: updateServiceConfig}, 1)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred updating service '%s'", serviceName)
+	}
+	if serviceErr, found := failedServices[serviceName]; found {
+		return nil, stacktrace.Propagate(serviceErr, "An error occurred updating service '%s'", serviceName)
+	}
+	return updatedServices[serviceName], nil
+}
+
+func (network *FakeServiceNetwork) UpdateServices(_ context.Context, updateServiceConfigs map[service.ServiceName]*service.ServiceConfig, _ int) (map[service.ServiceName]*service.Service, map[service.ServiceName]error, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	updatedServices := map[service.ServiceName]*service.Service{}
+	failedServices := map[service.ServiceName]error{}
+	for serviceName, serviceConfig := range updateServiceConfigs {
+		existingService, found := network.services[serviceName]
+		if !found {
+			failedServices[serviceName] = stacktrace.NewError("Service '%s' doesn't exist in the enclave", serviceName)
+			continue
+		}
+		registration := existingService.GetRegistration()
+		registration.SetConfig(serviceConfig)
+		updatedService := service.NewService(registration, container_status.ContainerStatus_Running, serviceConfig.GetPrivatePorts(), nil, nil)
+		network.services[serviceName] = updatedService
+		updatedServices[serviceName] = updatedService
+	}
+	return updatedServices, failedServices, nil
+}
+
+func (network *FakeServiceNetwork) RemoveService(_ context.Context, serviceIdentifier string) (service.ServiceUUID, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	serviceToRemove, err := network.getServiceUnlocked(serviceIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceIdentifier)
+	}
+	delete(network.services, serviceToRemove.GetRegistration().GetName())
+	return serviceToRemove.GetRegistration().GetUUID(), nil
+}
+
+func (network *FakeServiceNetwork) StartService(_ context.Context, serviceIdentifier string) error {
+	return network.setServiceStatus(serviceIdentifier, container_status.ContainerStatus_Running)
+}
+
+func (network *FakeServiceNetwork) StartServices(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	return network.setServicesStatus(ctx, serviceIdentifiers, network.StartService)
+}
+
+func (network *FakeServiceNetwork) StopService(_ context.Context, serviceIdentifier string) error {
+	return network.setServiceStatus(serviceIdentifier, container_status.ContainerStatus_Stopped)
+}
+
+func (network *FakeServiceNetwork) StopServices(ctx context.Context, serviceIdentifiers []string) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	return network.setServicesStatus(ctx, serviceIdentifiers, network.StopService)
+}
+
+func (network *FakeServiceNetwork) RunExec(_ context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceUnlocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%s' to run the command in", serviceIdentifier)
+	}
+	serviceName := existingService.GetRegistration().GetName()
+	if result, found := network.fakeExecResults[fakeRequestKey{serviceName: serviceName, target: strings.Join(userServiceCommand, fakeExecCommandSeparator)}]; found {
+		return result, nil
+	}
+	if result, found := network.fakeExecResults[fakeRequestKey{serviceName: serviceName, target: strings.Join(anyFakeExecCommand, fakeExecCommandSeparator)}]; found {
+		return result, nil
+	}
+	return exec_result.NewExecResult(defaultFakeExecExitCode, emptyFakeResponseBody), nil
+}
+
+func (network *FakeServiceNetwork) RunExecs(ctx context.Context, userServiceCommands map[string][]string) (map[service.ServiceUUID]*exec_result.ExecResult, map[service.ServiceUUID]error, error) {
+	successfulExecs := map[service.ServiceUUID]*exec_result.ExecResult{}
+	failedExecs := map[service.ServiceUUID]error{}
+	for serviceIdentifier, userServiceCommand := range userServiceCommands {
+		existingService, err := network.GetService(ctx, serviceIdentifier)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting service '%s' to run the command in", serviceIdentifier)
+		}
+		result, err := network.RunExec(ctx, serviceIdentifier, userServiceCommand)
+		if err != nil {
+			failedExecs[existingService.GetRegistration().GetUUID()] = err
+			continue
+		}
+		successfulExecs[existingService.GetRegistration().GetUUID()] = result
+	}
+	return successfulExecs, failedExecs, nil
+}
+
+func (network *FakeServiceNetwork) HttpRequestService(_ context.Context, serviceIdentifier string, portId string, _ string, _ string, endpoint string, _ string, _ *HttpRequestOptions) (*http.Response, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceUnlocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%s' to send the request to", serviceIdentifier)
+	}
+	if _, found := existingService.GetPrivatePorts()[portId]; !found {
+		return nil, stacktrace.NewError("Service '%s' doesn't have a port with ID '%s'", serviceIdentifier, portId)
+	}
+	response := NewFakeHttpResponse(defaultFakeHttpStatusCode, emptyFakeResponseBody)
+	if fakeResponse, found := network.fakeHttpResponses[fakeRequestKey{serviceName: existingService.GetRegistration().GetName(), target: endpoint}]; found {
+		response = fakeResponse
+	}
+	return &http.Response{ //nolint:exhaustruct
+		Status:     http.StatusText(response.statusCode),
+		StatusCode: response.statusCode,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(response.body)),
+	}, nil
+}
+
+func (network *FakeServiceNetwork) GrpcRequestService(_ context.Context, serviceIdentifier string, portId string, fullMethodName string, _ string, _ *GrpcRequestOptions) (*grpc_request.GrpcResponse, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceUnlocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%s' to send the request to", serviceIdentifier)
+	}
+	if _, found := existingService.GetPrivatePorts()[portId]; !found {
+		return nil, stacktrace.NewError("Service '%s' doesn't have a port with ID '%s'", serviceIdentifier, portId)
+	}
+	if fakeResponse, found := network.fakeGrpcResponses[fakeRequestKey{serviceName: existingService.GetRegistration().GetName(), target: fullMethodName}]; found {
+		return fakeResponse, nil
+	}
+	return grpc_request.NewGrpcResponse(codes.OK, emptyFakeResponseBody, emptyFakeResponseBody), nil
+}
+
+// ProbeServicePort always succeeds, the port sending back the expected data
+func (network *FakeServiceNetwork) ProbeServicePort(_ context.Context, serviceIdentifier string, portId string, _ port_spec.TransportProtocol, _ string, expectedData string, _ time.Duration) (string, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceUnlocked(serviceIdentifier)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting service '%s' to probe", serviceIdentifier)
+	}
+	if _, found := existingService.GetPrivatePorts()[portId]; !found {
+		return "", stacktrace.NewError("Service '%s' doesn't have a port with ID '%s'", serviceIdentifier, portId)
+	}
+	return expectedData, nil
+}
+
+func (network *FakeServiceNetwork) GetService(_ context.Context, serviceIdentifier string) (*service.Service, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceUnlocked(serviceIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceIdentifier)
+	}
+	return existingService, nil
+}
+
+func (network *FakeServiceNetwork) GetServices(_ context.Context) (map[service.ServiceUUID]*service.Service, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	servicesByUuid := map[service.ServiceUUID]*service.Service{}
+	for _, existingService := range network.services {
+		servicesByUuid[existingService.GetRegistration().GetUUID()] = existingService
+	}
+	return servicesByUuid, nil
+}
+
+// MonitorServiceHealth is a no-op, the fake services are always healthy
+func (network *FakeServiceNetwork) MonitorServiceHealth(_ service.ServiceName, _ *service_health.HealthConfig) error {
+	return nil
+}
+
+func (network *FakeServiceNetwork) GetServiceHealth(_ service.ServiceName) (*service_health.ServiceHealth, bool) {
+	return nil, false
+}
+
+func (network *FakeServiceNetwork) CopyFilesFromService(ctx context.Context, serviceIdentifier string, _ string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	if _, err := network.GetService(ctx, serviceIdentifier); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred getting service '%s' to copy the files from", serviceIdentifier)
+	}
+	return network.addFilesArtifact(artifactName, nil)
+}
+
+func (network *FakeServiceNetwork) GetServiceNames() (map[service.ServiceName]bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	serviceNames := map[service.ServiceName]bool{}
+	for serviceName := range network.services {
+		serviceNames[serviceName] = true
+	}
+	return serviceNames, nil
+}
+
+func (network *FakeServiceNetwork) GetExistingAndHistoricalServiceIdentifiers() (service_identifiers.ServiceIdentifiers, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	return network.historicalServiceIdentifiers, nil
+}
+
+func (network *FakeServiceNetwork) ExistServiceRegistration(serviceName service.ServiceName) (bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	_, found := network.services[serviceName]
+	return found, nil
+}
+
+func (network *FakeServiceNetwork) RenderTemplates(_ map[string]*render_templates.TemplateData, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return network.addFilesArtifact(artifactName, nil)
+}
+
+func (network *FakeServiceNetwork) UploadFilesArtifact(_ io.Reader, contentMd5 []byte, artifactName string) (enclave_data_directory.FilesArtifactUUID, error) {
+	return network.addFilesArtifact(artifactName, contentMd5)
+}
+
+func (network *FakeServiceNetwork) GetFilesArtifactMd5(artifactName string) (enclave_data_directory.FilesArtifactUUID, []byte, bool, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	filesArtifactUuid, found := network.filesArtifactUuids[artifactName]
+	if !found {
+		return "", nil, false, nil
+	}
+	return filesArtifactUuid, network.filesArtifactMd5s[artifactName], true, nil
+}
+
+func (network *FakeServiceNetwork) UpdateFilesArtifact(filesArtifactUuid enclave_data_directory.FilesArtifactUUID, _ io.Reader, contentMd5 []byte) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	for artifactName, existingFilesArtifactUuid := range network.filesArtifactUuids {
+		if existingFilesArtifactUuid == filesArtifactUuid {
+			network.filesArtifactMd5s[artifactName] = contentMd5
+			return nil
+		}
+	}
+	return stacktrace.NewError("Files artifact '%s' doesn't exist in the enclave", filesArtifactUuid)
+}
+
+// GetUniqueNameForFileArtifact returns predictable names, so that tests can rely on them
+func (network *FakeServiceNetwork) GetUniqueNameForFileArtifact() (string, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	for suffix := network.numberOfFilesArtifactsAdded + 1; ; suffix++ {
+		artifactName := fmt.Sprintf(fakeFilesArtifactNameFormat, suffix)
+		if _, found := network.filesArtifactUuids[artifactName]; !found {
+			return artifactName, nil
+		}
+	}
+}
+
+func (network *FakeServiceNetwork) SetConnection(_ context.Context, _ partition_topology.PartitionID, _ partition_topology.PartitionID, _ partition_topology.PartitionConnection) error {
+	return nil
+}
+
+func (network *FakeServiceNetwork) UnsetConnection(_ context.Context, _ partition_topology.PartitionID, _ partition_topology.PartitionID) error {
+	return nil
+}
+
+func (network *FakeServiceNetwork) SetDefaultConnection(_ context.Context, _ partition_topology.PartitionConnection) error {
+	return nil
+}
+
+func (network *FakeServiceNetwork) SetServicePartition(_ context.Context, serviceName service.ServiceName, _ partition_topology.PartitionID) error {
+	if _, err := network.GetService(context.Background(), string(serviceName)); err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%s' to move to the partition", serviceName)
+	}
+	return nil
+}
+
+func (network *FakeServiceNetwork) Repartition(_ context.Context, _ map[service.ServiceName]partition_topology.PartitionID, _ map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection, _ partition_topology.PartitionConnection) error {
+	return nil
+}
+
+func (network *FakeServiceNetwork) GetApiContainerInfo() *ApiContainerInfo {
+	return network.apiContainerInfo
+}
+
+func (network *FakeServiceNetwork) GetEnclaveUuid() enclave.EnclaveUUID {
+	return network.enclaveUuid
+}
+
+// ====================================================================================================
+//
+//	Private helper methods
+//
+// ====================================================================================================
+func (network *FakeServiceNetwork) getServiceUnlocked(serviceIdentifier string) (*service.Service, error) {
+	for serviceName, existingService := range network.services {
+		if string(serviceName) == serviceIdentifier || string(existingService.GetRegistration().GetUUID()) == serviceIdentifier {
+			return existingService, nil
+		}
+	}
+	return nil, stacktrace.NewError("Service '%s' doesn't exist in the enclave", serviceIdentifier)
+}
+
+func (network *FakeServiceNetwork) setServiceStatus(serviceIdentifier string, status container_status.ContainerStatus) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	existingService, err := network.getServiceUnlocked(serviceIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceIdentifier)
+	}
+	network.services[existingService.GetRegistration().GetName()] = service.NewService(
+		existingService.GetRegistration(),
+		status,
+		existingService.GetPrivatePorts(),
+		existingService.GetMaybePublicIP(),
+		existingService.GetMaybePublicPorts(),
+	)
+	return nil
+}
+
+func (network *FakeServiceNetwork) setServicesStatus(
+	ctx context.Context,
+	serviceIdentifiers []string,
+	setStatus func(ctx context.Context, serviceIdentifier string) error,
+) (map[service.ServiceUUID]bool, map[service.ServiceUUID]error, error) {
+	successfulServices := map[service.ServiceUUID]bool{}
+	failedServices := map[service.ServiceUUID]error{}
+	for _, serviceIdentifier := range serviceIdentifiers {
+		existingService, err := network.GetService(ctx, serviceIdentifier)
+		if err != nil {
+			return nil, nil, stacktrace.Propagate(err, "An error occurred getting service '%s'", serviceIdentifier)
+		}
+		if err := setStatus(ctx, serviceIdentifier); err != nil {
+			failedServices[existingService.GetRegistration().GetUUID()] = err
+			continue
+		}
+		successfulServices[existingService.GetRegistration().GetUUID()] = true
+	}
+	return successfulServices, failedServices, nil
+}
+
+func (network *FakeServiceNetwork) addFilesArtifact(artifactName string, contentMd5 []byte) (enclave_data_directory.FilesArtifactUUID, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+	if _, found := network.filesArtifactUuids[artifactName]; found {
+		return "", stacktrace.NewError("A files artifact with name '%s' already exists in the enclave", artifactName)
+	}
+	network.numberOfFilesArtifactsAdded += 1
+	filesArtifactUuid := enclave_data_directory.FilesArtifactUUID(fmt.Sprintf(fakeFilesArtifactUuidFormat, network.numberOfFilesArtifactsAdded))
+	if contentMd5 == nil {
+		hash := md5.Sum([]byte(artifactName))
+		contentMd5 = hash[:]
+	}
+	network.filesArtifactUuids[artifactName] = filesArtifactUuid
+	network.filesArtifactMd5s[artifactName] = contentMd5
+	return filesArtifactUuid, nil
+}
@@ -0,0 +1,276 @@
+package grpc_request
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	// Registers the descriptors of the standard health check service, so that it can be called on servers which don't
+	// support reflection
+	_ "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	methodNameSeparator = "/"
+
+	// Same as what the default gRPC client accepts
+	emptyRequestJson = "{}"
+)
+
+// GrpcResponse is the outcome of a unary gRPC call. A call failing with a gRPC status is not an error, its status code
+// is returned like the one of a successful call
+type GrpcResponse struct {
+	statusCode codes.Code
+
+	statusMessage string
+
+	// The response message encoded as JSON, empty if the call did not succeed
+	body string
+}
+
+func NewGrpcResponse(statusCode codes.Code, statusMessage string, body string) *GrpcResponse {
+	return &GrpcResponse{
+		statusCode:    statusCode,
+		statusMessage: statusMessage,
+		body:          body,
+	}
+}
+
+func (response *GrpcResponse) GetStatusCode() codes.Code {
+	return response.statusCode
+}
+
+func (response *GrpcResponse) GetStatusMessage() string {
+	return response.statusMessage
+}
+
+func (response *GrpcResponse) GetBody() string {
+	return response.body
+}
+
+// CallUnaryMethod calls the unary method named like 'package.Service/Method', taking and returning messages encoded as
+// JSON. The descriptor of the method is looked up in the descriptor set if it's not nil, then in the descriptors
+// compiled in Kurtosis (like the ones of the standard health check), and finally through the server reflection
+// service of the server
+func CallUnaryMethod(
+	ctx context.Context,
+	connection *grpc.ClientConn,
+	fullMethodName string,
+	requestJson string,
+	requestMetadata map[string]string,
+	descriptorSet *descriptorpb.FileDescriptorSet,
+) (*GrpcResponse, error) {
+	serviceName, methodName, err := splitFullMethodName(fullMethodName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing gRPC method name '%s'", fullMethodName)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, metadata.New(requestMetadata))
+
+	serviceDescriptor, err := findServiceDescriptor(ctx, connection, serviceName, descriptorSet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred finding the descriptor of gRPC service '%s'", serviceName)
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(methodName))
+	if methodDescriptor == nil {
+		return nil, stacktrace.NewError("gRPC service '%s' has no method '%s'", serviceName, methodName)
+	}
+	if methodDescriptor.IsStreamingClient() || methodDescriptor.IsStreamingServer() {
+		return nil, stacktrace.NewError("gRPC method '%s' is a streaming method, only unary methods are supported", fullMethodName)
+	}
+
+	if requestJson == "" {
+		requestJson = emptyRequestJson
+	}
+	request := dynamicpb.NewMessage(methodDescriptor.Input())
+	if err := protojson.Unmarshal([]byte(requestJson), request); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the JSON request '%s' as a '%s' message", requestJson, methodDescriptor.Input().FullName())
+	}
+	response := dynamicpb.NewMessage(methodDescriptor.Output())
+	invokeErr := connection.Invoke(ctx, methodNameSeparator+serviceName+methodNameSeparator+methodName, request, response)
+	if invokeErr != nil {
+		callStatus, isStatus := status.FromError(invokeErr)
+		if !isStatus {
+			return nil, stacktrace.Propagate(invokeErr, "An error occurred calling gRPC method '%s'", fullMethodName)
+		}
+		return NewGrpcResponse(callStatus.Code(), callStatus.Message(), ""), nil
+	}
+
+	responseJson, err := marshalResponse(response)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred encoding the response of gRPC method '%s' as JSON", fullMethodName)
+	}
+	return NewGrpcResponse(codes.OK, "", responseJson), nil
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+
+// splitFullMethodName accepts both 'package.Service/Method' and the '/package.Service/Method' form used on the wire
+func splitFullMethodName(fullMethodName string) (string, string, error) {
+	trimmedName := strings.TrimPrefix(fullMethodName, methodNameSeparator)
+	separatorIndex := strings.LastIndex(trimmedName, methodNameSeparator)
+	if separatorIndex <= 0 || separatorIndex == len(trimmedName)-1 {
+		return "", "", stacktrace.NewError("Method name '%s' is not of the form 'package.Service/Method'", fullMethodName)
+	}
+	return trimmedName[:separatorIndex], trimmedName[separatorIndex+1:], nil
+}
+
+func findServiceDescriptor(ctx context.Context, connection *grpc.ClientConn, serviceName string, descriptorSet *descriptorpb.FileDescriptorSet) (protoreflect.ServiceDescriptor, error) {
+	if descriptorSet != nil {
+		files, err := protodesc.NewFiles(descriptorSet)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred building the descriptors of the descriptor set")
+		}
+		return findServiceDescriptorInFiles(files, serviceName)
+	}
+	if serviceDescriptor, err := findServiceDescriptorInFiles(protoregistry.GlobalFiles, serviceName); err == nil {
+		return serviceDescriptor, nil
+	}
+	files, err := getFilesFromServerReflection(ctx, connection, serviceName)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the descriptor of service '%s' through server reflection. Either the server must support reflection, or a descriptor set must be provided", serviceName)
+	}
+	return findServiceDescriptorInFiles(files, serviceName)
+}
+
+func findServiceDescriptorInFiles(files *protoregistry.Files, serviceName string) (protoreflect.ServiceDescriptor, error) {
+	descriptor, err := files.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "No descriptor found for '%s'", serviceName)
+	}
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, stacktrace.NewError("'%s' is not a gRPC service", serviceName)
+	}
+	return serviceDescriptor, nil
+}
+
+// getFilesFromServerReflection fetches the file defining the service along with all its dependencies. Dependencies
+// compiled in Kurtosis, like the well-known types, are not fetched if the server does not send them
+func getFilesFromServerReflection(ctx context.Context, connection *grpc.ClientConn, serviceName string) (*protoregistry.Files, error) {
+	reflectionClient := grpc_reflection_v1alpha.NewServerReflectionClient(connection)
+	stream, err := reflectionClient.ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred opening the server reflection stream")
+	}
+	defer func() {
+		_ = stream.CloseSend()
+	}()
+
+	fileProtosByName := map[string]*descriptorpb.FileDescriptorProto{}
+	receivedFileProtos, err := sendReflectionRequest(stream, &grpc_reflection_v1alpha.ServerReflectionRequest{ //nolint:exhaustruct
+		MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_FileContainingSymbol{
+			FileContainingSymbol: serviceName,
+		},
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the file defining '%s'", serviceName)
+	}
+	filesToVisit := []*descriptorpb.FileDescriptorProto{}
+	for _, fileProto := range receivedFileProtos {
+		fileProtosByName[fileProto.GetName()] = fileProto
+		filesToVisit = append(filesToVisit, fileProto)
+	}
+	for len(filesToVisit) > 0 {
+		fileProto := filesToVisit[0]
+		filesToVisit = filesToVisit[1:]
+		for _, dependencyName := range fileProto.GetDependency() {
+			if _, found := fileProtosByName[dependencyName]; found {
+				continue
+			}
+			if compiledFile, err := protoregistry.GlobalFiles.FindFileByPath(dependencyName); err == nil {
+				fileProtosByName[dependencyName] = protodesc.ToFileDescriptorProto(compiledFile)
+				continue
+			}
+			dependencyFileProtos, err := sendReflectionRequest(stream, &grpc_reflection_v1alpha.ServerReflectionRequest{ //nolint:exhaustruct
+				MessageRequest: &grpc_reflection_v1alpha.ServerReflectionRequest_FileByFilename{
+					FileByFilename: dependencyName,
+				},
+			})
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting dependency '%s' of file '%s'", dependencyName, fileProto.GetName())
+			}
+			for _, dependencyFileProto := range dependencyFileProtos {
+				if _, found := fileProtosByName[dependencyFileProto.GetName()]; !found {
+					fileProtosByName[dependencyFileProto.GetName()] = dependencyFileProto
+					filesToVisit = append(filesToVisit, dependencyFileProto)
+				}
+			}
+		}
+	}
+
+	descriptorSet := &descriptorpb.FileDescriptorSet{} //nolint:exhaustruct
+	for _, fileProto := range fileProtosByName {
+		descriptorSet.File = append(descriptorSet.File, fileProto)
+	}
+	files, err := protodesc.NewFiles(descriptorSet)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred building the descriptors received through server reflection")
+	}
+	return files, nil
+}
+
+func sendReflectionRequest(stream grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfoClient, request *grpc_reflection_v1alpha.ServerReflectionRequest) ([]*descriptorpb.FileDescriptorProto, error) {
+	if err := stream.Send(request); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred sending the server reflection request")
+	}
+	response, err := stream.Recv()
+	if err == io.EOF {
+		return nil, stacktrace.NewError("The server closed the server reflection stream without responding")
+	}
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred receiving the server reflection response")
+	}
+	if errorResponse := response.GetErrorResponse(); errorResponse != nil {
+		return nil, stacktrace.NewError("The server reflection request failed with code '%d': %s", errorResponse.GetErrorCode(), errorResponse.GetErrorMessage())
+	}
+	fileDescriptorResponse := response.GetFileDescriptorResponse()
+	if fileDescriptorResponse == nil {
+		return nil, stacktrace.NewError("The server reflection response did not contain any file descriptor")
+	}
+	fileProtos := []*descriptorpb.FileDescriptorProto{}
+	for _, serializedFileProto := range fileDescriptorResponse.GetFileDescriptorProto() {
+		fileProto := &descriptorpb.FileDescriptorProto{} //nolint:exhaustruct
+		if err := proto.Unmarshal(serializedFileProto, fileProto); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing a file descriptor received through server reflection")
+		}
+		fileProtos = append(fileProtos, fileProto)
+	}
+	return fileProtos, nil
+}
+
+// marshalResponse emits the fields set to their default value too, so that they can be extracted. The output of
+// protojson is compacted because it's purposely unstable
+func marshalResponse(response proto.Message) (string, error) {
+	marshaller := protojson.MarshalOptions{ //nolint:exhaustruct
+		EmitUnpopulated: true,
+	}
+	responseJson, err := marshaller.Marshal(response)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred encoding the response as JSON")
+	}
+	compactedResponseJson := &bytes.Buffer{}
+	if err := json.Compact(compactedResponseJson, responseJson); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred compacting JSON response '%s'", string(responseJson))
+	}
+	return compactedResponseJson.String(), nil
+}
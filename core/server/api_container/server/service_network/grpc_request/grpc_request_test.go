@@ -0,0 +1,115 @@
+package grpc_request
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+const (
+	localhostAddress = "127.0.0.1:0"
+
+	healthCheckMethod = "grpc.health.v1.Health/Check"
+
+	notServingServiceName = "not-serving"
+)
+
+func TestCallUnaryMethod_HealthCheck(t *testing.T) {
+	connection := startTestServer(t)
+
+	response, err := CallUnaryMethod(context.Background(), connection, healthCheckMethod, "", map[string]string{"x-test": "value"}, nil)
+	require.NoError(t, err)
+	require.Equal(t, codes.OK, response.GetStatusCode())
+	require.Equal(t, `{"status":"SERVING"}`, response.GetBody())
+
+	response, err = CallUnaryMethod(context.Background(), connection, "/"+healthCheckMethod, `{"service": "`+notServingServiceName+`"}`, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, codes.OK, response.GetStatusCode())
+	require.Equal(t, `{"status":"NOT_SERVING"}`, response.GetBody())
+}
+
+func TestCallUnaryMethod_StatusErrorIsAResponse(t *testing.T) {
+	connection := startTestServer(t)
+
+	response, err := CallUnaryMethod(context.Background(), connection, healthCheckMethod, `{"service": "unknown"}`, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, codes.NotFound, response.GetStatusCode())
+	require.Empty(t, response.GetBody())
+}
+
+func TestCallUnaryMethod_ServerReflection(t *testing.T) {
+	connection := startTestServer(t)
+
+	files, err := getFilesFromServerReflection(context.Background(), connection, "grpc.health.v1.Health")
+	require.NoError(t, err)
+	serviceDescriptor, err := findServiceDescriptorInFiles(files, "grpc.health.v1.Health")
+	require.NoError(t, err)
+	require.NotNil(t, serviceDescriptor.Methods().ByName("Check"))
+}
+
+func TestCallUnaryMethod_DescriptorSet(t *testing.T) {
+	connection := startTestServer(t)
+	descriptorSet := &descriptorpb.FileDescriptorSet{ //nolint:exhaustruct
+		File: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(grpc_health_v1.File_grpc_health_v1_health_proto),
+		},
+	}
+
+	response, err := CallUnaryMethod(context.Background(), connection, healthCheckMethod, "{}", nil, descriptorSet)
+	require.NoError(t, err)
+	require.Equal(t, `{"status":"SERVING"}`, response.GetBody())
+
+	_, err = CallUnaryMethod(context.Background(), connection, "unknown.Service/Method", "{}", nil, descriptorSet)
+	require.Error(t, err)
+}
+
+func TestCallUnaryMethod_StreamingMethodIsRejected(t *testing.T) {
+	connection := startTestServer(t)
+
+	_, err := CallUnaryMethod(context.Background(), connection, "grpc.health.v1.Health/Watch", "{}", nil, nil)
+	require.Error(t, err)
+}
+
+func TestSplitFullMethodName(t *testing.T) {
+	serviceName, methodName, err := splitFullMethodName("/grpc.health.v1.Health/Check")
+	require.NoError(t, err)
+	require.Equal(t, "grpc.health.v1.Health", serviceName)
+	require.Equal(t, "Check", methodName)
+
+	for _, invalidName := range []string{"", "Check", "/Check", "grpc.health.v1.Health/"} {
+		_, _, err = splitFullMethodName(invalidName)
+		require.Error(t, err, invalidName)
+	}
+}
+
+func startTestServer(t *testing.T) *grpc.ClientConn {
+	listener, err := net.Listen("tcp", localhostAddress)
+	require.NoError(t, err)
+
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(notServingServiceName, grpc_health_v1.HealthCheckResponse_NOT_SERVING)
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, healthServer)
+	reflection.Register(server)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+
+	connection, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = connection.Close()
+	})
+	return connection
+}
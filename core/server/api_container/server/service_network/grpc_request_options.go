@@ -0,0 +1,55 @@
+package service_network
+
+// GrpcRequestOptions holds the optional settings of a call made by GrpcRequestService. A nil GrpcRequestOptions makes
+// a plaintext call without metadata, resolving the method through the compiled-in descriptors or server reflection
+type GrpcRequestOptions struct {
+	metadata map[string]string
+
+	// Name of a files artifact containing a serialized FileDescriptorSet describing the called method, empty if the
+	// method must be resolved through server reflection
+	descriptorSetArtifactName string
+
+	// Path of the descriptor set inside the files artifact. Can be empty if the artifact contains a single file
+	descriptorSetFilePath string
+
+	useTls bool
+
+	// Only meaningful when useTls is true
+	skipTlsVerify bool
+}
+
+func NewGrpcRequestOptions(
+	metadata map[string]string,
+	descriptorSetArtifactName string,
+	descriptorSetFilePath string,
+	useTls bool,
+	skipTlsVerify bool,
+) *GrpcRequestOptions {
+	return &GrpcRequestOptions{
+		metadata:                  metadata,
+		descriptorSetArtifactName: descriptorSetArtifactName,
+		descriptorSetFilePath:     descriptorSetFilePath,
+		useTls:                    useTls,
+		skipTlsVerify:             skipTlsVerify,
+	}
+}
+
+func (options *GrpcRequestOptions) GetMetadata() map[string]string {
+	return options.metadata
+}
+
+func (options *GrpcRequestOptions) GetDescriptorSetArtifactName() string {
+	return options.descriptorSetArtifactName
+}
+
+func (options *GrpcRequestOptions) GetDescriptorSetFilePath() string {
+	return options.descriptorSetFilePath
+}
+
+func (options *GrpcRequestOptions) GetUseTls() bool {
+	return options.useTls
+}
+
+func (options *GrpcRequestOptions) GetSkipTlsVerify() bool {
+	return options.skipTlsVerify
+}
@@ -0,0 +1,44 @@
+package service_network
+
+// HttpRequestOptions holds the optional settings of a request sent by HttpRequestService. A nil HttpRequestOptions
+// sends a plain HTTP request without any extra header or query parameter
+type HttpRequestOptions struct {
+	headers map[string]string
+
+	queryParams map[string]string
+
+	useTls bool
+
+	// Only meaningful when useTls is true
+	skipTlsVerify bool
+}
+
+func NewHttpRequestOptions(
+	headers map[string]string,
+	queryParams map[string]string,
+	useTls bool,
+	skipTlsVerify bool,
+) *HttpRequestOptions {
+	return &HttpRequestOptions{
+		headers:       headers,
+		queryParams:   queryParams,
+		useTls:        useTls,
+		skipTlsVerify: skipTlsVerify,
+	}
+}
+
+func (options *HttpRequestOptions) GetHeaders() map[string]string {
+	return options.headers
+}
+
+func (options *HttpRequestOptions) GetQueryParams() map[string]string {
+	return options.queryParams
+}
+
+func (options *HttpRequestOptions) GetUseTls() bool {
+	return options.useTls
+}
+
+func (options *HttpRequestOptions) GetSkipTlsVerify() bool {
+	return options.skipTlsVerify
+}
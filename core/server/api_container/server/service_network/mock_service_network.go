@@ -10,17 +10,27 @@ import (
 
 	exec_result "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
 
+	grpc_request "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/grpc_request"
+
 	http "net/http"
 
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
 
+	partition_topology "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+
+	port_spec "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+
 	render_templates "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
 
 	service "github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 
+	service_health "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+
 	service_identifiers "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
+
+	time "time"
 )
 
 // MockServiceNetwork is an autogenerated mock type for the ServiceNetwork type
@@ -524,6 +534,60 @@ func (_c *MockServiceNetwork_GetService_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetServiceHealth provides a mock function with given fields: serviceName
+func (_m *MockServiceNetwork) GetServiceHealth(serviceName service.ServiceName) (*service_health.ServiceHealth, bool) {
+	ret := _m.Called(serviceName)
+
+	var r0 *service_health.ServiceHealth
+	var r1 bool
+	if rf, ok := ret.Get(0).(func(service.ServiceName) (*service_health.ServiceHealth, bool)); ok {
+		return rf(serviceName)
+	}
+	if rf, ok := ret.Get(0).(func(service.ServiceName) *service_health.ServiceHealth); ok {
+		r0 = rf(serviceName)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*service_health.ServiceHealth)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(service.ServiceName) bool); ok {
+		r1 = rf(serviceName)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GetServiceHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetServiceHealth'
+type MockServiceNetwork_GetServiceHealth_Call struct {
+	*mock.Call
+}
+
+// GetServiceHealth is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+func (_e *MockServiceNetwork_Expecter) GetServiceHealth(serviceName interface{}) *MockServiceNetwork_GetServiceHealth_Call {
+	return &MockServiceNetwork_GetServiceHealth_Call{Call: _e.mock.On("GetServiceHealth", serviceName)}
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) Run(run func(serviceName service.ServiceName)) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) Return(_a0 *service_health.ServiceHealth, _a1 bool) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GetServiceHealth_Call) RunAndReturn(run func(service.ServiceName) (*service_health.ServiceHealth, bool)) *MockServiceNetwork_GetServiceHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetServiceNames provides a mock function with given fields:
 func (_m *MockServiceNetwork) GetServiceNames() (map[service.ServiceName]bool, error) {
 	ret := _m.Called()
@@ -682,25 +746,84 @@ func (_c *MockServiceNetwork_GetUniqueNameForFileArtifact_Call) RunAndReturn(run
 	return _c
 }
 
-// HttpRequestService provides a mock function with given fields: ctx, serviceIdentifier, portId, method, contentType, endpoint, body
-func (_m *MockServiceNetwork) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string) (*http.Response, error) {
-	ret := _m.Called(ctx, serviceIdentifier, portId, method, contentType, endpoint, body)
+// GrpcRequestService provides a mock function with given fields: ctx, serviceIdentifier, portId, fullMethodName, requestBody, options
+func (_m *MockServiceNetwork) GrpcRequestService(ctx context.Context, serviceIdentifier string, portId string, fullMethodName string, requestBody string, options *GrpcRequestOptions) (*grpc_request.GrpcResponse, error) {
+	ret := _m.Called(ctx, serviceIdentifier, portId, fullMethodName, requestBody, options)
+
+	var r0 *grpc_request.GrpcResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *GrpcRequestOptions) (*grpc_request.GrpcResponse, error)); ok {
+		return rf(ctx, serviceIdentifier, portId, fullMethodName, requestBody, options)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, *GrpcRequestOptions) *grpc_request.GrpcResponse); ok {
+		r0 = rf(ctx, serviceIdentifier, portId, fullMethodName, requestBody, options)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*grpc_request.GrpcResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, *GrpcRequestOptions) error); ok {
+		r1 = rf(ctx, serviceIdentifier, portId, fullMethodName, requestBody, options)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_GrpcRequestService_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GrpcRequestService'
+type MockServiceNetwork_GrpcRequestService_Call struct {
+	*mock.Call
+}
+
+// GrpcRequestService is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - portId string
+//   - fullMethodName string
+//   - requestBody string
+//   - options *GrpcRequestOptions
+func (_e *MockServiceNetwork_Expecter) GrpcRequestService(ctx interface{}, serviceIdentifier interface{}, portId interface{}, fullMethodName interface{}, requestBody interface{}, options interface{}) *MockServiceNetwork_GrpcRequestService_Call {
+	return &MockServiceNetwork_GrpcRequestService_Call{Call: _e.mock.On("GrpcRequestService", ctx, serviceIdentifier, portId, fullMethodName, requestBody, options)}
+}
+
+func (_c *MockServiceNetwork_GrpcRequestService_Call) Run(run func(ctx context.Context, serviceIdentifier string, portId string, fullMethodName string, requestBody string, options *GrpcRequestOptions)) *MockServiceNetwork_GrpcRequestService_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(*GrpcRequestOptions))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_GrpcRequestService_Call) Return(_a0 *grpc_request.GrpcResponse, _a1 error) *MockServiceNetwork_GrpcRequestService_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_GrpcRequestService_Call) RunAndReturn(run func(context.Context, string, string, string, string, *GrpcRequestOptions) (*grpc_request.GrpcResponse, error)) *MockServiceNetwork_GrpcRequestService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// HttpRequestService provides a mock function with given fields: ctx, serviceIdentifier, portId, method, contentType, endpoint, body, options
+func (_m *MockServiceNetwork) HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, options *HttpRequestOptions) (*http.Response, error) {
+	ret := _m.Called(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, options)
 
 	var r0 *http.Response
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string) (*http.Response, error)); ok {
-		return rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, *HttpRequestOptions) (*http.Response, error)); ok {
+		return rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, options)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string) *http.Response); ok {
-		r0 = rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string, string, string, *HttpRequestOptions) *http.Response); ok {
+		r0 = rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, options)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*http.Response)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, string) error); ok {
-		r1 = rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string, string, string, *HttpRequestOptions) error); ok {
+		r1 = rf(ctx, serviceIdentifier, portId, method, contentType, endpoint, body, options)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -721,13 +844,14 @@ type MockServiceNetwork_HttpRequestService_Call struct {
 //   - contentType string
 //   - endpoint string
 //   - body string
-func (_e *MockServiceNetwork_Expecter) HttpRequestService(ctx interface{}, serviceIdentifier interface{}, portId interface{}, method interface{}, contentType interface{}, endpoint interface{}, body interface{}) *MockServiceNetwork_HttpRequestService_Call {
-	return &MockServiceNetwork_HttpRequestService_Call{Call: _e.mock.On("HttpRequestService", ctx, serviceIdentifier, portId, method, contentType, endpoint, body)}
+//   - options *HttpRequestOptions
+func (_e *MockServiceNetwork_Expecter) HttpRequestService(ctx interface{}, serviceIdentifier interface{}, portId interface{}, method interface{}, contentType interface{}, endpoint interface{}, body interface{}, options interface{}) *MockServiceNetwork_HttpRequestService_Call {
+	return &MockServiceNetwork_HttpRequestService_Call{Call: _e.mock.On("HttpRequestService", ctx, serviceIdentifier, portId, method, contentType, endpoint, body, options)}
 }
 
-func (_c *MockServiceNetwork_HttpRequestService_Call) Run(run func(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string)) *MockServiceNetwork_HttpRequestService_Call {
+func (_c *MockServiceNetwork_HttpRequestService_Call) Run(run func(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, options *HttpRequestOptions)) *MockServiceNetwork_HttpRequestService_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string), args[5].(string), args[6].(string), args[7].(*HttpRequestOptions))
 	})
 	return _c
 }
@@ -737,7 +861,108 @@ func (_c *MockServiceNetwork_HttpRequestService_Call) Return(_a0 *http.Response,
 	return _c
 }
 
-func (_c *MockServiceNetwork_HttpRequestService_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, string) (*http.Response, error)) *MockServiceNetwork_HttpRequestService_Call {
+func (_c *MockServiceNetwork_HttpRequestService_Call) RunAndReturn(run func(context.Context, string, string, string, string, string, string, *HttpRequestOptions) (*http.Response, error)) *MockServiceNetwork_HttpRequestService_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MonitorServiceHealth provides a mock function with given fields: serviceName, healthConfig
+func (_m *MockServiceNetwork) MonitorServiceHealth(serviceName service.ServiceName, healthConfig *service_health.HealthConfig) error {
+	ret := _m.Called(serviceName, healthConfig)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(service.ServiceName, *service_health.HealthConfig) error); ok {
+		r0 = rf(serviceName, healthConfig)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_MonitorServiceHealth_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MonitorServiceHealth'
+type MockServiceNetwork_MonitorServiceHealth_Call struct {
+	*mock.Call
+}
+
+// MonitorServiceHealth is a helper method to define mock.On call
+//   - serviceName service.ServiceName
+//   - healthConfig *service_health.HealthConfig
+func (_e *MockServiceNetwork_Expecter) MonitorServiceHealth(serviceName interface{}, healthConfig interface{}) *MockServiceNetwork_MonitorServiceHealth_Call {
+	return &MockServiceNetwork_MonitorServiceHealth_Call{Call: _e.mock.On("MonitorServiceHealth", serviceName, healthConfig)}
+}
+
+func (_c *MockServiceNetwork_MonitorServiceHealth_Call) Run(run func(serviceName service.ServiceName, healthConfig *service_health.HealthConfig)) *MockServiceNetwork_MonitorServiceHealth_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(service.ServiceName), args[1].(*service_health.HealthConfig))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_MonitorServiceHealth_Call) Return(_a0 error) *MockServiceNetwork_MonitorServiceHealth_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_MonitorServiceHealth_Call) RunAndReturn(run func(service.ServiceName, *service_health.HealthConfig) error) *MockServiceNetwork_MonitorServiceHealth_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ProbeServicePort provides a mock function with given fields: ctx, serviceIdentifier, portId, transportProtocol, dataToSend, expectedData, timeout
+func (_m *MockServiceNetwork) ProbeServicePort(ctx context.Context, serviceIdentifier string, portId string, transportProtocol port_spec.TransportProtocol, dataToSend string, expectedData string, timeout time.Duration) (string, error) {
+	ret := _m.Called(ctx, serviceIdentifier, portId, transportProtocol, dataToSend, expectedData, timeout)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, port_spec.TransportProtocol, string, string, time.Duration) (string, error)); ok {
+		return rf(ctx, serviceIdentifier, portId, transportProtocol, dataToSend, expectedData, timeout)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, port_spec.TransportProtocol, string, string, time.Duration) string); ok {
+		r0 = rf(ctx, serviceIdentifier, portId, transportProtocol, dataToSend, expectedData, timeout)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, port_spec.TransportProtocol, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, serviceIdentifier, portId, transportProtocol, dataToSend, expectedData, timeout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MockServiceNetwork_ProbeServicePort_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ProbeServicePort'
+type MockServiceNetwork_ProbeServicePort_Call struct {
+	*mock.Call
+}
+
+// ProbeServicePort is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceIdentifier string
+//   - portId string
+//   - transportProtocol port_spec.TransportProtocol
+//   - dataToSend string
+//   - expectedData string
+//   - timeout time.Duration
+func (_e *MockServiceNetwork_Expecter) ProbeServicePort(ctx interface{}, serviceIdentifier interface{}, portId interface{}, transportProtocol interface{}, dataToSend interface{}, expectedData interface{}, timeout interface{}) *MockServiceNetwork_ProbeServicePort_Call {
+	return &MockServiceNetwork_ProbeServicePort_Call{Call: _e.mock.On("ProbeServicePort", ctx, serviceIdentifier, portId, transportProtocol, dataToSend, expectedData, timeout)}
+}
+
+func (_c *MockServiceNetwork_ProbeServicePort_Call) Run(run func(ctx context.Context, serviceIdentifier string, portId string, transportProtocol port_spec.TransportProtocol, dataToSend string, expectedData string, timeout time.Duration)) *MockServiceNetwork_ProbeServicePort_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(port_spec.TransportProtocol), args[4].(string), args[5].(string), args[6].(time.Duration))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_ProbeServicePort_Call) Return(_a0 string, _a1 error) *MockServiceNetwork_ProbeServicePort_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *MockServiceNetwork_ProbeServicePort_Call) RunAndReturn(run func(context.Context, string, string, port_spec.TransportProtocol, string, string, time.Duration) (string, error)) *MockServiceNetwork_ProbeServicePort_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -848,6 +1073,51 @@ func (_c *MockServiceNetwork_RenderTemplates_Call) RunAndReturn(run func(map[str
 	return _c
 }
 
+// Repartition provides a mock function with given fields: ctx, servicePartitions, partitionConnections, defaultConnection
+func (_m *MockServiceNetwork) Repartition(ctx context.Context, servicePartitions map[service.ServiceName]partition_topology.PartitionID, partitionConnections map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection, defaultConnection partition_topology.PartitionConnection) error {
+	ret := _m.Called(ctx, servicePartitions, partitionConnections, defaultConnection)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[service.ServiceName]partition_topology.PartitionID, map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection, partition_topology.PartitionConnection) error); ok {
+		r0 = rf(ctx, servicePartitions, partitionConnections, defaultConnection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_Repartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Repartition'
+type MockServiceNetwork_Repartition_Call struct {
+	*mock.Call
+}
+
+// Repartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - servicePartitions map[service.ServiceName]partition_topology.PartitionID
+//   - partitionConnections map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection
+//   - defaultConnection partition_topology.PartitionConnection
+func (_e *MockServiceNetwork_Expecter) Repartition(ctx interface{}, servicePartitions interface{}, partitionConnections interface{}, defaultConnection interface{}) *MockServiceNetwork_Repartition_Call {
+	return &MockServiceNetwork_Repartition_Call{Call: _e.mock.On("Repartition", ctx, servicePartitions, partitionConnections, defaultConnection)}
+}
+
+func (_c *MockServiceNetwork_Repartition_Call) Run(run func(ctx context.Context, servicePartitions map[service.ServiceName]partition_topology.PartitionID, partitionConnections map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection, defaultConnection partition_topology.PartitionConnection)) *MockServiceNetwork_Repartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(map[service.ServiceName]partition_topology.PartitionID), args[2].(map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection), args[3].(partition_topology.PartitionConnection))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_Repartition_Call) Return(_a0 error) *MockServiceNetwork_Repartition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_Repartition_Call) RunAndReturn(run func(context.Context, map[service.ServiceName]partition_topology.PartitionID, map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection, partition_topology.PartitionConnection) error) *MockServiceNetwork_Repartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // RunExec provides a mock function with given fields: ctx, serviceIdentifier, userServiceCommand
 func (_m *MockServiceNetwork) RunExec(ctx context.Context, serviceIdentifier string, userServiceCommand []string) (*exec_result.ExecResult, error) {
 	ret := _m.Called(ctx, serviceIdentifier, userServiceCommand)
@@ -968,6 +1238,138 @@ func (_c *MockServiceNetwork_RunExecs_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// SetConnection provides a mock function with given fields: ctx, partition1, partition2, connection
+func (_m *MockServiceNetwork) SetConnection(ctx context.Context, partition1 partition_topology.PartitionID, partition2 partition_topology.PartitionID, connection partition_topology.PartitionConnection) error {
+	ret := _m.Called(ctx, partition1, partition2, connection)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, partition_topology.PartitionID, partition_topology.PartitionID, partition_topology.PartitionConnection) error); ok {
+		r0 = rf(ctx, partition1, partition2, connection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SetConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetConnection'
+type MockServiceNetwork_SetConnection_Call struct {
+	*mock.Call
+}
+
+// SetConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partition1 partition_topology.PartitionID
+//   - partition2 partition_topology.PartitionID
+//   - connection partition_topology.PartitionConnection
+func (_e *MockServiceNetwork_Expecter) SetConnection(ctx interface{}, partition1 interface{}, partition2 interface{}, connection interface{}) *MockServiceNetwork_SetConnection_Call {
+	return &MockServiceNetwork_SetConnection_Call{Call: _e.mock.On("SetConnection", ctx, partition1, partition2, connection)}
+}
+
+func (_c *MockServiceNetwork_SetConnection_Call) Run(run func(ctx context.Context, partition1 partition_topology.PartitionID, partition2 partition_topology.PartitionID, connection partition_topology.PartitionConnection)) *MockServiceNetwork_SetConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(partition_topology.PartitionID), args[2].(partition_topology.PartitionID), args[3].(partition_topology.PartitionConnection))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetConnection_Call) Return(_a0 error) *MockServiceNetwork_SetConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetConnection_Call) RunAndReturn(run func(context.Context, partition_topology.PartitionID, partition_topology.PartitionID, partition_topology.PartitionConnection) error) *MockServiceNetwork_SetConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetDefaultConnection provides a mock function with given fields: ctx, connection
+func (_m *MockServiceNetwork) SetDefaultConnection(ctx context.Context, connection partition_topology.PartitionConnection) error {
+	ret := _m.Called(ctx, connection)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, partition_topology.PartitionConnection) error); ok {
+		r0 = rf(ctx, connection)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SetDefaultConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetDefaultConnection'
+type MockServiceNetwork_SetDefaultConnection_Call struct {
+	*mock.Call
+}
+
+// SetDefaultConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - connection partition_topology.PartitionConnection
+func (_e *MockServiceNetwork_Expecter) SetDefaultConnection(ctx interface{}, connection interface{}) *MockServiceNetwork_SetDefaultConnection_Call {
+	return &MockServiceNetwork_SetDefaultConnection_Call{Call: _e.mock.On("SetDefaultConnection", ctx, connection)}
+}
+
+func (_c *MockServiceNetwork_SetDefaultConnection_Call) Run(run func(ctx context.Context, connection partition_topology.PartitionConnection)) *MockServiceNetwork_SetDefaultConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(partition_topology.PartitionConnection))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetDefaultConnection_Call) Return(_a0 error) *MockServiceNetwork_SetDefaultConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetDefaultConnection_Call) RunAndReturn(run func(context.Context, partition_topology.PartitionConnection) error) *MockServiceNetwork_SetDefaultConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetServicePartition provides a mock function with given fields: ctx, serviceName, partitionId
+func (_m *MockServiceNetwork) SetServicePartition(ctx context.Context, serviceName service.ServiceName, partitionId partition_topology.PartitionID) error {
+	ret := _m.Called(ctx, serviceName, partitionId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, service.ServiceName, partition_topology.PartitionID) error); ok {
+		r0 = rf(ctx, serviceName, partitionId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_SetServicePartition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetServicePartition'
+type MockServiceNetwork_SetServicePartition_Call struct {
+	*mock.Call
+}
+
+// SetServicePartition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - serviceName service.ServiceName
+//   - partitionId partition_topology.PartitionID
+func (_e *MockServiceNetwork_Expecter) SetServicePartition(ctx interface{}, serviceName interface{}, partitionId interface{}) *MockServiceNetwork_SetServicePartition_Call {
+	return &MockServiceNetwork_SetServicePartition_Call{Call: _e.mock.On("SetServicePartition", ctx, serviceName, partitionId)}
+}
+
+func (_c *MockServiceNetwork_SetServicePartition_Call) Run(run func(ctx context.Context, serviceName service.ServiceName, partitionId partition_topology.PartitionID)) *MockServiceNetwork_SetServicePartition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(service.ServiceName), args[2].(partition_topology.PartitionID))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetServicePartition_Call) Return(_a0 error) *MockServiceNetwork_SetServicePartition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_SetServicePartition_Call) RunAndReturn(run func(context.Context, service.ServiceName, partition_topology.PartitionID) error) *MockServiceNetwork_SetServicePartition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StartService provides a mock function with given fields: ctx, serviceIdentifier
 func (_m *MockServiceNetwork) StartService(ctx context.Context, serviceIdentifier string) error {
 	ret := _m.Called(ctx, serviceIdentifier)
@@ -1182,6 +1584,50 @@ func (_c *MockServiceNetwork_StopServices_Call) RunAndReturn(run func(context.Co
 	return _c
 }
 
+// UnsetConnection provides a mock function with given fields: ctx, partition1, partition2
+func (_m *MockServiceNetwork) UnsetConnection(ctx context.Context, partition1 partition_topology.PartitionID, partition2 partition_topology.PartitionID) error {
+	ret := _m.Called(ctx, partition1, partition2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, partition_topology.PartitionID, partition_topology.PartitionID) error); ok {
+		r0 = rf(ctx, partition1, partition2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockServiceNetwork_UnsetConnection_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnsetConnection'
+type MockServiceNetwork_UnsetConnection_Call struct {
+	*mock.Call
+}
+
+// UnsetConnection is a helper method to define mock.On call
+//   - ctx context.Context
+//   - partition1 partition_topology.PartitionID
+//   - partition2 partition_topology.PartitionID
+func (_e *MockServiceNetwork_Expecter) UnsetConnection(ctx interface{}, partition1 interface{}, partition2 interface{}) *MockServiceNetwork_UnsetConnection_Call {
+	return &MockServiceNetwork_UnsetConnection_Call{Call: _e.mock.On("UnsetConnection", ctx, partition1, partition2)}
+}
+
+func (_c *MockServiceNetwork_UnsetConnection_Call) Run(run func(ctx context.Context, partition1 partition_topology.PartitionID, partition2 partition_topology.PartitionID)) *MockServiceNetwork_UnsetConnection_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(partition_topology.PartitionID), args[2].(partition_topology.PartitionID))
+	})
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnsetConnection_Call) Return(_a0 error) *MockServiceNetwork_UnsetConnection_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockServiceNetwork_UnsetConnection_Call) RunAndReturn(run func(context.Context, partition_topology.PartitionID, partition_topology.PartitionID) error) *MockServiceNetwork_UnsetConnection_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateFilesArtifact provides a mock function with given fields: fileArtifactUuid, updatedContent, contentMd5
 func (_m *MockServiceNetwork) UpdateFilesArtifact(fileArtifactUuid enclave_data_directory.FilesArtifactUUID, updatedContent io.Reader, contentMd5 []byte) error {
 	ret := _m.Called(fileArtifactUuid, updatedContent, contentMd5)
@@ -0,0 +1,59 @@
+package partition_topology
+
+type DelayDistribution string
+
+const (
+	// The delay of each packet is picked uniformly in [avgDelayMs - jitterMs, avgDelayMs + jitterMs]
+	UniformDelayDistribution DelayDistribution = "uniform"
+	// The delay of each packet follows a normal distribution centered on avgDelayMs, with jitterMs as standard deviation
+	NormalDelayDistribution DelayDistribution = "normal"
+)
+
+type PacketDelayDistribution struct {
+	avgDelayMs uint32
+
+	jitterMs uint32
+
+	// Percentage in [0, 100] of how much the delay of a packet depends on the delay of the previous packet
+	correlation float32
+
+	distribution DelayDistribution
+}
+
+func NewUniformPacketDelayDistribution(avgDelayMs uint32, jitterMs uint32) PacketDelayDistribution {
+	return PacketDelayDistribution{
+		avgDelayMs:   avgDelayMs,
+		jitterMs:     jitterMs,
+		correlation:  0,
+		distribution: UniformDelayDistribution,
+	}
+}
+
+func NewNormalPacketDelayDistribution(meanDelayMs uint32, stdDevMs uint32, correlation float32) PacketDelayDistribution {
+	return PacketDelayDistribution{
+		avgDelayMs:   meanDelayMs,
+		jitterMs:     stdDevMs,
+		correlation:  correlation,
+		distribution: NormalDelayDistribution,
+	}
+}
+
+func (delayDistribution PacketDelayDistribution) GetAvgDelayMs() uint32 {
+	return delayDistribution.avgDelayMs
+}
+
+func (delayDistribution PacketDelayDistribution) GetJitterMs() uint32 {
+	return delayDistribution.jitterMs
+}
+
+func (delayDistribution PacketDelayDistribution) GetCorrelation() float32 {
+	return delayDistribution.correlation
+}
+
+func (delayDistribution PacketDelayDistribution) GetDistribution() DelayDistribution {
+	return delayDistribution.distribution
+}
+
+func (delayDistribution PacketDelayDistribution) IsZero() bool {
+	return delayDistribution.avgDelayMs == 0 && delayDistribution.jitterMs == 0
+}
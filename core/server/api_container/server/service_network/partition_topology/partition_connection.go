@@ -0,0 +1,66 @@
+package partition_topology
+
+const (
+	allPacketsLostPercentage = 100
+)
+
+var (
+	// ConnectionAllowed lets the traffic flow between two partitions untouched
+	ConnectionAllowed = NewPartitionConnection(0, NewUniformPacketDelayDistribution(0, 0))
+
+	// ConnectionBlocked drops all the traffic between two partitions
+	ConnectionBlocked = NewPartitionConnection(allPacketsLostPercentage, NewUniformPacketDelayDistribution(0, 0))
+)
+
+// PartitionConnection describes the conditions of the link between two partitions. The conditions apply in both
+// directions
+type PartitionConnection struct {
+	packetLossPercentage float32
+
+	packetDelayDistribution PacketDelayDistribution
+}
+
+func NewPartitionConnection(packetLossPercentage float32, packetDelayDistribution PacketDelayDistribution) PartitionConnection {
+	return PartitionConnection{
+		packetLossPercentage:    packetLossPercentage,
+		packetDelayDistribution: packetDelayDistribution,
+	}
+}
+
+func (connection PartitionConnection) GetPacketLossPercentage() float32 {
+	return connection.packetLossPercentage
+}
+
+func (connection PartitionConnection) GetPacketDelayDistribution() PacketDelayDistribution {
+	return connection.packetDelayDistribution
+}
+
+// IsUnconditioned returns true if no packet is lost nor delayed on this connection
+func (connection PartitionConnection) IsUnconditioned() bool {
+	return connection.packetLossPercentage == 0 && connection.packetDelayDistribution.IsZero()
+}
+
+// PartitionConnectionID identifies the connection between two partitions, regardless of the order in which they are
+// given
+type PartitionConnectionID struct {
+	lexicalFirst  PartitionID
+	lexicalSecond PartitionID
+}
+
+func NewPartitionConnectionID(partitionA PartitionID, partitionB PartitionID) PartitionConnectionID {
+	if partitionA > partitionB {
+		partitionA, partitionB = partitionB, partitionA
+	}
+	return PartitionConnectionID{
+		lexicalFirst:  partitionA,
+		lexicalSecond: partitionB,
+	}
+}
+
+func (connectionId PartitionConnectionID) GetFirst() PartitionID {
+	return connectionId.lexicalFirst
+}
+
+func (connectionId PartitionConnectionID) GetSecond() PartitionID {
+	return connectionId.lexicalSecond
+}
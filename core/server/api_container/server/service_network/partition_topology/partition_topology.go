@@ -0,0 +1,153 @@
+package partition_topology
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+type PartitionID string
+
+const (
+	// Services land in this partition unless they're explicitly moved to another one
+	DefaultPartitionId PartitionID = "default"
+)
+
+// PartitionTopology groups the services of an enclave into partitions, and holds the conditions of the connections
+// between those partitions. Traffic between services of the same partition is never conditioned.
+// Partitions don't need to be declared, a partition exists as soon as a service or a connection refers to it.
+// NOTE: This is not thread-safe, it's up to the caller to synchronize the calls
+type PartitionTopology struct {
+	servicePartitions map[service.ServiceName]PartitionID
+
+	partitionConnections map[PartitionConnectionID]PartitionConnection
+
+	// Connection used between two partitions when no connection was explicitly set for them
+	defaultConnection PartitionConnection
+}
+
+func NewPartitionTopology(defaultConnection PartitionConnection) *PartitionTopology {
+	return &PartitionTopology{
+		servicePartitions:    map[service.ServiceName]PartitionID{},
+		partitionConnections: map[PartitionConnectionID]PartitionConnection{},
+		defaultConnection:    defaultConnection,
+	}
+}
+
+// AddService adds the service to the partition, or moves it there if the service was already in the topology
+func (topology *PartitionTopology) AddService(serviceName service.ServiceName, partitionId PartitionID) {
+	topology.servicePartitions[serviceName] = partitionId
+}
+
+// RemoveService removes the service from the topology; it's a no-op if the service isn't in the topology
+func (topology *PartitionTopology) RemoveService(serviceName service.ServiceName) {
+	delete(topology.servicePartitions, serviceName)
+}
+
+// SetServicePartition moves an existing service to the partition
+func (topology *PartitionTopology) SetServicePartition(serviceName service.ServiceName, partitionId PartitionID) error {
+	if _, found := topology.servicePartitions[serviceName]; !found {
+		return stacktrace.NewError("Service '%v' can't be moved to partition '%v' because it isn't part of the topology", serviceName, partitionId)
+	}
+	topology.servicePartitions[serviceName] = partitionId
+	return nil
+}
+
+// SetConnection sets the conditions of the connection between the two partitions
+func (topology *PartitionTopology) SetConnection(partitionA PartitionID, partitionB PartitionID, connection PartitionConnection) error {
+	if partitionA == partitionB {
+		return stacktrace.NewError("Can't set a connection from partition '%v' to itself, traffic within a partition is never conditioned", partitionA)
+	}
+	topology.partitionConnections[NewPartitionConnectionID(partitionA, partitionB)] = connection
+	return nil
+}
+
+// UnsetConnection makes the connection between the two partitions fall back to the default connection
+func (topology *PartitionTopology) UnsetConnection(partitionA PartitionID, partitionB PartitionID) error {
+	if partitionA == partitionB {
+		return stacktrace.NewError("Can't unset a connection from partition '%v' to itself, traffic within a partition is never conditioned", partitionA)
+	}
+	delete(topology.partitionConnections, NewPartitionConnectionID(partitionA, partitionB))
+	return nil
+}
+
+func (topology *PartitionTopology) SetDefaultConnection(connection PartitionConnection) {
+	topology.defaultConnection = connection
+}
+
+func (topology *PartitionTopology) GetDefaultConnection() PartitionConnection {
+	return topology.defaultConnection
+}
+
+// Repartition replaces the whole topology. The services of the topology that aren't in servicePartitions are moved
+// back to the default partition, while the services in servicePartitions that weren't in the topology are added to it
+func (topology *PartitionTopology) Repartition(
+	servicePartitions map[service.ServiceName]PartitionID,
+	partitionConnections map[PartitionConnectionID]PartitionConnection,
+	defaultConnection PartitionConnection,
+) error {
+	for connectionId := range partitionConnections {
+		if connectionId.GetFirst() == connectionId.GetSecond() {
+			return stacktrace.NewError("Can't set a connection from partition '%v' to itself, traffic within a partition is never conditioned", connectionId.GetFirst())
+		}
+	}
+
+	newServicePartitions := map[service.ServiceName]PartitionID{}
+	for serviceName := range topology.servicePartitions {
+		newServicePartitions[serviceName] = DefaultPartitionId
+	}
+	for serviceName, partitionId := range servicePartitions {
+		newServicePartitions[serviceName] = partitionId
+	}
+	newPartitionConnections := map[PartitionConnectionID]PartitionConnection{}
+	for connectionId, connection := range partitionConnections {
+		newPartitionConnections[connectionId] = connection
+	}
+
+	topology.servicePartitions = newServicePartitions
+	topology.partitionConnections = newPartitionConnections
+	topology.defaultConnection = defaultConnection
+	return nil
+}
+
+// GetServicePartitions returns a copy of the partition of each service of the topology
+func (topology *PartitionTopology) GetServicePartitions() map[service.ServiceName]PartitionID {
+	result := map[service.ServiceName]PartitionID{}
+	for serviceName, partitionId := range topology.servicePartitions {
+		result[serviceName] = partitionId
+	}
+	return result
+}
+
+// GetPartitionConnection returns the connection between the two partitions, falling back to the default connection
+// if none was set
+func (topology *PartitionTopology) GetPartitionConnection(partitionA PartitionID, partitionB PartitionID) PartitionConnection {
+	if partitionA == partitionB {
+		return ConnectionAllowed
+	}
+	if connection, found := topology.partitionConnections[NewPartitionConnectionID(partitionA, partitionB)]; found {
+		return connection
+	}
+	return topology.defaultConnection
+}
+
+// GetServicePartitionConnectionConfigByServiceName returns, for every service of the topology, the conditioned
+// connections it has to the other services. Unconditioned connections are left out, so a service that can freely
+// talk to every other service maps to an empty map
+func (topology *PartitionTopology) GetServicePartitionConnectionConfigByServiceName() map[service.ServiceName]map[service.ServiceName]PartitionConnection {
+	result := map[service.ServiceName]map[service.ServiceName]PartitionConnection{}
+	for serviceName, partitionId := range topology.servicePartitions {
+		conditionedConnections := map[service.ServiceName]PartitionConnection{}
+		for otherServiceName, otherPartitionId := range topology.servicePartitions {
+			if serviceName == otherServiceName {
+				continue
+			}
+			connection := topology.GetPartitionConnection(partitionId, otherPartitionId)
+			if connection.IsUnconditioned() {
+				continue
+			}
+			conditionedConnections[otherServiceName] = connection
+		}
+		result[serviceName] = conditionedConnections
+	}
+	return result
+}
@@ -0,0 +1,132 @@
+package partition_topology
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	partition1 PartitionID = "partition1"
+	partition2 PartitionID = "partition2"
+
+	service1 service.ServiceName = "service1"
+	service2 service.ServiceName = "service2"
+	service3 service.ServiceName = "service3"
+)
+
+var (
+	delayedConnection = NewPartitionConnection(0, NewUniformPacketDelayDistribution(100, 10))
+)
+
+func TestPartitionConnectionID_IsOrderIndependent(t *testing.T) {
+	require.Equal(t, NewPartitionConnectionID(partition1, partition2), NewPartitionConnectionID(partition2, partition1))
+}
+
+func TestTopology_AllServicesInDefaultPartitionAreUnconditioned(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionBlocked)
+	topology.AddService(service1, DefaultPartitionId)
+	topology.AddService(service2, DefaultPartitionId)
+
+	connections := topology.GetServicePartitionConnectionConfigByServiceName()
+	require.Len(t, connections, 2)
+	require.Empty(t, connections[service1])
+	require.Empty(t, connections[service2])
+}
+
+func TestTopology_DefaultConnectionAppliesBetweenPartitions(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionBlocked)
+	topology.AddService(service1, partition1)
+	topology.AddService(service2, partition1)
+	topology.AddService(service3, partition2)
+
+	connections := topology.GetServicePartitionConnectionConfigByServiceName()
+	require.Equal(t, map[service.ServiceName]PartitionConnection{service3: ConnectionBlocked}, connections[service1])
+	require.Equal(t, map[service.ServiceName]PartitionConnection{service3: ConnectionBlocked}, connections[service2])
+	require.Equal(t, map[service.ServiceName]PartitionConnection{service1: ConnectionBlocked, service2: ConnectionBlocked}, connections[service3])
+}
+
+func TestTopology_SetAndUnsetConnection(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionAllowed)
+	topology.AddService(service1, partition1)
+	topology.AddService(service2, partition2)
+
+	require.NoError(t, topology.SetConnection(partition2, partition1, delayedConnection))
+	connections := topology.GetServicePartitionConnectionConfigByServiceName()
+	require.Equal(t, map[service.ServiceName]PartitionConnection{service2: delayedConnection}, connections[service1])
+	require.Equal(t, map[service.ServiceName]PartitionConnection{service1: delayedConnection}, connections[service2])
+
+	require.NoError(t, topology.UnsetConnection(partition1, partition2))
+	connections = topology.GetServicePartitionConnectionConfigByServiceName()
+	require.Empty(t, connections[service1])
+	require.Empty(t, connections[service2])
+}
+
+func TestTopology_SetConnectionToSamePartitionFails(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionAllowed)
+	require.Error(t, topology.SetConnection(partition1, partition1, ConnectionBlocked))
+	require.Error(t, topology.UnsetConnection(partition1, partition1))
+}
+
+func TestTopology_SetServicePartition(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionBlocked)
+	topology.AddService(service1, DefaultPartitionId)
+	topology.AddService(service2, DefaultPartitionId)
+
+	require.NoError(t, topology.SetServicePartition(service2, partition1))
+	connections := topology.GetServicePartitionConnectionConfigByServiceName()
+	require.Equal(t, map[service.ServiceName]PartitionConnection{service2: ConnectionBlocked}, connections[service1])
+
+	require.Error(t, topology.SetServicePartition(service3, partition1))
+}
+
+func TestTopology_RemoveService(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionBlocked)
+	topology.AddService(service1, partition1)
+	topology.AddService(service2, partition2)
+
+	topology.RemoveService(service2)
+	connections := topology.GetServicePartitionConnectionConfigByServiceName()
+	require.Len(t, connections, 1)
+	require.Empty(t, connections[service1])
+}
+
+func TestTopology_RepartitionMovesUnlistedServicesToDefaultPartition(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionAllowed)
+	topology.AddService(service1, partition1)
+	topology.AddService(service2, partition2)
+	require.NoError(t, topology.SetConnection(partition1, partition2, delayedConnection))
+
+	err := topology.Repartition(
+		map[service.ServiceName]PartitionID{
+			service3: partition1,
+		},
+		map[PartitionConnectionID]PartitionConnection{},
+		ConnectionBlocked,
+	)
+	require.NoError(t, err)
+
+	require.Equal(t, map[service.ServiceName]PartitionID{
+		service1: DefaultPartitionId,
+		service2: DefaultPartitionId,
+		service3: partition1,
+	}, topology.GetServicePartitions())
+	require.Equal(t, ConnectionBlocked, topology.GetPartitionConnection(partition1, partition2))
+	require.Equal(t, ConnectionAllowed, topology.GetPartitionConnection(partition1, partition1))
+}
+
+func TestTopology_RepartitionWithSelfConnectionFails(t *testing.T) {
+	topology := NewPartitionTopology(ConnectionAllowed)
+	topology.AddService(service1, partition1)
+
+	err := topology.Repartition(
+		map[service.ServiceName]PartitionID{},
+		map[PartitionConnectionID]PartitionConnection{
+			NewPartitionConnectionID(partition1, partition1): ConnectionBlocked,
+		},
+		ConnectionAllowed,
+	)
+	require.Error(t, err)
+	require.Equal(t, partition1, topology.GetServicePartitions()[service1])
+}
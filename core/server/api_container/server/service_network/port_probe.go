@@ -0,0 +1,88 @@
+package service_network
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+const (
+	probeReadBufferSizeBytes = 4096
+
+	// Upper bound of what is kept from the data received on a TCP connection, so that a chatty service can't exhaust
+	// the memory of the API container
+	maxProbeReceivedDataSizeBytes = 1024 * 1024
+)
+
+// probePort connects to the address using the transport protocol, optionally sends the data and, if expectedData is
+// set, waits until the received data contains it. It returns the data received from the port.
+// For UDP, which is connection-less, the probe only succeeds once a reply is received
+func probePort(
+	ctx context.Context,
+	transportProtocol port_spec.TransportProtocol,
+	address string,
+	dataToSend string,
+	expectedData string,
+	timeout time.Duration,
+) (string, error) {
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, found := ctx.Deadline(); found && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	networkStr := strings.ToLower(transportProtocol.String())
+	dialer := &net.Dialer{ //nolint:exhaustruct
+		Deadline: deadline,
+	}
+	conn, err := dialer.DialContext(ctx, networkStr, address)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred connecting to '%s' using protocol '%s'", address, transportProtocol.String())
+	}
+	defer conn.Close()
+	if err := conn.SetDeadline(deadline); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred setting the deadline of the connection to '%s'", address)
+	}
+
+	if dataToSend != "" {
+		if _, err := conn.Write([]byte(dataToSend)); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred sending data to '%s'", address)
+		}
+	}
+	if expectedData == "" && transportProtocol != port_spec.TransportProtocol_UDP {
+		return "", nil
+	}
+
+	receivedData := &bytes.Buffer{}
+	readBuffer := make([]byte, probeReadBufferSizeBytes)
+	for {
+		numBytesRead, err := conn.Read(readBuffer)
+		if numBytesRead > 0 && receivedData.Len() < maxProbeReceivedDataSizeBytes {
+			receivedData.Write(readBuffer[:numBytesRead])
+		}
+		if expectedData == "" && numBytesRead > 0 {
+			return receivedData.String(), nil
+		}
+		if expectedData != "" && strings.Contains(receivedData.String(), expectedData) {
+			return receivedData.String(), nil
+		}
+		if err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred before receiving the expected data from '%s'%s", address, describeReceivedData(receivedData, expectedData))
+		}
+		// Datagrams are read whole, so a UDP reply which doesn't contain the expected data is final
+		if transportProtocol == port_spec.TransportProtocol_UDP {
+			return "", stacktrace.NewError("The reply received from '%s' does not contain the expected data%s", address, describeReceivedData(receivedData, expectedData))
+		}
+	}
+}
+
+func describeReceivedData(receivedData *bytes.Buffer, expectedData string) string {
+	if expectedData == "" {
+		return ""
+	}
+	return fmt.Sprintf("; expected data '%s' but received '%s'", expectedData, receivedData.String())
+}
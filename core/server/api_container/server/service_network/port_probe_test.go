@@ -0,0 +1,105 @@
+package service_network
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	probeTestAddress = "127.0.0.1:0"
+	probeTestTimeout = 2 * time.Second
+)
+
+func TestProbePort_TcpConnectOnly(t *testing.T) {
+	listener := startTcpEchoServer(t)
+
+	receivedData, err := probePort(context.Background(), port_spec.TransportProtocol_TCP, listener.Addr().String(), "", "", probeTestTimeout)
+	require.NoError(t, err)
+	require.Empty(t, receivedData)
+}
+
+func TestProbePort_TcpSendAndExpect(t *testing.T) {
+	listener := startTcpEchoServer(t)
+
+	receivedData, err := probePort(context.Background(), port_spec.TransportProtocol_TCP, listener.Addr().String(), "PING\n", "PING", probeTestTimeout)
+	require.NoError(t, err)
+	require.Equal(t, "PING\n", receivedData)
+}
+
+func TestProbePort_TcpUnexpectedData(t *testing.T) {
+	listener := startTcpEchoServer(t)
+
+	_, err := probePort(context.Background(), port_spec.TransportProtocol_TCP, listener.Addr().String(), "PING\n", "PONG", 200*time.Millisecond)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "expected data 'PONG' but received 'PING\n'")
+}
+
+func TestProbePort_TcpClosedPort(t *testing.T) {
+	listener := startTcpEchoServer(t)
+	address := listener.Addr().String()
+	require.NoError(t, listener.Close())
+
+	_, err := probePort(context.Background(), port_spec.TransportProtocol_TCP, address, "", "", probeTestTimeout)
+	require.Error(t, err)
+}
+
+func TestProbePort_UdpSendAndExpect(t *testing.T) {
+	conn := startUdpEchoServer(t)
+
+	receivedData, err := probePort(context.Background(), port_spec.TransportProtocol_UDP, conn.LocalAddr().String(), "PING", "", probeTestTimeout)
+	require.NoError(t, err)
+	require.Equal(t, "PING", receivedData)
+
+	_, err = probePort(context.Background(), port_spec.TransportProtocol_UDP, conn.LocalAddr().String(), "PING", "PONG", probeTestTimeout)
+	require.Error(t, err)
+}
+
+func startTcpEchoServer(t *testing.T) net.Listener {
+	listener, err := net.Listen("tcp", probeTestAddress)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				line, err := bufio.NewReader(conn).ReadString('\n')
+				if err != nil {
+					return
+				}
+				_, _ = conn.Write([]byte(line))
+			}()
+		}
+	}()
+	return listener
+}
+
+func startUdpEchoServer(t *testing.T) net.PacketConn {
+	conn, err := net.ListenPacket("udp", probeTestAddress)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = conn.Close()
+	})
+	go func() {
+		buffer := make([]byte, probeReadBufferSizeBytes)
+		for {
+			numBytesRead, address, err := conn.ReadFrom(buffer)
+			if err != nil {
+				return
+			}
+			_, _ = conn.WriteTo(buffer[:numBytesRead], address)
+		}
+	}()
+	return conn
+}
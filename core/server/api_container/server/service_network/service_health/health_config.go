@@ -0,0 +1,99 @@
+package service_health
+
+import (
+	"context"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+)
+
+type RestartPolicy string
+
+const (
+	// RestartPolicy_Never only reports the failures of the service
+	RestartPolicy_Never RestartPolicy = "never"
+	// RestartPolicy_OnFailure restarts the service when it fails, giving up after the max number of restarts
+	RestartPolicy_OnFailure RestartPolicy = "on-failure"
+	// RestartPolicy_Always restarts the service every time it fails
+	RestartPolicy_Always RestartPolicy = "always"
+
+	// NoMaxRestarts is used with RestartPolicy_OnFailure to never give up restarting the service
+	NoMaxRestarts uint32 = 0
+
+	// How often the container of a service without a liveness check is checked
+	DefaultCheckInterval = 5 * time.Second
+)
+
+func RestartPolicyStrings() []string {
+	return []string{
+		string(RestartPolicy_Never),
+		string(RestartPolicy_OnFailure),
+		string(RestartPolicy_Always),
+	}
+}
+
+// LivenessCheck returns an error when the service is not live. It should give up once ctx is cancelled
+type LivenessCheck func(ctx context.Context, serviceName service.ServiceName) error
+
+// HealthConfig describes how the health of a service gets monitored once it is up and running
+type HealthConfig struct {
+	// Nil if the service has no liveness check, in which case only its container gets checked
+	livenessCheck LivenessCheck
+
+	// What the liveness check gets built from again when the enclave restarts, empty if the service has no liveness check
+	serializedLivenessCheck string
+
+	checkInterval time.Duration
+
+	restartPolicy RestartPolicy
+
+	maxRestarts uint32
+}
+
+func NewHealthConfig(
+	livenessCheck LivenessCheck,
+	serializedLivenessCheck string,
+	checkInterval time.Duration,
+	restartPolicy RestartPolicy,
+	maxRestarts uint32,
+) *HealthConfig {
+	return &HealthConfig{
+		livenessCheck:           livenessCheck,
+		serializedLivenessCheck: serializedLivenessCheck,
+		checkInterval:           checkInterval,
+		restartPolicy:           restartPolicy,
+		maxRestarts:             maxRestarts,
+	}
+}
+
+func (config *HealthConfig) GetLivenessCheck() LivenessCheck {
+	return config.livenessCheck
+}
+
+func (config *HealthConfig) GetSerializedLivenessCheck() string {
+	return config.serializedLivenessCheck
+}
+
+func (config *HealthConfig) GetCheckInterval() time.Duration {
+	return config.checkInterval
+}
+
+func (config *HealthConfig) GetRestartPolicy() RestartPolicy {
+	return config.restartPolicy
+}
+
+func (config *HealthConfig) GetMaxRestarts() uint32 {
+	return config.maxRestarts
+}
+
+func (config *HealthConfig) shouldRestart(numRestarts uint32) bool {
+	switch config.restartPolicy {
+	case RestartPolicy_Always:
+		return true
+	case RestartPolicy_OnFailure:
+		return config.maxRestarts == NoMaxRestarts || numRestarts < config.maxRestarts
+	case RestartPolicy_Never:
+		return false
+	}
+	return false
+}
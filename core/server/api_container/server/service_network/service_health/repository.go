@@ -0,0 +1,116 @@
+package service_health
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	healthConfigsBucketName = []byte("service-health-configs-repository")
+)
+
+// HealthConfigRepository keeps the health configs of the monitored services, keyed by service name, so that their
+// monitoring resumes when the enclave restarts
+type HealthConfigRepository struct {
+	enclaveDb *enclave_db.EnclaveDB
+}
+
+// The liveness check itself can't be persisted, only what it gets built from
+type healthConfigForPersistence struct {
+	SerializedLivenessCheck string        `json:"serialized_liveness_check"`
+	CheckInterval           time.Duration `json:"check_interval"`
+	RestartPolicy           RestartPolicy `json:"restart_policy"`
+	MaxRestarts             uint32        `json:"max_restarts"`
+}
+
+func GetOrCreateNewHealthConfigRepository(enclaveDb *enclave_db.EnclaveDB) (*HealthConfigRepository, error) {
+	if err := enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket, err := tx.CreateBucketIfNotExists(healthConfigsBucketName)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred while creating the service health configs database bucket")
+		}
+		logrus.Debugf("Service health configs bucket: '%+v'", bucket)
+
+		return nil
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while building the service health configs repository")
+	}
+
+	healthConfigRepository := &HealthConfigRepository{
+		enclaveDb: enclaveDb,
+	}
+
+	return healthConfigRepository, nil
+}
+
+// Save replaces the health config of the service if it already has one
+func (repository *HealthConfigRepository) Save(serviceName service.ServiceName, config *HealthConfig) error {
+	configForPersistence := &healthConfigForPersistence{
+		SerializedLivenessCheck: config.GetSerializedLivenessCheck(),
+		CheckInterval:           config.GetCheckInterval(),
+		RestartPolicy:           config.GetRestartPolicy(),
+		MaxRestarts:             config.GetMaxRestarts(),
+	}
+	jsonBytes, err := json.Marshal(configForPersistence)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred marshalling the health config '%+v' of service '%s'", configForPersistence, serviceName)
+	}
+
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(healthConfigsBucketName)
+		if err := bucket.Put([]byte(serviceName), jsonBytes); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while saving the health config of service '%s' into the enclave db", serviceName)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while saving the health config of service '%s' into the enclave db", serviceName)
+	}
+	return nil
+}
+
+// Delete is a no-op if the service has no health config
+func (repository *HealthConfigRepository) Delete(serviceName service.ServiceName) error {
+	if err := repository.enclaveDb.Update(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(healthConfigsBucketName)
+		if err := bucket.Delete([]byte(serviceName)); err != nil {
+			return stacktrace.Propagate(err, "An error occurred while deleting the health config of service '%s' from the enclave db", serviceName)
+		}
+		return nil
+	}); err != nil {
+		return stacktrace.Propagate(err, "An error occurred while deleting the health config of service '%s' from the enclave db", serviceName)
+	}
+	return nil
+}
+
+// GetAll returns the health configs without their liveness check, which has to be built again from the serialized one
+func (repository *HealthConfigRepository) GetAll() (map[service.ServiceName]*HealthConfig, error) {
+	healthConfigs := map[service.ServiceName]*HealthConfig{}
+
+	if err := repository.enclaveDb.View(func(tx *bolt.Tx) error {
+		bucket := tx.Bucket(healthConfigsBucketName)
+
+		return bucket.ForEach(func(serviceNameBytes, jsonBytes []byte) error {
+			configForPersistence := &healthConfigForPersistence{} //nolint:exhaustruct
+			if err := json.Unmarshal(jsonBytes, configForPersistence); err != nil {
+				return stacktrace.Propagate(err, "An error occurred unmarshalling the health config of service '%s'", string(serviceNameBytes))
+			}
+			healthConfigs[service.ServiceName(serviceNameBytes)] = NewHealthConfig(
+				nil,
+				configForPersistence.SerializedLivenessCheck,
+				configForPersistence.CheckInterval,
+				configForPersistence.RestartPolicy,
+				configForPersistence.MaxRestarts,
+			)
+			return nil
+		})
+	}); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the service health configs from the enclave db")
+	}
+	return healthConfigs, nil
+}
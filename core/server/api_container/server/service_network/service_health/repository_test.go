@@ -0,0 +1,84 @@
+package service_health
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/stretchr/testify/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	otherServiceName service.ServiceName = "other-test-service"
+
+	serializedLivenessCheck = `ReadyCondition(recipe=GetHttpRequestRecipe(port_id="http", endpoint="/health"), field="code", assertion="==", target_value=200)`
+)
+
+func TestSaveAndGetAll(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.Save(serviceName, NewHealthConfig(nil, serializedLivenessCheck, 10*time.Second, RestartPolicy_OnFailure, 3)))
+	require.NoError(t, repository.Save(otherServiceName, NewHealthConfig(nil, "", DefaultCheckInterval, RestartPolicy_Always, NoMaxRestarts)))
+
+	healthConfigs, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Len(t, healthConfigs, 2)
+
+	healthConfig := healthConfigs[serviceName]
+	require.Nil(t, healthConfig.GetLivenessCheck())
+	require.Equal(t, serializedLivenessCheck, healthConfig.GetSerializedLivenessCheck())
+	require.Equal(t, 10*time.Second, healthConfig.GetCheckInterval())
+	require.Equal(t, RestartPolicy_OnFailure, healthConfig.GetRestartPolicy())
+	require.Equal(t, uint32(3), healthConfig.GetMaxRestarts())
+
+	otherHealthConfig := healthConfigs[otherServiceName]
+	require.Empty(t, otherHealthConfig.GetSerializedLivenessCheck())
+	require.Equal(t, RestartPolicy_Always, otherHealthConfig.GetRestartPolicy())
+}
+
+func TestSave_ReplacesPreviousConfig(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.Save(serviceName, NewHealthConfig(nil, "", DefaultCheckInterval, RestartPolicy_Always, NoMaxRestarts)))
+	require.NoError(t, repository.Save(serviceName, NewHealthConfig(nil, "", DefaultCheckInterval, RestartPolicy_OnFailure, 1)))
+
+	healthConfigs, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Len(t, healthConfigs, 1)
+	require.Equal(t, RestartPolicy_OnFailure, healthConfigs[serviceName].GetRestartPolicy())
+}
+
+func TestDelete(t *testing.T) {
+	repository := getRepositoryForTest(t)
+
+	require.NoError(t, repository.Save(serviceName, NewHealthConfig(nil, "", DefaultCheckInterval, RestartPolicy_Always, NoMaxRestarts)))
+	require.NoError(t, repository.Delete(serviceName))
+	// deleting a service without health config is a no-op
+	require.NoError(t, repository.Delete(otherServiceName))
+
+	healthConfigs, err := repository.GetAll()
+	require.NoError(t, err)
+	require.Empty(t, healthConfigs)
+}
+
+func getRepositoryForTest(t *testing.T) *HealthConfigRepository {
+	file, err := os.CreateTemp("/tmp", "*.db")
+	defer func() {
+		err = os.Remove(file.Name())
+		require.NoError(t, err)
+	}()
+
+	require.NoError(t, err)
+	db, err := bolt.Open(file.Name(), 0666, nil)
+	require.NoError(t, err)
+	enclaveDb := &enclave_db.EnclaveDB{
+		DB: db,
+	}
+	repository, err := GetOrCreateNewHealthConfigRepository(enclaveDb)
+	require.NoError(t, err)
+
+	return repository
+}
@@ -0,0 +1,48 @@
+package service_health
+
+import "time"
+
+type HealthStatus string
+
+const (
+	HealthStatus_Healthy   HealthStatus = "healthy"
+	HealthStatus_Unhealthy HealthStatus = "unhealthy"
+)
+
+// ServiceHealth is a snapshot of the health of a monitored service
+type ServiceHealth struct {
+	status HealthStatus
+
+	numRestarts uint32
+
+	// Empty if the service never failed
+	lastFailureReason string
+
+	lastFailureTime time.Time
+}
+
+func newServiceHealth() *ServiceHealth {
+	return &ServiceHealth{
+		// the service is only monitored once it is ready
+		status:            HealthStatus_Healthy,
+		numRestarts:       0,
+		lastFailureReason: "",
+		lastFailureTime:   time.Time{},
+	}
+}
+
+func (health *ServiceHealth) GetStatus() HealthStatus {
+	return health.status
+}
+
+func (health *ServiceHealth) GetNumRestarts() uint32 {
+	return health.numRestarts
+}
+
+func (health *ServiceHealth) GetLastFailureReason() string {
+	return health.lastFailureReason
+}
+
+func (health *ServiceHealth) GetLastFailureTime() time.Time {
+	return health.lastFailureTime
+}
@@ -0,0 +1,168 @@
+package service_health
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	containerNotRunningFailureReason = "The container of the service is not running"
+)
+
+// GetServiceRunStateFunc returns whether the service is expected to be running, i.e. it was not stopped by the user,
+// and whether its container is actually running
+type GetServiceRunStateFunc func(ctx context.Context, serviceName service.ServiceName) (bool, bool, error)
+
+type RestartServiceFunc func(ctx context.Context, serviceName service.ServiceName) error
+
+// ServiceHealthMonitor periodically checks the health of the services it monitors, and restarts the ones failing
+// according to their restart policy. Services stopped by the user are left alone until they get started again.
+type ServiceHealthMonitor struct {
+	mutex *sync.Mutex
+
+	getServiceRunState GetServiceRunStateFunc
+
+	restartService RestartServiceFunc
+
+	monitoredServices map[service.ServiceName]*monitoredService
+}
+
+type monitoredService struct {
+	config *HealthConfig
+
+	// Guarded by the mutex of the monitor
+	health *ServiceHealth
+
+	stopMonitoring context.CancelFunc
+}
+
+func NewServiceHealthMonitor(getServiceRunState GetServiceRunStateFunc, restartService RestartServiceFunc) *ServiceHealthMonitor {
+	return &ServiceHealthMonitor{
+		mutex:              &sync.Mutex{},
+		getServiceRunState: getServiceRunState,
+		restartService:     restartService,
+		monitoredServices:  map[service.ServiceName]*monitoredService{},
+	}
+}
+
+// StartMonitoring starts monitoring the service in the background, replacing any previous monitoring of it
+func (monitor *ServiceHealthMonitor) StartMonitoring(serviceName service.ServiceName, config *HealthConfig) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+
+	monitor.stopMonitoringUnlocked(serviceName)
+
+	// the monitoring outlives the instruction or the request that started it
+	ctx, cancelFunc := context.WithCancel(context.Background())
+	serviceToMonitor := &monitoredService{
+		config:         config,
+		health:         newServiceHealth(),
+		stopMonitoring: cancelFunc,
+	}
+	monitor.monitoredServices[serviceName] = serviceToMonitor
+	go monitor.monitorService(ctx, serviceName, serviceToMonitor)
+}
+
+// StopMonitoring is a no-op if the service is not monitored
+func (monitor *ServiceHealthMonitor) StopMonitoring(serviceName service.ServiceName) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	monitor.stopMonitoringUnlocked(serviceName)
+}
+
+// GetServiceHealth returns a snapshot of the health of the service, and false if the service is not monitored
+func (monitor *ServiceHealthMonitor) GetServiceHealth(serviceName service.ServiceName) (*ServiceHealth, bool) {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	serviceToMonitor, found := monitor.monitoredServices[serviceName]
+	if !found {
+		return nil, false
+	}
+	healthSnapshot := *serviceToMonitor.health
+	return &healthSnapshot, true
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+func (monitor *ServiceHealthMonitor) stopMonitoringUnlocked(serviceName service.ServiceName) {
+	serviceToMonitor, found := monitor.monitoredServices[serviceName]
+	if !found {
+		return
+	}
+	serviceToMonitor.stopMonitoring()
+	delete(monitor.monitoredServices, serviceName)
+}
+
+func (monitor *ServiceHealthMonitor) monitorService(ctx context.Context, serviceName service.ServiceName, serviceToMonitor *monitoredService) {
+	ticker := time.NewTicker(serviceToMonitor.config.GetCheckInterval())
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			monitor.checkService(ctx, serviceName, serviceToMonitor)
+		}
+	}
+}
+
+func (monitor *ServiceHealthMonitor) checkService(ctx context.Context, serviceName service.ServiceName, serviceToMonitor *monitoredService) {
+	isExpectedToRun, isRunning, err := monitor.getServiceRunState(ctx, serviceName)
+	if err != nil {
+		logrus.Debugf("An error occurred getting the state of service '%s', its health will be checked again later. Error was:\n%v", serviceName, err)
+		return
+	}
+	if !isExpectedToRun {
+		return
+	}
+
+	failureReason := ""
+	if !isRunning {
+		failureReason = containerNotRunningFailureReason
+	} else if livenessCheck := serviceToMonitor.config.GetLivenessCheck(); livenessCheck != nil {
+		if err := livenessCheck(ctx, serviceName); err != nil {
+			failureReason = err.Error()
+		}
+	}
+	if ctx.Err() != nil {
+		// the monitoring was stopped while checking the service, so the result can't be trusted
+		return
+	}
+
+	if !monitor.recordCheckResult(serviceName, serviceToMonitor, failureReason) {
+		return
+	}
+	logrus.Infof("Restarting service '%s' as per its '%s' restart policy", serviceName, serviceToMonitor.config.GetRestartPolicy())
+	// the monitor must not be locked while restarting as the service network calls it when services get removed
+	if err := monitor.restartService(ctx, serviceName); err != nil {
+		logrus.Errorf("An error occurred restarting unhealthy service '%s', it will be retried on the next failed check. Error was:\n%v", serviceName, err)
+		return
+	}
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	serviceToMonitor.health.numRestarts += 1
+}
+
+// recordCheckResult updates the health of the service, an empty failure reason meaning the check passed, and returns
+// whether the service should get restarted
+func (monitor *ServiceHealthMonitor) recordCheckResult(serviceName service.ServiceName, serviceToMonitor *monitoredService, failureReason string) bool {
+	monitor.mutex.Lock()
+	defer monitor.mutex.Unlock()
+	health := serviceToMonitor.health
+	if failureReason == "" {
+		health.status = HealthStatus_Healthy
+		return false
+	}
+	health.status = HealthStatus_Unhealthy
+	health.lastFailureReason = failureReason
+	health.lastFailureTime = time.Now()
+	logrus.Warnf("Service '%s' is unhealthy: %s", serviceName, failureReason)
+	return serviceToMonitor.config.shouldRestart(health.numRestarts)
+}
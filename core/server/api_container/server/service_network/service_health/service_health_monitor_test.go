@@ -0,0 +1,174 @@
+package service_health
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	serviceName service.ServiceName = "test-service"
+
+	livenessFailureReason = "Expected status code 200 but got 500"
+	restartFailureReason  = "Port 8080 is already allocated"
+)
+
+type fakeService struct {
+	isExpectedToRun   bool
+	isRunning         bool
+	isLive            bool
+	isRestartFailing  bool
+	numRestarts       int
+	numFailedRestarts int
+}
+
+func (fake *fakeService) getServiceRunState(_ context.Context, _ service.ServiceName) (bool, bool, error) {
+	return fake.isExpectedToRun, fake.isRunning, nil
+}
+
+func (fake *fakeService) restartService(_ context.Context, _ service.ServiceName) error {
+	if fake.isRestartFailing {
+		fake.numFailedRestarts += 1
+		return stacktrace.NewError(restartFailureReason)
+	}
+	fake.numRestarts += 1
+	fake.isRunning = true
+	return nil
+}
+
+func (fake *fakeService) livenessCheck(_ context.Context, _ service.ServiceName) error {
+	if !fake.isLive {
+		return stacktrace.NewError(livenessFailureReason)
+	}
+	return nil
+}
+
+func newMonitoredFakeService(restartPolicy RestartPolicy, maxRestarts uint32) (*ServiceHealthMonitor, *monitoredService, *fakeService) {
+	fake := &fakeService{
+		isExpectedToRun:   true,
+		isRunning:         true,
+		isLive:            true,
+		isRestartFailing:  false,
+		numRestarts:       0,
+		numFailedRestarts: 0,
+	}
+	monitor := NewServiceHealthMonitor(fake.getServiceRunState, fake.restartService)
+	serviceToMonitor := &monitoredService{
+		config:         NewHealthConfig(fake.livenessCheck, livenessFailureReason, DefaultCheckInterval, restartPolicy, maxRestarts),
+		health:         newServiceHealth(),
+		stopMonitoring: func() {},
+	}
+	monitor.monitoredServices[serviceName] = serviceToMonitor
+	return monitor, serviceToMonitor, fake
+}
+
+func TestCheckService_HealthyService(t *testing.T) {
+	monitor, serviceToMonitor, fake := newMonitoredFakeService(RestartPolicy_Always, NoMaxRestarts)
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+
+	health, found := monitor.GetServiceHealth(serviceName)
+	require.True(t, found)
+	require.Equal(t, HealthStatus_Healthy, health.GetStatus())
+	require.Equal(t, uint32(0), health.GetNumRestarts())
+	require.Empty(t, health.GetLastFailureReason())
+	require.Equal(t, 0, fake.numRestarts)
+}
+
+func TestCheckService_LivenessFailureRestartsService(t *testing.T) {
+	monitor, serviceToMonitor, fake := newMonitoredFakeService(RestartPolicy_Always, NoMaxRestarts)
+	fake.isLive = false
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+
+	health, _ := monitor.GetServiceHealth(serviceName)
+	require.Equal(t, HealthStatus_Unhealthy, health.GetStatus())
+	require.Equal(t, uint32(1), health.GetNumRestarts())
+	require.Contains(t, health.GetLastFailureReason(), livenessFailureReason)
+	require.False(t, health.GetLastFailureTime().IsZero())
+	require.Equal(t, 1, fake.numRestarts)
+
+	// the last failure is kept once the service is healthy again
+	fake.isLive = true
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+	health, _ = monitor.GetServiceHealth(serviceName)
+	require.Equal(t, HealthStatus_Healthy, health.GetStatus())
+	require.Contains(t, health.GetLastFailureReason(), livenessFailureReason)
+}
+
+func TestCheckService_StoppedContainerRestartsService(t *testing.T) {
+	monitor, serviceToMonitor, fake := newMonitoredFakeService(RestartPolicy_OnFailure, NoMaxRestarts)
+	fake.isRunning = false
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+
+	health, _ := monitor.GetServiceHealth(serviceName)
+	require.Equal(t, containerNotRunningFailureReason, health.GetLastFailureReason())
+	require.Equal(t, uint32(1), health.GetNumRestarts())
+	require.True(t, fake.isRunning)
+}
+
+func TestCheckService_FailedRestartIsRetriedOnNextCheck(t *testing.T) {
+	monitor, serviceToMonitor, fake := newMonitoredFakeService(RestartPolicy_Always, NoMaxRestarts)
+	fake.isRunning = false
+	fake.isRestartFailing = true
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+
+	health, _ := monitor.GetServiceHealth(serviceName)
+	require.Equal(t, HealthStatus_Unhealthy, health.GetStatus())
+	require.Equal(t, uint32(0), health.GetNumRestarts())
+	require.Equal(t, 1, fake.numFailedRestarts)
+	require.False(t, fake.isRunning)
+
+	// the service is still expected to run after the failed restart, so the next check restarts it again
+	fake.isRestartFailing = false
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+
+	health, _ = monitor.GetServiceHealth(serviceName)
+	require.Equal(t, uint32(1), health.GetNumRestarts())
+	require.Equal(t, 1, fake.numRestarts)
+	require.True(t, fake.isRunning)
+}
+
+func TestCheckService_OnFailureGivesUpAfterMaxRestarts(t *testing.T) {
+	monitor, serviceToMonitor, fake := newMonitoredFakeService(RestartPolicy_OnFailure, 2)
+	fake.isLive = false
+	for i := 0; i < 5; i++ {
+		monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+	}
+
+	health, _ := monitor.GetServiceHealth(serviceName)
+	require.Equal(t, HealthStatus_Unhealthy, health.GetStatus())
+	require.Equal(t, uint32(2), health.GetNumRestarts())
+	require.Equal(t, 2, fake.numRestarts)
+}
+
+func TestCheckService_NeverRestartsService(t *testing.T) {
+	monitor, serviceToMonitor, fake := newMonitoredFakeService(RestartPolicy_Never, NoMaxRestarts)
+	fake.isLive = false
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+
+	health, _ := monitor.GetServiceHealth(serviceName)
+	require.Equal(t, HealthStatus_Unhealthy, health.GetStatus())
+	require.Equal(t, uint32(0), health.GetNumRestarts())
+	require.Equal(t, 0, fake.numRestarts)
+}
+
+func TestCheckService_ServiceStoppedByUserIsLeftAlone(t *testing.T) {
+	monitor, serviceToMonitor, fake := newMonitoredFakeService(RestartPolicy_Always, NoMaxRestarts)
+	fake.isExpectedToRun = false
+	fake.isRunning = false
+	monitor.checkService(context.Background(), serviceName, serviceToMonitor)
+
+	health, _ := monitor.GetServiceHealth(serviceName)
+	require.Equal(t, HealthStatus_Healthy, health.GetStatus())
+	require.Equal(t, 0, fake.numRestarts)
+}
+
+func TestStopMonitoring(t *testing.T) {
+	monitor, _, _ := newMonitoredFakeService(RestartPolicy_Always, NoMaxRestarts)
+	monitor.StopMonitoring(serviceName)
+
+	_, found := monitor.GetServiceHealth(serviceName)
+	require.False(t, found)
+}
@@ -4,12 +4,17 @@ import (
 	"context"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/grpc_request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/render_templates"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_identifiers"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"io"
 	"net/http"
+	"time"
 )
 
 // ServiceNetwork handles the state of the enclave
@@ -90,12 +95,28 @@ type ServiceNetwork interface {
 		error,
 	)
 
-	HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string) (*http.Response, error)
+	// HttpRequestService sends an HTTP request to the given port of the service. Options can be nil
+	HttpRequestService(ctx context.Context, serviceIdentifier string, portId string, method string, contentType string, endpoint string, body string, options *HttpRequestOptions) (*http.Response, error)
+
+	// GrpcRequestService calls a unary gRPC method, named like 'package.Service/Method', on the given port of the service.
+	// The request and the response are encoded as JSON. Options can be nil
+	GrpcRequestService(ctx context.Context, serviceIdentifier string, portId string, fullMethodName string, requestBody string, options *GrpcRequestOptions) (*grpc_request.GrpcResponse, error)
+
+	// ProbeServicePort connects to the given TCP or UDP port of the service, optionally sending data and waiting for the
+	// expected data in return. It returns the data received from the port
+	ProbeServicePort(ctx context.Context, serviceIdentifier string, portId string, transportProtocol port_spec.TransportProtocol, dataToSend string, expectedData string, timeout time.Duration) (string, error)
 
 	GetService(ctx context.Context, serviceIdentifier string) (*service.Service, error)
 
 	GetServices(ctx context.Context) (map[service.ServiceUUID]*service.Service, error)
 
+	// MonitorServiceHealth starts monitoring the health of the service, replacing any previous monitoring of it. A nil
+	// config stops monitoring the service. The config is persisted so that the monitoring resumes when the enclave restarts
+	MonitorServiceHealth(serviceName service.ServiceName, healthConfig *service_health.HealthConfig) error
+
+	// GetServiceHealth returns false if the service is not monitored
+	GetServiceHealth(serviceName service.ServiceName) (*service_health.ServiceHealth, bool)
+
 	CopyFilesFromService(ctx context.Context, serviceIdentifier string, srcPath string, artifactName string) (enclave_data_directory.FilesArtifactUUID, error)
 
 	GetServiceNames() (map[service.ServiceName]bool, error)
@@ -114,6 +135,21 @@ type ServiceNetwork interface {
 
 	GetUniqueNameForFileArtifact() (string, error)
 
+	SetConnection(ctx context.Context, partition1 partition_topology.PartitionID, partition2 partition_topology.PartitionID, connection partition_topology.PartitionConnection) error
+
+	UnsetConnection(ctx context.Context, partition1 partition_topology.PartitionID, partition2 partition_topology.PartitionID) error
+
+	SetDefaultConnection(ctx context.Context, connection partition_topology.PartitionConnection) error
+
+	SetServicePartition(ctx context.Context, serviceName service.ServiceName, partitionId partition_topology.PartitionID) error
+
+	Repartition(
+		ctx context.Context,
+		servicePartitions map[service.ServiceName]partition_topology.PartitionID,
+		partitionConnections map[partition_topology.PartitionConnectionID]partition_topology.PartitionConnection,
+		defaultConnection partition_topology.PartitionConnection,
+	) error
+
 	GetApiContainerInfo() *ApiContainerInfo
 
 	GetEnclaveUuid() enclave.EnclaveUUID
@@ -0,0 +1,112 @@
+package traffic_control
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+)
+
+const (
+	shellBinary  = "sh"
+	shellCmdFlag = "-c"
+
+	// Interface of the user service on the enclave network, both on Docker and Kubernetes
+	networkInterface = "eth0"
+
+	commandSeparator = " && "
+
+	rootQdiscHandleMajor = 1
+	// Unconditioned traffic goes through this class
+	defaultClassMinor = 1
+	// The classes of the conditioned destinations are numbered from there
+	firstConditionedClassMinor = 2
+
+	// Shaping happens in the Linux kernel so this is just there to never be the bottleneck
+	classRate = "10gbit"
+
+	float32BitSize = 32
+)
+
+// GenerateTrafficControlCommand returns the command that, run in the network namespace of a service, replaces its
+// traffic control rules so that the traffic it sends to each of the given IPs follows the conditions of the
+// connection. Traffic to any other IP is left untouched.
+// Only the outgoing traffic is shaped, so both ends of a connection need to get their rules for the conditions to
+// apply in both directions. This also means the round-trip time between two services sees twice the configured delay.
+func GenerateTrafficControlCommand(conditionedConnectionsByIp map[string]partition_topology.PartitionConnection) []string {
+	commands := []string{
+		// Deleting the root qdisc fails when there is none, which is fine
+		fmt.Sprintf("(tc qdisc del dev %s root 2>/dev/null || true)", networkInterface),
+	}
+
+	ips := []string{}
+	for ip, connection := range conditionedConnectionsByIp {
+		if connection.IsUnconditioned() {
+			continue
+		}
+		ips = append(ips, ip)
+	}
+	if len(ips) == 0 {
+		return []string{shellBinary, shellCmdFlag, strings.Join(commands, commandSeparator)}
+	}
+	// Sorting to get a deterministic command
+	sort.Slice(ips, func(i, j int) bool {
+		return isIpLess(net.ParseIP(ips[i]), net.ParseIP(ips[j]), ips[i], ips[j])
+	})
+
+	commands = append(
+		commands,
+		fmt.Sprintf("tc qdisc add dev %s root handle %x: htb default %x", networkInterface, rootQdiscHandleMajor, defaultClassMinor),
+		fmt.Sprintf("tc class add dev %s parent %x: classid %x:%x htb rate %s", networkInterface, rootQdiscHandleMajor, rootQdiscHandleMajor, defaultClassMinor, classRate),
+	)
+	for idx, ip := range ips {
+		classMinor := firstConditionedClassMinor + idx
+		// qdisc handle majors live in their own namespace, they just need to differ from the root one
+		netemHandleMajor := classMinor + 1
+		commands = append(
+			commands,
+			fmt.Sprintf("tc class add dev %s parent %x: classid %x:%x htb rate %s", networkInterface, rootQdiscHandleMajor, rootQdiscHandleMajor, classMinor, classRate),
+			fmt.Sprintf("tc qdisc add dev %s parent %x:%x handle %x: %s", networkInterface, rootQdiscHandleMajor, classMinor, netemHandleMajor, generateNetemArgs(conditionedConnectionsByIp[ip])),
+			fmt.Sprintf("tc filter add dev %s protocol ip parent %x: prio 1 u32 match ip dst %s/32 flowid %x:%x", networkInterface, rootQdiscHandleMajor, ip, rootQdiscHandleMajor, classMinor),
+		)
+	}
+	return []string{shellBinary, shellCmdFlag, strings.Join(commands, commandSeparator)}
+}
+
+func generateNetemArgs(connection partition_topology.PartitionConnection) string {
+	args := []string{"netem"}
+	if connection.GetPacketLossPercentage() > 0 {
+		args = append(args, "loss", formatPercentage(connection.GetPacketLossPercentage()))
+	}
+	delayDistribution := connection.GetPacketDelayDistribution()
+	if !delayDistribution.IsZero() {
+		args = append(
+			args,
+			"delay",
+			fmt.Sprintf("%dms", delayDistribution.GetAvgDelayMs()),
+			fmt.Sprintf("%dms", delayDistribution.GetJitterMs()),
+		)
+		if delayDistribution.GetCorrelation() > 0 {
+			args = append(args, formatPercentage(delayDistribution.GetCorrelation()))
+		}
+		// netem only accepts a distribution when there is some jitter
+		if delayDistribution.GetDistribution() == partition_topology.NormalDelayDistribution && delayDistribution.GetJitterMs() > 0 {
+			args = append(args, "distribution", string(partition_topology.NormalDelayDistribution))
+		}
+	}
+	return strings.Join(args, " ")
+}
+
+func formatPercentage(percentage float32) string {
+	return strconv.FormatFloat(float64(percentage), 'f', -1, float32BitSize) + "%"
+}
+
+func isIpLess(ipA net.IP, ipB net.IP, ipStrA string, ipStrB string) bool {
+	if ipA == nil || ipB == nil {
+		return ipStrA < ipStrB
+	}
+	return string(ipA.To16()) < string(ipB.To16())
+}
@@ -0,0 +1,37 @@
+package traffic_control
+
+import (
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateTrafficControlCommand_NoConditionedConnections(t *testing.T) {
+	command := GenerateTrafficControlCommand(map[string]partition_topology.PartitionConnection{
+		"10.0.0.2": partition_topology.ConnectionAllowed,
+	})
+	require.Equal(t, []string{"sh", "-c", "(tc qdisc del dev eth0 root 2>/dev/null || true)"}, command)
+}
+
+func TestGenerateTrafficControlCommand_ConditionedConnections(t *testing.T) {
+	command := GenerateTrafficControlCommand(map[string]partition_topology.PartitionConnection{
+		"10.0.0.10": partition_topology.NewPartitionConnection(12.5, partition_topology.NewNormalPacketDelayDistribution(100, 20, 25)),
+		"10.0.0.9":  partition_topology.ConnectionBlocked,
+		"10.0.0.3":  partition_topology.ConnectionAllowed,
+		"10.0.0.4":  partition_topology.NewPartitionConnection(0, partition_topology.NewUniformPacketDelayDistribution(50, 0)),
+	})
+	expectedCommand := "(tc qdisc del dev eth0 root 2>/dev/null || true)" +
+		" && tc qdisc add dev eth0 root handle 1: htb default 1" +
+		" && tc class add dev eth0 parent 1: classid 1:1 htb rate 10gbit" +
+		" && tc class add dev eth0 parent 1: classid 1:2 htb rate 10gbit" +
+		" && tc qdisc add dev eth0 parent 1:2 handle 3: netem delay 50ms 0ms" +
+		" && tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 10.0.0.4/32 flowid 1:2" +
+		" && tc class add dev eth0 parent 1: classid 1:3 htb rate 10gbit" +
+		" && tc qdisc add dev eth0 parent 1:3 handle 4: netem loss 100%" +
+		" && tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 10.0.0.9/32 flowid 1:3" +
+		" && tc class add dev eth0 parent 1: classid 1:4 htb rate 10gbit" +
+		" && tc qdisc add dev eth0 parent 1:4 handle 5: netem loss 12.5% delay 100ms 20ms 25% distribution normal" +
+		" && tc filter add dev eth0 protocol ip parent 1: prio 1 u32 match ip dst 10.0.0.10/32 flowid 1:4"
+	require.Equal(t, []string{"sh", "-c", expectedCommand}, command)
+}
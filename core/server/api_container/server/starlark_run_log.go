@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"sync"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/stacktrace"
+)
+
+// starlarkRunLog keeps the response lines of the current Starlark run, so that clients that got disconnected can
+// resume streaming them from where they stopped
+// Only one Starlark run happens at a time against an enclave, so the log of the previous run is dropped when a new one starts
+type starlarkRunLog struct {
+	mutex *sync.Mutex
+
+	// Sent to the clients in the first response line of the run, for them to resume this run and not another one
+	runId string
+
+	responseLines []*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
+
+	isRunFinished bool
+
+	// Cancels the context the run executes with; the run isn't bound to the stream of the client that started it
+	cancelRun context.CancelFunc
+
+	// Closed, and replaced, every time the log changes to wake up the followers
+	logChangedChan chan struct{}
+}
+
+func newStarlarkRunLog(runId string, cancelRun context.CancelFunc) *starlarkRunLog {
+	return &starlarkRunLog{
+		mutex:          &sync.Mutex{},
+		runId:          runId,
+		responseLines:  []*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine{binding_constructors.NewStarlarkRunResponseLineFromRunStartedEvent(runId)},
+		isRunFinished:  false,
+		cancelRun:      cancelRun,
+		logChangedChan: make(chan struct{}),
+	}
+}
+
+func (runLog *starlarkRunLog) appendResponseLine(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) {
+	runLog.mutex.Lock()
+	defer runLog.mutex.Unlock()
+	runLog.responseLines = append(runLog.responseLines, responseLine)
+	runLog.notifyLogChanged()
+}
+
+func (runLog *starlarkRunLog) markRunFinished() {
+	runLog.mutex.Lock()
+	defer runLog.mutex.Unlock()
+	runLog.isRunFinished = true
+	runLog.notifyLogChanged()
+}
+
+// cancel stops the run before its next instruction. It's a no-op if the run already finished
+func (runLog *starlarkRunLog) cancel() {
+	runLog.cancelRun()
+}
+
+// follow sends the response lines starting at startingLineIndex through the send function, waiting for the new ones
+// until the run finishes or the context is done
+func (runLog *starlarkRunLog) follow(
+	ctx context.Context,
+	startingLineIndex int,
+	send func(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) error,
+) error {
+	nextLineIndex := startingLineIndex
+	for {
+		responseLinesToSend, isRunFinished, logChangedChan := runLog.getResponseLinesFrom(nextLineIndex)
+		for _, responseLine := range responseLinesToSend {
+			if err := send(responseLine); err != nil {
+				return stacktrace.Propagate(err, "An error occurred sending Starlark run response line number '%v'", nextLineIndex)
+			}
+			nextLineIndex++
+		}
+		if isRunFinished {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-logChangedChan:
+		}
+	}
+}
+
+func (runLog *starlarkRunLog) getResponseLinesFrom(startingLineIndex int) ([]*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, bool, chan struct{}) {
+	runLog.mutex.Lock()
+	defer runLog.mutex.Unlock()
+	if startingLineIndex >= len(runLog.responseLines) {
+		return nil, runLog.isRunFinished, runLog.logChangedChan
+	}
+	// Copying the slice for the lines to be sent without holding the lock
+	responseLines := make([]*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, len(runLog.responseLines)-startingLineIndex)
+	copy(responseLines, runLog.responseLines[startingLineIndex:])
+	return responseLines, runLog.isRunFinished, runLog.logChangedChan
+}
+
+// notifyLogChanged must be called holding the lock
+func (runLog *starlarkRunLog) notifyLogChanged() {
+	close(runLog.logChangedChan)
+	runLog.logChangedChan = make(chan struct{})
+}
+
+// currentStarlarkRunLog holds the log of the Starlark run currently happening in the enclave, or of the last one
+type currentStarlarkRunLog struct {
+	mutex *sync.Mutex
+
+	// Nil until the first Starlark run starts
+	runLog *starlarkRunLog
+}
+
+func newCurrentStarlarkRunLog() *currentStarlarkRunLog {
+	return &currentStarlarkRunLog{
+		mutex:  &sync.Mutex{},
+		runLog: nil,
+	}
+}
+
+func (current *currentStarlarkRunLog) set(runLog *starlarkRunLog) {
+	current.mutex.Lock()
+	defer current.mutex.Unlock()
+	current.runLog = runLog
+}
+
+// getRun returns the log of the run with the given run ID, which must be the run currently happening in the enclave
+// or the last one
+func (current *currentStarlarkRunLog) getRun(runId string) (*starlarkRunLog, error) {
+	current.mutex.Lock()
+	defer current.mutex.Unlock()
+	if runId == "" {
+		return nil, stacktrace.NewError("The ID of the Starlark run is required; it's sent in the first response line of every run")
+	}
+	if current.runLog == nil {
+		return nil, stacktrace.NewError("No Starlark run happened in the enclave, there's no run '%v'", runId)
+	}
+	if current.runLog.runId != runId {
+		return nil, stacktrace.NewError("Starlark run '%v' isn't the current run of the enclave anymore as run '%v' started since then", runId, current.runLog.runId)
+	}
+	return current.runLog, nil
+}
@@ -0,0 +1,111 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	firstInstructionResult  = "first"
+	secondInstructionResult = "second"
+	thirdInstructionResult  = "third"
+
+	firstRunId  = "first-run"
+	secondRunId = "second-run"
+	noRunId     = ""
+)
+
+var noOpCancelRun = func() {}
+
+func TestStarlarkRunLog_FollowFromStartingLineIndexUntilRunFinishes(t *testing.T) {
+	runLog := newStarlarkRunLog(firstRunId, noOpCancelRun)
+	runLog.appendResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(firstInstructionResult))
+	runLog.appendResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(secondInstructionResult))
+
+	go func() {
+		runLog.appendResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(thirdInstructionResult))
+		runLog.markRunFinished()
+	}()
+
+	var receivedInstructionResults []string
+	// skipping the run started event and the first instruction result
+	err := runLog.follow(context.Background(), 2, func(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) error {
+		receivedInstructionResults = append(receivedInstructionResults, responseLine.GetInstructionResult().GetSerializedInstructionResult())
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{secondInstructionResult, thirdInstructionResult}, receivedInstructionResults)
+}
+
+func TestStarlarkRunLog_FollowReturnsWhenContextIsDone(t *testing.T) {
+	runLog := newStarlarkRunLog(firstRunId, noOpCancelRun)
+	runLog.appendResponseLine(binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(firstInstructionResult))
+
+	ctx, cancelFunc := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancelFunc()
+
+	numReceivedLines := 0
+	err := runLog.follow(ctx, 0, func(_ *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) error {
+		numReceivedLines++
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, numReceivedLines)
+}
+
+func TestStarlarkRunLog_FirstResponseLineIsRunStartedEvent(t *testing.T) {
+	runLog := newStarlarkRunLog(firstRunId, noOpCancelRun)
+	runLog.markRunFinished()
+
+	var receivedResponseLines []*kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine
+	err := runLog.follow(context.Background(), 0, func(responseLine *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) error {
+		receivedResponseLines = append(receivedResponseLines, responseLine)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, receivedResponseLines, 1)
+	require.Equal(t, firstRunId, receivedResponseLines[0].GetRunStartedEvent().GetRunId())
+}
+
+func TestStarlarkRunLog_CancelCancelsRunContext(t *testing.T) {
+	runCtx, cancelRun := context.WithCancel(context.Background())
+	runLog := newStarlarkRunLog(firstRunId, cancelRun)
+	require.NoError(t, runCtx.Err())
+
+	runLog.cancel()
+	require.ErrorIs(t, runCtx.Err(), context.Canceled)
+}
+
+func TestCurrentStarlarkRunLog_NoRunToResumeBeforeFirstRun(t *testing.T) {
+	current := newCurrentStarlarkRunLog()
+	_, err := current.getRun(firstRunId)
+	require.Error(t, err)
+
+	runLog := newStarlarkRunLog(firstRunId, noOpCancelRun)
+	current.set(runLog)
+	currentRunLog, err := current.getRun(firstRunId)
+	require.NoError(t, err)
+	require.Equal(t, runLog, currentRunLog)
+}
+
+func TestCurrentStarlarkRunLog_OnlyLastRunCanBeResumed(t *testing.T) {
+	current := newCurrentStarlarkRunLog()
+	current.set(newStarlarkRunLog(firstRunId, noOpCancelRun))
+	secondRunLog := newStarlarkRunLog(secondRunId, noOpCancelRun)
+	current.set(secondRunLog)
+
+	_, err := current.getRun(firstRunId)
+	require.Error(t, err)
+
+	_, err = current.getRun(noRunId)
+	require.Error(t, err)
+
+	currentRunLog, err := current.getRun(secondRunId)
+	require.NoError(t, err)
+	require.Equal(t, secondRunLog, currentRunLog)
+}
@@ -0,0 +1,257 @@
+package testing_module
+
+import (
+	"fmt"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/grpc_request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"go.starlark.net/syntax"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	TestingModuleName = "testing"
+
+	assertEqualBuiltinName    = "assert_eq"
+	assertNotEqualBuiltinName = "assert_ne"
+	assertTrueBuiltinName     = "assert_true"
+	assertContainsBuiltinName = "assert_contains"
+	assertFailsBuiltinName    = "assert_fails"
+	failBuiltinName           = "fail"
+
+	mockHttpResponseBuiltinName = "mock_http_response"
+	mockGrpcResponseBuiltinName = "mock_grpc_response"
+	mockExecResultBuiltinName   = "mock_exec_result"
+
+	instructionsBuiltinName = "instructions"
+
+	actualArgName      = "actual"
+	expectedArgName    = "expected"
+	unexpectedArgName  = "unexpected"
+	valueArgName       = "value"
+	containerArgName   = "container"
+	itemArgName        = "item"
+	messageArgName     = "msg?"
+	serviceNameArgName = "service_name"
+	endpointArgName    = "endpoint"
+	methodArgName      = "method"
+	codeArgName        = "code?"
+	bodyArgName        = "body?"
+	outputArgName      = "output?"
+	commandArgName     = "command?"
+
+	instructionNameAttr       = "name"
+	instructionArgsAttr       = "args"
+	instructionExecutableAttr = "executable"
+
+	defaultMockHttpStatusCode = 200
+	defaultMockGrpcStatusCode = int(codes.OK)
+	defaultMockExecExitCode   = 0
+	noMessage                 = ""
+)
+
+// TestingModule returns the 'testing' module available to the tests of a Starlark package. It offers assertions, lets
+// the tests set what the requests sent to the services of the fake service network get, and lets them inspect the
+// instructions added to the plan
+func TestingModule(
+	serviceNetwork *service_network.FakeServiceNetwork,
+	instructionsPlan *instructions_plan.InstructionsPlan,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+) *starlarkstruct.Module {
+	return &starlarkstruct.Module{
+		Name: TestingModuleName,
+		Members: starlark.StringDict{
+			assertEqualBuiltinName:      starlark.NewBuiltin(assertEqualBuiltinName, assertEqual),
+			assertNotEqualBuiltinName:   starlark.NewBuiltin(assertNotEqualBuiltinName, assertNotEqual),
+			assertTrueBuiltinName:       starlark.NewBuiltin(assertTrueBuiltinName, assertTrue),
+			assertContainsBuiltinName:   starlark.NewBuiltin(assertContainsBuiltinName, assertContains),
+			assertFailsBuiltinName:      starlark.NewBuiltin(assertFailsBuiltinName, assertFails),
+			failBuiltinName:             starlark.NewBuiltin(failBuiltinName, fail),
+			mockHttpResponseBuiltinName: starlark.NewBuiltin(mockHttpResponseBuiltinName, generateMockHttpResponseBuiltin(serviceNetwork)),
+			mockGrpcResponseBuiltinName: starlark.NewBuiltin(mockGrpcResponseBuiltinName, generateMockGrpcResponseBuiltin(serviceNetwork)),
+			mockExecResultBuiltinName:   starlark.NewBuiltin(mockExecResultBuiltinName, generateMockExecResultBuiltin(serviceNetwork)),
+			instructionsBuiltinName:     starlark.NewBuiltin(instructionsBuiltinName, generateInstructionsBuiltin(instructionsPlan, starlarkValueSerde)),
+		},
+	}
+}
+
+func assertEqual(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var actual, expected starlark.Value
+	message := noMessage
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, actualArgName, &actual, expectedArgName, &expected, messageArgName, &message); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+	}
+	equal, err := starlark.Equal(actual, expected)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred comparing '%s' and '%s'", actual, expected)
+	}
+	if !equal {
+		return nil, assertionError(message, "Expected '%s' but got '%s'", expected, actual)
+	}
+	return starlark.None, nil
+}
+
+func assertNotEqual(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var actual, unexpected starlark.Value
+	message := noMessage
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, actualArgName, &actual, unexpectedArgName, &unexpected, messageArgName, &message); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+	}
+	equal, err := starlark.Equal(actual, unexpected)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred comparing '%s' and '%s'", actual, unexpected)
+	}
+	if equal {
+		return nil, assertionError(message, "Expected a value other than '%s'", unexpected)
+	}
+	return starlark.None, nil
+}
+
+func assertTrue(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var value starlark.Value
+	message := noMessage
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, valueArgName, &value, messageArgName, &message); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+	}
+	if !value.Truth() {
+		return nil, assertionError(message, "Expected '%s' to be true", value)
+	}
+	return starlark.None, nil
+}
+
+func assertContains(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var container, item starlark.Value
+	message := noMessage
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, containerArgName, &container, itemArgName, &item, messageArgName, &message); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+	}
+	contains, err := starlark.Binary(syntax.IN, item, container)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred checking whether '%s' contains '%s'", container, item)
+	}
+	if !contains.Truth() {
+		return nil, assertionError(message, "Expected '%s' to contain '%s'", container, item)
+	}
+	return starlark.None, nil
+}
+
+// assertFails calls the function with the rest of the arguments, and returns the message of the error it failed with
+func assertFails(thread *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	if len(args) == 0 {
+		return nil, startosis_errors.NewInterpretationError("'%s' expects the function to call as its first argument", b.Name())
+	}
+	function, ok := args[0].(starlark.Callable)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("'%s' expects the function to call as its first argument but got '%s'", b.Name(), args[0].Type())
+	}
+	if _, err := starlark.Call(thread, function, args[1:], kwargs); err != nil {
+		return starlark.String(err.Error()), nil
+	}
+	return nil, assertionError(noMessage, "Expected '%s' to fail but it succeeded", function.Name())
+}
+
+func fail(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	message := noMessage
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs, messageArgName, &message); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+	}
+	return nil, assertionError(message, "Test failed")
+}
+
+func generateMockHttpResponseBuiltin(serviceNetwork *service_network.FakeServiceNetwork) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var serviceName, endpoint, body string
+		code := defaultMockHttpStatusCode
+		if err := starlark.UnpackArgs(b.Name(), args, kwargs, serviceNameArgName, &serviceName, endpointArgName, &endpoint, codeArgName, &code, bodyArgName, &body); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+		}
+		serviceNetwork.SetFakeHttpResponse(service.ServiceName(serviceName), endpoint, service_network.NewFakeHttpResponse(code, body))
+		return starlark.None, nil
+	}
+}
+
+func generateMockGrpcResponseBuiltin(serviceNetwork *service_network.FakeServiceNetwork) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var serviceName, method, body string
+		code := defaultMockGrpcStatusCode
+		if err := starlark.UnpackArgs(b.Name(), args, kwargs, serviceNameArgName, &serviceName, methodArgName, &method, codeArgName, &code, bodyArgName, &body); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+		}
+		statusCode := codes.Code(code)
+		serviceNetwork.SetFakeGrpcResponse(service.ServiceName(serviceName), method, grpc_request.NewGrpcResponse(statusCode, statusCode.String(), body))
+		return starlark.None, nil
+	}
+}
+
+func generateMockExecResultBuiltin(serviceNetwork *service_network.FakeServiceNetwork) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var serviceName, output string
+		var command *starlark.List
+		code := defaultMockExecExitCode
+		if err := starlark.UnpackArgs(b.Name(), args, kwargs, serviceNameArgName, &serviceName, codeArgName, &code, outputArgName, &output, commandArgName, &command); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+		}
+		var commandArgs []string
+		if command != nil {
+			for idx := 0; idx < command.Len(); idx++ {
+				commandArg, ok := command.Index(idx).(starlark.String)
+				if !ok {
+					return nil, startosis_errors.NewInterpretationError("'%s' expects the command to be a list of strings but got '%s'", b.Name(), command)
+				}
+				commandArgs = append(commandArgs, commandArg.GoString())
+			}
+		}
+		serviceNetwork.SetFakeExecResult(service.ServiceName(serviceName), commandArgs, exec_result.NewExecResult(int32(code), output))
+		return starlark.None, nil
+	}
+}
+
+// generateInstructionsBuiltin returns the instructions added to the plan so far, each of them with its name, its
+// arguments and its executable Starlark
+func generateInstructionsBuiltin(instructionsPlan *instructions_plan.InstructionsPlan, starlarkValueSerde *kurtosis_types.StarlarkValueSerde) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(_ *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		if err := starlark.UnpackArgs(b.Name(), args, kwargs); err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid arguments for '%s'", b.Name())
+		}
+		scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		instructions := make([]starlark.Value, len(scheduledInstructions))
+		for idx, scheduledInstruction := range scheduledInstructions {
+			canonicalInstruction := scheduledInstruction.GetInstruction().GetCanonicalInstruction(false)
+			instructionArgs := starlark.NewDict(len(canonicalInstruction.GetArguments()))
+			for _, argument := range canonicalInstruction.GetArguments() {
+				// arguments which can't be deserialized, e.g. the ones containing types only known by the plan, are
+				// kept as their serialized Starlark
+				var argumentValue starlark.Value = starlark.String(argument.GetSerializedArgValue())
+				if deserializedValue, deserializationErr := starlarkValueSerde.Deserialize(argument.GetSerializedArgValue()); deserializationErr == nil {
+					argumentValue = deserializedValue
+				}
+				if err := instructionArgs.SetKey(starlark.String(argument.GetArgName()), argumentValue); err != nil {
+					return nil, startosis_errors.WrapWithInterpretationError(err, "An error occurred adding argument '%s' of instruction '%s'", argument.GetArgName(), canonicalInstruction.GetInstructionName())
+				}
+			}
+			instructions[idx] = starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+				instructionNameAttr:       starlark.String(canonicalInstruction.GetInstructionName()),
+				instructionArgsAttr:       instructionArgs,
+				instructionExecutableAttr: starlark.String(canonicalInstruction.GetExecutableInstruction()),
+			})
+		}
+		return starlark.NewList(instructions), nil
+	}
+}
+
+func assertionError(message string, defaultMessageFormat string, args ...interface{}) *startosis_errors.InterpretationError {
+	if message != noMessage {
+		return startosis_errors.NewInterpretationError("%s: %s", message, fmt.Sprintf(defaultMessageFormat, args...))
+	}
+	return startosis_errors.NewInterpretationError(defaultMessageFormat, args...)
+}
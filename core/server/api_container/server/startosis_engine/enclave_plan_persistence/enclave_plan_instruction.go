@@ -55,12 +55,12 @@ func (enclavePlanInstruction *EnclavePlanInstruction) HasOnlyFilesArtifactMd5(fi
 
 func (enclavePlanInstruction *EnclavePlanInstruction) Clone() *EnclavePlanInstruction {
 	clonedServiceNames := make([]string, len(enclavePlanInstruction.ServiceNames))
-	copy(enclavePlanInstruction.ServiceNames, clonedServiceNames)
+	copy(clonedServiceNames, enclavePlanInstruction.ServiceNames)
 
 	clonedFilesArtifacts := make(map[string][]byte, len(enclavePlanInstruction.FilesArtifacts))
 	for filesArtifactName, filesArtifactMd5 := range enclavePlanInstruction.FilesArtifacts {
 		clonedFilesArtifactMd5 := make([]byte, len(filesArtifactMd5))
-		copy(filesArtifactMd5, clonedFilesArtifactMd5)
+		copy(clonedFilesArtifactMd5, filesArtifactMd5)
 		clonedFilesArtifacts[filesArtifactName] = clonedFilesArtifactMd5
 	}
 	return &EnclavePlanInstruction{
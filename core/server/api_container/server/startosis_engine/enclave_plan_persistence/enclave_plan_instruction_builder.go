@@ -17,6 +17,10 @@ type EnclavePlanInstructionBuilder struct {
 	serviceNames []string
 
 	filesArtifacts map[string][]byte
+
+	// The services the instruction runs against without adding or removing them, like the service of an exec.
+	// They aren't persisted, they only order the instructions running against the same service
+	targetedServiceNames []string
 }
 
 func NewEnclavePlanInstructionBuilder() *EnclavePlanInstructionBuilder {
@@ -27,6 +31,8 @@ func NewEnclavePlanInstructionBuilder() *EnclavePlanInstructionBuilder {
 		returnedValue:   "",
 		serviceNames:    []string{},
 		filesArtifacts:  map[string][]byte{},
+
+		targetedServiceNames: []string{},
 	}
 }
 
@@ -60,6 +66,36 @@ func (builder *EnclavePlanInstructionBuilder) AddFilesArtifact(filesArtifactName
 	return builder
 }
 
+func (builder *EnclavePlanInstructionBuilder) AddTargetedServiceName(serviceName service.ServiceName) *EnclavePlanInstructionBuilder {
+	builder.targetedServiceNames = append(builder.targetedServiceNames, string(serviceName))
+	return builder
+}
+
+func (builder *EnclavePlanInstructionBuilder) GetType() string {
+	return builder.instructionType
+}
+
+func (builder *EnclavePlanInstructionBuilder) GetServiceNames() []string {
+	return builder.serviceNames
+}
+
+func (builder *EnclavePlanInstructionBuilder) GetTargetedServiceNames() []string {
+	return builder.targetedServiceNames
+}
+
+func (builder *EnclavePlanInstructionBuilder) GetFilesArtifactNames() []string {
+	filesArtifactNames := make([]string, 0, len(builder.filesArtifacts))
+	for filesArtifactName := range builder.filesArtifacts {
+		filesArtifactNames = append(filesArtifactNames, filesArtifactName)
+	}
+	return filesArtifactNames
+}
+
+// GetFilesArtifactMd5 returns the MD5 of the files artifact, nil if it's not tracked by the instruction
+func (builder *EnclavePlanInstructionBuilder) GetFilesArtifactMd5(filesArtifactName string) []byte {
+	return builder.filesArtifacts[filesArtifactName]
+}
+
 func (builder *EnclavePlanInstructionBuilder) Build() (*EnclavePlanInstruction, error) {
 	if builder.uuid == "" || builder.instructionType == "" || builder.starlarkCode == "" || builder.returnedValue == "" {
 		return nil, stacktrace.NewError("Some required attributes aren't set on this builder")
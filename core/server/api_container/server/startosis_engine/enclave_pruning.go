@@ -0,0 +1,71 @@
+package startosis_engine
+
+import (
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
+)
+
+// enclaveComponentsToPrune are the services and files artifacts present in the enclave that the plan being run doesn't
+// contain anymore
+type enclaveComponentsToPrune struct {
+	serviceNames []service.ServiceName
+
+	filesArtifactNames []string
+}
+
+// getEnclaveComponentsToPrune returns the services added and the files artifacts created by the instructions run in
+// the enclave before that none of the instructions of the plan being run refers to. Both are sorted alphabetically
+func getEnclaveComponentsToPrune(
+	currentEnclavePlanInstructions []*enclave_plan_persistence.EnclavePlanInstruction,
+	instructionsSequence []*instructions_plan.ScheduledInstruction,
+) *enclaveComponentsToPrune {
+	existingServices := map[string]bool{}
+	existingFilesArtifacts := map[string]bool{}
+	for _, enclavePlanInstruction := range currentEnclavePlanInstructions {
+		switch enclavePlanInstruction.Type {
+		case add_service.AddServiceBuiltinName, add_service.AddServicesBuiltinName:
+			for _, serviceName := range enclavePlanInstruction.ServiceNames {
+				existingServices[serviceName] = true
+			}
+		case remove_service.RemoveServiceBuiltinName:
+			for _, serviceName := range enclavePlanInstruction.ServiceNames {
+				delete(existingServices, serviceName)
+			}
+		}
+		for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+			existingFilesArtifacts[filesArtifactName] = true
+		}
+	}
+
+	for _, scheduledInstruction := range instructionsSequence {
+		persistableAttributes := scheduledInstruction.GetInstruction().GetPersistableAttributes()
+		for _, serviceName := range persistableAttributes.GetServiceNames() {
+			delete(existingServices, serviceName)
+		}
+		for _, filesArtifactName := range persistableAttributes.GetFilesArtifactNames() {
+			delete(existingFilesArtifacts, filesArtifactName)
+		}
+	}
+
+	serviceNames := []service.ServiceName{}
+	for serviceName := range existingServices {
+		serviceNames = append(serviceNames, service.ServiceName(serviceName))
+	}
+	sort.Slice(serviceNames, func(i, j int) bool {
+		return serviceNames[i] < serviceNames[j]
+	})
+	filesArtifactNames := []string{}
+	for filesArtifactName := range existingFilesArtifacts {
+		filesArtifactNames = append(filesArtifactNames, filesArtifactName)
+	}
+	sort.Strings(filesArtifactNames)
+	return &enclaveComponentsToPrune{
+		serviceNames:       serviceNames,
+		filesArtifactNames: filesArtifactNames,
+	}
+}
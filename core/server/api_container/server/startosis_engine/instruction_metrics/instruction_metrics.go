@@ -0,0 +1,69 @@
+package instruction_metrics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+)
+
+// InstructionMetrics collects the metrics of a single instruction execution. The executor stores it in the context
+// passed to the instruction so that the instruction, and the helpers it calls, can report what they did
+// It is safe for concurrent use as some instructions, like add_services, do things in parallel
+type InstructionMetrics struct {
+	mutex *sync.Mutex
+
+	startTime time.Time
+
+	imagePullDuration time.Duration
+
+	numRetries uint32
+}
+
+func NewInstructionMetrics(startTime time.Time, imagePullDuration time.Duration) *InstructionMetrics {
+	return &InstructionMetrics{
+		mutex:             &sync.Mutex{},
+		startTime:         startTime,
+		imagePullDuration: imagePullDuration,
+		numRetries:        0,
+	}
+}
+
+// WithInstructionMetrics returns a copy of the context carrying the metrics
+func WithInstructionMetrics(ctx context.Context, metrics *InstructionMetrics) context.Context {
+	return context.WithValue(ctx, startosis_constants.InstructionMetricsParam, metrics)
+}
+
+// RecordRetries adds numRetries to the metrics of the instruction being executed with this context. It is a no-op if
+// the context carries no metrics, i.e. when the instruction is not run by the executor
+func RecordRetries(ctx context.Context, numRetries uint32) {
+	metrics, ok := ctx.Value(startosis_constants.InstructionMetricsParam).(*InstructionMetrics)
+	if !ok {
+		return
+	}
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	metrics.numRetries += numRetries
+}
+
+func (metrics *InstructionMetrics) GetNumRetries() uint32 {
+	metrics.mutex.Lock()
+	defer metrics.mutex.Unlock()
+	return metrics.numRetries
+}
+
+// ToAPIType returns the metrics of the instruction, considering its execution finished at endTime
+func (metrics *InstructionMetrics) ToAPIType(endTime time.Time) *kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics {
+	startTimestampMs := metrics.startTime.UnixMilli()
+	endTimestampMs := endTime.UnixMilli()
+	return binding_constructors.NewStarlarkInstructionMetrics(
+		startTimestampMs,
+		endTimestampMs,
+		uint64(endTimestampMs-startTimestampMs),
+		uint64(metrics.imagePullDuration.Milliseconds()),
+		metrics.GetNumRetries(),
+	)
+}
@@ -0,0 +1,179 @@
+package startosis_engine
+
+import (
+	"strings"
+
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+// Instructions changing the connections between subnetworks affect every service of the enclave, so they run on their
+// own, after all the instructions preceding them and before all the instructions following them
+var enclaveWideInstructionTypes = map[string]bool{
+	set_connection.SetConnectionBuiltinName:       true,
+	remove_connection.RemoveConnectionBuiltinName: true,
+}
+
+// instructionsDependencyGraph stores, for each instruction of a sequence, the instructions preceding it in the sequence
+// that must be done executing before it can start executing.
+//
+// An instruction depends on a previous one when one of them references a resource the other one creates or modifies,
+// or when both modify or run against the same resource. Resources are services, files artifacts and runtime values. Resources are
+// referenced by name (or by UUID for runtime values) in the Starlark code of the instructions, so the detection errs
+// on the side of caution: any occurrence of the name in the code of an instruction is considered a reference.
+type instructionsDependencyGraph struct {
+	prerequisites [][]int
+}
+
+func newInstructionsDependencyGraph(instructionsSequence []*instructions_plan.ScheduledInstruction) *instructionsDependencyGraph {
+	instructionsResources := make([]map[string]bool, len(instructionsSequence))
+	instructionsCode := make([]string, len(instructionsSequence))
+	isEnclaveWide := make([]bool, len(instructionsSequence))
+	for index, scheduledInstruction := range instructionsSequence {
+		instructionType, resources := getInstructionResources(scheduledInstruction)
+		instructionsResources[index] = resources
+		instructionsCode[index] = scheduledInstruction.GetInstruction().String()
+		isEnclaveWide[index] = enclaveWideInstructionTypes[instructionType]
+	}
+
+	prerequisites := make([][]int, len(instructionsSequence))
+	for index := range instructionsSequence {
+		prerequisites[index] = []int{}
+		for previousIndex := 0; previousIndex < index; previousIndex++ {
+			if isEnclaveWide[index] || isEnclaveWide[previousIndex] ||
+				haveCommonResource(instructionsResources[index], instructionsResources[previousIndex]) ||
+				referencesAnyResource(instructionsCode[index], instructionsResources[previousIndex]) ||
+				referencesAnyResource(instructionsCode[previousIndex], instructionsResources[index]) {
+				prerequisites[index] = append(prerequisites[index], previousIndex)
+			}
+		}
+	}
+	return &instructionsDependencyGraph{
+		prerequisites: prerequisites,
+	}
+}
+
+// getPrerequisites returns the indices of the instructions that must be done executing before the instruction at the
+// given index can start executing
+func (graph *instructionsDependencyGraph) getPrerequisites(index int) []int {
+	return graph.prerequisites[index]
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+
+// getInstructionResources returns the type of the instruction along with the resources it creates, modifies or runs
+// against
+func getInstructionResources(scheduledInstruction *instructions_plan.ScheduledInstruction) (string, map[string]bool) {
+	resources := map[string]bool{}
+	persistableAttributes := scheduledInstruction.GetInstruction().GetPersistableAttributes()
+	for _, serviceName := range persistableAttributes.GetServiceNames() {
+		resources[serviceName] = true
+	}
+	// instructions running against the same service, like two execs, run in the order of the sequence as the
+	// outcome of one might depend on the side effects of the other on the service
+	for _, serviceName := range persistableAttributes.GetTargetedServiceNames() {
+		resources[serviceName] = true
+	}
+	for _, filesArtifactName := range persistableAttributes.GetFilesArtifactNames() {
+		resources[filesArtifactName] = true
+	}
+	addReturnedValueResources(scheduledInstruction.GetReturnedValue(), resources)
+	return persistableAttributes.GetType(), resources
+}
+
+// addReturnedValueResources adds the runtime values returned by an instruction, and the names it returns, like the
+// names of the files artifacts created by tasks
+func addReturnedValueResources(returnedValue starlark.Value, resources map[string]bool) {
+	switch value := returnedValue.(type) {
+	case nil, starlark.NoneType:
+		return
+	case starlark.String:
+		runtimeValueUuids := magic_string_helper.GetRuntimeValueUuids(value.GoString())
+		if len(runtimeValueUuids) == 0 && value.GoString() != "" {
+			resources[value.GoString()] = true
+		}
+		for _, runtimeValueUuid := range runtimeValueUuids {
+			resources[runtimeValueUuid] = true
+		}
+	case *starlarkstruct.Struct:
+		for _, attrName := range value.AttrNames() {
+			attrValue, err := value.Attr(attrName)
+			if err == nil {
+				addReturnedValueResources(attrValue, resources)
+			}
+		}
+	case *starlark.Dict:
+		for _, item := range value.Items() {
+			addReturnedValueResources(item[1], resources)
+		}
+	case *starlark.List:
+		for index := 0; index < value.Len(); index++ {
+			addReturnedValueResources(value.Index(index), resources)
+		}
+	case starlark.Tuple:
+		for _, item := range value {
+			addReturnedValueResources(item, resources)
+		}
+	default:
+		// other Kurtosis types, like services, only expose runtime values
+		for _, runtimeValueUuid := range magic_string_helper.GetRuntimeValueUuids(value.String()) {
+			resources[runtimeValueUuid] = true
+		}
+	}
+}
+
+func haveCommonResource(resources map[string]bool, otherResources map[string]bool) bool {
+	for resource := range resources {
+		if otherResources[resource] {
+			return true
+		}
+	}
+	return false
+}
+
+func referencesAnyResource(instructionCode string, resources map[string]bool) bool {
+	for resource := range resources {
+		if containsName(instructionCode, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsName returns true if the name appears in the code without being part of a longer name, such that service
+// 'node-1' is referenced by 'http://node-1:8080' but not by 'node-10'
+func containsName(code string, name string) bool {
+	if name == "" {
+		return false
+	}
+	for searchStart := 0; searchStart < len(code); {
+		matchIndex := strings.Index(code[searchStart:], name)
+		if matchIndex < 0 {
+			return false
+		}
+		matchStart := searchStart + matchIndex
+		matchEnd := matchStart + len(name)
+		isStartOfName := matchStart == 0 || !isNameCharacter(code[matchStart-1])
+		isEndOfName := matchEnd == len(code) || !isNameCharacter(code[matchEnd])
+		if isStartOfName && isEndOfName {
+			return true
+		}
+		searchStart = matchStart + 1
+	}
+	return false
+}
+
+func isNameCharacter(character byte) bool {
+	return character == '-' || character == '_' ||
+		('a' <= character && character <= 'z') ||
+		('A' <= character && character <= 'Z') ||
+		('0' <= character && character <= '9')
+}
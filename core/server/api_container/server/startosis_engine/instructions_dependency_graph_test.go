@@ -0,0 +1,104 @@
+package startosis_engine
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+)
+
+const (
+	testRuntimeValueUuid = "0123456789abcdef0123456789abcdef"
+)
+
+func TestInstructionsDependencyGraph_ServicesAndFilesArtifacts(t *testing.T) {
+	instructionsSequence := []*instructions_plan.ScheduledInstruction{
+		newScheduledInstructionForGraphTest(t, "add_service", `add_service(name="node-1")`, []string{"node-1"}, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, "add_service", `add_service(name="node-10")`, []string{"node-10"}, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, "upload_files", `upload_files(src="./genesis", name="genesis")`, nil, []string{"genesis"}, nil, starlark.String("genesis")),
+		newScheduledInstructionForGraphTest(t, "add_service", `add_service(name="client", config=ServiceConfig(files={"/data": "genesis"}, env_vars={"NODE": "http://node-1:8080"}))`, []string{"client"}, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, "exec", `exec(service_name="node-10")`, nil, nil, []string{"node-10"}, starlark.None),
+		newScheduledInstructionForGraphTest(t, "remove_service", `remove_service(name="node-10")`, []string{"node-10"}, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, "wait", `wait(service_name="node-1")`, nil, nil, []string{"node-1"}, starlark.None),
+		newScheduledInstructionForGraphTest(t, "exec", `exec(service_name="node-1")`, nil, nil, []string{"node-1"}, starlark.None),
+		newScheduledInstructionForGraphTest(t, "exec", `exec(service_name="node-1", recipe=ExecRecipe(command=["touch", "/ready"]))`, nil, nil, []string{"node-1"}, starlark.None),
+	}
+	graph := newInstructionsDependencyGraph(instructionsSequence)
+
+	require.Empty(t, graph.getPrerequisites(0))
+	require.Empty(t, graph.getPrerequisites(1))
+	require.Empty(t, graph.getPrerequisites(2))
+	// references node-1 and the genesis files artifact, but not node-10
+	require.Equal(t, []int{0, 2}, graph.getPrerequisites(3))
+	require.Equal(t, []int{1}, graph.getPrerequisites(4))
+	// both modify node-10, and the exec must be done before the service gets removed
+	require.Equal(t, []int{1, 4}, graph.getPrerequisites(5))
+	// instructions running against the same service keep the order of the sequence
+	require.Equal(t, []int{0, 3}, graph.getPrerequisites(6))
+	require.Equal(t, []int{0, 3, 6}, graph.getPrerequisites(7))
+	require.Equal(t, []int{0, 3, 6, 7}, graph.getPrerequisites(8))
+}
+
+func TestInstructionsDependencyGraph_RuntimeValues(t *testing.T) {
+	runtimeValue := fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, testRuntimeValueUuid, "output")
+	taskResult := starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"output":          starlark.String(runtimeValue),
+		"files_artifacts": starlark.NewList([]starlark.Value{starlark.String("task-artifact")}),
+	})
+	instructionsSequence := []*instructions_plan.ScheduledInstruction{
+		newScheduledInstructionForGraphTest(t, "run_sh", `run_sh(run="echo hello")`, nil, nil, nil, taskResult),
+		newScheduledInstructionForGraphTest(t, "print", `print(msg="hello")`, nil, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, "print", fmt.Sprintf(`print(msg="%s")`, runtimeValue), nil, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, "add_service", `add_service(name="consumer", config=ServiceConfig(files={"/data": "task-artifact"}))`, []string{"consumer"}, nil, nil, starlark.None),
+	}
+	graph := newInstructionsDependencyGraph(instructionsSequence)
+
+	require.Empty(t, graph.getPrerequisites(1))
+	require.Equal(t, []int{0}, graph.getPrerequisites(2))
+	require.Equal(t, []int{0}, graph.getPrerequisites(3))
+}
+
+func TestInstructionsDependencyGraph_EnclaveWideInstructions(t *testing.T) {
+	instructionsSequence := []*instructions_plan.ScheduledInstruction{
+		newScheduledInstructionForGraphTest(t, "add_service", `add_service(name="service-1")`, []string{"service-1"}, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, set_connection.SetConnectionBuiltinName, `set_connection(subnetworks=("subnet-1", "subnet-2"))`, nil, nil, nil, starlark.None),
+		newScheduledInstructionForGraphTest(t, "add_service", `add_service(name="service-2")`, []string{"service-2"}, nil, nil, starlark.None),
+	}
+	graph := newInstructionsDependencyGraph(instructionsSequence)
+
+	require.Equal(t, []int{0}, graph.getPrerequisites(1))
+	require.Equal(t, []int{1}, graph.getPrerequisites(2))
+}
+
+func TestContainsName(t *testing.T) {
+	require.True(t, containsName(`add_service(name="node-1")`, "node-1"))
+	require.True(t, containsName(`env_vars={"NODE": "http://node-1:8080"}`, "node-1"))
+	require.False(t, containsName(`add_service(name="node-10")`, "node-1"))
+	require.False(t, containsName(`add_service(name="my-node-1")`, "node-1"))
+	require.False(t, containsName(`add_service(name="node-1")`, ""))
+}
+
+func newScheduledInstructionForGraphTest(t *testing.T, instructionType string, code string, serviceNames []string, filesArtifactNames []string, targetedServiceNames []string, returnedValue starlark.Value) *instructions_plan.ScheduledInstruction {
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+	persistableAttributes := enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetType(instructionType).SetStarlarkCode(code)
+	for _, serviceName := range serviceNames {
+		persistableAttributes.AddServiceName(service.ServiceName(serviceName))
+	}
+	for _, filesArtifactName := range filesArtifactNames {
+		persistableAttributes.AddFilesArtifact(filesArtifactName, nil)
+	}
+	for _, serviceName := range targetedServiceNames {
+		persistableAttributes.AddTargetedServiceName(service.ServiceName(serviceName))
+	}
+	instruction.EXPECT().GetPersistableAttributes().Maybe().Return(persistableAttributes)
+	instruction.EXPECT().String().Maybe().Return(code)
+	return instructions_plan.NewScheduledInstruction(instructions_plan.ScheduledInstructionUuid(code), instruction, returnedValue)
+}
@@ -1,6 +1,8 @@
 package instructions_plan
 
 import (
+	"time"
+
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
 	"go.starlark.net/starlark"
 )
@@ -20,6 +22,9 @@ type ScheduledInstruction struct {
 	returnedValue starlark.Value
 
 	executed bool
+
+	// Time spent during validation pulling the container images this instruction was the first one to require
+	imagePullDuration time.Duration
 }
 
 func NewScheduledInstruction(uuid ScheduledInstructionUuid, kurtosisInstruction kurtosis_instruction.KurtosisInstruction, returnedValue starlark.Value) *ScheduledInstruction {
@@ -28,6 +33,7 @@ func NewScheduledInstruction(uuid ScheduledInstructionUuid, kurtosisInstruction
 		kurtosisInstruction: kurtosisInstruction,
 		returnedValue:       returnedValue,
 		executed:            false,
+		imagePullDuration:   0,
 	}
 }
 
@@ -51,3 +57,11 @@ func (instruction *ScheduledInstruction) Executed(isExecuted bool) *ScheduledIns
 func (instruction *ScheduledInstruction) IsExecuted() bool {
 	return instruction.executed
 }
+
+func (instruction *ScheduledInstruction) AddImagePullDuration(imagePullDuration time.Duration) {
+	instruction.imagePullDuration += imagePullDuration
+}
+
+func (instruction *ScheduledInstruction) GetImagePullDuration() time.Duration {
+	return instruction.imagePullDuration
+}
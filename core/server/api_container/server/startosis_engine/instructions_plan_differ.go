@@ -0,0 +1,170 @@
+package startosis_engine
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/update_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
+)
+
+const (
+	unmatchedInstructionReason = "Its instruction couldn't be matched with the instructions run in the enclave before, so it runs again"
+
+	prunedServiceReason = "It's pruned as it isn't part of the plan anymore"
+)
+
+// diffPlan compares the instructions of a plan optimized against the current enclave plan with the instructions run in
+// the enclave before, and returns the changes running the plan would make to the services of the enclave.
+//
+// A service is updated when the arguments of the instruction adding it changed, and restarted when they didn't but the
+// instruction runs again anyway, most of the time because one of the files artifacts it mounts changed. When prune is
+// true, the services the run prunes from the enclave as none of the instructions of the plan refers to them anymore are
+// removed after all the instructions ran
+func diffPlan(
+	currentEnclavePlanInstructions []*enclave_plan_persistence.EnclavePlanInstruction,
+	instructionsSequence []*instructions_plan.ScheduledInstruction,
+	prune bool,
+) *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse {
+	// the code of the instruction that added each service still in the enclave
+	existingServices := map[string]string{}
+	// the instruction that created each files artifact of the enclave
+	existingFilesArtifacts := map[string]*enclave_plan_persistence.EnclavePlanInstruction{}
+	for _, enclavePlanInstruction := range currentEnclavePlanInstructions {
+		switch enclavePlanInstruction.Type {
+		case add_service.AddServiceBuiltinName, add_service.AddServicesBuiltinName:
+			for _, serviceName := range enclavePlanInstruction.ServiceNames {
+				existingServices[serviceName] = enclavePlanInstruction.StarlarkCode
+			}
+		case remove_service.RemoveServiceBuiltinName:
+			for _, serviceName := range enclavePlanInstruction.ServiceNames {
+				delete(existingServices, serviceName)
+			}
+		}
+		for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+			existingFilesArtifacts[filesArtifactName] = enclavePlanInstruction
+		}
+	}
+
+	serviceChanges := []*kurtosis_core_rpc_api_bindings.ServiceChange{}
+	// why each files artifact created again by this run changed
+	filesArtifactChanges := map[string]string{}
+	var instructionsToRunCount, instructionsToSkipCount uint32
+	for _, scheduledInstruction := range instructionsSequence {
+		if scheduledInstruction.IsExecuted() {
+			instructionsToSkipCount += 1
+			continue
+		}
+		instructionsToRunCount += 1
+
+		instruction := scheduledInstruction.GetInstruction()
+		instructionCode := instruction.String()
+		persistableAttributes := instruction.GetPersistableAttributes()
+		for _, filesArtifactName := range persistableAttributes.GetFilesArtifactNames() {
+			filesArtifactChanges[filesArtifactName] = getFilesArtifactChange(
+				filesArtifactName,
+				instructionCode,
+				persistableAttributes.GetFilesArtifactMd5(filesArtifactName),
+				existingFilesArtifacts[filesArtifactName],
+			)
+		}
+
+		serviceNames := persistableAttributes.GetServiceNames()
+		sort.Strings(serviceNames)
+		switch persistableAttributes.GetType() {
+		case add_service.AddServiceBuiltinName, add_service.AddServicesBuiltinName:
+			mountedFilesArtifacts := getMountedFilesArtifacts(instruction)
+			for _, serviceName := range serviceNames {
+				previousInstructionCode, found := existingServices[serviceName]
+				if !found {
+					serviceChanges = append(serviceChanges, binding_constructors.NewServiceChange(serviceName, kurtosis_core_rpc_api_bindings.ServiceChangeType_ADDED, nil))
+				} else if previousInstructionCode != instructionCode {
+					reason := fmt.Sprintf("Its arguments changed from '%s' to '%s'", previousInstructionCode, instructionCode)
+					serviceChanges = append(serviceChanges, binding_constructors.NewServiceChange(serviceName, kurtosis_core_rpc_api_bindings.ServiceChangeType_UPDATED, []string{reason}))
+				} else {
+					reasons := getRestartReasons(mountedFilesArtifacts[serviceName], filesArtifactChanges)
+					serviceChanges = append(serviceChanges, binding_constructors.NewServiceChange(serviceName, kurtosis_core_rpc_api_bindings.ServiceChangeType_RESTARTED, reasons))
+				}
+				existingServices[serviceName] = instructionCode
+			}
+		case update_service.UpdateServiceBuiltinName:
+			for _, serviceName := range serviceNames {
+				reason := fmt.Sprintf("It's updated by '%s'", instructionCode)
+				serviceChanges = append(serviceChanges, binding_constructors.NewServiceChange(serviceName, kurtosis_core_rpc_api_bindings.ServiceChangeType_UPDATED, []string{reason}))
+			}
+		case remove_service.RemoveServiceBuiltinName:
+			for _, serviceName := range serviceNames {
+				serviceChanges = append(serviceChanges, binding_constructors.NewServiceChange(serviceName, kurtosis_core_rpc_api_bindings.ServiceChangeType_REMOVED, nil))
+				delete(existingServices, serviceName)
+			}
+		}
+	}
+
+	if prune {
+		componentsToPrune := getEnclaveComponentsToPrune(currentEnclavePlanInstructions, instructionsSequence)
+		for _, serviceName := range componentsToPrune.serviceNames {
+			serviceChanges = append(serviceChanges, binding_constructors.NewServiceChange(string(serviceName), kurtosis_core_rpc_api_bindings.ServiceChangeType_REMOVED, []string{prunedServiceReason}))
+		}
+	}
+	return binding_constructors.NewGetStarlarkRunDiffResponseFromServiceChanges(serviceChanges, instructionsToRunCount, instructionsToSkipCount)
+}
+
+// getFilesArtifactChange describes why a files artifact created by an instruction that runs again changed
+func getFilesArtifactChange(filesArtifactName string, instructionCode string, filesArtifactMd5 []byte, previousInstruction *enclave_plan_persistence.EnclavePlanInstruction) string {
+	if previousInstruction == nil {
+		return "is new"
+	}
+	previousFilesArtifactMd5 := previousInstruction.FilesArtifacts[filesArtifactName]
+	if len(filesArtifactMd5) > 0 && len(previousFilesArtifactMd5) > 0 && !bytes.Equal(filesArtifactMd5, previousFilesArtifactMd5) {
+		return fmt.Sprintf("changed from MD5 '%x' to '%x'", previousFilesArtifactMd5, filesArtifactMd5)
+	}
+	if previousInstruction.StarlarkCode != instructionCode {
+		return "changed as the arguments of the instruction creating it changed"
+	}
+	return "is created again"
+}
+
+// getMountedFilesArtifacts returns the files artifacts mounted by the services an instruction adds, by mount path,
+// by service name
+func getMountedFilesArtifacts(instruction kurtosis_instruction.KurtosisInstruction) map[string]map[string]string {
+	planBuilder := plan_export.NewPlanBuilder()
+	planBuilder.AddInstruction(instruction.GetCanonicalInstruction(isNotSkipped).GetInstructionName(), instruction.GetPositionInOriginalScript().String(), instruction.String(), nil, nil)
+	instruction.UpdatePlan(planBuilder)
+
+	mountedFilesArtifacts := map[string]map[string]string{}
+	for _, plannedService := range planBuilder.Build().Services {
+		mountedFilesArtifacts[plannedService.Name] = map[string]string{}
+		for _, filesMount := range plannedService.Files {
+			mountedFilesArtifacts[plannedService.Name][filesMount.MountPath] = filesMount.FilesArtifact
+		}
+	}
+	return mountedFilesArtifacts
+}
+
+func getRestartReasons(mountedFilesArtifacts map[string]string, filesArtifactChanges map[string]string) []string {
+	mountPaths := make([]string, 0, len(mountedFilesArtifacts))
+	for mountPath := range mountedFilesArtifacts {
+		mountPaths = append(mountPaths, mountPath)
+	}
+	sort.Strings(mountPaths)
+
+	reasons := []string{}
+	for _, mountPath := range mountPaths {
+		filesArtifactName := mountedFilesArtifacts[mountPath]
+		if filesArtifactChange, found := filesArtifactChanges[filesArtifactName]; found {
+			reasons = append(reasons, fmt.Sprintf("Files artifact '%s' mounted at '%s' %s", filesArtifactName, mountPath, filesArtifactChange))
+		}
+	}
+	if len(reasons) == 0 {
+		reasons = append(reasons, unmatchedInstructionReason)
+	}
+	return reasons
+}
@@ -0,0 +1,34 @@
+package startosis_engine
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
+)
+
+const (
+	isNotSkipped = false
+)
+
+// exportPlan turns a sequence of interpreted instructions into the plan describing what executing them would do,
+// along with the dependencies between them used to schedule their execution
+func exportPlan(instructionsSequence []*instructions_plan.ScheduledInstruction) *plan_export.Plan {
+	dependencyGraph := newInstructionsDependencyGraph(instructionsSequence)
+	planBuilder := plan_export.NewPlanBuilder()
+	for index, scheduledInstruction := range instructionsSequence {
+		instruction := scheduledInstruction.GetInstruction()
+		var returnedRuntimeValueUuids []string
+		if scheduledInstruction.GetReturnedValue() != nil {
+			returnedRuntimeValueUuids = magic_string_helper.GetRuntimeValueUuids(scheduledInstruction.GetReturnedValue().String())
+		}
+		planBuilder.AddInstruction(
+			instruction.GetCanonicalInstruction(isNotSkipped).GetInstructionName(),
+			instruction.GetPositionInOriginalScript().String(),
+			instruction.String(),
+			dependencyGraph.getPrerequisites(index),
+			returnedRuntimeValueUuids,
+		)
+		instruction.UpdatePlan(planBuilder)
+	}
+	return planBuilder.Build()
+}
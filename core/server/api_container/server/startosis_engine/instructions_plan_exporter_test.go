@@ -0,0 +1,95 @@
+package startosis_engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	planExportTestScript = `
+def run(plan):
+    db = plan.add_service(name = "db", config = ServiceConfig(
+        image = "postgres:15",
+        ports = {"postgres": PortSpec(number = 5432, application_protocol = "postgresql")},
+        env_vars = {"POSTGRES_PASSWORD": "secret"},
+    ))
+    app_config = plan.render_templates(name = "app-config", config = {
+        "config.json": struct(template = "{\"host\": \"{{.Host}}\"}", data = {"Host": db.ip_address}),
+    })
+    plan.add_service(name = "app", config = ServiceConfig(
+        image = "app:latest",
+        env_vars = {"DB_URL": "postgres://" + db.ip_address + ":5432"},
+        files = {"/config": app_config},
+    ))
+    plan.remove_service(name = "db")
+`
+)
+
+func TestExportPlan(t *testing.T) {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	defer packageContentProvider.RemoveAll()
+
+	starlarkEnv := Predeclared()
+	for _, typeConstructor := range KurtosisTypeConstructors() {
+		starlarkEnv[typeConstructor.Name()] = typeConstructor
+	}
+	serde := kurtosis_types.NewStarlarkValueSerde(newStarlarkThread("plan-export-test-serde"), starlarkEnv)
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(serde, getEnclaveDBForTest(t))
+	require.NoError(t, err)
+	serviceNetwork := service_network.NewFakeServiceNetwork(enclave.EnclaveUUID(mockEnclaveUuid), service_network.NewApiContainerInfo(nil, 0, ""))
+	interpreter := NewStartosisInterpreter(serviceNetwork, packageContentProvider, runtimeValueStore, serde, "")
+
+	_, instructionsPlan, interpretationError := interpreter.Interpret(context.Background(), startosis_constants.PackageIdPlaceholderForStandaloneScript, useDefaultMainFunctionName, startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript, planExportTestScript, startosis_constants.EmptyInputArgs, emptyEnclaveComponents, emptyInstructionsPlanMask)
+	require.Nil(t, interpretationError)
+	instructionsSequence, interpretationErr := instructionsPlan.GeneratePlan()
+	require.Nil(t, interpretationErr)
+
+	plan := exportPlan(instructionsSequence)
+
+	require.Len(t, plan.Instructions, 4)
+	require.Equal(t, "add_service", plan.Instructions[0].Type)
+	require.Equal(t, "render_templates", plan.Instructions[1].Type)
+	require.Contains(t, plan.Instructions[1].Code, "{{instructions[0].ip_address}}")
+	require.Equal(t, []int{0, 1}, plan.Instructions[2].DependsOn)
+	require.Contains(t, plan.Instructions[3].DependsOn, 0)
+
+	removedBy := 3
+	require.Equal(t, []*plan_export.Service{
+		{
+			Name:       "db",
+			Image:      "postgres:15",
+			Ports:      []*plan_export.Port{{Id: "postgres", Number: 5432, TransportProtocol: "TCP", ApplicationProtocol: "postgresql"}},
+			EnvVars:    []*plan_export.EnvVar{{Key: "POSTGRES_PASSWORD", Value: "secret"}},
+			Files:      nil,
+			Entrypoint: nil,
+			Cmd:        nil,
+			AddedBy:    0,
+			RemovedBy:  &removedBy,
+		},
+		{
+			Name:       "app",
+			Image:      "app:latest",
+			Ports:      []*plan_export.Port{},
+			EnvVars:    []*plan_export.EnvVar{{Key: "DB_URL", Value: "postgres://{{instructions[0].ip_address}}:5432"}},
+			Files:      []*plan_export.FilesMount{{MountPath: "/config", FilesArtifact: "app-config"}},
+			Entrypoint: nil,
+			Cmd:        nil,
+			AddedBy:    2,
+			RemovedBy:  nil,
+		},
+	}, plan.Services)
+	require.Equal(t, []*plan_export.FilesArtifact{
+		{Name: "app-config", Files: []string{"config.json"}, Service: "", CreatedBy: 1},
+	}, plan.FilesArtifacts)
+	require.Equal(t, []string{"app:latest", "postgres:15"}, plan.Images)
+	require.Empty(t, plan.Tasks)
+}
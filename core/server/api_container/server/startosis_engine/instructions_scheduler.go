@@ -0,0 +1,165 @@
+package startosis_engine
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instruction_metrics"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+)
+
+type instructionExecutionResult struct {
+	output *string
+
+	metrics *kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics
+
+	err error
+}
+
+// instructionsScheduler executes a sequence of instructions concurrently, starting each instruction as soon as all
+// the instructions it depends on are done executing, up to parallelism instructions at a time.
+// No instruction depending on a failed instruction is started.
+type instructionsScheduler struct {
+	ctx context.Context
+
+	instructionsSequence []*instructions_plan.ScheduledInstruction
+
+	// buffered channel of size parallelism, an instruction holding a slot while it executes
+	executionSlots chan struct{}
+
+	// dependents[i] are the indices of the instructions depending on instruction i
+	dependents [][]int
+
+	// closed once the result of the instruction at the same index is set
+	resultsReady []chan struct{}
+
+	executingInstructions *sync.WaitGroup
+
+	mutex *sync.Mutex
+
+	// Guarded by the mutex
+	numPendingPrerequisites []int
+	results                 []*instructionExecutionResult
+	isStopped               bool
+}
+
+func newInstructionsScheduler(ctx context.Context, parallelism int, instructionsSequence []*instructions_plan.ScheduledInstruction) *instructionsScheduler {
+	dependencyGraph := newInstructionsDependencyGraph(instructionsSequence)
+	dependents := make([][]int, len(instructionsSequence))
+	numPendingPrerequisites := make([]int, len(instructionsSequence))
+	resultsReady := make([]chan struct{}, len(instructionsSequence))
+	for index := range instructionsSequence {
+		prerequisites := dependencyGraph.getPrerequisites(index)
+		numPendingPrerequisites[index] = len(prerequisites)
+		for _, prerequisite := range prerequisites {
+			dependents[prerequisite] = append(dependents[prerequisite], index)
+		}
+		resultsReady[index] = make(chan struct{})
+	}
+	return &instructionsScheduler{
+		ctx:                     ctx,
+		instructionsSequence:    instructionsSequence,
+		executionSlots:          make(chan struct{}, parallelism),
+		dependents:              dependents,
+		resultsReady:            resultsReady,
+		executingInstructions:   &sync.WaitGroup{},
+		mutex:                   &sync.Mutex{},
+		numPendingPrerequisites: numPendingPrerequisites,
+		results:                 make([]*instructionExecutionResult, len(instructionsSequence)),
+		isStopped:               false,
+	}
+}
+
+// start starts executing the instructions not depending on any other instruction. It does not block
+func (scheduler *instructionsScheduler) start() {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	for index, numPendingPrerequisites := range scheduler.numPendingPrerequisites {
+		if numPendingPrerequisites == 0 {
+			scheduler.startInstruction(index)
+		}
+	}
+}
+
+// waitForResult blocks until the instruction at the given index is done executing. It must not be called for an
+// instruction depending on a failed instruction, as such instructions are never executed
+func (scheduler *instructionsScheduler) waitForResult(index int) *instructionExecutionResult {
+	<-scheduler.resultsReady[index]
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	return scheduler.results[index]
+}
+
+// getResultIfDone returns the result of the instruction at the given index, or nil if it wasn't executed or is still
+// executing
+func (scheduler *instructionsScheduler) getResultIfDone(index int) *instructionExecutionResult {
+	scheduler.mutex.Lock()
+	defer scheduler.mutex.Unlock()
+	return scheduler.results[index]
+}
+
+// stopAndWait prevents any new instruction from being started, and waits for the ones executing to be done
+func (scheduler *instructionsScheduler) stopAndWait() {
+	scheduler.mutex.Lock()
+	scheduler.isStopped = true
+	scheduler.mutex.Unlock()
+	scheduler.executingInstructions.Wait()
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+
+// startInstruction must be called with the mutex locked
+func (scheduler *instructionsScheduler) startInstruction(index int) {
+	scheduler.executingInstructions.Add(1)
+	go func() {
+		defer scheduler.executingInstructions.Done()
+		scheduler.executionSlots <- struct{}{}
+		scheduler.mutex.Lock()
+		isStopped := scheduler.isStopped
+		scheduler.mutex.Unlock()
+		if isStopped {
+			<-scheduler.executionSlots
+			return
+		}
+
+		result := executeScheduledInstruction(scheduler.ctx, scheduler.instructionsSequence[index])
+		<-scheduler.executionSlots
+
+		scheduler.mutex.Lock()
+		defer scheduler.mutex.Unlock()
+		scheduler.results[index] = result
+		close(scheduler.resultsReady[index])
+		if result.err != nil {
+			return
+		}
+		for _, dependent := range scheduler.dependents[index] {
+			scheduler.numPendingPrerequisites[dependent] -= 1
+			if scheduler.numPendingPrerequisites[dependent] == 0 && !scheduler.isStopped {
+				scheduler.startInstruction(dependent)
+			}
+		}
+	}()
+}
+
+func executeScheduledInstruction(ctx context.Context, scheduledInstruction *instructions_plan.ScheduledInstruction) *instructionExecutionResult {
+	var err error
+	var instructionOutput *string
+	metrics := instruction_metrics.NewInstructionMetrics(time.Now(), scheduledInstruction.GetImagePullDuration())
+	if scheduledInstruction.IsExecuted() {
+		// instruction already executed within this enclave. Do not run it
+		instructionOutput = &skippedInstructionOutput
+	} else {
+		instructionOutput, err = scheduledInstruction.GetInstruction().Execute(instruction_metrics.WithInstructionMetrics(ctx, metrics))
+	}
+	return &instructionExecutionResult{
+		output:  instructionOutput,
+		metrics: metrics.ToAPIType(time.Now()),
+		err:     err,
+	}
+}
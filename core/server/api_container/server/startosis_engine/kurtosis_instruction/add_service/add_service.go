@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
@@ -66,6 +68,7 @@ func NewAddService(serviceNetwork service_network.ServiceNetwork, runtimeValueSt
 
 				resultUuid:     "",  // populated at interpretation time
 				readyCondition: nil, // populated at interpretation time
+				healthConfig:   nil, // populated at interpretation time
 			}
 		},
 
@@ -84,6 +87,9 @@ type AddServiceCapabilities struct {
 	serviceConfig  *service.ServiceConfig
 	readyCondition *service_config.ReadyCondition
 
+	// nil if the health of the service is not monitored
+	healthConfig *service_health.HealthConfig
+
 	resultUuid string
 }
 
@@ -105,6 +111,10 @@ func (builtin *AddServiceCapabilities) Interpret(_ string, arguments *builtin_ar
 	builtin.serviceName = service.ServiceName(serviceName.GoString())
 	builtin.serviceConfig = apiServiceConfig
 	builtin.readyCondition = readyCondition
+	builtin.healthConfig, interpretationErr = getServiceHealthConfig(builtin.serviceNetwork, builtin.runtimeValueStore, serviceConfig)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
 	builtin.resultUuid, err = builtin.runtimeValueStore.GetOrCreateValueAssociatedWithService(builtin.serviceName)
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to create runtime value to hold '%v' command return values", AddServiceBuiltinName)
@@ -152,6 +162,9 @@ func (builtin *AddServiceCapabilities) Execute(ctx context.Context, _ *builtin_a
 	); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while checking if service '%v' is ready", replacedServiceName)
 	}
+	if err := builtin.serviceNetwork.MonitorServiceHealth(replacedServiceName, builtin.healthConfig); err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred monitoring the health of service '%v'", replacedServiceName)
+	}
 
 	if err := fillAddServiceReturnValueWithRuntimeValues(startedService, builtin.resultUuid, builtin.runtimeValueStore); err != nil {
 		return "", stacktrace.Propagate(err, "An error occurred while adding service return values with result key UUID '%s'", builtin.resultUuid)
@@ -209,6 +222,10 @@ func (builtin *AddServiceCapabilities) FillPersistableAttributes(builder *enclav
 	)
 }
 
+func (builtin *AddServiceCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	planBuilder.AddService(builtin.serviceName, builtin.serviceConfig)
+}
+
 func validateAndConvertConfigAndReadyCondition(
 	serviceNetwork service_network.ServiceNetwork,
 	rawConfig starlark.Value,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -22,6 +23,9 @@ import (
 const (
 	ipAddressRuntimeValue = "ip_address"
 	hostnameRuntimeValue  = "hostname"
+
+	defaultLivenessInterval = 10 * time.Second
+	defaultLivenessTimeout  = 30 * time.Second
 )
 
 func fillAddServiceReturnValueWithRuntimeValues(service *service.Service, resultUuid string, runtimeValueStore *runtime_value_store.RuntimeValueStore) error {
@@ -80,6 +84,29 @@ func validateSingleService(validatorEnvironment *startosis_validator.ValidatorEn
 			}
 		}
 	}
+	if serviceConfig.GetPersistentDirectories() != nil {
+		for _, persistentDirectory := range serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory {
+			if persistentDirectory.SeedFilesArtifactExpansion == nil {
+				continue
+			}
+			for _, artifactName := range persistentDirectory.SeedFilesArtifactExpansion.ServiceDirpathsToArtifactIdentifiers {
+				if validatorEnvironment.DoesArtifactNameExist(artifactName) == startosis_validator.ComponentNotFound {
+					return startosis_errors.NewValidationError("There was an error validating '%s' as artifact name '%s' seeding persistent directory '%s' does not exist", AddServiceBuiltinName, artifactName, persistentDirectory.PersistentKey)
+				}
+			}
+		}
+	}
+
+	// The pod of a service affine to a service that doesn't exist would never get scheduled
+	for _, affinityServiceName := range serviceConfig.GetAffinityServiceNames() {
+		if validatorEnvironment.DoesServiceNameExist(affinityServiceName) == startosis_validator.ComponentNotFound {
+			return startosis_errors.NewValidationError("There was an error validating '%s' as service '%s' is in the affinity of service '%s' but does not exist", AddServiceBuiltinName, affinityServiceName, serviceName)
+		}
+	}
+
+	if validationErr := validatorEnvironment.CanSetUlimits(serviceConfig.GetUlimits(), serviceName); validationErr != nil {
+		return validationErr
+	}
 
 	if validationErr := validatorEnvironment.HasEnoughCPU(serviceConfig.GetMinCPUAllocationMillicpus(), serviceName); validationErr != nil {
 		return validationErr
@@ -179,6 +206,17 @@ func replaceMagicStrings(
 		serviceConfig.GetPrivateIPAddrPlaceholder(),
 		serviceConfig.GetMinCPUAllocationMillicpus(),
 		serviceConfig.GetMinMemoryAllocationMegabytes(),
+		serviceConfig.GetUser(),
+		serviceConfig.GetLabels(),
+		serviceConfig.GetCapabilities(),
+		serviceConfig.GetSysctls(),
+		serviceConfig.GetUlimits(),
+		serviceConfig.GetTmpfsDirs(),
+		serviceConfig.GetShmSizeMegabytes(),
+		serviceConfig.GetNodeSelectors(),
+		serviceConfig.GetTolerations(),
+		serviceConfig.GetAffinityServiceNames(),
+		serviceConfig.GetAntiAffinityServiceNames(),
 	)
 	return service.ServiceName(serviceNameStr), renderedServiceConfig, nil
 }
@@ -261,3 +299,105 @@ func runServiceReadinessCheck(
 	}
 	return nil
 }
+
+// getServiceHealthConfig returns how the health of the service should be monitored once it is ready, or nil if the
+// service has neither a liveness check nor a restart policy
+func getServiceHealthConfig(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	config *service_config.ServiceConfig,
+) (*service_health.HealthConfig, *startosis_errors.InterpretationError) {
+	liveness, interpretationErr := config.GetLiveness()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	restartPolicy, maxRestarts, interpretationErr := config.GetRestartPolicy()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if liveness == nil {
+		if restartPolicy == service_health.RestartPolicy_Never {
+			return nil, nil
+		}
+		return service_health.NewHealthConfig(nil, "", service_health.DefaultCheckInterval, restartPolicy, maxRestarts), nil
+	}
+
+	livenessCheck, interval, interpretationErr := getLivenessCheck(serviceNetwork, runtimeValueStore, liveness)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return service_health.NewHealthConfig(livenessCheck, liveness.String(), interval, restartPolicy, maxRestarts), nil
+}
+
+// NewLivenessCheckBuilder returns what builds the liveness checks again from the liveness conditions persisted along
+// with the health configs of the services
+func NewLivenessCheckBuilder(
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+) service_network.LivenessCheckBuilder {
+	return func(serviceNetwork service_network.ServiceNetwork, serializedLivenessCheck string) (service_health.LivenessCheck, error) {
+		deserializedLiveness, interpretationErr := starlarkValueSerde.Deserialize(serializedLivenessCheck)
+		if interpretationErr != nil {
+			return nil, stacktrace.Propagate(interpretationErr, "An error occurred deserializing liveness condition '%s'", serializedLivenessCheck)
+		}
+		liveness, ok := deserializedLiveness.(*service_config.ReadyCondition)
+		if !ok {
+			return nil, stacktrace.NewError("Expected '%s' to be a '%s' but it's a '%s'", serializedLivenessCheck, service_config.ReadyConditionTypeName, deserializedLiveness.Type())
+		}
+		livenessCheck, _, interpretationErr := getLivenessCheck(serviceNetwork, runtimeValueStore, liveness)
+		if interpretationErr != nil {
+			return nil, stacktrace.Propagate(interpretationErr, "An error occurred building the liveness check from liveness condition '%s'", serializedLivenessCheck)
+		}
+		return livenessCheck, nil
+	}
+}
+
+// getLivenessCheck returns the liveness check for the liveness condition along with how often it should run
+func getLivenessCheck(
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	liveness *service_config.ReadyCondition,
+) (service_health.LivenessCheck, time.Duration, *startosis_errors.InterpretationError) {
+	recipe, interpretationErr := liveness.GetRecipe()
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+	field, interpretationErr := liveness.GetField()
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+	assertion, interpretationErr := liveness.GetAssertion()
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+	target, interpretationErr := liveness.GetTarget()
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+	interval, interpretationErr := liveness.GetIntervalOrDefault(defaultLivenessInterval)
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+	timeout, interpretationErr := liveness.GetTimeoutOrDefault(defaultLivenessTimeout)
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+
+	// The service is live as long as the condition is satisfied at least once within the timeout
+	livenessCheck := func(ctx context.Context, serviceName service.ServiceName) error {
+		_, _, err := shared_helpers.ExecuteServiceAssertionWithRecipe(
+			ctx,
+			serviceNetwork,
+			runtimeValueStore,
+			serviceName,
+			recipe,
+			field,
+			assertion,
+			target,
+			interval,
+			timeout,
+		)
+		return err
+	}
+	return livenessCheck, interval, nil
+}
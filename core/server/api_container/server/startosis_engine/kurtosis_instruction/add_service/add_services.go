@@ -5,12 +5,14 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -19,6 +21,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
 	"reflect"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -42,7 +45,7 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
 						// we just try to convert the configs here to validate their shape, to avoid code duplication
 						// with Interpret
-						if _, _, err := validateAndConvertConfigsAndReadyConditions(serviceNetwork, value); err != nil {
+						if _, _, _, err := validateAndConvertConfigsAndReadyConditions(serviceNetwork, runtimeValueStore, value); err != nil {
 							return err
 						}
 						return nil
@@ -60,6 +63,7 @@ func NewAddServices(serviceNetwork service_network.ServiceNetwork, runtimeValueS
 
 				resultUuids:     map[service.ServiceName]string{}, // populated at interpretation time
 				readyConditions: nil,                              // populated at interpretation time
+				healthConfigs:   nil,                              // populated at interpretation time
 			}
 		},
 
@@ -79,6 +83,9 @@ type AddServicesCapabilities struct {
 
 	readyConditions map[service.ServiceName]*service_config.ReadyCondition
 
+	// services whose health is not monitored map to nil
+	healthConfigs map[service.ServiceName]*service_health.HealthConfig
+
 	resultUuids map[service.ServiceName]string
 }
 
@@ -87,12 +94,13 @@ func (builtin *AddServicesCapabilities) Interpret(_ string, arguments *builtin_a
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConfigsArgName)
 	}
-	serviceConfigs, readyConditions, interpretationErr := validateAndConvertConfigsAndReadyConditions(builtin.serviceNetwork, ServiceConfigsDict)
+	serviceConfigs, readyConditions, healthConfigs, interpretationErr := validateAndConvertConfigsAndReadyConditions(builtin.serviceNetwork, builtin.runtimeValueStore, ServiceConfigsDict)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 	builtin.serviceConfigs = serviceConfigs
 	builtin.readyConditions = readyConditions
+	builtin.healthConfigs = healthConfigs
 
 	resultUuids, returnValue, interpretationErr := makeAddServicesInterpretationReturnValue(builtin.serviceConfigs, builtin.runtimeValueStore)
 	if interpretationErr != nil {
@@ -200,6 +208,11 @@ func (builtin *AddServicesCapabilities) Execute(ctx context.Context, _ *builtin_
 		}
 		instructionResult.WriteString(fmt.Sprintf("\n  Service '%s' added with UUID '%s'", serviceName, serviceObj.GetRegistration().GetUUID()))
 	}
+	for serviceName := range startedAndUpdatedService {
+		if err := builtin.serviceNetwork.MonitorServiceHealth(serviceName, builtin.healthConfigs[serviceName]); err != nil {
+			return "", stacktrace.Propagate(err, "An error occurred monitoring the health of service '%v'", serviceName)
+		}
+	}
 	shouldDeleteAllStartedServices = false
 	return instructionResult.String(), nil
 }
@@ -283,6 +296,17 @@ func (builtin *AddServicesCapabilities) FillPersistableAttributes(builder *encla
 	}
 }
 
+func (builtin *AddServicesCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	serviceNames := []string{}
+	for serviceName := range builtin.serviceConfigs {
+		serviceNames = append(serviceNames, string(serviceName))
+	}
+	sort.Strings(serviceNames)
+	for _, serviceName := range serviceNames {
+		planBuilder.AddService(service.ServiceName(serviceName), builtin.serviceConfigs[service.ServiceName(serviceName)])
+	}
+}
+
 func (builtin *AddServicesCapabilities) removeAllStartedServices(
 	ctx context.Context,
 	startedServices map[service.ServiceName]*service.Service,
@@ -368,49 +392,58 @@ func (builtin *AddServicesCapabilities) runServiceReadinessCheck(
 
 func validateAndConvertConfigsAndReadyConditions(
 	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
 	configs starlark.Value,
 ) (
 	map[service.ServiceName]*service.ServiceConfig,
 	map[service.ServiceName]*service_config.ReadyCondition,
+	map[service.ServiceName]*service_health.HealthConfig,
 	*startosis_errors.InterpretationError,
 ) {
 	configsDict, ok := configs.(*starlark.Dict)
 	if !ok {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
+		return nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a dictionary of matching each service name to their respective ServiceConfig object. Got '%s'", ConfigsArgName, reflect.TypeOf(configs))
 	}
 	if configsDict.Len() == 0 {
-		return nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
+		return nil, nil, nil, startosis_errors.NewInterpretationError("The '%s' argument should be a non empty dictionary", ConfigsArgName)
 	}
 	convertedServiceConfigs := map[service.ServiceName]*service.ServiceConfig{}
 	readyConditionsByServiceName := map[service.ServiceName]*service_config.ReadyCondition{}
+	healthConfigsByServiceName := map[service.ServiceName]*service_health.HealthConfig{}
 	for _, serviceName := range configsDict.Keys() {
 		serviceNameStr, isServiceNameAString := serviceName.(starlark.String)
 		if !isServiceNameAString {
-			return nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
+			return nil, nil, nil, startosis_errors.NewInterpretationError("One key of the '%s' dictionary is not a string (was '%s'). Keys of this argument should correspond to service names, which should be strings", ConfigsArgName, reflect.TypeOf(serviceName))
 		}
 
 		dictValue, found, err := configsDict.Get(serviceName)
 		if err != nil || !found {
-			return nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Could not extract the value of the '%s' dictionary for key '%s'. This is Kurtosis bug", ConfigsArgName, serviceName)
 		}
 		serviceConfig, isDictValueAServiceConfig := dictValue.(*service_config.ServiceConfig)
 		if !isDictValueAServiceConfig {
-			return nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
+			return nil, nil, nil, startosis_errors.NewInterpretationError("One value of the '%s' dictionary is not a ServiceConfig (was '%s'). Values of this argument should correspond to the config of the service to be added", ConfigsArgName, reflect.TypeOf(dictValue))
 		}
 		apiServiceConfig, interpretationErr := serviceConfig.ToKurtosisType(serviceNetwork)
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		convertedServiceConfigs[service.ServiceName(serviceNameStr.GoString())] = apiServiceConfig
 
 		readyConditions, interpretationErr := serviceConfig.GetReadyCondition()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 
 		readyConditionsByServiceName[service.ServiceName(serviceNameStr.GoString())] = readyConditions
+
+		healthConfig, interpretationErr := getServiceHealthConfig(serviceNetwork, runtimeValueStore, serviceConfig)
+		if interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
+		}
+		healthConfigsByServiceName[service.ServiceName(serviceNameStr.GoString())] = healthConfig
 	}
-	return convertedServiceConfigs, readyConditionsByServiceName, nil
+	return convertedServiceConfigs, readyConditionsByServiceName, healthConfigsByServiceName, nil
 }
 
 func makeAddServicesInterpretationReturnValue(serviceConfigs map[service.ServiceName]*service.ServiceConfig, runtimeValueStore *runtime_value_store.RuntimeValueStore) (map[service.ServiceName]string, *starlark.Dict, *startosis_errors.InterpretationError) {
@@ -9,6 +9,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
@@ -155,6 +156,10 @@ func (builtin *AssertCapabilities) FillPersistableAttributes(builder *enclave_pl
 	builder.SetType(AssertBuiltinName)
 }
 
+func (builtin *AssertCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	// assert only checks values
+}
+
 // Assert verifies whether the currentValue matches the targetValue w.r.t. the assertion operator
 // TODO: This and ValidateAssertionToken below are used by both assert and wait. Refactor it to a better place
 func Assert(currentValue starlark.Comparable, assertion string, targetValue starlark.Comparable) error {
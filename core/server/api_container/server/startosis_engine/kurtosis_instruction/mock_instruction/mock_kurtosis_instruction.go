@@ -14,6 +14,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	plan_export "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
+
 	startosis_validator "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 )
 
@@ -298,6 +300,39 @@ func (_c *MockKurtosisInstruction_TryResolveWith_Call) RunAndReturn(run func(*en
 	return _c
 }
 
+// UpdatePlan provides a mock function with given fields: planBuilder
+func (_m *MockKurtosisInstruction) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	_m.Called(planBuilder)
+}
+
+// MockKurtosisInstruction_UpdatePlan_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePlan'
+type MockKurtosisInstruction_UpdatePlan_Call struct {
+	*mock.Call
+}
+
+// UpdatePlan is a helper method to define mock.On call
+//   - planBuilder *plan_export.PlanBuilder
+func (_e *MockKurtosisInstruction_Expecter) UpdatePlan(planBuilder interface{}) *MockKurtosisInstruction_UpdatePlan_Call {
+	return &MockKurtosisInstruction_UpdatePlan_Call{Call: _e.mock.On("UpdatePlan", planBuilder)}
+}
+
+func (_c *MockKurtosisInstruction_UpdatePlan_Call) Run(run func(planBuilder *plan_export.PlanBuilder)) *MockKurtosisInstruction_UpdatePlan_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(*plan_export.PlanBuilder))
+	})
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_UpdatePlan_Call) Return() *MockKurtosisInstruction_UpdatePlan_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockKurtosisInstruction_UpdatePlan_Call) RunAndReturn(run func(*plan_export.PlanBuilder)) *MockKurtosisInstruction_UpdatePlan_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ValidateAndUpdateEnvironment provides a mock function with given fields: environment
 func (_m *MockKurtosisInstruction) ValidateAndUpdateEnvironment(environment *startosis_validator.ValidatorEnvironment) error {
 	ret := _m.Called(environment)
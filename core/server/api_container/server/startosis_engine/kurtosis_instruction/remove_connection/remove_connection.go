@@ -0,0 +1,105 @@
+package remove_connection
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	RemoveConnectionBuiltinName = "remove_connection"
+
+	SubnetworksArgName = "subnetworks"
+)
+
+func NewRemoveConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RemoveConnectionBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              SubnetworksArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Tuple],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, _, interpretationErr := shared_helpers.ParseSubnetworks(SubnetworksArgName, value)
+						return interpretationErr
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &RemoveConnectionCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				subnetwork1: "", // populated at interpretation time
+				subnetwork2: "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			SubnetworksArgName: true,
+		},
+	}
+}
+
+type RemoveConnectionCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	subnetwork1 partition_topology.PartitionID
+	subnetwork2 partition_topology.PartitionID
+}
+
+func (builtin *RemoveConnectionCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	subnetworks, err := builtin_argument.ExtractArgumentValue[starlark.Tuple](arguments, SubnetworksArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SubnetworksArgName)
+	}
+	subnetwork1, subnetwork2, interpretationErr := shared_helpers.ParseSubnetworks(SubnetworksArgName, subnetworks)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	builtin.subnetwork1 = partition_topology.PartitionID(subnetwork1)
+	builtin.subnetwork2 = partition_topology.PartitionID(subnetwork2)
+	return starlark.None, nil
+}
+
+func (builtin *RemoveConnectionCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return nil
+}
+
+func (builtin *RemoveConnectionCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.UnsetConnection(ctx, builtin.subnetwork1, builtin.subnetwork2); err != nil {
+		return "", stacktrace.Propagate(err, "Failed removing the connection between subnetworks '%s' and '%s'", builtin.subnetwork1, builtin.subnetwork2)
+	}
+	return fmt.Sprintf("Removed subnetwork connection override between '%s' and '%s'", builtin.subnetwork1, builtin.subnetwork2), nil
+}
+
+func (builtin *RemoveConnectionCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *RemoveConnectionCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(RemoveConnectionBuiltinName)
+}
+
+func (builtin *RemoveConnectionCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	// connections between subnetworks aren't part of the plan
+}
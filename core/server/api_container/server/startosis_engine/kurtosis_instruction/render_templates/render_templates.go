@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
@@ -20,6 +21,7 @@ import (
 	"go.starlark.net/starlark"
 	"go.starlark.net/starlarkstruct"
 	"reflect"
+	"sort"
 )
 
 const (
@@ -168,6 +170,15 @@ func (builtin *RenderTemplatesCapabilities) FillPersistableAttributes(builder *e
 	)
 }
 
+func (builtin *RenderTemplatesCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	destRelFilepaths := []string{}
+	for destRelFilepath := range builtin.templatesAndDataByDestRelFilepath {
+		destRelFilepaths = append(destRelFilepaths, destRelFilepath)
+	}
+	sort.Strings(destRelFilepaths)
+	planBuilder.AddFilesArtifact(builtin.artifactName, destRelFilepaths, "")
+}
+
 func parseTemplatesAndData(templatesAndData *starlark.Dict) (map[string]*render_templates.TemplateData, *startosis_errors.InterpretationError) {
 	templateAndDataByDestRelFilepath := make(map[string]*render_templates.TemplateData)
 	for _, relPathInFilesArtifactKey := range templatesAndData.Keys() {
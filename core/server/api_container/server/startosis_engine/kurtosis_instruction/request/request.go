@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -17,8 +18,12 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"go.starlark.net/starlark"
 	"net/http"
+	"reflect"
 )
 
+// Probe recipes always return a zero code when they succeed
+var defaultProbeAcceptableCodes = []int64{0}
+
 var defaultAcceptableCodes = []int64{
 	http.StatusOK,
 	http.StatusCreated,
@@ -62,8 +67,8 @@ func NewRequest(serviceNetwork service_network.ServiceNetwork, runtimeValueStore
 				{
 					Name:              RecipeArgName,
 					IsOptional:        false,
-					ZeroValueProvider: builtin_argument.ZeroValueProvider[recipe.HttpRequestRecipe],
-					Validator:         nil,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateRecipe,
 				},
 				{
 					Name:              AcceptableCodesArgName,
@@ -85,11 +90,11 @@ func NewRequest(serviceNetwork service_network.ServiceNetwork, runtimeValueStore
 				serviceNetwork:    serviceNetwork,
 				runtimeValueStore: runtimeValueStore,
 
-				serviceName:       "",    // populated at interpretation time
-				httpRequestRecipe: nil,   // populated at interpretation time
-				resultUuid:        "",    // populated at interpretation time
-				acceptableCodes:   nil,   // populated at interpretation time
-				skipCodeCheck:     false, // populated at interpretation time
+				serviceName:     "",    // populated at interpretation time
+				recipe:          nil,   // populated at interpretation time
+				resultUuid:      "",    // populated at interpretation time
+				acceptableCodes: nil,   // populated at interpretation time
+				skipCodeCheck:   false, // populated at interpretation time
 			}
 		},
 
@@ -103,11 +108,11 @@ type RequestCapabilities struct {
 	serviceNetwork    service_network.ServiceNetwork
 	runtimeValueStore *runtime_value_store.RuntimeValueStore
 
-	serviceName       service.ServiceName
-	httpRequestRecipe recipe.HttpRequestRecipe
-	resultUuid        string
-	acceptableCodes   []int64
-	skipCodeCheck     bool
+	serviceName     service.ServiceName
+	recipe          recipe.Recipe
+	resultUuid      string
+	acceptableCodes []int64
+	skipCodeCheck   bool
 }
 
 func (builtin *RequestCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
@@ -118,12 +123,19 @@ func (builtin *RequestCapabilities) Interpret(_ string, arguments *builtin_argum
 	}
 	serviceName := service.ServiceName(serviceNameArgumentValue.GoString())
 
-	httpRequestRecipe, err := builtin_argument.ExtractArgumentValue[recipe.HttpRequestRecipe](arguments, RecipeArgName)
-	if err != nil {
-		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RecipeArgName)
+	var requestRecipe recipe.Recipe
+	acceptableCodes := defaultAcceptableCodes
+	if probeRecipe, err := builtin_argument.ExtractArgumentValue[recipe.ProbeRecipe](arguments, RecipeArgName); err == nil {
+		requestRecipe = probeRecipe
+		acceptableCodes = defaultProbeAcceptableCodes
+	} else {
+		httpRequestRecipe, err := builtin_argument.ExtractArgumentValue[recipe.HttpRequestRecipe](arguments, RecipeArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RecipeArgName)
+		}
+		requestRecipe = httpRequestRecipe
 	}
 
-	acceptableCodes := defaultAcceptableCodes
 	if arguments.IsSet(AcceptableCodesArgName) {
 		acceptableCodesValue, err := builtin_argument.ExtractArgumentValue[*starlark.List](arguments, AcceptableCodesArgName)
 		if err != nil {
@@ -150,12 +162,12 @@ func (builtin *RequestCapabilities) Interpret(_ string, arguments *builtin_argum
 	}
 
 	builtin.serviceName = serviceName
-	builtin.httpRequestRecipe = httpRequestRecipe
+	builtin.recipe = requestRecipe
 	builtin.resultUuid = resultUuid
 	builtin.acceptableCodes = acceptableCodes
 	builtin.skipCodeCheck = skipCodeCheck
 
-	returnValue, interpretationErr := builtin.httpRequestRecipe.CreateStarlarkReturnValue(builtin.resultUuid)
+	returnValue, interpretationErr := builtin.recipe.CreateStarlarkReturnValue(builtin.resultUuid)
 	if interpretationErr != nil {
 		return nil, startosis_errors.NewInterpretationError("An error occurred while creating return value for %v instruction", RequestBuiltinName)
 	}
@@ -166,16 +178,23 @@ func (builtin *RequestCapabilities) Validate(_ *builtin_argument.ArgumentValuesS
 	if validatorEnvironment.DoesServiceNameExist(builtin.serviceName) == startosis_validator.ComponentNotFound {
 		return startosis_errors.NewValidationError("Tried creating a request for service '%s' which doesn't exist", builtin.serviceName)
 	}
-	if validationErr := recipe.ValidateHttpRequestRecipe(builtin.httpRequestRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
-		return validationErr
+	switch typedRecipe := builtin.recipe.(type) {
+	case recipe.HttpRequestRecipe:
+		if validationErr := recipe.ValidateHttpRequestRecipe(typedRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
+			return validationErr
+		}
+	case recipe.ProbeRecipe:
+		if validationErr := recipe.ValidateProbeRecipe(typedRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
+			return validationErr
+		}
 	}
 	return nil
 }
 
 func (builtin *RequestCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
-	result, err := builtin.httpRequestRecipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName)
+	result, err := builtin.recipe.Execute(ctx, builtin.serviceNetwork, builtin.runtimeValueStore, builtin.serviceName)
 	if err != nil {
-		return "", stacktrace.Propagate(err, "Error executing http recipe")
+		return "", stacktrace.Propagate(err, "Error executing request recipe")
 	}
 	if !builtin.skipCodeCheck && !builtin.isAcceptableCode(result) {
 		return "", stacktrace.NewError("Request returned status code '%v' that is not part of the acceptable status codes '%v'", result["code"], builtin.acceptableCodes)
@@ -184,7 +203,7 @@ func (builtin *RequestCapabilities) Execute(ctx context.Context, _ *builtin_argu
 		return "", stacktrace.Propagate(err, "An error occurred setting value '%+v' using key UUID '%s' in the runtime value store", result, builtin.resultUuid)
 	}
 
-	instructionResult := builtin.httpRequestRecipe.ResultMapToString(result)
+	instructionResult := builtin.recipe.ResultMapToString(result)
 	return instructionResult, err
 }
 
@@ -198,7 +217,15 @@ func (builtin *RequestCapabilities) TryResolveWith(instructionsAreEqual bool, _
 }
 
 func (builtin *RequestCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
-	builder.SetType(RequestBuiltinName)
+	builder.SetType(
+		RequestBuiltinName,
+	).AddTargetedServiceName(
+		builtin.serviceName,
+	)
+}
+
+func (builtin *RequestCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	// the request only reads from the service it targets
 }
 
 func (builtin *RequestCapabilities) isAcceptableCode(recipeResult map[string]starlark.Comparable) bool {
@@ -211,3 +238,12 @@ func (builtin *RequestCapabilities) isAcceptableCode(recipeResult map[string]sta
 	}
 	return isAcceptableCode
 }
+
+func validateRecipe(value starlark.Value) *startosis_errors.InterpretationError {
+	_, isHttpRequestRecipe := value.(recipe.HttpRequestRecipe)
+	_, isProbeRecipe := value.(recipe.ProbeRecipe)
+	if !isHttpRequestRecipe && !isProbeRecipe {
+		return startosis_errors.NewInterpretationError("The '%s' argument must be an HTTP request recipe or a gRPC, TCP or UDP probe recipe (was '%s').", RecipeArgName, reflect.TypeOf(value))
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+package set_connection
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	SetConnectionBuiltinName = "set_connection"
+
+	ConnectionConfigArgName = "config"
+	SubnetworksArgName      = "subnetworks"
+)
+
+func NewSetConnection(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: SetConnectionBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ConnectionConfigArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*connection_config.ConnectionConfig],
+					Validator:         nil,
+				},
+				{
+					Name:              SubnetworksArgName,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Tuple],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, _, interpretationErr := shared_helpers.ParseSubnetworks(SubnetworksArgName, value)
+						return interpretationErr
+					},
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &SetConnectionCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				subnetwork1: "", // populated at interpretation time
+				subnetwork2: "", // populated at interpretation time
+				connection:  partition_topology.ConnectionAllowed,
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ConnectionConfigArgName: true,
+			SubnetworksArgName:      true,
+		},
+	}
+}
+
+type SetConnectionCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	// both are empty when the default connection is being set
+	subnetwork1 partition_topology.PartitionID
+	subnetwork2 partition_topology.PartitionID
+
+	connection partition_topology.PartitionConnection
+}
+
+func (builtin *SetConnectionCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	connectionConfig, err := builtin_argument.ExtractArgumentValue[*connection_config.ConnectionConfig](arguments, ConnectionConfigArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ConnectionConfigArgName)
+	}
+	connection, interpretationErr := connectionConfig.ToKurtosisType()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	builtin.connection = connection
+
+	if arguments.IsSet(SubnetworksArgName) {
+		subnetworks, err := builtin_argument.ExtractArgumentValue[starlark.Tuple](arguments, SubnetworksArgName)
+		if err != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", SubnetworksArgName)
+		}
+		subnetwork1, subnetwork2, interpretationErr := shared_helpers.ParseSubnetworks(SubnetworksArgName, subnetworks)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		builtin.subnetwork1 = partition_topology.PartitionID(subnetwork1)
+		builtin.subnetwork2 = partition_topology.PartitionID(subnetwork2)
+	}
+	return starlark.None, nil
+}
+
+func (builtin *SetConnectionCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, _ *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	return nil
+}
+
+func (builtin *SetConnectionCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if builtin.subnetwork1 == "" && builtin.subnetwork2 == "" {
+		if err := builtin.serviceNetwork.SetDefaultConnection(ctx, builtin.connection); err != nil {
+			return "", stacktrace.Propagate(err, "Failed setting the default connection")
+		}
+		return "Configured default subnetwork connection", nil
+	}
+	if err := builtin.serviceNetwork.SetConnection(ctx, builtin.subnetwork1, builtin.subnetwork2, builtin.connection); err != nil {
+		return "", stacktrace.Propagate(err, "Failed setting the connection between subnetworks '%s' and '%s'", builtin.subnetwork1, builtin.subnetwork2)
+	}
+	return fmt.Sprintf("Configured subnetwork connection between '%s' and '%s'", builtin.subnetwork1, builtin.subnetwork2), nil
+}
+
+func (builtin *SetConnectionCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *SetConnectionCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(SetConnectionBuiltinName)
+}
+
+func (builtin *SetConnectionCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	// connections between subnetworks aren't part of the plan
+}
@@ -64,6 +64,27 @@ func GetOrReplaceRuntimeValueFromString(originalString string, runtimeValueStore
 	}
 }
 
+// GetRuntimeValueUuids returns the UUIDs of the runtime values referenced in the string, without resolving them
+func GetRuntimeValueUuids(originalString string) []string {
+	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
+	runtimeValueUuids := []string{}
+	for _, match := range compiledRuntimeValueReplacementRegex.FindAllStringSubmatch(originalString, unlimitedMatches) {
+		runtimeValueUuids = append(runtimeValueUuids, match[runtimeValueMatchIndex])
+	}
+	return runtimeValueUuids
+}
+
+// ReplaceRuntimeValueReferencesInString replaces each runtime value referenced in the string with what the function
+// returns for its UUID and field, without resolving them
+func ReplaceRuntimeValueReferencesInString(originalString string, replaceFunc func(runtimeValueUuid string, runtimeValueField string) string) string {
+	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
+	runtimeValueFieldMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueFieldSubgroupName)
+	return compiledRuntimeValueReplacementRegex.ReplaceAllStringFunc(originalString, func(reference string) string {
+		match := compiledRuntimeValueReplacementRegex.FindStringSubmatch(reference)
+		return replaceFunc(match[runtimeValueMatchIndex], match[runtimeValueFieldMatchIndex])
+	})
+}
+
 func getRuntimeValueFromRegexMatch(match []string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (starlark.Comparable, error) {
 	runtimeValueMatchIndex := compiledRuntimeValueReplacementRegex.SubexpIndex(runtimeValueSubgroupName)
 	if runtimeValueMatchIndex == subExpNotFound {
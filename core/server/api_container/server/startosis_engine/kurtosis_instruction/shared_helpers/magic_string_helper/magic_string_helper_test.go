@@ -67,6 +67,24 @@ func TestGetOrReplaceRuntimeValueFromString_Interpolated(t *testing.T) {
 	require.Equal(t, resolvedInterpolatedString, testExpectedInterpolatedString)
 }
 
+func TestGetRuntimeValueUuids(t *testing.T) {
+	firstUuid := "0123456789abcdef0123456789abcdef"
+	secondUuid := "fedcba9876543210fedcba9876543210"
+	originalString := fmt.Sprintf("http://"+RuntimeValueReplacementPlaceholderFormat+":8080/"+RuntimeValueReplacementPlaceholderFormat, firstUuid, "ip_address", secondUuid, testRuntimeValueField)
+	require.Equal(t, []string{firstUuid, secondUuid}, GetRuntimeValueUuids(originalString))
+	require.Empty(t, GetRuntimeValueUuids("no runtime value"))
+}
+
+func TestReplaceRuntimeValueReferencesInString(t *testing.T) {
+	firstUuid := "0123456789abcdef0123456789abcdef"
+	secondUuid := "fedcba9876543210fedcba9876543210"
+	originalString := fmt.Sprintf("http://"+RuntimeValueReplacementPlaceholderFormat+":8080/"+RuntimeValueReplacementPlaceholderFormat, firstUuid, "ip_address", secondUuid, testRuntimeValueField)
+	replacedString := ReplaceRuntimeValueReferencesInString(originalString, func(runtimeValueUuid string, runtimeValueField string) string {
+		return fmt.Sprintf("<%s.%s>", runtimeValueUuid[:4], runtimeValueField)
+	})
+	require.Equal(t, "http://<0123.ip_address>:8080/<fedc.field.subfield>", replacedString)
+}
+
 func TestReplaceRuntimeValueFromString(t *testing.T) {
 	enclaveDb := getEnclaveDBForTest(t)
 
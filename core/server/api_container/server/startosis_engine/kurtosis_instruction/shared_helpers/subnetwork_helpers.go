@@ -0,0 +1,35 @@
+package shared_helpers
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"reflect"
+)
+
+const (
+	numSubnetworksInConnection = 2
+)
+
+// ParseSubnetworks parses a tuple of exactly two distinct non-empty subnetwork names, as used to identify the
+// connection between two subnetworks
+func ParseSubnetworks(argNameForLogging string, value starlark.Value) (string, string, *startosis_errors.InterpretationError) {
+	subnetworksTuple, ok := value.(starlark.Tuple)
+	if !ok {
+		return "", "", startosis_errors.NewInterpretationError("'%s' argument should be a tuple of two subnetwork names, got '%s'", argNameForLogging, reflect.TypeOf(value))
+	}
+	if subnetworksTuple.Len() != numSubnetworksInConnection {
+		return "", "", startosis_errors.NewInterpretationError("'%s' argument should contain exactly %d subnetwork names, got %d", argNameForLogging, numSubnetworksInConnection, subnetworksTuple.Len())
+	}
+	subnetworks := make([]string, numSubnetworksInConnection)
+	for idx, subnetworkValue := range subnetworksTuple {
+		subnetwork, ok := subnetworkValue.(starlark.String)
+		if !ok || subnetwork.GoString() == "" {
+			return "", "", startosis_errors.NewInterpretationError("'%s' argument should only contain non-empty strings, got '%s'", argNameForLogging, subnetworkValue)
+		}
+		subnetworks[idx] = subnetwork.GoString()
+	}
+	if subnetworks[0] == subnetworks[1] {
+		return "", "", startosis_errors.NewInterpretationError("'%s' argument should contain two different subnetworks, traffic within a subnetwork can't be conditioned. Got '%s' twice", argNameForLogging, subnetworks[0])
+	}
+	return subnetworks[0], subnetworks[1], nil
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"github.com/kurtosis-tech/stacktrace"
@@ -169,5 +170,11 @@ func (builtin *StoreServiceFilesCapabilities) FillPersistableAttributes(builder
 		StoreServiceFilesBuiltinName,
 	).AddFilesArtifact(
 		builtin.artifactName, nil,
+	).AddTargetedServiceName(
+		builtin.serviceName,
 	)
 }
+
+func (builtin *StoreServiceFilesCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	planBuilder.AddFilesArtifact(builtin.artifactName, []string{builtin.src}, builtin.serviceName)
+}
@@ -249,6 +249,17 @@ func getServiceConfig(image string, filesArtifactExpansion *service_directory.Fi
 		service_config.DefaultPrivateIPAddrPlaceholder,
 		0,
 		0,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+		nil,
+		nil,
 	)
 }
 
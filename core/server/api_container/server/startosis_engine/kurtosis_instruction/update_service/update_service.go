@@ -0,0 +1,125 @@
+package update_service
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+)
+
+const (
+	UpdateServiceBuiltinName = "update_service"
+
+	ServiceNameArgName         = "name"
+	UpdateServiceConfigArgName = "config"
+)
+
+func NewUpdateService(serviceNetwork service_network.ServiceNetwork) *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return &kurtosis_plan_instruction.KurtosisPlanInstruction{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: UpdateServiceBuiltinName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              ServiceNameArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ServiceNameArgName)
+					},
+				},
+				{
+					Name:              UpdateServiceConfigArgName,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*service_config.UpdateServiceConfig],
+					Validator:         nil,
+				},
+			},
+		},
+
+		Capabilities: func() kurtosis_plan_instruction.KurtosisPlanInstructionCapabilities {
+			return &UpdateServiceCapabilities{
+				serviceNetwork: serviceNetwork,
+
+				serviceName: "", // populated at interpretation time
+				subnetwork:  "", // populated at interpretation time
+			}
+		},
+
+		DefaultDisplayArguments: map[string]bool{
+			ServiceNameArgName:         true,
+			UpdateServiceConfigArgName: true,
+		},
+	}
+}
+
+type UpdateServiceCapabilities struct {
+	serviceNetwork service_network.ServiceNetwork
+
+	serviceName service.ServiceName
+	subnetwork  partition_topology.PartitionID
+}
+
+func (builtin *UpdateServiceCapabilities) Interpret(_ string, arguments *builtin_argument.ArgumentValuesSet) (starlark.Value, *startosis_errors.InterpretationError) {
+	serviceName, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ServiceNameArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", ServiceNameArgName)
+	}
+	updateServiceConfig, err := builtin_argument.ExtractArgumentValue[*service_config.UpdateServiceConfig](arguments, UpdateServiceConfigArgName)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", UpdateServiceConfigArgName)
+	}
+	subnetwork, interpretationErr := updateServiceConfig.GetSubnetwork()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	builtin.serviceName = service.ServiceName(serviceName.GoString())
+	builtin.subnetwork = partition_topology.PartitionID(subnetwork)
+	return starlark.None, nil
+}
+
+func (builtin *UpdateServiceCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validatorEnvironment.DoesServiceNameExist(builtin.serviceName) == startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("There was an error validating '%v' as service name '%v' doesn't exist", UpdateServiceBuiltinName, builtin.serviceName)
+	}
+	return nil
+}
+
+func (builtin *UpdateServiceCapabilities) Execute(ctx context.Context, _ *builtin_argument.ArgumentValuesSet) (string, error) {
+	if err := builtin.serviceNetwork.SetServicePartition(ctx, builtin.serviceName, builtin.subnetwork); err != nil {
+		return "", stacktrace.Propagate(err, "Failed moving service '%s' to subnetwork '%s'", builtin.serviceName, builtin.subnetwork)
+	}
+	return fmt.Sprintf("Service '%s' moved to subnetwork '%s'", builtin.serviceName, builtin.subnetwork), nil
+}
+
+func (builtin *UpdateServiceCapabilities) TryResolveWith(instructionsAreEqual bool, _ *enclave_plan_persistence.EnclavePlanInstruction, _ *enclave_structure.EnclaveComponents) enclave_structure.InstructionResolutionStatus {
+	if instructionsAreEqual {
+		return enclave_structure.InstructionIsEqual
+	}
+	return enclave_structure.InstructionIsUnknown
+}
+
+func (builtin *UpdateServiceCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
+	builder.SetType(
+		UpdateServiceBuiltinName,
+	).AddServiceName(
+		builtin.serviceName,
+	)
+}
+
+func (builtin *UpdateServiceCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	// only the subnetwork of the service gets updated, which isn't part of the plan
+}
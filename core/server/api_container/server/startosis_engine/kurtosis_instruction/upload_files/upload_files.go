@@ -11,6 +11,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
@@ -202,3 +203,7 @@ func (builtin *UploadFilesCapabilities) FillPersistableAttributes(builder *encla
 		builtin.artifactName, builtin.filesArtifactMd5,
 	)
 }
+
+func (builtin *UploadFilesCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	planBuilder.AddFilesArtifact(builtin.artifactName, []string{builtin.src}, "")
+}
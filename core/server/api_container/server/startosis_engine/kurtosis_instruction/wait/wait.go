@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
@@ -140,15 +141,16 @@ func (builtin *WaitCapabilities) Interpret(_ string, arguments *builtin_argument
 	serviceName := service.ServiceName(serviceNameArgumentValue.GoString())
 
 	var genericRecipe recipe.Recipe
-	httpRecipe, err := builtin_argument.ExtractArgumentValue[recipe.HttpRequestRecipe](arguments, RecipeArgName)
-	if err != nil {
+	if httpRecipe, err := builtin_argument.ExtractArgumentValue[recipe.HttpRequestRecipe](arguments, RecipeArgName); err == nil {
+		genericRecipe = httpRecipe
+	} else if probeRecipe, err := builtin_argument.ExtractArgumentValue[recipe.ProbeRecipe](arguments, RecipeArgName); err == nil {
+		genericRecipe = probeRecipe
+	} else {
 		execRecipe, err := builtin_argument.ExtractArgumentValue[*recipe.ExecRecipe](arguments, RecipeArgName)
 		if err != nil {
 			return nil, startosis_errors.WrapWithInterpretationError(err, "Unable to extract value for '%s' argument", RecipeArgName)
 		}
 		genericRecipe = execRecipe
-	} else {
-		genericRecipe = httpRecipe
 	}
 
 	valueField, err := builtin_argument.ExtractArgumentValue[starlark.String](arguments, ValueFieldArgName)
@@ -227,13 +229,16 @@ func (builtin *WaitCapabilities) Validate(_ *builtin_argument.ArgumentValuesSet,
 		return startosis_errors.NewValidationError("Tried creating a wait for service '%s' which doesn't exist", builtin.serviceName)
 	}
 
-	httpRequestRecipe, ok := builtin.recipe.(recipe.HttpRequestRecipe)
-	// if the passed recipe isn't http request recipe we can't do much
-	if !ok {
-		return nil
-	}
-	if validationErr := recipe.ValidateHttpRequestRecipe(httpRequestRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
-		return validationErr
+	// ExecRecipe has nothing to validate against the environment
+	switch typedRecipe := builtin.recipe.(type) {
+	case recipe.HttpRequestRecipe:
+		if validationErr := recipe.ValidateHttpRequestRecipe(typedRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
+			return validationErr
+		}
+	case recipe.ProbeRecipe:
+		if validationErr := recipe.ValidateProbeRecipe(typedRecipe, builtin.serviceName, validatorEnvironment); validationErr != nil {
+			return validationErr
+		}
 	}
 	return nil
 }
@@ -286,5 +291,13 @@ func (builtin *WaitCapabilities) TryResolveWith(instructionsAreEqual bool, _ *en
 }
 
 func (builtin *WaitCapabilities) FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder) {
-	builder.SetType(WaitBuiltinName)
+	builder.SetType(
+		WaitBuiltinName,
+	).AddTargetedServiceName(
+		builtin.serviceName,
+	)
+}
+
+func (builtin *WaitCapabilities) UpdatePlan(planBuilder *plan_export.PlanBuilder) {
+	// waiting only reads from the service it targets
 }
@@ -5,6 +5,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
 	"go.starlark.net/starlark"
@@ -22,4 +23,7 @@ type KurtosisPlanInstructionCapabilities interface {
 	// FillPersistableAttributes adds to the builder the attributes of the instruction that needs to be persisted to the
 	// enclave database to power idempotent runs.
 	FillPersistableAttributes(builder *enclave_plan_persistence.EnclavePlanInstructionBuilder)
+
+	// UpdatePlan adds to the plan the services, tasks and files artifacts the instruction creates or removes
+	UpdatePlan(planBuilder *plan_export.PlanBuilder)
 }
@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
@@ -43,6 +44,17 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 				service_config.DefaultPrivateIPAddrPlaceholder,
 				0,
 				0,
+				nil,
+				map[string]string{},
+				nil,
+				map[string]string{},
+				map[string]uint64{},
+				map[string]uint64{},
+				0,
+				map[string]string{},
+				nil,
+				nil,
+				nil,
 			)
 
 			actualServiceConfig := serviceConfig
@@ -53,6 +65,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddService() {
 		service.NewService(service.NewServiceRegistration(TestServiceName, TestServiceUuid, TestEnclaveUuid, nil, string(TestServiceName)), container_status.ContainerStatus_Running, nil, nil, nil),
 		nil,
 	)
+	suite.serviceNetwork.EXPECT().MonitorServiceHealth(TestServiceName, (*service_health.HealthConfig)(nil)).Times(1).Return(nil)
 
 	suite.run(&addServiceTestCase{
 		T:                 suite.T(),
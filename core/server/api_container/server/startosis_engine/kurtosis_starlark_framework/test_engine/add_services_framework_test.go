@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
@@ -59,6 +60,17 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 				service_config.DefaultPrivateIPAddrPlaceholder,
 				0,
 				0,
+				nil,
+				map[string]string{},
+				nil,
+				map[string]string{},
+				map[string]uint64{},
+				map[string]uint64{},
+				0,
+				map[string]string{},
+				nil,
+				nil,
+				nil,
 			)
 			actualServiceConfig1 := configs[TestServiceName]
 			suite.Assert().Equal(expectedServiceConfig1, actualServiceConfig1)
@@ -77,6 +89,17 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 				service_config.DefaultPrivateIPAddrPlaceholder,
 				0,
 				0,
+				nil,
+				map[string]string{},
+				nil,
+				map[string]string{},
+				map[string]uint64{},
+				map[string]uint64{},
+				0,
+				map[string]string{},
+				nil,
+				nil,
+				nil,
 			)
 			actualServiceConfig2 := configs[TestServiceName2]
 			suite.Assert().Equal(expectedServiceConfig2, actualServiceConfig2)
@@ -91,6 +114,8 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 		map[service.ServiceName]error{},
 		nil,
 	)
+	suite.serviceNetwork.EXPECT().MonitorServiceHealth(TestServiceName, (*service_health.HealthConfig)(nil)).Times(1).Return(nil)
+	suite.serviceNetwork.EXPECT().MonitorServiceHealth(TestServiceName2, (*service_health.HealthConfig)(nil)).Times(1).Return(nil)
 
 	suite.serviceNetwork.EXPECT().HttpRequestService(
 		mock.Anything,
@@ -100,6 +125,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 		"",
 		TestReadyConditionsRecipeEndpoint,
 		"",
+		(*service_network.HttpRequestOptions)(nil),
 	).Times(1).Return(&http.Response{
 		Status:     "200 OK",
 		StatusCode: 200,
@@ -128,6 +154,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestAddServices() {
 		"",
 		TestReadyConditions2RecipeEndpoint,
 		"",
+		(*service_network.HttpRequestOptions)(nil),
 	).Times(1).Return(&http.Response{
 		Status:     "201 OK",
 		StatusCode: 201,
@@ -0,0 +1,43 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type connectionConfigTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestConnectionConfig() {
+	suite.run(&connectionConfigTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *connectionConfigTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%s, %s=%s)",
+		connection_config.ConnectionConfigTypeName,
+		connection_config.PacketLossPercentageAttr,
+		TestPacketLossPercentage,
+		connection_config.LatencyAttr,
+		getNormalPacketDelayDistributionScriptPart(),
+	)
+}
+
+func (t *connectionConfigTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	connectionConfigStarlark, ok := typeValue.(*connection_config.ConnectionConfig)
+	require.True(t, ok)
+
+	connection, interpretationErr := connectionConfigStarlark.ToKurtosisType()
+	require.Nil(t, interpretationErr)
+	expectedConnection := partition_topology.NewPartitionConnection(
+		TestPacketLossPercentageValue,
+		partition_topology.NewNormalPacketDelayDistribution(TestPacketDelayMs, TestPacketDelayStdDevMs, TestPacketDelayCorrelation),
+	)
+	require.Equal(t, expectedConnection, connection)
+}
@@ -0,0 +1,54 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type directorySeededPersistentDirectoryTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestDirectorySeededPersistentDirectory() {
+	suite.run(&directorySeededPersistentDirectoryTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *directorySeededPersistentDirectoryTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%d, %s=%q)",
+		directory.DirectoryTypeName,
+		directory.ArtifactNameAttr, TestFilesArtifactName1,
+		directory.PersistentKeyAttr, TestPersistentDirectoryKey,
+		directory.SizeAttr, TestPersistentDirectorySizeMegabytes,
+		directory.StorageClassAttr, TestPersistentDirectoryStorageClass,
+	)
+}
+
+func (t *directorySeededPersistentDirectoryTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	directoryStarlark, ok := typeValue.(*directory.Directory)
+	require.True(t, ok)
+
+	artifactName, found, err := directoryStarlark.GetArtifactNameIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, TestFilesArtifactName1, artifactName)
+
+	persistentKey, found, err := directoryStarlark.GetPersistentKeyIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, TestPersistentDirectoryKey, persistentKey)
+
+	sizeInMegabytes, found, err := directoryStarlark.GetSizeInMegabytesIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, TestPersistentDirectorySizeMegabytes, sizeInMegabytes)
+
+	storageClass, found, err := directoryStarlark.GetStorageClassIfSet()
+	require.Nil(t, err)
+	require.True(t, found)
+	require.Equal(t, TestPersistentDirectoryStorageClass, storageClass)
+}
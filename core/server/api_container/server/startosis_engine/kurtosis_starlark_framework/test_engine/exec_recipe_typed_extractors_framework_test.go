@@ -0,0 +1,69 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/exec_result"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+const (
+	execRecipeTypedExtractorsOutput = "chain id: 1337\npeers:\n  - 10.0.0.1\n  - 10.0.0.2\nstatus: ready\n"
+)
+
+type execRecipeTypedExtractorsTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestExecRecipeWithTypedExtractors() {
+	suite.serviceNetwork.EXPECT().RunExec(
+		mock.Anything,
+		string(TestServiceName),
+		[]string{"cat", "/status.yaml"},
+	).Times(1).Return(
+		exec_result.NewExecResult(0, execRecipeTypedExtractorsOutput),
+		nil,
+	)
+
+	suite.run(&execRecipeTypedExtractorsTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *execRecipeTypedExtractorsTestCase) GetStarlarkCode() string {
+	command := fmt.Sprintf("[%q, %q]", "cat", "/status.yaml")
+	extractors := fmt.Sprintf("{%q: %s(%s=%q, %s=%q), %q: %s(%s=%q, %s=%q), %q: %s(%s=%d, %s=%d), %q: %q}",
+		"chain_id", recipe.RegexExtractorTypeName, recipe.PatternAttr, "chain id: (?P<id>[0-9]+)", recipe.GroupAttr, "id",
+		"peer", recipe.JqExtractorTypeName, recipe.QueryAttr, ".peers[-1]", recipe.InputFormatAttr, "yaml",
+		"status", recipe.LineExtractorTypeName, recipe.LineAttr, -1, recipe.ColumnAttr, 1,
+		"word", `split(" ") | .[0]`)
+	return fmt.Sprintf("%s(%s=%s, %s=%s)", recipe.ExecRecipeTypeName, recipe.CommandAttr, command, recipe.ExtractAttr, extractors)
+}
+
+func (t *execRecipeTypedExtractorsTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	execRecipe, ok := typeValue.(*recipe.ExecRecipe)
+	require.True(t, ok)
+
+	result, err := execRecipe.Execute(context.Background(), t.serviceNetwork, t.runtimeValueStore, TestServiceName)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("1337"), result["extract.chain_id"])
+	require.Equal(t, starlark.String("10.0.0.2"), result["extract.peer"])
+	require.Equal(t, starlark.String("ready"), result["extract.status"])
+	require.Equal(t, starlark.String("chain"), result["extract.word"])
+
+	returnValue, interpretationErr := execRecipe.CreateStarlarkReturnValue("result-fake-uuid")
+	require.Nil(t, interpretationErr)
+	expectedInterpretationResult := `{"code": "{{kurtosis:result-fake-uuid:code.runtime_value}}", "output": "{{kurtosis:result-fake-uuid:output.runtime_value}}", "extract.chain_id": "{{kurtosis:result-fake-uuid:extract.chain_id.runtime_value}}", "extract.peer": "{{kurtosis:result-fake-uuid:extract.peer.runtime_value}}", "extract.status": "{{kurtosis:result-fake-uuid:extract.status.runtime_value}}", "extract.word": "{{kurtosis:result-fake-uuid:extract.word.runtime_value}}"}`
+	require.Equal(t, expectedInterpretationResult, returnValue.String())
+}
@@ -30,6 +30,7 @@ func (suite *KurtosisTypeConstructorTestSuite) TestGetHttpRequestRecipeNoExtract
 		"",
 		"/test",
 		"",
+		(*service_network.HttpRequestOptions)(nil),
 	).Times(1).Return(
 		&http.Response{
 			Status:           "200 OK",
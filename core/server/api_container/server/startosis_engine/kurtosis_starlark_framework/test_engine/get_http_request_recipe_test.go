@@ -34,6 +34,7 @@ func (suite *KurtosisTypeConstructorTestSuite) TestGetHttpRequestRecipe() {
 		"",
 		"/test",
 		"",
+		(*service_network.HttpRequestOptions)(nil),
 	).Times(1).Return(
 		&http.Response{
 			Status:           "200 OK",
@@ -0,0 +1,84 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/grpc_request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+const (
+	grpcRequestRecipeMethod       = "helloworld.Greeter/SayHello"
+	grpcRequestRecipeRequestJson  = `{"name": "world"}`
+	grpcRequestRecipeResponseJson = `{"message":"Hello world!"}`
+	grpcRequestRecipeArtifactName = "greeter-descriptors"
+	grpcRequestRecipeFilePath     = "greeter.pb"
+)
+
+type grpcRequestRecipeTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestGrpcRequestRecipe() {
+	suite.serviceNetwork.EXPECT().GrpcRequestService(
+		mock.Anything,
+		string(TestServiceName),
+		TestPrivatePortId,
+		grpcRequestRecipeMethod,
+		grpcRequestRecipeRequestJson,
+		service_network.NewGrpcRequestOptions(
+			map[string]string{"authorization": "Bearer token"},
+			grpcRequestRecipeArtifactName,
+			grpcRequestRecipeFilePath,
+			false,
+			false,
+		),
+	).Times(1).Return(
+		grpc_request.NewGrpcResponse(codes.OK, "", grpcRequestRecipeResponseJson),
+		nil,
+	)
+
+	suite.run(&grpcRequestRecipeTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *grpcRequestRecipeTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%s, %s=%q, %s=%q, %s=%s)",
+		recipe.GrpcRecipeTypeName,
+		recipe.PortIdAttr, TestPrivatePortId,
+		recipe.MethodAttr, grpcRequestRecipeMethod,
+		recipe.RequestBodyAttr, grpcRequestRecipeRequestJson,
+		recipe.MetadataAttr, `{"authorization": "Bearer token"}`,
+		recipe.DescriptorSetArtifactAttr, grpcRequestRecipeArtifactName,
+		recipe.DescriptorSetFileAttr, grpcRequestRecipeFilePath,
+		recipe.ExtractAttr, `{"message": ".message"}`)
+}
+
+func (t *grpcRequestRecipeTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	grpcRequestRecipe, ok := typeValue.(*recipe.GrpcRequestRecipe)
+	require.True(t, ok)
+
+	result, err := grpcRequestRecipe.Execute(context.Background(), t.serviceNetwork, t.runtimeValueStore, TestServiceName)
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(0), result["code"])
+	require.Equal(t, starlark.String(grpcRequestRecipeResponseJson), result["body"])
+	require.Equal(t, starlark.String("Hello world!"), result["extract.message"])
+
+	returnValue, interpretationErr := grpcRequestRecipe.CreateStarlarkReturnValue("result-fake-uuid")
+	require.Nil(t, interpretationErr)
+	expectedInterpretationResult := `{"body": "{{kurtosis:result-fake-uuid:body.runtime_value}}", "code": "{{kurtosis:result-fake-uuid:code.runtime_value}}", "extract.message": "{{kurtosis:result-fake-uuid:extract.message.runtime_value}}"}`
+	require.Equal(t, expectedInterpretationResult, returnValue.String())
+}
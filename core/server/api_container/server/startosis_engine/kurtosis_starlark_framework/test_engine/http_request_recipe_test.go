@@ -0,0 +1,102 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const (
+	httpRequestRecipeResponseJson = `{"value": "Hello world!"}`
+)
+
+type httpRequestRecipeTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestHttpRequestRecipe() {
+	suite.serviceNetwork.EXPECT().HttpRequestService(
+		mock.Anything,
+		string(TestServiceName),
+		TestPrivatePortId,
+		"PUT",
+		"application/json",
+		"/test",
+		"{}",
+		service_network.NewHttpRequestOptions(
+			map[string]string{"Authorization": "Bearer token"},
+			map[string]string{"verbose": "true"},
+			true,
+			true,
+		),
+	).Times(1).Return(
+		&http.Response{
+			Status:           "200 OK",
+			StatusCode:       200,
+			Proto:            "HTTP/1.0",
+			ProtoMajor:       1,
+			ProtoMinor:       0,
+			Header:           http.Header{"X-Request-Id": []string{"42"}},
+			Body:             io.NopCloser(strings.NewReader(httpRequestRecipeResponseJson)),
+			ContentLength:    -1,
+			TransferEncoding: nil,
+			Close:            false,
+			Uncompressed:     false,
+			Trailer:          nil,
+			Request:          nil,
+			TLS:              nil,
+		},
+		nil,
+	)
+
+	suite.run(&httpRequestRecipeTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *httpRequestRecipeTestCase) GetStarlarkCode() string {
+	headers := `{"Authorization": "Bearer token"}`
+	queryParams := `{"verbose": "true"}`
+	extractors := `{"request_id": "$headers[\"x-request-id\"]", "result": ".value"}`
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%s, %s=%s, %s=%q, %s=True, %s=True, %s=%s)",
+		recipe.HttpRecipeTypeName,
+		recipe.PortIdAttr, TestPrivatePortId,
+		recipe.EndpointAttr, "/test",
+		recipe.MethodAttr, "PUT",
+		recipe.HeadersAttr, headers,
+		recipe.QueryParamsAttr, queryParams,
+		recipe.RequestBodyAttr, "{}",
+		recipe.UseTlsAttr,
+		recipe.SkipTlsVerifyAttr,
+		recipe.ExtractAttr, extractors)
+}
+
+func (t *httpRequestRecipeTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	httpRequestRecipe, ok := typeValue.(*recipe.GenericHttpRequestRecipe)
+	require.True(t, ok)
+	require.Equal(t, "PUT", httpRequestRecipe.RequestType())
+
+	result, err := httpRequestRecipe.Execute(context.Background(), t.serviceNetwork, t.runtimeValueStore, TestServiceName)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("Hello world!"), result["extract.result"])
+	require.Equal(t, starlark.String("42"), result["extract.request_id"])
+
+	returnValue, interpretationErr := httpRequestRecipe.CreateStarlarkReturnValue("result-fake-uuid")
+	require.Nil(t, interpretationErr)
+	expectedInterpretationResult := `{"body": "{{kurtosis:result-fake-uuid:body.runtime_value}}", "code": "{{kurtosis:result-fake-uuid:code.runtime_value}}", "extract.request_id": "{{kurtosis:result-fake-uuid:extract.request_id.runtime_value}}", "extract.result": "{{kurtosis:result-fake-uuid:extract.result.runtime_value}}"}`
+	require.Equal(t, expectedInterpretationResult, returnValue.String())
+}
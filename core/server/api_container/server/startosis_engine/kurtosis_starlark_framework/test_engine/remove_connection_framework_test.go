@@ -0,0 +1,52 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/remove_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type removeConnectionTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestRemoveConnection() {
+	suite.serviceNetwork.EXPECT().UnsetConnection(
+		mock.Anything,
+		partition_topology.PartitionID(TestSubnetwork1),
+		partition_topology.PartitionID(TestSubnetwork2),
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&removeConnectionTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *removeConnectionTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return remove_connection.NewRemoveConnection(t.serviceNetwork)
+}
+
+func (t *removeConnectionTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=(%q, %q))", remove_connection.RemoveConnectionBuiltinName, remove_connection.SubnetworksArgName, TestSubnetwork1, TestSubnetwork2)
+}
+
+func (t *removeConnectionTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *removeConnectionTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Removed subnetwork connection override between '%s' and '%s'", TestSubnetwork1, TestSubnetwork2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
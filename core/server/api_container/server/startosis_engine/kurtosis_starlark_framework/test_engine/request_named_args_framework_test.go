@@ -42,6 +42,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestRequestWithNamedArgs() {
 		requestContentType,
 		requestEndpoint,
 		requestBody,
+		(*service_network.HttpRequestOptions)(nil),
 	).Times(1).Return(
 		&http.Response{
 			Status:           "200 OK",
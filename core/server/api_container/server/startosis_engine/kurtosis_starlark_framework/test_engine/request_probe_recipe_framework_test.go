@@ -0,0 +1,69 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/request"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+	"time"
+)
+
+const (
+	requestProbeSend = "ping"
+
+	requestProbeReply = "pong"
+)
+
+type requestWithProbeRecipeTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestRequestWithProbeRecipe() {
+	suite.serviceNetwork.EXPECT().ProbeServicePort(
+		mock.Anything,
+		string(requestTestCaseServiceName),
+		requestPortId,
+		port_spec.TransportProtocol_UDP,
+		requestProbeSend,
+		"",
+		5*time.Second,
+	).Times(1).Return(
+		requestProbeReply,
+		nil,
+	)
+
+	suite.run(&requestWithProbeRecipeTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *requestWithProbeRecipeTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return request.NewRequest(t.serviceNetwork, t.runtimeValueStore)
+}
+
+func (t *requestWithProbeRecipeTestCase) GetStarlarkCode() string {
+	recipe := fmt.Sprintf(`UdpProbeRecipe(port_id=%q, send=%q)`, requestPortId, requestProbeSend)
+	return fmt.Sprintf("%s(%s=%q, %s=%s)", request.RequestBuiltinName, request.ServiceNameArgName, requestTestCaseServiceName, request.RecipeArgName, recipe)
+}
+
+func (t *requestWithProbeRecipeTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *requestWithProbeRecipeTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	expectedInterpretationResultMap := `{"body": "{{kurtosis:[0-9a-f]{32}:body.runtime_value}}", "code": "{{kurtosis:[0-9a-f]{32}:code.runtime_value}}"}`
+	require.Regexp(t, expectedInterpretationResultMap, interpretationResult.String())
+
+	expectedExecutionResult := `Request had response code '0' and body "pong"`
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -87,11 +87,16 @@ func (t *serviceConfigFullTestCase) Assert(typeValue builtin_argument.KurtosisVa
 	require.NotNil(t, serviceConfig.GetFilesArtifactsExpansion())
 	require.Equal(t, expectedFilesArtifactMap, serviceConfig.GetFilesArtifactsExpansion().ServiceDirpathsToArtifactIdentifiers)
 
-	expectedPersistentDirectoryMap := map[string]service_directory.DirectoryPersistentKey{
-		TestPersistentDirectoryPath: service_directory.DirectoryPersistentKey(TestPersistentDirectoryKey),
+	expectedPersistentDirectoryMap := map[string]service_directory.PersistentDirectory{
+		TestPersistentDirectoryPath: {
+			PersistentKey:              service_directory.DirectoryPersistentKey(TestPersistentDirectoryKey),
+			Size:                       service_directory.DirectoryPersistentSize(0),
+			StorageClass:               "",
+			SeedFilesArtifactExpansion: nil,
+		},
 	}
 	require.NotNil(t, serviceConfig.GetPersistentDirectories())
-	require.Equal(t, expectedPersistentDirectoryMap, serviceConfig.GetPersistentDirectories().ServiceDirpathToDirectoryPersistentKey)
+	require.Equal(t, expectedPersistentDirectoryMap, serviceConfig.GetPersistentDirectories().ServiceDirpathToPersistentDirectory)
 
 	require.Equal(t, TestEntryPointSlice, serviceConfig.GetEntrypointArgs())
 	require.Equal(t, TestCmdSlice, serviceConfig.GetCmdArgs())
@@ -0,0 +1,54 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type serviceConfigHealthTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigHealth() {
+	suite.run(&serviceConfigHealthTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *serviceConfigHealthTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%s, %s=%q, %s=%d)",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, TestContainerImageName,
+		service_config.LivenessAttr, getDefaultReadyConditionsScriptPart(),
+		service_config.RestartPolicyAttr, service_health.RestartPolicy_OnFailure,
+		service_config.MaxRestartsAttr, TestMaxRestarts,
+	)
+}
+
+func (t *serviceConfigHealthTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	liveness, interpretationErr := serviceConfigStarlark.GetLiveness()
+	require.Nil(t, interpretationErr)
+	require.NotNil(t, liveness)
+	field, interpretationErr := liveness.GetField()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, TestReadyConditionsField, field)
+
+	readyCondition, interpretationErr := serviceConfigStarlark.GetReadyCondition()
+	require.Nil(t, interpretationErr)
+	require.Nil(t, readyCondition)
+
+	restartPolicy, maxRestarts, interpretationErr := serviceConfigStarlark.GetRestartPolicy()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, service_health.RestartPolicy_OnFailure, restartPolicy)
+	require.Equal(t, TestMaxRestarts, maxRestarts)
+}
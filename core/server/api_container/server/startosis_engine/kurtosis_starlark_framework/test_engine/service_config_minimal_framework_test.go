@@ -50,6 +50,17 @@ func (t *serviceConfigMinimalTestCase) Assert(typeValue builtin_argument.Kurtosi
 		service_config.DefaultPrivateIPAddrPlaceholder,
 		0,
 		0,
+		nil,
+		map[string]string{},
+		nil,
+		map[string]string{},
+		map[string]uint64{},
+		map[string]uint64{},
+		0,
+		map[string]string{},
+		nil,
+		nil,
+		nil,
 	)
 	require.Equal(t, expectedServiceConfig, serviceConfig)
 }
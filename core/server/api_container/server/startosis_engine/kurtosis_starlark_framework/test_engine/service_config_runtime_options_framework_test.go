@@ -0,0 +1,57 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_user"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type serviceConfigRuntimeOptionsTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigRuntimeOptions() {
+	suite.run(&serviceConfigRuntimeOptionsTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *serviceConfigRuntimeOptionsTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%s, %s=%d)",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, TestContainerImageName,
+		service_config.UserAttr, fmt.Sprintf("%s(%s=%d, %s=%d)", service_user.UserTypeName, service_user.UIDAttr, TestUserUID, service_user.GIDAttr, TestUserGID),
+		service_config.LabelsAttr, fmt.Sprintf("{%q: %q}", TestLabelKey, TestLabelValue),
+		service_config.CapabilitiesAttr, fmt.Sprintf("[%q]", TestCapability),
+		service_config.SysctlsAttr, fmt.Sprintf("{%q: %q}", TestSysctlName, TestSysctlValue),
+		service_config.UlimitsAttr, fmt.Sprintf("{%q: %d}", TestUlimitName, TestUlimitValue),
+		service_config.TmpfsAttr, fmt.Sprintf("{%q: %d}", TestTmpfsDirpath, TestTmpfsSizeMegabytes),
+		service_config.ShmSizeAttr, TestShmSizeMegabytes,
+	)
+}
+
+func (t *serviceConfigRuntimeOptionsTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(t.serviceNetwork)
+	require.Nil(t, interpretationErr)
+
+	require.NotNil(t, serviceConfig.GetUser())
+	require.Equal(t, TestUserUID, serviceConfig.GetUser().GetUID())
+	gid, found := serviceConfig.GetUser().GetGID()
+	require.True(t, found)
+	require.Equal(t, TestUserGID, gid)
+	require.Equal(t, map[string]string{TestLabelKey: TestLabelValue}, serviceConfig.GetLabels())
+	require.Equal(t, []string{TestNormalizedCapability}, serviceConfig.GetCapabilities())
+	require.Equal(t, map[string]string{TestSysctlName: TestSysctlValue}, serviceConfig.GetSysctls())
+	require.Equal(t, map[string]uint64{TestUlimitName: TestUlimitValue}, serviceConfig.GetUlimits())
+	require.Equal(t, map[string]uint64{TestTmpfsDirpath: TestTmpfsSizeMegabytes}, serviceConfig.GetTmpfsDirs())
+	require.Equal(t, TestShmSizeMegabytes, serviceConfig.GetShmSizeMegabytes())
+}
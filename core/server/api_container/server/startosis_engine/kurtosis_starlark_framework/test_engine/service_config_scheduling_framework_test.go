@@ -0,0 +1,49 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_toleration"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type serviceConfigSchedulingTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestServiceConfigScheduling() {
+	suite.run(&serviceConfigSchedulingTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *serviceConfigSchedulingTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%s, %s=%s, %s=%s, %s=%s)",
+		service_config.ServiceConfigTypeName,
+		service_config.ImageAttr, TestContainerImageName,
+		service_config.NodeSelectorsAttr, fmt.Sprintf("{%q: %q}", TestNodeSelectorKey, TestNodeSelectorValue),
+		service_config.TolerationsAttr, fmt.Sprintf("[%s(%s=%q, %s=%q)]", service_toleration.TolerationTypeName, service_toleration.KeyAttr, TestTolerationKey, service_toleration.ValueAttr, TestTolerationValue),
+		service_config.AffinityAttr, fmt.Sprintf("[%q]", TestAffinityServiceName),
+		service_config.AntiAffinityAttr, fmt.Sprintf("[%q]", TestAntiAffinityServiceName),
+	)
+}
+
+func (t *serviceConfigSchedulingTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	serviceConfigStarlark, ok := typeValue.(*service_config.ServiceConfig)
+	require.True(t, ok)
+
+	serviceConfig, interpretationErr := serviceConfigStarlark.ToKurtosisType(t.serviceNetwork)
+	require.Nil(t, interpretationErr)
+
+	require.Equal(t, map[string]string{TestNodeSelectorKey: TestNodeSelectorValue}, serviceConfig.GetNodeSelectors())
+	expectedToleration := service.NewToleration(TestTolerationKey, service.TolerationOperator_Equal, TestTolerationValue, service.TolerationEffect_Any, nil)
+	require.Equal(t, []service.Toleration{expectedToleration}, serviceConfig.GetTolerations())
+	require.Equal(t, []service.ServiceName{service.ServiceName(TestAffinityServiceName)}, serviceConfig.GetAffinityServiceNames())
+	require.Equal(t, []service.ServiceName{service.ServiceName(TestAntiAffinityServiceName)}, serviceConfig.GetAntiAffinityServiceNames())
+}
@@ -0,0 +1,58 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type setConnectionTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestSetConnection() {
+	suite.serviceNetwork.EXPECT().SetConnection(
+		mock.Anything,
+		partition_topology.PartitionID(TestSubnetwork1),
+		partition_topology.PartitionID(TestSubnetwork2),
+		partition_topology.NewPartitionConnection(
+			TestPacketLossPercentageValue,
+			partition_topology.NewUniformPacketDelayDistribution(0, 0),
+		),
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&setConnectionTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *setConnectionTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return set_connection.NewSetConnection(t.serviceNetwork)
+}
+
+func (t *setConnectionTestCase) GetStarlarkCode() string {
+	connectionConfig := fmt.Sprintf("%s(%s=%s)", connection_config.ConnectionConfigTypeName, connection_config.PacketLossPercentageAttr, TestPacketLossPercentage)
+	return fmt.Sprintf("%s(%s=%s, %s=(%q, %q))", set_connection.SetConnectionBuiltinName, set_connection.ConnectionConfigArgName, connectionConfig, set_connection.SubnetworksArgName, TestSubnetwork1, TestSubnetwork2)
+}
+
+func (t *setConnectionTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *setConnectionTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Configured subnetwork connection between '%s' and '%s'", TestSubnetwork1, TestSubnetwork2)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
@@ -0,0 +1,55 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/set_connection"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type setDefaultConnectionTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestSetDefaultConnection() {
+	suite.serviceNetwork.EXPECT().SetDefaultConnection(
+		mock.Anything,
+		partition_topology.NewPartitionConnection(
+			0,
+			partition_topology.NewUniformPacketDelayDistribution(TestPacketDelayMs, TestPacketDelayStdDevMs),
+		),
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&setDefaultConnectionTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *setDefaultConnectionTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return set_connection.NewSetConnection(t.serviceNetwork)
+}
+
+func (t *setDefaultConnectionTestCase) GetStarlarkCode() string {
+	latency := fmt.Sprintf("%s(%s=%d, %s=%d)", connection_config.UniformPacketDelayDistributionTypeName, connection_config.MsAttr, TestPacketDelayMs, connection_config.JitterAttr, TestPacketDelayStdDevMs)
+	connectionConfig := fmt.Sprintf("%s(%s=%s)", connection_config.ConnectionConfigTypeName, connection_config.LatencyAttr, latency)
+	return fmt.Sprintf("%s(%s=%s)", set_connection.SetConnectionBuiltinName, set_connection.ConnectionConfigArgName, connectionConfig)
+}
+
+func (t *setDefaultConnectionTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *setDefaultConnectionTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+	require.Equal(t, "Configured default subnetwork connection", *executionResult)
+}
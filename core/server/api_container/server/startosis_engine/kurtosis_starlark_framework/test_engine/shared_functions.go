@@ -2,6 +2,7 @@ package test_engine
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/connection_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
 )
@@ -51,3 +52,15 @@ func getCustomReadyConditionsScripPart(
 		timeoutStr,
 	)
 }
+
+func getNormalPacketDelayDistributionScriptPart() string {
+	return fmt.Sprintf("%s(%s=%d, %s=%d, %s=%s)",
+		connection_config.NormalPacketDelayDistributionTypeName,
+		connection_config.MeanMsAttr,
+		TestPacketDelayMs,
+		connection_config.StdDevAttr,
+		TestPacketDelayStdDevMs,
+		connection_config.CorrelationAttr,
+		TestPacketDelayCorrelationStr,
+	)
+}
@@ -46,6 +46,9 @@ var (
 	TestPersistentDirectoryPath = "path/to/persistent/dir"
 	TestPersistentDirectoryKey  = "persistent-dir-test"
 
+	TestPersistentDirectorySizeMegabytes = uint64(1024)
+	TestPersistentDirectoryStorageClass  = "standard"
+
 	TestEntryPointSlice = []string{
 		"127.0.0.0",
 		"1234",
@@ -90,4 +93,40 @@ var (
 	TestReadyConditions2Timeout        = "2s"
 
 	TestGetRequestMethod = "GET"
+
+	TestMaxRestarts = uint32(3)
+
+	TestUserUID              = uint32(1000)
+	TestUserGID              = uint32(1001)
+	TestLabelKey             = "team"
+	TestLabelValue           = "networking"
+	TestCapability           = "cap_net_admin"
+	TestNormalizedCapability = "NET_ADMIN"
+	TestSysctlName           = "net.core.somaxconn"
+	TestSysctlValue          = "1024"
+	TestUlimitName           = "nofile"
+	TestUlimitValue          = uint64(65536)
+	TestTmpfsDirpath         = "/scratch"
+	TestTmpfsSizeMegabytes   = uint64(64)
+	TestShmSizeMegabytes     = uint64(256)
+
+	TestNodeSelectorKey         = "pool"
+	TestNodeSelectorValue       = "load-testing"
+	TestTolerationKey           = "dedicated"
+	TestTolerationOperator      = "Equal"
+	TestTolerationValue         = "load-testing"
+	TestTolerationEffect        = "NoExecute"
+	TestTolerationSeconds       = int64(30)
+	TestAffinityServiceName     = "database"
+	TestAntiAffinityServiceName = "other-load-generator"
+
+	TestSubnetwork1 = "subnetwork1"
+	TestSubnetwork2 = "subnetwork2"
+
+	TestPacketLossPercentage      = "50.0"
+	TestPacketLossPercentageValue = float32(50.0)
+	TestPacketDelayMs             = uint32(100)
+	TestPacketDelayStdDevMs       = uint32(10)
+	TestPacketDelayCorrelationStr = "25.0"
+	TestPacketDelayCorrelation    = float32(25.0)
 )
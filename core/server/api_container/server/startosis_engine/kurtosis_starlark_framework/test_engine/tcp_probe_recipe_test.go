@@ -0,0 +1,74 @@
+package test_engine
+
+import (
+	"context"
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/recipe"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+	"time"
+)
+
+const (
+	tcpProbeRecipeSend    = "PING\r\n"
+	tcpProbeRecipeExpect  = "+PONG"
+	tcpProbeRecipeTimeout = "2s"
+)
+
+type tcpProbeRecipeTestCase struct {
+	*testing.T
+	serviceNetwork    *service_network.MockServiceNetwork
+	runtimeValueStore *runtime_value_store.RuntimeValueStore
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestTcpProbeRecipe() {
+	suite.serviceNetwork.EXPECT().ProbeServicePort(
+		mock.Anything,
+		string(TestServiceName),
+		TestPrivatePortId,
+		port_spec.TransportProtocol_TCP,
+		tcpProbeRecipeSend,
+		tcpProbeRecipeExpect,
+		2*time.Second,
+	).Times(1).Return(
+		"+PONG\r\n",
+		nil,
+	)
+
+	suite.run(&tcpProbeRecipeTestCase{
+		T:                 suite.T(),
+		serviceNetwork:    suite.serviceNetwork,
+		runtimeValueStore: suite.runtimeValueStore,
+	})
+}
+
+func (t *tcpProbeRecipeTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%q)",
+		recipe.TcpProbeRecipeTypeName,
+		recipe.PortIdAttr, TestPrivatePortId,
+		recipe.SendAttr, tcpProbeRecipeSend,
+		recipe.ExpectAttr, tcpProbeRecipeExpect,
+		recipe.TimeoutAttr, tcpProbeRecipeTimeout)
+}
+
+func (t *tcpProbeRecipeTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	tcpProbeRecipe, ok := typeValue.(*recipe.PortProbeRecipe)
+	require.True(t, ok)
+	require.Equal(t, "tcp", tcpProbeRecipe.ProbeType())
+
+	result, err := tcpProbeRecipe.Execute(context.Background(), t.serviceNetwork, t.runtimeValueStore, TestServiceName)
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(0), result["code"])
+	require.Equal(t, starlark.String("+PONG\r\n"), result["body"])
+
+	returnValue, interpretationErr := tcpProbeRecipe.CreateStarlarkReturnValue("result-fake-uuid")
+	require.Nil(t, interpretationErr)
+	expectedInterpretationResult := `{"body": "{{kurtosis:result-fake-uuid:body.runtime_value}}", "code": "{{kurtosis:result-fake-uuid:code.runtime_value}}"}`
+	require.Equal(t, expectedInterpretationResult, returnValue.String())
+}
@@ -0,0 +1,48 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_toleration"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type tolerationTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestToleration() {
+	suite.run(&tolerationTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *tolerationTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%q, %s=%q, %s=%q, %s=%q, %s=%d)",
+		service_toleration.TolerationTypeName,
+		service_toleration.KeyAttr, TestTolerationKey,
+		service_toleration.OperatorAttr, TestTolerationOperator,
+		service_toleration.ValueAttr, TestTolerationValue,
+		service_toleration.EffectAttr, TestTolerationEffect,
+		service_toleration.TolerationSecondsAttr, TestTolerationSeconds,
+	)
+}
+
+func (t *tolerationTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	tolerationStarlark, ok := typeValue.(*service_toleration.Toleration)
+	require.True(t, ok)
+
+	toleration, interpretationErr := tolerationStarlark.ToKurtosisType()
+	require.Nil(t, interpretationErr)
+	tolerationSeconds := TestTolerationSeconds
+	expectedToleration := service.NewToleration(
+		TestTolerationKey,
+		service.TolerationOperator(TestTolerationOperator),
+		TestTolerationValue,
+		service.TolerationEffect(TestTolerationEffect),
+		&tolerationSeconds,
+	)
+	require.Equal(t, &expectedToleration, toleration)
+}
@@ -0,0 +1,54 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/update_service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_plan_instruction"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_config"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+	"testing"
+)
+
+type updateServiceTestCase struct {
+	*testing.T
+	serviceNetwork *service_network.MockServiceNetwork
+}
+
+func (suite *KurtosisPlanInstructionTestSuite) TestUpdateService() {
+	suite.serviceNetwork.EXPECT().SetServicePartition(
+		mock.Anything,
+		TestServiceName,
+		partition_topology.PartitionID(TestSubnetwork1),
+	).Times(1).Return(
+		nil,
+	)
+
+	suite.run(&updateServiceTestCase{
+		T:              suite.T(),
+		serviceNetwork: suite.serviceNetwork,
+	})
+}
+
+func (t *updateServiceTestCase) GetInstruction() *kurtosis_plan_instruction.KurtosisPlanInstruction {
+	return update_service.NewUpdateService(t.serviceNetwork)
+}
+
+func (t *updateServiceTestCase) GetStarlarkCode() string {
+	updateServiceConfig := fmt.Sprintf("%s(%s=%q)", service_config.UpdateServiceConfigTypeName, service_config.SubnetworkAttr, TestSubnetwork1)
+	return fmt.Sprintf("%s(%s=%q, %s=%s)", update_service.UpdateServiceBuiltinName, update_service.ServiceNameArgName, TestServiceName, update_service.UpdateServiceConfigArgName, updateServiceConfig)
+}
+
+func (t *updateServiceTestCase) GetStarlarkCodeForAssertion() string {
+	return ""
+}
+
+func (t *updateServiceTestCase) Assert(interpretationResult starlark.Value, executionResult *string) {
+	require.Equal(t, starlark.None, interpretationResult)
+
+	expectedExecutionResult := fmt.Sprintf("Service '%s' moved to subnetwork '%s'", TestServiceName, TestSubnetwork1)
+	require.Equal(t, expectedExecutionResult, *executionResult)
+}
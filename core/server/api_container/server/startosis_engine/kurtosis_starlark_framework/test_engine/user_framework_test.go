@@ -0,0 +1,35 @@
+package test_engine
+
+import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_user"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type userTestCase struct {
+	*testing.T
+}
+
+func (suite *KurtosisTypeConstructorTestSuite) TestUser() {
+	suite.run(&userTestCase{
+		T: suite.T(),
+	})
+}
+
+func (t *userTestCase) GetStarlarkCode() string {
+	return fmt.Sprintf("%s(%s=%d, %s=%d)", service_user.UserTypeName, service_user.UIDAttr, TestUserUID, service_user.GIDAttr, TestUserGID)
+}
+
+func (t *userTestCase) Assert(typeValue builtin_argument.KurtosisValueType) {
+	userStarlark, ok := typeValue.(*service_user.User)
+	require.True(t, ok)
+
+	user, interpretationErr := userStarlark.ToKurtosisType()
+	require.Nil(t, interpretationErr)
+	require.Equal(t, TestUserUID, user.GetUID())
+	gid, found := user.GetGID()
+	require.True(t, found)
+	require.Equal(t, TestUserGID, gid)
+}
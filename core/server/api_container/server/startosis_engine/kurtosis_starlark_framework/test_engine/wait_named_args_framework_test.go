@@ -48,6 +48,7 @@ func (suite *KurtosisPlanInstructionTestSuite) TestWaitWithNamedArgs() {
 		waitRecipeContentType,
 		waitRecipeEndpoint,
 		waitRecipeBody,
+		(*service_network.HttpRequestOptions)(nil),
 	).Times(1).Return(
 		&http.Response{
 			Status:           "200 OK",
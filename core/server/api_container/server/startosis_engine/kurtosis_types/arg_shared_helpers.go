@@ -59,6 +59,35 @@ func SafeCastToMapStringString(expectedValue starlark.Value, argNameForLogging s
 	return castValue, nil
 }
 
+// SafeCastToMapStringUint64 casts a dict of non-negative integers keyed by strings
+func SafeCastToMapStringUint64(expectedValue starlark.Value, argNameForLogging string) (map[string]uint64, *startosis_errors.InterpretationError) {
+	dictValue, ok := expectedValue.(*starlark.Dict)
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("'%s' argument is expected to be a dict. Got %s", argNameForLogging, reflect.TypeOf(expectedValue))
+	}
+	castValue := make(map[string]uint64)
+	for _, key := range dictValue.Keys() {
+		stringKey, castErr := SafeCastToString(key, fmt.Sprintf("%v.key:%v", argNameForLogging, key))
+		if castErr != nil {
+			return nil, castErr
+		}
+		value, found, dictErr := dictValue.Get(key)
+		if !found || dictErr != nil {
+			return nil, startosis_errors.NewInterpretationError("'%s' key in dict '%s' doesn't have a value we could retrieve. This is a Kurtosis bug.", key.String(), argNameForLogging)
+		}
+		intValue, ok := value.(starlark.Int)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("'%v[\"%v\"]' is expected to be an integer. Got %s", argNameForLogging, stringKey, reflect.TypeOf(value))
+		}
+		uint64Value, ok := intValue.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("'%v[\"%v\"]' is expected to be a non-negative integer. Got %v", argNameForLogging, stringKey, intValue)
+		}
+		castValue[stringKey] = uint64Value
+	}
+	return castValue, nil
+}
+
 // TODO: make private once arg_parser don't need it anymore
 func SafeCastToString(expectedValueString starlark.Value, argNameForLogging string) (string, *startosis_errors.InterpretationError) {
 	castValue, ok := expectedValueString.(starlark.String)
@@ -80,3 +80,32 @@ func TestSafeCastToMapStringString_FailureKeyIsNotString(t *testing.T) {
 	require.Equal(t, "'test.key:42' is expected to be a string. Got starlark.Int", err.Error())
 	require.Equal(t, map[string]string(nil), output)
 }
+
+func TestSafeCastToMapStringUint64_Success(t *testing.T) {
+	input := starlark.NewDict(1)
+	err := input.SetKey(starlark.String("key"), starlark.MakeInt(42))
+	require.Nil(t, err)
+	output, err := SafeCastToMapStringUint64(input, "test")
+	require.Nil(t, err)
+	require.Equal(t, map[string]uint64{"key": 42}, output)
+}
+
+func TestSafeCastToMapStringUint64_FailureValueIsNotInteger(t *testing.T) {
+	input := starlark.NewDict(1)
+	err := input.SetKey(starlark.String("key"), starlark.String("value"))
+	require.Nil(t, err)
+	output, err := SafeCastToMapStringUint64(input, "test")
+	require.NotNil(t, err)
+	require.Equal(t, "'test[\"key\"]' is expected to be an integer. Got starlark.String", err.Error())
+	require.Equal(t, map[string]uint64(nil), output)
+}
+
+func TestSafeCastToMapStringUint64_FailureValueIsNegative(t *testing.T) {
+	input := starlark.NewDict(1)
+	err := input.SetKey(starlark.String("key"), starlark.MakeInt(-1))
+	require.Nil(t, err)
+	output, err := SafeCastToMapStringUint64(input, "test")
+	require.NotNil(t, err)
+	require.Equal(t, "'test[\"key\"]' is expected to be a non-negative integer. Got -1", err.Error())
+	require.Equal(t, map[string]uint64(nil), output)
+}
@@ -0,0 +1,110 @@
+package connection_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"reflect"
+)
+
+const (
+	ConnectionConfigTypeName = "ConnectionConfig"
+
+	PacketLossPercentageAttr = "packet_loss_percentage"
+	LatencyAttr              = "latency"
+
+	minPacketLossPercentage = 0
+	maxPacketLossPercentage = 100
+)
+
+func NewConnectionConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: ConnectionConfigTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PacketLossPercentageAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Float],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.FloatInRange(value, PacketLossPercentageAttr, minPacketLossPercentage, maxPacketLossPercentage)
+					},
+				},
+				{
+					Name:              LatencyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Value],
+					Validator:         validateLatency,
+				},
+			},
+		},
+
+		Instantiate: instantiateConnectionConfig,
+	}
+}
+
+func instantiateConnectionConfig(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(ConnectionConfigTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &ConnectionConfig{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// ConnectionConfig describes the conditions of the link between two subnetworks
+type ConnectionConfig struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (config *ConnectionConfig) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := config.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &ConnectionConfig{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (config *ConnectionConfig) ToKurtosisType() (partition_topology.PartitionConnection, *startosis_errors.InterpretationError) {
+	packetLossPercentage := float32(0)
+	packetLossPercentageStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Float](config.KurtosisValueTypeDefault, PacketLossPercentageAttr)
+	if interpretationErr != nil {
+		return partition_topology.PartitionConnection{}, interpretationErr
+	}
+	if found {
+		packetLossPercentage = float32(packetLossPercentageStarlark)
+	}
+
+	delayDistribution := partition_topology.NewUniformPacketDelayDistribution(0, 0)
+	latency, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[PacketDelayDistribution](config.KurtosisValueTypeDefault, LatencyAttr)
+	if interpretationErr != nil {
+		return partition_topology.PartitionConnection{}, interpretationErr
+	}
+	if found {
+		delayDistribution, interpretationErr = latency.ToKurtosisType()
+		if interpretationErr != nil {
+			return partition_topology.PartitionConnection{}, interpretationErr
+		}
+	}
+	return partition_topology.NewPartitionConnection(packetLossPercentage, delayDistribution), nil
+}
+
+func validateLatency(value starlark.Value) *startosis_errors.InterpretationError {
+	if _, ok := value.(PacketDelayDistribution); !ok {
+		return startosis_errors.NewInterpretationError(
+			"The '%s' attribute is not a valid packet delay distribution, it should be either a '%s' or a '%s' but was '%v'",
+			LatencyAttr,
+			UniformPacketDelayDistributionTypeName,
+			NormalPacketDelayDistributionTypeName,
+			reflect.TypeOf(value),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package connection_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/partition_topology"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+)
+
+const (
+	UniformPacketDelayDistributionTypeName = "UniformPacketDelayDistribution"
+	NormalPacketDelayDistributionTypeName  = "NormalPacketDelayDistribution"
+
+	MsAttr          = "ms"
+	JitterAttr      = "jitter"
+	MeanMsAttr      = "mean_ms"
+	StdDevAttr      = "std_dev"
+	CorrelationAttr = "correlation"
+
+	minDelayMs         = 0
+	maxDelayMs         = math.MaxUint32
+	minCorrelation     = 0
+	maxCorrelation     = 100
+	uint32BitSize      = 32
+	defaultJitterMs    = 0
+	defaultStdDevMs    = 0
+	defaultCorrelation = 0
+)
+
+// PacketDelayDistribution is implemented by all the Starlark types describing the latency of a connection
+type PacketDelayDistribution interface {
+	builtin_argument.KurtosisValueType
+
+	ToKurtosisType() (partition_topology.PacketDelayDistribution, *startosis_errors.InterpretationError)
+}
+
+func NewUniformPacketDelayDistributionType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: UniformPacketDelayDistributionTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              MsAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, MsAttr, minDelayMs, maxDelayMs)
+					},
+				},
+				{
+					Name:              JitterAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, JitterAttr, minDelayMs, maxDelayMs)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiateUniformPacketDelayDistribution,
+	}
+}
+
+func instantiateUniformPacketDelayDistribution(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(UniformPacketDelayDistributionTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &UniformPacketDelayDistribution{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// UniformPacketDelayDistribution delays each packet by a duration picked uniformly in [ms - jitter, ms + jitter]
+type UniformPacketDelayDistribution struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (distribution *UniformPacketDelayDistribution) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := distribution.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &UniformPacketDelayDistribution{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (distribution *UniformPacketDelayDistribution) ToKurtosisType() (partition_topology.PacketDelayDistribution, *startosis_errors.InterpretationError) {
+	delayMs, interpretationErr := extractUint32Attr(distribution.KurtosisValueTypeDefault, UniformPacketDelayDistributionTypeName, MsAttr, true, 0)
+	if interpretationErr != nil {
+		return partition_topology.PacketDelayDistribution{}, interpretationErr
+	}
+	jitterMs, interpretationErr := extractUint32Attr(distribution.KurtosisValueTypeDefault, UniformPacketDelayDistributionTypeName, JitterAttr, false, defaultJitterMs)
+	if interpretationErr != nil {
+		return partition_topology.PacketDelayDistribution{}, interpretationErr
+	}
+	return partition_topology.NewUniformPacketDelayDistribution(delayMs, jitterMs), nil
+}
+
+func NewNormalPacketDelayDistributionType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: NormalPacketDelayDistributionTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              MeanMsAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, MeanMsAttr, minDelayMs, maxDelayMs)
+					},
+				},
+				{
+					Name:              StdDevAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, StdDevAttr, minDelayMs, maxDelayMs)
+					},
+				},
+				{
+					Name:              CorrelationAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Float],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.FloatInRange(value, CorrelationAttr, minCorrelation, maxCorrelation)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiateNormalPacketDelayDistribution,
+	}
+}
+
+func instantiateNormalPacketDelayDistribution(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(NormalPacketDelayDistributionTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &NormalPacketDelayDistribution{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// NormalPacketDelayDistribution delays each packet by a duration following a normal distribution of mean mean_ms and
+// standard deviation std_dev. correlation is the percentage by which the delay of a packet depends on the delay of the
+// previous one
+type NormalPacketDelayDistribution struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (distribution *NormalPacketDelayDistribution) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := distribution.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &NormalPacketDelayDistribution{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (distribution *NormalPacketDelayDistribution) ToKurtosisType() (partition_topology.PacketDelayDistribution, *startosis_errors.InterpretationError) {
+	meanMs, interpretationErr := extractUint32Attr(distribution.KurtosisValueTypeDefault, NormalPacketDelayDistributionTypeName, MeanMsAttr, true, 0)
+	if interpretationErr != nil {
+		return partition_topology.PacketDelayDistribution{}, interpretationErr
+	}
+	stdDevMs, interpretationErr := extractUint32Attr(distribution.KurtosisValueTypeDefault, NormalPacketDelayDistributionTypeName, StdDevAttr, false, defaultStdDevMs)
+	if interpretationErr != nil {
+		return partition_topology.PacketDelayDistribution{}, interpretationErr
+	}
+	correlation, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Float](distribution.KurtosisValueTypeDefault, CorrelationAttr)
+	if interpretationErr != nil {
+		return partition_topology.PacketDelayDistribution{}, interpretationErr
+	}
+	correlationValue := float32(defaultCorrelation)
+	if found {
+		correlationValue = float32(correlation)
+	}
+	return partition_topology.NewNormalPacketDelayDistribution(meanMs, stdDevMs, correlationValue), nil
+}
+
+func extractUint32Attr(
+	kurtosisValueType *kurtosis_type_constructor.KurtosisValueTypeDefault,
+	typeName string,
+	attrName string,
+	isRequired bool,
+	defaultValue uint32,
+) (uint32, *startosis_errors.InterpretationError) {
+	value, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](kurtosisValueType, attrName)
+	if interpretationErr != nil {
+		return 0, interpretationErr
+	}
+	if !found {
+		if isRequired {
+			return 0, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", attrName, typeName)
+		}
+		return defaultValue, nil
+	}
+	valueUint64, ok := value.Uint64()
+	if !ok || valueUint64 > math.MaxUint32 {
+		return 0, startosis_errors.NewInterpretationError("Attribute '%s' on type '%s' should fit in %d bits but was '%v'", attrName, typeName, uint32BitSize, value)
+	}
+	return uint32(valueUint64), nil
+}
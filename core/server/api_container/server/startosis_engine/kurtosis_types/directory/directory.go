@@ -6,6 +6,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
+	"math"
 )
 
 const (
@@ -13,6 +14,12 @@ const (
 
 	ArtifactNameAttr  = "artifact_name"
 	PersistentKeyAttr = "persistent_key"
+	SizeAttr          = "size"
+	StorageClassAttr  = "storage_class"
+
+	minimumSizeMegabytes = 1
+	// the size gets converted to bytes, which have to fit in an int64
+	maximumSizeMegabytes = math.MaxInt64 / (1024 * 1024)
 )
 
 func NewDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -37,6 +44,22 @@ func NewDirectoryType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 						return builtin_argument.NonEmptyString(value, ArtifactNameAttr)
 					},
 				},
+				{
+					Name:              SizeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, SizeAttr, minimumSizeMegabytes, maximumSizeMegabytes)
+					},
+				},
+				{
+					Name:              StorageClassAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, StorageClassAttr)
+					},
+				},
 			},
 		},
 
@@ -66,6 +89,8 @@ func CreateDirectoryFromFilesArtifact(
 	args := []starlark.Value{
 		starlark.String(filesArtifactName),
 		nil,
+		nil,
+		nil,
 	}
 
 	argumentDefinitions := NewDirectoryType().KurtosisBaseBuiltin.Arguments
@@ -112,3 +137,32 @@ func (directory *Directory) GetPersistentKeyIfSet() (string, bool, *startosis_er
 	}
 	return persistentKey.GoString(), true, nil
 }
+
+// GetSizeInMegabytesIfSet returns the size requested for the persistent directory, in megabytes
+func (directory *Directory) GetSizeInMegabytesIfSet() (uint64, bool, *startosis_errors.InterpretationError) {
+	size, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		directory.KurtosisValueTypeDefault, SizeAttr)
+	if interpretationErr != nil {
+		return 0, false, interpretationErr
+	}
+	if !found {
+		return 0, false, nil
+	}
+	sizeUint64, ok := size.Uint64()
+	if !ok {
+		return 0, false, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", SizeAttr, size)
+	}
+	return sizeUint64, true, nil
+}
+
+func (directory *Directory) GetStorageClassIfSet() (string, bool, *startosis_errors.InterpretationError) {
+	storageClass, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		directory.KurtosisValueTypeDefault, StorageClassAttr)
+	if interpretationErr != nil {
+		return "", false, interpretationErr
+	}
+	if !found {
+		return "", false, nil
+	}
+	return storageClass.GoString(), true, nil
+}
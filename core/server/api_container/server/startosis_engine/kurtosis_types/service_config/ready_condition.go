@@ -118,6 +118,10 @@ func (readyCondition *ReadyCondition) GetRecipe() (recipe.Recipe, *startosis_err
 	if interpretationErr == nil {
 		return execRecipe, nil
 	}
+	probeRecipe, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[recipe.ProbeRecipe](readyCondition.KurtosisValueTypeDefault, RecipeAttr)
+	if interpretationErr == nil {
+		return probeRecipe, nil
+	}
 	return nil, interpretationErr
 }
 
@@ -163,6 +167,11 @@ func (readyCondition *ReadyCondition) GetTarget() (starlark.Comparable, *startos
 }
 
 func (readyCondition *ReadyCondition) GetInterval() (time.Duration, *startosis_errors.InterpretationError) {
+	return readyCondition.GetIntervalOrDefault(defaultInterval)
+}
+
+// GetIntervalOrDefault is used when the condition is not a readiness check, which defaults to a different interval
+func (readyCondition *ReadyCondition) GetIntervalOrDefault(defaultInterval time.Duration) (time.Duration, *startosis_errors.InterpretationError) {
 	interval := defaultInterval
 
 	intervalStr, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](readyCondition.KurtosisValueTypeDefault, IntervalAttr)
@@ -181,6 +190,11 @@ func (readyCondition *ReadyCondition) GetInterval() (time.Duration, *startosis_e
 }
 
 func (readyCondition *ReadyCondition) GetTimeout() (time.Duration, *startosis_errors.InterpretationError) {
+	return readyCondition.GetTimeoutOrDefault(defaultTimeout)
+}
+
+// GetTimeoutOrDefault is used when the condition is not a readiness check, which defaults to a different timeout
+func (readyCondition *ReadyCondition) GetTimeoutOrDefault(defaultTimeout time.Duration) (time.Duration, *startosis_errors.InterpretationError) {
 	timeout := defaultTimeout
 
 	timeoutStr, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](readyCondition.KurtosisValueTypeDefault, TimeoutAttr)
@@ -202,8 +216,9 @@ func validateRecipe(value starlark.Value) *startosis_errors.InterpretationError
 	_, ok := value.(recipe.HttpRequestRecipe)
 	if !ok {
 		//TODO we should rework the recipe types to inherit a single common type, this will avoid the double parsing here.
-		_, ok := value.(*recipe.ExecRecipe)
-		if !ok {
+		_, isExecRecipe := value.(*recipe.ExecRecipe)
+		_, isProbeRecipe := value.(recipe.ProbeRecipe)
+		if !isExecRecipe && !isProbeRecipe {
 			return startosis_errors.NewInterpretationError("The '%s' attribute is not a Recipe (was '%s').", RecipeAttr, reflect.TypeOf(value))
 		}
 	}
@@ -7,17 +7,21 @@ import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service_directory"
 	"github.com/kurtosis-tech/kurtosis/core/files_artifacts_expander/args"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network/service_health"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/directory"
 	starlark_port_spec "github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/port_spec"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_toleration"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types/service_user"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_warning"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
 	"go.starlark.net/starlark"
 	"math"
 	"path"
+	"strings"
 )
 
 const (
@@ -38,14 +42,42 @@ const (
 	MinMemoryMegaBytesAttr          = "min_memory"
 	MaxCpuMilliCoresAttr            = "max_cpu"
 	MaxMemoryMegaBytesAttr          = "max_memory"
+	LivenessAttr                    = "liveness"
+	RestartPolicyAttr               = "restart_policy"
+	MaxRestartsAttr                 = "max_restarts"
+	UserAttr                        = "user"
+	LabelsAttr                      = "labels"
+	CapabilitiesAttr                = "capabilities"
+	SysctlsAttr                     = "sysctls"
+	UlimitsAttr                     = "ulimits"
+	TmpfsAttr                       = "tmpfs"
+	ShmSizeAttr                     = "shm_size"
+	NodeSelectorsAttr               = "node_selectors"
+	TolerationsAttr                 = "tolerations"
+	AffinityAttr                    = "affinity"
+	AntiAffinityAttr                = "anti_affinity"
 
 	DefaultPrivateIPAddrPlaceholder = "KURTOSIS_IP_ADDR_PLACEHOLDER"
 
 	filesArtifactExpansionDirsParentDirpath string = "/files-artifacts"
+	// The expander of a persistent directory seed expands it directly into the volume backing the persistent directory
+	persistentDirectorySeedExpansionDirsParentDirpath string = "/persistent-directory-seeds"
 	// TODO This should be populated from the build flow that builds the files-artifacts-expander Docker image
 	filesArtifactsExpanderImage string = "kurtosistech/files-artifacts-expander"
 
 	minimumMemoryAllocationMegabytes = 6
+
+	minimumShmSizeMegabytes = 1
+
+	// Capabilities can be written with or without the prefix Linux uses, e.g. CAP_NET_ADMIN or NET_ADMIN
+	linuxCapabilityPrefix = "CAP_"
+
+	bytesInMegabyte = 1024 * 1024
+
+	alwaysExpand = false
+	// Seeding must never overwrite the data of a persistent directory, e.g. if Kubernetes re-runs the init containers
+	// of a pod that mounts an already seeded persistent directory
+	skipAlreadySeededDirs = true
 )
 
 func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
@@ -162,6 +194,96 @@ func NewServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[*ReadyCondition],
 					Validator:         nil,
 				},
+				{
+					Name:              LivenessAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*ReadyCondition],
+					Validator:         nil,
+				},
+				{
+					Name:              RestartPolicyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, RestartPolicyAttr, service_health.RestartPolicyStrings())
+					},
+				},
+				{
+					Name:              MaxRestartsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, MaxRestartsAttr, 0, math.MaxUint32)
+					},
+				},
+				{
+					Name:              UserAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*service_user.User],
+					Validator:         nil,
+				},
+				{
+					Name:              LabelsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              CapabilitiesAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              SysctlsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              UlimitsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              TmpfsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              ShmSizeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, ShmSizeAttr, minimumShmSizeMegabytes, math.MaxUint64)
+					},
+				},
+				{
+					Name:              NodeSelectorsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator:         nil,
+				},
+				{
+					Name:              TolerationsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              AffinityAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
+				{
+					Name:              AntiAffinityAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.List],
+					Validator:         nil,
+				},
 			},
 		},
 
@@ -195,14 +317,24 @@ func (config *ServiceConfig) Copy() (builtin_argument.KurtosisValueType, error)
 }
 
 func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string]string, serviceNetwork service_network.ServiceNetwork) (*service_directory.FilesArtifactsExpansion, *startosis_errors.InterpretationError) {
+	return convertFilesArtifactsMountsExpandingTo(filesArtifactExpansionDirsParentDirpath, alwaysExpand, filesArtifactsMountDirpathsMap, serviceNetwork)
+}
+
+func convertFilesArtifactsMountsExpandingTo(
+	expansionDirsParentDirpath string,
+	shouldSkipNonEmptyDirs bool,
+	filesArtifactsMountDirpathsMap map[string]string,
+	serviceNetwork service_network.ServiceNetwork,
+) (*service_directory.FilesArtifactsExpansion, *startosis_errors.InterpretationError) {
 	filesArtifactsExpansions := []args.FilesArtifactExpansion{}
 	serviceDirpathsToArtifactIdentifiers := map[string]string{}
 	expanderDirpathToUserServiceDirpathMap := map[string]string{}
 	for mountpointOnUserService, filesArtifactIdentifier := range filesArtifactsMountDirpathsMap {
-		dirpathToExpandTo := path.Join(filesArtifactExpansionDirsParentDirpath, filesArtifactIdentifier)
+		dirpathToExpandTo := path.Join(expansionDirsParentDirpath, filesArtifactIdentifier)
 		expansion := args.FilesArtifactExpansion{
-			FilesIdentifier:   filesArtifactIdentifier,
-			DirPathToExpandTo: dirpathToExpandTo,
+			FilesIdentifier:           filesArtifactIdentifier,
+			DirPathToExpandTo:         dirpathToExpandTo,
+			ShouldSkipIfDirIsNotEmpty: shouldSkipNonEmptyDirs,
 		}
 		filesArtifactsExpansions = append(filesArtifactsExpansions, expansion)
 		serviceDirpathsToArtifactIdentifiers[mountpointOnUserService] = filesArtifactIdentifier
@@ -241,12 +373,30 @@ func ConvertFilesArtifactsMounts(filesArtifactsMountDirpathsMap map[string]strin
 	}, nil
 }
 
-func convertPersistentDirectoryMounts(persistentDirectoriesDirpathsMap map[string]string) *service_directory.PersistentDirectories {
-	persistentDirectoriesMap := map[string]service_directory.DirectoryPersistentKey{}
-	for dirPath, persistentKeyStr := range persistentDirectoriesDirpathsMap {
-		persistentDirectoriesMap[dirPath] = service_directory.DirectoryPersistentKey(persistentKeyStr)
+// Each seeded persistent directory gets its own files artifacts expansion, as the backend only runs it when the volume
+// backing the persistent directory gets created
+func convertPersistentDirectoryMounts(
+	persistentDirectoriesDirpathsMap map[string]service_directory.PersistentDirectory,
+	persistentDirectoriesSeedsMap map[string]string,
+	serviceNetwork service_network.ServiceNetwork,
+) (*service_directory.PersistentDirectories, *startosis_errors.InterpretationError) {
+	persistentDirectoriesMap := map[string]service_directory.PersistentDirectory{}
+	for dirPath, persistentDirectory := range persistentDirectoriesDirpathsMap {
+		if seedFilesArtifactIdentifier, found := persistentDirectoriesSeedsMap[dirPath]; found {
+			seedFilesArtifactExpansion, interpretationErr := convertFilesArtifactsMountsExpandingTo(
+				persistentDirectorySeedExpansionDirsParentDirpath,
+				skipAlreadySeededDirs,
+				map[string]string{dirPath: seedFilesArtifactIdentifier},
+				serviceNetwork,
+			)
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			persistentDirectory.SeedFilesArtifactExpansion = seedFilesArtifactExpansion
+		}
+		persistentDirectoriesMap[dirPath] = persistentDirectory
 	}
-	return service_directory.NewPersistentDirectories(persistentDirectoriesMap)
+	return service_directory.NewPersistentDirectories(persistentDirectoriesMap), nil
 }
 
 func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.ServiceNetwork) (*service.ServiceConfig, *startosis_errors.InterpretationError) {
@@ -293,14 +443,16 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 
 	var filesArtifactExpansions *service_directory.FilesArtifactsExpansion
 	var persistentDirectories *service_directory.PersistentDirectories
+	filesDirpaths := map[string]bool{}
 	filesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, FilesAttr)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
 	if found {
 		var filesArtifactsMountDirpathsMap map[string]string
-		var persistentDirectoriesDirpathsMap map[string]string
-		filesArtifactsMountDirpathsMap, persistentDirectoriesDirpathsMap, interpretationErr = convertFilesArguments(FilesAttr, filesStarlark)
+		var persistentDirectoriesDirpathsMap map[string]service_directory.PersistentDirectory
+		var persistentDirectoriesSeedsMap map[string]string
+		filesArtifactsMountDirpathsMap, persistentDirectoriesDirpathsMap, persistentDirectoriesSeedsMap, interpretationErr = convertFilesArguments(FilesAttr, filesStarlark)
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
@@ -308,7 +460,16 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 		if interpretationErr != nil {
 			return nil, interpretationErr
 		}
-		persistentDirectories = convertPersistentDirectoryMounts(persistentDirectoriesDirpathsMap)
+		persistentDirectories, interpretationErr = convertPersistentDirectoryMounts(persistentDirectoriesDirpathsMap, persistentDirectoriesSeedsMap, serviceNetwork)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		for dirpath := range filesArtifactsMountDirpathsMap {
+			filesDirpaths[dirpath] = true
+		}
+		for dirpath := range persistentDirectoriesDirpathsMap {
+			filesDirpaths[dirpath] = true
+		}
 	}
 
 	var entryPointArgs []string
@@ -434,6 +595,138 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 		minMemory = 0
 	}
 
+	var user *service.ServiceUser
+	userStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*service_user.User](config.KurtosisValueTypeDefault, UserAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		user, interpretationErr = userStarlark.ToKurtosisType()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	labels := map[string]string{}
+	labelsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, LabelsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && labelsStarlark.Len() > 0 {
+		labels, interpretationErr = kurtosis_types.SafeCastToMapStringString(labelsStarlark, LabelsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	var capabilities []string
+	capabilitiesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, CapabilitiesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && capabilitiesStarlark.Len() > 0 {
+		capabilities, interpretationErr = convertCapabilities(capabilitiesStarlark)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	sysctls := map[string]string{}
+	sysctlsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, SysctlsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && sysctlsStarlark.Len() > 0 {
+		sysctls, interpretationErr = kurtosis_types.SafeCastToMapStringString(sysctlsStarlark, SysctlsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	ulimits := map[string]uint64{}
+	ulimitsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, UlimitsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && ulimitsStarlark.Len() > 0 {
+		ulimits, interpretationErr = kurtosis_types.SafeCastToMapStringUint64(ulimitsStarlark, UlimitsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	tmpfsDirs := map[string]uint64{}
+	tmpfsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, TmpfsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && tmpfsStarlark.Len() > 0 {
+		tmpfsDirs, interpretationErr = kurtosis_types.SafeCastToMapStringUint64(tmpfsStarlark, TmpfsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		for dirpath := range tmpfsDirs {
+			if !path.IsAbs(dirpath) {
+				return nil, startosis_errors.NewInterpretationError("The '%s' dirpath '%s' needs to be an absolute path", TmpfsAttr, dirpath)
+			}
+			if filesDirpaths[dirpath] {
+				return nil, startosis_errors.NewInterpretationError("The '%s' dirpath '%s' is also in '%s'; a directory can't be both", TmpfsAttr, dirpath, FilesAttr)
+			}
+		}
+	}
+
+	var shmSize uint64
+	shmSizeStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, ShmSizeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		shmSize, ok = shmSizeStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", ShmSizeAttr, shmSizeStarlark)
+		}
+	}
+
+	nodeSelectors := map[string]string{}
+	nodeSelectorsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](config.KurtosisValueTypeDefault, NodeSelectorsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && nodeSelectorsStarlark.Len() > 0 {
+		nodeSelectors, interpretationErr = kurtosis_types.SafeCastToMapStringString(nodeSelectorsStarlark, NodeSelectorsAttr)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	var tolerations []service.Toleration
+	tolerationsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, TolerationsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found && tolerationsStarlark.Len() > 0 {
+		tolerations, interpretationErr = convertTolerations(tolerationsStarlark)
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+	}
+
+	affinityServiceNames, interpretationErr := config.getServiceNames(AffinityAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	antiAffinityServiceNames, interpretationErr := config.getServiceNames(AntiAffinityAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for _, affinityServiceName := range affinityServiceNames {
+		for _, antiAffinityServiceName := range antiAffinityServiceNames {
+			if affinityServiceName == antiAffinityServiceName {
+				return nil, startosis_errors.NewInterpretationError("Service '%s' is both in '%s' and '%s'; a service can't be both", affinityServiceName, AffinityAttr, AntiAffinityAttr)
+			}
+		}
+	}
+
 	return service.NewServiceConfig(
 		imageName,
 		privatePorts,
@@ -448,6 +741,17 @@ func (config *ServiceConfig) ToKurtosisType(serviceNetwork service_network.Servi
 		privateIpAddressPlaceholder,
 		minCpu,
 		minMemory,
+		user,
+		labels,
+		capabilities,
+		sysctls,
+		ulimits,
+		tmpfsDirs,
+		shmSize,
+		nodeSelectors,
+		tolerations,
+		affinityServiceNames,
+		antiAffinityServiceNames,
 	), nil
 }
 
@@ -463,6 +767,106 @@ func (config *ServiceConfig) GetReadyCondition() (*ReadyCondition, *startosis_er
 	return readyConditions, nil
 }
 
+// GetLiveness returns the condition checked periodically once the service is ready, or nil if the service has none
+func (config *ServiceConfig) GetLiveness() (*ReadyCondition, *startosis_errors.InterpretationError) {
+	liveness, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*ReadyCondition](config.KurtosisValueTypeDefault, LivenessAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, nil
+	}
+	return liveness, nil
+}
+
+// GetRestartPolicy returns the restart policy of the service along with its max number of restarts, which is only
+// relevant to the on-failure policy
+func (config *ServiceConfig) GetRestartPolicy() (service_health.RestartPolicy, uint32, *startosis_errors.InterpretationError) {
+	restartPolicy := service_health.RestartPolicy_Never
+	restartPolicyStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, RestartPolicyAttr)
+	if interpretationErr != nil {
+		return "", 0, interpretationErr
+	}
+	if found {
+		restartPolicy = service_health.RestartPolicy(restartPolicyStarlark.GoString())
+	}
+
+	maxRestarts := service_health.NoMaxRestarts
+	maxRestartsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](config.KurtosisValueTypeDefault, MaxRestartsAttr)
+	if interpretationErr != nil {
+		return "", 0, interpretationErr
+	}
+	if found {
+		if restartPolicy != service_health.RestartPolicy_OnFailure {
+			return "", 0, startosis_errors.NewInterpretationError("Attribute '%s' can only be set when '%s' is '%s' (was '%s')",
+				MaxRestartsAttr, RestartPolicyAttr, service_health.RestartPolicy_OnFailure, restartPolicy)
+		}
+		maxRestartsUint64, ok := maxRestartsStarlark.Uint64()
+		if !ok {
+			return "", 0, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", MaxRestartsAttr, maxRestartsStarlark)
+		}
+		maxRestarts = uint32(maxRestartsUint64)
+	}
+	return restartPolicy, maxRestarts, nil
+}
+
+// convertCapabilities normalizes the capabilities to the upper case names without the Linux prefix, e.g. cap_net_admin
+// becomes NET_ADMIN, which both backends accept
+func convertCapabilities(capabilitiesList *starlark.List) ([]string, *startosis_errors.InterpretationError) {
+	capabilityStrs, interpretationErr := kurtosis_types.SafeCastToStringSlice(capabilitiesList, CapabilitiesAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	capabilities := []string{}
+	for _, capabilityStr := range capabilityStrs {
+		capability := strings.TrimPrefix(strings.ToUpper(strings.TrimSpace(capabilityStr)), linuxCapabilityPrefix)
+		if capability == "" {
+			return nil, startosis_errors.NewInterpretationError("The '%s' list contains an empty capability: '%v'", CapabilitiesAttr, capabilitiesList)
+		}
+		capabilities = append(capabilities, capability)
+	}
+	return capabilities, nil
+}
+
+func convertTolerations(tolerationsList *starlark.List) ([]service.Toleration, *startosis_errors.InterpretationError) {
+	tolerations := []service.Toleration{}
+	for index := 0; index < tolerationsList.Len(); index++ {
+		tolerationStarlark, ok := tolerationsList.Index(index).(*service_toleration.Toleration)
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("The '%s' list must only contain '%s' objects but it was: '%v'", TolerationsAttr, service_toleration.TolerationTypeName, tolerationsList)
+		}
+		toleration, interpretationErr := tolerationStarlark.ToKurtosisType()
+		if interpretationErr != nil {
+			return nil, interpretationErr
+		}
+		tolerations = append(tolerations, *toleration)
+	}
+	return tolerations, nil
+}
+
+// getServiceNames returns the names of the services listed in the attribute, or nil if it isn't set
+func (config *ServiceConfig) getServiceNames(attrName string) ([]service.ServiceName, *startosis_errors.InterpretationError) {
+	serviceNamesStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.List](config.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found || serviceNamesStarlark.Len() == 0 {
+		return nil, nil
+	}
+	serviceNameStrs, interpretationErr := kurtosis_types.SafeCastToStringSlice(serviceNamesStarlark, attrName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	serviceNames := []service.ServiceName{}
+	for _, serviceNameStr := range serviceNameStrs {
+		if serviceNameStr == "" {
+			return nil, startosis_errors.NewInterpretationError("The '%s' list contains an empty service name: '%v'", attrName, serviceNamesStarlark)
+		}
+		serviceNames = append(serviceNames, service.ServiceName(serviceNameStr))
+	}
+	return serviceNames, nil
+}
+
 func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value starlark.Value, dictForLogging *starlark.Dict) (string, *port_spec.PortSpec, *startosis_errors.InterpretationError) {
 	keyStr, ok := key.(starlark.String)
 	if !ok {
@@ -479,14 +883,17 @@ func convertPortMapEntry(attrNameForLogging string, key starlark.Value, value st
 	return keyStr.GoString(), servicePortSpec, nil
 }
 
-func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string]string, map[string]string, *startosis_errors.InterpretationError) {
+// convertFilesArguments splits the files dictionary into the files artifacts to mount, the persistent directories to
+// mount and, for the persistent directories seeded from a files artifact, the files artifact they're seeded from
+func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict) (map[string]string, map[string]service_directory.PersistentDirectory, map[string]string, *startosis_errors.InterpretationError) {
 	filesArtifacts := map[string]string{}
-	persistentDirectories := map[string]string{}
+	persistentDirectories := map[string]service_directory.PersistentDirectory{}
+	persistentDirectoriesSeeds := map[string]string{}
 	for _, fileItem := range filesDict.Items() {
 		rawDirPath := fileItem[0]
 		dirPath, ok := rawDirPath.(starlark.String)
 		if !ok {
-			return nil, nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, filesDict)
+			return nil, nil, nil, startosis_errors.NewInterpretationError("Unable to convert key of '%s' dictionary '%v' to string", attrNameForLogging, filesDict)
 		}
 
 		var interpretationErr *startosis_errors.InterpretationError
@@ -496,32 +903,56 @@ func convertFilesArguments(attrNameForLogging string, filesDict *starlark.Dict)
 			// we're also supporting raw strings as well and transform them into files artifact name.
 			directoryObjAsStr, isSimpleStringArg := rawDirectoryObj.(starlark.String)
 			if !isSimpleStringArg {
-				return nil, nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a Directory object", attrNameForLogging, filesDict)
+				return nil, nil, nil, startosis_errors.NewInterpretationError("Unable to convert value of '%s' dictionary '%v' to a Directory object", attrNameForLogging, filesDict)
 			}
 			directoryObj, interpretationErr = directory.CreateDirectoryFromFilesArtifact(directoryObjAsStr.GoString())
 			if interpretationErr != nil {
-				return nil, nil, interpretationErr
+				return nil, nil, nil, interpretationErr
 			}
 		}
 		artifactName, artifactNameSet, interpretationErr := directoryObj.GetArtifactNameIfSet()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
 		}
 		persistentKey, persistentKeySet, interpretationErr := directoryObj.GetPersistentKeyIfSet()
 		if interpretationErr != nil {
-			return nil, nil, interpretationErr
+			return nil, nil, nil, interpretationErr
+		}
+		sizeInMegabytes, sizeSet, interpretationErr := directoryObj.GetSizeInMegabytesIfSet()
+		if interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
+		}
+		storageClass, storageClassSet, interpretationErr := directoryObj.GetStorageClassIfSet()
+		if interpretationErr != nil {
+			return nil, nil, nil, interpretationErr
 		}
-		if artifactNameSet == persistentKeySet {
-			// this condition is a XOR
-			return nil, nil, startosis_errors.NewInterpretationError("Parameter '%s' and '%s' cannot be set on the same '%s' object: '%s'",
+		if !artifactNameSet && !persistentKeySet {
+			return nil, nil, nil, startosis_errors.NewInterpretationError("At least one of parameters '%s' and '%s' needs to be set on the '%s' object: '%s'",
 				directory.ArtifactNameAttr, directory.PersistentKeyAttr, directory.DirectoryTypeName, directoryObj.String())
 		}
-		if artifactNameSet {
+		if !persistentKeySet {
+			if sizeSet || storageClassSet {
+				return nil, nil, nil, startosis_errors.NewInterpretationError("Parameters '%s' and '%s' can only be set on a '%s' object that has '%s' set: '%s'",
+					directory.SizeAttr, directory.StorageClassAttr, directory.DirectoryTypeName, directory.PersistentKeyAttr, directoryObj.String())
+			}
 			filesArtifacts[dirPath.GoString()] = artifactName
-		} else {
-			// persistentKey is necessarily set since we checked the exclusivity above
-			persistentDirectories[dirPath.GoString()] = persistentKey
+			continue
+		}
+
+		size := service_directory.DirectoryPersistentSize(0)
+		if sizeSet {
+			size = service_directory.DirectoryPersistentSize(sizeInMegabytes * bytesInMegabyte)
+		}
+		persistentDirectories[dirPath.GoString()] = service_directory.PersistentDirectory{
+			PersistentKey:              service_directory.DirectoryPersistentKey(persistentKey),
+			Size:                       size,
+			StorageClass:               storageClass,
+			SeedFilesArtifactExpansion: nil,
+		}
+		if artifactNameSet {
+			// the artifact is only used to seed the persistent directory when it gets created
+			persistentDirectoriesSeeds[dirPath.GoString()] = artifactName
 		}
 	}
-	return filesArtifacts, persistentDirectories, nil
+	return filesArtifacts, persistentDirectories, persistentDirectoriesSeeds, nil
 }
@@ -0,0 +1,72 @@
+package service_config
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+)
+
+const (
+	UpdateServiceConfigTypeName = "UpdateServiceConfig"
+
+	SubnetworkAttr = "subnetwork"
+)
+
+func NewUpdateServiceConfigType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: UpdateServiceConfigTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              SubnetworkAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SubnetworkAttr)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiateUpdateServiceConfig,
+	}
+}
+
+func instantiateUpdateServiceConfig(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(UpdateServiceConfigTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &UpdateServiceConfig{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// UpdateServiceConfig holds the attributes of a running service that can be changed without restarting it
+type UpdateServiceConfig struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (config *UpdateServiceConfig) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := config.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &UpdateServiceConfig{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (config *UpdateServiceConfig) GetSubnetwork() (string, *startosis_errors.InterpretationError) {
+	subnetwork, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](config.KurtosisValueTypeDefault, SubnetworkAttr)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", SubnetworkAttr, UpdateServiceConfigTypeName)
+	}
+	return subnetwork.GoString(), nil
+}
@@ -0,0 +1,164 @@
+package service_toleration
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+)
+
+const (
+	TolerationTypeName = "Toleration"
+
+	KeyAttr               = "key"
+	OperatorAttr          = "operator"
+	ValueAttr             = "value"
+	EffectAttr            = "effect"
+	TolerationSecondsAttr = "toleration_seconds"
+)
+
+func NewTolerationType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: TolerationTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              KeyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+				{
+					Name:              OperatorAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, OperatorAttr, service.TolerationOperatorStrings())
+					},
+				},
+				{
+					Name:              ValueAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator:         nil,
+				},
+				{
+					Name:              EffectAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, EffectAttr, service.TolerationEffectStrings())
+					},
+				},
+				{
+					Name:              TolerationSecondsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, TolerationSecondsAttr, 0, math.MaxInt64)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiate,
+	}
+}
+
+func instantiate(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(TolerationTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	toleration := &Toleration{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}
+	// Validating the attributes together here so that the error points at the Toleration and not at the service using it
+	if _, interpretationErr := toleration.ToKurtosisType(); interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return toleration, nil
+}
+
+// Toleration lets a service be scheduled on the Kubernetes nodes having a matching taint
+type Toleration struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (toleration *Toleration) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := toleration.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &Toleration{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (toleration *Toleration) ToKurtosisType() (*service.Toleration, *startosis_errors.InterpretationError) {
+	key, interpretationErr := toleration.getStringAttr(KeyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	operator := service.TolerationOperator_Equal
+	operatorStr, interpretationErr := toleration.getStringAttr(OperatorAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if operatorStr != "" {
+		operator = service.TolerationOperator(operatorStr)
+	}
+
+	value, interpretationErr := toleration.getStringAttr(ValueAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	effectStr, interpretationErr := toleration.getStringAttr(EffectAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	effect := service.TolerationEffect(effectStr)
+
+	var tolerationSecondsPtr *int64
+	tolerationSecondsStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](toleration.KurtosisValueTypeDefault, TolerationSecondsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		tolerationSeconds, ok := tolerationSecondsStarlark.Int64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to int64", TolerationSecondsAttr, tolerationSecondsStarlark)
+		}
+		tolerationSecondsPtr = &tolerationSeconds
+	}
+
+	if operator == service.TolerationOperator_Exists && value != "" {
+		return nil, startosis_errors.NewInterpretationError("The '%s' of a '%s' must be empty when its '%s' is '%s'", ValueAttr, TolerationTypeName, OperatorAttr, service.TolerationOperator_Exists)
+	}
+	if operator == service.TolerationOperator_Equal && key == "" {
+		return nil, startosis_errors.NewInterpretationError("The '%s' of a '%s' can only be empty when its '%s' is '%s'", KeyAttr, TolerationTypeName, OperatorAttr, service.TolerationOperator_Exists)
+	}
+	if tolerationSecondsPtr != nil && effect != service.TolerationEffect_NoExecute {
+		return nil, startosis_errors.NewInterpretationError("The '%s' of a '%s' can only be set when its '%s' is '%s'", TolerationSecondsAttr, TolerationTypeName, EffectAttr, service.TolerationEffect_NoExecute)
+	}
+
+	kurtosisToleration := service.NewToleration(key, operator, value, effect, tolerationSecondsPtr)
+	return &kurtosisToleration, nil
+}
+
+func (toleration *Toleration) getStringAttr(attrName string) (string, *startosis_errors.InterpretationError) {
+	attrValue, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](toleration.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", nil
+	}
+	return attrValue.GoString(), nil
+}
@@ -0,0 +1,101 @@
+package service_user
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"math"
+)
+
+const (
+	UserTypeName = "User"
+
+	UIDAttr = "uid"
+	GIDAttr = "gid"
+)
+
+func NewUserType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: UserTypeName,
+
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              UIDAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, UIDAttr, 0, math.MaxUint32)
+					},
+				},
+				{
+					Name:              GIDAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Uint64InRange(value, GIDAttr, 0, math.MaxUint32)
+					},
+				},
+			},
+		},
+
+		Instantiate: instantiate,
+	}
+}
+
+func instantiate(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(UserTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &User{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// User is the user, and optionally the group, the service container runs as
+type User struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (user *User) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := user.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &User{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (user *User) ToKurtosisType() (*service.ServiceUser, *startosis_errors.InterpretationError) {
+	uidStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](user.KurtosisValueTypeDefault, UIDAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Required attribute '%s' could not be found on type '%s'", UIDAttr, UserTypeName)
+	}
+	uid, ok := uidStarlark.Uint64()
+	if !ok {
+		return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", UIDAttr, uidStarlark)
+	}
+
+	var gidPtr *uint32
+	gidStarlark, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](user.KurtosisValueTypeDefault, GIDAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		gid, ok := gidStarlark.Uint64()
+		if !ok {
+			return nil, startosis_errors.NewInterpretationError("An error occurred parsing field '%v' with value '%v' to uint64", GIDAttr, gidStarlark)
+		}
+		gidUint32 := uint32(gid)
+		gidPtr = &gidUint32
+	}
+	return service.NewServiceUser(uint32(uid), gidPtr), nil
+}
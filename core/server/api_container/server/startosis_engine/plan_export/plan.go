@@ -0,0 +1,112 @@
+package plan_export
+
+import (
+	"encoding/json"
+
+	"github.com/kurtosis-tech/stacktrace"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	jsonIndent = "  "
+	jsonPrefix = ""
+)
+
+// Plan describes what running a Starlark script or package would do to an enclave, without running it.
+//
+// Values that are only known once the instructions producing them are executed, like the IP address of a service or
+// the body of the response to a request, are symbolic references to these instructions, such as
+// '{{instructions[2].ip_address}}'
+type Plan struct {
+	Services       []*Service       `json:"services" yaml:"services"`
+	Tasks          []*Task          `json:"tasks" yaml:"tasks"`
+	FilesArtifacts []*FilesArtifact `json:"filesArtifacts" yaml:"filesArtifacts"`
+	Images         []string         `json:"images" yaml:"images"`
+	Instructions   []*Instruction   `json:"instructions" yaml:"instructions"`
+}
+
+type Service struct {
+	Name       string        `json:"name" yaml:"name"`
+	Image      string        `json:"image" yaml:"image"`
+	Ports      []*Port       `json:"ports,omitempty" yaml:"ports,omitempty"`
+	EnvVars    []*EnvVar     `json:"envVars,omitempty" yaml:"envVars,omitempty"`
+	Files      []*FilesMount `json:"files,omitempty" yaml:"files,omitempty"`
+	Entrypoint []string      `json:"entrypoint,omitempty" yaml:"entrypoint,omitempty"`
+	Cmd        []string      `json:"cmd,omitempty" yaml:"cmd,omitempty"`
+
+	// The index of the instruction adding the service
+	AddedBy int `json:"addedBy" yaml:"addedBy"`
+
+	// The index of the instruction removing the service, nil if it isn't removed
+	RemovedBy *int `json:"removedBy,omitempty" yaml:"removedBy,omitempty"`
+}
+
+// Task is a one-off container run by a 'run_sh' or a 'run_python' instruction
+type Task struct {
+	Image   string        `json:"image" yaml:"image"`
+	Command string        `json:"command" yaml:"command"`
+	EnvVars []*EnvVar     `json:"envVars,omitempty" yaml:"envVars,omitempty"`
+	Files   []*FilesMount `json:"files,omitempty" yaml:"files,omitempty"`
+
+	// The files artifacts the task stores once it's done
+	Store []string `json:"store,omitempty" yaml:"store,omitempty"`
+
+	// The index of the instruction running the task
+	RunBy int `json:"runBy" yaml:"runBy"`
+}
+
+type Port struct {
+	Id                  string `json:"id" yaml:"id"`
+	Number              uint16 `json:"number" yaml:"number"`
+	TransportProtocol   string `json:"transportProtocol" yaml:"transportProtocol"`
+	ApplicationProtocol string `json:"applicationProtocol,omitempty" yaml:"applicationProtocol,omitempty"`
+}
+
+type EnvVar struct {
+	Key   string `json:"key" yaml:"key"`
+	Value string `json:"value" yaml:"value"`
+}
+
+type FilesMount struct {
+	MountPath     string `json:"mountPath" yaml:"mountPath"`
+	FilesArtifact string `json:"filesArtifact" yaml:"filesArtifact"`
+}
+
+type FilesArtifact struct {
+	Name string `json:"name" yaml:"name"`
+
+	// The files the artifact is made of, when known before running the plan
+	Files []string `json:"files,omitempty" yaml:"files,omitempty"`
+
+	// The service the files are copied from, if any
+	Service string `json:"service,omitempty" yaml:"service,omitempty"`
+
+	// The index of the instruction creating the files artifact
+	CreatedBy int `json:"createdBy" yaml:"createdBy"`
+}
+
+type Instruction struct {
+	Index    int    `json:"index" yaml:"index"`
+	Type     string `json:"type" yaml:"type"`
+	Position string `json:"position" yaml:"position"`
+	Code     string `json:"code" yaml:"code"`
+
+	// The indices of the instructions that must be done executing before this one can start executing
+	DependsOn []int `json:"dependsOn" yaml:"dependsOn"`
+}
+
+func (plan *Plan) ToYaml() (string, error) {
+	serializedPlan, err := yaml.Marshal(plan)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the plan to YAML")
+	}
+	return string(serializedPlan), nil
+}
+
+func (plan *Plan) ToJson() (string, error) {
+	serializedPlan, err := json.MarshalIndent(plan, jsonPrefix, jsonIndent)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred serializing the plan to JSON")
+	}
+	return string(serializedPlan), nil
+}
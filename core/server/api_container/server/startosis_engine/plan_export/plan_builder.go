@@ -0,0 +1,218 @@
+package plan_export
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+)
+
+const (
+	symbolicReferenceFormat = "{{instructions[%d].%s}}"
+)
+
+// PlanBuilder builds the plan instruction by instruction. Each instruction is added with AddInstruction, and then
+// adds to the plan the services, tasks and files artifacts it creates or removes
+type PlanBuilder struct {
+	services       []*Service
+	tasks          []*Task
+	filesArtifacts []*FilesArtifact
+	images         map[string]bool
+	instructions   []*Instruction
+
+	// the index of the instruction returning each runtime value, by runtime value UUID
+	runtimeValueProducers map[string]int
+}
+
+func NewPlanBuilder() *PlanBuilder {
+	return &PlanBuilder{
+		services:              []*Service{},
+		tasks:                 []*Task{},
+		filesArtifacts:        []*FilesArtifact{},
+		images:                map[string]bool{},
+		instructions:          []*Instruction{},
+		runtimeValueProducers: map[string]int{},
+	}
+}
+
+// AddInstruction adds an instruction to the plan, along with the UUIDs of the runtime values it returns so that the
+// following instructions can reference them symbolically
+func (builder *PlanBuilder) AddInstruction(instructionType string, position string, code string, dependsOn []int, returnedRuntimeValueUuids []string) {
+	index := len(builder.instructions)
+	builder.instructions = append(builder.instructions, &Instruction{
+		Index:     index,
+		Type:      instructionType,
+		Position:  position,
+		Code:      builder.toSymbolicReferences(code),
+		DependsOn: dependsOn,
+	})
+	for _, runtimeValueUuid := range returnedRuntimeValueUuids {
+		builder.runtimeValueProducers[runtimeValueUuid] = index
+	}
+}
+
+// AddService adds a service added by the last instruction added to the plan. A service added again replaces the
+// previous one
+func (builder *PlanBuilder) AddService(serviceName service.ServiceName, serviceConfig *service.ServiceConfig) {
+	addedService := &Service{
+		Name:       string(serviceName),
+		Image:      serviceConfig.GetContainerImageName(),
+		Ports:      getPorts(serviceConfig),
+		EnvVars:    builder.getEnvVars(serviceConfig.GetEnvVars()),
+		Files:      getFilesMounts(serviceConfig),
+		Entrypoint: builder.toSymbolicReferencesList(serviceConfig.GetEntrypointArgs()),
+		Cmd:        builder.toSymbolicReferencesList(serviceConfig.GetCmdArgs()),
+		AddedBy:    builder.getCurrentInstructionIndex(),
+		RemovedBy:  nil,
+	}
+	builder.images[addedService.Image] = true
+	for index, existingService := range builder.services {
+		if existingService.Name == addedService.Name {
+			builder.services[index] = addedService
+			return
+		}
+	}
+	builder.services = append(builder.services, addedService)
+}
+
+// RemoveService marks a service as removed by the last instruction added to the plan
+func (builder *PlanBuilder) RemoveService(serviceName service.ServiceName) {
+	removingInstructionIndex := builder.getCurrentInstructionIndex()
+	for _, existingService := range builder.services {
+		if existingService.Name == string(serviceName) && existingService.RemovedBy == nil {
+			existingService.RemovedBy = &removingInstructionIndex
+		}
+	}
+}
+
+// AddTask adds a task run by the last instruction added to the plan, along with the files artifacts it stores
+func (builder *PlanBuilder) AddTask(command string, serviceConfig *service.ServiceConfig, storedFilesArtifactNames []string) {
+	task := &Task{
+		Image:   serviceConfig.GetContainerImageName(),
+		Command: builder.toSymbolicReferences(command),
+		EnvVars: builder.getEnvVars(serviceConfig.GetEnvVars()),
+		Files:   getFilesMounts(serviceConfig),
+		Store:   storedFilesArtifactNames,
+		RunBy:   builder.getCurrentInstructionIndex(),
+	}
+	builder.images[task.Image] = true
+	builder.tasks = append(builder.tasks, task)
+	for _, filesArtifactName := range storedFilesArtifactNames {
+		builder.AddFilesArtifact(filesArtifactName, nil, "")
+	}
+}
+
+// AddFilesArtifact adds a files artifact created by the last instruction added to the plan. The files and the service
+// they're copied from are optional
+func (builder *PlanBuilder) AddFilesArtifact(filesArtifactName string, files []string, serviceName service.ServiceName) {
+	builder.filesArtifacts = append(builder.filesArtifacts, &FilesArtifact{
+		Name:      filesArtifactName,
+		Files:     files,
+		Service:   string(serviceName),
+		CreatedBy: builder.getCurrentInstructionIndex(),
+	})
+}
+
+func (builder *PlanBuilder) Build() *Plan {
+	images := []string{}
+	for image := range builder.images {
+		images = append(images, image)
+	}
+	sort.Strings(images)
+	return &Plan{
+		Services:       builder.services,
+		Tasks:          builder.tasks,
+		FilesArtifacts: builder.filesArtifacts,
+		Images:         images,
+		Instructions:   builder.instructions,
+	}
+}
+
+// ====================================================================================================
+//
+//	Private Helper Functions
+//
+// ====================================================================================================
+
+func (builder *PlanBuilder) getCurrentInstructionIndex() int {
+	return len(builder.instructions) - 1
+}
+
+// toSymbolicReferences replaces the runtime values returned by the instructions of the plan with a reference to these
+// instructions. The runtime values returned by the instructions of previous runs are left as they are
+func (builder *PlanBuilder) toSymbolicReferences(str string) string {
+	return magic_string_helper.ReplaceRuntimeValueReferencesInString(str, func(runtimeValueUuid string, runtimeValueField string) string {
+		producerIndex, found := builder.runtimeValueProducers[runtimeValueUuid]
+		if !found {
+			return fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, runtimeValueUuid, runtimeValueField)
+		}
+		return fmt.Sprintf(symbolicReferenceFormat, producerIndex, runtimeValueField)
+	})
+}
+
+func (builder *PlanBuilder) toSymbolicReferencesList(strs []string) []string {
+	if len(strs) == 0 {
+		return nil
+	}
+	result := make([]string, len(strs))
+	for index, str := range strs {
+		result[index] = builder.toSymbolicReferences(str)
+	}
+	return result
+}
+
+func (builder *PlanBuilder) getEnvVars(envVars map[string]string) []*EnvVar {
+	if len(envVars) == 0 {
+		return nil
+	}
+	result := []*EnvVar{}
+	for key, value := range envVars {
+		result = append(result, &EnvVar{
+			Key:   key,
+			Value: builder.toSymbolicReferences(value),
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Key < result[j].Key
+	})
+	return result
+}
+
+func getPorts(serviceConfig *service.ServiceConfig) []*Port {
+	result := []*Port{}
+	for portId, portSpec := range serviceConfig.GetPrivatePorts() {
+		applicationProtocol := ""
+		if portSpec.GetMaybeApplicationProtocol() != nil {
+			applicationProtocol = *portSpec.GetMaybeApplicationProtocol()
+		}
+		result = append(result, &Port{
+			Id:                  portId,
+			Number:              portSpec.GetNumber(),
+			TransportProtocol:   portSpec.GetTransportProtocol().String(),
+			ApplicationProtocol: applicationProtocol,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Id < result[j].Id
+	})
+	return result
+}
+
+func getFilesMounts(serviceConfig *service.ServiceConfig) []*FilesMount {
+	filesArtifactsExpansion := serviceConfig.GetFilesArtifactsExpansion()
+	if filesArtifactsExpansion == nil {
+		return nil
+	}
+	result := []*FilesMount{}
+	for mountPath, filesArtifactName := range filesArtifactsExpansion.ServiceDirpathsToArtifactIdentifiers {
+		result = append(result, &FilesMount{
+			MountPath:     mountPath,
+			FilesArtifact: filesArtifactName,
+		})
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].MountPath < result[j].MountPath
+	})
+	return result
+}
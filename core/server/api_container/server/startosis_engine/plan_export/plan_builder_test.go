@@ -0,0 +1,73 @@
+package plan_export
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	producedRuntimeValueUuid = "0123456789abcdef0123456789abcdef"
+	unknownRuntimeValueUuid  = "fedcba9876543210fedcba9876543210"
+)
+
+func TestPlanBuilder_TaskStoringFilesArtifacts(t *testing.T) {
+	planBuilder := NewPlanBuilder()
+	planBuilder.AddInstruction("request", "main.star[2:17]", "plan.request(...)", []int{}, []string{producedRuntimeValueUuid})
+
+	command := fmt.Sprintf("echo "+magic_string_helper.RuntimeValueReplacementPlaceholderFormat+" > /out/body", producedRuntimeValueUuid, "body")
+	planBuilder.AddInstruction("run_sh", "main.star[3:16]", "plan.run_sh(...)", []int{0}, nil)
+	serviceConfig := service.NewServiceConfig("alpine:3", nil, nil, nil, nil, nil, nil, nil, 0, 0, "", 0, 0, nil, nil, nil, nil, nil, nil, 0, nil, nil, nil, nil)
+	planBuilder.AddTask(command, serviceConfig, []string{"body"})
+
+	plan := planBuilder.Build()
+	require.Equal(t, []*Task{
+		{
+			Image:   "alpine:3",
+			Command: "echo {{instructions[0].body}} > /out/body",
+			EnvVars: nil,
+			Files:   nil,
+			Store:   []string{"body"},
+			RunBy:   1,
+		},
+	}, plan.Tasks)
+	require.Equal(t, []*FilesArtifact{{Name: "body", Files: nil, Service: "", CreatedBy: 1}}, plan.FilesArtifacts)
+	require.Equal(t, []string{"alpine:3"}, plan.Images)
+}
+
+func TestPlanBuilder_KeepsRuntimeValuesOfPreviousRuns(t *testing.T) {
+	planBuilder := NewPlanBuilder()
+	code := fmt.Sprintf("plan.print("+magic_string_helper.RuntimeValueReplacementPlaceholderFormat+")", unknownRuntimeValueUuid, "ip_address")
+	planBuilder.AddInstruction("print", "main.star[2:15]", code, []int{}, nil)
+
+	plan := planBuilder.Build()
+	require.Equal(t, code, plan.Instructions[0].Code)
+}
+
+func TestPlan_Serialization(t *testing.T) {
+	planBuilder := NewPlanBuilder()
+	planBuilder.AddInstruction("remove_service", "main.star[2:24]", "plan.remove_service(name=\"db\")", []int{}, nil)
+	planBuilder.RemoveService("db")
+	plan := planBuilder.Build()
+
+	serializedYaml, err := plan.ToYaml()
+	require.NoError(t, err)
+	require.Equal(t, `services: []
+tasks: []
+filesArtifacts: []
+images: []
+instructions:
+    - index: 0
+      type: remove_service
+      position: main.star[2:24]
+      code: plan.remove_service(name="db")
+      dependsOn: []
+`, serializedYaml)
+
+	serializedJson, err := plan.ToJson()
+	require.NoError(t, err)
+	require.Contains(t, serializedJson, `"dependsOn": []`)
+}
@@ -16,6 +16,7 @@ import (
 	"go.starlark.net/starlark"
 	"golang.org/x/exp/maps"
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -49,7 +50,7 @@ func NewExecRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
 					IsOptional:        true,
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
-						_, interpretationErr := convertExtractorsToDict(true, value)
+						_, interpretationErr := convertExtractorsToDict(true, value, jqInputFormatText)
 						return interpretationErr
 					},
 				},
@@ -109,7 +110,7 @@ func (recipe *ExecRecipe) Execute(
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors)
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatText)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -138,7 +139,7 @@ func (recipe *ExecRecipe) Execute(
 		execOutputKey:   starlark.String(commandOutput),
 		execExitCodeKey: starlark.MakeInt(int(execResult.GetExitCode())),
 	}
-	extractDict, err := runExtractors([]byte(fmt.Sprintf("%q", commandOutput)), extractors)
+	extractDict, err := runExtractors([]byte(commandOutput), noHeaders, extractors)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while running extractors '%v' on command output '%v'", extractors, commandOutput)
 	}
@@ -183,11 +184,14 @@ func (recipe *ExecRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlar
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors)
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatText)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	for extractorKey := range extractors {
+	// sorted so that the returned dict is the same from one interpretation to the next
+	extractorKeys := maps.Keys(extractors)
+	sort.Strings(extractorKeys)
+	for _, extractorKey := range extractorKeys {
 		fullExtractorKey := fmt.Sprintf("%v.%v", extractKeyPrefix, extractorKey)
 		err = dict.SetKey(starlark.String(fullExtractorKey), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, fullExtractorKey)))
 		if err != nil {
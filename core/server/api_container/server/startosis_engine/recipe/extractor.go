@@ -3,26 +3,91 @@ package recipe
 import (
 	"encoding/json"
 	"fmt"
+	"github.com/BurntSushi/toml"
 	"github.com/itchyny/gojq"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"go.starlark.net/starlark"
+	"gopkg.in/yaml.v3"
+	"net/http"
+	"strings"
 )
 
-const extractKeyPrefix = "extract"
+const (
+	extractKeyPrefix = "extract"
+
+	// Extractors can read the headers of the response through this jq variable, like `$headers["content-type"]`
+	headersVariableName = "$headers"
+
+	// Values of a header sent multiple times get joined, as per RFC 9110
+	headerValuesSeparator = ", "
+
+	// Formats the input of a jq query can be parsed from before the query runs against it. 'text' hands the raw input
+	// to the query as a single string
+	jqInputFormatJson = "json"
+	jqInputFormatYaml = "yaml"
+	jqInputFormatToml = "toml"
+	jqInputFormatText = "text"
+)
+
+var jqInputFormats = []string{
+	jqInputFormatJson,
+	jqInputFormatYaml,
+	jqInputFormatToml,
+	jqInputFormatText,
+}
+
+// Exec recipes run extractors against the command output, which comes without any header
+var noHeaders = http.Header{}
+
+// fieldExtractor pulls a single value out of the result of a recipe, i.e. the body of an HTTP response along with its
+// headers, the output of a command or the data received by a probe
+type fieldExtractor interface {
+	extract(input []byte, headers http.Header) (starlark.Comparable, error)
+}
+
+// jqExtractor runs a jq query against the input, once parsed according to its input format
+type jqExtractor struct {
+	query       string
+	inputFormat string
+}
+
+func newJqExtractor(query string, inputFormat string) *jqExtractor {
+	return &jqExtractor{
+		query:       query,
+		inputFormat: inputFormat,
+	}
+}
 
 func extract(input []byte, query string) (starlark.Comparable, error) {
+	return extractWithHeaders(input, noHeaders, query)
+}
+
+// extractWithHeaders runs the query against the JSON input, exposing the headers under the '$headers' variable with
+// lower-cased names. The input does not need to be valid JSON if the query only looks at the headers
+func extractWithHeaders(input []byte, headers http.Header, query string) (starlark.Comparable, error) {
+	return newJqExtractor(query, jqInputFormatJson).extract(input, headers)
+}
+
+func (extractor *jqExtractor) extract(input []byte, headers http.Header) (starlark.Comparable, error) {
+	query := extractor.query
 	logrus.Debugf("Running extractor against query '%v' and input '%v'", string(input), query)
-	jqQuery, err := gojq.Parse(query)
+	jqCode, err := compileJqQuery(query)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred when parsing field extractor '%v'", query)
+		return nil, err
 	}
-	var jsonBody interface{}
-	err = json.Unmarshal(input, &jsonBody)
+	parsedInput, err := parseJqInput(input, extractor.inputFormat)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred when parsing JSON response body:\n'%v'", string(input))
+		if !strings.Contains(query, headersVariableName) {
+			return nil, err
+		}
+		parsedInput = nil
 	}
-	matchIterator := jqQuery.Run(jsonBody)
+	headersValue := map[string]any{}
+	for headerName, headerValues := range headers {
+		headersValue[strings.ToLower(headerName)] = strings.Join(headerValues, headerValuesSeparator)
+	}
+	matchIterator := jqCode.Run(parsedInput, headersValue)
 	parsedMatchList := []starlark.Value{}
 	for {
 		matchValue, ok := matchIterator.Next()
@@ -49,6 +114,85 @@ func extract(input []byte, query string) (starlark.Comparable, error) {
 	return starlark.NewList(parsedMatchList), nil
 }
 
+func compileJqQuery(query string) (*gojq.Code, error) {
+	jqQuery, err := gojq.Parse(query)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when parsing field extractor '%v'", query)
+	}
+	jqCode, err := gojq.Compile(jqQuery, gojq.WithVariables([]string{headersVariableName}))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when compiling field extractor '%v'", query)
+	}
+	return jqCode, nil
+}
+
+// parseJqInput turns the input into the generic values gojq works with. YAML and TOML documents go through a JSON
+// round trip so that their dates, integers and nested tables end up with the same types as a JSON document would
+func parseJqInput(input []byte, inputFormat string) (any, error) {
+	var parsedInput any
+	switch inputFormat {
+	case jqInputFormatJson:
+		if err := json.Unmarshal(input, &parsedInput); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred when parsing JSON response body:\n'%v'", string(input))
+		}
+		return parsedInput, nil
+	case jqInputFormatYaml:
+		if err := yaml.Unmarshal(input, &parsedInput); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred when parsing YAML input:\n'%v'", string(input))
+		}
+	case jqInputFormatToml:
+		if _, err := toml.Decode(string(input), &parsedInput); err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred when parsing TOML input:\n'%v'", string(input))
+		}
+	case jqInputFormatText:
+		return string(input), nil
+	default:
+		return nil, stacktrace.NewError("Unknown extractor input format '%s'. Supported formats are '%s'", inputFormat, strings.Join(jqInputFormats, "', '"))
+	}
+	jsonInput, err := json.Marshal(normalizeDecodedValue(parsedInput))
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred converting the '%s' input to JSON:\n'%v'", inputFormat, string(input))
+	}
+	var normalizedInput any
+	if err = json.Unmarshal(jsonInput, &normalizedInput); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the '%s' input converted to JSON:\n'%v'", inputFormat, string(jsonInput))
+	}
+	return normalizedInput, nil
+}
+
+// normalizeDecodedValue converts the maps with non-string keys a YAML document can hold, as well as the arrays of
+// tables of a TOML document, into types which can be marshalled to JSON
+func normalizeDecodedValue(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		normalizedMap := map[string]any{}
+		for key, element := range value {
+			normalizedMap[key] = normalizeDecodedValue(element)
+		}
+		return normalizedMap
+	case map[any]any:
+		normalizedMap := map[string]any{}
+		for key, element := range value {
+			normalizedMap[fmt.Sprintf("%v", key)] = normalizeDecodedValue(element)
+		}
+		return normalizedMap
+	case []any:
+		normalizedList := []any{}
+		for _, element := range value {
+			normalizedList = append(normalizedList, normalizeDecodedValue(element))
+		}
+		return normalizedList
+	case []map[string]any:
+		normalizedList := []any{}
+		for _, element := range value {
+			normalizedList = append(normalizedList, normalizeDecodedValue(element))
+		}
+		return normalizedList
+	default:
+		return value
+	}
+}
+
 func parseJsonValueToStarlark(value any) starlark.Value {
 	switch value := value.(type) {
 	case int:
@@ -80,14 +224,14 @@ func parseJsonValueToStarlark(value any) starlark.Value {
 	}
 }
 
-// runExtractors takes in `input` and a map of `extractors`. Each entry of extractors has an `id` and a `query` string.
-// For each extractor, we run `query` against `input`, returning a map with key being extract.id and the Starlark result.
-func runExtractors(input []byte, extractors map[string]string) (map[string]starlark.Comparable, error) {
+// runExtractors takes in `input` and a map of `extractors`. Each entry of extractors has an `id` and an extractor.
+// For each extractor, we run it against `input`, returning a map with key being extract.id and the Starlark result.
+func runExtractors(input []byte, headers http.Header, extractors map[string]fieldExtractor) (map[string]starlark.Comparable, error) {
 	extractResult := map[string]starlark.Comparable{}
-	for extractorName, query := range extractors {
-		extractedValue, err := extract(input, query)
+	for extractorName, extractor := range extractors {
+		extractedValue, err := extractor.extract(input, headers)
 		if err != nil {
-			return nil, stacktrace.Propagate(err, "An error occurred running extractor '%v' on recipe", query)
+			return nil, stacktrace.Propagate(err, "An error occurred running extractor '%v' on recipe", extractorName)
 		}
 		extractResult[fmt.Sprintf("%v.%v", extractKeyPrefix, extractorName)] = extractedValue
 	}
@@ -1,13 +1,19 @@
 package recipe
 
 import (
+	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.starlark.net/starlark"
 	"go.starlark.net/syntax"
+	"net/http"
 	"testing"
 )
 
+const extractorResultVariableName = "extractor"
+
 var jsonObject = []byte(`
 {
 	"integer": 1,
@@ -82,11 +88,11 @@ func TestExtractor_FailureInput(t *testing.T) {
 }
 
 func TestExtractor_SimpleValues(t *testing.T) {
-	extractors := map[string]string{
-		"id":  ".integer",
-		"id2": ".str",
+	extractors := map[string]fieldExtractor{
+		"id":  newJqExtractor(".integer", jqInputFormatJson),
+		"id2": newJqExtractor(".str", jqInputFormatJson),
 	}
-	result, err := runExtractors(jsonObject, extractors)
+	result, err := runExtractors(jsonObject, noHeaders, extractors)
 	assert.Nil(t, err)
 	assert.Equal(t, map[string]starlark.Comparable{
 		"extract.id":  starlark.MakeInt(1),
@@ -95,11 +101,11 @@ func TestExtractor_SimpleValues(t *testing.T) {
 }
 
 func TestRunExtractors_OutputKeys(t *testing.T) {
-	extractors := map[string]string{
-		"id":  ".integer",
-		"id2": ".str",
+	extractors := map[string]fieldExtractor{
+		"id":  newJqExtractor(".integer", jqInputFormatJson),
+		"id2": newJqExtractor(".str", jqInputFormatJson),
 	}
-	result, err := runExtractors(jsonObject, extractors)
+	result, err := runExtractors(jsonObject, noHeaders, extractors)
 	assert.Nil(t, err)
 	assert.Equal(t, map[string]starlark.Comparable{
 		"extract.id":  starlark.MakeInt(1),
@@ -108,18 +114,199 @@ func TestRunExtractors_OutputKeys(t *testing.T) {
 }
 
 func TestRunExtractors_EmptyOutput(t *testing.T) {
-	extractors := map[string]string{}
-	result, err := runExtractors(jsonObject, extractors)
+	extractors := map[string]fieldExtractor{}
+	result, err := runExtractors(jsonObject, noHeaders, extractors)
 	assert.Nil(t, err)
 	assert.NotNil(t, result)
 	assert.Len(t, result, 0)
 }
 
 func TestRunExtractors_FailureQueryInvalid(t *testing.T) {
-	extractors := map[string]string{
-		"id": ".does_not_exist",
+	extractors := map[string]fieldExtractor{
+		"id": newJqExtractor(".does_not_exist", jqInputFormatJson),
 	}
-	result, err := runExtractors(jsonObject, extractors)
+	result, err := runExtractors(jsonObject, noHeaders, extractors)
 	assert.NotNil(t, err)
 	assert.Nil(t, result)
 }
+
+func TestExtractorWithHeaders_HeadersAndBody(t *testing.T) {
+	headers := http.Header{}
+	headers.Add("Content-Type", "application/json")
+	headers.Add("X-Request-Id", "1")
+	headers.Add("X-Request-Id", "2")
+
+	result, err := extractWithHeaders(jsonObject, headers, `$headers["content-type"]`)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("application/json"), result)
+
+	result, err = extractWithHeaders(jsonObject, headers, `$headers["x-request-id"]`)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("1, 2"), result)
+
+	result, err = extractWithHeaders(jsonObject, headers, `.str + $headers["x-request-id"]`)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("a1, 2"), result)
+}
+
+func TestExtractorWithHeaders_NonJsonBody(t *testing.T) {
+	headers := http.Header{}
+	headers.Add("Location", "/redirected")
+
+	result, err := extractWithHeaders([]byte("<html></html>"), headers, `$headers["location"]`)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("/redirected"), result)
+
+	result, err = extractWithHeaders([]byte("<html></html>"), headers, ".str")
+	require.Error(t, err)
+	require.Nil(t, result)
+}
+
+func TestJqExtractor_YamlInput(t *testing.T) {
+	yamlInput := []byte(`
+version: 2
+services:
+  - name: web
+    ports: [80, 443]
+  - name: db
+created: 2023-06-01T10:00:00Z
+`)
+	result, err := newJqExtractor(".services[1].name", jqInputFormatYaml).extract(yamlInput, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("db"), result)
+
+	result, err = newJqExtractor(".services[0].ports[1] + .version", jqInputFormatYaml).extract(yamlInput, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(445), result)
+
+	result, err = newJqExtractor(".created", jqInputFormatYaml).extract(yamlInput, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("2023-06-01T10:00:00Z"), result)
+}
+
+func TestJqExtractor_TomlInput(t *testing.T) {
+	tomlInput := []byte(`
+title = "node"
+
+[network]
+chain_id = 1337
+
+[[peers]]
+address = "10.0.0.1"
+
+[[peers]]
+address = "10.0.0.2"
+`)
+	result, err := newJqExtractor(".network.chain_id", jqInputFormatToml).extract(tomlInput, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.MakeInt(1337), result)
+
+	result, err = newJqExtractor(".peers[-1].address", jqInputFormatToml).extract(tomlInput, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("10.0.0.2"), result)
+}
+
+func TestJqExtractor_InvalidInputForFormat(t *testing.T) {
+	_, err := newJqExtractor(".title", jqInputFormatToml).extract([]byte("title: node"), noHeaders)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "An error occurred when parsing TOML input")
+}
+
+func TestJqExtractor_TextInput(t *testing.T) {
+	result, err := newJqExtractor(`split("\n") | .[0]`, jqInputFormatText).extract([]byte("first line\nsecond line\n"), noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("first line"), result)
+}
+
+func TestRegexExtractor(t *testing.T) {
+	input := []byte("Listening on 0.0.0.0:8545, chain id: 1337\n")
+
+	extractor := newTypedExtractor(t, NewRegexExtractorType(), `pattern=r"chain id: (?P<chain_id>\d+)", group="chain_id"`)
+	result, err := extractor.extract(input, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("1337"), result)
+
+	extractor = newTypedExtractor(t, NewRegexExtractorType(), `pattern=r"\d+\.\d+\.\d+\.\d+"`)
+	result, err = extractor.extract(input, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("0.0.0.0"), result)
+
+	extractor = newTypedExtractor(t, NewRegexExtractorType(), `pattern=r"block: \d+"`)
+	_, err = extractor.extract(input, noHeaders)
+	require.Error(t, err)
+}
+
+func TestRegexExtractor_InvalidArguments(t *testing.T) {
+	_, err := callTypeConstructor(NewRegexExtractorType(), `pattern=r"chain id: (\d+"`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "is not a valid regular expression")
+
+	_, err = callTypeConstructor(NewRegexExtractorType(), `pattern=r"chain id: (?P<chain_id>\d+)", group="block"`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "has no capture group named 'block'")
+}
+
+func TestLineExtractor(t *testing.T) {
+	input := []byte("NAME      STATUS   AGE\r\nnode-1    Ready    10d\r\nnode-2    NotReady 2d\r\n")
+
+	extractor := newTypedExtractor(t, NewLineExtractorType(), `line=-1`)
+	result, err := extractor.extract(input, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("node-2    NotReady 2d"), result)
+
+	extractor = newTypedExtractor(t, NewLineExtractorType(), `contains="node-1", column=1`)
+	result, err = extractor.extract(input, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("Ready"), result)
+
+	extractor = newTypedExtractor(t, NewLineExtractorType(), `line=1, column=0, separator="-"`)
+	result, err = extractor.extract(input, noHeaders)
+	require.NoError(t, err)
+	require.Equal(t, starlark.String("node"), result)
+
+	extractor = newTypedExtractor(t, NewLineExtractorType(), `line=3`)
+	_, err = extractor.extract(input, noHeaders)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Line 3 could not be found in the 3 line(s)")
+}
+
+func TestConvertExtractorsToDict(t *testing.T) {
+	extractorsDict := starlark.NewDict(2)
+	require.NoError(t, extractorsDict.SetKey(starlark.String("query"), starlark.String(".str")))
+	require.NoError(t, extractorsDict.SetKey(starlark.String("regex"), newTypedExtractor(t, NewRegexExtractorType(), `pattern="[a-z]"`)))
+	extractors, interpretationErr := convertExtractorsToDict(true, extractorsDict, jqInputFormatJson)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, newJqExtractor(".str", jqInputFormatJson), extractors["query"])
+	require.IsType(t, &RegexExtractor{}, extractors["regex"]) //nolint:exhaustruct
+
+	require.NoError(t, extractorsDict.SetKey(starlark.String("invalid"), starlark.String(".str |")))
+	_, interpretationErr = convertExtractorsToDict(true, extractorsDict, jqInputFormatJson)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), `Value for 'extract["invalid"]' is not a valid jq query`)
+
+	require.NoError(t, extractorsDict.SetKey(starlark.String("invalid"), starlark.MakeInt(1)))
+	_, interpretationErr = convertExtractorsToDict(true, extractorsDict, jqInputFormatJson)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "was expected to be a jq query string")
+}
+
+// callTypeConstructor runs the Starlark constructor with the given arguments code, as a package would
+func callTypeConstructor(typeConstructor *kurtosis_type_constructor.KurtosisTypeConstructor, argumentsCode string) (starlark.Value, error) {
+	predeclared := starlark.StringDict{
+		typeConstructor.GetName(): starlark.NewBuiltin(typeConstructor.GetName(), typeConstructor.CreateBuiltin()),
+	}
+	code := fmt.Sprintf("%s = %s(%s)", extractorResultVariableName, typeConstructor.GetName(), argumentsCode)
+	globals, err := starlark.ExecFile(&starlark.Thread{}, "extractor_test.star", code, predeclared) //nolint:exhaustruct
+	if err != nil {
+		return nil, err
+	}
+	return globals[extractorResultVariableName], nil
+}
+
+func newTypedExtractor(t *testing.T, typeConstructor *kurtosis_type_constructor.KurtosisTypeConstructor, argumentsCode string) TypedExtractor {
+	value, err := callTypeConstructor(typeConstructor, argumentsCode)
+	require.NoError(t, err)
+	extractor, ok := value.(TypedExtractor)
+	require.True(t, ok)
+	return extractor
+}
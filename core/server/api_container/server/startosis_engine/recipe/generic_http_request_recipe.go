@@ -0,0 +1,322 @@
+package recipe
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"net/http"
+)
+
+const (
+	HttpRecipeTypeName = "HttpRequestRecipe"
+
+	MethodAttr        = "method"
+	HeadersAttr       = "headers"
+	QueryParamsAttr   = "query_params"
+	UseTlsAttr        = "use_tls"
+	SkipTlsVerifyAttr = "skip_tls_verify"
+
+	defaultMethod = http.MethodGet
+)
+
+var supportedHttpMethods = []string{
+	http.MethodGet,
+	http.MethodPost,
+	http.MethodPut,
+	http.MethodPatch,
+	http.MethodDelete,
+	http.MethodHead,
+	http.MethodOptions,
+}
+
+// NewHttpRequestRecipeType creates the generic HTTP recipe, supporting any method, custom headers, query parameters and
+// HTTPS, where [Get|Post]HttpRequestRecipe only send plain GET and POST requests
+func NewHttpRequestRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: HttpRecipeTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              EndpointAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              MethodAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, MethodAttr, supportedHttpMethods)
+					},
+				},
+				{
+					Name:              HeadersAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := kurtosis_types.SafeCastToMapStringString(value, HeadersAttr)
+						return interpretationErr
+					},
+				},
+				{
+					Name:              QueryParamsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := kurtosis_types.SafeCastToMapStringString(value, QueryParamsAttr)
+						return interpretationErr
+					},
+				},
+				{
+					Name:              RequestBodyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              ContentTypeAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              UseTlsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              SkipTlsVerifyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              ExtractAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertExtractorsToDict(true, value, jqInputFormatJson)
+						return interpretationErr
+					},
+				},
+			},
+		},
+		Instantiate: instantiateHttpRequestRecipe,
+	}
+}
+
+func instantiateHttpRequestRecipe(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(HttpRecipeTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &GenericHttpRequestRecipe{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// GenericHttpRequestRecipe is the Go type behind the HttpRequestRecipe Starlark type. It's named differently to not
+// clash with the HttpRequestRecipe interface implemented by all HTTP recipes
+type GenericHttpRequestRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (recipe *GenericHttpRequestRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &GenericHttpRequestRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (recipe *GenericHttpRequestRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	logrus.Debugf("Running HTTP request recipe '%s'", recipe.String())
+
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, PortIdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", PortIdAttr, HttpRecipeTypeName)
+	}
+
+	endpoint, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, EndpointAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", EndpointAttr, HttpRecipeTypeName)
+	}
+	endpointWithRuntimeValue, err := magic_string_helper.ReplaceRuntimeValueInString(endpoint.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the endpoint of the http recipe")
+	}
+
+	method := recipe.RequestType()
+
+	requestBody, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, RequestBodyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		requestBody = emptyRequestBody
+	}
+
+	contentType, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, ContentTypeAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		// Same default as the POST recipe, but only when there's a body to describe
+		contentType = noContentType
+		if requestBody != emptyRequestBody {
+			contentType = defaultContentType
+		}
+	}
+
+	headers, err := getStringDictAttrWithRuntimeValues(recipe.KurtosisValueTypeDefault, HeadersAttr, runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the headers of the http recipe")
+	}
+	queryParams, err := getStringDictAttrWithRuntimeValues(recipe.KurtosisValueTypeDefault, QueryParamsAttr, runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the query params of the http recipe")
+	}
+
+	useTls, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](
+		recipe.KurtosisValueTypeDefault, UseTlsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	skipTlsVerify, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](
+		recipe.KurtosisValueTypeDefault, SkipTlsVerifyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	rawExtractors, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
+		recipe.KurtosisValueTypeDefault, ExtractAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	requestResultDict, err := executeInternal(
+		ctx,
+		serviceNetwork,
+		runtimeValueStore,
+		serviceName,
+		requestBody.GoString(),
+		portId.GoString(),
+		method,
+		contentType.GoString(),
+		endpointWithRuntimeValue,
+		service_network.NewHttpRequestOptions(headers, queryParams, bool(useTls), bool(skipTlsVerify)),
+		extractors,
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when running HTTP request recipe '%v'", recipe.String())
+	}
+	return requestResultDict, nil
+}
+
+func (recipe *GenericHttpRequestRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	return resultMapToStringInternal(resultMap)
+}
+
+func (recipe *GenericHttpRequestRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	rawExtractors, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
+		recipe.KurtosisValueTypeDefault, ExtractAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	returnValue, interpretationErr := createStarlarkReturnValueInternal(resultUuid, extractors)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return returnValue, nil
+}
+
+func (recipe *GenericHttpRequestRecipe) RequestType() string {
+	method, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, MethodAttr)
+	if interpretationErr != nil || !found {
+		return defaultMethod
+	}
+	return method.GoString()
+}
+
+// getStringDictAttrWithRuntimeValues returns the dict of strings stored in the attribute, with the runtime values of its
+// values replaced. An empty map is returned if the attribute isn't set
+func getStringDictAttrWithRuntimeValues(recipeValue *kurtosis_type_constructor.KurtosisValueTypeDefault, attrName string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (map[string]string, error) {
+	rawDict, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
+		recipeValue, attrName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	result := map[string]string{}
+	if !found {
+		return result, nil
+	}
+	stringDict, interpretationErr := kurtosis_types.SafeCastToMapStringString(rawDict, attrName)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	for key, value := range stringDict {
+		valueWithRuntimeValue, err := magic_string_helper.ReplaceRuntimeValueInString(value, runtimeValueStore)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the value of key '%s' of '%s'", key, attrName)
+		}
+		result[key] = valueWithRuntimeValue
+	}
+	return result, nil
+}
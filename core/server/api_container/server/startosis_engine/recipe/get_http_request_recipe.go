@@ -48,7 +48,7 @@ func NewGetHttpRequestRecipeType() *kurtosis_type_constructor.KurtosisTypeConstr
 					IsOptional:        true,
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
-						_, interpretationErr := convertExtractorsToDict(true, value)
+						_, interpretationErr := convertExtractorsToDict(true, value, jqInputFormatJson)
 						return interpretationErr
 					},
 				},
@@ -113,7 +113,7 @@ func (recipe *GetHttpRequestRecipe) Execute(
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors)
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -133,6 +133,7 @@ func (recipe *GetHttpRequestRecipe) Execute(
 		getMethod,
 		noContentType,
 		endpoint.GoString(),
+		noHttpRequestOptions,
 		extractors,
 	)
 	if err != nil {
@@ -151,7 +152,7 @@ func (recipe *GetHttpRequestRecipe) CreateStarlarkReturnValue(resultUuid string)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors)
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -0,0 +1,280 @@
+package recipe
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"golang.org/x/exp/maps"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	GrpcRecipeTypeName = "GrpcRequestRecipe"
+
+	MetadataAttr              = "metadata"
+	DescriptorSetArtifactAttr = "descriptor_set_artifact"
+	DescriptorSetFileAttr     = "descriptor_set_file"
+
+	// The standard gRPC health check, which most servers implement
+	defaultGrpcMethod = "grpc.health.v1.Health/Check"
+
+	emptyGrpcRequestBody = "{}"
+
+	grpcProbeType = "grpc"
+)
+
+// NewGrpcRequestRecipeType creates the recipe calling a unary gRPC method with a JSON payload. The method descriptor is
+// fetched through server reflection unless a files artifact holding a FileDescriptorSet is provided
+func NewGrpcRequestRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: GrpcRecipeTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              MethodAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, MethodAttr)
+					},
+				},
+				{
+					Name:              RequestBodyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              MetadataAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := kurtosis_types.SafeCastToMapStringString(value, MetadataAttr)
+						return interpretationErr
+					},
+				},
+				{
+					Name:              DescriptorSetArtifactAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, DescriptorSetArtifactAttr)
+					},
+				},
+				{
+					Name:              DescriptorSetFileAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, DescriptorSetFileAttr)
+					},
+				},
+				{
+					Name:              UseTlsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              SkipTlsVerifyAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Bool],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              ExtractAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertExtractorsToDict(true, value, jqInputFormatJson)
+						return interpretationErr
+					},
+				},
+			},
+		},
+		Instantiate: instantiateGrpcRequestRecipe,
+	}
+}
+
+func instantiateGrpcRequestRecipe(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(GrpcRecipeTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &GrpcRequestRecipe{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+type GrpcRequestRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (recipe *GrpcRequestRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &GrpcRequestRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (recipe *GrpcRequestRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	logrus.Debugf("Running gRPC request recipe '%s'", recipe.String())
+
+	serviceNameStr := string(serviceName)
+	if serviceNameStr == "" {
+		return nil, stacktrace.NewError("The service name parameter can't be an empty string")
+	}
+
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, PortIdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", PortIdAttr, GrpcRecipeTypeName)
+	}
+
+	method, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, MethodAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		method = defaultGrpcMethod
+	}
+
+	requestBody, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, RequestBodyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		requestBody = emptyGrpcRequestBody
+	}
+	requestBodyWithRuntimeValue, err := magic_string_helper.ReplaceRuntimeValueInString(requestBody.GoString(), runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while replacing runtime values in the body of the gRPC recipe")
+	}
+
+	metadata, err := getStringDictAttrWithRuntimeValues(recipe.KurtosisValueTypeDefault, MetadataAttr, runtimeValueStore)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while getting the metadata of the gRPC recipe")
+	}
+
+	descriptorSetArtifact, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, DescriptorSetArtifactAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	descriptorSetFile, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, DescriptorSetFileAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	useTls, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](
+		recipe.KurtosisValueTypeDefault, UseTlsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	skipTlsVerify, _, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Bool](
+		recipe.KurtosisValueTypeDefault, SkipTlsVerifyAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	response, err := serviceNetwork.GrpcRequestService(
+		ctx,
+		serviceNameStr,
+		portId.GoString(),
+		method.GoString(),
+		requestBodyWithRuntimeValue,
+		service_network.NewGrpcRequestOptions(metadata, descriptorSetArtifact.GoString(), descriptorSetFile.GoString(), bool(useTls), bool(skipTlsVerify)),
+	)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when running gRPC request recipe '%v'", recipe.String())
+	}
+	logrus.Debugf("Got gRPC response with code '%v' and body '%v'", response.GetStatusCode(), response.GetBody())
+
+	if response.GetStatusCode() != codes.OK {
+		// There's nothing to extract from a failed call, its body is the status message
+		if len(extractors) > 0 {
+			return nil, stacktrace.NewError("The gRPC call failed with code '%v' (%v) so fields can't be extracted from its response: %v", int(response.GetStatusCode()), response.GetStatusCode().String(), response.GetStatusMessage())
+		}
+		return map[string]starlark.Comparable{
+			bodyKey:       starlark.String(response.GetStatusMessage()),
+			statusCodeKey: starlark.MakeInt(int(response.GetStatusCode())),
+		}, nil
+	}
+	resultDict := map[string]starlark.Comparable{
+		bodyKey:       starlark.String(response.GetBody()),
+		statusCodeKey: starlark.MakeInt(int(codes.OK)),
+	}
+	extractDict, err := runExtractors([]byte(response.GetBody()), noHeaders, extractors)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while running extractors from gRPC recipe")
+	}
+	maps.Copy(resultDict, extractDict)
+	return resultDict, nil
+}
+
+func (recipe *GrpcRequestRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	return resultMapToStringInternal(resultMap)
+}
+
+func (recipe *GrpcRequestRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return createStarlarkReturnValueInternal(resultUuid, extractors)
+}
+
+func (recipe *GrpcRequestRecipe) ProbeType() string {
+	return grpcProbeType
+}
+
+func (recipe *GrpcRequestRecipe) getExtractors() (map[string]fieldExtractor, *startosis_errors.InterpretationError) {
+	rawExtractors, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
+		recipe.KurtosisValueTypeDefault, ExtractAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
+}
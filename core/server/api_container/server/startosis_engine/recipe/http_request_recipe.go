@@ -17,6 +17,7 @@ import (
 	"io"
 	"net/http"
 	"reflect"
+	"sort"
 	"strings"
 )
 
@@ -24,12 +25,15 @@ const (
 	statusCodeKey = "code"
 	bodyKey       = "body"
 
-	// Common attributes for both [Get|Post]HttpRequestRecipe
+	// Common attributes for all [Get|Post]HttpRequestRecipe and HttpRequestRecipe
 	PortIdAttr   = "port_id"
 	EndpointAttr = "endpoint"
 	ExtractAttr  = "extract"
 )
 
+// Get and Post recipes send plain HTTP requests
+var noHttpRequestOptions *service_network.HttpRequestOptions = nil
+
 type HttpRequestRecipe interface {
 	builtin_argument.KurtosisValueType
 
@@ -49,7 +53,8 @@ func executeInternal(
 	method string,
 	contentType string,
 	endpoint string,
-	extractors map[string]string,
+	options *service_network.HttpRequestOptions,
+	extractors map[string]fieldExtractor,
 ) (map[string]starlark.Comparable, error) {
 	var response *http.Response
 	var err error
@@ -71,6 +76,7 @@ func executeInternal(
 		contentType,
 		endpoint,
 		recipeBodyWithRuntimeValue,
+		options,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred when running HTTP request recipe")
@@ -90,7 +96,7 @@ func executeInternal(
 		bodyKey:       starlark.String(responseBody),
 		statusCodeKey: starlark.MakeInt(response.StatusCode),
 	}
-	extractDict, err := runExtractors(responseBody, extractors)
+	extractDict, err := runExtractors(responseBody, response.Header, extractors)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred while running extractors from HTTP recipe")
 	}
@@ -114,7 +120,7 @@ func resultMapToStringInternal(resultMap map[string]starlark.Comparable) string
 	}
 }
 
-func createStarlarkReturnValueInternal(resultUuid string, extractors map[string]string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+func createStarlarkReturnValueInternal(resultUuid string, extractors map[string]fieldExtractor) (*starlark.Dict, *startosis_errors.InterpretationError) {
 	dict := &starlark.Dict{}
 	err := dict.SetKey(starlark.String(bodyKey), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, bodyKey)))
 	if err != nil {
@@ -124,7 +130,10 @@ func createStarlarkReturnValueInternal(resultUuid string, extractors map[string]
 	if err != nil {
 		return nil, startosis_errors.NewInterpretationError("An error has occurred when creating return value for request recipe, setting field '%v'", statusCodeKey)
 	}
-	for extractorKey := range extractors {
+	// sorted so that the returned dict is the same from one interpretation to the next
+	extractorKeys := maps.Keys(extractors)
+	sort.Strings(extractorKeys)
+	for _, extractorKey := range extractorKeys {
 		fullExtractorKey := fmt.Sprintf("%v.%v", extractKeyPrefix, extractorKey)
 		err = dict.SetKey(starlark.String(fullExtractorKey), starlark.String(fmt.Sprintf(magic_string_helper.RuntimeValueReplacementPlaceholderFormat, resultUuid, fullExtractorKey)))
 		if err != nil {
@@ -135,14 +144,16 @@ func createStarlarkReturnValueInternal(resultUuid string, extractors map[string]
 	return dict, nil
 }
 
-func convertExtractorsToDict(isAttrSet bool, extractorsValue starlark.Value) (map[string]string, *startosis_errors.InterpretationError) {
-	extractorStringMap := map[string]string{}
+// convertExtractorsToDict converts the `extract` dictionary of a recipe into field extractors. Plain string values are
+// jq queries, run against the result of the recipe parsed with the given input format
+func convertExtractorsToDict(isAttrSet bool, extractorsValue starlark.Value, stringExtractorInputFormat string) (map[string]fieldExtractor, *startosis_errors.InterpretationError) {
+	extractors := map[string]fieldExtractor{}
 	if !isAttrSet {
-		return extractorStringMap, nil
+		return extractors, nil
 	}
 	extractorsDict, ok := extractorsValue.(*starlark.Dict)
 	if !ok {
-		return nil, startosis_errors.NewInterpretationError("Attribute '%s' on '%s' is expected to be a dictionary of strings, got '%s'", ExtractAttr, GetHttpRecipeTypeName, reflect.TypeOf(extractorsValue))
+		return nil, startosis_errors.NewInterpretationError("Attribute '%s' on '%s' is expected to be a dictionary of strings or extractors, got '%s'", ExtractAttr, GetHttpRecipeTypeName, reflect.TypeOf(extractorsValue))
 	}
 
 	for _, extractorKey := range extractorsDict.Keys() {
@@ -157,17 +168,28 @@ func convertExtractorsToDict(isAttrSet bool, extractorsValue starlark.Value) (ma
 		if !ok {
 			return nil, startosis_errors.NewInterpretationError("Key in '%s' dictionary was expected to be a string, got '%s'", ExtractAttr, reflect.TypeOf(extractorKey))
 		}
-		extractorValueStr, ok := extractorValue.(starlark.String)
-		if !ok {
-			return nil, startosis_errors.NewInterpretationError("Value associated to key '%s' in dictionary '%s' was expected to be a string, got '%s'", extractorKeyStr, ExtractAttr, reflect.TypeOf(extractorsValue))
+		switch extractorValue := extractorValue.(type) {
+		case starlark.String:
+			if interpretationErr := validateJqQuery(extractorValue, fmt.Sprintf("%s[%q]", ExtractAttr, extractorKeyStr.GoString())); interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			extractors[extractorKeyStr.GoString()] = newJqExtractor(extractorValue.GoString(), stringExtractorInputFormat)
+		case TypedExtractor:
+			extractors[extractorKeyStr.GoString()] = extractorValue
+		default:
+			return nil, startosis_errors.NewInterpretationError("Value associated to key '%s' in dictionary '%s' was expected to be a jq query string, a '%s', a '%s' or a '%s', got '%s'", extractorKeyStr, ExtractAttr, JqExtractorTypeName, RegexExtractorTypeName, LineExtractorTypeName, reflect.TypeOf(extractorValue))
 		}
-		extractorStringMap[extractorKeyStr.GoString()] = extractorValueStr.GoString()
 	}
-	return extractorStringMap, nil
+	return extractors, nil
 }
 
 func ValidateHttpRequestRecipe(httpRequestRecipe HttpRequestRecipe, serviceName service.ServiceName, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
-	portIdValue, err := httpRequestRecipe.Attr(PortIdAttr)
+	return validatePortIdAttr(httpRequestRecipe, serviceName, validatorEnvironment)
+}
+
+// validatePortIdAttr checks that the port targeted by an HTTP or probe recipe exists on the service
+func validatePortIdAttr(recipe builtin_argument.KurtosisValueType, serviceName service.ServiceName, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	portIdValue, err := recipe.Attr(PortIdAttr)
 	if err != nil {
 		return startosis_errors.NewValidationError("Tried fetching port ID for request on service '%s' but failed", serviceName)
 	}
@@ -0,0 +1,101 @@
+package recipe
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"net/http"
+)
+
+const (
+	JqExtractorTypeName = "JqExtractor"
+
+	QueryAttr       = "query"
+	InputFormatAttr = "input_format"
+)
+
+func NewJqExtractorType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: JqExtractorTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              QueryAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateJqQuery(value, QueryAttr)
+					},
+				},
+				{
+					Name:              InputFormatAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.StringValues(value, InputFormatAttr, jqInputFormats)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateJqExtractor,
+	}
+}
+
+func instantiateJqExtractor(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(JqExtractorTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &JqExtractor{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// JqExtractor runs a jq query against the result of a recipe, parsed as JSON, YAML, TOML or taken as plain text
+type JqExtractor struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (extractor *JqExtractor) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := extractor.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &JqExtractor{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (extractor *JqExtractor) extract(input []byte, headers http.Header) (starlark.Comparable, error) {
+	query, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		extractor.KurtosisValueTypeDefault, QueryAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, stacktrace.NewError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", QueryAttr, JqExtractorTypeName)
+	}
+	inputFormat, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		extractor.KurtosisValueTypeDefault, InputFormatAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		inputFormat = jqInputFormatJson
+	}
+	return newJqExtractor(query.GoString(), inputFormat.GoString()).extract(input, headers)
+}
+
+func validateJqQuery(value starlark.Value, attrName string) *startosis_errors.InterpretationError {
+	if interpretationErr := builtin_argument.NonEmptyString(value, attrName); interpretationErr != nil {
+		return interpretationErr
+	}
+	query, _ := starlark.AsString(value)
+	if _, err := compileJqQuery(query); err != nil {
+		return startosis_errors.WrapWithInterpretationError(err, "Value for '%s' is not a valid jq query: '%s'", attrName, query)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package recipe
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"net/http"
+	"strings"
+)
+
+const (
+	LineExtractorTypeName = "LineExtractor"
+
+	LineAttr      = "line"
+	ContainsAttr  = "contains"
+	ColumnAttr    = "column"
+	SeparatorAttr = "separator"
+
+	lineSeparator = "\n"
+)
+
+func NewLineExtractorType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: LineExtractorTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              LineAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateInt(value, LineAttr)
+					},
+				},
+				{
+					Name:              ContainsAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, ContainsAttr)
+					},
+				},
+				{
+					Name:              ColumnAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.Int],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return validateInt(value, ColumnAttr)
+					},
+				},
+				{
+					Name:              SeparatorAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, SeparatorAttr)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateLineExtractor,
+	}
+}
+
+func instantiateLineExtractor(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(LineExtractorTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return &LineExtractor{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}, nil
+}
+
+// LineExtractor selects a line of the result of a recipe, optionally among the lines containing a given string, and
+// optionally a column of this line. Negative indexes count from the end, like Starlark lists
+type LineExtractor struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (extractor *LineExtractor) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := extractor.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &LineExtractor{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (extractor *LineExtractor) extract(input []byte, _ http.Header) (starlark.Comparable, error) {
+	lineIndex, _, err := extractor.getIntAttr(LineAttr)
+	if err != nil {
+		return nil, err
+	}
+	contains, filterLines, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		extractor.KurtosisValueTypeDefault, ContainsAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	lines := []string{}
+	for _, line := range strings.Split(strings.TrimSuffix(string(input), lineSeparator), lineSeparator) {
+		line = strings.TrimSuffix(line, "\r")
+		if filterLines && !strings.Contains(line, contains.GoString()) {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	line, found := getElementAtIndex(lines, lineIndex)
+	if !found {
+		return nil, stacktrace.NewError("Line %d could not be found in the %d line(s) of input '%v'", lineIndex, len(lines), string(input))
+	}
+
+	columnIndex, selectColumn, err := extractor.getIntAttr(ColumnAttr)
+	if err != nil {
+		return nil, err
+	}
+	if !selectColumn {
+		return starlark.String(line), nil
+	}
+	separator, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		extractor.KurtosisValueTypeDefault, SeparatorAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	var columns []string
+	if found {
+		columns = strings.Split(line, separator.GoString())
+	} else {
+		columns = strings.Fields(line)
+	}
+	column, found := getElementAtIndex(columns, columnIndex)
+	if !found {
+		return nil, stacktrace.NewError("Column %d could not be found in the %d column(s) of line '%s'", columnIndex, len(columns), line)
+	}
+	return starlark.String(column), nil
+}
+
+func (extractor *LineExtractor) getIntAttr(attrName string) (int, bool, error) {
+	value, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.Int](
+		extractor.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return 0, false, interpretationErr
+	}
+	if !found {
+		return 0, false, nil
+	}
+	valueInt64, ok := value.Int64()
+	if !ok {
+		return 0, false, stacktrace.NewError("Attribute '%s' of '%s' is out of range: %v. This is unexpected and should have been caught earlier", attrName, LineExtractorTypeName, value)
+	}
+	return int(valueInt64), true, nil
+}
+
+func getElementAtIndex(elements []string, index int) (string, bool) {
+	if index < 0 {
+		index += len(elements)
+	}
+	if index < 0 || index >= len(elements) {
+		return "", false
+	}
+	return elements[index], true
+}
@@ -0,0 +1,225 @@
+package recipe
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	"go.starlark.net/starlark"
+	"golang.org/x/exp/maps"
+	"strings"
+	"time"
+)
+
+const (
+	TcpProbeRecipeTypeName = "TcpProbeRecipe"
+	UdpProbeRecipeTypeName = "UdpProbeRecipe"
+
+	SendAttr   = "send"
+	ExpectAttr = "expect"
+
+	defaultProbeTimeout = 5 * time.Second
+)
+
+func NewTcpProbeRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return newPortProbeRecipeType(TcpProbeRecipeTypeName, port_spec.TransportProtocol_TCP)
+}
+
+func NewUdpProbeRecipeType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return newPortProbeRecipeType(UdpProbeRecipeTypeName, port_spec.TransportProtocol_UDP)
+}
+
+// newPortProbeRecipeType creates a recipe which connects to a port, optionally sending some data and waiting for the
+// expected data in return. TcpProbeRecipe and UdpProbeRecipe only differ by the transport protocol used
+func newPortProbeRecipeType(typeName string, transportProtocol port_spec.TransportProtocol) *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: typeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PortIdAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, PortIdAttr)
+					},
+				},
+				{
+					Name:              SendAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              ExpectAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return nil
+					},
+				},
+				{
+					Name:              TimeoutAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.Duration(value, TimeoutAttr)
+					},
+				},
+				{
+					Name:              ExtractAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						_, interpretationErr := convertExtractorsToDict(true, value, jqInputFormatJson)
+						return interpretationErr
+					},
+				},
+			},
+		},
+		Instantiate: func(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+			kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(typeName, arguments)
+			if interpretationErr != nil {
+				return nil, interpretationErr
+			}
+			return &PortProbeRecipe{
+				KurtosisValueTypeDefault: kurtosisValueType,
+				transportProtocol:        transportProtocol,
+			}, nil
+		},
+	}
+}
+
+// PortProbeRecipe is the Go type behind both TcpProbeRecipe and UdpProbeRecipe
+type PortProbeRecipe struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+
+	transportProtocol port_spec.TransportProtocol
+}
+
+func (recipe *PortProbeRecipe) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := recipe.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &PortProbeRecipe{
+		KurtosisValueTypeDefault: copiedValueType,
+		transportProtocol:        recipe.transportProtocol,
+	}, nil
+}
+
+func (recipe *PortProbeRecipe) Execute(
+	ctx context.Context,
+	serviceNetwork service_network.ServiceNetwork,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	serviceName service.ServiceName,
+) (map[string]starlark.Comparable, error) {
+	logrus.Debugf("Running port probe recipe '%s'", recipe.String())
+
+	serviceNameStr := string(serviceName)
+	if serviceNameStr == "" {
+		return nil, stacktrace.NewError("The service name parameter can't be an empty string")
+	}
+
+	portId, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, PortIdAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if !found {
+		return nil, startosis_errors.NewInterpretationError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", PortIdAttr, recipe.Type())
+	}
+
+	dataToSend, err := recipe.getStringAttrWithRuntimeValue(SendAttr, runtimeValueStore)
+	if err != nil {
+		return nil, err
+	}
+	expectedData, err := recipe.getStringAttrWithRuntimeValue(ExpectAttr, runtimeValueStore)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := defaultProbeTimeout
+	timeoutStr, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, TimeoutAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	if found {
+		timeout, err = time.ParseDuration(timeoutStr.GoString())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred parsing timeout '%s' of the probe recipe. This is unexpected and should have been caught earlier", timeoutStr.GoString())
+		}
+	}
+
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+
+	receivedData, err := serviceNetwork.ProbeServicePort(ctx, serviceNameStr, portId.GoString(), recipe.transportProtocol, dataToSend, expectedData, timeout)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred when running probe recipe '%v'", recipe.String())
+	}
+	resultDict := map[string]starlark.Comparable{
+		bodyKey:       starlark.String(receivedData),
+		statusCodeKey: starlark.MakeInt(successfulProbeCode),
+	}
+	extractDict, err := runExtractors([]byte(receivedData), noHeaders, extractors)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred while running extractors from probe recipe")
+	}
+	maps.Copy(resultDict, extractDict)
+	return resultDict, nil
+}
+
+func (recipe *PortProbeRecipe) ResultMapToString(resultMap map[string]starlark.Comparable) string {
+	return resultMapToStringInternal(resultMap)
+}
+
+func (recipe *PortProbeRecipe) CreateStarlarkReturnValue(resultUuid string) (*starlark.Dict, *startosis_errors.InterpretationError) {
+	extractors, interpretationErr := recipe.getExtractors()
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return createStarlarkReturnValueInternal(resultUuid, extractors)
+}
+
+func (recipe *PortProbeRecipe) ProbeType() string {
+	return strings.ToLower(recipe.transportProtocol.String())
+}
+
+func (recipe *PortProbeRecipe) getStringAttrWithRuntimeValue(attrName string, runtimeValueStore *runtime_value_store.RuntimeValueStore) (string, error) {
+	value, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		recipe.KurtosisValueTypeDefault, attrName)
+	if interpretationErr != nil {
+		return "", interpretationErr
+	}
+	if !found {
+		return "", nil
+	}
+	valueWithRuntimeValue, err := magic_string_helper.ReplaceRuntimeValueInString(value.GoString(), runtimeValueStore)
+	if err != nil {
+		return "", stacktrace.Propagate(err, "An error occurred while replacing runtime values in '%s' of the probe recipe", attrName)
+	}
+	return valueWithRuntimeValue, nil
+}
+
+func (recipe *PortProbeRecipe) getExtractors() (map[string]fieldExtractor, *startosis_errors.InterpretationError) {
+	rawExtractors, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[*starlark.Dict](
+		recipe.KurtosisValueTypeDefault, ExtractAttr)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	return convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
+}
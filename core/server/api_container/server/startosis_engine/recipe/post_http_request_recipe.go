@@ -66,7 +66,7 @@ func NewPostHttpRequestRecipeType() *kurtosis_type_constructor.KurtosisTypeConst
 					IsOptional:        true,
 					ZeroValueProvider: builtin_argument.ZeroValueProvider[*starlark.Dict],
 					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
-						_, interpretationErr := convertExtractorsToDict(true, value)
+						_, interpretationErr := convertExtractorsToDict(true, value, jqInputFormatJson)
 						return interpretationErr
 					},
 				},
@@ -149,7 +149,7 @@ func (recipe *PostHttpRequestRecipe) Execute(
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors)
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
@@ -169,6 +169,7 @@ func (recipe *PostHttpRequestRecipe) Execute(
 		postMethod,
 		contentType.GoString(),
 		endpoint.GoString(),
+		noHttpRequestOptions,
 		extractors,
 	)
 	if err != nil {
@@ -187,7 +188,7 @@ func (recipe *PostHttpRequestRecipe) CreateStarlarkReturnValue(resultUuid string
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
-	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors)
+	extractors, interpretationErr := convertExtractorsToDict(found, rawExtractors, jqInputFormatJson)
 	if interpretationErr != nil {
 		return nil, interpretationErr
 	}
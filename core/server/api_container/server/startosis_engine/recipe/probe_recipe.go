@@ -0,0 +1,47 @@
+package recipe
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_validator"
+	"go.starlark.net/starlark"
+)
+
+const (
+	// Result code of a successful probe, mirroring the exit code of a successful ExecRecipe
+	successfulProbeCode = 0
+
+	// Common attributes for all probe recipes
+	TimeoutAttr = "timeout"
+)
+
+// ProbeRecipe is implemented by the recipes talking to a port of a service using something else than HTTP, i.e.
+// GrpcRequestRecipe, TcpProbeRecipe and UdpProbeRecipe. Like HttpRequestRecipe, they return a 'code' and a 'body'
+type ProbeRecipe interface {
+	builtin_argument.KurtosisValueType
+
+	Recipe
+
+	// ProbeType exists so that recipes of other kinds don't implement ProbeRecipe
+	ProbeType() string
+}
+
+func ValidateProbeRecipe(probeRecipe ProbeRecipe, serviceName service.ServiceName, validatorEnvironment *startosis_validator.ValidatorEnvironment) *startosis_errors.ValidationError {
+	if validationErr := validatePortIdAttr(probeRecipe, serviceName, validatorEnvironment); validationErr != nil {
+		return validationErr
+	}
+	descriptorSetArtifactValue, err := probeRecipe.Attr(DescriptorSetArtifactAttr)
+	if err != nil || descriptorSetArtifactValue == nil {
+		// the attribute isn't set or doesn't exist on this kind of probe
+		return nil
+	}
+	descriptorSetArtifactName, ok := starlark.AsString(descriptorSetArtifactValue)
+	if !ok {
+		return startosis_errors.NewValidationError("Tried getting string value for descriptor set artifact '%v' for request to service '%s' but failed", descriptorSetArtifactValue, serviceName)
+	}
+	if validatorEnvironment.DoesArtifactNameExist(descriptorSetArtifactName) == startosis_validator.ComponentNotFound {
+		return startosis_errors.NewValidationError("Request required files artifact '%v' holding the gRPC descriptor set to exist but it doesn't", descriptorSetArtifactName)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package recipe
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/kurtosis_type_constructor"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/stacktrace"
+	"go.starlark.net/starlark"
+	"net/http"
+	"regexp"
+)
+
+const (
+	RegexExtractorTypeName = "RegexExtractor"
+
+	PatternAttr = "pattern"
+	GroupAttr   = "group"
+)
+
+func NewRegexExtractorType() *kurtosis_type_constructor.KurtosisTypeConstructor {
+	return &kurtosis_type_constructor.KurtosisTypeConstructor{
+		KurtosisBaseBuiltin: &kurtosis_starlark_framework.KurtosisBaseBuiltin{
+			Name: RegexExtractorTypeName,
+			Arguments: []*builtin_argument.BuiltinArgument{
+				{
+					Name:              PatternAttr,
+					IsOptional:        false,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						if interpretationErr := builtin_argument.NonEmptyString(value, PatternAttr); interpretationErr != nil {
+							return interpretationErr
+						}
+						pattern, _ := starlark.AsString(value)
+						if _, err := regexp.Compile(pattern); err != nil {
+							return startosis_errors.WrapWithInterpretationError(err, "Value for '%s' is not a valid regular expression: '%s'", PatternAttr, pattern)
+						}
+						return nil
+					},
+				},
+				{
+					Name:              GroupAttr,
+					IsOptional:        true,
+					ZeroValueProvider: builtin_argument.ZeroValueProvider[starlark.String],
+					Validator: func(value starlark.Value) *startosis_errors.InterpretationError {
+						return builtin_argument.NonEmptyString(value, GroupAttr)
+					},
+				},
+			},
+		},
+		Instantiate: instantiateRegexExtractor,
+	}
+}
+
+func instantiateRegexExtractor(arguments *builtin_argument.ArgumentValuesSet) (builtin_argument.KurtosisValueType, *startosis_errors.InterpretationError) {
+	kurtosisValueType, interpretationErr := kurtosis_type_constructor.CreateKurtosisStarlarkTypeDefault(RegexExtractorTypeName, arguments)
+	if interpretationErr != nil {
+		return nil, interpretationErr
+	}
+	extractor := &RegexExtractor{
+		KurtosisValueTypeDefault: kurtosisValueType,
+	}
+	// the group can only be validated against the pattern once both are known
+	if _, _, err := extractor.getPatternAndGroupIndex(); err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Invalid '%s'", RegexExtractorTypeName)
+	}
+	return extractor, nil
+}
+
+// RegexExtractor matches a regular expression against the result of a recipe, returning either the whole match or the
+// value of one of its named capture groups
+type RegexExtractor struct {
+	*kurtosis_type_constructor.KurtosisValueTypeDefault
+}
+
+func (extractor *RegexExtractor) Copy() (builtin_argument.KurtosisValueType, error) {
+	copiedValueType, err := extractor.KurtosisValueTypeDefault.Copy()
+	if err != nil {
+		return nil, err
+	}
+	return &RegexExtractor{
+		KurtosisValueTypeDefault: copiedValueType,
+	}, nil
+}
+
+func (extractor *RegexExtractor) extract(input []byte, _ http.Header) (starlark.Comparable, error) {
+	pattern, groupIndex, err := extractor.getPatternAndGroupIndex()
+	if err != nil {
+		return nil, err
+	}
+	match := pattern.FindSubmatch(input)
+	if match == nil {
+		return nil, stacktrace.NewError("Regular expression '%s' did not match input '%v'", pattern.String(), string(input))
+	}
+	return starlark.String(match[groupIndex]), nil
+}
+
+// getPatternAndGroupIndex returns the compiled pattern along with the index of the submatch to return, which is 0
+// (i.e. the whole match) when no group is set
+func (extractor *RegexExtractor) getPatternAndGroupIndex() (*regexp.Regexp, int, error) {
+	patternStr, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		extractor.KurtosisValueTypeDefault, PatternAttr)
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+	if !found {
+		return nil, 0, stacktrace.NewError("Mandatory attribute '%s' was not set on '%s'. This is unexpected and should have been caught earlier", PatternAttr, RegexExtractorTypeName)
+	}
+	pattern, err := regexp.Compile(patternStr.GoString())
+	if err != nil {
+		return nil, 0, stacktrace.Propagate(err, "An error occurred compiling regular expression '%s'", patternStr.GoString())
+	}
+	group, found, interpretationErr := kurtosis_type_constructor.ExtractAttrValue[starlark.String](
+		extractor.KurtosisValueTypeDefault, GroupAttr)
+	if interpretationErr != nil {
+		return nil, 0, interpretationErr
+	}
+	if !found {
+		return pattern, 0, nil
+	}
+	groupIndex := pattern.SubexpIndex(group.GoString())
+	if groupIndex < 0 {
+		return nil, 0, stacktrace.NewError("Regular expression '%s' has no capture group named '%s'", pattern.String(), group.GoString())
+	}
+	return pattern, groupIndex, nil
+}
@@ -0,0 +1,27 @@
+package recipe
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework/builtin_argument"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"go.starlark.net/starlark"
+	"reflect"
+)
+
+// TypedExtractor is implemented by the extractors which can be used as values of the `extract` dictionary of a recipe
+// instead of a plain jq query, i.e. JqExtractor, RegexExtractor and LineExtractor
+type TypedExtractor interface {
+	builtin_argument.KurtosisValueType
+
+	fieldExtractor
+}
+
+func validateInt(value starlark.Value, attrName string) *startosis_errors.InterpretationError {
+	valueInt, ok := value.(starlark.Int)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Value for '%s' was expected to be an integer but was '%s'", attrName, reflect.TypeOf(value))
+	}
+	if _, ok := valueInt.Int64(); !ok {
+		return startosis_errors.NewInterpretationError("Value for '%s' is out of range: %v", attrName, valueInt)
+	}
+	return nil
+}
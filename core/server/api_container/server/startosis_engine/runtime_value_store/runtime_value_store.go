@@ -93,3 +93,12 @@ func (re *RuntimeValueStore) GetValue(uuid string) (map[string]starlark.Comparab
 
 	return value, nil
 }
+
+// GetPersistedBucketNames returns the names of the enclave database buckets backing the runtime value store, so that
+// their content can be carried over to another enclave
+func GetPersistedBucketNames() []string {
+	return []string{
+		string(recipeResultBucketName),
+		string(serviceAssociatedValuesBucketName),
+	}
+}
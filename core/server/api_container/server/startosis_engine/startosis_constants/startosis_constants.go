@@ -13,4 +13,5 @@ const (
 	PackageIdPlaceholderForStandaloneScript                          = "DEFAULT_PACKAGE_ID_FOR_SCRIPT"
 	PlaceHolderMainFileForPlaceStandAloneScript                      = ""
 	ParallelismParam                            StarlarkContextParam = "PARALLELISM"
+	InstructionMetricsParam                     StarlarkContextParam = "INSTRUCTION_METRICS"
 )
@@ -6,12 +6,14 @@ import (
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers/magic_string_helper"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"sync"
@@ -35,19 +37,23 @@ type StartosisExecutor struct {
 	enclavePlan        *enclave_plan_persistence.EnclavePlan
 	enclaveDb          *enclave_db.EnclaveDB
 	runtimeValueStore  *runtime_value_store.RuntimeValueStore
+	serviceNetwork     service_network.ServiceNetwork
+	filesArtifactStore *enclave_data_directory.FilesArtifactStore
 }
 
 type ExecutionError struct {
 	Error string
 }
 
-func NewStartosisExecutor(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, runtimeValueStore *runtime_value_store.RuntimeValueStore, enclavePlan *enclave_plan_persistence.EnclavePlan, enclaveDb *enclave_db.EnclaveDB) *StartosisExecutor {
+func NewStartosisExecutor(starlarkValueSerde *kurtosis_types.StarlarkValueSerde, runtimeValueStore *runtime_value_store.RuntimeValueStore, enclavePlan *enclave_plan_persistence.EnclavePlan, enclaveDb *enclave_db.EnclaveDB, serviceNetwork service_network.ServiceNetwork, filesArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisExecutor {
 	return &StartosisExecutor{
 		mutex:              &sync.Mutex{},
 		starlarkValueSerde: starlarkValueSerde,
 		enclaveDb:          enclaveDb,
 		enclavePlan:        enclavePlan,
 		runtimeValueStore:  runtimeValueStore,
+		serviceNetwork:     serviceNetwork,
+		filesArtifactStore: filesArtifactStore,
 	}
 }
 
@@ -58,7 +64,20 @@ func NewStartosisExecutor(starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
 // - A regular KurtosisInstruction that was successfully executed
 // - A KurtosisExecutionError if the execution failed
 // - A ProgressInfo to update the current "state" of the execution
-func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, parallelism int, indexOfFirstInstructionInEnclavePlan int, instructionsSequence []*instructions_plan.ScheduledInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
+// - An InstructionMetrics once an instruction is done executing, with its timing and the number of retries it needed
+//
+// When parallelism is greater than 1, the instructions not depending on each other are executed concurrently, up to
+// parallelism instructions at a time. The response lines are still sent in the order of the instructions. When an
+// instruction fails, no new instruction gets started and the execution ends once the executing ones are done. The
+// instructions following the failed one that were executed successfully meanwhile are still added to the enclave plan.
+//
+// Cancelling the context stops the execution before the next instruction, as if it failed. The instructions that were
+// executed successfully until then are added to the enclave plan.
+//
+// When prune is true, the services and files artifacts created by the instructions run in the enclave before that the
+// plan doesn't refer to anymore are removed once all the instructions executed successfully, along with the
+// instructions that created them in the enclave plan. On dry runs, they're only reported.
+func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, prune bool, parallelism int, indexOfFirstInstructionInEnclavePlan int, instructionsSequence []*instructions_plan.ScheduledInstruction, serializedScriptOutput string) <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine {
 	executor.mutex.Lock()
 	starlarkRunResponseLineStream := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
 	ctxWithParallelism := context.WithValue(ctx, startosis_constants.ParallelismParam, parallelism)
@@ -72,7 +91,13 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 		logrus.Debugf("Current enclave plan contains %d instuctions. About to process a new plan with %d instructions starting at index %d (dry-run: %v)",
 			executor.enclavePlan.Size(), len(instructionsSequence), indexOfFirstInstructionInEnclavePlan, dryRun)
 
+		var componentsToPrune *enclaveComponentsToPrune
+		if prune {
+			componentsToPrune = getEnclaveComponentsToPrune(executor.enclavePlan.GeneratePlan(), instructionsSequence)
+		}
+
 		executor.enclavePlan = executor.enclavePlan.PartialDeepClone(indexOfFirstInstructionInEnclavePlan)
+		numberOfInstructionsFromPreviousPlan := executor.enclavePlan.Size()
 
 		defer func() {
 			// TODO: we now perist the plan at the end of the execution. We could persist it everytime an instruction
@@ -89,9 +114,24 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 
 		logrus.Debugf("Transfered %d instructions from previous enclave plan to keep the enclave state consistent", executor.enclavePlan.Size())
 
+		var scheduler *instructionsScheduler
+		if !dryRun && parallelism > 1 {
+			scheduler = newInstructionsScheduler(ctxWithParallelism, parallelism, instructionsSequence)
+			scheduler.start()
+			// the instructions still executing when the execution fails must be done before the plan gets persisted
+			defer scheduler.stopAndWait()
+		}
+
 		totalNumberOfInstructions := uint32(len(instructionsSequence))
 		for index, scheduledInstruction := range instructionsSequence {
 			instructionNumber := uint32(index + 1)
+			if err := ctx.Err(); err != nil {
+				sendErrorAndFail(starlarkRunResponseLineStream, err, "The Starlark run was cancelled before instruction (number %d) at %v", instructionNumber, scheduledInstruction.GetInstruction().GetPositionInOriginalScript().String())
+				if scheduler != nil {
+					executor.appendInstructionsSucceededConcurrently(scheduler, index, instructionsSequence)
+				}
+				return
+			}
 			progress := binding_constructors.NewStarlarkRunResponseLineFromSinglelineProgressInfo(
 				progressMsg, instructionNumber, totalNumberOfInstructions)
 			starlarkRunResponseLineStream <- progress
@@ -101,35 +141,42 @@ func (executor *StartosisExecutor) Execute(ctx context.Context, dryRun bool, par
 			starlarkRunResponseLineStream <- canonicalInstruction
 
 			if !dryRun {
-				var err error
-				var instructionOutput *string
-				if scheduledInstruction.IsExecuted() {
-					// instruction already executed within this enclave. Do not run it
-					instructionOutput = &skippedInstructionOutput
+				// the response lines are sent in the order of the instructions, whatever order they're executed in
+				var result *instructionExecutionResult
+				if scheduler != nil {
+					result = scheduler.waitForResult(index)
 				} else {
-					instructionOutput, err = instruction.Execute(ctxWithParallelism)
+					result = executeScheduledInstruction(ctxWithParallelism, scheduledInstruction)
 				}
-				if err != nil {
-					sendErrorAndFail(starlarkRunResponseLineStream, err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+				starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionMetrics(result.metrics)
+				if result.err != nil {
+					sendErrorAndFail(starlarkRunResponseLineStream, result.err, "An error occurred executing instruction (number %d) at %v:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+					if scheduler != nil {
+						executor.appendInstructionsSucceededConcurrently(scheduler, index+1, instructionsSequence)
+					}
 					return
 				}
-				if instructionOutput != nil {
-					instructionOutputStr := *instructionOutput
+				if result.output != nil {
+					instructionOutputStr := *result.output
 					if len(instructionOutputStr) > outputSizeLimit {
 						instructionOutputStr = fmt.Sprintf("%s%s", instructionOutputStr[0:outputSizeLimit], outputLimitReachedSuffix)
 					}
 					starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInstructionResult(instructionOutputStr)
 				}
 				// add the instruction into the current enclave plan
-				enclavePlanInstruction, err := scheduledInstruction.GetInstruction().GetPersistableAttributes().SetUuid(
-					string(scheduledInstruction.GetUuid()),
-				).SetReturnedValue(
-					executor.starlarkValueSerde.Serialize(scheduledInstruction.GetReturnedValue()),
-				).Build()
-				if err != nil {
+				if err := executor.appendInstructionToEnclavePlan(scheduledInstruction); err != nil {
 					sendErrorAndFail(starlarkRunResponseLineStream, err, "An error occurred persisting instruction (number %d) at %v after it's been executed:\n%v", instructionNumber, instruction.GetPositionInOriginalScript().String(), instruction.String())
+					if scheduler != nil {
+						executor.appendInstructionsSucceededConcurrently(scheduler, index+1, instructionsSequence)
+					}
+					return
 				}
-				executor.enclavePlan.AppendInstruction(enclavePlanInstruction)
+			}
+		}
+
+		if componentsToPrune != nil {
+			if isPruningSuccessful := executor.pruneEnclave(ctx, dryRun, numberOfInstructionsFromPreviousPlan, componentsToPrune, starlarkRunResponseLineStream); !isPruningSuccessful {
+				return
 			}
 		}
 
@@ -153,6 +200,98 @@ func (executor *StartosisExecutor) GetCurrentEnclavePLan() *enclave_plan_persist
 	return executor.enclavePlan
 }
 
+func (executor *StartosisExecutor) appendInstructionToEnclavePlan(scheduledInstruction *instructions_plan.ScheduledInstruction) error {
+	enclavePlanInstruction, err := scheduledInstruction.GetInstruction().GetPersistableAttributes().SetUuid(
+		string(scheduledInstruction.GetUuid()),
+	).SetReturnedValue(
+		executor.starlarkValueSerde.Serialize(scheduledInstruction.GetReturnedValue()),
+	).Build()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred building the enclave plan instruction of instruction '%s'", scheduledInstruction.GetUuid())
+	}
+	executor.enclavePlan.AppendInstruction(enclavePlanInstruction)
+	return nil
+}
+
+// appendInstructionsSucceededConcurrently waits for the instructions still executing to be done, and adds the
+// instructions of the sequence starting at firstInstructionIndex that were executed successfully concurrently to the
+// enclave plan, in the order of the sequence, so that the plan matches what exists in the enclave once the execution
+// stopped early
+func (executor *StartosisExecutor) appendInstructionsSucceededConcurrently(scheduler *instructionsScheduler, firstInstructionIndex int, instructionsSequence []*instructions_plan.ScheduledInstruction) {
+	scheduler.stopAndWait()
+	for index := firstInstructionIndex; index < len(instructionsSequence); index++ {
+		result := scheduler.getResultIfDone(index)
+		if result == nil || result.err != nil {
+			continue
+		}
+		if err := executor.appendInstructionToEnclavePlan(instructionsSequence[index]); err != nil {
+			logrus.Errorf("Instruction (number %d) was executed successfully but couldn't be added to the enclave plan. Next runs of Starlark package might not be executed as expected. Error was:\n%v", index+1, err.Error())
+		}
+	}
+}
+
+// pruneEnclave removes the services and files artifacts to prune from the enclave, and the instructions that created
+// them from the instructions of the enclave plan transferred from the previous plan. It returns false if one of them
+// couldn't be removed, in which case the error has been sent through the stream
+func (executor *StartosisExecutor) pruneEnclave(ctx context.Context, dryRun bool, numberOfInstructionsFromPreviousPlan int, componentsToPrune *enclaveComponentsToPrune, starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
+	prunedServiceNames := map[string]bool{}
+	for _, serviceName := range componentsToPrune.serviceNames {
+		prunedServiceNames[string(serviceName)] = true
+		if dryRun {
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInfo(fmt.Sprintf("Service '%s' would be pruned as it isn't part of the plan anymore", serviceName))
+			continue
+		}
+		serviceUuid, err := executor.serviceNetwork.RemoveService(ctx, string(serviceName))
+		if err != nil {
+			sendErrorAndFail(starlarkRunResponseLineStream, err, "An error occurred pruning service '%s' which isn't part of the plan anymore", serviceName)
+			return false
+		}
+		starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInfo(fmt.Sprintf("Service '%s' with service UUID '%s' pruned as it isn't part of the plan anymore", serviceName, serviceUuid))
+	}
+
+	prunedFilesArtifactNames := map[string]bool{}
+	for _, filesArtifactName := range componentsToPrune.filesArtifactNames {
+		prunedFilesArtifactNames[filesArtifactName] = true
+		if dryRun {
+			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInfo(fmt.Sprintf("Files artifact '%s' would be pruned as it isn't part of the plan anymore", filesArtifactName))
+			continue
+		}
+		if err := executor.filesArtifactStore.RemoveFile(filesArtifactName); err != nil {
+			sendErrorAndFail(starlarkRunResponseLineStream, err, "An error occurred pruning files artifact '%s' which isn't part of the plan anymore", filesArtifactName)
+			return false
+		}
+		starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromInfo(fmt.Sprintf("Files artifact '%s' pruned as it isn't part of the plan anymore", filesArtifactName))
+	}
+
+	if dryRun {
+		return true
+	}
+	prunedEnclavePlan := enclave_plan_persistence.NewEnclavePlan()
+	for index, enclavePlanInstruction := range executor.enclavePlan.GeneratePlan() {
+		if index < numberOfInstructionsFromPreviousPlan && isEnclavePlanInstructionPruned(enclavePlanInstruction, prunedServiceNames, prunedFilesArtifactNames) {
+			continue
+		}
+		prunedEnclavePlan.AppendInstruction(enclavePlanInstruction)
+	}
+	logrus.Debugf("Removed %d instructions from the enclave plan after pruning the enclave", executor.enclavePlan.Size()-prunedEnclavePlan.Size())
+	executor.enclavePlan = prunedEnclavePlan
+	return true
+}
+
+func isEnclavePlanInstructionPruned(enclavePlanInstruction *enclave_plan_persistence.EnclavePlanInstruction, prunedServiceNames map[string]bool, prunedFilesArtifactNames map[string]bool) bool {
+	for _, serviceName := range enclavePlanInstruction.ServiceNames {
+		if prunedServiceNames[serviceName] {
+			return true
+		}
+	}
+	for filesArtifactName := range enclavePlanInstruction.FilesArtifacts {
+		if prunedFilesArtifactNames[filesArtifactName] {
+			return true
+		}
+	}
+	return false
+}
+
 func sendErrorAndFail(starlarkRunResponseLineStream chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, err error, msg string, msgArgs ...interface{}) {
 	propagatedErr := stacktrace.Propagate(err, msg, msgArgs...)
 	serializedError := binding_constructors.NewStarlarkExecutionError(propagatedErr.Error())
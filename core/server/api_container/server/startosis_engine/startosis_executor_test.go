@@ -6,9 +6,17 @@ import (
 	"github.com/google/uuid"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/port_spec"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instruction_metrics"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/add_service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/mock_instruction"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/shared_helpers"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_starlark_framework"
@@ -17,9 +25,12 @@ import (
 	"github.com/stretchr/testify/require"
 	bolt "go.etcd.io/bbolt"
 	"go.starlark.net/starlark"
+	"net"
 	"os"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 const (
@@ -29,11 +40,18 @@ const (
 	doDryRun       = true
 	executeForReal = false
 	isSkipped      = false
+	doNotPrune     = false
+	doPrune        = true
 
 	noScriptOutputObject = ""
 	noParallelism        = 1
+	testParallelism      = 4
+
+	parallelExecutionTestTimeout = 10 * time.Second
 
 	enclaveDbFilePerm = 0666
+
+	testEnclaveUuid = enclave.EnclaveUUID("test-enclave")
 )
 
 var (
@@ -49,7 +67,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_Success(t *testing.T) {
 	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
 	require.NoError(t, createRuntimeValueStoreErr)
 
-	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
 
 	instructionsPlan := instructions_plan.NewInstructionsPlan()
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
@@ -93,7 +111,7 @@ func TestExecuteKurtosisInstructions_ExecuteForReal_FailureHalfWay(t *testing.T)
 	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
 	require.NoError(t, err)
 
-	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", throwOnExecute)
@@ -138,7 +156,7 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
 	require.NoError(t, createRuntimeValueStoreErr)
 
-	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb)
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
 
 	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
 	instruction2 := createMockInstruction(t, "instruction2", executeSuccessfully)
@@ -164,6 +182,369 @@ func TestExecuteKurtosisInstructions_DoDryRun(t *testing.T) {
 	require.Equal(t, serializedInstruction, expectedSerializedInstructions)
 }
 
+func TestExecuteKurtosisInstructions_ExecuteForReal_SendsInstructionMetrics(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(nil, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
+
+	instruction1 := createMockInstruction(t, "instruction1", executeSuccessfully)
+	instruction2 := mock_instruction.NewMockKurtosisInstruction(t)
+	canonicalInstruction2 := binding_constructors.NewStarlarkInstruction(
+		dummyPosition.ToAPIType(), "instruction2", "instruction2()", noInstructionArgsForTesting, isSkipped)
+	instruction2.EXPECT().GetCanonicalInstruction(mock.Anything).Maybe().Return(canonicalInstruction2)
+	instruction2.EXPECT().GetPersistableAttributes().Maybe().Return(
+		enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetUuid(uuid.New().String()).SetType("instruction2").SetStarlarkCode("instruction2()").SetReturnedValue("None"),
+		nil,
+	)
+	instruction2.EXPECT().Execute(mock.Anything).Run(func(ctx context.Context) {
+		instruction_metrics.RecordRetries(ctx, 2)
+		instruction_metrics.RecordRetries(ctx, 1)
+	}).Return(nil, nil)
+
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(instruction1, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction2, starlark.None))
+	scheduledInstructions, err := instructionsPlan.GeneratePlan()
+	require.Nil(t, err)
+	scheduledInstructions[0].AddImagePullDuration(1500 * time.Millisecond)
+
+	var instructionsMetrics []*kurtosis_core_rpc_api_bindings.StarlarkInstructionMetrics
+	for executionResponseLine := range executor.Execute(context.Background(), executeForReal, doNotPrune, noParallelism, 0, scheduledInstructions, noScriptOutputObject) {
+		require.Nil(t, executionResponseLine.GetError())
+		if executionResponseLine.GetInstructionMetrics() != nil {
+			instructionsMetrics = append(instructionsMetrics, executionResponseLine.GetInstructionMetrics())
+		}
+	}
+
+	require.Len(t, instructionsMetrics, 2)
+	require.Equal(t, uint64(1500), instructionsMetrics[0].GetImagePullDurationMs())
+	require.Equal(t, uint32(0), instructionsMetrics[0].GetNumRetries())
+	require.Equal(t, uint64(0), instructionsMetrics[1].GetImagePullDurationMs())
+	require.Equal(t, uint32(3), instructionsMetrics[1].GetNumRetries())
+	for _, instructionMetrics := range instructionsMetrics {
+		require.LessOrEqual(t, instructionMetrics.GetStartTimestampMs(), instructionMetrics.GetEndTimestampMs())
+		require.Equal(t, uint64(instructionMetrics.GetEndTimestampMs()-instructionMetrics.GetStartTimestampMs()), instructionMetrics.GetDurationMs())
+	}
+}
+
+func TestExecuteKurtosisInstructions_ExecuteForReal_ParallelExecution(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(dummySerde, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
+
+	executionOrderMutex := &sync.Mutex{}
+	var executionOrder []string
+	recordExecution := func(instructionName string) {
+		executionOrderMutex.Lock()
+		defer executionOrderMutex.Unlock()
+		executionOrder = append(executionOrder, instructionName)
+	}
+
+	// instruction1 only completes once instruction3 executed, which requires both to execute concurrently
+	instruction3Executed := make(chan struct{})
+	instruction1 := createMockInstructionWithService(t, "instruction1", `instruction1(name="service-1")`, "service-1", func() error {
+		select {
+		case <-instruction3Executed:
+		case <-time.After(parallelExecutionTestTimeout):
+			return errors.New("instruction3 was not executed concurrently to instruction1")
+		}
+		recordExecution("instruction1")
+		return nil
+	})
+	instruction2 := createMockInstructionWithService(t, "instruction2", `instruction2(name="service-2", target="service-1")`, "service-2", func() error {
+		recordExecution("instruction2")
+		return nil
+	})
+	instruction3 := createMockInstructionWithService(t, "instruction3", `instruction3(name="service-3")`, "service-3", func() error {
+		recordExecution("instruction3")
+		close(instruction3Executed)
+		return nil
+	})
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(instruction1, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction2, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction3, starlark.None))
+
+	_, serializedInstructions, err := executeSynchronouslyWithParallelism(t, executor, testParallelism, instructionsPlan)
+	require.Nil(t, err)
+
+	// instruction2 depends on instruction1 as it references service-1
+	require.Equal(t, []string{"instruction3", "instruction1", "instruction2"}, executionOrder)
+	// the response lines still follow the order of the instructions
+	require.Len(t, serializedInstructions, 3)
+	require.Equal(t, "instruction1", serializedInstructions[0].GetInstructionName())
+	require.Equal(t, "instruction2", serializedInstructions[1].GetInstructionName())
+	require.Equal(t, "instruction3", serializedInstructions[2].GetInstructionName())
+	require.Equal(t, 3, executor.enclavePlan.Size())
+}
+
+func TestExecuteKurtosisInstructions_ExecuteForReal_IndependentAddServicesStartServicesConcurrently(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	backend := backend_interface.NewMockKurtosisBackend(t)
+	serviceNetwork, err := service_network.NewDefaultServiceNetwork(testEnclaveUuid, nil, backend, nil, enclaveDb, nil)
+	require.NoError(t, err)
+
+	executor := NewStartosisExecutor(dummySerde, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, serviceNetwork, nil)
+
+	backend.EXPECT().RegisterUserServices(mock.Anything, testEnclaveUuid, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, serviceNames map[service.ServiceName]bool) (map[service.ServiceName]*service.ServiceRegistration, map[service.ServiceName]error, error) {
+			serviceRegistrations := map[service.ServiceName]*service.ServiceRegistration{}
+			for serviceName := range serviceNames {
+				serviceUuid := service.ServiceUUID(serviceName + "-uuid")
+				serviceRegistrations[serviceName] = service.NewServiceRegistration(serviceName, serviceUuid, testEnclaveUuid, net.IPv4(1, 1, 1, 1), string(serviceName))
+			}
+			return serviceRegistrations, map[service.ServiceName]error{}, nil
+		}).Times(2)
+
+	// each service only finishes starting once the other one started starting too, which requires the containers of
+	// both add_service instructions to be started concurrently
+	servicesStarting := &sync.WaitGroup{}
+	servicesStarting.Add(2)
+	allServicesStarting := make(chan struct{})
+	go func() {
+		servicesStarting.Wait()
+		close(allServicesStarting)
+	}()
+	backend.EXPECT().StartRegisteredUserServices(mock.Anything, testEnclaveUuid, mock.Anything).RunAndReturn(
+		func(_ context.Context, _ enclave.EnclaveUUID, serviceConfigs map[service.ServiceUUID]*service.ServiceConfig) (map[service.ServiceUUID]*service.Service, map[service.ServiceUUID]error, error) {
+			servicesStarting.Done()
+			select {
+			case <-allServicesStarting:
+			case <-time.After(parallelExecutionTestTimeout):
+				return nil, nil, errors.New("the other service was not started concurrently to this one")
+			}
+			startedServices := map[service.ServiceUUID]*service.Service{}
+			for serviceUuid := range serviceConfigs {
+				serviceName := service.ServiceName(strings.TrimSuffix(string(serviceUuid), "-uuid"))
+				serviceRegistration := service.NewServiceRegistration(serviceName, serviceUuid, testEnclaveUuid, net.IPv4(1, 1, 1, 1), string(serviceName))
+				startedServices[serviceUuid] = service.NewService(serviceRegistration, container_status.ContainerStatus_Running, map[string]*port_spec.PortSpec{}, net.IPv4(1, 1, 1, 1), map[string]*port_spec.PortSpec{})
+			}
+			return startedServices, map[service.ServiceUUID]error{}, nil
+		}).Times(2)
+
+	// only called when a service fails to start, which gets the batch of the service rolled back
+	backend.EXPECT().DestroyUserServices(mock.Anything, testEnclaveUuid, mock.Anything).Maybe().Return(map[service.ServiceUUID]bool{}, map[service.ServiceUUID]error{}, nil)
+	backend.EXPECT().UnregisterUserServices(mock.Anything, testEnclaveUuid, mock.Anything).Maybe().Return(map[service.ServiceUUID]bool{}, map[service.ServiceUUID]error{}, nil)
+
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	for _, serviceName := range []service.ServiceName{"service-1", "service-2"} {
+		serviceNameToAdd := serviceName
+		instruction := createMockInstructionWithService(t, add_service.AddServiceBuiltinName, `add_service(name="`+string(serviceNameToAdd)+`")`, serviceNameToAdd, func() error {
+			_, err := serviceNetwork.AddService(context.Background(), serviceNameToAdd, testServiceConfig())
+			return err
+		})
+		require.NoError(t, instructionsPlan.AddInstruction(instruction, starlark.None))
+	}
+
+	_, serializedInstructions, executionError := executeSynchronouslyWithParallelism(t, executor, testParallelism, instructionsPlan)
+	require.Nil(t, executionError)
+	require.Len(t, serializedInstructions, 2)
+
+	serviceNames, err := serviceNetwork.GetServiceNames()
+	require.NoError(t, err)
+	require.Equal(t, map[service.ServiceName]bool{"service-1": true, "service-2": true}, serviceNames)
+}
+
+func TestExecuteKurtosisInstructions_ExecuteForReal_ParallelExecutionFailure(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(dummySerde, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
+
+	instruction1 := createMockInstructionWithService(t, "instruction1", `instruction1(name="service-1")`, "service-1", func() error {
+		return errors.New("expected error for test")
+	})
+	instruction2 := createMockInstructionWithService(t, "instruction2", `instruction2(name="service-2", target="service-1")`, "service-2", func() error {
+		return nil
+	})
+	instruction3 := createMockInstructionWithService(t, "instruction3", `instruction3(name="service-3")`, "service-3", func() error {
+		return nil
+	})
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(instruction1, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction2, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction3, starlark.None))
+
+	_, serializedInstructions, executionError := executeSynchronouslyWithParallelism(t, executor, testParallelism, instructionsPlan)
+	require.NotNil(t, executionError)
+	require.Contains(t, executionError.GetErrorMessage(), "An error occurred executing instruction (number 1)")
+	// instruction2 is never executed as it depends on the failed instruction
+	instruction2.AssertNumberOfCalls(t, "Execute", 0)
+	require.Len(t, serializedInstructions, 1)
+	for _, enclavePlanInstruction := range executor.enclavePlan.GeneratePlan() {
+		require.Equal(t, []string{"service-3"}, enclavePlanInstruction.ServiceNames)
+	}
+}
+
+func TestExecuteKurtosisInstructions_ExecuteForReal_ParallelExecutionFailureAfterIndependentInstructionsSucceeded(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(dummySerde, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
+
+	// instruction1 only fails once the independent instruction2 and instruction4 executed successfully
+	instruction2Executed := make(chan struct{})
+	instruction4Executed := make(chan struct{})
+	instruction1 := createMockInstructionWithService(t, "instruction1", `instruction1(name="service-1")`, "service-1", func() error {
+		for _, instructionExecuted := range []chan struct{}{instruction2Executed, instruction4Executed} {
+			select {
+			case <-instructionExecuted:
+			case <-time.After(parallelExecutionTestTimeout):
+				return errors.New("independent instructions were not executed concurrently to instruction1")
+			}
+		}
+		return errors.New("expected error for test")
+	})
+	instruction2 := createMockInstructionWithService(t, "instruction2", `instruction2(name="service-2")`, "service-2", func() error {
+		close(instruction2Executed)
+		return nil
+	})
+	instruction3 := createMockInstructionWithService(t, "instruction3", `instruction3(name="service-3", target="service-1")`, "service-3", func() error {
+		return nil
+	})
+	instruction4 := createMockInstructionWithService(t, "instruction4", `instruction4(name="service-4")`, "service-4", func() error {
+		close(instruction4Executed)
+		return nil
+	})
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(instruction1, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction2, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction3, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction4, starlark.None))
+
+	_, serializedInstructions, executionError := executeSynchronouslyWithParallelism(t, executor, testParallelism, instructionsPlan)
+	require.NotNil(t, executionError)
+	require.Contains(t, executionError.GetErrorMessage(), "An error occurred executing instruction (number 1)")
+	require.Len(t, serializedInstructions, 1)
+	instruction3.AssertNumberOfCalls(t, "Execute", 0)
+
+	// the instructions that succeeded after the failed one in the sequence are in the plan, in the sequence order
+	enclavePlanInstructions := executor.enclavePlan.GeneratePlan()
+	require.Len(t, enclavePlanInstructions, 2)
+	require.Equal(t, `instruction2(name="service-2")`, enclavePlanInstructions[0].StarlarkCode)
+	require.Equal(t, []string{"service-2"}, enclavePlanInstructions[0].ServiceNames)
+	require.Equal(t, `instruction4(name="service-4")`, enclavePlanInstructions[1].StarlarkCode)
+	require.Equal(t, []string{"service-4"}, enclavePlanInstructions[1].ServiceNames)
+}
+
+func TestExecuteKurtosisInstructions_ExecuteForReal_Cancelled(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	executor := NewStartosisExecutor(dummySerde, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, nil, nil)
+
+	// the run gets cancelled while instruction1 executes
+	ctx, cancelRun := context.WithCancel(context.Background())
+	defer cancelRun()
+	instruction1 := createMockInstructionWithService(t, "instruction1", `instruction1(name="service-1")`, "service-1", func() error {
+		cancelRun()
+		return nil
+	})
+	instruction2 := createMockInstructionWithService(t, "instruction2", `instruction2(name="service-2")`, "service-2", func() error {
+		return nil
+	})
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(instruction1, starlark.None))
+	require.NoError(t, instructionsPlan.AddInstruction(instruction2, starlark.None))
+
+	scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+	require.Nil(t, interpretationErr)
+	_, serializedInstructions, executionError := collectExecutionResponseLines(t, executor.Execute(ctx, executeForReal, doNotPrune, noParallelism, 0, scheduledInstructions, noScriptOutputObject))
+	require.NotNil(t, executionError)
+	require.Contains(t, executionError.GetErrorMessage(), "The Starlark run was cancelled before instruction (number 2)")
+	require.Len(t, serializedInstructions, 1)
+	instruction2.AssertNumberOfCalls(t, "Execute", 0)
+
+	// the instruction executed before the run got cancelled is in the plan
+	enclavePlanInstructions := executor.enclavePlan.GeneratePlan()
+	require.Len(t, enclavePlanInstructions, 1)
+	require.Equal(t, `instruction1(name="service-1")`, enclavePlanInstructions[0].StarlarkCode)
+}
+
+func TestExecuteKurtosisInstructions_ExecuteForReal_Prune(t *testing.T) {
+	enclaveDb := getEnclaveDBForTest(t)
+
+	dummySerde := shared_helpers.NewDummyStarlarkValueSerDeForTest()
+
+	runtimeValueStore, createRuntimeValueStoreErr := runtime_value_store.CreateRuntimeValueStore(dummySerde, enclaveDb)
+	require.NoError(t, createRuntimeValueStoreErr)
+
+	// the services were added by a previous run
+	enclavePlan := enclave_plan_persistence.NewEnclavePlan()
+	for _, serviceName := range []service.ServiceName{"service-1", "service-2"} {
+		enclavePlanInstruction, err := enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetUuid(
+			uuid.New().String(),
+		).SetType(
+			add_service.AddServiceBuiltinName,
+		).SetStarlarkCode(
+			`add_service(name="` + string(serviceName) + `")`,
+		).SetReturnedValue(
+			"None",
+		).AddServiceName(
+			serviceName,
+		).Build()
+		require.NoError(t, err)
+		enclavePlan.AppendInstruction(enclavePlanInstruction)
+	}
+
+	serviceNetwork := service_network.NewMockServiceNetwork(t)
+	serviceNetwork.EXPECT().RemoveService(mock.Anything, "service-2").Times(1).Return(service.ServiceUUID("service-2-uuid"), nil)
+
+	executor := NewStartosisExecutor(dummySerde, runtimeValueStore, enclavePlan, enclaveDb, serviceNetwork, nil)
+
+	instruction := createMockInstructionWithService(t, add_service.AddServiceBuiltinName, `add_service(name="service-1")`, "service-1", func() error {
+		return nil
+	})
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+	require.NoError(t, instructionsPlan.AddInstruction(instruction, starlark.None))
+	scheduledInstructions, interpretationErr := instructionsPlan.GeneratePlan()
+	require.Nil(t, interpretationErr)
+
+	indexOfFirstInstructionInEnclavePlan := enclavePlan.Size()
+	executionResponseLines, infoMessages := recordInfoMessages(executor.Execute(context.Background(), executeForReal, doPrune, noParallelism, indexOfFirstInstructionInEnclavePlan, scheduledInstructions, noScriptOutputObject))
+	scriptOutput, serializedInstructions, err := collectExecutionResponseLines(t, executionResponseLines)
+	require.Nil(t, err)
+	require.Len(t, serializedInstructions, 1)
+	// pruning notices are sent as info lines such that they don't end up in the output of the run
+	require.Empty(t, scriptOutput)
+	require.Equal(t, []string{"Service 'service-2' with service UUID 'service-2-uuid' pruned as it isn't part of the plan anymore"}, *infoMessages)
+
+	// the instruction adding service-2 is dropped from the instructions transferred from the previous plan
+	enclavePlanInstructions := executor.enclavePlan.GeneratePlan()
+	require.Len(t, enclavePlanInstructions, 2)
+	require.Equal(t, []string{"service-1"}, enclavePlanInstructions[0].ServiceNames)
+	require.Equal(t, `add_service(name="service-1")`, enclavePlanInstructions[0].StarlarkCode)
+	require.Equal(t, []string{"service-1"}, enclavePlanInstructions[1].ServiceNames)
+}
+
 func createMockInstruction(t *testing.T, instructionName string, executeSuccessfully bool) *mock_instruction.MockKurtosisInstruction {
 	instruction := mock_instruction.NewMockKurtosisInstruction(t)
 
@@ -187,17 +568,78 @@ func createMockInstruction(t *testing.T, instructionName string, executeSuccessf
 	return instruction
 }
 
+func createMockInstructionWithService(t *testing.T, instructionName string, stringifiedInstruction string, serviceName service.ServiceName, execute func() error) *mock_instruction.MockKurtosisInstruction {
+	instruction := mock_instruction.NewMockKurtosisInstruction(t)
+
+	canonicalInstruction := binding_constructors.NewStarlarkInstruction(
+		dummyPosition.ToAPIType(), instructionName, stringifiedInstruction, noInstructionArgsForTesting, isSkipped)
+	instruction.EXPECT().GetCanonicalInstruction(mock.Anything).Maybe().Return(canonicalInstruction)
+	instruction.EXPECT().GetPositionInOriginalScript().Maybe().Return(dummyPosition)
+	instruction.EXPECT().String().Maybe().Return(stringifiedInstruction)
+	instruction.EXPECT().GetPersistableAttributes().RunAndReturn(func() *enclave_plan_persistence.EnclavePlanInstructionBuilder {
+		return enclave_plan_persistence.NewEnclavePlanInstructionBuilder().SetType(instructionName).SetStarlarkCode(stringifiedInstruction).AddServiceName(serviceName)
+	}).Maybe()
+	instruction.EXPECT().Execute(mock.Anything).RunAndReturn(func(_ context.Context) (*string, error) {
+		return nil, execute()
+	}).Maybe()
+
+	return instruction
+}
+
+func testServiceConfig() *service.ServiceConfig {
+	return service.NewServiceConfig(
+		"kurtosistech/test-container",
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		0,
+		"",
+		0,
+		0,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		nil,
+		0,
+		nil,
+		nil,
+		nil,
+		nil,
+	)
+}
+
 func executeSynchronously(t *testing.T, executor *StartosisExecutor, dryRun bool, instructionsPlan *instructions_plan.InstructionsPlan) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
-	scriptOutput := strings.Builder{}
-	var serializedInstructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
+	scheduledInstructions, err := instructionsPlan.GeneratePlan()
+	require.Nil(t, err)
+	return collectExecutionResponseLines(t, executor.Execute(context.Background(), dryRun, doNotPrune, noParallelism, 0, scheduledInstructions, noScriptOutputObject))
+}
 
+func executeSynchronouslyWithParallelism(t *testing.T, executor *StartosisExecutor, parallelism int, instructionsPlan *instructions_plan.InstructionsPlan) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
 	scheduledInstructions, err := instructionsPlan.GeneratePlan()
 	require.Nil(t, err)
+	return collectExecutionResponseLines(t, executor.Execute(context.Background(), executeForReal, doNotPrune, parallelism, 0, scheduledInstructions, noScriptOutputObject))
+}
+
+func collectExecutionResponseLines(t *testing.T, executionResponseLines <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (string, []*kurtosis_core_rpc_api_bindings.StarlarkInstruction, *kurtosis_core_rpc_api_bindings.StarlarkExecutionError) {
+	scriptOutput := strings.Builder{}
+	var serializedInstructions []*kurtosis_core_rpc_api_bindings.StarlarkInstruction
+	var executionError *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
 
-	executionResponseLines := executor.Execute(context.Background(), dryRun, noParallelism, 0, scheduledInstructions, noScriptOutputObject)
+	// the stream is read until it's closed so that the execution is fully done when this returns
 	for executionResponseLine := range executionResponseLines {
+		if executionError != nil {
+			continue
+		}
 		if executionResponseLine.GetError() != nil {
-			return scriptOutput.String(), serializedInstructions, executionResponseLine.GetError().GetExecutionError()
+			executionError = executionResponseLine.GetError().GetExecutionError()
+			continue
 		}
 		if executionResponseLine.GetInstruction() != nil {
 			executedKurtosisInstruction := executionResponseLine.GetInstruction()
@@ -209,7 +651,24 @@ func executeSynchronously(t *testing.T, executor *StartosisExecutor, dryRun bool
 			}
 		}
 	}
-	return scriptOutput.String(), serializedInstructions, nil
+	return scriptOutput.String(), serializedInstructions, executionError
+}
+
+// recordInfoMessages forwards the response lines to the returned channel and records the info messages. The messages
+// are all recorded once the returned channel is closed
+func recordInfoMessages(executionResponseLines <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (<-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, *[]string) {
+	forwardedResponseLines := make(chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine)
+	infoMessages := []string{}
+	go func() {
+		defer close(forwardedResponseLines)
+		for executionResponseLine := range executionResponseLines {
+			if executionResponseLine.GetInfo() != nil {
+				infoMessages = append(infoMessages, executionResponseLine.GetInfo().GetInfoMessage())
+			}
+			forwardedResponseLines <- executionResponseLine
+		}
+	}()
+	return forwardedResponseLines, &infoMessages
 }
 
 func getEnclaveDBForTest(t *testing.T) *enclave_db.EnclaveDB {
@@ -3,7 +3,9 @@ package startosis_engine
 import (
 	"context"
 	"github.com/google/uuid"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
@@ -434,6 +436,233 @@ func (suite *StartosisInterpreterIdempotentTestSuite) TestInterpretAndOptimize_U
 	require.True(suite.T(), scheduledInstruction4.IsExecuted()) // this instruction is not affected, i.e. it won't be re-run
 }
 
+// Submit a package updating a service, updating the files artifact another service mounts and adding a service. Check
+// that the diff reports each change along with the reason why the services get updated or restarted
+func (suite *StartosisInterpreterIdempotentTestSuite) TestDiffPlan() {
+	initialScript := `def run(plan):
+	files_artifact = plan.render_templates(
+        name="files_artifact",
+        config={
+            "/output.txt": struct(template="Hello {{.World}}", data={"World" : "World!"}),
+        }
+    )
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3", files={"/path/": files_artifact}))
+	plan.add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+	plan.add_service(name="service_3", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+`
+	_, currentEnclavePlan, interpretationApiErr := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		initialScript,
+		noInputParams,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0))
+	require.Nil(suite.T(), interpretationApiErr)
+	convertedEnclavePlan := suite.convertInstructionPlanToEnclavePlan(currentEnclavePlan)
+
+	updatedScript := `def run(plan):
+	files_artifact = plan.render_templates(
+        name="files_artifact",
+        config={
+            "/output.txt": struct(template="Bonjour {{.World}}", data={"World" : "World!"}), # updated template
+        }
+    )
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3", files={"/path/": files_artifact})) # <-- restarted
+	plan.add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.5.0")) # <-- version updated
+	plan.add_service(name="service_3", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+	plan.add_service(name="service_4", config=ServiceConfig(image="kurtosistech/image:1.2.3")) # <-- added
+`
+	_, instructionsPlan, interpretationError := suite.interpreter.InterpretAndOptimizePlan(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		updatedScript,
+		noInputParams,
+		convertedEnclavePlan,
+	)
+	require.Nil(suite.T(), interpretationError)
+	instructionSequence, err := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), err)
+
+	diff := diffPlan(convertedEnclavePlan.GeneratePlan(), instructionSequence, doNotPrune)
+	require.Nil(suite.T(), diff.GetInterpretationError())
+	require.Equal(suite.T(), uint32(4), diff.GetInstructionsToRunCount())
+	require.Equal(suite.T(), uint32(1), diff.GetInstructionsToSkipCount())
+
+	serviceChanges := diff.GetServiceChanges()
+	require.Len(suite.T(), serviceChanges, 3)
+
+	require.Equal(suite.T(), "service_1", serviceChanges[0].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_RESTARTED, serviceChanges[0].GetChangeType())
+	require.Equal(suite.T(), []string{"Files artifact 'files_artifact' mounted at '/path/' changed as the arguments of the instruction creating it changed"}, serviceChanges[0].GetReasons())
+
+	require.Equal(suite.T(), "service_2", serviceChanges[1].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_UPDATED, serviceChanges[1].GetChangeType())
+	require.Equal(suite.T(), []string{`Its arguments changed from 'add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.2.3"))' to 'add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.5.0"))'`}, serviceChanges[1].GetReasons())
+
+	require.Equal(suite.T(), "service_4", serviceChanges[2].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_ADDED, serviceChanges[2].GetChangeType())
+	require.Empty(suite.T(), serviceChanges[2].GetReasons())
+}
+
+// Submit a package removing a service. As remove_service instructions can't be resolved with the instructions run in
+// the enclave before, check that the diff reports every service as restarted on top of the removal
+func (suite *StartosisInterpreterIdempotentTestSuite) TestDiffPlan_RemovedService() {
+	initialScript := `def run(plan):
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+`
+	_, currentEnclavePlan, interpretationApiErr := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		initialScript,
+		noInputParams,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0))
+	require.Nil(suite.T(), interpretationApiErr)
+	convertedEnclavePlan := suite.convertInstructionPlanToEnclavePlan(currentEnclavePlan)
+
+	updatedScript := `def run(plan):
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+	plan.remove_service(name="service_1")
+`
+	_, instructionsPlan, interpretationError := suite.interpreter.InterpretAndOptimizePlan(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		updatedScript,
+		noInputParams,
+		convertedEnclavePlan,
+	)
+	require.Nil(suite.T(), interpretationError)
+	instructionSequence, err := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), err)
+
+	diff := diffPlan(convertedEnclavePlan.GeneratePlan(), instructionSequence, doNotPrune)
+	require.Equal(suite.T(), uint32(2), diff.GetInstructionsToRunCount())
+	require.Equal(suite.T(), uint32(0), diff.GetInstructionsToSkipCount())
+
+	serviceChanges := diff.GetServiceChanges()
+	require.Len(suite.T(), serviceChanges, 2)
+
+	require.Equal(suite.T(), "service_1", serviceChanges[0].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_RESTARTED, serviceChanges[0].GetChangeType())
+	require.Equal(suite.T(), []string{unmatchedInstructionReason}, serviceChanges[0].GetReasons())
+
+	require.Equal(suite.T(), "service_1", serviceChanges[1].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_REMOVED, serviceChanges[1].GetChangeType())
+}
+
+// Submit a package that doesn't add one of the services nor create the files artifact anymore, and check that both
+// are pruned while the services still referred to by the package are kept
+func (suite *StartosisInterpreterIdempotentTestSuite) TestGetEnclaveComponentsToPrune() {
+	initialScript := `def run(plan):
+	files_artifact = plan.render_templates(
+        name="files_artifact",
+        config={
+            "/output.txt": struct(template="Hello {{.World}}", data={"World" : "World!"}),
+        }
+    )
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3", files={"/path/": files_artifact}))
+	plan.add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+	plan.add_service(name="service_3", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+	plan.remove_service(name="service_3")
+`
+	_, currentEnclavePlan, interpretationApiErr := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		initialScript,
+		noInputParams,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0))
+	require.Nil(suite.T(), interpretationApiErr)
+	convertedEnclavePlan := suite.convertInstructionPlanToEnclavePlan(currentEnclavePlan)
+
+	updatedScript := `def run(plan):
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3")) # <-- doesn't mount the files artifact anymore
+`
+	_, instructionsPlan, interpretationError := suite.interpreter.InterpretAndOptimizePlan(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		updatedScript,
+		noInputParams,
+		convertedEnclavePlan,
+	)
+	require.Nil(suite.T(), interpretationError)
+	instructionSequence, err := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), err)
+
+	componentsToPrune := getEnclaveComponentsToPrune(convertedEnclavePlan.GeneratePlan(), instructionSequence)
+	// service_3 was already removed
+	require.Equal(suite.T(), []service.ServiceName{"service_2"}, componentsToPrune.serviceNames)
+	require.Equal(suite.T(), []string{"files_artifact"}, componentsToPrune.filesArtifactNames)
+}
+
+// Submit a package that doesn't add one of the services anymore, and check that the diff reports it as removed only
+// when the run prunes the enclave
+func (suite *StartosisInterpreterIdempotentTestSuite) TestDiffPlan_PrunedService() {
+	initialScript := `def run(plan):
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+	plan.add_service(name="service_2", config=ServiceConfig(image="kurtosistech/image:1.2.3"))
+`
+	_, currentEnclavePlan, interpretationApiErr := suite.interpreter.Interpret(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		initialScript,
+		noInputParams,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0))
+	require.Nil(suite.T(), interpretationApiErr)
+	convertedEnclavePlan := suite.convertInstructionPlanToEnclavePlan(currentEnclavePlan)
+
+	updatedScript := `def run(plan):
+	plan.add_service(name="service_1", config=ServiceConfig(image="kurtosistech/image:1.2.3")) # <-- service_2 dropped
+`
+	_, instructionsPlan, interpretationError := suite.interpreter.InterpretAndOptimizePlan(
+		context.Background(),
+		startosis_constants.PackageIdPlaceholderForStandaloneScript,
+		useDefaultMainFunctionName,
+		startosis_constants.PlaceHolderMainFileForPlaceStandAloneScript,
+		updatedScript,
+		noInputParams,
+		convertedEnclavePlan,
+	)
+	require.Nil(suite.T(), interpretationError)
+	instructionSequence, err := instructionsPlan.GeneratePlan()
+	require.Nil(suite.T(), err)
+
+	// dropping an instruction prevents the previous instructions from being matched, so service_1 is restarted anyway
+	diffWithoutPruning := diffPlan(convertedEnclavePlan.GeneratePlan(), instructionSequence, doNotPrune)
+	require.Len(suite.T(), diffWithoutPruning.GetServiceChanges(), 1)
+	require.Equal(suite.T(), "service_1", diffWithoutPruning.GetServiceChanges()[0].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_RESTARTED, diffWithoutPruning.GetServiceChanges()[0].GetChangeType())
+
+	diff := diffPlan(convertedEnclavePlan.GeneratePlan(), instructionSequence, doPrune)
+	require.Equal(suite.T(), uint32(1), diff.GetInstructionsToRunCount())
+	require.Equal(suite.T(), uint32(0), diff.GetInstructionsToSkipCount())
+
+	serviceChanges := diff.GetServiceChanges()
+	require.Len(suite.T(), serviceChanges, 2)
+
+	require.Equal(suite.T(), "service_1", serviceChanges[0].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_RESTARTED, serviceChanges[0].GetChangeType())
+
+	require.Equal(suite.T(), "service_2", serviceChanges[1].GetServiceName())
+	require.Equal(suite.T(), kurtosis_core_rpc_api_bindings.ServiceChangeType_REMOVED, serviceChanges[1].GetChangeType())
+	require.Equal(suite.T(), []string{prunedServiceReason}, serviceChanges[1].GetReasons())
+}
+
 func (suite *StartosisInterpreterIdempotentTestSuite) convertInstructionPlanToEnclavePlan(instructionPlan *instructions_plan.InstructionsPlan) *enclave_plan_persistence.EnclavePlan {
 	enclavePlan := enclave_plan_persistence.NewEnclavePlan()
 	instructionPlanSequence, interpretationErr := instructionPlan.GeneratePlan()
@@ -0,0 +1,273 @@
+package startosis_engine
+
+import (
+	"context"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/kurtosis_core_rpc_api_bindings"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/binding_constructors"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/builtins/testing_module"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_plan_persistence"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_instruction/plan_module"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/runtime_value_store"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/stacktrace"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.starlark.net/starlark"
+	"go.starlark.net/syntax"
+)
+
+const (
+	testFileSuffix         = "_test.star"
+	testFunctionNamePrefix = "test_"
+
+	testsEnclaveDbFilePattern = "starlark-package-tests-*.db"
+	testsEnclaveDbFilePerm    = 0600
+
+	// the plans of the tests are executed against the fake service network, one instruction after the other
+	testsDryRun               = false
+	testsPrune                = false
+	testsExecutionParallelism = 1
+
+	noParsingMode syntax.Mode = 0
+
+	// the failures of a whole test file, e.g. a syntax error, aren't attached to any test function
+	noTestFunctionName = ""
+)
+
+var (
+	noTestFunctionArgs starlark.Tuple
+)
+
+// StartosisPackageTester runs the test functions of the *_test.star files of a Starlark package. Each test function
+// gets its own plan, which is executed against its own FakeServiceNetwork once the function returns, so that packages
+// can be tested without starting any container. The validation step is skipped, as it would pull the container images
+type StartosisPackageTester struct {
+	// The tests are run one package at a time, as the package content provider is shared with the runs
+	mutex *sync.Mutex
+
+	enclaveUuid enclave.EnclaveUUID
+
+	apiContainerInfo *service_network.ApiContainerInfo
+
+	packageContentProvider startosis_packages.PackageContentProvider
+
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde
+
+	enclaveEnvVars string
+}
+
+func NewStartosisPackageTester(
+	enclaveUuid enclave.EnclaveUUID,
+	apiContainerInfo *service_network.ApiContainerInfo,
+	packageContentProvider startosis_packages.PackageContentProvider,
+	starlarkValueSerde *kurtosis_types.StarlarkValueSerde,
+	enclaveEnvVars string,
+) *StartosisPackageTester {
+	return &StartosisPackageTester{
+		mutex:                  &sync.Mutex{},
+		enclaveUuid:            enclaveUuid,
+		apiContainerInfo:       apiContainerInfo,
+		packageContentProvider: packageContentProvider,
+		starlarkValueSerde:     starlarkValueSerde,
+		enclaveEnvVars:         enclaveEnvVars,
+	}
+}
+
+// RunTests runs the test functions of the package whose name contains the filter, in the order of the test files
+// and of the functions in the files. It returns an error only if the tests couldn't be run; failing tests are reported
+// in the results
+func (tester *StartosisPackageTester) RunTests(ctx context.Context, packageId string, packageRootPathOnDisk string, testNameFilter string) ([]*kurtosis_core_rpc_api_bindings.StarlarkTestResult, error) {
+	tester.mutex.Lock()
+	defer tester.mutex.Unlock()
+
+	testFilePaths, err := findTestFiles(packageRootPathOnDisk)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred finding the test files of package '%s'", packageId)
+	}
+
+	enclaveDb, cleanEnclaveDb, err := createTestsEnclaveDb()
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the database the tests are run with")
+	}
+	defer cleanEnclaveDb()
+	runtimeValueStore, err := runtime_value_store.CreateRuntimeValueStore(tester.starlarkValueSerde, enclaveDb)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred creating the runtime value store the tests are run with")
+	}
+
+	testResults := []*kurtosis_core_rpc_api_bindings.StarlarkTestResult{}
+	for _, testFilePath := range testFilePaths {
+		startTime := time.Now()
+		moduleLocator := path.Join(packageId, testFilePath)
+		testFileContents, err := os.ReadFile(path.Join(packageRootPathOnDisk, testFilePath))
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading test file '%s'", testFilePath)
+		}
+		testFunctionNames, interpretationErr := findTestFunctionNames(moduleLocator, testFileContents)
+		if interpretationErr != nil {
+			testResults = append(testResults, newStarlarkTestResult(testFilePath, noTestFunctionName, interpretationErr, startTime))
+			continue
+		}
+		for _, testFunctionName := range testFunctionNames {
+			if !strings.Contains(testFunctionName, testNameFilter) {
+				continue
+			}
+			logrus.Debugf("Running Starlark test '%s' of file '%s'", testFunctionName, testFilePath)
+			startTime = time.Now()
+			interpretationErr = tester.runTest(ctx, moduleLocator, string(testFileContents), testFunctionName, runtimeValueStore, enclaveDb)
+			testResults = append(testResults, newStarlarkTestResult(testFilePath, testFunctionName, interpretationErr, startTime))
+		}
+	}
+	return testResults, nil
+}
+
+// runTest interprets the test file, calls the test function, then executes the instructions it added to its plan
+func (tester *StartosisPackageTester) runTest(
+	ctx context.Context,
+	moduleLocator string,
+	testFileContents string,
+	testFunctionName string,
+	runtimeValueStore *runtime_value_store.RuntimeValueStore,
+	enclaveDb *enclave_db.EnclaveDB,
+) *startosis_errors.InterpretationError {
+	serviceNetwork := service_network.NewFakeServiceNetwork(tester.enclaveUuid, tester.apiContainerInfo)
+	interpreter := NewStartosisInterpreter(serviceNetwork, tester.packageContentProvider, runtimeValueStore, tester.starlarkValueSerde, tester.enclaveEnvVars)
+	instructionsPlan := instructions_plan.NewInstructionsPlan()
+
+	thread := newStarlarkThread(moduleLocator)
+	predeclared, interpretationErr := interpreter.buildBindings(thread, instructionsPlan, map[string]*startosis_packages.ModuleCacheEntry{})
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	(*predeclared)[testing_module.TestingModuleName] = testing_module.TestingModule(serviceNetwork, instructionsPlan, tester.starlarkValueSerde)
+	globalVariables, err := starlark.ExecFile(thread, moduleLocator, testFileContents, *predeclared)
+	if err != nil {
+		return generateInterpretationError(err)
+	}
+	testFunction, ok := globalVariables[testFunctionName].(*starlark.Function)
+	if !ok {
+		return startosis_errors.NewInterpretationError("Test '%s' isn't a function", testFunctionName)
+	}
+
+	// the plan is injected if the first parameter of the test function is called 'plan', as for the main function
+	testFunctionArgs := noTestFunctionArgs
+	if testFunction.NumParams() >= minimumParamsRequiredForPlan {
+		if firstParamName, _ := testFunction.Param(planParamIndex); firstParamName == planParamName {
+			kurtosisPlanInstructions := KurtosisPlanInstructions(serviceNetwork, runtimeValueStore, tester.packageContentProvider)
+			planModule := plan_module.PlanModule(instructionsPlan, enclave_structure.NewEnclaveComponents(), tester.starlarkValueSerde, resolver.NewInstructionsPlanMask(0), kurtosisPlanInstructions)
+			testFunctionArgs = starlark.Tuple{planModule}
+		}
+	}
+	testFunctionThread := newStarlarkThread(moduleLocator)
+	if _, err = starlark.Call(testFunctionThread, testFunction, testFunctionArgs, noKwargs); err != nil {
+		return generateInterpretationError(err)
+	}
+
+	instructionsSequence, interpretationErr := instructionsPlan.GeneratePlan()
+	if interpretationErr != nil {
+		return interpretationErr
+	}
+	executor := NewStartosisExecutor(tester.starlarkValueSerde, runtimeValueStore, enclave_plan_persistence.NewEnclavePlan(), enclaveDb, serviceNetwork, nil)
+	// the response lines are read until the channel is closed, so that the executor isn't blocked
+	var executionErr *kurtosis_core_rpc_api_bindings.StarlarkExecutionError
+	for responseLine := range executor.Execute(ctx, testsDryRun, testsPrune, testsExecutionParallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, startosis_constants.NoOutputObject) {
+		if responseLine.GetError().GetExecutionError() != nil && executionErr == nil {
+			executionErr = responseLine.GetError().GetExecutionError()
+		}
+	}
+	if executionErr != nil {
+		return startosis_errors.NewInterpretationError("%s", executionErr.GetErrorMessage())
+	}
+	return nil
+}
+
+// findTestFiles returns the paths of the test files of the package, relative to its root
+func findTestFiles(packageRootPathOnDisk string) ([]string, error) {
+	var testFilePaths []string
+	err := filepath.WalkDir(packageRootPathOnDisk, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), testFileSuffix) {
+			return nil
+		}
+		relativeFilePath, err := filepath.Rel(packageRootPathOnDisk, filePath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%s' relative to the root of the package", filePath)
+		}
+		testFilePaths = append(testFilePaths, filepath.ToSlash(relativeFilePath))
+		return nil
+	})
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred walking the package at '%s'", packageRootPathOnDisk)
+	}
+	sort.Strings(testFilePaths)
+	return testFilePaths, nil
+}
+
+// findTestFunctionNames returns the names of the test functions of the file, in the order they're defined in
+func findTestFunctionNames(moduleLocator string, testFileContents []byte) ([]string, *startosis_errors.InterpretationError) {
+	parsedFile, err := syntax.Parse(moduleLocator, testFileContents, noParsingMode)
+	if err != nil {
+		return nil, generateInterpretationError(err)
+	}
+	var testFunctionNames []string
+	for _, statement := range parsedFile.Stmts {
+		defStatement, ok := statement.(*syntax.DefStmt)
+		if ok && strings.HasPrefix(defStatement.Name.Name, testFunctionNamePrefix) {
+			testFunctionNames = append(testFunctionNames, defStatement.Name.Name)
+		}
+	}
+	return testFunctionNames, nil
+}
+
+// createTestsEnclaveDb creates a throwaway database for the runtime values and the plans of the tests, so that they
+// don't end up in the database of the enclave
+func createTestsEnclaveDb() (*enclave_db.EnclaveDB, func(), error) {
+	file, err := os.CreateTemp("", testsEnclaveDbFilePattern)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the database file")
+	}
+	if err = file.Close(); err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred closing the database file")
+	}
+	db, err := bolt.Open(file.Name(), testsEnclaveDbFilePerm, nil)
+	if err != nil {
+		return nil, nil, stacktrace.Propagate(err, "An error occurred opening the database at '%s'", file.Name())
+	}
+	cleanEnclaveDb := func() {
+		if err := db.Close(); err != nil {
+			logrus.Warnf("An error occurred closing the database of the tests at '%s':\n%v", file.Name(), err)
+		}
+		if err := os.Remove(file.Name()); err != nil {
+			logrus.Warnf("An error occurred removing the database of the tests at '%s':\n%v", file.Name(), err)
+		}
+	}
+	return &enclave_db.EnclaveDB{DB: db}, cleanEnclaveDb, nil
+}
+
+func newStarlarkTestResult(testFilePath string, testFunctionName string, interpretationErr *startosis_errors.InterpretationError, startTime time.Time) *kurtosis_core_rpc_api_bindings.StarlarkTestResult {
+	durationMs := uint64(time.Since(startTime).Milliseconds())
+	if interpretationErr != nil {
+		return binding_constructors.NewStarlarkTestResult(testFilePath, testFunctionName, false, interpretationErr.Error(), durationMs)
+	}
+	return binding_constructors.NewStarlarkTestResult(testFilePath, testFunctionName, true, "", durationMs)
+}
@@ -0,0 +1,127 @@
+package startosis_engine
+
+import (
+	"context"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/service_network"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/kurtosis_types"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/mock_package_content_provider"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testPackageId       = "github.com/kurtosis-tech/test-package"
+	testPackageTestFile = "tests/main_test.star"
+	testFilePerm        = 0644
+	testDirPerm         = 0755
+
+	testPackageMainFile = `
+def run(plan, node_count = 1):
+    if node_count < 1:
+        fail("node_count must be positive")
+    for index in range(node_count):
+        plan.add_service(name = "node-%d" % index, config = ServiceConfig(image = "node:latest", ports = {"http": PortSpec(number = 8080)}))
+    response = plan.request(service_name = "node-0", recipe = GetHttpRequestRecipe(port_id = "http", endpoint = "/health"))
+    plan.assert(value = response["body"], assertion = "==", target_value = "healthy")
+`
+
+	testPackageTests = `
+main = import_module("github.com/kurtosis-tech/test-package/main.star")
+
+def test_adds_one_service_per_node(plan):
+    testing.mock_http_response("node-0", "/health", body = "healthy")
+    main.run(plan, node_count = 2)
+    instructions = testing.instructions()
+    testing.assert_eq([instruction.name for instruction in instructions], ["add_service", "add_service", "request", "assert"])
+    testing.assert_eq(instructions[1].args["name"], "node-1")
+    testing.assert_contains(instructions[1].executable, "node:latest")
+
+def test_fails_on_invalid_node_count(plan):
+    error = testing.assert_fails(main.run, plan, node_count = 0)
+    testing.assert_contains(error, "node_count must be positive")
+
+def test_unhealthy_node(plan):
+    testing.mock_http_response("node-0", "/health", body = "unhealthy")
+    main.run(plan)
+
+def test_wrong_assertion():
+    testing.assert_eq(1 + 1, 3, "the sum is wrong")
+
+def helper_is_not_a_test():
+    testing.fail("helpers aren't run")
+`
+)
+
+func TestStartosisPackageTester_RunsTestFunctions(t *testing.T) {
+	packageRootPath := createTestPackage(t, testPackageTests)
+	tester := createPackageTester(t)
+
+	testResults, err := tester.RunTests(context.Background(), testPackageId, packageRootPath, "")
+	require.NoError(t, err)
+	require.Len(t, testResults, 4)
+
+	for _, testResult := range testResults {
+		require.Equal(t, testPackageTestFile, testResult.GetFile())
+	}
+	require.Equal(t, "test_adds_one_service_per_node", testResults[0].GetName())
+	require.True(t, testResults[0].GetPassed(), testResults[0].GetFailureMessage())
+	require.Equal(t, "test_fails_on_invalid_node_count", testResults[1].GetName())
+	require.True(t, testResults[1].GetPassed(), testResults[1].GetFailureMessage())
+
+	// the fake response makes the assertion of the plan fail when it's executed
+	require.Equal(t, "test_unhealthy_node", testResults[2].GetName())
+	require.False(t, testResults[2].GetPassed())
+	require.Contains(t, testResults[2].GetFailureMessage(), "unhealthy")
+
+	require.Equal(t, "test_wrong_assertion", testResults[3].GetName())
+	require.False(t, testResults[3].GetPassed())
+	require.Contains(t, testResults[3].GetFailureMessage(), "the sum is wrong: Expected '3' but got '2'")
+}
+
+func TestStartosisPackageTester_FiltersTestsByName(t *testing.T) {
+	packageRootPath := createTestPackage(t, testPackageTests)
+	tester := createPackageTester(t)
+
+	testResults, err := tester.RunTests(context.Background(), testPackageId, packageRootPath, "node_count")
+	require.NoError(t, err)
+	require.Len(t, testResults, 1)
+	require.Equal(t, "test_fails_on_invalid_node_count", testResults[0].GetName())
+	require.True(t, testResults[0].GetPassed())
+}
+
+func TestStartosisPackageTester_ReportsInvalidTestFile(t *testing.T) {
+	packageRootPath := createTestPackage(t, "def test_invalid(plan)\n    pass\n")
+	tester := createPackageTester(t)
+
+	testResults, err := tester.RunTests(context.Background(), testPackageId, packageRootPath, "")
+	require.NoError(t, err)
+	require.Len(t, testResults, 1)
+	require.Equal(t, testPackageTestFile, testResults[0].GetFile())
+	require.Empty(t, testResults[0].GetName())
+	require.False(t, testResults[0].GetPassed())
+}
+
+func createTestPackage(t *testing.T, tests string) string {
+	packageRootPath := t.TempDir()
+	require.NoError(t, os.MkdirAll(path.Join(packageRootPath, path.Dir(testPackageTestFile)), testDirPerm))
+	require.NoError(t, os.WriteFile(path.Join(packageRootPath, testPackageTestFile), []byte(tests), testFilePerm))
+	return packageRootPath
+}
+
+func createPackageTester(t *testing.T) *StartosisPackageTester {
+	packageContentProvider := mock_package_content_provider.NewMockPackageContentProvider()
+	t.Cleanup(func() { packageContentProvider.RemoveAll() })
+	require.NoError(t, packageContentProvider.AddFileContent(testPackageId+"/main.star", testPackageMainFile))
+
+	starlarkEnv := Predeclared()
+	for _, typeConstructor := range KurtosisTypeConstructors() {
+		starlarkEnv[typeConstructor.Name()] = typeConstructor
+	}
+	serde := kurtosis_types.NewStarlarkValueSerde(newStarlarkThread("test-serde"), starlarkEnv)
+	apiContainerInfo := service_network.NewApiContainerInfo(nil, 0, "")
+	return NewStartosisPackageTester(enclave.EnclaveUUID(mockEnclaveUuid), apiContainerInfo, packageContentProvider, serde, "")
+}
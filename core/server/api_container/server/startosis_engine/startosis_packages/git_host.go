@@ -0,0 +1,39 @@
+package startosis_packages
+
+// GitHost A git server other than GitHub hosting Starlark packages, or GitHub itself when its private repositories have
+// to be reached. The locators of its packages start with its host, e.g. gitlab.com/author/package/main.star
+type GitHost struct {
+	// host the first segment of the locators of the packages on this git server, e.g. gitlab.com
+	host string
+	// cloneUrlPrefix what the repositories are cloned from, e.g. https://gitlab.com or file:///srv/git for bare repos
+	// stored as /srv/git/author/package.git
+	cloneUrlPrefix string
+	// username and token authenticate the clones over HTTPS; both are empty for public repositories
+	username string
+	token    string
+}
+
+func NewGitHost(host string, cloneUrlPrefix string, username string, token string) *GitHost {
+	return &GitHost{
+		host:           host,
+		cloneUrlPrefix: cloneUrlPrefix,
+		username:       username,
+		token:          token,
+	}
+}
+
+func (gitHost *GitHost) GetHost() string {
+	return gitHost.host
+}
+
+func (gitHost *GitHost) GetCloneUrlPrefix() string {
+	return gitHost.cloneUrlPrefix
+}
+
+func (gitHost *GitHost) GetUsername() string {
+	return gitHost.username
+}
+
+func (gitHost *GitHost) GetToken() string {
+	return gitHost.token
+}
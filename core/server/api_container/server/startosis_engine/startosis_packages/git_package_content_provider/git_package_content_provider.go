@@ -4,15 +4,23 @@ import (
 	"errors"
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
+	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/package_cache"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/mholt/archiver"
 	"github.com/sirupsen/logrus"
 	"io"
+	"net/url"
 	"os"
 	"path"
 	"strings"
+	"sync"
 )
 
 const (
@@ -36,26 +44,93 @@ const (
 
 	packageDocLink        = "https://docs.kurtosis.com/concepts-reference/packages"
 	osPathSeparatorString = string(os.PathSeparator)
+
+	noPackageRootPath = ""
+
+	// git servers accept any username along with an access token
+	defaultGitUsername = "git"
 )
 
+var supportedCloneUrlSchemes = map[string]bool{
+	"https": true,
+	"http":  true,
+	"ssh":   true,
+	"file":  true,
+}
+
 type GitPackageContentProvider struct {
 	packagesTmpDir string
 	packagesDir    string
+
+	// the dependencies and the locally replaced packages of the package being run, see SetUpRootPackage
+	rootPackageDependencies   map[string]*packageDependency
+	locallyReplacedPackageIds map[string]bool
+	rootPackageMutex          *sync.RWMutex
+
+	// where the packages get cloned from, see ConfigurePackageSources
+	packageCache        *package_cache.PackageCache
+	gitHosts            map[string]*startosis_packages.GitHost
+	isOffline           bool
+	packageSourcesMutex *sync.RWMutex
 }
 
-func NewGitPackageContentProvider(moduleDir string, tmpDir string) *GitPackageContentProvider {
+func NewGitPackageContentProvider(moduleDir string, tmpDir string, packageCache *package_cache.PackageCache) *GitPackageContentProvider {
 	return &GitPackageContentProvider{
-		packagesDir:    moduleDir,
-		packagesTmpDir: tmpDir,
+		packagesDir:               moduleDir,
+		packagesTmpDir:            tmpDir,
+		rootPackageDependencies:   map[string]*packageDependency{},
+		locallyReplacedPackageIds: map[string]bool{},
+		rootPackageMutex:          &sync.RWMutex{},
+		packageCache:              packageCache,
+		gitHosts:                  map[string]*startosis_packages.GitHost{},
+		isOffline:                 false,
+		packageSourcesMutex:       &sync.RWMutex{},
 	}
 }
 
+func (provider *GitPackageContentProvider) ConfigurePackageSources(gitHosts []*startosis_packages.GitHost, isOffline bool) error {
+	// an API container with its own cache starts with an empty one, so it could never run anything offline
+	if isOffline && !provider.packageCache.IsSharedAcrossEnclaves() {
+		return stacktrace.NewError("Packages can't be run offline in this enclave as its package cache isn't shared across enclaves, which is the case on Kubernetes")
+	}
+	gitHostsByHost := map[string]*startosis_packages.GitHost{}
+	for _, gitHost := range gitHosts {
+		host := gitHost.GetHost()
+		if !strings.Contains(host, hostDomainSeparator) || strings.Contains(host, urlPathSeparator) {
+			return stacktrace.NewError("Git host '%v' isn't a valid host; expected a domain like 'gitlab.com'", host)
+		}
+		if _, found := gitHostsByHost[host]; found {
+			return stacktrace.NewError("Git host '%v' is configured more than once", host)
+		}
+		cloneUrlPrefix := gitHost.GetCloneUrlPrefix()
+		if cloneUrlPrefix == "" {
+			cloneUrlPrefix = httpsSchema + "://" + host
+		}
+		parsedCloneUrlPrefix, err := url.Parse(cloneUrlPrefix)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred parsing the clone URL prefix '%v' of git host '%v'", cloneUrlPrefix, host)
+		}
+		if !supportedCloneUrlSchemes[parsedCloneUrlPrefix.Scheme] {
+			return stacktrace.NewError("The clone URL prefix '%v' of git host '%v' has unsupported scheme '%v'", cloneUrlPrefix, host, parsedCloneUrlPrefix.Scheme)
+		}
+		gitHostsByHost[host] = startosis_packages.NewGitHost(host, cloneUrlPrefix, gitHost.GetUsername(), gitHost.GetToken())
+	}
+
+	provider.packageSourcesMutex.Lock()
+	defer provider.packageSourcesMutex.Unlock()
+	provider.gitHosts = gitHostsByHost
+	provider.isOffline = isOffline
+	return nil
+}
+
 func (provider *GitPackageContentProvider) ClonePackage(packageId string) (string, *startosis_errors.InterpretationError) {
-	parsedURL, interpretationError := parseGitURL(packageId)
+	parsedURL, interpretationError := provider.parseLocator(packageId)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
 
+	// the package being cloned is the one about to run, so the dependencies of the previous one don't apply anymore
+	provider.setRootPackageDependencies(map[string]*packageDependency{})
 	interpretationError = provider.atomicClone(parsedURL)
 	if interpretationError != nil {
 		return "", interpretationError
@@ -70,14 +145,14 @@ func (provider *GitPackageContentProvider) ClonePackage(packageId string) (strin
 			startosis_constants.KurtosisYamlName, packageId, startosis_constants.KurtosisYamlName, packageDocLink)
 	}
 
-	if interpretationError = validateKurtosisYaml(pathToKurtosisYaml, provider.packagesDir); interpretationError != nil {
+	if interpretationError = validateKurtosisYaml(pathToKurtosisYaml, provider.packagesDir, provider.getGitHosts()); interpretationError != nil {
 		return "", interpretationError
 	}
 	return packageAbsolutePathOnDisk, nil
 }
 
 func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsidePackageUrl string) (string, *startosis_errors.InterpretationError) {
-	parsedURL, interpretationError := parseGitURL(fileInsidePackageUrl)
+	parsedURL, interpretationError := provider.parseLocator(fileInsidePackageUrl)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -87,16 +162,34 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsideP
 	pathToFileOnDisk := path.Join(provider.packagesDir, parsedURL.relativeFilePath)
 	packagePath := path.Join(provider.packagesDir, parsedURL.relativeRepoPath)
 
-	// Return the file path straight if it exists
-	if _, err := os.Stat(pathToFileOnDisk); err == nil {
+	// The local copy of a replaced package was uploaded prior to the run, and must never be overwritten by a clone
+	if replacedPackageId, found := provider.findLocallyReplacedPackage(fileInsidePackageUrl); found {
+		if _, err := os.Stat(pathToFileOnDisk); err != nil {
+			return "", startosis_errors.WrapWithInterpretationError(err, "'%v' doesn't exist in the local copy replacing package '%v'", fileInsidePackageUrl, replacedPackageId)
+		}
 		return pathToFileOnDisk, nil
 	}
+	// Other packages of its repository can still be read when they were uploaded too, like the root package
+	if replacedPackageId, found := provider.findLocallyReplacedPackageInRepo(parsedURL.relativeRepoPath); found {
+		if _, err := os.Stat(pathToFileOnDisk); err == nil {
+			return pathToFileOnDisk, nil
+		}
+		return "", startosis_errors.NewInterpretationError("Package '%v' is replaced by a local copy, so '%v' which is in the same repository can't be cloned; replace the whole repository '%v' instead", replacedPackageId, fileInsidePackageUrl, parsedURL.relativeRepoPath)
+	}
 
-	// Check if the repo exists
-	// If the repo exists but the `pathToFileOnDisk` doesn't that means there's a mistake in the locator
-	if _, err := os.Stat(packagePath); err == nil {
-		relativeFilePathWithoutPackageName := strings.Replace(parsedURL.relativeFilePath, parsedURL.relativeRepoPath, replacedWithEmptyString, onlyOneReplacement)
-		return "", startosis_errors.NewInterpretationError("'%v' doesn't exist in the package '%v'", relativeFilePathWithoutPackageName, parsedURL.relativeRepoPath)
+	// A repo cloned at another commit than the one the locator is pinned to has to be cloned again
+	if isRepoOnDiskAtVersion(packagePath, parsedURL.tagBranchOrCommit) {
+		// Return the file path straight if it exists
+		if _, err := os.Stat(pathToFileOnDisk); err == nil {
+			return pathToFileOnDisk, nil
+		}
+
+		// Check if the repo exists
+		// If the repo exists but the `pathToFileOnDisk` doesn't that means there's a mistake in the locator
+		if _, err := os.Stat(packagePath); err == nil {
+			relativeFilePathWithoutPackageName := strings.Replace(parsedURL.relativeFilePath, parsedURL.relativeRepoPath, replacedWithEmptyString, onlyOneReplacement)
+			return "", startosis_errors.NewInterpretationError("'%v' doesn't exist in the package '%v'", relativeFilePathWithoutPackageName, parsedURL.relativeRepoPath)
+		}
 	}
 
 	// Otherwise clone the repo and return the absolute path of the requested file
@@ -115,7 +208,7 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsoluteFilePath(fileInsideP
 		return "", startosis_errors.NewInterpretationError("%v is not found in the path of '%v'; files can only be accessed from Kurtosis packages. For more information, go to: %v", startosis_constants.KurtosisYamlName, fileInsidePackageUrl, howImportWorksLink)
 	}
 
-	if interpretationError = validateKurtosisYaml(maybeKurtosisYamlPath, provider.packagesDir); interpretationError != nil {
+	if interpretationError = validateKurtosisYaml(maybeKurtosisYamlPath, provider.packagesDir, provider.getGitHosts()); interpretationError != nil {
 		return "", interpretationError
 	}
 
@@ -138,7 +231,7 @@ func (provider *GitPackageContentProvider) GetModuleContents(fileInsideModuleUrl
 }
 
 func (provider *GitPackageContentProvider) GetOnDiskAbsolutePackagePath(packageId string) (string, *startosis_errors.InterpretationError) {
-	parsedPackageId, interpretationError := parseGitURL(packageId)
+	parsedPackageId, interpretationError := provider.parseLocator(packageId)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -154,7 +247,7 @@ func (provider *GitPackageContentProvider) GetOnDiskAbsolutePackagePath(packageI
 }
 
 func (provider *GitPackageContentProvider) StorePackageContents(packageId string, moduleTar io.Reader, overwriteExisting bool) (string, *startosis_errors.InterpretationError) {
-	parsedPackageId, interpretationError := parseGitURL(packageId)
+	parsedPackageId, interpretationError := provider.parseLocator(packageId)
 	if interpretationError != nil {
 		return "", interpretationError
 	}
@@ -194,21 +287,179 @@ func (provider *GitPackageContentProvider) StorePackageContents(packageId string
 
 func (provider *GitPackageContentProvider) GetAbsoluteLocatorForRelativeModuleLocator(parentModuleId string, maybeRelativeLocator string) (string, *startosis_errors.InterpretationError) {
 	// maybe it's not a relative url in which case we return the url
-	_, errorParsingUrl := parseGitURL(maybeRelativeLocator)
+	_, errorParsingUrl := provider.parseLocator(maybeRelativeLocator)
 	if errorParsingUrl == nil {
-		return maybeRelativeLocator, nil
+		return provider.resolveLocatorForRootPackage(maybeRelativeLocator), nil
 	}
 
-	parsedParentModuleId, errorParsingPackageId := parseGitURL(parentModuleId)
+	parsedParentModuleId, errorParsingPackageId := provider.parseLocator(parentModuleId)
 	if errorParsingPackageId != nil {
 		return "", startosis_errors.NewInterpretationError("Parent package id '%v' isn't a valid locator; relative URLs don't work with standalone scripts", parentModuleId)
 	}
 
-	return parsedParentModuleId.getAbsoluteLocatorRelativeToThisURL(maybeRelativeLocator), nil
+	return provider.resolveLocatorForRootPackage(parsedParentModuleId.getAbsoluteLocatorRelativeToThisURL(maybeRelativeLocator)), nil
 }
 
-// atomicClone This first clones to a temporary directory and then moves it
-// TODO make this support versioning via tags, commit hashes or branches
+func (provider *GitPackageContentProvider) SetUpRootPackage(packageRootPathOnDisk string, locallyReplacedPackageIds []string) *startosis_errors.InterpretationError {
+	dependencies := map[string]*packageDependency{}
+	if packageRootPathOnDisk != noPackageRootPath {
+		var interpretationErr *startosis_errors.InterpretationError
+		if dependencies, interpretationErr = loadPackageDependencies(packageRootPathOnDisk, provider.getGitHosts()); interpretationErr != nil {
+			return interpretationErr
+		}
+	}
+
+	replacedPackageIds := map[string]bool{}
+	pathsInUse := []string{packageRootPathOnDisk}
+	for _, packageId := range locallyReplacedPackageIds {
+		parsedPackageId, interpretationErr := provider.parseLocator(packageId)
+		if interpretationErr != nil {
+			return startosis_errors.WrapWithInterpretationError(interpretationErr, "Locally replaced package '%v' isn't a valid package name", packageId)
+		}
+		if parsedPackageId.tagBranchOrCommit != emptyTagBranchOrCommit {
+			return startosis_errors.NewInterpretationError("Locally replaced package '%v' can't have a version, as every version of it gets replaced", packageId)
+		}
+		replacedPackagePath := path.Join(provider.packagesDir, getPathToPackageRoot(parsedPackageId))
+		if _, err := os.Stat(replacedPackagePath); err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "Package '%v' is replaced by a local copy but this copy wasn't uploaded prior to running the package", packageId)
+		}
+		replacedPackageIds[packageId] = true
+		pathsInUse = append(pathsInUse, replacedPackagePath)
+	}
+
+	provider.rootPackageMutex.Lock()
+	defer provider.rootPackageMutex.Unlock()
+	// the local copies of the packages which aren't replaced anymore are removed so that they get cloned again
+	for packageId := range provider.locallyReplacedPackageIds {
+		if replacedPackageIds[packageId] {
+			continue
+		}
+		parsedPackageId, interpretationErr := provider.parseLocator(packageId)
+		if interpretationErr != nil {
+			continue
+		}
+		repoPath := path.Join(provider.packagesDir, parsedPackageId.relativeRepoPath)
+		if isAnyPathInDir(pathsInUse, repoPath) {
+			continue
+		}
+		if err := os.RemoveAll(repoPath); err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "An error occurred removing the local copy of package '%v' which isn't replaced anymore from '%v'", packageId, repoPath)
+		}
+		logrus.Debugf("Removed the local copy of package '%v' as it isn't replaced anymore", packageId)
+	}
+	provider.rootPackageDependencies = dependencies
+	provider.locallyReplacedPackageIds = replacedPackageIds
+	return nil
+}
+
+func isAnyPathInDir(paths []string, dirPath string) bool {
+	for _, maybePathInDir := range paths {
+		if maybePathInDir == dirPath || strings.HasPrefix(maybePathInDir, dirPath+osPathSeparatorString) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveLocatorForRootPackage drops the version of the locators pointing into a locally replaced package, as the
+// local copy replaces all of its versions, and appends the version of the dependency of the root package the other
+// locators point into, i.e. the commit it's locked at or the version declared in the kurtosis.yml. Locators with an
+// explicit version, and the ones which aren't part of any dependency, are returned as is
+func (provider *GitPackageContentProvider) resolveLocatorForRootPackage(absoluteLocator string) string {
+	if _, found := provider.findLocallyReplacedPackage(absoluteLocator); found {
+		locatorWithoutVersion, _, _ := strings.Cut(absoluteLocator, tagBranchOrCommitDelimiter)
+		return locatorWithoutVersion
+	}
+	if strings.Contains(absoluteLocator, tagBranchOrCommitDelimiter) {
+		return absoluteLocator
+	}
+	provider.rootPackageMutex.RLock()
+	defer provider.rootPackageMutex.RUnlock()
+	dependency, found := findDependency(provider.rootPackageDependencies, absoluteLocator)
+	if !found || dependency.getVersionToClone() == emptyTagBranchOrCommit {
+		return absoluteLocator
+	}
+	return absoluteLocator + tagBranchOrCommitDelimiter + dependency.getVersionToClone()
+}
+
+// findLocallyReplacedPackage returns the locally replaced package the locator points into, if any
+func (provider *GitPackageContentProvider) findLocallyReplacedPackage(locator string) (string, bool) {
+	locatorWithoutVersion, _, _ := strings.Cut(locator, tagBranchOrCommitDelimiter)
+	provider.rootPackageMutex.RLock()
+	defer provider.rootPackageMutex.RUnlock()
+	for packageId := range provider.locallyReplacedPackageIds {
+		if locatorWithoutVersion == packageId || strings.HasPrefix(locatorWithoutVersion, packageId+urlPathSeparator) {
+			return packageId, true
+		}
+	}
+	return "", false
+}
+
+// findLocallyReplacedPackageInRepo returns a locally replaced package living in the repository, if any
+func (provider *GitPackageContentProvider) findLocallyReplacedPackageInRepo(relativeRepoPath string) (string, bool) {
+	provider.rootPackageMutex.RLock()
+	defer provider.rootPackageMutex.RUnlock()
+	for packageId := range provider.locallyReplacedPackageIds {
+		parsedPackageId, interpretationErr := provider.parseLocator(packageId)
+		if interpretationErr == nil && parsedPackageId.relativeRepoPath == relativeRepoPath {
+			return packageId, true
+		}
+	}
+	return "", false
+}
+
+// parseLocator parses the locator of a package or a file inside a package on GitHub or on one of the git hosts
+func (provider *GitPackageContentProvider) parseLocator(locator string) (*ParsedGitURL, *startosis_errors.InterpretationError) {
+	return parseGitURLOnGitHosts(locator, provider.getGitHosts())
+}
+
+func (provider *GitPackageContentProvider) getGitHosts() map[string]*startosis_packages.GitHost {
+	provider.packageSourcesMutex.RLock()
+	defer provider.packageSourcesMutex.RUnlock()
+	return provider.gitHosts
+}
+
+func (provider *GitPackageContentProvider) getPackageSource(host string) (*startosis_packages.GitHost, bool) {
+	provider.packageSourcesMutex.RLock()
+	defer provider.packageSourcesMutex.RUnlock()
+	return provider.gitHosts[host], provider.isOffline
+}
+
+func (provider *GitPackageContentProvider) setRootPackageDependencies(dependencies map[string]*packageDependency) {
+	provider.rootPackageMutex.Lock()
+	defer provider.rootPackageMutex.Unlock()
+	provider.rootPackageDependencies = dependencies
+}
+
+// verifyLockedDependenciesContent checks that the dependencies locked at the commit the repo just got cloned at still
+// have the content they had when they got locked
+func (provider *GitPackageContentProvider) verifyLockedDependenciesContent(parsedURL *ParsedGitURL, clonedPackagesDir string) *startosis_errors.InterpretationError {
+	provider.rootPackageMutex.RLock()
+	defer provider.rootPackageMutex.RUnlock()
+	for _, dependency := range provider.rootPackageDependencies {
+		lockedDependency := dependency.lockedDependency
+		if lockedDependency == nil || lockedDependency.Hash == "" || lockedDependency.Commit != parsedURL.tagBranchOrCommit {
+			continue
+		}
+		parsedDependency, interpretationErr := provider.parseLocator(dependency.packageName)
+		if interpretationErr != nil || parsedDependency.relativeRepoPath != parsedURL.relativeRepoPath {
+			continue
+		}
+		dependencyPath := path.Join(clonedPackagesDir, getPathToPackageRoot(parsedDependency))
+		contentHash, err := package_lock.ComputePackageContentHash(dependencyPath)
+		if err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "An error occurred verifying the content of dependency '%v' at commit '%v'", dependency.packageName, lockedDependency.Commit)
+		}
+		if contentHash != lockedDependency.Hash {
+			return startosis_errors.NewInterpretationError("The content of dependency '%v' at commit '%v' doesn't match the hash in %v: expected '%v' but got '%v'. If the change is expected, run 'kurtosis package update' to lock the new content",
+				dependency.packageName, lockedDependency.Commit, package_lock.KurtosisLockFilename, lockedDependency.Hash, contentHash)
+		}
+	}
+	return nil
+}
+
+// atomicClone This first clones to a temporary directory and then moves it. Repositories pinned to a commit are taken
+// from the package cache when they're in it, and all of them are when offline
 func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL) *startosis_errors.InterpretationError {
 	// First we clone into a temporary directory
 	tempRepoDirPath, err := os.MkdirTemp(provider.packagesTmpDir, temporaryRepoDirPattern)
@@ -218,6 +469,59 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 	defer os.RemoveAll(tempRepoDirPath)
 	gitClonePath := path.Join(tempRepoDirPath, parsedURL.relativeRepoPath)
 
+	gitHost, isOffline := provider.getPackageSource(parsedURL.getHost())
+	repositoryLocator := getLocatorForPathOnDisk(parsedURL.relativeRepoPath)
+	cachedRepoPath, isCached := provider.packageCache.GetRepository(repositoryLocator, parsedURL.tagBranchOrCommit)
+	if isOffline && !isCached {
+		return startosis_errors.NewInterpretationError("Repository '%v' at version '%v' isn't in the package cache, and packages can't be cloned while offline. Run it once without being offline to cache it", repositoryLocator, parsedURL.tagBranchOrCommit)
+	}
+	if isCached && (isOffline || fullCommitShaRegex.MatchString(parsedURL.tagBranchOrCommit)) {
+		logrus.Debugf("Taking repository '%v' at version '%v' from the package cache at '%v'", repositoryLocator, parsedURL.tagBranchOrCommit, cachedRepoPath)
+		if err = package_cache.CopyDirectory(cachedRepoPath, gitClonePath); err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "An error occurred copying repository '%v' from the package cache", repositoryLocator)
+		}
+	} else {
+		if interpretationErr := cloneRepository(parsedURL, gitClonePath, gitHost); interpretationErr != nil {
+			return interpretationErr
+		}
+		// the clone is usable even if it can't be cached, the next run will just clone it again
+		if err = provider.packageCache.StoreRepository(repositoryLocator, parsedURL.tagBranchOrCommit, gitClonePath); err != nil {
+			logrus.Warnf("An error occurred storing repository '%v' at version '%v' in the package cache:\n%v", repositoryLocator, parsedURL.tagBranchOrCommit, err)
+		}
+	}
+	if parsedURL.tagBranchOrCommit != emptyTagBranchOrCommit {
+		if interpretationErr := provider.verifyLockedDependenciesContent(parsedURL, tempRepoDirPath); interpretationErr != nil {
+			return interpretationErr
+		}
+	}
+
+	// Then we move it into the target directory
+	packageAuthorPath := path.Dir(path.Join(provider.packagesDir, parsedURL.relativeRepoPath))
+	packagePath := path.Join(provider.packagesDir, parsedURL.relativeRepoPath)
+	fileMode, err := os.Stat(packageAuthorPath)
+	if err == nil && !fileMode.IsDir() {
+		return startosis_errors.WrapWithInterpretationError(err, "Expected '%s' to be a directory but it is something else", packageAuthorPath)
+	}
+	if err != nil {
+		if err = os.MkdirAll(packageAuthorPath, moduleDirPermission); err != nil {
+			return startosis_errors.WrapWithInterpretationError(err, "Cloning the package '%s' failed. An error occurred while creating the directory '%s'.", parsedURL.gitURL, packageAuthorPath)
+		}
+	}
+	if _, err = os.Stat(packagePath); !os.IsNotExist(err) {
+		logrus.Debugf("Package '%s' already exists in enclave at path '%s'. Going to remove previous version.", parsedURL.gitURL, packagePath)
+		if err = os.RemoveAll(packagePath); err != nil {
+			return startosis_errors.NewInterpretationError("Unable to remove a previous version of package '%s' existing inside Kurtosis enclave at '%s'.", parsedURL.gitURL, packagePath)
+		}
+	}
+	if err = os.Rename(gitClonePath, packagePath); err != nil {
+		return startosis_errors.NewInterpretationError("Cloning the package '%s' failed. An error occurred while moving package at temporary destination '%s' to final destination '%s'", parsedURL.gitURL, gitClonePath, packagePath)
+	}
+	return nil
+}
+
+// cloneRepository clones the repository of the URL at its version, authenticating with the credentials of its git
+// host if any
+func cloneRepository(parsedURL *ParsedGitURL, gitClonePath string, gitHost *startosis_packages.GitHost) *startosis_errors.InterpretationError {
 	depth := defaultDepth
 	if parsedURL.tagBranchOrCommit != emptyTagBranchOrCommit {
 		depth = depthAssumingBranchTagsCommitsAreSpecified
@@ -225,7 +529,7 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 
 	repo, err := git.PlainClone(gitClonePath, isNotBareClone, &git.CloneOptions{
 		URL:               parsedURL.gitURL,
-		Auth:              nil,
+		Auth:              getCloneAuth(gitHost),
 		RemoteName:        "",
 		ReferenceName:     "",
 		SingleBranch:      false,
@@ -238,11 +542,10 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 		CABundle:          nil,
 	})
 	if err != nil {
-		// TODO remove public repository from error after we support private repositories
 		// We silent the underlying error here as it can be confusing to the user. For example, when there's a typo in
 		// the repo name, pointing to a non existing repo, the underlying error is: "authentication required"
 		logrus.Errorf("Error cloning git repository: '%s' to '%s'. Error was: \n%s", parsedURL.gitURL, gitClonePath, err.Error())
-		return startosis_errors.NewInterpretationError("Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists and is a public repository.", parsedURL.gitURL, gitClonePath, parsedURL.gitURL)
+		return startosis_errors.NewInterpretationError("Error in cloning git repository '%s' to '%s'. Make sure that '%v' exists and is either a public repository or a repository the credentials of its git host can read.", parsedURL.gitURL, gitClonePath, parsedURL.gitURL)
 	}
 
 	if parsedURL.tagBranchOrCommit != emptyTagBranchOrCommit {
@@ -280,29 +583,21 @@ func (provider *GitPackageContentProvider) atomicClone(parsedURL *ParsedGitURL)
 			return startosis_errors.NewInterpretationError("Tried checking out '%v' on repository '%v' but failed", parsedURL.tagBranchOrCommit, parsedURL.gitURL)
 		}
 	}
+	return nil
+}
 
-	// Then we move it into the target directory
-	packageAuthorPath := path.Join(provider.packagesDir, parsedURL.moduleAuthor)
-	packagePath := path.Join(provider.packagesDir, parsedURL.relativeRepoPath)
-	fileMode, err := os.Stat(packageAuthorPath)
-	if err == nil && !fileMode.IsDir() {
-		return startosis_errors.WrapWithInterpretationError(err, "Expected '%s' to be a directory but it is something else", packageAuthorPath)
-	}
-	if err != nil {
-		if err = os.Mkdir(packageAuthorPath, moduleDirPermission); err != nil {
-			return startosis_errors.WrapWithInterpretationError(err, "Cloning the package '%s' failed. An error occurred while creating the directory '%s'.", parsedURL.gitURL, packageAuthorPath)
-		}
+func getCloneAuth(gitHost *startosis_packages.GitHost) transport.AuthMethod {
+	if gitHost == nil || gitHost.GetToken() == "" {
+		return nil
 	}
-	if _, err = os.Stat(packagePath); !os.IsNotExist(err) {
-		logrus.Debugf("Package '%s' already exists in enclave at path '%s'. Going to remove previous version.", parsedURL.gitURL, packagePath)
-		if err = os.RemoveAll(packagePath); err != nil {
-			return startosis_errors.NewInterpretationError("Unable to remove a previous version of package '%s' existing inside Kurtosis enclave at '%s'.", parsedURL.gitURL, packagePath)
-		}
+	username := gitHost.GetUsername()
+	if username == "" {
+		username = defaultGitUsername
 	}
-	if err = os.Rename(gitClonePath, packagePath); err != nil {
-		return startosis_errors.NewInterpretationError("Cloning the package '%s' failed. An error occurred while moving package at temporary destination '%s' to final destination '%s'", parsedURL.gitURL, gitClonePath, packagePath)
+	return &http.BasicAuth{
+		Username: username,
+		Password: gitHost.GetToken(),
 	}
-	return nil
 }
 
 // methods checks whether the root of the package is same as repository root
@@ -315,6 +610,23 @@ func getPathToPackageRoot(parsedPackagePath *ParsedGitURL) string {
 	return packagePathOnDisk
 }
 
+// isRepoOnDiskAtVersion returns false when the version is a commit and the repo on disk is checked out at another one.
+// Tags and branches can't be compared without fetching the remote, so the repo on disk is assumed to be at them
+func isRepoOnDiskAtVersion(repoPath string, version string) bool {
+	if !fullCommitShaRegex.MatchString(version) {
+		return true
+	}
+	repo, err := git.PlainOpen(repoPath)
+	if err != nil {
+		return false
+	}
+	head, err := repo.Head()
+	if err != nil {
+		return false
+	}
+	return head.Hash().String() == version
+}
+
 func getReferenceName(repo *git.Repository, parsedURL *ParsedGitURL) (plumbing.ReferenceName, bool, *startosis_errors.InterpretationError) {
 	tag, err := repo.Tag(parsedURL.tagBranchOrCommit)
 	if err == nil {
@@ -352,14 +664,14 @@ func getReferenceName(repo *git.Repository, parsedURL *ParsedGitURL) (plumbing.R
 }
 
 // this method validates the contents of the kurtosis.yml found at path identified by the absPathToKurtosisYmlInThePackage
-func validateKurtosisYaml(absPathToKurtosisYmlInThePackage string, packageDir string) *startosis_errors.InterpretationError {
+func validateKurtosisYaml(absPathToKurtosisYmlInThePackage string, packageDir string, gitHosts map[string]*startosis_packages.GitHost) *startosis_errors.InterpretationError {
 	kurtosisYaml, errWhileParsing := yaml_parser.ParseKurtosisYaml(absPathToKurtosisYmlInThePackage)
 	if errWhileParsing != nil {
 		return startosis_errors.WrapWithInterpretationError(errWhileParsing, "Error occurred while parsing %v", absPathToKurtosisYmlInThePackage)
 	}
 
 	// this method validates whether the package name is also the locator - it should the location where kurtosis.yml exists
-	if err := validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml, absPathToKurtosisYmlInThePackage, packageDir); err != nil {
+	if err := validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml, absPathToKurtosisYmlInThePackage, packageDir, gitHosts); err != nil {
 		return startosis_errors.WrapWithInterpretationError(err, "Error occurred while validating %v", absPathToKurtosisYmlInThePackage)
 	}
 
@@ -367,9 +679,9 @@ func validateKurtosisYaml(absPathToKurtosisYmlInThePackage string, packageDir st
 }
 
 // this method validates whether the package name found in kurtosis yml is same as the location where kurtosis.yml is found
-func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.KurtosisYaml, absPathToKurtosisYmlInThePackage string, packageDir string) *startosis_errors.InterpretationError {
+func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.KurtosisYaml, absPathToKurtosisYmlInThePackage string, packageDir string, gitHosts map[string]*startosis_packages.GitHost) *startosis_errors.InterpretationError {
 	// get package name from absolute path to package
-	packageNameFromAbsPackagePath := strings.Replace(absPathToKurtosisYmlInThePackage, packageDir, startosis_constants.GithubDomainPrefix, replaceCountPackageDirWithGithubConstant)
+	packageNameFromAbsPackagePath := getLocatorForPathOnDisk(strings.Replace(absPathToKurtosisYmlInThePackage, packageDir, replacedWithEmptyString, replaceCountPackageDirWithGithubConstant))
 	packageName := kurtosisYaml.GetPackageName()
 
 	if strings.HasSuffix(packageName, osPathSeparatorString) {
@@ -377,7 +689,7 @@ func validatePackageNameMatchesKurtosisYamlLocation(kurtosisYaml *yaml_parser.Ku
 	}
 
 	// re-using parseGitURL with packageName found from kurtosis.yml as it already does some validations
-	_, err := parseGitURL(packageName)
+	_, err := parseGitURLOnGitHosts(packageName, gitHosts)
 	if err != nil {
 		return startosis_errors.WrapWithInterpretationError(err, "Error occurred while validating package name: %v which is found in kurtosis.yml at: '%v'", kurtosisYaml.GetPackageName(), packageNameFromAbsPackagePath)
 	}
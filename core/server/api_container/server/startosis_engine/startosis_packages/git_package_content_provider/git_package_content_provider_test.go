@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/package_cache"
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/stretchr/testify/require"
@@ -25,7 +26,7 @@ func TestGitPackageProvider_SucceedsForValidPackage(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -41,7 +42,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithExplicitMasterSet(t *test
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@main"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -57,7 +58,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithBranch(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@test-branch"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -73,7 +74,7 @@ func TestGitPackageProvider_FailsForInvalidBranch(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@non-existent-branch"
 	_, err = provider.GetModuleContents(sampleStartosisModule)
@@ -88,7 +89,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithTag(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@0.1.1"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -104,7 +105,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithCommit(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@ec9062828e1a687a5db7dfa750f754f88119e4c0"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -120,7 +121,7 @@ func TestGitPackageProvider_SucceedsForValidPackageWithCommitOnABranch(t *testin
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStartosisModule := "github.com/kurtosis-tech/sample-startosis-load/sample.star@df88baf51caffbe7e8f66c0e54715f680f4482b2"
 	contents, err := provider.GetModuleContents(sampleStartosisModule)
@@ -136,7 +137,7 @@ func TestGitPackageProvider_SucceedsForNonStarlarkFile(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	sampleStarlarkPackage := "github.com/kurtosis-tech/eth2-package/static_files/prometheus-config/prometheus.yml.tmpl"
 	contents, err := provider.GetModuleContents(sampleStarlarkPackage)
@@ -152,7 +153,7 @@ func TestGitPackageProvider_FailsForNonExistentPackage(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(oackageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(oackageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 	nonExistentModulePath := "github.com/kurtosis-tech/non-existent-startosis-load/sample.star"
 
 	_, err = provider.GetModuleContents(nonExistentModulePath)
@@ -167,7 +168,7 @@ func TestGetAbsolutePathOnDisk_WorksForPureDirectories(t *testing.T) {
 	require.Nil(t, err)
 	defer os.RemoveAll(packageTmpDir)
 
-	provider := NewGitPackageContentProvider(packageDir, packageTmpDir)
+	provider := NewGitPackageContentProvider(packageDir, packageTmpDir, package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
 
 	packagePath := "github.com/kurtosis-tech/datastore-army-package/src/helpers.star"
 	pathOnDisk, err := provider.GetOnDiskAbsoluteFilePath(packagePath)
@@ -357,7 +358,7 @@ func Test_validatePackageNameMatchesKurtosisYamlLocation(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			err := validatePackageNameMatchesKurtosisYamlLocation(tt.args.kurtosisYaml, tt.args.absPathToPackageWithKurtosisYml, tt.args.packagesDir)
+			err := validatePackageNameMatchesKurtosisYamlLocation(tt.args.kurtosisYaml, tt.args.absPathToPackageWithKurtosisYml, tt.args.packagesDir, noGitHosts)
 			if tt.want == nil {
 				require.Nil(t, err)
 			} else {
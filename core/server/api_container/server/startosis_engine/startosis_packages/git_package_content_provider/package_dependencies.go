@@ -0,0 +1,112 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/commons/yaml_parser"
+	"github.com/sirupsen/logrus"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const (
+	packageLockDocLink = "https://docs.kurtosis.com/concepts-reference/kurtosis-yml"
+)
+
+// Versions made of a full commit SHA can be compared to the commit checked out on disk
+var fullCommitShaRegex = regexp.MustCompile("^[0-9a-f]{40}$")
+
+// packageDependency is a dependency of the root package, i.e. the package being run, declared in its kurtosis.yml or
+// pinned in its kurtosis.lock
+type packageDependency struct {
+	packageName string
+
+	// the version the root package declares for the dependency; a tag, a branch or a commit
+	version string
+
+	// nil if the dependency isn't locked, in which case it gets cloned at its version
+	lockedDependency *package_lock.LockedDependency
+}
+
+func newPackageDependency(packageName string, version string, lockedDependency *package_lock.LockedDependency) *packageDependency {
+	return &packageDependency{
+		packageName:      packageName,
+		version:          version,
+		lockedDependency: lockedDependency,
+	}
+}
+
+// getVersionToClone returns the locked commit if any, and the declared version otherwise
+func (dependency *packageDependency) getVersionToClone() string {
+	if dependency.lockedDependency != nil {
+		return dependency.lockedDependency.Commit
+	}
+	return dependency.version
+}
+
+// loadPackageDependencies reads the dependencies declared in the kurtosis.yml of the package at the root path, and
+// the commits they're locked at in its kurtosis.lock. The lock also contains the transitive dependencies, which get
+// pinned as well
+func loadPackageDependencies(packageRootPath string, gitHosts map[string]*startosis_packages.GitHost) (map[string]*packageDependency, *startosis_errors.InterpretationError) {
+	dependencies := map[string]*packageDependency{}
+	kurtosisYamlPath := path.Join(packageRootPath, startosis_constants.KurtosisYamlName)
+	if _, err := os.Stat(kurtosisYamlPath); err != nil {
+		return dependencies, nil
+	}
+	kurtosisYaml, err := yaml_parser.ParseKurtosisYaml(kurtosisYamlPath)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Error occurred while parsing %v", kurtosisYamlPath)
+	}
+	kurtosisLockPath := path.Join(packageRootPath, package_lock.KurtosisLockFilename)
+	kurtosisLock, isLocked, err := package_lock.ParseKurtosisLock(kurtosisLockPath)
+	if err != nil {
+		return nil, startosis_errors.WrapWithInterpretationError(err, "Error occurred while parsing %v", kurtosisLockPath)
+	}
+
+	for packageName, version := range kurtosisYaml.GetDependencies() {
+		if _, interpretationErr := parseGitURLOnGitHosts(packageName, gitHosts); interpretationErr != nil {
+			return nil, startosis_errors.WrapWithInterpretationError(interpretationErr, "Dependency '%v' declared in %v isn't a valid package name", packageName, kurtosisYamlPath)
+		}
+		lockedDependency, found := kurtosisLock.GetLockedDependency(packageName)
+		if isLocked && !found {
+			return nil, startosis_errors.NewInterpretationError("Dependency '%v' declared in %v is missing from %v. Run 'kurtosis package lock' to lock it; for more information have a look at %v",
+				packageName, startosis_constants.KurtosisYamlName, package_lock.KurtosisLockFilename, packageLockDocLink)
+		}
+		if found && lockedDependency.Version != version {
+			return nil, startosis_errors.NewInterpretationError("Dependency '%v' is declared with version '%v' in %v but was locked with version '%v' in %v. Run 'kurtosis package lock' to update the lock; for more information have a look at %v",
+				packageName, version, startosis_constants.KurtosisYamlName, lockedDependency.Version, package_lock.KurtosisLockFilename, packageLockDocLink)
+		}
+		dependencies[packageName] = newPackageDependency(packageName, version, lockedDependency)
+	}
+	for _, packageName := range kurtosisLock.GetSortedPackageNames() {
+		if _, found := dependencies[packageName]; found {
+			continue
+		}
+		lockedDependency, _ := kurtosisLock.GetLockedDependency(packageName)
+		dependencies[packageName] = newPackageDependency(packageName, lockedDependency.Version, lockedDependency)
+	}
+	if len(dependencies) > 0 && !isLocked {
+		logrus.Warnf("Package at '%v' declares dependencies but has no %v; they're going to be cloned at their current version", packageRootPath, package_lock.KurtosisLockFilename)
+	}
+	return dependencies, nil
+}
+
+// findDependency returns the dependency the locator points into, preferring the most specific package name when a
+// dependency lives in a subdirectory of another
+func findDependency(dependencies map[string]*packageDependency, locator string) (*packageDependency, bool) {
+	locatorWithoutVersion, _, _ := strings.Cut(locator, tagBranchOrCommitDelimiter)
+	var matchingDependency *packageDependency
+	for packageName, dependency := range dependencies {
+		if locatorWithoutVersion != packageName && !strings.HasPrefix(locatorWithoutVersion, packageName+urlPathSeparator) {
+			continue
+		}
+		if matchingDependency == nil || len(packageName) > len(matchingDependency.packageName) {
+			matchingDependency = dependency
+		}
+	}
+	return matchingDependency, matchingDependency != nil
+}
@@ -0,0 +1,143 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/package_lock"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/package_cache"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	rootPackageName     = "github.com/kurtosis-tech/root-package"
+	dependencyName      = "github.com/kurtosis-tech/dependency"
+	subDependencyName   = "github.com/kurtosis-tech/dependency/sub-package"
+	transitiveDepName   = "github.com/kurtosis-tech/transitive"
+	dependencyVersion   = "v1.0.0"
+	dependencyCommit    = "0123456789abcdef0123456789abcdef01234567"
+	transitiveDepCommit = "89abcdef0123456789abcdef0123456789abcdef"
+
+	kurtosisYamlWithDependencies = `name: "` + rootPackageName + `"
+dependencies:
+  "` + dependencyName + `": "` + dependencyVersion + `"
+`
+	dependenciesFilePerm = 0644
+)
+
+func writePackageFiles(t *testing.T, kurtosisYamlContent string, kurtosisLock *package_lock.KurtosisLock) string {
+	packageRootPath := t.TempDir()
+	err := os.WriteFile(path.Join(packageRootPath, startosis_constants.KurtosisYamlName), []byte(kurtosisYamlContent), dependenciesFilePerm)
+	require.NoError(t, err)
+	if kurtosisLock != nil {
+		err = package_lock.WriteKurtosisLock(path.Join(packageRootPath, package_lock.KurtosisLockFilename), kurtosisLock)
+		require.NoError(t, err)
+	}
+	return packageRootPath
+}
+
+func TestLoadPackageDependencies_NoDependencies(t *testing.T) {
+	packageRootPath := writePackageFiles(t, `name: "`+rootPackageName+`"`, nil)
+
+	dependencies, interpretationErr := loadPackageDependencies(packageRootPath, noGitHosts)
+	require.Nil(t, interpretationErr)
+	require.Empty(t, dependencies)
+}
+
+func TestLoadPackageDependencies_UnlockedDependencyUsesDeclaredVersion(t *testing.T) {
+	packageRootPath := writePackageFiles(t, kurtosisYamlWithDependencies, nil)
+
+	dependencies, interpretationErr := loadPackageDependencies(packageRootPath, noGitHosts)
+	require.Nil(t, interpretationErr)
+	require.Len(t, dependencies, 1)
+	require.Nil(t, dependencies[dependencyName].lockedDependency)
+	require.Equal(t, dependencyVersion, dependencies[dependencyName].getVersionToClone())
+}
+
+func TestLoadPackageDependencies_LockedDependenciesUseLockedCommit(t *testing.T) {
+	kurtosisLock := package_lock.NewKurtosisLock()
+	kurtosisLock.Dependencies[dependencyName] = package_lock.NewLockedDependency(dependencyVersion, dependencyCommit, "sha256:1234")
+	kurtosisLock.Dependencies[transitiveDepName] = package_lock.NewLockedDependency("", transitiveDepCommit, "sha256:5678")
+	packageRootPath := writePackageFiles(t, kurtosisYamlWithDependencies, kurtosisLock)
+
+	dependencies, interpretationErr := loadPackageDependencies(packageRootPath, noGitHosts)
+	require.Nil(t, interpretationErr)
+	require.Len(t, dependencies, 2)
+	require.Equal(t, dependencyCommit, dependencies[dependencyName].getVersionToClone())
+	require.Equal(t, transitiveDepCommit, dependencies[transitiveDepName].getVersionToClone())
+}
+
+func TestLoadPackageDependencies_FailsOnDependencyMissingFromLock(t *testing.T) {
+	packageRootPath := writePackageFiles(t, kurtosisYamlWithDependencies, package_lock.NewKurtosisLock())
+
+	_, interpretationErr := loadPackageDependencies(packageRootPath, noGitHosts)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "is missing from "+package_lock.KurtosisLockFilename)
+}
+
+func TestLoadPackageDependencies_FailsOnOutdatedLock(t *testing.T) {
+	kurtosisLock := package_lock.NewKurtosisLock()
+	kurtosisLock.Dependencies[dependencyName] = package_lock.NewLockedDependency("v0.9.0", dependencyCommit, "")
+	packageRootPath := writePackageFiles(t, kurtosisYamlWithDependencies, kurtosisLock)
+
+	_, interpretationErr := loadPackageDependencies(packageRootPath, noGitHosts)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "was locked with version 'v0.9.0'")
+}
+
+func TestLoadPackageDependencies_FailsOnInvalidDependencyName(t *testing.T) {
+	packageRootPath := writePackageFiles(t, `name: "`+rootPackageName+`"
+dependencies:
+  "gitlab.com/author/repo": "main"
+`, nil)
+
+	_, interpretationErr := loadPackageDependencies(packageRootPath, noGitHosts)
+	require.NotNil(t, interpretationErr)
+}
+
+func TestFindDependency_PrefersMostSpecificPackage(t *testing.T) {
+	dependencies := map[string]*packageDependency{
+		dependencyName:    newPackageDependency(dependencyName, dependencyVersion, nil),
+		subDependencyName: newPackageDependency(subDependencyName, "main", nil),
+	}
+
+	dependency, found := findDependency(dependencies, dependencyName+"/lib/lib.star")
+	require.True(t, found)
+	require.Equal(t, dependencyName, dependency.packageName)
+
+	dependency, found = findDependency(dependencies, subDependencyName+"/main.star")
+	require.True(t, found)
+	require.Equal(t, subDependencyName, dependency.packageName)
+
+	_, found = findDependency(dependencies, dependencyName+"-other/main.star")
+	require.False(t, found)
+}
+
+func TestGetAbsoluteLocatorForRelativeModuleLocator_PinsDependencyVersion(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
+	kurtosisLock := package_lock.NewKurtosisLock()
+	kurtosisLock.Dependencies[dependencyName] = package_lock.NewLockedDependency(dependencyVersion, dependencyCommit, "")
+	packageRootPath := writePackageFiles(t, kurtosisYamlWithDependencies, kurtosisLock)
+	require.Nil(t, provider.SetUpRootPackage(packageRootPath, nil))
+
+	absoluteLocator, interpretationErr := provider.GetAbsoluteLocatorForRelativeModuleLocator(rootPackageName+"/main.star", dependencyName+"/lib.star")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, dependencyName+"/lib.star@"+dependencyCommit, absoluteLocator)
+
+	// an explicit version wins over the lock
+	absoluteLocator, interpretationErr = provider.GetAbsoluteLocatorForRelativeModuleLocator(rootPackageName+"/main.star", dependencyName+"/lib.star@main")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, dependencyName+"/lib.star@main", absoluteLocator)
+
+	// relative locators inside the root package aren't dependencies
+	absoluteLocator, interpretationErr = provider.GetAbsoluteLocatorForRelativeModuleLocator(rootPackageName+"/main.star", "./lib.star")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, rootPackageName+"/lib.star", absoluteLocator)
+}
+
+func TestIsRepoOnDiskAtVersion_NonCommitVersionsAreAssumedCheckedOut(t *testing.T) {
+	require.True(t, isRepoOnDiskAtVersion(t.TempDir(), "main"))
+	require.True(t, isRepoOnDiskAtVersion(t.TempDir(), emptyTagBranchOrCommit))
+	require.False(t, isRepoOnDiskAtVersion(t.TempDir(), dependencyCommit))
+}
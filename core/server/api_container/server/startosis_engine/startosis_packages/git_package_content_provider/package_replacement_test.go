@@ -0,0 +1,103 @@
+package git_package_content_provider
+
+import (
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/package_cache"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+)
+
+const (
+	replacedPackageName      = "github.com/kurtosis-tech/replaced"
+	replacedFileLocator      = replacedPackageName + "/lib.star"
+	otherPackageInReplaced   = "github.com/kurtosis-tech/replaced-other/lib.star"
+	replacedPackageFileName  = "lib.star"
+	replacedPackageFileValue = "a = 1"
+)
+
+func newProviderWithLocalReplacement(t *testing.T) (*GitPackageContentProvider, string) {
+	packagesDir := t.TempDir()
+	provider := NewGitPackageContentProvider(packagesDir, t.TempDir(), package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
+	replacedPackagePath := path.Join(packagesDir, "kurtosis-tech", "replaced")
+	require.NoError(t, os.MkdirAll(replacedPackagePath, moduleDirPermission))
+	require.NoError(t, os.WriteFile(path.Join(replacedPackagePath, replacedPackageFileName), []byte(replacedPackageFileValue), dependenciesFilePerm))
+	require.Nil(t, provider.SetUpRootPackage(noPackageRootPath, []string{replacedPackageName}))
+	return provider, replacedPackagePath
+}
+
+func TestLocallyReplacedPackage_LocatorsResolveToLocalCopy(t *testing.T) {
+	provider, replacedPackagePath := newProviderWithLocalReplacement(t)
+
+	absoluteLocator, interpretationErr := provider.GetAbsoluteLocatorForRelativeModuleLocator(rootPackageName+"/main.star", replacedFileLocator+"@v1.0.0")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, replacedFileLocator, absoluteLocator)
+
+	absoluteLocator, interpretationErr = provider.GetAbsoluteLocatorForRelativeModuleLocator(rootPackageName+"/main.star", otherPackageInReplaced)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, otherPackageInReplaced, absoluteLocator)
+
+	contents, interpretationErr := provider.GetModuleContents(replacedFileLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, replacedPackageFileValue, contents)
+
+	filePath, interpretationErr := provider.GetOnDiskAbsoluteFilePath(replacedFileLocator)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, path.Join(replacedPackagePath, replacedPackageFileName), filePath)
+
+	// files missing from the local copy never get cloned
+	_, interpretationErr = provider.GetOnDiskAbsoluteFilePath(replacedPackageName + "/missing.star")
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "doesn't exist in the local copy")
+}
+
+func TestLocallyReplacedPackage_FailsIfNotUploaded(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
+	interpretationErr := provider.SetUpRootPackage(noPackageRootPath, []string{replacedPackageName})
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "wasn't uploaded")
+
+	interpretationErr = provider.SetUpRootPackage(noPackageRootPath, []string{replacedPackageName + "@main"})
+	require.NotNil(t, interpretationErr)
+}
+
+func TestLocallyReplacedPackage_LocalCopyRemovedOnceNotReplacedAnymore(t *testing.T) {
+	provider, replacedPackagePath := newProviderWithLocalReplacement(t)
+
+	require.Nil(t, provider.SetUpRootPackage(noPackageRootPath, nil))
+	_, err := os.Stat(replacedPackagePath)
+	require.True(t, os.IsNotExist(err))
+
+	absoluteLocator, interpretationErr := provider.GetAbsoluteLocatorForRelativeModuleLocator(rootPackageName+"/main.star", replacedFileLocator+"@v1.0.0")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, replacedFileLocator+"@v1.0.0", absoluteLocator)
+}
+
+func TestLocallyReplacedPackage_LocalCopyKeptWhenItIsTheRootPackage(t *testing.T) {
+	provider, replacedPackagePath := newProviderWithLocalReplacement(t)
+
+	require.NoError(t, os.WriteFile(path.Join(replacedPackagePath, "kurtosis.yml"), []byte(`name: "`+replacedPackageName+`"`), dependenciesFilePerm))
+	require.Nil(t, provider.SetUpRootPackage(replacedPackagePath, nil))
+	_, err := os.Stat(replacedPackagePath)
+	require.NoError(t, err)
+}
+
+func TestLocallyReplacedPackage_OtherPackagesOfTheRepositoryAreNeverCloned(t *testing.T) {
+	packagesDir := t.TempDir()
+	provider := NewGitPackageContentProvider(packagesDir, t.TempDir(), package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
+	replacedSubPackagePath := path.Join(packagesDir, "kurtosis-tech", "mono", "sub")
+	require.NoError(t, os.MkdirAll(replacedSubPackagePath, moduleDirPermission))
+	require.Nil(t, provider.SetUpRootPackage(noPackageRootPath, []string{"github.com/kurtosis-tech/mono/sub"}))
+
+	_, interpretationErr := provider.GetOnDiskAbsoluteFilePath("github.com/kurtosis-tech/mono/other/lib.star")
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "replace the whole repository")
+
+	// the ones uploaded along, like the root package, are still readable
+	uploadedPackagePath := path.Join(packagesDir, "kurtosis-tech", "mono", "other")
+	require.NoError(t, os.MkdirAll(uploadedPackagePath, moduleDirPermission))
+	require.NoError(t, os.WriteFile(path.Join(uploadedPackagePath, replacedPackageFileName), []byte(replacedPackageFileValue), dependenciesFilePerm))
+	filePath, interpretationErr := provider.GetOnDiskAbsoluteFilePath("github.com/kurtosis-tech/mono/other/lib.star")
+	require.Nil(t, interpretationErr)
+	require.Equal(t, path.Join(uploadedPackagePath, replacedPackageFileName), filePath)
+}
@@ -0,0 +1,97 @@
+package git_package_content_provider
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/package_cache"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+const (
+	localGitHost           = "git.local"
+	localPackageName       = localGitHost + "/kurtosis-tech/local-package"
+	localPackageFileName   = "main.star"
+	localPackageFileValue  = "a = 1"
+	localPackageRepository = "kurtosis-tech/local-package.git"
+
+	isSharedPackageCache = true
+)
+
+// createBareRepository creates a bare repository containing a package, as served by a git host, and returns the
+// clone URL prefix of the host
+func createBareRepository(t *testing.T) string {
+	workTreePath := t.TempDir()
+	repo, err := git.PlainInit(workTreePath, isNotBareClone)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path.Join(workTreePath, "kurtosis.yml"), []byte("name: "+localPackageName+"\n"), dependenciesFilePerm))
+	require.NoError(t, os.WriteFile(path.Join(workTreePath, localPackageFileName), []byte(localPackageFileValue), dependenciesFilePerm))
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = workTree.Add(".")
+	require.NoError(t, err)
+	_, err = workTree.Commit("Initial commit", &git.CommitOptions{ //nolint:exhaustruct
+		Author: &object.Signature{Name: "kurtosis", Email: "kurtosis@kurtosistech.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	gitHostPath := t.TempDir()
+	_, err = git.PlainClone(path.Join(gitHostPath, localPackageRepository), true, &git.CloneOptions{URL: workTreePath}) //nolint:exhaustruct
+	require.NoError(t, err)
+	return "file://" + gitHostPath
+}
+
+func TestConfigurePackageSources_ClonesFromGitHostThenFromCacheWhenOffline(t *testing.T) {
+	cloneUrlPrefix := createBareRepository(t)
+	packageCache := package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache)
+	gitHosts := []*startosis_packages.GitHost{startosis_packages.NewGitHost(localGitHost, cloneUrlPrefix, "", "")}
+
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), packageCache)
+	require.NoError(t, provider.ConfigurePackageSources(gitHosts, false))
+	contents, interpretationErr := provider.GetModuleContents(localPackageName + "/" + localPackageFileName)
+	require.Nil(t, interpretationErr)
+	require.Equal(t, localPackageFileValue, contents)
+
+	// another enclave gets the package from the cache, even though its git host is gone
+	require.NoError(t, os.RemoveAll(path.Join(cloneUrlPrefix[len("file://"):], localPackageRepository)))
+	otherProvider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), packageCache)
+	require.NoError(t, otherProvider.ConfigurePackageSources(gitHosts, true))
+	packagePath, interpretationErr := otherProvider.ClonePackage(localPackageName)
+	require.Nil(t, interpretationErr)
+	contentsBytes, err := os.ReadFile(path.Join(packagePath, localPackageFileName))
+	require.NoError(t, err)
+	require.Equal(t, localPackageFileValue, string(contentsBytes))
+}
+
+func TestConfigurePackageSources_FailsWhenOfflineAndNotCached(t *testing.T) {
+	cloneUrlPrefix := createBareRepository(t)
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
+	require.NoError(t, provider.ConfigurePackageSources([]*startosis_packages.GitHost{startosis_packages.NewGitHost(localGitHost, cloneUrlPrefix, "", "")}, true))
+
+	_, interpretationErr := provider.GetModuleContents(localPackageName + "/" + localPackageFileName)
+	require.NotNil(t, interpretationErr)
+	require.Contains(t, interpretationErr.Error(), "isn't in the package cache")
+}
+
+func TestConfigurePackageSources_FailsWhenOfflineAndCacheNotShared(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), package_cache.NewPackageCache(t.TempDir(), !isSharedPackageCache))
+	require.NoError(t, provider.ConfigurePackageSources(nil, false))
+	err := provider.ConfigurePackageSources(nil, true)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "isn't shared across enclaves")
+}
+
+func TestConfigurePackageSources_FailsOnInvalidGitHosts(t *testing.T) {
+	provider := NewGitPackageContentProvider(t.TempDir(), t.TempDir(), package_cache.NewPackageCache(t.TempDir(), isSharedPackageCache))
+	require.Error(t, provider.ConfigurePackageSources([]*startosis_packages.GitHost{startosis_packages.NewGitHost("gitlab", "", "", "")}, false))
+	require.Error(t, provider.ConfigurePackageSources([]*startosis_packages.GitHost{startosis_packages.NewGitHost(localGitHost, "ftp://git.local", "", "")}, false))
+	require.NoError(t, provider.ConfigurePackageSources([]*startosis_packages.GitHost{startosis_packages.NewGitHost("gitlab.com", "", "", "token")}, false))
+
+	// packages on hosts which aren't configured can't be fetched
+	_, interpretationErr := provider.GetModuleContents(localPackageName + "/" + localPackageFileName)
+	require.NotNil(t, interpretationErr)
+}
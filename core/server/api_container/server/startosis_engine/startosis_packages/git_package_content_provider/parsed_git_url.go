@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"net/url"
 	"path"
 	"strings"
@@ -18,8 +19,15 @@ const (
 
 	tagBranchOrCommitDelimiter = "@"
 	emptyTagBranchOrCommit     = ""
+
+	gitRepositorySuffix = ".git"
+	// GitHub users and organizations can't have dots in their name, unlike hosts, so the packages of the other git hosts
+	// are stored in a directory named after their host without clashing with the ones of GitHub
+	hostDomainSeparator = "."
 )
 
+var noGitHosts = map[string]*startosis_packages.GitHost{}
+
 // ParsedGitURL an object representing a parsed moduleURL
 type ParsedGitURL struct {
 	// moduleAuthor the git of the module (GitHub user or org)
@@ -50,9 +58,14 @@ func newParsedGitURL(moduleAuthor, moduleName, gitURL, relativeRepoPath, relativ
 	}
 }
 
-// parseGitURL this takes a Git url (GitHub) for now and converts it into the struct ParsedGitURL
-// This can in the future be extended to GitLab or BitBucket or any other Git Host
+// parseGitURL this takes a Git url (GitHub) and converts it into the struct ParsedGitURL
 func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.InterpretationError) {
+	return parseGitURLOnGitHosts(packageURL, noGitHosts)
+}
+
+// parseGitURLOnGitHosts is parseGitURL for URLs on GitHub or on one of the git hosts, mapped by their host. The packages
+// of a git host are stored in a directory named after it, and cloned from its clone URL prefix
+func parseGitURLOnGitHosts(packageURL string, gitHosts map[string]*startosis_packages.GitHost) (*ParsedGitURL, *startosis_errors.InterpretationError) {
 	// we expect something like github.com/author/module/path.star
 	// we don't want schemas
 	parsedURL, err := url.Parse(packageURL)
@@ -69,8 +82,9 @@ func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.Interpreta
 	if err != nil {
 		return nil, startosis_errors.WrapWithInterpretationError(err, "Error parsing the URL with scheme for module '%v'", packageURLPrefixedWithHttps)
 	}
-	if parsedURL.Host != startosis_constants.GithubDomainPrefix {
-		return nil, startosis_errors.NewInterpretationError("Error parsing the URL of module. We only support modules on Github for now but got '%v'", packageURL)
+	gitHost, isConfiguredGitHost := gitHosts[parsedURL.Host]
+	if parsedURL.Host != startosis_constants.GithubDomainPrefix && !isConfiguredGitHost {
+		return nil, startosis_errors.NewInterpretationError("Error parsing the URL of module. We only support modules on Github for now, or on the git hosts of the current Kurtosis context, but got '%v'", packageURL)
 	}
 
 	pathWithoutVersion, maybeTagBranchOrCommit := parseOutTagBranchOrCommit(parsedURL.Path)
@@ -83,7 +97,10 @@ func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.Interpreta
 
 	moduleAuthor := splitURLPath[0]
 	moduleName := splitURLPath[1]
-	gitURL := fmt.Sprintf("%v://%v/%v/%v.git", httpsSchema, startosis_constants.GithubDomainPrefix, moduleAuthor, moduleName)
+	gitURL := fmt.Sprintf("%v://%v/%v/%v%v", httpsSchema, startosis_constants.GithubDomainPrefix, moduleAuthor, moduleName, gitRepositorySuffix)
+	if isConfiguredGitHost {
+		gitURL = fmt.Sprintf("%v/%v/%v%v", strings.TrimSuffix(gitHost.GetCloneUrlPrefix(), urlPathSeparator), moduleAuthor, moduleName, gitRepositorySuffix)
+	}
 	relativeModulePath := path.Join(moduleAuthor, moduleName)
 
 	relativeFilePath := ""
@@ -91,6 +108,13 @@ func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.Interpreta
 		relativeFilePath = path.Join(splitURLPath...)
 	}
 
+	if parsedURL.Host != startosis_constants.GithubDomainPrefix {
+		relativeModulePath = path.Join(parsedURL.Host, relativeModulePath)
+		if relativeFilePath != "" {
+			relativeFilePath = path.Join(parsedURL.Host, relativeFilePath)
+		}
+	}
+
 	parsedGitURL := newParsedGitURL(
 		moduleAuthor,
 		moduleName,
@@ -104,10 +128,29 @@ func parseGitURL(packageURL string) (*ParsedGitURL, *startosis_errors.Interpreta
 }
 
 func (parsedUrl *ParsedGitURL) getAbsoluteLocatorRelativeToThisURL(relativeUrl string) string {
-	absoluteUrl := path.Join(startosis_constants.GithubDomainPrefix, path.Dir(parsedUrl.relativeFilePath), relativeUrl)
+	absoluteUrl := getLocatorForPathOnDisk(path.Join(path.Dir(parsedUrl.relativeFilePath), relativeUrl))
 	return absoluteUrl
 }
 
+// getHost returns the host of the git server the repository is on, e.g. github.com
+func (parsedUrl *ParsedGitURL) getHost() string {
+	firstSubPath, _, _ := strings.Cut(parsedUrl.relativeRepoPath, urlPathSeparator)
+	if strings.Contains(firstSubPath, hostDomainSeparator) {
+		return firstSubPath
+	}
+	return startosis_constants.GithubDomainPrefix
+}
+
+// getLocatorForPathOnDisk returns the locator of the file or directory at the path relative to the packages directory
+func getLocatorForPathOnDisk(relativePathOnDisk string) string {
+	cleanRelativePathOnDisk := strings.TrimPrefix(path.Clean(relativePathOnDisk), urlPathSeparator)
+	firstSubPath, _, _ := strings.Cut(cleanRelativePathOnDisk, urlPathSeparator)
+	if strings.Contains(firstSubPath, hostDomainSeparator) {
+		return cleanRelativePathOnDisk
+	}
+	return path.Join(startosis_constants.GithubDomainPrefix, relativePathOnDisk)
+}
+
 // cleanPath removes empty "" from the string slice
 func cleanPathAndSplit(urlPath string) []string {
 	cleanPath := path.Clean(urlPath)
@@ -2,6 +2,7 @@ package git_package_content_provider
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/stretchr/testify/require"
 	"testing"
 )
@@ -136,3 +137,26 @@ func TestParsedGitUrl_ResolvesRelativeUrl(t *testing.T) {
 	expected = "github.com/kurtosis-tech/sample-startosis-load/src/lib.star"
 	require.Equal(t, expected, absoluteUrl)
 }
+
+func TestParsedGitURL_ParsesURLOnConfiguredGitHost(t *testing.T) {
+	gitHosts := map[string]*startosis_packages.GitHost{
+		"gitlab.com": startosis_packages.NewGitHost("gitlab.com", "https://gitlab.com/", "", ""),
+	}
+	parsedURL, err := parseGitURLOnGitHosts("gitlab.com/"+testModuleAuthor+"/"+testModuleName+"/"+testFileName+"@v1.0.0", gitHosts)
+	require.Nil(t, err)
+
+	expectedParsedURL := newParsedGitURL(
+		testModuleAuthor,
+		testModuleName,
+		fmt.Sprintf("https://gitlab.com/%v/%v.git", testModuleAuthor, testModuleName),
+		fmt.Sprintf("gitlab.com/%v/%v", testModuleAuthor, testModuleName),
+		fmt.Sprintf("gitlab.com/%v/%v/%v", testModuleAuthor, testModuleName, testFileName),
+		"v1.0.0",
+	)
+	require.Equal(t, expectedParsedURL, parsedURL)
+	require.Equal(t, "gitlab.com", parsedURL.getHost())
+	require.Equal(t, fmt.Sprintf("gitlab.com/%v/%v/lib/lib.star", testModuleAuthor, testModuleName), parsedURL.getAbsoluteLocatorRelativeToThisURL("lib/lib.star"))
+
+	_, err = parseGitURLOnGitHosts("gitea.com/"+testModuleAuthor+"/"+testModuleName, gitHosts)
+	require.NotNil(t, err)
+}
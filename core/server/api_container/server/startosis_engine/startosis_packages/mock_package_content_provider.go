@@ -76,6 +76,49 @@ func (_c *MockPackageContentProvider_ClonePackage_Call) RunAndReturn(run func(st
 	return _c
 }
 
+// ConfigurePackageSources provides a mock function with given fields: gitHosts, isOffline
+func (_m *MockPackageContentProvider) ConfigurePackageSources(gitHosts []*GitHost, isOffline bool) error {
+	ret := _m.Called(gitHosts, isOffline)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*GitHost, bool) error); ok {
+		r0 = rf(gitHosts, isOffline)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MockPackageContentProvider_ConfigurePackageSources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfigurePackageSources'
+type MockPackageContentProvider_ConfigurePackageSources_Call struct {
+	*mock.Call
+}
+
+// ConfigurePackageSources is a helper method to define mock.On call
+//   - gitHosts []*GitHost
+//   - isOffline bool
+func (_e *MockPackageContentProvider_Expecter) ConfigurePackageSources(gitHosts interface{}, isOffline interface{}) *MockPackageContentProvider_ConfigurePackageSources_Call {
+	return &MockPackageContentProvider_ConfigurePackageSources_Call{Call: _e.mock.On("ConfigurePackageSources", gitHosts, isOffline)}
+}
+
+func (_c *MockPackageContentProvider_ConfigurePackageSources_Call) Run(run func(gitHosts []*GitHost, isOffline bool)) *MockPackageContentProvider_ConfigurePackageSources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].([]*GitHost), args[1].(bool))
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_ConfigurePackageSources_Call) Return(_a0 error) *MockPackageContentProvider_ConfigurePackageSources_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_ConfigurePackageSources_Call) RunAndReturn(run func([]*GitHost, bool) error) *MockPackageContentProvider_ConfigurePackageSources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAbsoluteLocatorForRelativeModuleLocator provides a mock function with given fields: packageId, relativeOrAbsoluteModulePath
 func (_m *MockPackageContentProvider) GetAbsoluteLocatorForRelativeModuleLocator(packageId string, relativeOrAbsoluteModulePath string) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(packageId, relativeOrAbsoluteModulePath)
@@ -293,6 +336,51 @@ func (_c *MockPackageContentProvider_GetOnDiskAbsolutePackagePath_Call) RunAndRe
 	return _c
 }
 
+// SetUpRootPackage provides a mock function with given fields: packageRootPathOnDisk, locallyReplacedPackageIds
+func (_m *MockPackageContentProvider) SetUpRootPackage(packageRootPathOnDisk string, locallyReplacedPackageIds []string) *startosis_errors.InterpretationError {
+	ret := _m.Called(packageRootPathOnDisk, locallyReplacedPackageIds)
+
+	var r0 *startosis_errors.InterpretationError
+	if rf, ok := ret.Get(0).(func(string, []string) *startosis_errors.InterpretationError); ok {
+		r0 = rf(packageRootPathOnDisk, locallyReplacedPackageIds)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*startosis_errors.InterpretationError)
+		}
+	}
+
+	return r0
+}
+
+// MockPackageContentProvider_SetUpRootPackage_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetUpRootPackage'
+type MockPackageContentProvider_SetUpRootPackage_Call struct {
+	*mock.Call
+}
+
+// SetUpRootPackage is a helper method to define mock.On call
+//   - packageRootPathOnDisk string
+//   - locallyReplacedPackageIds []string
+func (_e *MockPackageContentProvider_Expecter) SetUpRootPackage(packageRootPathOnDisk interface{}, locallyReplacedPackageIds interface{}) *MockPackageContentProvider_SetUpRootPackage_Call {
+	return &MockPackageContentProvider_SetUpRootPackage_Call{Call: _e.mock.On("SetUpRootPackage", packageRootPathOnDisk, locallyReplacedPackageIds)}
+}
+
+func (_c *MockPackageContentProvider_SetUpRootPackage_Call) Run(run func(packageRootPathOnDisk string, locallyReplacedPackageIds []string)) *MockPackageContentProvider_SetUpRootPackage_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(string), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetUpRootPackage_Call) Return(_a0 *startosis_errors.InterpretationError) *MockPackageContentProvider_SetUpRootPackage_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *MockPackageContentProvider_SetUpRootPackage_Call) RunAndReturn(run func(string, []string) *startosis_errors.InterpretationError) *MockPackageContentProvider_SetUpRootPackage_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // StorePackageContents provides a mock function with given fields: packageId, packageContent, overwriteExisting
 func (_m *MockPackageContentProvider) StorePackageContents(packageId string, packageContent io.Reader, overwriteExisting bool) (string, *startosis_errors.InterpretationError) {
 	ret := _m.Called(packageId, packageContent, overwriteExisting)
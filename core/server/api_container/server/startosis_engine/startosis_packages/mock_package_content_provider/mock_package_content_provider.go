@@ -3,6 +3,7 @@ package mock_package_content_provider
 import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_constants"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_errors"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages"
 	"github.com/kurtosis-tech/stacktrace"
 	"io"
 	"os"
@@ -73,6 +74,14 @@ func (provider *MockPackageContentProvider) GetAbsoluteLocatorForRelativeModuleL
 	return "", nil
 }
 
+func (provider *MockPackageContentProvider) SetUpRootPackage(_ string, _ []string) *startosis_errors.InterpretationError {
+	return nil
+}
+
+func (provider *MockPackageContentProvider) ConfigurePackageSources(_ []*startosis_packages.GitHost, _ bool) error {
+	return nil
+}
+
 func (provider *MockPackageContentProvider) AddFileContent(packageId string, contents string) error {
 	absFilePath, err := writeContentToTempFile(contents)
 	if err != nil {
@@ -0,0 +1,200 @@
+package package_cache
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/go-git/go-git/v5"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+const (
+	commitsDirname  = "commits"
+	versionsDirname = "versions"
+
+	temporaryDirPattern = "tmp-*"
+	cacheDirPermission  = 0755
+	versionFilePerm     = 0644
+)
+
+var fullCommitShaRegex = regexp.MustCompile("^[0-9a-f]{40}$")
+
+// PackageCache Stores the repositories the Starlark packages are cloned from, addressed by their commit, so that the
+// enclaves reuse the clones of one another and packages can be run without reaching their git host.
+//
+// The layout on disk is:
+//
+//	<repository key>/commits/<commit>/     the repository checked out at the commit
+//	<repository key>/versions/<version key> the commit the tag or branch pointed to the last time it got cloned
+//
+// The cache is shared by API containers running concurrently, so entries are written to a temporary directory and
+// renamed in place, and never modified afterwards
+type PackageCache struct {
+	dirpath string
+
+	// false when the cache lives and dies with the API container, e.g. on Kubernetes where there's no volume the
+	// enclaves' namespaces can share
+	isSharedAcrossEnclaves bool
+}
+
+func NewPackageCache(dirpath string, isSharedAcrossEnclaves bool) *PackageCache {
+	return &PackageCache{
+		dirpath:                dirpath,
+		isSharedAcrossEnclaves: isSharedAcrossEnclaves,
+	}
+}
+
+func (cache *PackageCache) IsSharedAcrossEnclaves() bool {
+	return cache.isSharedAcrossEnclaves
+}
+
+// GetRepository returns the path of the cached repository cloned from the URL at the version, i.e. a tag, a branch,
+// a commit or an empty string for the default branch. A tag or branch resolves to the commit it pointed to the last
+// time it got stored
+func (cache *PackageCache) GetRepository(repositoryUrl string, version string) (string, bool) {
+	repositoryDirpath := cache.getRepositoryDirpath(repositoryUrl)
+	commit := version
+	if !fullCommitShaRegex.MatchString(version) {
+		commitBytes, err := os.ReadFile(path.Join(repositoryDirpath, versionsDirname, getKey(version)))
+		if err != nil {
+			return "", false
+		}
+		commit = strings.TrimSpace(string(commitBytes))
+	}
+	commitDirpath := path.Join(repositoryDirpath, commitsDirname, commit)
+	if _, err := os.Stat(commitDirpath); err != nil {
+		return "", false
+	}
+	return commitDirpath, true
+}
+
+// StoreRepository stores a copy of the repository cloned from the URL and checked out at the version
+func (cache *PackageCache) StoreRepository(repositoryUrl string, version string, clonedRepositoryDirpath string) error {
+	repository, err := git.PlainOpen(clonedRepositoryDirpath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening the repository at '%v'", clonedRepositoryDirpath)
+	}
+	head, err := repository.Head()
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the commit the repository at '%v' is checked out at", clonedRepositoryDirpath)
+	}
+	commit := head.Hash().String()
+
+	repositoryDirpath := cache.getRepositoryDirpath(repositoryUrl)
+	for _, dirpath := range []string{path.Join(repositoryDirpath, commitsDirname), path.Join(repositoryDirpath, versionsDirname)} {
+		if err = os.MkdirAll(dirpath, cacheDirPermission); err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating the package cache directory '%v'", dirpath)
+		}
+	}
+
+	commitDirpath := path.Join(repositoryDirpath, commitsDirname, commit)
+	if _, err = os.Stat(commitDirpath); os.IsNotExist(err) {
+		temporaryDirpath, err := os.MkdirTemp(repositoryDirpath, temporaryDirPattern)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred creating a temporary directory in the package cache")
+		}
+		defer os.RemoveAll(temporaryDirpath)
+		if err = CopyDirectory(clonedRepositoryDirpath, temporaryDirpath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred copying the repository at '%v' to the package cache", clonedRepositoryDirpath)
+		}
+		// another API container storing the same commit concurrently wins the rename, and its copy is just as good
+		if err = os.Rename(temporaryDirpath, commitDirpath); err != nil {
+			if _, statErr := os.Stat(commitDirpath); statErr != nil {
+				return stacktrace.Propagate(err, "An error occurred moving the copy of the repository at '%v' into the package cache", clonedRepositoryDirpath)
+			}
+		}
+	}
+
+	if fullCommitShaRegex.MatchString(version) {
+		return nil
+	}
+	versionFile, err := os.CreateTemp(repositoryDirpath, temporaryDirPattern)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating a temporary file in the package cache")
+	}
+	defer os.Remove(versionFile.Name())
+	_, err = versionFile.WriteString(commit)
+	if closeErr := versionFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred writing the commit of version '%v' of '%v' to the package cache", version, repositoryUrl)
+	}
+	if err = os.Chmod(versionFile.Name(), versionFilePerm); err != nil {
+		return stacktrace.Propagate(err, "An error occurred setting the permissions of the version file in the package cache")
+	}
+	if err = os.Rename(versionFile.Name(), path.Join(repositoryDirpath, versionsDirname, getKey(version))); err != nil {
+		return stacktrace.Propagate(err, "An error occurred storing the commit of version '%v' of '%v' in the package cache", version, repositoryUrl)
+	}
+	return nil
+}
+
+// CopyDirectory copies the content of the source directory into the destination directory, which must exist
+func CopyDirectory(sourceDirpath string, destinationDirpath string) error {
+	return filepath.WalkDir(sourceDirpath, func(sourcePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred walking '%v'", sourcePath)
+		}
+		relativePath, err := filepath.Rel(sourceDirpath, sourcePath)
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the path of '%v' relative to '%v'", sourcePath, sourceDirpath)
+		}
+		destinationPath := filepath.Join(destinationDirpath, relativePath)
+		info, err := entry.Info()
+		if err != nil {
+			return stacktrace.Propagate(err, "An error occurred getting the info of '%v'", sourcePath)
+		}
+		switch {
+		case entry.IsDir():
+			if err = os.MkdirAll(destinationPath, info.Mode().Perm()); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating directory '%v'", destinationPath)
+			}
+		case info.Mode()&fs.ModeSymlink != 0:
+			linkTarget, err := os.Readlink(sourcePath)
+			if err != nil {
+				return stacktrace.Propagate(err, "An error occurred reading the symlink '%v'", sourcePath)
+			}
+			if err = os.Symlink(linkTarget, destinationPath); err != nil {
+				return stacktrace.Propagate(err, "An error occurred creating the symlink '%v'", destinationPath)
+			}
+		default:
+			if err = copyFile(sourcePath, destinationPath, info.Mode().Perm()); err != nil {
+				return stacktrace.Propagate(err, "An error occurred copying file '%v' to '%v'", sourcePath, destinationPath)
+			}
+		}
+		return nil
+	})
+}
+
+func copyFile(sourceFilepath string, destinationFilepath string, perm fs.FileMode) error {
+	sourceFile, err := os.Open(sourceFilepath)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred opening '%v'", sourceFilepath)
+	}
+	defer sourceFile.Close()
+	destinationFile, err := os.OpenFile(destinationFilepath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, perm)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating '%v'", destinationFilepath)
+	}
+	defer destinationFile.Close()
+	if _, err = io.Copy(destinationFile, sourceFile); err != nil {
+		return stacktrace.Propagate(err, "An error occurred copying the content of '%v'", sourceFilepath)
+	}
+	return nil
+}
+
+func (cache *PackageCache) getRepositoryDirpath(repositoryUrl string) string {
+	return path.Join(cache.dirpath, getKey(repositoryUrl))
+}
+
+// getKey turns a URL or a version, which can contain slashes, into a directory name
+func getKey(value string) string {
+	hash := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,66 @@
+package package_cache
+
+import (
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path"
+	"testing"
+	"time"
+)
+
+const (
+	repositoryUrl = "gitlab.com/kurtosis-tech/package"
+	branch        = "main"
+	fileName      = "main.star"
+	fileValue     = "a = 1"
+	filePerm      = 0644
+
+	isSharedPackageCache = true
+)
+
+func createRepository(t *testing.T) (string, string) {
+	repositoryPath := t.TempDir()
+	repo, err := git.PlainInit(repositoryPath, false)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path.Join(repositoryPath, fileName), []byte(fileValue), filePerm))
+	workTree, err := repo.Worktree()
+	require.NoError(t, err)
+	_, err = workTree.Add(fileName)
+	require.NoError(t, err)
+	commit, err := workTree.Commit("Initial commit", &git.CommitOptions{ //nolint:exhaustruct
+		Author: &object.Signature{Name: "kurtosis", Email: "kurtosis@kurtosistech.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+	return repositoryPath, commit.String()
+}
+
+func TestPackageCache_StoredRepositoryIsFoundByVersionAndCommit(t *testing.T) {
+	repositoryPath, commit := createRepository(t)
+	cache := NewPackageCache(t.TempDir(), isSharedPackageCache)
+	require.NoError(t, cache.StoreRepository(repositoryUrl, branch, repositoryPath))
+	// storing it again is a no-op
+	require.NoError(t, cache.StoreRepository(repositoryUrl, branch, repositoryPath))
+
+	for _, version := range []string{branch, commit} {
+		cachedRepositoryPath, found := cache.GetRepository(repositoryUrl, version)
+		require.True(t, found)
+		contents, err := os.ReadFile(path.Join(cachedRepositoryPath, fileName))
+		require.NoError(t, err)
+		require.Equal(t, fileValue, string(contents))
+	}
+}
+
+func TestPackageCache_MissingRepositoryIsNotFound(t *testing.T) {
+	repositoryPath, _ := createRepository(t)
+	cache := NewPackageCache(t.TempDir(), isSharedPackageCache)
+	require.NoError(t, cache.StoreRepository(repositoryUrl, branch, repositoryPath))
+
+	_, found := cache.GetRepository(repositoryUrl, "other-branch")
+	require.False(t, found)
+	_, found = cache.GetRepository(repositoryUrl, "0123456789abcdef0123456789abcdef01234567")
+	require.False(t, found)
+	_, found = cache.GetRepository("gitlab.com/kurtosis-tech/other-package", branch)
+	require.False(t, found)
+}
@@ -30,4 +30,15 @@ type PackageContentProvider interface {
 
 	// GetAbsoluteLocatorForRelativeModuleLocator returns the absolute package path for a relative module path
 	GetAbsoluteLocatorForRelativeModuleLocator(packageId string, relativeOrAbsoluteModulePath string) (string, *startosis_errors.InterpretationError)
+
+	// SetUpRootPackage configures the provider for the package about to run, until the next one runs. The locators
+	// pointing into a dependency declared in its kurtosis.yml get pinned to their version, and the ones pointing into a
+	// locally replaced package resolve to the copy stored with StorePackageContents. The root path is empty for
+	// standalone scripts
+	SetUpRootPackage(packageRootPathOnDisk string, locallyReplacedPackageIds []string) *startosis_errors.InterpretationError
+
+	// ConfigurePackageSources sets the git hosts the packages of the run about to start can be fetched from on top of
+	// GitHub, and whether they can only be taken from the package cache shared by the enclaves. Every run sets up its
+	// own sources before it gets interpreted
+	ConfigurePackageSources(gitHosts []*GitHost, isOffline bool) error
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/enclave_structure"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/instructions_plan/resolver"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/plan_export"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/starlark_warning"
 	"github.com/sirupsen/logrus"
 	"strings"
@@ -46,6 +47,7 @@ func NewStartosisRunner(interpreter *StartosisInterpreter, validator *StartosisV
 func (runner *StartosisRunner) Run(
 	ctx context.Context,
 	dryRun bool,
+	prune bool,
 	parallelism int,
 	packageId string,
 	mainFunctionName string,
@@ -78,9 +80,10 @@ func (runner *StartosisRunner) Run(
 		for _, experimentalFeature := range experimentalFeatures {
 			experimentalFeaturesStr = append(experimentalFeaturesStr, experimentalFeature.String())
 		}
-		logrus.Infof("Executing Starlark package '%s' with the following parameters: dry-run: '%v', parallelism: '%d', experimental features: '%s', main function name: '%s', params: '%s'",
+		logrus.Infof("Executing Starlark package '%s' with the following parameters: dry-run: '%v', prune: '%v', parallelism: '%d', experimental features: '%s', main function name: '%s', params: '%s'",
 			packageId,
 			dryRun,
+			prune,
 			parallelism,
 			strings.Join(experimentalFeaturesStr, ", "),
 			mainFunctionName,
@@ -154,7 +157,7 @@ func (runner *StartosisRunner) Run(
 			startingExecutionMsg, defaultCurrentStepNumber, totalNumberOfInstructions)
 		starlarkRunResponseLines <- progressInfo
 
-		executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput)
+		executionResponseLinesChan := runner.startosisExecutor.Execute(ctx, dryRun, prune, parallelism, instructionsPlan.GetIndexOfFirstInstruction(), instructionsSequence, serializedScriptOutput)
 		if isRunFinished, isRunSuccessful := forwardKurtosisResponseLineChannelUntilSourceIsClosed(executionResponseLinesChan, starlarkRunResponseLines); !isRunFinished {
 			logrus.Warnf("Execution finished but no 'RunFinishedEvent' was received through the stream. This is unexpected as every execution should be terminal.")
 		} else if !isRunSuccessful {
@@ -166,6 +169,74 @@ func (runner *StartosisRunner) Run(
 	return starlarkRunResponseLines
 }
 
+// ExportPlan interprets the Starlark code without validating nor executing it, and returns the plan describing what
+// running it against an empty enclave would do
+func (runner *StartosisRunner) ExportPlan(
+	ctx context.Context,
+	packageId string,
+	mainFunctionName string,
+	relativePathToMainFile string,
+	serializedStartosis string,
+	serializedParams string,
+) (*plan_export.Plan, *kurtosis_core_rpc_api_bindings.StarlarkInterpretationError) {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+
+	_, instructionsPlan, interpretationError := runner.startosisInterpreter.Interpret(
+		ctx,
+		packageId,
+		mainFunctionName,
+		relativePathToMainFile,
+		serializedStartosis,
+		serializedParams,
+		enclave_structure.NewEnclaveComponents(),
+		resolver.NewInstructionsPlanMask(0),
+	)
+	if interpretationError != nil {
+		return nil, interpretationError
+	}
+	instructionsSequence, interpretationErr := instructionsPlan.GeneratePlan()
+	if interpretationErr != nil {
+		return nil, interpretationErr.ToAPIType()
+	}
+	return exportPlan(instructionsSequence), nil
+}
+
+// DiffPlan interprets the Starlark code without validating nor executing it, and returns the changes running it would
+// make to the services of the enclave given the instructions run in the enclave before. When prune is true, the
+// services the run would prune from the enclave are reported as removed
+func (runner *StartosisRunner) DiffPlan(
+	ctx context.Context,
+	packageId string,
+	mainFunctionName string,
+	relativePathToMainFile string,
+	serializedStartosis string,
+	serializedParams string,
+	prune bool,
+) *kurtosis_core_rpc_api_bindings.GetStarlarkRunDiffResponse {
+	runner.mutex.Lock()
+	defer runner.mutex.Unlock()
+
+	currentEnclavePlan := runner.startosisExecutor.enclavePlan
+	_, instructionsPlan, interpretationError := runner.startosisInterpreter.InterpretAndOptimizePlan(
+		ctx,
+		packageId,
+		mainFunctionName,
+		relativePathToMainFile,
+		serializedStartosis,
+		serializedParams,
+		currentEnclavePlan,
+	)
+	if interpretationError != nil {
+		return binding_constructors.NewGetStarlarkRunDiffResponseFromInterpretationError(interpretationError)
+	}
+	instructionsSequence, interpretationErr := instructionsPlan.GeneratePlan()
+	if interpretationErr != nil {
+		return binding_constructors.NewGetStarlarkRunDiffResponseFromInterpretationError(interpretationErr.ToAPIType())
+	}
+	return diffPlan(currentEnclavePlan.GeneratePlan(), instructionsSequence, prune)
+}
+
 func forwardKurtosisResponseLineChannelUntilSourceIsClosed(sourceChan <-chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine, destChan chan<- *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) (bool, bool) {
 	isSuccessful := false
 	isStarlarkRunFinished := false
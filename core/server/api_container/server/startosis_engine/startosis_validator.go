@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/kurtosis/core/server/commons/enclave_data_directory"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
+	"time"
 )
 
 const (
@@ -27,15 +28,18 @@ type StartosisValidator struct {
 	fileArtifactStore *enclave_data_directory.FilesArtifactStore
 
 	backend *backend_interface.KurtosisBackend
+
+	areUlimitsSupported bool
 }
 
-func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore) *StartosisValidator {
+func NewStartosisValidator(kurtosisBackend *backend_interface.KurtosisBackend, serviceNetwork service_network.ServiceNetwork, fileArtifactStore *enclave_data_directory.FilesArtifactStore, areUlimitsSupported bool) *StartosisValidator {
 	dockerImagesValidator := startosis_validator.NewDockerImagesValidator(kurtosisBackend)
 	return &StartosisValidator{
 		dockerImagesValidator,
 		serviceNetwork,
 		fileArtifactStore,
 		kurtosisBackend,
+		areUlimitsSupported,
 	}
 }
 
@@ -78,14 +82,24 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 			serviceNamePortIdMapping,
 			availableCpuInMilliCores,
 			availableMemoryInMegaBytes,
-			isResourceInformationComplete)
+			isResourceInformationComplete,
+			validator.areUlimitsSupported)
 
+		firstInstructionRequiringImage := map[string]*instructions_plan.ScheduledInstruction{}
 		isValidationFailure = isValidationFailure ||
-			validator.validateAndUpdateEnvironment(instructionsSequence, environment, starlarkRunResponseLineStream)
+			validator.validateAndUpdateEnvironment(instructionsSequence, environment, firstInstructionRequiringImage, starlarkRunResponseLineStream)
 		logrus.Debug("Finished validating environment. Validating and downloading container images.")
 
+		imagePullDurations := map[string]time.Duration{}
 		isValidationFailure = isValidationFailure ||
-			validator.downloadAndValidateImagesAccountingForProgress(ctx, environment, starlarkRunResponseLineStream)
+			validator.downloadAndValidateImagesAccountingForProgress(ctx, environment, imagePullDurations, starlarkRunResponseLineStream)
+
+		// the time spent pulling an image is accounted to the first instruction requiring it
+		for image, imagePullDuration := range imagePullDurations {
+			if scheduledInstruction, found := firstInstructionRequiringImage[image]; found {
+				scheduledInstruction.AddImagePullDuration(imagePullDuration)
+			}
+		}
 
 		if isValidationFailure {
 			logrus.Debug("Errors encountered downloading and validating container images.")
@@ -97,7 +111,7 @@ func (validator *StartosisValidator) Validate(ctx context.Context, instructionsS
 	return starlarkRunResponseLineStream
 }
 
-func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSequence []*instructions_plan.ScheduledInstruction, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
+func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSequence []*instructions_plan.ScheduledInstruction, environment *startosis_validator.ValidatorEnvironment, firstInstructionRequiringImage map[string]*instructions_plan.ScheduledInstruction, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
 	isValidationFailure := false
 	for _, scheduledInstruction := range instructionsSequence {
 		if scheduledInstruction.IsExecuted() {
@@ -114,11 +128,18 @@ func (validator *StartosisValidator) validateAndUpdateEnvironment(instructionsSe
 			starlarkRunResponseLineStream <- binding_constructors.NewStarlarkRunResponseLineFromValidationError(wrappedValidationError.ToAPIType())
 			isValidationFailure = true
 		}
+		for image := range environment.GetRequiredContainerImages() {
+			if _, found := firstInstructionRequiringImage[image]; !found {
+				firstInstructionRequiringImage[image] = scheduledInstruction
+			}
+		}
 	}
 	return isValidationFailure
 }
 
-func (validator *StartosisValidator) downloadAndValidateImagesAccountingForProgress(ctx context.Context, environment *startosis_validator.ValidatorEnvironment, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
+// downloadAndValidateImagesAccountingForProgress fills imagePullDurations with the time it took to validate each image,
+// which is mostly the time spent pulling it when it's not available locally
+func (validator *StartosisValidator) downloadAndValidateImagesAccountingForProgress(ctx context.Context, environment *startosis_validator.ValidatorEnvironment, imagePullDurations map[string]time.Duration, starlarkRunResponseLineStream chan *kurtosis_core_rpc_api_bindings.StarlarkRunResponseLine) bool {
 	isValidationFailure := false
 
 	errors := make(chan error)
@@ -134,6 +155,7 @@ func (validator *StartosisValidator) downloadAndValidateImagesAccountingForProgr
 
 	go func() {
 		var imageCurrentlyBeingValidated []string
+		imageValidationStartTimes := map[string]time.Time{}
 		// we read the three channels to update imageCurrentlyBeingValidated and return progress info back to the CLI
 		// it returns when the error channel is closed. The error channel is the reference here as we don't want to
 		// hide an error from the user. I.e. we don't want this function to return before the error channel is closed
@@ -146,6 +168,7 @@ func (validator *StartosisValidator) downloadAndValidateImagesAccountingForProgr
 				}
 				logrus.Debugf("Received image validation started event: '%s'", image)
 				imageCurrentlyBeingValidated = append(imageCurrentlyBeingValidated, image)
+				imageValidationStartTimes[image] = time.Now()
 				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, numberOfImageValidated, totalImageNumberToValidate)
 			case image, isChanOpen := <-imageValidationFinished:
 				if !isChanOpen {
@@ -155,6 +178,7 @@ func (validator *StartosisValidator) downloadAndValidateImagesAccountingForProgr
 				numberOfImageValidated++
 				logrus.Debugf("Received image validation finished event: '%s'", image)
 				imageCurrentlyBeingValidated = removeIfPresent(imageCurrentlyBeingValidated, image)
+				imagePullDurations[image] = time.Since(imageValidationStartTimes[image])
 				updateProgressWithDownloadInfo(starlarkRunResponseLineStream, imageCurrentlyBeingValidated, numberOfImageValidated, totalImageNumberToValidate)
 			case err, isChanOpen := <-errors:
 				if !isChanOpen {
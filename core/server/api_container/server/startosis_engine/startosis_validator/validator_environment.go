@@ -18,9 +18,10 @@ type ValidatorEnvironment struct {
 	isResourceInformationComplete bool
 	minCPUByServiceName           map[service.ServiceName]compute_resources.CpuMilliCores
 	minMemoryByServiceName        map[service.ServiceName]compute_resources.MemoryInMegaBytes
+	areUlimitsSupported           bool
 }
 
-func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool) *ValidatorEnvironment {
+func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifactNames map[string]bool, serviceNameToPrivatePortIds map[service.ServiceName][]string, availableCpuInMilliCores compute_resources.CpuMilliCores, availableMemoryInMegaBytes compute_resources.MemoryInMegaBytes, isResourceInformationComplete bool, areUlimitsSupported bool) *ValidatorEnvironment {
 	serviceNamesWithComponentExistence := map[service.ServiceName]ComponentExistence{}
 	for serviceName := range serviceNames {
 		serviceNamesWithComponentExistence[serviceName] = ComponentExistedBeforePackageRun
@@ -39,6 +40,7 @@ func NewValidatorEnvironment(serviceNames map[service.ServiceName]bool, artifact
 		isResourceInformationComplete: isResourceInformationComplete,
 		minMemoryByServiceName:        map[service.ServiceName]compute_resources.MemoryInMegaBytes{},
 		minCPUByServiceName:           map[service.ServiceName]compute_resources.CpuMilliCores{},
+		areUlimitsSupported:           areUlimitsSupported,
 	}
 }
 
@@ -46,6 +48,15 @@ func (environment *ValidatorEnvironment) AppendRequiredContainerImage(containerI
 	environment.requiredDockerImages[containerImage] = true
 }
 
+// GetRequiredContainerImages returns a copy of the set of container images required so far
+func (environment *ValidatorEnvironment) GetRequiredContainerImages() map[string]bool {
+	requiredContainerImages := map[string]bool{}
+	for containerImage := range environment.requiredDockerImages {
+		requiredContainerImages[containerImage] = true
+	}
+	return requiredContainerImages
+}
+
 func (environment *ValidatorEnvironment) GetNumberOfContainerImages() uint32 {
 	return uint32(len(environment.requiredDockerImages))
 }
@@ -150,3 +161,11 @@ func (environment *ValidatorEnvironment) HasEnoughMemory(memoryToConsume uint64,
 	}
 	return startosis_errors.NewValidationError("service '%v' requires '%v' megabytes of memory but based on our calculation we will only have '%v' megabytes available at the time we start the service", serviceNameForLogging, memoryToConsume, environment.availableMemoryInMegaBytes)
 }
+
+// CanSetUlimits fails for services setting ulimits when the backend leaves them to the container runtime, like Kubernetes
+func (environment *ValidatorEnvironment) CanSetUlimits(ulimits map[string]uint64, serviceNameForLogging service.ServiceName) *startosis_errors.ValidationError {
+	if environment.areUlimitsSupported || len(ulimits) == 0 {
+		return nil
+	}
+	return startosis_errors.NewValidationError("service '%v' sets ulimits but they aren't supported by the backend of this enclave, which is the case on Kubernetes", serviceNameForLogging)
+}
@@ -16,11 +16,12 @@ const (
 	isResourceInformationComplete = true
 	tooMuchMemory                 = 120000
 	tooMuchCpu                    = 5000
+	areUlimitsSupported           = true
 )
 
 func TestMultiplePortIdsForValidation(t *testing.T) {
 	emptyInitialMapping := map[service.ServiceName][]string{}
-	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete)
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, areUlimitsSupported)
 	portIds := []string{
 		fooPortId,
 		fizzPortId,
@@ -35,3 +36,15 @@ func TestMultiplePortIdsForValidation(t *testing.T) {
 	require.Error(t, validatorEnvironment.HasEnoughCPU(tooMuchCpu, testBarService))
 	require.Error(t, validatorEnvironment.HasEnoughMemory(tooMuchMemory, testBarService))
 }
+
+func TestCanSetUlimits_FailsOnlyWhenUlimitsAreNotSupported(t *testing.T) {
+	ulimits := map[string]uint64{"nofile": 65536}
+	emptyInitialMapping := map[service.ServiceName][]string{}
+
+	validatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, areUlimitsSupported)
+	require.Nil(t, validatorEnvironment.CanSetUlimits(ulimits, testBarService))
+
+	kubernetesValidatorEnvironment := NewValidatorEnvironment(nil, nil, emptyInitialMapping, availableCpuInMilliCores, availableMemoryInBytes, isResourceInformationComplete, !areUlimitsSupported)
+	require.Nil(t, kubernetesValidatorEnvironment.CanSetUlimits(map[string]uint64{}, testBarService))
+	require.NotNil(t, kubernetesValidatorEnvironment.CanSetUlimits(ulimits, testBarService))
+}
@@ -8,6 +8,7 @@ package enclave_data_directory
 import (
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/database_accessors/enclave_db/file_artifacts_db"
 	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/git_package_content_provider"
+	"github.com/kurtosis-tech/kurtosis/core/server/api_container/server/startosis_engine/startosis_packages/package_cache"
 	"github.com/kurtosis-tech/stacktrace"
 	"path"
 	"sync"
@@ -65,7 +66,9 @@ func (dir EnclaveDataDirectory) GetFilesArtifactStore() (*FilesArtifactStore, er
 	return currentFilesArtifactStore, dbError
 }
 
-func (dir EnclaveDataDirectory) GetGitPackageContentProvider() (*git_package_content_provider.GitPackageContentProvider, error) {
+// GetGitPackageContentProvider the package cache dirpath is outside the enclave data dir as it's shared by all the enclaves,
+// unless the backend can't share it in which case it's only reused across the runs of this enclave
+func (dir EnclaveDataDirectory) GetGitPackageContentProvider(packageCacheDirpath string, isPackageCacheShared bool) (*git_package_content_provider.GitPackageContentProvider, error) {
 	packageStoreDirpath := path.Join(dir.absMountDirpath, startosisPackageStoreDirname)
 	if err := ensureDirpathExists(packageStoreDirpath); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred ensuring the Starlark package store dirpath '%v' exists.", packageStoreDirpath)
@@ -76,5 +79,9 @@ func (dir EnclaveDataDirectory) GetGitPackageContentProvider() (*git_package_con
 		return nil, stacktrace.Propagate(err, "An error occurred ensuring the Starlark temporary package store dirpath '%v' exists.", tempPackageStoreDirpath)
 	}
 
-	return git_package_content_provider.NewGitPackageContentProvider(packageStoreDirpath, tempPackageStoreDirpath), nil
+	if err := ensureDirpathExists(packageCacheDirpath); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred ensuring the Starlark package cache dirpath '%v' exists.", packageCacheDirpath)
+	}
+
+	return git_package_content_provider.NewGitPackageContentProvider(packageStoreDirpath, tempPackageStoreDirpath, package_cache.NewPackageCache(packageCacheDirpath, isPackageCacheShared)), nil
 }
@@ -10,6 +10,9 @@ var noPackageNameFound = ""
 
 type KurtosisYaml struct {
 	PackageName string `yaml:"name"`
+
+	// The packages this package imports from, mapped to the version to use, i.e. a tag, a branch or a commit
+	Dependencies map[string]string `yaml:"dependencies"`
 }
 
 func (parser *KurtosisYaml) GetPackageName() string {
@@ -19,6 +22,13 @@ func (parser *KurtosisYaml) GetPackageName() string {
 	return parser.PackageName
 }
 
+func (parser *KurtosisYaml) GetDependencies() map[string]string {
+	if parser == nil || parser.Dependencies == nil {
+		return map[string]string{}
+	}
+	return parser.Dependencies
+}
+
 // TODO: this parsing logic is similar to what have we in the api, maybe we should move everything into one
 // common package. This method assumes that the kurtosis.yml exists in the path provided.
 func parseKurtosisYamlInternal(absPathToKurtosisYaml string, read func(filename string) ([]byte, error)) (*KurtosisYaml, error) {
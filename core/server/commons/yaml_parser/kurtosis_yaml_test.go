@@ -42,3 +42,20 @@ func Test_parseKurtosisYamlInternal_IncorrectYaml(t *testing.T) {
 	require.Nil(t, err)
 	require.Equal(t, "", actual.GetPackageName())
 }
+
+func Test_parseKurtosisYamlInternal_Dependencies(t *testing.T) {
+	mockRead := func(filename string) ([]byte, error) {
+		return []byte(`name: github.com/test-author/test-repo
+dependencies:
+  github.com/test-author/redis-package: 0.1.0
+  github.com/test-author/monorepo/postgres: main
+`), nil
+	}
+
+	actual, err := parseKurtosisYamlInternal(kurtosisYmlPath, mockRead)
+	require.Nil(t, err)
+	require.Equal(t, map[string]string{
+		"github.com/test-author/redis-package":     "0.1.0",
+		"github.com/test-author/monorepo/postgres": "main",
+	}, actual.GetDependencies())
+}
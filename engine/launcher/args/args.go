@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"reflect"
 	"strings"
+	"time"
 
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/stacktrace"
@@ -47,6 +48,12 @@ type EngineServerArgs struct {
 	// Environment variable to pass to all the enclaves the engine is going to create. Those environment variable will
 	// then be accessible in Starlark scripts in the `kurtosis` module
 	EnclaveEnvVars string `json:"enclaveEnvVars"`
+
+	// How long the service logs are kept around for, as a Go duration string (e.g. "168h")
+	LogRetentionPeriod string `json:"logRetentionPeriod"`
+
+	// When the stored service logs take more than this, the oldest ones get removed until they fit; zero means no limit
+	LogRetentionMaxSizeInMegabytes uint64 `json:"logRetentionMaxSizeInMegabytes"`
 }
 
 func (args *EngineServerArgs) UnmarshalJSON(data []byte) error {
@@ -93,21 +100,25 @@ func NewEngineServerArgs(
 	onBastionHost bool,
 	poolSize uint8,
 	enclaveEnvVars string,
+	logRetentionPeriod time.Duration,
+	logRetentionMaxSizeInMegabytes uint64,
 ) (*EngineServerArgs, error) {
 	if enclaveEnvVars == "" {
 		enclaveEnvVars = emptyJsonField
 	}
 	result := &EngineServerArgs{
-		GrpcListenPortNum:           grpcListenPortNum,
-		LogLevelStr:                 logLevelStr,
-		ImageVersionTag:             imageVersionTag,
-		MetricsUserID:               metricsUserID,
-		DidUserAcceptSendingMetrics: didUserAcceptSendingMetrics,
-		KurtosisBackendType:         kurtosisBackendType,
-		KurtosisLocalBackendConfig:  kurtosisLocalBackendConfig,
-		OnBastionHost:               onBastionHost,
-		PoolSize:                    poolSize,
-		EnclaveEnvVars:              enclaveEnvVars,
+		GrpcListenPortNum:              grpcListenPortNum,
+		LogLevelStr:                    logLevelStr,
+		ImageVersionTag:                imageVersionTag,
+		MetricsUserID:                  metricsUserID,
+		DidUserAcceptSendingMetrics:    didUserAcceptSendingMetrics,
+		KurtosisBackendType:            kurtosisBackendType,
+		KurtosisLocalBackendConfig:     kurtosisLocalBackendConfig,
+		OnBastionHost:                  onBastionHost,
+		PoolSize:                       poolSize,
+		EnclaveEnvVars:                 enclaveEnvVars,
+		LogRetentionPeriod:             logRetentionPeriod.String(),
+		LogRetentionMaxSizeInMegabytes: logRetentionMaxSizeInMegabytes,
 	}
 	if err := result.validate(); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred validating engine server args")
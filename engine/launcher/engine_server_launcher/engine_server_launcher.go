@@ -14,6 +14,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"net"
+	"time"
 )
 
 const (
@@ -39,6 +40,8 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 	onBastionHost bool,
 	poolSize uint8,
 	enclaveEnvVars string,
+	logRetentionPeriod time.Duration,
+	logRetentionMaxSizeInMegabytes uint64,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -55,6 +58,8 @@ func (launcher *EngineServerLauncher) LaunchWithDefaultVersion(
 		onBastionHost,
 		poolSize,
 		enclaveEnvVars,
+		logRetentionPeriod,
+		logRetentionMaxSizeInMegabytes,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred launching the engine server container with default version tag '%v'", kurtosis_version.KurtosisVersion)
@@ -73,6 +78,8 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 	onBastionHost bool,
 	poolSize uint8,
 	enclaveEnvVars string,
+	logRetentionPeriod time.Duration,
+	logRetentionMaxSizeInMegabytes uint64,
 ) (
 	resultPublicIpAddr net.IP,
 	resultPublicGrpcPortSpec *port_spec.PortSpec,
@@ -90,6 +97,8 @@ func (launcher *EngineServerLauncher) LaunchWithCustomVersion(
 		onBastionHost,
 		poolSize,
 		enclaveEnvVars,
+		logRetentionPeriod,
+		logRetentionMaxSizeInMegabytes,
 	)
 	if err != nil {
 		return nil, nil, stacktrace.Propagate(err, "An error occurred creating the engine server args")
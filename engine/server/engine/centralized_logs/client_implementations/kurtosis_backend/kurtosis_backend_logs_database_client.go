@@ -14,6 +14,7 @@ import (
 	"io"
 	"strings"
 	"sync"
+	"time"
 )
 
 const (
@@ -36,6 +37,7 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+	logLineRange *logline.LogLineRange,
 	shouldFollowLogs bool,
 ) (
 	chan map[service.ServiceUUID][]logline.LogLine,
@@ -44,6 +46,11 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 	error,
 ) {
 
+	// the backend doesn't tell when the stored logs end and the followed ones start, so there's no way to know which are the last N lines
+	if shouldFollowLogs && logLineRange.ShouldReturnLastLogLinesOnly() {
+		return nil, nil, nil, stacktrace.NewError("Following the logs while requesting only the last '%v' log lines is not supported by this logs database client", logLineRange.GetNumLogLines())
+	}
+
 	ctx, cancelCtxFunc := context.WithCancel(ctx)
 
 	userServiceFilters := &service.ServiceFilters{
@@ -103,6 +110,7 @@ func (client *kurtosisBackendLogsDatabaseClient) StreamUserServiceLogs(
 			serviceUuid,
 			serviceReadCloser,
 			conjunctiveLogFiltersWithRegex,
+			logLineRange,
 		)
 	}
 
@@ -153,6 +161,20 @@ func (client *kurtosisBackendLogsDatabaseClient) FilterExistingServiceUuids(
 	return filteredServiceUuidsSet, nil
 }
 
+// The logs are read straight from the containers, so they go away along with the enclave and there's nothing left to remove
+func (client *kurtosisBackendLogsDatabaseClient) RemoveEnclaveLogs(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) error {
+	return nil
+}
+
+func (client *kurtosisBackendLogsDatabaseClient) GetLogsSizeInBytesByEnclaveUuid(
+	ctx context.Context,
+) (map[enclave.EnclaveUUID]uint64, error) {
+	return nil, stacktrace.NewError("Getting the size of the stored logs isn't supported when the logs are read straight from the containers")
+}
+
 // ====================================================================================================
 //
 //	Private helper functions
@@ -166,11 +188,15 @@ func streamServiceLogLines(
 	serviceUuid service.ServiceUUID,
 	userServiceReadCloserLog io.ReadCloser,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	logLineRange *logline.LogLineRange,
 ) {
 	defer wgSenders.Done()
 
 	logsReader := bufio.NewReader(userServiceReadCloserLog)
 
+	// only used when the last N log lines were requested, these are sent all together once the end of the logs is reached
+	var lastLogLines []logline.LogLine
+
 	for {
 		select {
 		// client cancel ctx case
@@ -183,6 +209,7 @@ func streamServiceLogLines(
 			if err != nil && errors.Is(err, io.EOF) {
 				//exiting stream
 				logrus.Debugf("EOF error returned when reading logs for service '%v'", serviceUuid)
+				sendLogLines(logsByKurtosisUserServiceUuidChan, serviceUuid, lastLogLines)
 				return
 			}
 			if err != nil {
@@ -190,7 +217,8 @@ func streamServiceLogLines(
 				return
 			}
 
-			logLine := logline.NewLogLine(logLineStr)
+			// the backend logs don't carry the time they were logged at
+			logLine := logline.NewLogLine(logLineStr, time.Time{})
 
 			//filtering it
 			shouldReturnLogLine, err := logLine.IsValidLogLineBaseOnFilters(conjunctiveLogLinesFiltersWithRegex)
@@ -202,12 +230,30 @@ func streamServiceLogLines(
 				break
 			}
 
-			//send the log line
-			logLines := []logline.LogLine{*logLine}
-			userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
-				serviceUuid: logLines,
+			if logLineRange.ShouldReturnLastLogLinesOnly() {
+				lastLogLines = append(lastLogLines, *logLine)
+				if len(lastLogLines) > int(logLineRange.GetNumLogLines()) {
+					lastLogLines = lastLogLines[1:]
+				}
+				break
 			}
-			logsByKurtosisUserServiceUuidChan <- userServicesLogLinesMap
+
+			//send the log line
+			sendLogLines(logsByKurtosisUserServiceUuidChan, serviceUuid, []logline.LogLine{*logLine})
 		}
 	}
 }
+
+func sendLogLines(
+	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
+	serviceUuid service.ServiceUUID,
+	logLines []logline.LogLine,
+) {
+	if len(logLines) == 0 {
+		return
+	}
+	userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
+		serviceUuid: logLines,
+	}
+	logsByKurtosisUserServiceUuidChan <- userServicesLogLinesMap
+}
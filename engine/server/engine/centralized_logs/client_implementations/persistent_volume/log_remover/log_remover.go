@@ -1,44 +1,272 @@
 package log_remover
 
 import (
-	"fmt"
+	"bufio"
+	"encoding/json"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/stream_logs_strategy"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
+	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
-	"strconv"
+	"io"
+	"path/filepath"
+	"sort"
 	"time"
 )
 
 const (
 	oneWeek = 7 * 24 * time.Hour
+
+	// The logs aggregator keeps a logs file open until it wasn't written to for this long, which is the default
+	// idle_timeout_secs of the Vector file sinks
+	logsAggregatorFileIdleTimeout = 30 * time.Second
 )
 
-// LogRemover removes logs one week older than the log retention period.
+// LogRemover removes the logs older than the log retention period and, if a max logs storage size is set, the oldest
+// logs until the logs storage fits in it
 type LogRemover struct {
 	filesystem volume_filesystem.VolumeFilesystem
 
 	time logs_clock.LogsClock
+
+	logRetentionPeriod time.Duration
+
+	// zero means the logs storage size isn't limited
+	maxLogsStorageSizeInBytes uint64
 }
 
-func NewLogRemover(filesystem volume_filesystem.VolumeFilesystem, time logs_clock.LogsClock) *LogRemover {
+func NewLogRemover(
+	filesystem volume_filesystem.VolumeFilesystem,
+	time logs_clock.LogsClock,
+	logRetentionPeriod time.Duration,
+	maxLogsStorageSizeInBytes uint64,
+) *LogRemover {
 	return &LogRemover{
-		filesystem: filesystem,
-		time:       time,
+		filesystem:                filesystem,
+		time:                      time,
+		logRetentionPeriod:        logRetentionPeriod,
+		maxLogsStorageSizeInBytes: maxLogsStorageSizeInBytes,
 	}
 }
 
-// Run implements the Job cron interface. It removes logs a week older than the log retention period.
+// Run implements the Job cron interface. It removes the weeks of logs past the log retention period along with the
+// service logs that weren't written to within it, and then, if the logs storage is over its max size, the logs of the
+// least recently written services until it fits, the ones of past weeks first. Each service has its logs stored in
+// several files, which are always removed together.
 func (remover LogRemover) Run() {
-	// [LogRetentionPeriodInWeeks] weeks plus an extra week of logs are retained so remove logs a week past that, hence +1
-	numWeeksBack := volume_consts.LogRetentionPeriodInWeeks + 1
+	weekLogsDirs, err := stream_logs_strategy.GetPerWeekLogsDirs(remover.filesystem)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the per week logs directories, no logs will be removed: %v\n", err)
+		return
+	}
+
+	// the current week plus [retentionPeriodInWeeks] weeks of logs are retained so remove logs from before that
+	retentionPeriodInWeeks := volume_consts.GetLogRetentionPeriodInWeeks(remover.logRetentionPeriod)
+	now := remover.time.Now()
+	oldestRetainedWeekMoment := now.Add(time.Duration(-retentionPeriodInWeeks) * oneWeek)
+
+	var retainedWeekLogsDirs []stream_logs_strategy.PerWeekLogsDir
+	for _, weekLogsDir := range weekLogsDirs {
+		if !weekLogsDir.IsBeforeWeekOf(oldestRetainedWeekMoment) {
+			retainedWeekLogsDirs = append(retainedWeekLogsDirs, weekLogsDir)
+			continue
+		}
+		remover.removeLogs(weekLogsDir.GetPath())
+	}
+
+	allServiceLogs, err := remover.getServiceLogs(retainedWeekLogsDirs, now)
+	if err != nil {
+		logrus.Warnf("An error occurred getting the service logs files, only whole weeks of logs were removed: %v\n", err)
+		return
+	}
+
+	// the retained weeks can still hold logs past the retention period when it isn't a whole number of weeks, so the
+	// service logs only holding such logs are removed too
+	oldestRetainedLogMoment := now.Add(-remover.logRetentionPeriod)
+	var retainedServiceLogs []serviceLogs
+	for _, logs := range allServiceLogs {
+		if !logs.lastModificationTime.Before(oldestRetainedLogMoment) {
+			retainedServiceLogs = append(retainedServiceLogs, logs)
+			continue
+		}
+		remover.removeServiceLogs(logs)
+	}
+
+	if remover.maxLogsStorageSizeInBytes == volume_consts.NoLogStorageSizeLimit {
+		return
+	}
+	remover.removeOldestServiceLogsUntilUnderMaxSize(retainedServiceLogs, now)
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+
+type serviceLogsFile struct {
+	path string
+
+	sizeInBytes uint64
+}
+
+// serviceLogs holds the logs of a service for a week, which the logs aggregator stores in one file per service
+// identifier (UUID, name and short UUID)
+type serviceLogs struct {
+	files []serviceLogsFile
+
+	sizeInBytes uint64
+
+	lastModificationTime time.Time
+
+	// true when the week of the logs is over, so the logs aggregator won't write them anymore
+	isWeekOver bool
+}
+
+// getServiceLogs returns the service logs of the week logs directories, sorted from the ones of past weeks to the ones of
+// the current week and then from the least to the most recently written to
+func (remover LogRemover) getServiceLogs(weekLogsDirs []stream_logs_strategy.PerWeekLogsDir, now time.Time) ([]serviceLogs, error) {
+	var allServiceLogs []serviceLogs
+	for _, weekLogsDir := range weekLogsDirs {
+		weekDirEntries, err := remover.filesystem.ReadDir(weekLogsDir.GetPath())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading directory '%v'", weekLogsDir.GetPath())
+		}
+		isWeekOver := weekLogsDir.IsBeforeWeekOf(now)
+		for _, weekDirEntry := range weekDirEntries {
+			if !weekDirEntry.IsDir() {
+				continue
+			}
+			enclaveLogsDirPath := weekLogsDir.GetPath() + weekDirEntry.Name()
+			enclaveDirEntries, err := remover.filesystem.ReadDir(enclaveLogsDirPath)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred reading directory '%v'", enclaveLogsDirPath)
+			}
+
+			var serviceKeys []string
+			serviceLogsByServiceKey := map[string]*serviceLogs{}
+			for _, enclaveDirEntry := range enclaveDirEntries {
+				if enclaveDirEntry.IsDir() {
+					continue
+				}
+				logsFilePath := filepath.Join(enclaveLogsDirPath, enclaveDirEntry.Name())
+				logsFileInfo, err := enclaveDirEntry.Info()
+				if err != nil {
+					return nil, stacktrace.Propagate(err, "An error occurred getting the info of file '%v'", logsFilePath)
+				}
+
+				// the files of a service can't be told apart by their names, but every log line carries the service UUID
+				serviceKey := logsFilePath
+				if serviceUuid, found := remover.getServiceUuid(logsFilePath); found {
+					serviceKey = serviceUuid
+				}
+				logs, found := serviceLogsByServiceKey[serviceKey]
+				if !found {
+					logs = &serviceLogs{
+						files:                nil,
+						sizeInBytes:          0,
+						lastModificationTime: time.Time{},
+						isWeekOver:           isWeekOver,
+					}
+					serviceLogsByServiceKey[serviceKey] = logs
+					serviceKeys = append(serviceKeys, serviceKey)
+				}
+				logs.files = append(logs.files, serviceLogsFile{
+					path:        logsFilePath,
+					sizeInBytes: uint64(logsFileInfo.Size()),
+				})
+				logs.sizeInBytes += uint64(logsFileInfo.Size())
+				if logsFileInfo.ModTime().After(logs.lastModificationTime) {
+					logs.lastModificationTime = logsFileInfo.ModTime()
+				}
+			}
+			for _, serviceKey := range serviceKeys {
+				allServiceLogs = append(allServiceLogs, *serviceLogsByServiceKey[serviceKey])
+			}
+		}
+	}
+	// the logs are listed from the oldest week to the most recent one, which breaks the ties
+	sort.SliceStable(allServiceLogs, func(i, j int) bool {
+		if allServiceLogs[i].isWeekOver != allServiceLogs[j].isWeekOver {
+			return allServiceLogs[i].isWeekOver
+		}
+		return allServiceLogs[i].lastModificationTime.Before(allServiceLogs[j].lastModificationTime)
+	})
+	return allServiceLogs, nil
+}
+
+// getServiceUuid returns the UUID of the service the logs file at [path] holds the logs of, read from its first log line
+func (remover LogRemover) getServiceUuid(path string) (string, bool) {
+	logsFile, err := remover.filesystem.Open(path)
+	if err != nil {
+		logrus.Debugf("An error occurred opening logs file '%v' to get its service UUID: %v", path, err)
+		return "", false
+	}
+	// the removal runs periodically, so the files it opens must not pile up
+	if logsFileCloser, ok := logsFile.(io.Closer); ok {
+		defer logsFileCloser.Close()
+	}
+
+	firstLogLine, err := bufio.NewReader(logsFile).ReadBytes(volume_consts.NewLineRune)
+	if err != nil && len(firstLogLine) == 0 {
+		return "", false
+	}
+	var jsonLog stream_logs_strategy.JsonLog
+	if err := json.Unmarshal(firstLogLine, &jsonLog); err != nil {
+		return "", false
+	}
+	serviceUuid, found := jsonLog[volume_consts.ServiceUuidLabel]
+	if !found || serviceUuid == "" {
+		return "", false
+	}
+	return serviceUuid, true
+}
 
-	// compute the next oldest week
-	year, weekToRemove := remover.time.Now().Add(time.Duration(-numWeeksBack) * oneWeek).ISOWeek()
+// removeOldestServiceLogsUntilUnderMaxSize never removes the logs written to within the idle timeout of the logs
+// aggregator, as it may still have their files open and would keep writing to the removed files
+func (remover LogRemover) removeOldestServiceLogsUntilUnderMaxSize(allServiceLogs []serviceLogs, now time.Time) {
+	var logsStorageSizeInBytes uint64
+	for _, logs := range allServiceLogs {
+		logsStorageSizeInBytes += logs.sizeInBytes
+	}
+
+	oldestOpenLogsModificationTime := now.Add(-logsAggregatorFileIdleTimeout)
+	for _, logs := range allServiceLogs {
+		if logsStorageSizeInBytes <= remover.maxLogsStorageSizeInBytes {
+			return
+		}
+		if !logs.isWeekOver && logs.lastModificationTime.After(oldestOpenLogsModificationTime) {
+			continue
+		}
+		logsStorageSizeInBytes -= remover.removeServiceLogs(logs)
+	}
+	if logsStorageSizeInBytes > remover.maxLogsStorageSizeInBytes {
+		logrus.Warnf(
+			"The logs storage takes '%v' bytes, which is over the max logs storage size of '%v' bytes, even though all the logs that could be removed were; consider raising the max size",
+			logsStorageSizeInBytes,
+			remover.maxLogsStorageSizeInBytes,
+		)
+	}
+}
+
+// Returns the size of the service logs files that were removed
+func (remover LogRemover) removeServiceLogs(logs serviceLogs) uint64 {
+	var removedSizeInBytes uint64
+	for _, logsFile := range logs.files {
+		if remover.removeLogs(logsFile.path) {
+			removedSizeInBytes += logsFile.sizeInBytes
+		}
+	}
+	return removedSizeInBytes
+}
 
-	// remove directory for that week
-	oldLogsDirPath := fmt.Sprintf(volume_consts.PerWeekDirPathStr, volume_consts.LogsStorageDirpath, strconv.Itoa(year), strconv.Itoa(weekToRemove))
-	if err := remover.filesystem.RemoveAll(oldLogsDirPath); err != nil {
-		logrus.Warnf("An error occurred removing old logs at the following path '%v': %v\n", oldLogsDirPath, err)
+// Returns true if the logs at [path], either a directory or a file, were removed
+func (remover LogRemover) removeLogs(path string) bool {
+	if err := remover.filesystem.RemoveAll(path); err != nil {
+		logrus.Warnf("An error occurred removing old logs at the following path '%v': %v\n", path, err)
+		return false
 	}
+	logrus.Debugf("Removed old logs at the following path '%v'", path)
+	return true
 }
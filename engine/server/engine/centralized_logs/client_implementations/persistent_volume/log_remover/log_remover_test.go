@@ -9,11 +9,18 @@ import (
 	"strconv"
 	"testing"
 	"testing/fstest"
+	"time"
 )
 
 const (
 	testEnclaveUuid      = "test-enclave"
 	testUserService1Uuid = "test-user-service-1"
+	testUserService2Uuid = "test-user-service-2"
+
+	testUserService1Name      = "service-one"
+	testUserService1ShortUuid = "test-user-1"
+	testUserService2Name      = "service-two"
+	testUserService2ShortUuid = "test-user-2"
 
 	defaultDay = 0
 )
@@ -28,22 +35,28 @@ func TestLogRemover_Run(t *testing.T) {
 
 	mapFs := &fstest.MapFS{
 		week49filepath: {
-			Data: []byte{},
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2022, 49),
 		},
 		week50filepath: {
-			Data: []byte{},
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2022, 50),
 		},
 		week51filepath: {
-			Data: []byte{},
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2022, 51),
 		},
 		week52filepath: {
-			Data: []byte{},
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2022, 52),
 		},
 		week1filepath: {
-			Data: []byte{},
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2023, 1),
 		},
 		week2filepath: {
-			Data: []byte{},
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2023, 2),
 		},
 	}
 
@@ -51,7 +64,7 @@ func TestLogRemover_Run(t *testing.T) {
 	currentWeek := 2
 
 	mockTime := logs_clock.NewMockLogsClock(2023, currentWeek, defaultDay)
-	logRemover := NewLogRemover(mockFs, mockTime)
+	logRemover := NewLogRemover(mockFs, mockTime, volume_consts.DefaultLogRetentionPeriod, volume_consts.NoLogStorageSizeLimit)
 
 	// log remover should remove week 49 logs
 	logRemover.Run()
@@ -60,6 +73,278 @@ func TestLogRemover_Run(t *testing.T) {
 	require.Error(t, err) // check the file doesn't exist
 }
 
+func TestLogRemover_RunRemovesAllWeeksPastRetentionPeriod(t *testing.T) {
+	week47filepath := getWeekFilepathStr(2022, 47)
+	week48filepath := getWeekFilepathStr(2022, 48)
+	week52filepath := getWeekFilepathStr(2022, 52)
+	week1filepath := getWeekFilepathStr(2023, 1)
+	week2filepath := getWeekFilepathStr(2023, 2)
+
+	mapFs := &fstest.MapFS{
+		week47filepath: {
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2022, 47),
+		},
+		week48filepath: {
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2022, 48),
+		},
+		week52filepath: {
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2022, 52),
+		},
+		week1filepath: {
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2023, 1),
+		},
+		week2filepath: {
+			Data:    []byte{},
+			ModTime: getEndOfWeek(2023, 2),
+		},
+	}
+
+	mockFs := volume_filesystem.NewMockedVolumeFilesystem(mapFs)
+	currentWeek := 2
+	retentionPeriod := 2 * oneWeek
+
+	mockTime := logs_clock.NewMockLogsClock(2023, currentWeek, defaultDay)
+	logRemover := NewLogRemover(mockFs, mockTime, retentionPeriod, volume_consts.NoLogStorageSizeLimit)
+
+	// log remover should remove every week before week 52
+	logRemover.Run()
+
+	for _, removedFilepath := range []string{week47filepath, week48filepath} {
+		_, err := mockFs.Stat(removedFilepath)
+		require.Error(t, err)
+	}
+	for _, retainedFilepath := range []string{week52filepath, week1filepath, week2filepath} {
+		_, err := mockFs.Stat(retainedFilepath)
+		require.NoError(t, err)
+	}
+}
+
+func TestLogRemover_RunRemovesOldestWeeksUntilUnderMaxSize(t *testing.T) {
+	week52filepath := getWeekFilepathStr(2022, 52)
+	week1filepath := getWeekFilepathStr(2023, 1)
+	week2filepath := getWeekFilepathStr(2023, 2)
+
+	mapFs := &fstest.MapFS{
+		week52filepath: {
+			Data:    make([]byte, 100),
+			ModTime: getEndOfWeek(2022, 52),
+		},
+		week1filepath: {
+			Data:    make([]byte, 100),
+			ModTime: getEndOfWeek(2023, 1),
+		},
+		week2filepath: {
+			Data:    make([]byte, 100),
+			ModTime: getEndOfWeek(2023, 2),
+		},
+	}
+
+	mockFs := volume_filesystem.NewMockedVolumeFilesystem(mapFs)
+	currentWeek := 2
+	maxSizeInBytes := uint64(250)
+
+	mockTime := logs_clock.NewMockLogsClock(2023, currentWeek, defaultDay)
+	logRemover := NewLogRemover(mockFs, mockTime, volume_consts.DefaultLogRetentionPeriod, maxSizeInBytes)
+
+	// log remover should only remove week 52 logs, which is enough to fit in the max size
+	logRemover.Run()
+
+	_, err := mockFs.Stat(week52filepath)
+	require.Error(t, err)
+	for _, retainedFilepath := range []string{week1filepath, week2filepath} {
+		_, err = mockFs.Stat(retainedFilepath)
+		require.NoError(t, err)
+	}
+}
+
+func TestLogRemover_RunRemovesCurrentWeekLogsFilesToFitMaxSize(t *testing.T) {
+	// the mocked clock is on wednesday of the third ISO week of 2023
+	mockTime := logs_clock.NewMockLogsClock(2023, 2, 3)
+	now := mockTime.Now()
+
+	week2filepath := getWeekFilepathStr(2023, 2)
+	week3Service1Filepath := getWeekFilepathStr(2023, 3)
+	week3Service2Filepath := getServiceWeekFilepathStr(2023, 3, testUserService2Uuid)
+
+	mapFs := &fstest.MapFS{
+		week2filepath: {
+			Data:    make([]byte, 100),
+			ModTime: now.Add(-5 * 24 * time.Hour),
+		},
+		week3Service1Filepath: {
+			Data:    make([]byte, 100),
+			ModTime: now.Add(-2 * time.Hour),
+		},
+		week3Service2Filepath: {
+			Data:    make([]byte, 100),
+			ModTime: now.Add(-time.Hour),
+		},
+	}
+
+	mockFs := volume_filesystem.NewMockedVolumeFilesystem(mapFs)
+	maxSizeInBytes := uint64(150)
+
+	logRemover := NewLogRemover(mockFs, mockTime, volume_consts.DefaultLogRetentionPeriod, maxSizeInBytes)
+
+	// log remover should remove the logs of the previous week and then the least recently written logs of the current week
+	logRemover.Run()
+
+	for _, removedFilepath := range []string{week2filepath, week3Service1Filepath} {
+		_, err := mockFs.Stat(removedFilepath)
+		require.Error(t, err)
+	}
+	_, err := mockFs.Stat(week3Service2Filepath)
+	require.NoError(t, err)
+}
+
+func TestLogRemover_RunRemovesLogsFilesPastSubWeekRetentionPeriod(t *testing.T) {
+	// the mocked clock is on wednesday of the third ISO week of 2023
+	mockTime := logs_clock.NewMockLogsClock(2023, 2, 3)
+	now := mockTime.Now()
+
+	week2Service1Filepath := getWeekFilepathStr(2023, 2)
+	week2Service2Filepath := getServiceWeekFilepathStr(2023, 2, testUserService2Uuid)
+	week3filepath := getWeekFilepathStr(2023, 3)
+
+	mapFs := &fstest.MapFS{
+		week2Service1Filepath: {
+			Data:    []byte{},
+			ModTime: now.Add(-5 * 24 * time.Hour),
+		},
+		week2Service2Filepath: {
+			Data:    []byte{},
+			ModTime: now.Add(-60 * time.Hour),
+		},
+		week3filepath: {
+			Data:    []byte{},
+			ModTime: now.Add(-time.Hour),
+		},
+	}
+
+	mockFs := volume_filesystem.NewMockedVolumeFilesystem(mapFs)
+	retentionPeriod := 3 * 24 * time.Hour
+
+	logRemover := NewLogRemover(mockFs, mockTime, retentionPeriod, volume_consts.NoLogStorageSizeLimit)
+
+	// log remover should only keep the logs files written to within the last three days, even in the previous week
+	logRemover.Run()
+
+	_, err := mockFs.Stat(week2Service1Filepath)
+	require.Error(t, err)
+	for _, retainedFilepath := range []string{week2Service2Filepath, week3filepath} {
+		_, err = mockFs.Stat(retainedFilepath)
+		require.NoError(t, err)
+	}
+}
+
+func TestLogRemover_RunRemovesAllLogsFilesOfAServiceTogether(t *testing.T) {
+	// the mocked clock is on wednesday of the third ISO week of 2023
+	mockTime := logs_clock.NewMockLogsClock(2023, 2, 3)
+	now := mockTime.Now()
+
+	// the logs aggregator stores the logs of a service in one file per service identifier
+	service1Filepaths := []string{
+		getServiceWeekFilepathStr(2023, 3, testUserService1Uuid),
+		getServiceWeekFilepathStr(2023, 3, testUserService1Name),
+		getServiceWeekFilepathStr(2023, 3, testUserService1ShortUuid),
+	}
+	service2Filepaths := []string{
+		getServiceWeekFilepathStr(2023, 3, testUserService2Uuid),
+		getServiceWeekFilepathStr(2023, 3, testUserService2Name),
+		getServiceWeekFilepathStr(2023, 3, testUserService2ShortUuid),
+	}
+
+	mapFs := fstest.MapFS{}
+	for _, service1Filepath := range service1Filepaths {
+		mapFs[service1Filepath] = &fstest.MapFile{
+			Data:    getTestLogLine(testUserService1Uuid),
+			ModTime: now.Add(-2 * time.Hour),
+		}
+	}
+	// the files of a service aren't all written at the exact same moment
+	for fileIdx, service2Filepath := range service2Filepaths {
+		mapFs[service2Filepath] = &fstest.MapFile{
+			Data:    getTestLogLine(testUserService2Uuid),
+			ModTime: now.Add(-time.Hour + time.Duration(fileIdx)*time.Second),
+		}
+	}
+
+	mockFs := volume_filesystem.NewMockedVolumeFilesystem(&mapFs)
+	logsFileSizeInBytes := uint64(len(getTestLogLine(testUserService1Uuid)))
+	maxSizeInBytes := 4 * logsFileSizeInBytes
+
+	logRemover := NewLogRemover(mockFs, mockTime, volume_consts.DefaultLogRetentionPeriod, maxSizeInBytes)
+
+	// log remover should remove the three files of the least recently written service, and none of the other service
+	logRemover.Run()
+
+	for _, removedFilepath := range service1Filepaths {
+		_, err := mockFs.Stat(removedFilepath)
+		require.Error(t, err)
+	}
+	for _, retainedFilepath := range service2Filepaths {
+		_, err := mockFs.Stat(retainedFilepath)
+		require.NoError(t, err)
+	}
+}
+
+func TestLogRemover_RunKeepsCurrentWeekLogsFilesTheLogsAggregatorMayStillHaveOpen(t *testing.T) {
+	// the mocked clock is on wednesday of the third ISO week of 2023
+	mockTime := logs_clock.NewMockLogsClock(2023, 2, 3)
+	now := mockTime.Now()
+
+	week2filepath := getWeekFilepathStr(2023, 2)
+	week3Service1Filepath := getWeekFilepathStr(2023, 3)
+	week3Service2Filepath := getServiceWeekFilepathStr(2023, 3, testUserService2Uuid)
+
+	mapFs := &fstest.MapFS{
+		week2filepath: {
+			Data:    make([]byte, 100),
+			ModTime: now.Add(-5 * 24 * time.Hour),
+		},
+		week3Service1Filepath: {
+			Data:    make([]byte, 100),
+			ModTime: now.Add(-10 * time.Second),
+		},
+		week3Service2Filepath: {
+			Data:    make([]byte, 100),
+			ModTime: now.Add(-time.Second),
+		},
+	}
+
+	mockFs := volume_filesystem.NewMockedVolumeFilesystem(mapFs)
+	maxSizeInBytes := uint64(150)
+
+	logRemover := NewLogRemover(mockFs, mockTime, volume_consts.DefaultLogRetentionPeriod, maxSizeInBytes)
+
+	// log remover should only remove the logs of the previous week, even though the logs storage stays over its max size
+	logRemover.Run()
+
+	_, err := mockFs.Stat(week2filepath)
+	require.Error(t, err)
+	for _, retainedFilepath := range []string{week3Service1Filepath, week3Service2Filepath} {
+		_, err = mockFs.Stat(retainedFilepath)
+		require.NoError(t, err)
+	}
+}
+
 func getWeekFilepathStr(year, week int) string {
-	return fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpathForTests, strconv.Itoa(year), strconv.Itoa(week), testEnclaveUuid, testUserService1Uuid, volume_consts.Filetype)
+	return getServiceWeekFilepathStr(year, week, testUserService1Uuid)
+}
+
+func getServiceWeekFilepathStr(year, week int, serviceUuid string) string {
+	return fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpathForTests, strconv.Itoa(year), strconv.Itoa(week), testEnclaveUuid, serviceUuid, volume_consts.Filetype)
+}
+
+// The logs file of a week is last written to at the end of it
+func getEndOfWeek(year, week int) time.Time {
+	return logs_clock.NewMockLogsClock(year, week+1, defaultDay).Now().Add(-time.Second)
+}
+
+func getTestLogLine(serviceUuid string) []byte {
+	return []byte(fmt.Sprintf(`{"log":"Starting feature 'runs idempotently'","service_uuid":"%v","timestamp":"2023-01-18T12:00:00.000Z"}`+"\n", serviceUuid))
 }
@@ -42,6 +42,7 @@ func (client *persistentVolumeLogsDatabaseClient) StreamUserServiceLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	userServiceUuids map[service.ServiceUUID]bool,
 	conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+	logLineRange *logline.LogLineRange,
 	shouldFollowLogs bool,
 ) (
 	chan map[service.ServiceUUID][]logline.LogLine,
@@ -74,6 +75,7 @@ func (client *persistentVolumeLogsDatabaseClient) StreamUserServiceLogs(
 			enclaveUuid,
 			serviceUuid,
 			conjunctiveLogFiltersWithRegex,
+			logLineRange,
 			shouldFollowLogs,
 		)
 	}
@@ -118,6 +120,26 @@ func (client *persistentVolumeLogsDatabaseClient) FilterExistingServiceUuids(
 	return filteredServiceUuidsSet, nil
 }
 
+func (client *persistentVolumeLogsDatabaseClient) RemoveEnclaveLogs(
+	ctx context.Context,
+	enclaveUuid enclave.EnclaveUUID,
+) error {
+	if err := client.streamStrategy.RemoveEnclaveLogs(client.filesystem, enclaveUuid); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the logs of enclave '%v'", enclaveUuid)
+	}
+	return nil
+}
+
+func (client *persistentVolumeLogsDatabaseClient) GetLogsSizeInBytesByEnclaveUuid(
+	ctx context.Context,
+) (map[enclave.EnclaveUUID]uint64, error) {
+	logsSizeInBytesByEnclaveUuid, err := client.streamStrategy.GetLogsSizeInBytesByEnclaveUuid(client.filesystem)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the size of the logs stored for each enclave")
+	}
+	return logsSizeInBytesByEnclaveUuid, nil
+}
+
 // ====================================================================================================
 //
 //	Private helper functions
@@ -131,6 +153,7 @@ func (client *persistentVolumeLogsDatabaseClient) streamServiceLogLines(
 	enclaveUuid enclave.EnclaveUUID,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	logLineRange *logline.LogLineRange,
 	shouldFollowLogs bool,
 ) {
 	defer wgSenders.Done()
@@ -142,5 +165,6 @@ func (client *persistentVolumeLogsDatabaseClient) streamServiceLogLines(
 		enclaveUuid,
 		serviceUuid,
 		conjunctiveLogLinesFiltersWithRegex,
+		logLineRange,
 		shouldFollowLogs)
 }
@@ -81,6 +81,7 @@ func TestStreamUserServiceLogs_WithFilters(t *testing.T) {
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		logLinesFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -125,11 +126,12 @@ func TestStreamUserServiceLogsPerWeek_WithFilters(t *testing.T) {
 
 	underlyingFs := createFilledPerWeekFilesystem(startingWeek)
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, startingWeek, defaultDay)
-	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
 
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		logLinesFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -172,6 +174,7 @@ func TestStreamUserServiceLogs_NoLogsFromPersistentVolume(t *testing.T) {
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		logLinesFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -209,11 +212,12 @@ func TestStreamUserServiceLogsPerWeek_NoLogsFromPersistentVolume(t *testing.T) {
 
 	underlyingFs := createEmptyPerWeekFilesystem(startingWeek)
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, startingWeek, defaultDay)
-	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
 
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		logLinesFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -264,6 +268,7 @@ func TestStreamUserServiceLogs_ThousandsOfLogLinesSuccessfulExecution(t *testing
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		emptyFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -311,11 +316,12 @@ func TestStreamUserServiceLogsPerWeek_ThousandsOfLogLinesSuccessfulExecution(t *
 		},
 	}
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, startingWeek, defaultDay)
-	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
 
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		emptyFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -359,6 +365,7 @@ func TestStreamUserServiceLogs_EmptyLogLines(t *testing.T) {
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		emptyFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -397,11 +404,12 @@ func TestStreamUserServiceLogsPerWeek_EmptyLogLines(t *testing.T) {
 		},
 	}
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, startingWeek, defaultDay)
-	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
 
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		emptyFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -460,11 +468,12 @@ func TestStreamUserServiceLogsPerWeek_WithLogsAcrossWeeks(t *testing.T) {
 		},
 	}
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, 4, defaultDay)
-	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
 
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		logLinesFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -524,11 +533,12 @@ func TestStreamUserServiceLogsPerWeek_WithLogLineAcrossWeeks(t *testing.T) {
 		},
 	}
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, 4, defaultDay)
-	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
 
 	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
 		t,
 		logLinesFilters,
+		logline.NewAllLogLinesRange(),
 		userServiceUuids,
 		expectedServiceAmountLogLinesByServiceUuid,
 		doNotFollowLogs,
@@ -546,6 +556,185 @@ func TestStreamUserServiceLogsPerWeek_WithLogLineAcrossWeeks(t *testing.T) {
 	require.NoError(t, testEvaluationErr)
 }
 
+func TestStreamUserServiceLogsPerWeek_WithTimeRange(t *testing.T) {
+	expectedAmountLogLines := 3
+
+	expectedServiceAmountLogLinesByServiceUuid := map[service.ServiceUUID]int{
+		testUserService1Uuid: expectedAmountLogLines,
+	}
+
+	logLinesFilters := []logline.LogLineFilter{}
+
+	userServiceUuids := map[service.ServiceUUID]bool{
+		testUserService1Uuid: true,
+	}
+
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, startingWeek, defaultDay)
+	firstLogLineTime := mockTime.Now().Add(-time.Hour)
+
+	var logLines []string
+	for i := 0; i < 6; i++ {
+		logLineTime := firstLogLineTime.Add(time.Duration(i) * time.Minute)
+		logLines = append(logLines, fmt.Sprintf("{\"log\":\"Log line number %v\",\"timestamp\":\"%v\"}", i, logLineTime.Format(time.RFC3339)))
+	}
+	logLinesStr := strings.Join(logLines, "\n") + "\n"
+
+	// returns log lines 1 to 3, as the upper bound is exclusive
+	since := firstLogLineTime.Add(time.Minute)
+	until := firstLogLineTime.Add(4 * time.Minute)
+	logLineRange := logline.NewLogLineRange(&since, &until, 0)
+
+	expectedFirstLogLine := "Log line number 1"
+
+	weekFilepath := fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpathForTests, strconv.Itoa(defaultYear), strconv.Itoa(startingWeek), testEnclaveUuid, testUserService1Uuid, volume_consts.Filetype)
+	underlyingFs := &fstest.MapFS{
+		weekFilepath: {
+			Data: []byte(logLinesStr),
+		},
+	}
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+
+	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
+		t,
+		logLinesFilters,
+		logLineRange,
+		userServiceUuids,
+		expectedServiceAmountLogLinesByServiceUuid,
+		doNotFollowLogs,
+		underlyingFs,
+		perWeekStreamStrategy,
+	)
+	require.NoError(t, testEvaluationErr)
+
+	for serviceUuid, serviceLogLines := range receivedUserServiceLogsByUuid {
+		expectedAmountLogLines, found := expectedServiceAmountLogLinesByServiceUuid[serviceUuid]
+		require.True(t, found)
+		require.Equal(t, expectedAmountLogLines, len(serviceLogLines))
+		require.Equal(t, expectedFirstLogLine, serviceLogLines[0].GetContent())
+		require.True(t, since.Equal(serviceLogLines[0].GetTimestamp()))
+	}
+}
+
+func TestStreamUserServiceLogsPerWeek_LastLogLines(t *testing.T) {
+	expectedServiceAmountLogLinesByServiceUuid := map[service.ServiceUUID]int{
+		testUserService1Uuid: 2,
+		testUserService2Uuid: 2,
+		testUserService3Uuid: 2,
+	}
+
+	logLinesFilters := []logline.LogLineFilter{}
+	logLineRange := logline.NewLogLineRange(nil, nil, 2)
+
+	expectedFirstLogLine := "User service started"
+
+	userServiceUuids := map[service.ServiceUUID]bool{
+		testUserService1Uuid: true,
+		testUserService2Uuid: true,
+		testUserService3Uuid: true,
+	}
+
+	underlyingFs := createFilledPerWeekFilesystem(startingWeek)
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, startingWeek, defaultDay)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+
+	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
+		t,
+		logLinesFilters,
+		logLineRange,
+		userServiceUuids,
+		expectedServiceAmountLogLinesByServiceUuid,
+		doNotFollowLogs,
+		underlyingFs,
+		perWeekStreamStrategy,
+	)
+	require.NoError(t, testEvaluationErr)
+
+	for serviceUuid, serviceLogLines := range receivedUserServiceLogsByUuid {
+		expectedAmountLogLines, found := expectedServiceAmountLogLinesByServiceUuid[serviceUuid]
+		require.True(t, found)
+		require.Equal(t, expectedAmountLogLines, len(serviceLogLines))
+		require.Equal(t, expectedFirstLogLine, serviceLogLines[0].GetContent())
+	}
+}
+
+func TestStreamUserServiceLogsPerWeek_LastLogLinesWithFilters(t *testing.T) {
+	expectedServiceAmountLogLinesByServiceUuid := map[service.ServiceUUID]int{
+		testUserService1Uuid: 1,
+	}
+
+	firstTextFilter := logline.NewDoesContainTextLogLineFilter(firstFilterText)
+	logLinesFilters := []logline.LogLineFilter{
+		*firstTextFilter,
+	}
+	logLineRange := logline.NewLogLineRange(nil, nil, 1)
+
+	expectedFirstLogLine := "Starting feature 'files manager'"
+
+	userServiceUuids := map[service.ServiceUUID]bool{
+		testUserService1Uuid: true,
+	}
+
+	underlyingFs := createFilledPerWeekFilesystem(startingWeek)
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, startingWeek, defaultDay)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+
+	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
+		t,
+		logLinesFilters,
+		logLineRange,
+		userServiceUuids,
+		expectedServiceAmountLogLinesByServiceUuid,
+		doNotFollowLogs,
+		underlyingFs,
+		perWeekStreamStrategy,
+	)
+	require.NoError(t, testEvaluationErr)
+
+	for serviceUuid, serviceLogLines := range receivedUserServiceLogsByUuid {
+		expectedAmountLogLines, found := expectedServiceAmountLogLinesByServiceUuid[serviceUuid]
+		require.True(t, found)
+		require.Equal(t, expectedAmountLogLines, len(serviceLogLines))
+		require.Equal(t, expectedFirstLogLine, serviceLogLines[0].GetContent())
+	}
+}
+
+func TestStreamUserServiceLogs_LastLogLines(t *testing.T) {
+	expectedServiceAmountLogLinesByServiceUuid := map[service.ServiceUUID]int{
+		testUserService1Uuid: 3,
+	}
+
+	logLinesFilters := []logline.LogLineFilter{}
+	logLineRange := logline.NewLogLineRange(nil, nil, 3)
+
+	expectedFirstLogLine := "Starting feature 'files manager'"
+
+	userServiceUuids := map[service.ServiceUUID]bool{
+		testUserService1Uuid: true,
+	}
+
+	underlyingFs := createFilledPerFileFilesystem()
+	perFileStreamStrategy := stream_logs_strategy.NewPerFileStreamLogsStrategy()
+
+	receivedUserServiceLogsByUuid, testEvaluationErr := executeStreamCallAndGetReceivedServiceLogLines(
+		t,
+		logLinesFilters,
+		logLineRange,
+		userServiceUuids,
+		expectedServiceAmountLogLinesByServiceUuid,
+		doNotFollowLogs,
+		underlyingFs,
+		perFileStreamStrategy,
+	)
+	require.NoError(t, testEvaluationErr)
+
+	for serviceUuid, serviceLogLines := range receivedUserServiceLogsByUuid {
+		expectedAmountLogLines, found := expectedServiceAmountLogLinesByServiceUuid[serviceUuid]
+		require.True(t, found)
+		require.Equal(t, expectedAmountLogLines, len(serviceLogLines))
+		require.Equal(t, expectedFirstLogLine, serviceLogLines[0].GetContent())
+	}
+}
+
 // ====================================================================================================
 //
 //	Private helper functions
@@ -554,6 +743,7 @@ func TestStreamUserServiceLogsPerWeek_WithLogLineAcrossWeeks(t *testing.T) {
 func executeStreamCallAndGetReceivedServiceLogLines(
 	t *testing.T,
 	logLinesFilters []logline.LogLineFilter,
+	logLineRange *logline.LogLineRange,
 	userServiceUuids map[service.ServiceUUID]bool,
 	expectedServiceAmountLogLinesByServiceUuid map[service.ServiceUUID]int,
 	shouldFollowLogs bool,
@@ -573,9 +763,9 @@ func executeStreamCallAndGetReceivedServiceLogLines(
 	mockedFs := volume_filesystem.NewMockedVolumeFilesystem(underlyingFs)
 	logsDatabaseClient := NewPersistentVolumeLogsDatabaseClient(kurtosisBackend, mockedFs, streamStrategy)
 
-	userServiceLogsByUuidChan, errChan, receivedCancelCtxFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, logLinesFilters, shouldFollowLogs)
+	userServiceLogsByUuidChan, errChan, receivedCancelCtxFunc, err := logsDatabaseClient.StreamUserServiceLogs(ctx, enclaveUuid, userServiceUuids, logLinesFilters, logLineRange, shouldFollowLogs)
 	if err != nil {
-		return nil, stacktrace.Propagate(err, "An error occurred getting user service logs for UUIDs '%+v' using log line filters '%v' in enclave '%v'", userServiceUuids, logLinesFilters, enclaveUuid)
+		return nil, stacktrace.Propagate(err, "An error occurred getting user service logs for UUIDs '%+v' using log line filters '%v' and range '%+v' in enclave '%v'", userServiceUuids, logLinesFilters, logLineRange, enclaveUuid)
 	}
 	defer func() {
 		if receivedCancelCtxFunc != nil {
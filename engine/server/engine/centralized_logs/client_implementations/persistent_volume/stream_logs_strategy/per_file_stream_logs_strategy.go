@@ -14,9 +14,15 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"io"
+	"os"
 	"strings"
 )
 
+const (
+	// basepath/enclave uuid/
+	perFileEnclaveDirPathFmtStr = "%s%s/"
+)
+
 // This strategy pulls logs from filesytsem where there is a log file per enclave, per service
 type PerFileStreamLogsStrategy struct {
 }
@@ -35,6 +41,7 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	logLineRange *logline.LogLineRange,
 	shouldFollowLogs bool,
 ) {
 	// logs are stored per enclave id, per service uuid, eg. <base path>/123440231421/54325342w2341.json
@@ -46,6 +53,10 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 	}
 	logsReader := bufio.NewReader(logsFile)
 
+	// only used when the last N log lines were requested, these are sent all together once all the stored logs have been read
+	var lastLogLines []logline.LogLine
+	hasReadAllStoredLogs := false
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -69,6 +80,10 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 					}
 				}
 				if readErr != nil && errors.Is(readErr, io.EOF) {
+					if !hasReadAllStoredLogs {
+						hasReadAllStoredLogs = true
+						sendLogLines(logsByKurtosisUserServiceUuidChan, serviceUuid, lastLogLines)
+					}
 					if shouldFollowLogs {
 						continue
 					}
@@ -101,7 +116,17 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 				streamErrChan <- stacktrace.NewError("An error retrieving the log field from logs json file. This is a bug in Kurtosis.")
 				return
 			}
-			logLine := logline.NewLogLine(logLineStr)
+			timestamp, err := getTimestamp(jsonLog)
+			if err != nil {
+				streamErrChan <- stacktrace.Propagate(err, "An error occurred retrieving the timestamp from logs json file for service '%v' in enclave '%v' at the following path: %v.", serviceUuid, enclaveUuid, logsFilepath)
+				return
+			}
+			logLine := logline.NewLogLine(logLineStr, timestamp)
+
+			// Then we leave it out if it was not logged within the requested time range
+			if !logLineRange.IsWithinTimeRange(*logLine) {
+				break
+			}
 
 			// Then we filter by checking if the log message is valid based on requested filters
 			shouldReturnLogLine, err := logLine.IsValidLogLineBaseOnFilters(conjunctiveLogLinesFiltersWithRegex)
@@ -113,12 +138,59 @@ func (strategy *PerFileStreamLogsStrategy) StreamLogs(
 				break
 			}
 
-			// send the log line
-			logLines := []logline.LogLine{*logLine}
-			userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
-				serviceUuid: logLines,
+			// keep the log line until all the stored logs have been read if only the last ones were requested, otherwise send it
+			if logLineRange.ShouldReturnLastLogLinesOnly() && !hasReadAllStoredLogs {
+				lastLogLines = appendToLastLogLines(lastLogLines, *logLine, logLineRange.GetNumLogLines())
+				break
 			}
-			logsByKurtosisUserServiceUuidChan <- userServicesLogLinesMap
+			sendLogLines(logsByKurtosisUserServiceUuidChan, serviceUuid, []logline.LogLine{*logLine})
+		}
+	}
+}
+
+func (strategy *PerFileStreamLogsStrategy) RemoveEnclaveLogs(fs volume_filesystem.VolumeFilesystem, enclaveUuid enclave.EnclaveUUID) error {
+	enclaveLogsDirPath := fmt.Sprintf(perFileEnclaveDirPathFmtStr, volume_consts.LogsStorageDirpath, string(enclaveUuid))
+	if err := fs.RemoveAll(enclaveLogsDirPath); err != nil {
+		return stacktrace.Propagate(err, "An error occurred removing the logs of enclave '%v' at the following path: %v", enclaveUuid, enclaveLogsDirPath)
+	}
+	return nil
+}
+
+func (strategy *PerFileStreamLogsStrategy) GetLogsSizeInBytesByEnclaveUuid(fs volume_filesystem.VolumeFilesystem) (map[enclave.EnclaveUUID]uint64, error) {
+	logsSizeInBytesByEnclaveUuid := map[enclave.EnclaveUUID]uint64{}
+	storageDirEntries, err := fs.ReadDir(volume_consts.LogsStorageDirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// no logs have been stored yet
+			return logsSizeInBytesByEnclaveUuid, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred reading the logs storage directory '%v'", volume_consts.LogsStorageDirpath)
+	}
+	for _, storageDirEntry := range storageDirEntries {
+		if !storageDirEntry.IsDir() {
+			continue
+		}
+		enclaveLogsDirPath := fmt.Sprintf(perFileEnclaveDirPathFmtStr, volume_consts.LogsStorageDirpath, storageDirEntry.Name())
+		enclaveDirEntries, err := fs.ReadDir(enclaveLogsDirPath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading directory '%v'", enclaveLogsDirPath)
+		}
+		// the year directories of the per week logs also sit in the logs storage directory, only the logs files right
+		// under the directory are counted so that those are left out
+		var enclaveLogsSizeInBytes uint64
+		for _, enclaveDirEntry := range enclaveDirEntries {
+			if enclaveDirEntry.IsDir() || !strings.HasSuffix(enclaveDirEntry.Name(), volume_consts.Filetype) {
+				continue
+			}
+			logsFileInfo, err := enclaveDirEntry.Info()
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the info of logs file '%v' in directory '%v'", enclaveDirEntry.Name(), enclaveLogsDirPath)
+			}
+			enclaveLogsSizeInBytes += uint64(logsFileInfo.Size())
+		}
+		if enclaveLogsSizeInBytes > 0 {
+			logsSizeInBytesByEnclaveUuid[enclave.EnclaveUUID(storageDirEntry.Name())] = enclaveLogsSizeInBytes
 		}
 	}
+	return logsSizeInBytesByEnclaveUuid, nil
 }
@@ -17,6 +17,8 @@ import (
 	"github.com/sirupsen/logrus"
 	"golang.org/x/exp/slices"
 	"io"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -24,8 +26,77 @@ import (
 
 const (
 	oneWeek = 7 * 24 * time.Hour
+
+	forwardSlash = "/"
 )
 
+// PerWeekLogsDir is a directory holding the logs of all the enclaves for an ISO week of a year
+type PerWeekLogsDir struct {
+	path string
+	year int
+	week int
+}
+
+func (dir PerWeekLogsDir) GetPath() string {
+	return dir.path
+}
+
+func (dir PerWeekLogsDir) GetYear() int {
+	return dir.year
+}
+
+func (dir PerWeekLogsDir) GetWeek() int {
+	return dir.week
+}
+
+// IsBeforeWeekOf returns true if the week of the directory is earlier than the week [moment] falls in
+func (dir PerWeekLogsDir) IsBeforeWeekOf(moment time.Time) bool {
+	return isWeekBefore(dir.year, dir.week, moment)
+}
+
+// GetPerWeekLogsDirs returns the per week logs directories found in the logs storage, sorted from the oldest to the most recent
+func GetPerWeekLogsDirs(fs volume_filesystem.VolumeFilesystem) ([]PerWeekLogsDir, error) {
+	var weekLogsDirs []PerWeekLogsDir
+	storageDirEntries, err := fs.ReadDir(volume_consts.LogsStorageDirpath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			// no logs have been stored yet
+			return weekLogsDirs, nil
+		}
+		return nil, stacktrace.Propagate(err, "An error occurred reading the logs storage directory '%v'", volume_consts.LogsStorageDirpath)
+	}
+	for _, storageDirEntry := range storageDirEntries {
+		year, err := strconv.Atoi(storageDirEntry.Name())
+		// the per file logs are stored in enclave directories sitting next to the year directories
+		if err != nil || !storageDirEntry.IsDir() {
+			continue
+		}
+		yearDirPath := volume_consts.LogsStorageDirpath + storageDirEntry.Name()
+		yearDirEntries, err := fs.ReadDir(yearDirPath)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading directory '%v'", yearDirPath)
+		}
+		for _, yearDirEntry := range yearDirEntries {
+			week, err := strconv.Atoi(yearDirEntry.Name())
+			if err != nil || !yearDirEntry.IsDir() {
+				continue
+			}
+			weekLogsDirs = append(weekLogsDirs, PerWeekLogsDir{
+				path: fmt.Sprintf(volume_consts.PerWeekDirPathStr, volume_consts.LogsStorageDirpath, storageDirEntry.Name(), yearDirEntry.Name()),
+				year: year,
+				week: week,
+			})
+		}
+	}
+	sort.Slice(weekLogsDirs, func(i, j int) bool {
+		if weekLogsDirs[i].year != weekLogsDirs[j].year {
+			return weekLogsDirs[i].year < weekLogsDirs[j].year
+		}
+		return weekLogsDirs[i].week < weekLogsDirs[j].week
+	})
+	return weekLogsDirs, nil
+}
+
 // PerWeekStreamLogsStrategy pulls logs from filesystem where there is a log file per year, per week, per enclave, per service
 // Weeks are denoted 01-52
 // e.g.
@@ -33,11 +104,15 @@ const (
 // in the 28th week of the current year
 type PerWeekStreamLogsStrategy struct {
 	time logs_clock.LogsClock
+
+	// log lines older than this are never returned
+	logRetentionPeriod time.Duration
 }
 
-func NewPerWeekStreamLogsStrategy(time logs_clock.LogsClock) *PerWeekStreamLogsStrategy {
+func NewPerWeekStreamLogsStrategy(time logs_clock.LogsClock, logRetentionPeriod time.Duration) *PerWeekStreamLogsStrategy {
 	return &PerWeekStreamLogsStrategy{
-		time: time,
+		time:               time,
+		logRetentionPeriod: logRetentionPeriod,
 	}
 }
 
@@ -49,9 +124,11 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 	enclaveUuid enclave.EnclaveUUID,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	logLineRange *logline.LogLineRange,
 	shouldFollowLogs bool,
 ) {
-	paths := strategy.getRetainedLogsFilePaths(fs, volume_consts.LogRetentionPeriodInWeeks, string(enclaveUuid), string(serviceUuid))
+	retentionPeriodInWeeks := volume_consts.GetLogRetentionPeriodInWeeks(strategy.logRetentionPeriod)
+	paths := strategy.getRetainedLogsFilePaths(fs, retentionPeriodInWeeks, string(enclaveUuid), string(serviceUuid), logLineRange.GetSince())
 	if len(paths) == 0 {
 		streamErrChan <- stacktrace.NewError(
 			`No logs file paths for service '%v' in enclave '%v' were found. This means either:
@@ -60,21 +137,28 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 			serviceUuid, enclaveUuid)
 		return
 	}
-	if len(paths) > volume_consts.LogRetentionPeriodInWeeks+1 {
+	if len(paths) > retentionPeriodInWeeks+1 {
 		logrus.Warnf(
 			`We expected to retrieve logs going back '%v' weeks, but instead retrieved logs going back '%v' weeks. 
 					This means logs past the retention period are being returned, likely a bug in Kurtosis.`,
-			volume_consts.LogRetentionPeriodInWeeks+1, len(paths))
+			retentionPeriodInWeeks+1, len(paths))
 	}
 	latestLogFile := paths[len(paths)-1]
 
 	var fileReaders []io.Reader
+	// keeps track of how much of the latest file has been read, so following the logs continues from there
+	latestLogFileReader := &countingReader{reader: nil, numBytesRead: 0}
 	for _, pathStr := range paths {
 		logsFile, err := fs.Open(pathStr)
 		if err != nil {
 			streamErrChan <- stacktrace.Propagate(err, "An error occurred opening the logs file for service '%v' in enclave '%v' at the following path: %v.", serviceUuid, enclaveUuid, pathStr)
 			return
 		}
+		if pathStr == latestLogFile {
+			latestLogFileReader.reader = logsFile
+			fileReaders = append(fileReaders, latestLogFileReader)
+			continue
+		}
 		fileReaders = append(fileReaders, logsFile)
 	}
 
@@ -82,6 +166,9 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 
 	logsReader := bufio.NewReader(combinedLogsReader)
 
+	// only used when the last N log lines were requested, these are sent all together once all the stored logs have been read
+	var lastLogLines []logline.LogLine
+
 	for {
 		select {
 		case <-ctx.Done():
@@ -91,8 +178,7 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 			var jsonLogStr string
 			var jsonLogNewStr string
 			var readErr error
-			var err error
-			var isLastLogLine = false
+			var isEndOfLogs = false
 
 			// get a complete log line
 			for {
@@ -108,20 +194,8 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 					}
 				}
 				if readErr != nil && errors.Is(readErr, io.EOF) {
-					// exiting stream
 					logrus.Debugf("EOF error returned when reading logs for service '%v' in enclave '%v'", serviceUuid, enclaveUuid)
-					if jsonLogStr != "" {
-						isLastLogLine = true
-					} else {
-						if shouldFollowLogs {
-							if err = strategy.tailLogs(latestLogFile, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
-								streamErrChan <- stacktrace.Propagate(err, "An error occurred following logs for service '%v' in enclave '%v'.", serviceUuid, enclaveUuid)
-								return
-							}
-						} else {
-							return
-						}
-					}
+					isEndOfLogs = true
 				}
 				break
 			}
@@ -130,20 +204,30 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 				return
 			}
 
-			if err = strategy.sendJsonLogLine(jsonLogStr, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
-				streamErrChan <- stacktrace.Propagate(err, "An error occurred sending log line for service '%v' in enclave '%v'.", serviceUuid, enclaveUuid)
-				return
+			if jsonLogStr != "" {
+				logLine, err := strategy.getLogLineIfRequested(jsonLogStr, conjunctiveLogLinesFiltersWithRegex, logLineRange)
+				if err != nil {
+					streamErrChan <- stacktrace.Propagate(err, "An error occurred processing log line for service '%v' in enclave '%v'.", serviceUuid, enclaveUuid)
+					return
+				}
+				if logLine != nil {
+					if logLineRange.ShouldReturnLastLogLinesOnly() {
+						lastLogLines = appendToLastLogLines(lastLogLines, *logLine, logLineRange.GetNumLogLines())
+					} else {
+						sendLogLines(logsByKurtosisUserServiceUuidChan, serviceUuid, []logline.LogLine{*logLine})
+					}
+				}
 			}
 
-			if isLastLogLine {
-				if shouldFollowLogs {
-					if err = strategy.tailLogs(latestLogFile, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex); err != nil {
-						streamErrChan <- stacktrace.Propagate(err, "An error occurred following logs for service '%v' in enclave '%v'.", serviceUuid, enclaveUuid)
-						return
-					}
-				} else {
+			if isEndOfLogs {
+				sendLogLines(logsByKurtosisUserServiceUuidChan, serviceUuid, lastLogLines)
+				if !shouldFollowLogs {
 					return
 				}
+				if err := strategy.tailLogs(latestLogFile, latestLogFileReader.numBytesRead, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex, logLineRange); err != nil {
+					streamErrChan <- stacktrace.Propagate(err, "An error occurred following logs for service '%v' in enclave '%v'.", serviceUuid, enclaveUuid)
+				}
+				return
 			}
 		}
 	}
@@ -156,15 +240,61 @@ func (strategy *PerWeekStreamLogsStrategy) StreamLogs(
 // - The +1 is because we retain an extra week of logs compared to what we promise to retain for safety.
 // - The list of file paths is returned in order of oldest logs to most recent logs e.g. [ 3/80124/1234.json, /4/801234/1234.json, ...]
 // - If a file path does not exist, the function with exits and returns whatever file paths were found
+// - If [since] is set, the weeks before the one [since] falls in are not returned, as they can't contain any requested log line.
+// The current week is always returned though, so it can be followed
+
+func (strategy *PerWeekStreamLogsStrategy) RemoveEnclaveLogs(fs volume_filesystem.VolumeFilesystem, enclaveUuid enclave.EnclaveUUID) error {
+	weekLogsDirs, err := GetPerWeekLogsDirs(fs)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the per week logs directories")
+	}
+	for _, weekLogsDir := range weekLogsDirs {
+		enclaveLogsDirPath := weekLogsDir.GetPath() + string(enclaveUuid) + forwardSlash
+		if err := fs.RemoveAll(enclaveLogsDirPath); err != nil {
+			return stacktrace.Propagate(err, "An error occurred removing the logs of enclave '%v' at the following path: %v", enclaveUuid, enclaveLogsDirPath)
+		}
+	}
+	return nil
+}
+
+func (strategy *PerWeekStreamLogsStrategy) GetLogsSizeInBytesByEnclaveUuid(fs volume_filesystem.VolumeFilesystem) (map[enclave.EnclaveUUID]uint64, error) {
+	logsSizeInBytesByEnclaveUuid := map[enclave.EnclaveUUID]uint64{}
+	weekLogsDirs, err := GetPerWeekLogsDirs(fs)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the per week logs directories")
+	}
+	for _, weekLogsDir := range weekLogsDirs {
+		weekDirEntries, err := fs.ReadDir(weekLogsDir.GetPath())
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred reading directory '%v'", weekLogsDir.GetPath())
+		}
+		for _, weekDirEntry := range weekDirEntries {
+			if !weekDirEntry.IsDir() {
+				continue
+			}
+			enclaveLogsDirPath := weekLogsDir.GetPath() + weekDirEntry.Name()
+			enclaveLogsSizeInBytes, err := volume_filesystem.GetDirSizeInBytes(fs, enclaveLogsDirPath)
+			if err != nil {
+				return nil, stacktrace.Propagate(err, "An error occurred getting the size of directory '%v'", enclaveLogsDirPath)
+			}
+			logsSizeInBytesByEnclaveUuid[enclave.EnclaveUUID(weekDirEntry.Name())] += enclaveLogsSizeInBytes
+		}
+	}
+	return logsSizeInBytesByEnclaveUuid, nil
+}
 func (strategy *PerWeekStreamLogsStrategy) getRetainedLogsFilePaths(
 	filesystem volume_filesystem.VolumeFilesystem,
 	retentionPeriodInWeeks int,
-	enclaveUuid, serviceUuid string) []string {
+	enclaveUuid, serviceUuid string,
+	since *time.Time) []string {
 	var paths []string
 
 	// get log file paths as far back as they exist
 	for i := 0; i < (retentionPeriodInWeeks + 1); i++ {
 		year, week := strategy.time.Now().Add(time.Duration(-i) * oneWeek).ISOWeek()
+		if i > 0 && since != nil && isWeekBefore(year, week, *since) {
+			break
+		}
 		filePathStr := fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpath, strconv.Itoa(year), strconv.Itoa(week), enclaveUuid, serviceUuid, volume_consts.Filetype)
 		if _, err := filesystem.Stat(filePathStr); err != nil {
 			break
@@ -178,15 +308,20 @@ func (strategy *PerWeekStreamLogsStrategy) getRetainedLogsFilePaths(
 	return paths
 }
 
-// tail -f [filepath]
+// tail -f [filepath], starting at [offset]
 func (strategy *PerWeekStreamLogsStrategy) tailLogs(
 	filepath string,
+	offset int64,
 	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
 	serviceUuid service.ServiceUUID,
 	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	logLineRange *logline.LogLineRange,
 ) error {
 	logTail, err := tail.TailFile(filepath, tail.Config{
-		Location:    nil,
+		Location: &tail.SeekInfo{
+			Offset: offset,
+			Whence: io.SeekStart,
+		},
 		ReOpen:      false,
 		MustExist:   true,
 		Poll:        false,
@@ -199,21 +334,24 @@ func (strategy *PerWeekStreamLogsStrategy) tailLogs(
 		return stacktrace.Propagate(err, "An error occurred while attempting to tail the log file.")
 	}
 
-	for logLine := range logTail.Lines {
-		err = strategy.sendJsonLogLine(logLine.Text, logsByKurtosisUserServiceUuidChan, serviceUuid, conjunctiveLogLinesFiltersWithRegex)
+	for tailedLine := range logTail.Lines {
+		logLine, err := strategy.getLogLineIfRequested(tailedLine.Text, conjunctiveLogLinesFiltersWithRegex, logLineRange)
 		if err != nil {
-			return stacktrace.Propagate(err, "An error occurred sending json log line '%v'.", logLine.Text)
+			return stacktrace.Propagate(err, "An error occurred processing json log line '%v'.", tailedLine.Text)
+		}
+		if logLine != nil {
+			sendLogLines(logsByKurtosisUserServiceUuidChan, serviceUuid, []logline.LogLine{*logLine})
 		}
 	}
 	return nil
 }
 
+// Returns the log line contained in [jsonLogLineStr] if it was requested, or nil if it was left out by the filters, the range or the retention period
 // Returns error if [jsonLogLineStr] is not a valid log line
-func (strategy *PerWeekStreamLogsStrategy) sendJsonLogLine(
+func (strategy *PerWeekStreamLogsStrategy) getLogLineIfRequested(
 	jsonLogLineStr string,
-	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
-	serviceUuid service.ServiceUUID,
-	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex) error {
+	conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+	logLineRange *logline.LogLineRange) (*logline.LogLine, error) {
 	// each logLineStr is of the following structure: {"enclave_uuid": "...", "service_uuid":"...", "log": "...",.. "timestamp":"..."}
 	// eg. {"container_type":"api-container", "container_id":"8f8558ba", "container_name":"/kurtosis-api--ffd",
 	// "log":"hi","timestamp":"2023-08-14T14:57:49Z"}
@@ -221,54 +359,64 @@ func (strategy *PerWeekStreamLogsStrategy) sendJsonLogLine(
 	// First decode the line
 	var jsonLog JsonLog
 	if err := json.Unmarshal([]byte(jsonLogLineStr), &jsonLog); err != nil {
-		return stacktrace.Propagate(err, "An error occurred parsing the json log string: %v\n", jsonLogLineStr)
+		return nil, stacktrace.Propagate(err, "An error occurred parsing the json log string: %v\n", jsonLogLineStr)
 	}
 
 	// Then extract the actual log message using the "log" field
 	logLineStr, found := jsonLog[volume_consts.LogLabel]
 	if !found {
-		return stacktrace.NewError("An error retrieving the log field from json log string: %v\n", jsonLogLineStr)
+		return nil, stacktrace.NewError("An error retrieving the log field from json log string: %v\n", jsonLogLineStr)
+	}
+	timestamp, err := getTimestamp(jsonLog)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred retrieving the timestamp from json log string: %v\n", jsonLogLineStr)
+	}
+	logLine := logline.NewLogLine(logLineStr, timestamp)
+
+	// Then leave it out if it was not logged within the requested time range
+	if !logLineRange.IsWithinTimeRange(*logLine) {
+		return nil, nil
 	}
-	logLine := logline.NewLogLine(logLineStr)
 
 	// Then filter by checking if the log message is valid based on requested filters
 	validLogLine, err := logLine.IsValidLogLineBaseOnFilters(conjunctiveLogLinesFiltersWithRegex)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
+		return nil, stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
 	}
 	if !validLogLine {
-		return nil
+		return nil, nil
 	}
 
 	// ensure this log line is within the retention period if it has a timestamp
 	withinRetentionPeriod, err := strategy.isWithinRetentionPeriod(jsonLog)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
+		return nil, stacktrace.Propagate(err, "An error occurred filtering log line '%+v' using filters '%+v'", logLine, conjunctiveLogLinesFiltersWithRegex)
 	}
 	if !withinRetentionPeriod {
-		return nil
+		return nil, nil
 	}
 
-	// send the log line
-	logLines := []logline.LogLine{*logLine}
-	userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
-		serviceUuid: logLines,
-	}
-	logsByKurtosisUserServiceUuidChan <- userServicesLogLinesMap
-	return nil
+	return logLine, nil
 }
 
 // Returns true if [logLine] has no timestamp
 func (strategy *PerWeekStreamLogsStrategy) isWithinRetentionPeriod(logLine JsonLog) (bool, error) {
-	retentionPeriod := strategy.time.Now().Add(time.Duration(-volume_consts.LogRetentionPeriodInWeeks-1) * oneWeek)
-	timestampStr, found := logLine[volume_consts.TimestampLabel]
-	if !found {
-		return true, nil
-	}
-
-	timestamp, err := time.Parse(time.RFC3339, timestampStr)
+	retentionPeriod := strategy.time.Now().Add(-strategy.logRetentionPeriod)
+	timestamp, err := getTimestamp(logLine)
 	if err != nil {
 		return false, stacktrace.Propagate(err, "An error occurred retrieving the timestamp field from logs json log line. This is a bug in Kurtosis.")
 	}
+	if timestamp.IsZero() {
+		return true, nil
+	}
 	return timestamp.After(retentionPeriod), nil
 }
+
+// Returns true if the ISO [year] and [week] are earlier than the week [moment] falls in
+func isWeekBefore(year int, week int, moment time.Time) bool {
+	momentYear, momentWeek := moment.ISOWeek()
+	if year != momentYear {
+		return year < momentYear
+	}
+	return week < momentWeek
+}
@@ -2,6 +2,7 @@ package stream_logs_strategy
 
 import (
 	"fmt"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
@@ -13,6 +14,7 @@ import (
 
 const (
 	testEnclaveUuid      = "test-enclave"
+	testOtherEnclaveUuid = "test-other-enclave"
 	testUserService1Uuid = "test-user-service-1"
 
 	defaultRetentionPeriodInWeeks = volume_consts.LogRetentionPeriodInWeeks
@@ -63,8 +65,8 @@ func TestGetRetainedLogsFilePaths(t *testing.T) {
 	}
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, nil)
 
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
 	for i, filePath := range expectedLogFilePaths {
@@ -110,8 +112,8 @@ func TestGetRetainedLogsFilePathsAcrossNewYear(t *testing.T) {
 	}
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, nil)
 
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
 	for i, filePath := range expectedLogFilePaths {
@@ -148,8 +150,8 @@ func TestGetRetainedLogsFilePathsWithDiffRetentionPeriod(t *testing.T) {
 	}
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, retentionPeriod, testEnclaveUuid, testUserService1Uuid)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, retentionPeriod, testEnclaveUuid, testUserService1Uuid, nil)
 
 	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
 	for i, filePath := range expectedLogFilePaths {
@@ -179,8 +181,8 @@ func TestGetRetainedLogsFilePathsReturnsErrorIfWeeksMissing(t *testing.T) {
 	currentWeek := 2
 
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, nil)
 
 	require.Less(t, len(logFilePaths), defaultRetentionPeriodInWeeks)
 }
@@ -208,13 +210,81 @@ func TestGetRetainedLogsFilePathsReturnsCorrectPathsIfWeeksMissing(t *testing.T)
 
 	// should only return week 3
 	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime)
-	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, nil)
 
 	require.Len(t, logFilePaths, 1)
 	require.Equal(t, "/"+week3filepath, logFilePaths[0])
 }
 
+func TestGetRetainedLogsFilePathsSkipsWeeksBeforeSince(t *testing.T) {
+	// ../week/enclave uuid/service uuid.json
+	week14filepath := getWeekFilepathStr(defaultYear, 14)
+	week15filepath := getWeekFilepathStr(defaultYear, 15)
+	week16filepath := getWeekFilepathStr(defaultYear, 16)
+	week17filepath := getWeekFilepathStr(defaultYear, 17)
+
+	mapFS := &fstest.MapFS{
+		week14filepath: {
+			Data: []byte{},
+		},
+		week15filepath: {
+			Data: []byte{},
+		},
+		week16filepath: {
+			Data: []byte{},
+		},
+		week17filepath: {
+			Data: []byte{},
+		},
+	}
+
+	filesystem := volume_filesystem.NewMockedVolumeFilesystem(mapFS)
+	currentWeek := 17
+	since := logs_clock.NewMockLogsClock(defaultYear, 15, defaultDay).Now()
+
+	expectedLogFilePaths := []string{
+		week15filepath,
+		week16filepath,
+		week17filepath,
+	}
+
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, &since)
+
+	require.Equal(t, len(expectedLogFilePaths), len(logFilePaths))
+	for i, filePath := range expectedLogFilePaths {
+		require.Equal(t, "/"+filePath, logFilePaths[i])
+	}
+}
+
+func TestGetRetainedLogsFilePathsAlwaysReturnsCurrentWeekIfSinceIsInTheFuture(t *testing.T) {
+	// ../week/enclave uuid/service uuid.json
+	week16filepath := getWeekFilepathStr(defaultYear, 16)
+	week17filepath := getWeekFilepathStr(defaultYear, 17)
+
+	mapFS := &fstest.MapFS{
+		week16filepath: {
+			Data: []byte{},
+		},
+		week17filepath: {
+			Data: []byte{},
+		},
+	}
+
+	filesystem := volume_filesystem.NewMockedVolumeFilesystem(mapFS)
+	currentWeek := 17
+	since := logs_clock.NewMockLogsClock(defaultYear, 20, defaultDay).Now()
+
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, currentWeek, defaultDay)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+	logFilePaths := strategy.getRetainedLogsFilePaths(filesystem, defaultRetentionPeriodInWeeks, testEnclaveUuid, testUserService1Uuid, &since)
+
+	require.Len(t, logFilePaths, 1)
+	require.Equal(t, "/"+week17filepath, logFilePaths[0])
+}
+
 func TestIsWithinRetentionPeriod(t *testing.T) {
 	// this is the 36th week of the yera
 	jsonLogLine := map[string]string{
@@ -223,7 +293,7 @@ func TestIsWithinRetentionPeriod(t *testing.T) {
 
 	// week 41 would put the log line outside the retention period
 	mockTime := logs_clock.NewMockLogsClock(2023, 41, 0)
-	strategy := NewPerWeekStreamLogsStrategy(mockTime)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
 
 	isWithinRetentionPeriod, err := strategy.isWithinRetentionPeriod(jsonLogLine)
 
@@ -231,6 +301,74 @@ func TestIsWithinRetentionPeriod(t *testing.T) {
 	require.False(t, isWithinRetentionPeriod)
 }
 
+func TestRemoveEnclaveLogs(t *testing.T) {
+	week1filepath := getWeekFilepathStr(defaultYear, 1)
+	week2filepath := getWeekFilepathStr(defaultYear, 2)
+	otherEnclaveWeek2filepath := fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpathForTests, strconv.Itoa(defaultYear), strconv.Itoa(2), testOtherEnclaveUuid, testUserService1Uuid, volume_consts.Filetype)
+
+	mapFS := &fstest.MapFS{
+		week1filepath: {
+			Data: []byte{},
+		},
+		week2filepath: {
+			Data: []byte{},
+		},
+		otherEnclaveWeek2filepath: {
+			Data: []byte{},
+		},
+	}
+
+	filesystem := volume_filesystem.NewMockedVolumeFilesystem(mapFS)
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, 2, defaultDay)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+
+	err := strategy.RemoveEnclaveLogs(filesystem, testEnclaveUuid)
+	require.NoError(t, err)
+
+	for _, removedFilepath := range []string{week1filepath, week2filepath} {
+		_, err = filesystem.Stat(removedFilepath)
+		require.Error(t, err)
+	}
+	_, err = filesystem.Stat(otherEnclaveWeek2filepath)
+	require.NoError(t, err)
+}
+
+func TestGetLogsSizeInBytesByEnclaveUuid(t *testing.T) {
+	week1filepath := getWeekFilepathStr(defaultYear, 1)
+	week2filepath := getWeekFilepathStr(defaultYear, 2)
+	otherEnclaveWeek2filepath := fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpathForTests, strconv.Itoa(defaultYear), strconv.Itoa(2), testOtherEnclaveUuid, testUserService1Uuid, volume_consts.Filetype)
+	// logs stored per file are left out
+	perFileFilepath := fmt.Sprintf(volume_consts.PerFileFmtStr, volume_consts.LogsStorageDirpathForTests, testOtherEnclaveUuid, testUserService1Uuid, volume_consts.Filetype)
+
+	mapFS := &fstest.MapFS{
+		week1filepath: {
+			Data: make([]byte, 10),
+		},
+		week2filepath: {
+			Data: make([]byte, 20),
+		},
+		otherEnclaveWeek2filepath: {
+			Data: make([]byte, 40),
+		},
+		perFileFilepath: {
+			Data: make([]byte, 80),
+		},
+	}
+
+	filesystem := volume_filesystem.NewMockedVolumeFilesystem(mapFS)
+	mockTime := logs_clock.NewMockLogsClock(defaultYear, 2, defaultDay)
+	strategy := NewPerWeekStreamLogsStrategy(mockTime, volume_consts.DefaultLogRetentionPeriod)
+
+	logsSizeInBytesByEnclaveUuid, err := strategy.GetLogsSizeInBytesByEnclaveUuid(filesystem)
+	require.NoError(t, err)
+
+	expectedLogsSizeInBytesByEnclaveUuid := map[enclave.EnclaveUUID]uint64{
+		testEnclaveUuid:      30,
+		testOtherEnclaveUuid: 40,
+	}
+	require.Equal(t, expectedLogsSizeInBytesByEnclaveUuid, logsSizeInBytesByEnclaveUuid)
+}
+
 func getWeekFilepathStr(year, week int) string {
 	return fmt.Sprintf(volume_consts.PerWeekFilePathFmtStr, volume_consts.LogsStorageDirpathForTests, strconv.Itoa(year), strconv.Itoa(week), testEnclaveUuid, testUserService1Uuid, volume_consts.Filetype)
 }
@@ -0,0 +1,58 @@
+package stream_logs_strategy
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/service"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/logline"
+	"github.com/kurtosis-tech/stacktrace"
+	"io"
+	"time"
+)
+
+// countingReader keeps track of the number of bytes read from the underlying reader
+type countingReader struct {
+	reader       io.Reader
+	numBytesRead int64
+}
+
+func (countingReader *countingReader) Read(p []byte) (int, error) {
+	numBytesRead, err := countingReader.reader.Read(p)
+	countingReader.numBytesRead += int64(numBytesRead)
+	return numBytesRead, err
+}
+
+// Returns the time [jsonLog] was logged at, or the zero time if it doesn't have a timestamp
+func getTimestamp(jsonLog JsonLog) (time.Time, error) {
+	timestampStr, found := jsonLog[volume_consts.TimestampLabel]
+	if !found {
+		return time.Time{}, nil
+	}
+	timestamp, err := time.Parse(time.RFC3339, timestampStr)
+	if err != nil {
+		return time.Time{}, stacktrace.Propagate(err, "An error occurred parsing timestamp '%v' using layout '%v'", timestampStr, time.RFC3339)
+	}
+	return timestamp, nil
+}
+
+// Appends [logLine] to [lastLogLines], dropping the oldest log lines so no more than [numLogLines] are kept
+func appendToLastLogLines(lastLogLines []logline.LogLine, logLine logline.LogLine, numLogLines uint32) []logline.LogLine {
+	lastLogLines = append(lastLogLines, logLine)
+	if numLogLinesToDrop := len(lastLogLines) - int(numLogLines); numLogLinesToDrop > 0 {
+		lastLogLines = lastLogLines[numLogLinesToDrop:]
+	}
+	return lastLogLines
+}
+
+func sendLogLines(
+	logsByKurtosisUserServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
+	serviceUuid service.ServiceUUID,
+	logLines []logline.LogLine,
+) {
+	if len(logLines) == 0 {
+		return
+	}
+	userServicesLogLinesMap := map[service.ServiceUUID][]logline.LogLine{
+		serviceUuid: logLines,
+	}
+	logsByKurtosisUserServiceUuidChan <- userServicesLogLinesMap
+}
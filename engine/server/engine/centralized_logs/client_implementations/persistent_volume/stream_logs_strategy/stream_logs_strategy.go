@@ -20,6 +20,13 @@ type StreamLogsStrategy interface {
 		enclaveUuid enclave.EnclaveUUID,
 		serviceUuid service.ServiceUUID,
 		conjunctiveLogLinesFiltersWithRegex []logline.LogLineFilterWithRegex,
+		logLineRange *logline.LogLineRange,
 		shouldFollowLogs bool,
 	)
+
+	// RemoveEnclaveLogs removes all the logs stored for the enclave
+	RemoveEnclaveLogs(fs volume_filesystem.VolumeFilesystem, enclaveUuid enclave.EnclaveUUID) error
+
+	// GetLogsSizeInBytesByEnclaveUuid returns the disk space taken by the logs stored for each enclave
+	GetLogsSizeInBytesByEnclaveUuid(fs volume_filesystem.VolumeFilesystem) (map[enclave.EnclaveUUID]uint64, error)
 }
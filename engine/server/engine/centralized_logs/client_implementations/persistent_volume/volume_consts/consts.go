@@ -1,6 +1,10 @@
 package volume_consts
 
-import "strings"
+import (
+	"math"
+	"strings"
+	"time"
+)
 
 const (
 	// Location of logs on the filesystem of the engine
@@ -10,13 +14,22 @@ const (
 
 	NewLineRune = '\n'
 
-	LogLabel       = "log"
-	TimestampLabel = "timestamp"
+	LogLabel         = "log"
+	TimestampLabel   = "timestamp"
+	ServiceUuidLabel = "service_uuid"
 
 	EndOfJsonLine = "}\n"
 
 	LogRetentionPeriodInWeeks = 4
 
+	oneWeek = 7 * 24 * time.Hour
+
+	// Used when the engine doesn't get a log retention period configured
+	DefaultLogRetentionPeriod = LogRetentionPeriodInWeeks * oneWeek
+
+	// Used when the engine doesn't get a max logs storage size configured, meaning logs are only pruned by age
+	NoLogStorageSizeLimit = 0
+
 	// basepath/enclave uuid/service uuid <filetype>
 	PerFileFmtStr = "%s%s/%s%s"
 
@@ -33,3 +46,9 @@ var (
 	// so we trim the leading forward slash
 	LogsStorageDirpathForTests = strings.TrimLeft(LogsStorageDirpath, "/")
 )
+
+// GetLogRetentionPeriodInWeeks returns how many weeks, besides the current one, need to be kept around so that logs are
+// retained for [logRetentionPeriod]
+func GetLogRetentionPeriodInWeeks(logRetentionPeriod time.Duration) int {
+	return int(math.Ceil(float64(logRetentionPeriod) / float64(oneWeek)))
+}
@@ -1,8 +1,11 @@
 package volume_filesystem
 
 import (
+	"github.com/kurtosis-tech/stacktrace"
 	"io"
+	"io/fs"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing/fstest"
 )
@@ -17,6 +20,7 @@ type VolumeFilesystem interface {
 	Open(name string) (VolumeFile, error)
 	Stat(name string) (VolumeFileInfo, error)
 	RemoveAll(path string) error
+	ReadDir(name string) ([]fs.DirEntry, error)
 }
 
 type VolumeFile interface {
@@ -45,6 +49,10 @@ func (fs *OsVolumeFilesystem) RemoveAll(path string) error {
 	return os.RemoveAll(path)
 }
 
+func (fs *OsVolumeFilesystem) ReadDir(name string) ([]fs.DirEntry, error) {
+	return os.ReadDir(name)
+}
+
 // MockedVolumeFilesystem is an implementation used for unit testing
 type MockedVolumeFilesystem struct {
 	// we use an underlying map filesystem that's easy to mock file data with
@@ -77,6 +85,37 @@ func (fs *MockedVolumeFilesystem) RemoveAll(path string) error {
 	return nil
 }
 
+func (fs *MockedVolumeFilesystem) ReadDir(name string) ([]fs.DirEntry, error) {
+	// fstest.MapFS doesn't like trailing slashes either
+	return fs.mapFS.ReadDir(strings.TrimRight(trimForwardSlash(name), forwardSlash))
+}
+
+// GetDirSizeInBytes returns the sum of the sizes of all the files inside [dirPath], walking it recursively
+func GetDirSizeInBytes(filesystem VolumeFilesystem, dirPath string) (uint64, error) {
+	dirEntries, err := filesystem.ReadDir(dirPath)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred reading directory '%v'", dirPath)
+	}
+	var sizeInBytes uint64
+	for _, dirEntry := range dirEntries {
+		entryPath := filepath.Join(dirPath, dirEntry.Name())
+		if dirEntry.IsDir() {
+			subDirSizeInBytes, err := GetDirSizeInBytes(filesystem, entryPath)
+			if err != nil {
+				return 0, stacktrace.Propagate(err, "An error occurred getting the size of directory '%v'", entryPath)
+			}
+			sizeInBytes += subDirSizeInBytes
+			continue
+		}
+		entryInfo, err := dirEntry.Info()
+		if err != nil {
+			return 0, stacktrace.Propagate(err, "An error occurred getting the info of file '%v'", entryPath)
+		}
+		sizeInBytes += uint64(entryInfo.Size())
+	}
+	return sizeInBytes, nil
+}
+
 func trimForwardSlash(name string) string {
 	return strings.TrimLeft(name, forwardSlash)
 }
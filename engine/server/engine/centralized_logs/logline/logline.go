@@ -3,6 +3,7 @@ package logline
 import (
 	"github.com/kurtosis-tech/stacktrace"
 	"strings"
+	"time"
 )
 
 const (
@@ -10,19 +11,25 @@ const (
 )
 
 type LogLine struct {
-	//lineTime time.Time //TODO add the time from loki logs result
 	content string
+
+	// The zero value means that the time the line was logged at is unknown
+	timestamp time.Time
 }
 
-func NewLogLine(content string) *LogLine {
+func NewLogLine(content string, timestamp time.Time) *LogLine {
 	contentWithoutNewLine := strings.TrimSuffix(content, newlineChar)
-	return &LogLine{content: contentWithoutNewLine}
+	return &LogLine{content: contentWithoutNewLine, timestamp: timestamp}
 }
 
 func (logLine LogLine) GetContent() string {
 	return logLine.content
 }
 
+func (logLine LogLine) GetTimestamp() time.Time {
+	return logLine.timestamp
+}
+
 func (logLine LogLine) IsValidLogLineBaseOnFilters(
 	conjunctiveLogLinesFiltersWithRegex []LogLineFilterWithRegex,
 ) (bool, error) {
@@ -0,0 +1,62 @@
+package logline
+
+import "time"
+
+const (
+	allLogLines = 0
+)
+
+// LogLineRange narrows down which of the stored log lines of a service get returned
+// The time bounds are applied first, then the filters, and finally only the last [numLogLines] lines are kept
+type LogLineRange struct {
+	// Inclusive lower time bound, nil means no lower bound
+	since *time.Time
+
+	// Exclusive upper time bound, nil means no upper bound
+	until *time.Time
+
+	// Zero means all the log lines
+	numLogLines uint32
+}
+
+func NewLogLineRange(since *time.Time, until *time.Time, numLogLines uint32) *LogLineRange {
+	return &LogLineRange{since: since, until: until, numLogLines: numLogLines}
+}
+
+// NewAllLogLinesRange returns a range that doesn't leave out any log line
+func NewAllLogLinesRange() *LogLineRange {
+	return &LogLineRange{since: nil, until: nil, numLogLines: allLogLines}
+}
+
+func (logLineRange LogLineRange) GetSince() *time.Time {
+	return logLineRange.since
+}
+
+func (logLineRange LogLineRange) GetUntil() *time.Time {
+	return logLineRange.until
+}
+
+func (logLineRange LogLineRange) GetNumLogLines() uint32 {
+	return logLineRange.numLogLines
+}
+
+// ShouldReturnLastLogLinesOnly returns true if only the last N log lines have to be returned
+func (logLineRange LogLineRange) ShouldReturnLastLogLinesOnly() bool {
+	return logLineRange.numLogLines != allLogLines
+}
+
+// IsWithinTimeRange returns true if [logLine] was logged between the time bounds
+// Log lines without timestamp are always considered within the range, as there is no way to tell otherwise
+func (logLineRange LogLineRange) IsWithinTimeRange(logLine LogLine) bool {
+	timestamp := logLine.GetTimestamp()
+	if timestamp.IsZero() {
+		return true
+	}
+	if logLineRange.since != nil && timestamp.Before(*logLineRange.since) {
+		return false
+	}
+	if logLineRange.until != nil && !timestamp.Before(*logLineRange.until) {
+		return false
+	}
+	return true
+}
@@ -13,6 +13,7 @@ type LogsDatabaseClient interface {
 		enclaveUuid enclave.EnclaveUUID,
 		userServiceUuids map[service.ServiceUUID]bool,
 		conjunctiveLogLineFilters logline.ConjunctiveLogLineFilters,
+		logLineRange *logline.LogLineRange,
 		shouldFollowLogs bool,
 	) (
 		userServiceLogsByServiceUuidChan chan map[service.ServiceUUID][]logline.LogLine,
@@ -29,4 +30,18 @@ type LogsDatabaseClient interface {
 		map[service.ServiceUUID]bool,
 		error,
 	)
+
+	// RemoveEnclaveLogs removes all the logs stored for the enclave, e.g. once the enclave has been destroyed
+	RemoveEnclaveLogs(
+		ctx context.Context,
+		enclaveUuid enclave.EnclaveUUID,
+	) error
+
+	// GetLogsSizeInBytesByEnclaveUuid returns the disk space taken by the logs stored for each enclave
+	GetLogsSizeInBytesByEnclaveUuid(
+		ctx context.Context,
+	) (
+		map[enclave.EnclaveUUID]uint64,
+		error,
+	)
 }
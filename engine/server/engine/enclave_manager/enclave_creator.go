@@ -37,6 +37,8 @@ func (creator *EnclaveCreator) CreateEnclave(
 	enclaveName string,
 	enclaveEnvVars string,
 	isProduction bool,
+	// Optional, only available for Kubernetes
+	kubernetesSettings *enclave.KubernetesSettings,
 ) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
 
 	uuid, err := uuid_generator.GenerateUUIDString()
@@ -48,7 +50,7 @@ func (creator *EnclaveCreator) CreateEnclave(
 	teardownCtx := context.Background() // Separate context for tearing stuff down in case the input context is cancelled
 	// Create Enclave with kurtosisBackend
 
-	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName)
+	newEnclave, err := creator.kurtosisBackend.CreateEnclave(setupCtx, enclaveUuid, enclaveName, kubernetesSettings)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating enclave with name `%v` and uuid '%v'", enclaveName, enclaveUuid)
 	}
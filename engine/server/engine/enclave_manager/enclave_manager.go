@@ -112,6 +112,8 @@ func (manager *EnclaveManager) CreateEnclave(
 	//If blank, will use a random one
 	enclaveName string,
 	isProduction bool,
+	// Optional, only available for Kubernetes
+	kubernetesSettings *enclave.KubernetesSettings,
 ) (*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
 	manager.mutex.Lock()
 	defer manager.mutex.Unlock()
@@ -141,7 +143,8 @@ func (manager *EnclaveManager) CreateEnclave(
 	}
 
 	// TODO(victor.colombo): Extend enclave pool to have warm production enclaves
-	if !isProduction && manager.enclavePool != nil {
+	// The idle enclaves of the pool are created without any Kubernetes settings, so they can't be used when some are set
+	if !isProduction && kubernetesSettings.IsEmpty() && manager.enclavePool != nil {
 		enclaveInfo, err = manager.enclavePool.GetEnclave(
 			setupCtx,
 			enclaveName,
@@ -162,6 +165,7 @@ func (manager *EnclaveManager) CreateEnclave(
 			enclaveName,
 			manager.enclaveEnvVars,
 			isProduction,
+			kubernetesSettings,
 		)
 		if err != nil {
 			return nil, stacktrace.Propagate(
@@ -220,6 +224,40 @@ func (manager *EnclaveManager) StopEnclave(ctx context.Context, enclaveIdentifie
 	return manager.stopEnclaveWithoutMutex(ctx, enclaveUuid)
 }
 
+// StartEnclave
+// Starts a stopped enclave again; its API container reloads the enclave data and restarts the services that were running
+// when the enclave was stopped
+func (manager *EnclaveManager) StartEnclave(ctx context.Context, enclaveIdentifier string) error {
+	manager.mutex.Lock()
+	defer manager.mutex.Unlock()
+
+	enclaveUuid, err := manager.getEnclaveUuidForIdentifierUnlocked(ctx, enclaveIdentifier)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred while fetching enclave uuid for identifier '%v'", enclaveIdentifier)
+	}
+
+	enclaves, err := manager.kurtosisBackend.GetEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting enclave '%v'", enclaveUuid)
+	}
+	enclaveObj, found := enclaves[enclaveUuid]
+	if !found {
+		return stacktrace.NewError("Couldn't find enclave '%v'", enclaveUuid)
+	}
+	if enclaveObj.GetStatus() != enclave.EnclaveStatus_Stopped {
+		return stacktrace.NewError("Enclave '%v' can't be started as it isn't stopped; its status is '%v'", enclaveIdentifier, enclaveObj.GetStatus())
+	}
+
+	_, enclaveStartErrs, err := manager.kurtosisBackend.StartEnclaves(ctx, getEnclaveByEnclaveIdFilter(enclaveUuid))
+	if err != nil {
+		return stacktrace.Propagate(err, "Attempted to start enclave '%v' but the backend threw an error", enclaveUuid)
+	}
+	for erroredEnclaveUuid, enclaveStartErr := range enclaveStartErrs {
+		return stacktrace.Propagate(enclaveStartErr, "An error occurred starting enclave '%v'", erroredEnclaveUuid)
+	}
+	return nil
+}
+
 // DestroyEnclave
 // TODO remove these notes - this should be working on active enclaves as well
 // Destroys an enclave, deleting all objects associated with it in the container engine (containers, volumes, networks, etc.)
@@ -507,6 +545,14 @@ func (manager *EnclaveManager) getEnclavesWithoutMutex(
 
 }
 
+// GetEnclavePoolInfo returns the state of the enclave pool, or nil if the engine runs without it
+func (manager *EnclaveManager) GetEnclavePoolInfo() *kurtosis_engine_rpc_api_bindings.EnclavePoolInfo {
+	if manager.enclavePool == nil {
+		return nil
+	}
+	return manager.enclavePool.GetInfo()
+}
+
 func (manager *EnclaveManager) Close() error {
 	if err := manager.enclavePool.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave pool")
@@ -5,10 +5,13 @@ import (
 	"fmt"
 	"github.com/kurtosis-tech/kurtosis/api/golang/engine/kurtosis_engine_rpc_api_bindings"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
 	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/enclave"
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"sort"
 	"strings"
 	"time"
 )
@@ -30,13 +33,18 @@ type EnclavePool struct {
 	engineVersion           string
 	cancelSubRoutineCtxFunc context.CancelFunc
 	enclaveEnvVars          string
+	poolSize                uint8
+	// The number of idle enclaves from previous engine runs that were adopted by the pool when it was created
+	numAdoptedIdleEnclaves uint8
 }
 
 // CreateEnclavePool will do the following:
-// 1- Will remove idle enclaves from previous engine runs even if the pool is not activated (this is for removing
+// 1- Will adopt the idle enclaves from previous engine runs that can be reused, these are the running ones whose API
+// container runs the same version as the current engine with the same enclave env vars, up to the pool size
+// 2- Will remove the other idle enclaves from previous engine runs even if the pool is not activated (this is for removing
 // any resource leak after an engine restar without this feature enabled or after an engine crash)
-// 2- Wil create a new enclave pool object, if pool size > 1, return nil if pool size = 0, or return an error
-// 3- Will start a subroutine in charge of filling the pool
+// 3- Wil create a new enclave pool object, if pool size > 1, return nil if pool size = 0, or return an error
+// 4- Will start a subroutine in charge of filling the pool with the idle enclaves that weren't adopted
 func CreateEnclavePool(
 	kurtosisBackend backend_interface.KurtosisBackend,
 	enclaveCreator *EnclaveCreator,
@@ -45,10 +53,6 @@ func CreateEnclavePool(
 	enclaveEnvVars string,
 ) (*EnclavePool, error) {
 
-	//TODO the current implementation only removes the previous idle enclave, it's pending to implement the reusable feature
-	//TODO the reuse logic is not enable yet because we ned to store the APIC version on the APIContainer object in container-engine-lib
-	//TODO in order to using it for comparing it with the expected version
-
 	// Iterate on all the existing enclaves in order to find idle enclaves already created
 	// and reuse or destroy them if these were created from old Kurtosis version.
 	// It's executed as the first operation because the engine could be restarted or could crash
@@ -57,8 +61,17 @@ func CreateEnclavePool(
 	// We do our best effort to destroy idle enclaves from previous runs with a retry strategy
 	// but, we don't want to wait for it. If something fails, we suggest users to manually
 	// destroy the old idle enclaves showing them the UUIDs
+	// The reusable idle enclaves are looked up first, and synchronously, because these must be kept
 	now := time.Now()
-	go destroyIdleEnclavesFromPreviousRuns(kurtosisBackend, now)
+	var reusableIdleEnclaves []*kurtosis_engine_rpc_api_bindings.EnclaveInfo
+	if poolSize > 0 {
+		reusableIdleEnclaves = getReusableIdleEnclavesFromPreviousRuns(kurtosisBackend, now, engineVersion, enclaveEnvVars, poolSize)
+	}
+	idleEnclavesToKeep := map[enclave.EnclaveUUID]bool{}
+	for _, reusableIdleEnclave := range reusableIdleEnclaves {
+		idleEnclavesToKeep[enclave.EnclaveUUID(reusableIdleEnclave.GetEnclaveUuid())] = true
+	}
+	go destroyIdleEnclavesFromPreviousRuns(kurtosisBackend, now, idleEnclavesToKeep)
 
 	// validations
 	// poolSize = 0 means that the Enclave Pool won't be activated, it returns nil with no error
@@ -85,11 +98,18 @@ func CreateEnclavePool(
 		engineVersion:           engineVersion,
 		cancelSubRoutineCtxFunc: cancelCtxFunc,
 		enclaveEnvVars:          enclaveEnvVars,
+		poolSize:                poolSize,
+		numAdoptedIdleEnclaves:  uint8(len(reusableIdleEnclaves)),
+	}
+
+	for _, reusableIdleEnclave := range reusableIdleEnclaves {
+		idleEnclavesChan <- reusableIdleEnclave
+		logrus.Debugf("Idle enclave with UUID '%s' from a previous engine run was adopted by the pool", reusableIdleEnclave.GetEnclaveUuid())
 	}
 
 	go enclavePool.run(ctxWithCancel)
 
-	enclavePool.init(poolSize)
+	enclavePool.init(poolSize - enclavePool.numAdoptedIdleEnclaves)
 
 	return enclavePool, nil
 }
@@ -178,8 +198,20 @@ func (pool *EnclavePool) GetEnclave(
 	return enclaveInfo, nil
 }
 
-// Close stop the EnclavePool subroutine, in charge of filling the pool,
-// and removes all the idle enclaves already created
+// GetInfo returns the size of the pool and the state of its idle enclaves
+func (pool *EnclavePool) GetInfo() *kurtosis_engine_rpc_api_bindings.EnclavePoolInfo {
+	return &kurtosis_engine_rpc_api_bindings.EnclavePoolInfo{
+		PoolSize:               uint32(pool.poolSize),
+		ApiContainerVersion:    pool.engineVersion,
+		NumIdleEnclaves:        uint32(len(pool.idleEnclavesChan)),
+		NumAdoptedIdleEnclaves: uint32(pool.numAdoptedIdleEnclaves),
+	}
+}
+
+// Close stop the EnclavePool subroutine, in charge of filling the pool
+// The idle enclaves already created are kept running, so the next engine run can adopt them
+// if these are compatible with it, or remove them otherwise. Users that stop using the pool can
+// remove them with `kurtosis clean -a`, as documented in the engine stop and clean CLI references
 func (pool *EnclavePool) Close() error {
 
 	defer close(pool.idleEnclavesChan)
@@ -188,11 +220,6 @@ func (pool *EnclavePool) Close() error {
 	// will terminate running processes in the subroutine
 	pool.cancelSubRoutineCtxFunc()
 
-	// destroy all the idle enclaves
-	if err := destroyIdleEnclaves(pool.kurtosisBackend); err != nil {
-		return stacktrace.Propagate(err, "An error occurred destroying idle enclave")
-	}
-
 	return nil
 }
 
@@ -267,6 +294,8 @@ func (pool *EnclavePool) createNewIdleEnclave(ctx context.Context) (*kurtosis_en
 		enclaveName,
 		pool.enclaveEnvVars,
 		createTestEnclave,
+		// Idle enclaves have no Kubernetes settings so they can be handed out for any enclave created without them
+		nil,
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(
@@ -340,46 +369,125 @@ func areRequestedEnclaveParamsEqualToEnclaveInThePoolParams(
 	return false
 }
 
-func destroyIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend) error {
+const (
+	destroyEnclaveMaxRetries = 5
+
+	getReusableIdleEnclavesMaxRetries = 5
+)
+
+// getReusableIdleEnclavesFromPreviousRuns returns, up to maxIdleEnclaves, the idle enclaves created before the beforeTime
+// that can be reused with the given API container version and enclave env vars, with a retry strategy for the same reason as in destroyIdleEnclavesFromPreviousRuns
+// If something fails no idle enclave is reused, they will be replaced by new ones
+func getReusableIdleEnclavesFromPreviousRuns(
+	kurtosisBackend backend_interface.KurtosisBackend,
+	beforeTime time.Time,
+	apiContainerVersion string,
+	enclaveEnvVars string,
+	maxIdleEnclaves uint8,
+) []*kurtosis_engine_rpc_api_bindings.EnclaveInfo {
+	logrus.Debugf("Looking for idle enclaves created before '%s' that can be reused...", beforeTime)
+	var err error
+	var reusableIdleEnclaves []*kurtosis_engine_rpc_api_bindings.EnclaveInfo
+	numRetries := 0
+	for ; numRetries < getReusableIdleEnclavesMaxRetries; numRetries++ {
+		reusableIdleEnclaves, err = getReusableOldIdleEnclaves(kurtosisBackend, beforeTime, apiContainerVersion, enclaveEnvVars, maxIdleEnclaves)
+		if err == nil {
+			return reusableIdleEnclaves
+		}
+	}
+	logrus.Warnf("We tried to find the idle enclaves from previous runs that can be reused but something failed, even after retrying %v times; these will be replaced by new ones. Last error was:\n %v", numRetries, err)
+	return nil
+}
+
+func getReusableOldIdleEnclaves(
+	kurtosisBackend backend_interface.KurtosisBackend,
+	beforeTime time.Time,
+	apiContainerVersion string,
+	enclaveEnvVars string,
+	maxIdleEnclaves uint8,
+) ([]*kurtosis_engine_rpc_api_bindings.EnclaveInfo, error) {
 	ctx := context.Background()
 
-	filters := &enclave.EnclaveFilters{
-		UUIDs:    nil,
-		Statuses: nil,
+	enclaveFilters := &enclave.EnclaveFilters{
+		UUIDs: nil,
+		Statuses: map[enclave.EnclaveStatus]bool{
+			enclave.EnclaveStatus_Running: true,
+		},
+	}
+	enclaves, err := kurtosisBackend.GetEnclaves(ctx, enclaveFilters)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting enclaves using filters '%+v'", enclaveFilters)
 	}
 
-	enclaves, err := kurtosisBackend.GetEnclaves(ctx, filters)
+	apiContainerFilters := &api_container.APIContainerFilters{
+		EnclaveIDs: nil,
+		Statuses:   nil,
+	}
+	apiContainers, err := kurtosisBackend.GetAPIContainers(ctx, apiContainerFilters)
 	if err != nil {
-		return stacktrace.Propagate(err, "An error occurred getting enclaves using filters '%+v'", filters)
+		return nil, stacktrace.Propagate(err, "An error occurred getting API containers using filters '%+v'", apiContainerFilters)
 	}
 
-	idleEnclavesToRemove := map[enclave.EnclaveUUID]bool{}
+	enclaveEnvVarsHash := api_container.GetEnclaveEnvVarsHash(enclaveEnvVars)
 
-	for enclaveUUID, enclaveObj := range enclaves {
-		if isIdleEnclave(*enclaveObj) {
-			idleEnclavesToRemove[enclaveUUID] = true
-		}
+	// Sorting the UUIDs for always adopting the same idle enclaves when there are more than needed
+	var enclaveUUIDs []string
+	for enclaveUUID := range enclaves {
+		enclaveUUIDs = append(enclaveUUIDs, string(enclaveUUID))
 	}
+	sort.Strings(enclaveUUIDs)
 
-	if err := destroyEnclavesByUUID(ctx, kurtosisBackend, idleEnclavesToRemove); err != nil {
-		return stacktrace.Propagate(err, "An error occurred destroying enclaves with UUIDs '%v'", idleEnclavesToRemove)
+	reusableIdleEnclaves := []*kurtosis_engine_rpc_api_bindings.EnclaveInfo{}
+	for _, enclaveUUIDStr := range enclaveUUIDs {
+		if len(reusableIdleEnclaves) >= int(maxIdleEnclaves) {
+			break
+		}
+		enclaveUUID := enclave.EnclaveUUID(enclaveUUIDStr)
+		enclaveObj := enclaves[enclaveUUID]
+		enclaveCreationTime := enclaveObj.GetCreationTime()
+		if !isIdleEnclave(*enclaveObj) || enclaveCreationTime == nil || !enclaveCreationTime.Before(beforeTime) {
+			continue
+		}
+		apiContainer, found := apiContainers[enclaveUUID]
+		if !found || !isApiContainerReusable(apiContainer, apiContainerVersion, enclaveEnvVarsHash) {
+			continue
+		}
+		enclaveInfo, err := getEnclaveInfoForEnclave(ctx, kurtosisBackend, enclaveObj)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting information about idle enclave '%v'", enclaveUUID)
+		}
+		reusableIdleEnclaves = append(reusableIdleEnclaves, enclaveInfo)
 	}
-
-	return nil
+	return reusableIdleEnclaves, nil
 }
 
-const destroyEnclaveMaxRetries = 5
+// isApiContainerReusable returns whether the API container of an idle enclave from a previous run can be reused by the pool,
+// it has to be running the expected version with the expected enclave env vars, otherwise the enclave would be handed out with
+// the env vars of the previous engine run; API containers created by old Kurtosis versions don't record them, so they can't be reused
+func isApiContainerReusable(apiContainer *api_container.APIContainer, apiContainerVersion string, enclaveEnvVarsHash string) bool {
+	imageVersionTag := apiContainer.GetImageVersionTag()
+	apiContainerEnclaveEnvVarsHash := apiContainer.GetEnclaveEnvVarsHash()
+	return apiContainer.GetStatus() == container_status.ContainerStatus_Running &&
+		imageVersionTag != "" &&
+		imageVersionTag == apiContainerVersion &&
+		apiContainerEnclaveEnvVarsHash != "" &&
+		apiContainerEnclaveEnvVarsHash == enclaveEnvVarsHash
+}
 
-// destroyIdleEnclavesFromPreviousRuns destroy idle enclaves created before the beforeTime with a retry strategy
+// destroyIdleEnclavesFromPreviousRuns destroy idle enclaves created before the beforeTime, except the ones to keep, with a retry strategy
 // We have seen the "context deadline exceeded" from Kubernetes in the past, and this usually happens
 // because the Kubernetes has just started, and it is a bit slow to retrieve the information and throws that error
-func destroyIdleEnclavesFromPreviousRuns(kurtosisBackend backend_interface.KurtosisBackend, beforeTime time.Time) {
+func destroyIdleEnclavesFromPreviousRuns(
+	kurtosisBackend backend_interface.KurtosisBackend,
+	beforeTime time.Time,
+	idleEnclavesToKeep map[enclave.EnclaveUUID]bool,
+) {
 	logrus.Debugf("Destroying idle enclaves created before '%s'...", beforeTime)
 	var err error
 	var idleEnclavesToRemove map[enclave.EnclaveUUID]bool
 	numRetries := 0
 	for ; numRetries < destroyEnclaveMaxRetries; numRetries++ {
-		idleEnclavesToRemove, err = destroyOldIdleEnclaves(kurtosisBackend, beforeTime)
+		idleEnclavesToRemove, err = destroyOldIdleEnclaves(kurtosisBackend, beforeTime, idleEnclavesToKeep)
 		if err == nil {
 			break
 		}
@@ -395,7 +503,11 @@ func destroyIdleEnclavesFromPreviousRuns(kurtosisBackend backend_interface.Kurto
 	}
 }
 
-func destroyOldIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend, beforeTime time.Time) (map[enclave.EnclaveUUID]bool, error) {
+func destroyOldIdleEnclaves(
+	kurtosisBackend backend_interface.KurtosisBackend,
+	beforeTime time.Time,
+	idleEnclavesToKeep map[enclave.EnclaveUUID]bool,
+) (map[enclave.EnclaveUUID]bool, error) {
 	ctx := context.Background()
 
 	filters := &enclave.EnclaveFilters{
@@ -414,7 +526,7 @@ func destroyOldIdleEnclaves(kurtosisBackend backend_interface.KurtosisBackend, b
 		enclaveName := enclaveObj.GetName()
 		enclaveCreationTime := enclaveObj.GetCreationTime()
 		// is it an idle enclave from a previous run?
-		if strings.HasPrefix(enclaveName, idleEnclaveNamePrefix) && enclaveCreationTime.Before(beforeTime) {
+		if strings.HasPrefix(enclaveName, idleEnclaveNamePrefix) && enclaveCreationTime.Before(beforeTime) && !idleEnclavesToKeep[enclaveUUID] {
 			idleEnclavesToRemove[enclaveUUID] = true
 		}
 	}
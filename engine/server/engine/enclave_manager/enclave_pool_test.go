@@ -0,0 +1,39 @@
+package enclave_manager
+
+import (
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/api_container"
+	"github.com/kurtosis-tech/kurtosis/container-engine-lib/lib/backend_interface/objects/container_status"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+const (
+	poolApiContainerVersion  = "0.85.0"
+	otherApiContainerVersion = "0.84.0"
+	unknownImageVersionTag   = ""
+
+	poolEnclaveEnvVars        = "FOO=bar"
+	otherEnclaveEnvVars       = "FOO=baz"
+	unknownEnclaveEnvVarsHash = ""
+)
+
+var poolEnclaveEnvVarsHash = api_container.GetEnclaveEnvVarsHash(poolEnclaveEnvVars)
+
+func TestIsApiContainerReusable(t *testing.T) {
+	require.True(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Running, poolApiContainerVersion, poolEnclaveEnvVarsHash), poolApiContainerVersion, poolEnclaveEnvVarsHash))
+	require.False(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Stopped, poolApiContainerVersion, poolEnclaveEnvVarsHash), poolApiContainerVersion, poolEnclaveEnvVarsHash))
+	require.False(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Running, otherApiContainerVersion, poolEnclaveEnvVarsHash), poolApiContainerVersion, poolEnclaveEnvVarsHash))
+	require.False(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Running, unknownImageVersionTag, poolEnclaveEnvVarsHash), poolApiContainerVersion, poolEnclaveEnvVarsHash))
+	require.False(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Running, unknownImageVersionTag, poolEnclaveEnvVarsHash), unknownImageVersionTag, poolEnclaveEnvVarsHash))
+}
+
+func TestIsApiContainerReusable_RequiresSameEnclaveEnvVars(t *testing.T) {
+	otherEnclaveEnvVarsHash := api_container.GetEnclaveEnvVarsHash(otherEnclaveEnvVars)
+	require.False(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Running, poolApiContainerVersion, otherEnclaveEnvVarsHash), poolApiContainerVersion, poolEnclaveEnvVarsHash))
+	require.False(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Running, poolApiContainerVersion, unknownEnclaveEnvVarsHash), poolApiContainerVersion, poolEnclaveEnvVarsHash))
+	require.False(t, isApiContainerReusable(newTestApiContainer(container_status.ContainerStatus_Running, poolApiContainerVersion, unknownEnclaveEnvVarsHash), poolApiContainerVersion, unknownEnclaveEnvVarsHash))
+}
+
+func newTestApiContainer(status container_status.ContainerStatus, imageVersionTag string, enclaveEnvVarsHash string) *api_container.APIContainer {
+	return api_container.NewAPIContainer("enclave-uuid", status, nil, nil, nil, nil, nil, imageVersionTag, enclaveEnvVarsHash)
+}
@@ -20,8 +20,10 @@ import (
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args"
 	"github.com/kurtosis-tech/kurtosis/engine/launcher/args/kurtosis_backend_config"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/log_remover"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/logs_clock"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/stream_logs_strategy"
+	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_consts"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/centralized_logs/client_implementations/persistent_volume/volume_filesystem"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/enclave_manager"
 	"github.com/kurtosis-tech/kurtosis/engine/server/engine/server"
@@ -54,6 +56,9 @@ const (
 	remoteBackendConfigFilename = "remote_backend_config.json"
 	pathToStaticFolder          = "/run/webapp"
 	indexPath                   = "index.html"
+
+	bytesInMegabyte    = 1024 * 1024
+	logRemovalInterval = 6 * time.Hour
 )
 
 // Nil indicates that the KurtosisBackend should not operate in API container mode, which is appropriate here
@@ -145,11 +150,20 @@ func runMain() error {
 	perFileStreamStrategy := stream_logs_strategy.NewPerFileStreamLogsStrategy()
 	perFileLogsDatabaseClient := persistent_volume.NewPersistentVolumeLogsDatabaseClient(kurtosisBackend, osFs, perFileStreamStrategy)
 
+	logRetentionPeriod, err := getLogRetentionPeriod(serverArgs.LogRetentionPeriod)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred getting the log retention period from string '%v'", serverArgs.LogRetentionPeriod)
+	}
+
 	// pulls logs /per week/per enclave/per service
 	realTime := logs_clock.NewRealClock()
-	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(realTime)
+	perWeekStreamStrategy := stream_logs_strategy.NewPerWeekStreamLogsStrategy(realTime, logRetentionPeriod)
 	perWeekLogsDatabaseClient := persistent_volume.NewPersistentVolumeLogsDatabaseClient(kurtosisBackend, osFs, perWeekStreamStrategy)
 
+	// removes logs past the retention period or over the max logs storage size
+	logRemover := log_remover.NewLogRemover(osFs, realTime, logRetentionPeriod, serverArgs.LogRetentionMaxSizeInMegabytes*bytesInMegabyte)
+	go runLogRemoverPeriodically(logRemover)
+
 	go func() {
 		fileServer := http.FileServer(http.Dir(pathToStaticFolder))
 		handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -279,6 +293,31 @@ func getKurtosisBackend(ctx context.Context, kurtosisBackendType args.KurtosisBa
 	return kurtosisBackend, nil
 }
 
+// If the log retention period wasn't provided (e.g. the engine was launched by an older CLI), the default one is used
+func getLogRetentionPeriod(logRetentionPeriodStr string) (time.Duration, error) {
+	if logRetentionPeriodStr == "" {
+		return volume_consts.DefaultLogRetentionPeriod, nil
+	}
+	logRetentionPeriod, err := time.ParseDuration(logRetentionPeriodStr)
+	if err != nil {
+		return 0, stacktrace.Propagate(err, "An error occurred parsing the log retention period '%v'", logRetentionPeriodStr)
+	}
+	if logRetentionPeriod <= 0 {
+		return 0, stacktrace.NewError("The log retention period has to be greater than zero but it was '%v'", logRetentionPeriod)
+	}
+	return logRetentionPeriod, nil
+}
+
+// Runs the log remover right away and then every [logRemovalInterval] for as long as the engine is alive
+func runLogRemoverPeriodically(logRemover *log_remover.LogRemover) {
+	logRemover.Run()
+	ticker := time.NewTicker(logRemovalInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		logRemover.Run()
+	}
+}
+
 func formatFilenameFunctionForLogs(filename string, functionName string) string {
 	var output strings.Builder
 	output.WriteString("[")
@@ -12,6 +12,7 @@ import (
 	"github.com/kurtosis-tech/stacktrace"
 	"github.com/sirupsen/logrus"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"time"
 )
 
@@ -62,7 +63,8 @@ func NewEngineConnectServerService(
 
 func (service *EngineConnectServerService) GetEngineInfo(context.Context, *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse], error) {
 	result := &kurtosis_engine_rpc_api_bindings.GetEngineInfoResponse{
-		EngineVersion: service.imageVersionTag,
+		EngineVersion:   service.imageVersionTag,
+		EnclavePoolInfo: service.enclaveManager.GetEnclavePoolInfo(),
 	}
 	return connect.NewResponse(result), nil
 }
@@ -86,6 +88,7 @@ func (service *EngineConnectServerService) CreateEnclave(ctx context.Context, co
 		apiContainerLogLevel,
 		args.EnclaveName,
 		isProduction,
+		newKubernetesSettingsFromArgs(args.GetKubernetesSettings()),
 	)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred creating new enclave with name '%v'", args.EnclaveName)
@@ -127,13 +130,30 @@ func (service *EngineConnectServerService) StopEnclave(ctx context.Context, conn
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
+func (service *EngineConnectServerService) StartEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.StartEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
+	args := connectArgs.Msg
+	enclaveIdentifier := args.EnclaveIdentifier
+
+	if err := service.enclaveManager.StartEnclave(ctx, enclaveIdentifier); err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred starting enclave '%v'", enclaveIdentifier)
+	}
+
+	return connect.NewResponse(&emptypb.Empty{}), nil
+}
+
 func (service *EngineConnectServerService) DestroyEnclave(ctx context.Context, connectArgs *connect.Request[kurtosis_engine_rpc_api_bindings.DestroyEnclaveArgs]) (*connect.Response[emptypb.Empty], error) {
 	args := connectArgs.Msg
 	enclaveIdentifier := args.EnclaveIdentifier
 
+	enclaveUuid, err := service.enclaveManager.GetEnclaveUuidForEnclaveIdentifier(ctx, enclaveIdentifier)
+	if err != nil {
+		return nil, stacktrace.Propagate(err, "An error occurred getting the UUID of the enclave with identifier '%v'", enclaveIdentifier)
+	}
+
 	if err := service.enclaveManager.DestroyEnclave(ctx, enclaveIdentifier); err != nil {
 		return nil, stacktrace.Propagate(err, "An error occurred destroying enclave with identifier '%v':", args.EnclaveIdentifier)
 	}
+	service.removeEnclaveLogs(ctx, enclaveUuid)
 	return connect.NewResponse(&emptypb.Empty{}), nil
 }
 
@@ -144,6 +164,10 @@ func (service *EngineConnectServerService) Clean(ctx context.Context, connectArg
 		return nil, stacktrace.Propagate(err, "An error occurred while cleaning enclaves")
 	}
 
+	for _, removedEnclaveUuidAndName := range removedEnclaveUuidsAndNames {
+		service.removeEnclaveLogs(ctx, enclave.EnclaveUUID(removedEnclaveUuidAndName.GetUuid()))
+	}
+
 	response := &kurtosis_engine_rpc_api_bindings.CleanResponse{RemovedEnclaveNameAndUuids: removedEnclaveUuidsAndNames}
 	return connect.NewResponse(response), nil
 }
@@ -190,6 +214,11 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 		return stacktrace.Propagate(err, "An error occurred creating the conjunctive log line filters from the GRPC's conjunctive log line filters '%+v'", args.GetConjunctiveFilters())
 	}
 
+	logLineRange, err := newLogLineRangeFromGRPCArgs(args)
+	if err != nil {
+		return stacktrace.Propagate(err, "An error occurred creating the log line range from the GRPC's since '%v', until '%v' and number of log lines '%v'", args.GetSince(), args.GetUntil(), args.GetNumLogLines())
+	}
+
 	// get enclave creation time to determine strategy to pull logs
 	enclaveCreationTime, err := service.getEnclaveCreationTime(ctx, enclaveUuid)
 	if err != nil {
@@ -197,15 +226,16 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 	}
 	logsDatabaseClient := service.getLogsDatabaseClient(enclaveCreationTime)
 
-	serviceLogsByServiceUuidChan, errChan, cancelCtxFunc, err = logsDatabaseClient.StreamUserServiceLogs(contextWithCancel, enclaveUuid, requestedServiceUuids, conjunctiveLogLineFilters, shouldFollowLogs)
+	serviceLogsByServiceUuidChan, errChan, cancelCtxFunc, err = logsDatabaseClient.StreamUserServiceLogs(contextWithCancel, enclaveUuid, requestedServiceUuids, conjunctiveLogLineFilters, logLineRange, shouldFollowLogs)
 	if err != nil {
 		return stacktrace.Propagate(
 			err,
-			"An error occurred streaming service logs for UUIDs '%+v' in enclave with ID '%v' using filters '%v+' "+
-				"and with should follow logs value as '%v'",
+			"An error occurred streaming service logs for UUIDs '%+v' in enclave with ID '%v' using filters '%v+', "+
+				"range '%+v' and with should follow logs value as '%v'",
 			requestedServiceUuids,
 			enclaveUuid,
 			conjunctiveLogLineFilters,
+			logLineRange,
 			shouldFollowLogs,
 		)
 	}
@@ -245,6 +275,28 @@ func (service *EngineConnectServerService) GetServiceLogs(ctx context.Context, c
 	}
 }
 
+func (service *EngineConnectServerService) GetLogsStorageUsage(ctx context.Context, _ *connect.Request[emptypb.Empty]) (*connect.Response[kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse], error) {
+	sizeInBytesByEnclaveUuid := map[string]uint64{}
+	var totalSizeInBytes uint64
+	// enclaves created before and after the log retention feature store their logs following different schemas
+	for _, logsDatabaseClient := range []centralized_logs.LogsDatabaseClient{service.perWeekLogsDatabaseClient, service.perFileLogsDatabaseClient} {
+		logsSizeInBytesByEnclaveUuid, err := logsDatabaseClient.GetLogsSizeInBytesByEnclaveUuid(ctx)
+		if err != nil {
+			return nil, stacktrace.Propagate(err, "An error occurred getting the size of the logs stored for each enclave")
+		}
+		for enclaveUuid, logsSizeInBytes := range logsSizeInBytesByEnclaveUuid {
+			sizeInBytesByEnclaveUuid[string(enclaveUuid)] += logsSizeInBytes
+			totalSizeInBytes += logsSizeInBytes
+		}
+	}
+
+	response := &kurtosis_engine_rpc_api_bindings.GetLogsStorageUsageResponse{
+		TotalSizeInBytes:         totalSizeInBytes,
+		SizeInBytesByEnclaveUuid: sizeInBytesByEnclaveUuid,
+	}
+	return connect.NewResponse(response), nil
+}
+
 func (service *EngineConnectServerService) Close() error {
 	if err := service.enclaveManager.Close(); err != nil {
 		return stacktrace.Propagate(err, "An error occurred closing the enclave manager")
@@ -303,7 +355,8 @@ func newLogsResponse(
 		// there is no new log lines but is a found UUID, so it has to be included in the service logs map
 		if !found && !isInNotFoundUuidList {
 			serviceLogLinesByUuid[serviceUuidStr] = &kurtosis_engine_rpc_api_bindings.LogLine{
-				Line: nil,
+				Line:      nil,
+				Timestamp: nil,
 			}
 		}
 		//Remove the service's UUID from the initial not found list, if it was returned from the logs database
@@ -326,12 +379,14 @@ func newLogsResponse(
 func newRPCBindingsLogLineFromLogLines(logLines []logline.LogLine) *kurtosis_engine_rpc_api_bindings.LogLine {
 
 	logLinesStr := make([]string, len(logLines))
+	logLinesTimestamps := make([]*timestamppb.Timestamp, len(logLines))
 
 	for logLineIndex, logLine := range logLines {
 		logLinesStr[logLineIndex] = logLine.GetContent()
+		logLinesTimestamps[logLineIndex] = timestamppb.New(logLine.GetTimestamp())
 	}
 
-	rpcBindingsLogLines := &kurtosis_engine_rpc_api_bindings.LogLine{Line: logLinesStr}
+	rpcBindingsLogLines := &kurtosis_engine_rpc_api_bindings.LogLine{Line: logLinesStr, Timestamp: logLinesTimestamps}
 
 	return rpcBindingsLogLines
 }
@@ -379,6 +434,42 @@ func newConjunctiveLogLineFiltersFromGRPCLogLineFilters(
 	return conjunctiveLogLineFilters, nil
 }
 
+func newLogLineRangeFromGRPCArgs(args *kurtosis_engine_rpc_api_bindings.GetServiceLogsArgs) (*logline.LogLineRange, error) {
+	var since *time.Time
+	if args.GetSince() != nil {
+		if err := args.GetSince().CheckValid(); err != nil {
+			return nil, stacktrace.Propagate(err, "The since timestamp '%v' is not valid", args.GetSince())
+		}
+		sinceTime := args.GetSince().AsTime()
+		since = &sinceTime
+	}
+
+	var until *time.Time
+	if args.GetUntil() != nil {
+		if err := args.GetUntil().CheckValid(); err != nil {
+			return nil, stacktrace.Propagate(err, "The until timestamp '%v' is not valid", args.GetUntil())
+		}
+		untilTime := args.GetUntil().AsTime()
+		until = &untilTime
+	}
+
+	if since != nil && until != nil && !since.Before(*until) {
+		return nil, stacktrace.NewError("The since timestamp '%v' has to be earlier than the until timestamp '%v'", *since, *until)
+	}
+
+	return logline.NewLogLineRange(since, until, args.GetNumLogLines()), nil
+}
+
+// The enclave is gone already so failing to remove its logs isn't worth failing the request for, they'll be removed
+// once they fall out of the log retention period anyway
+func (service *EngineConnectServerService) removeEnclaveLogs(ctx context.Context, enclaveUuid enclave.EnclaveUUID) {
+	for _, logsDatabaseClient := range []centralized_logs.LogsDatabaseClient{service.perWeekLogsDatabaseClient, service.perFileLogsDatabaseClient} {
+		if err := logsDatabaseClient.RemoveEnclaveLogs(ctx, enclaveUuid); err != nil {
+			logrus.Warnf("An error occurred removing the logs of destroyed enclave '%v':\n%v", enclaveUuid, err)
+		}
+	}
+}
+
 // If the enclave was created prior to log retention, return the per file logs client
 func (service *EngineConnectServerService) getLogsDatabaseClient(enclaveCreationTime time.Time) centralized_logs.LogsDatabaseClient {
 	if enclaveCreationTime.After(logRetentionFeatureReleaseTime) {
@@ -405,3 +496,15 @@ func (service *EngineConnectServerService) getEnclaveCreationTime(ctx context.Co
 	}
 	return timestamp.AsTime(), nil
 }
+
+func newKubernetesSettingsFromArgs(kubernetesSettingsArgs *kurtosis_engine_rpc_api_bindings.KubernetesEnclaveSettings) *enclave.KubernetesSettings {
+	if kubernetesSettingsArgs == nil {
+		return nil
+	}
+	return enclave.NewKubernetesSettings(
+		kubernetesSettingsArgs.GetNamespaceLabels(),
+		kubernetesSettingsArgs.GetNamespaceAnnotations(),
+		kubernetesSettingsArgs.GetDefaultNodeSelectors(),
+		kubernetesSettingsArgs.GetServiceAccountName(),
+	)
+}
@@ -82,6 +82,7 @@ const (
 	testDatastoreValue = "test-value"
 
 	defaultDryRun = false
+	notOffline    = false
 	emptyParams   = "{}"
 
 	waitForGetAvaliabilityStalarkScript = `
@@ -119,6 +120,8 @@ var (
 	emptyCmdArgs                 = []string{}
 	emptyEnvVars                 = map[string]string{}
 	noExperimentalFeature        = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noGitHosts                   = []*kurtosis_core_rpc_api_bindings.GitHost{}
+	allLogLines                  *kurtosis_context.LogLineRange
 )
 
 var fileServerPortSpec = &kurtosis_core_rpc_api_bindings.Port{
@@ -161,7 +164,7 @@ func AddService(
 def run(plan):
 	plan.add_service(name = "%s", config = %s)
 `, serviceName, serviceConfigStarlark)
-	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, "{}", false, defaultParallelism, noExperimentalFeature)
+	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, "{}", false, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	if err != nil {
 		return nil, stacktrace.Propagate(err, "An error has occurred when running Starlark to add service")
 	}
@@ -190,7 +193,7 @@ func RemoveService(
 def run(plan):
 	plan.remove_service(name = "%s")
 `, serviceName)
-	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, "{}", false, defaultParallelism, noExperimentalFeature)
+	starlarkRunResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, starlarkScript, "{}", false, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	if err != nil {
 		return stacktrace.Propagate(err, "An error has occurred when running Starlark to remove service")
 	}
@@ -338,7 +341,7 @@ func AddAPIService(ctx context.Context, serviceName services.ServiceName, enclav
 }
 
 func RunScriptWithDefaultConfig(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, script string) (*enclaves.StarlarkRunResult, error) {
-	return enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, script, emptyParams, defaultDryRun, defaultParallelism, noExperimentalFeature)
+	return enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, script, emptyParams, defaultDryRun, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 }
 
 func SetupSimpleEnclaveAndRunScript(t *testing.T, ctx context.Context, testName string, script string) (*enclaves.StarlarkRunResult, error) {
@@ -459,7 +462,7 @@ func GetLogsResponse(
 	receivedNotFoundServiceGuids := map[services.ServiceUUID]bool{}
 	var testEvaluationErr error
 
-	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, serviceUuids, shouldFollowLogs, logLineFilter)
+	serviceLogsStreamContentChan, cancelStreamUserServiceLogsFunc, err := kurtosisCtx.GetServiceLogs(ctx, enclaveIdentifier, serviceUuids, shouldFollowLogs, logLineFilter, allLogLines)
 	defer cancelStreamUserServiceLogsFunc()
 	require.NoError(t, err, "An error occurred getting user service logs from user services with UUIDs '%+v' in enclave '%v' and with follow logs value '%v'", serviceUuids, enclaveIdentifier, shouldFollowLogs)
 
@@ -663,7 +666,7 @@ func createDatastoreClient(ipAddr string, portNum uint16) (datastore_rpc_api_bin
 
 func waitForFileServerAvailability(ctx context.Context, enclaveCtx *enclaves.EnclaveContext, serviceName services.ServiceName, portId string, endpoint string, initialDelayMilliseconds uint32, timeoutMilliseconds uint32) error {
 	starlarkParams := fmt.Sprintf(waitForGetAvaliabilityStalarkScriptParams, serviceName, portId, endpoint, initialDelayMilliseconds, timeoutMilliseconds)
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, waitForGetAvaliabilityStalarkScript, starlarkParams, false, defaultParallelism, noExperimentalFeature)
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, waitForGetAvaliabilityStalarkScript, starlarkParams, false, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	if err != nil {
 		return stacktrace.Propagate(err, "An unexpected error has occurred getting endpoint availability using Starlark")
 	}
@@ -0,0 +1,89 @@
+//go:build !kubernetes
+// +build !kubernetes
+
+// We don't run this test in Kubernetes because the logs collector is only stopped along with the enclave in Docker
+
+package enclave_start_logs_test
+
+import (
+	"context"
+	"github.com/kurtosis-tech/kurtosis-cli/golang_internal_testsuite/test_helpers"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/kurtosis-tech/kurtosis/api/golang/engine/lib/kurtosis_context"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+const (
+	// Tests that the logs of the services restored by starting a stopped enclave are still collected
+	testName = "enclave-start-logs"
+
+	serviceName services.ServiceName = "enclave-start-logs-service"
+
+	logLine = "Service started"
+
+	// the service keeps running after logging, so that it's restored when the enclave gets started again
+	serviceConfigStarlark = `ServiceConfig(
+	image = "docker/getting-started",
+	entrypoint = ["/bin/sh", "-c"],
+	cmd = ["echo '` + logLine + `' && sleep 999999"],
+)`
+
+	shouldFollowLogs = true
+
+	testTimeOut = 180 * time.Second
+
+	secondsToWaitForLogs = 1 * time.Second
+)
+
+var (
+	noLogLineFilter *kurtosis_context.LogLineFilter = nil
+)
+
+func TestServiceLogsAreCollectedAfterEnclaveStart(t *testing.T) {
+	ctx := context.Background()
+
+	// ------------------------------------- ENGINE SETUP ----------------------------------------------
+	enclaveCtx, stopEnclaveFunc, destroyEnclaveFunc, err := test_helpers.CreateEnclave(t, ctx, testName)
+	require.NoError(t, err, "An error occurred creating an enclave")
+	defer func() {
+		err = destroyEnclaveFunc()
+		require.NoError(t, err, "An error occurred destroying the enclave after the test finished")
+	}()
+
+	kurtosisCtx, err := kurtosis_context.NewKurtosisContextFromLocalEngine()
+	require.NoError(t, err)
+
+	// ------------------------------------- TEST SETUP ----------------------------------------------
+	serviceCtx, err := test_helpers.AddService(ctx, enclaveCtx, serviceName, serviceConfigStarlark)
+	require.NoError(t, err, "An error occurred adding service '%v'", serviceName)
+	serviceUuid := serviceCtx.GetServiceUUID()
+	serviceUuids := map[services.ServiceUUID]bool{
+		serviceUuid: true,
+	}
+	enclaveUuid := string(enclaveCtx.GetEnclaveUuid())
+
+	// It takes some time for logs to persist so we sleep to ensure logs have persisted
+	// Otherwise the test is flaky
+	time.Sleep(secondsToWaitForLogs)
+	expectedLogLinesBeforeEnclaveStart := map[services.ServiceUUID][]string{
+		serviceUuid: {logLine},
+	}
+	testEvaluationErr, receivedLogLinesByService, _ := test_helpers.GetLogsResponse(t, ctx, testTimeOut, kurtosisCtx, enclaveUuid, serviceUuids, expectedLogLinesBeforeEnclaveStart, shouldFollowLogs, noLogLineFilter)
+	require.NoError(t, testEvaluationErr)
+	require.Equal(t, expectedLogLinesBeforeEnclaveStart[serviceUuid], receivedLogLinesByService[serviceUuid])
+
+	// ------------------------------------- TEST RUN -------------------------------------------------
+	stopEnclaveFunc()
+	err = kurtosisCtx.StartEnclave(ctx, enclaveCtx.GetEnclaveName())
+	require.NoError(t, err, "An error occurred starting the enclave again")
+
+	// the restored service logged its line a second time, which the logs collector must have collected
+	expectedLogLinesAfterEnclaveStart := map[services.ServiceUUID][]string{
+		serviceUuid: {logLine, logLine},
+	}
+	testEvaluationErr, receivedLogLinesByService, _ = test_helpers.GetLogsResponse(t, ctx, testTimeOut, kurtosisCtx, enclaveUuid, serviceUuids, expectedLogLinesAfterEnclaveStart, shouldFollowLogs, noLogLineFilter)
+	require.NoError(t, testEvaluationErr)
+	require.Equal(t, expectedLogLinesAfterEnclaveStart[serviceUuid], receivedLogLinesByService[serviceUuid])
+}
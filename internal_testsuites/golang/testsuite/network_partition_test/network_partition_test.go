@@ -0,0 +1,145 @@
+//go:build !kubernetes
+// +build !kubernetes
+
+// We don't run this test in Kubernetes because the networking sidecars rely on ephemeral containers there, which can't
+// be removed once added to a pod
+package network_partition_test
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/kurtosis-tech/kurtosis-cli/golang_internal_testsuite/test_helpers"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/enclaves"
+	"github.com/kurtosis-tech/kurtosis/api/golang/core/lib/services"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testName = "network-partition"
+
+	serviceA = "service-a"
+	serviceB = "service-b"
+	serviceC = "service-c"
+
+	subnetwork1 = "subnetwork1"
+	subnetwork2 = "subnetwork2"
+	// Services that were never moved to a subnetwork live in this one
+	defaultSubnetwork = "default"
+
+	addedDelayMs = 250
+
+	successExitCode = int32(0)
+
+	addServicesScript = `
+def run(plan):
+	config = ServiceConfig(
+		image = "alpine:3.17",
+		entrypoint = ["sleep", "999999"],
+	)
+	plan.add_services(configs = {
+		"` + serviceA + `": config,
+		"` + serviceB + `": config,
+		"` + serviceC + `": config,
+	})
+`
+)
+
+var (
+	partitionScript = `
+def run(plan):
+	plan.update_service(name = "` + serviceA + `", config = UpdateServiceConfig(subnetwork = "` + subnetwork1 + `"))
+	plan.update_service(name = "` + serviceB + `", config = UpdateServiceConfig(subnetwork = "` + subnetwork2 + `"))
+	plan.set_connection(config = ConnectionConfig(packet_loss_percentage = 100.0), subnetworks = ("` + subnetwork1 + `", "` + subnetwork2 + `"))
+	plan.set_connection(
+		config = ConnectionConfig(latency = UniformPacketDelayDistribution(ms = ` + strconv.Itoa(addedDelayMs) + `)),
+		subnetworks = ("` + subnetwork1 + `", "` + defaultSubnetwork + `"),
+	)
+`
+
+	pingTimeRegexp = regexp.MustCompile(`time=([0-9.]+) ms`)
+)
+
+func TestNetworkPartitioning(t *testing.T) {
+	ctx := context.Background()
+
+	// ------------------------------------- ENGINE SETUP ----------------------------------------------
+	enclaveCtx, _, destroyEnclaveFunc, err := test_helpers.CreateEnclave(t, ctx, testName)
+	require.NoError(t, err, "An error occurred creating an enclave")
+	defer func() {
+		destroyErr := destroyEnclaveFunc()
+		if destroyErr != nil {
+			logrus.Errorf("Error destroying enclave at the end of integration test '%s'", testName)
+		}
+	}()
+
+	// ------------------------------------- TEST SETUP ----------------------------------------------
+	runScript(t, ctx, enclaveCtx, addServicesScript)
+
+	serviceACtx, err := enclaveCtx.GetServiceContext(serviceA)
+	require.NoError(t, err)
+	serviceBCtx, err := enclaveCtx.GetServiceContext(serviceB)
+	require.NoError(t, err)
+	serviceCCtx, err := enclaveCtx.GetServiceContext(serviceC)
+	require.NoError(t, err)
+
+	require.True(t, canPing(t, serviceACtx, serviceBCtx), "Services should be able to reach each other before partitioning")
+
+	// ------------------------------------- TEST RUN ----------------------------------------------
+	logrus.Infof("Partitioning the network")
+	runScript(t, ctx, enclaveCtx, partitionScript)
+
+	require.False(t, canPing(t, serviceACtx, serviceBCtx), "Traffic between '%s' and '%s' should be blocked", subnetwork1, subnetwork2)
+	require.True(t, canPing(t, serviceBCtx, serviceCCtx), "Traffic between '%s' and '%s' should be untouched", subnetwork2, defaultSubnetwork)
+
+	// Both ends of the connection delay their outgoing packets, so the round trip takes at least twice the delay
+	roundTripTimeMs := getPingRoundTripTimeMs(t, serviceACtx, serviceCCtx)
+	require.GreaterOrEqual(t, roundTripTimeMs, float64(2*addedDelayMs), "Traffic between '%s' and '%s' should be delayed", subnetwork1, defaultSubnetwork)
+
+	logrus.Infof("Healing the network")
+	err = enclaveCtx.Repartition(
+		ctx,
+		map[enclaves.PartitionID]map[services.ServiceName]bool{},
+		map[enclaves.PartitionID]map[enclaves.PartitionID]enclaves.PartitionConnection{},
+		enclaves.NewUnblockedPartitionConnection(),
+	)
+	require.NoError(t, err, "An error occurred healing the network")
+
+	require.True(t, canPing(t, serviceACtx, serviceBCtx), "Services should be able to reach each other once the network is healed")
+	roundTripTimeMs = getPingRoundTripTimeMs(t, serviceACtx, serviceCCtx)
+	require.Less(t, roundTripTimeMs, float64(addedDelayMs), "Traffic should not be delayed anymore once the network is healed")
+}
+
+// ====================================================================================================
+//
+//	Private helper functions
+//
+// ====================================================================================================
+func runScript(t *testing.T, ctx context.Context, enclaveCtx *enclaves.EnclaveContext, script string) {
+	runResult, err := test_helpers.RunScriptWithDefaultConfig(ctx, enclaveCtx, script)
+	require.NoError(t, err, "Unexpected error executing Starlark script")
+	require.Nil(t, runResult.InterpretationError, "Unexpected interpretation error")
+	require.Empty(t, runResult.ValidationErrors, "Unexpected validation error")
+	require.Nil(t, runResult.ExecutionError, "Unexpected execution error")
+}
+
+func canPing(t *testing.T, from *services.ServiceContext, to *services.ServiceContext) bool {
+	exitCode, _, err := from.ExecCommand([]string{"ping", "-c", "1", "-W", "2", to.GetPrivateIPAddress()})
+	require.NoError(t, err, "An error occurred running ping from '%s' to '%s'", from.GetServiceName(), to.GetServiceName())
+	return exitCode == successExitCode
+}
+
+func getPingRoundTripTimeMs(t *testing.T, from *services.ServiceContext, to *services.ServiceContext) float64 {
+	exitCode, output, err := from.ExecCommand([]string{"ping", "-c", "1", "-W", "5", to.GetPrivateIPAddress()})
+	require.NoError(t, err, "An error occurred running ping from '%s' to '%s'", from.GetServiceName(), to.GetServiceName())
+	require.Equal(t, successExitCode, exitCode, "Ping from '%s' to '%s' failed with output:\n%s", from.GetServiceName(), to.GetServiceName(), output)
+
+	matches := pingTimeRegexp.FindStringSubmatch(output)
+	require.Len(t, matches, 2, "Couldn't find the round trip time in the ping output:\n%s", output)
+	roundTripTimeMs, err := strconv.ParseFloat(matches[1], 64)
+	require.NoError(t, err)
+	return roundTripTimeMs
+}
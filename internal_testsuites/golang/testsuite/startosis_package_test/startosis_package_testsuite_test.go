@@ -18,13 +18,17 @@ const (
 	name                   = "startosis-package"
 	emptyRunParams         = "{}"
 	defaultDryRun          = false
+	doNotPrune             = false
+	notOffline             = false
 	defaultParallelism     = 4
 	useDefaultMainFile     = ""
 	useDefaultFunctionName = ""
 )
 
 var (
-	noExperimentalFeature = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noExperimentalFeature   = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noGitHosts              = []*kurtosis_core_rpc_api_bindings.GitHost{}
+	noPackageReplaceOptions = map[string]string{}
 )
 
 type StartosisPackageTestSuite struct {
@@ -73,5 +77,9 @@ func (suite *StartosisPackageTestSuite) RunPackageWithParams(ctx context.Context
 		defaultDryRun,
 		defaultParallelism,
 		noExperimentalFeature,
+		noPackageReplaceOptions,
+		doNotPrune,
+		noGitHosts,
+		notOffline,
 	)
 }
@@ -12,6 +12,8 @@ import (
 const (
 	testName               = "package"
 	defaultDryRun          = false
+	doNotPrune             = false
+	notOffline             = false
 	remotePackage          = "github.com/kurtosis-tech/datastore-army-package"
 	executeParams          = `{"num_datastores": 2}`
 	dataStoreService0Name  = "datastore-0"
@@ -23,7 +25,9 @@ const (
 )
 
 var (
-	noExperimentalFeature = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noExperimentalFeature   = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noGitHosts              = []*kurtosis_core_rpc_api_bindings.GitHost{}
+	noPackageReplaceOptions = map[string]string{}
 )
 
 func TestStartosisRemotePackage(t *testing.T) {
@@ -40,7 +44,7 @@ func TestStartosisRemotePackage(t *testing.T) {
 	// ------------------------------------- TEST RUN ----------------------------------------------
 	logrus.Debugf("Executing Starlark Package: '%v'", remotePackage)
 
-	runResult, err := enclaveCtx.RunStarlarkRemotePackageBlocking(ctx, remotePackage, useDefaultMainFile, useDefaultFunctionName, executeParams, defaultDryRun, defaultParallelism, noExperimentalFeature)
+	runResult, err := enclaveCtx.RunStarlarkRemotePackageBlocking(ctx, remotePackage, useDefaultMainFile, useDefaultFunctionName, executeParams, defaultDryRun, defaultParallelism, noExperimentalFeature, noPackageReplaceOptions, doNotPrune, noGitHosts, notOffline)
 	require.NoError(t, err, "Unexpected error executing starlark package")
 
 	require.Nil(t, runResult.InterpretationError, "Unexpected interpretation error. This test requires you to be online for the read_file command to run")
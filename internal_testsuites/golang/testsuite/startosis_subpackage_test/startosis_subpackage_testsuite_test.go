@@ -16,13 +16,17 @@ const (
 
 	emptyRunParams         = "{}"
 	defaultDryRun          = false
+	doNotPrune             = false
+	notOffline             = false
 	defaultParallelism     = 4
 	useDefaultMainFile     = ""
 	useDefaultFunctionName = ""
 )
 
 var (
-	noExperimentalFeature = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noExperimentalFeature   = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noGitHosts              = []*kurtosis_core_rpc_api_bindings.GitHost{}
+	noPackageReplaceOptions = map[string]string{}
 )
 
 type StartosisSubpackageTestSuite struct {
@@ -64,6 +68,10 @@ func (suite *StartosisSubpackageTestSuite) RunPackage(ctx context.Context, packa
 			defaultDryRun,
 			defaultParallelism,
 			noExperimentalFeature,
+			noPackageReplaceOptions,
+			doNotPrune,
+			noGitHosts,
+			notOffline,
 		)
 	}
 
@@ -76,5 +84,9 @@ func (suite *StartosisSubpackageTestSuite) RunPackage(ctx context.Context, packa
 		defaultDryRun,
 		defaultParallelism,
 		noExperimentalFeature,
+		noPackageReplaceOptions,
+		doNotPrune,
+		noGitHosts,
+		notOffline,
 	)
 }
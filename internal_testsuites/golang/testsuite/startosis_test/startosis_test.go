@@ -12,6 +12,7 @@ import (
 const (
 	testName           = "module"
 	defaultDryRun      = false
+	notOffline         = false
 	defaultParallelism = 4
 	greetingsArg       = `{"greeting": "World!"}`
 
@@ -106,6 +107,7 @@ def run(plan, args):
 
 var (
 	noExperimentalFeature = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noGitHosts            = []*kurtosis_core_rpc_api_bindings.GitHost{}
 )
 
 func TestStartosis(t *testing.T) {
@@ -123,7 +125,7 @@ func TestStartosis(t *testing.T) {
 	logrus.Infof("Executing Startosis script...")
 	logrus.Debugf("Startosis script content: \n%v", startosisScript)
 
-	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, startosisScript, greetingsArg, defaultDryRun, defaultParallelism, noExperimentalFeature)
+	runResult, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, useDefaultMainFile, startosisScript, greetingsArg, defaultDryRun, defaultParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	require.NoError(t, err, "Unexpected error executing startosis script")
 
 	require.Nil(t, runResult.InterpretationError, "Unexpected interpretation error. This test requires you to be online for the read_file command to run")
@@ -16,6 +16,8 @@ import (
 
 const (
 	defaultDryRun      = false
+	doNotPrune         = false
+	notOffline         = false
 	defaultParallelism = 1
 
 	validPackageWithInputTestName = "upload-file-package"
@@ -29,7 +31,9 @@ const (
 )
 
 var (
-	noExperimentalFeature = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noExperimentalFeature   = []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{}
+	noGitHosts              = []*kurtosis_core_rpc_api_bindings.GitHost{}
+	noPackageReplaceOptions = map[string]string{}
 )
 
 func TestStartosisPackage_ValidPackageWithInput(t *testing.T) {
@@ -67,7 +71,7 @@ func TestStartosisPackage_ValidPackageWithInput(t *testing.T) {
 	// Note: the result extracted from the recipe inside Starlark contains a newline char at the end.
 	// We need to add it here manually to have matching hashes
 	params := fmt.Sprintf(`{"file_hash": "%s\n"}`, randomFileHexHash)
-	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, useDefaultMainFile, useDefaultFunctionName, params, defaultDryRun, defaultParallelism, noExperimentalFeature)
+	runResult, err := enclaveCtx.RunStarlarkPackageBlocking(ctx, packageDirpath, useDefaultMainFile, useDefaultFunctionName, params, defaultDryRun, defaultParallelism, noExperimentalFeature, noPackageReplaceOptions, doNotPrune, noGitHosts, notOffline)
 	require.NoError(t, err, "Unexpected error executing Starlark package")
 
 	// the package itself runs the assertion here. If the file hash computed withing the enclave with md5sum differs
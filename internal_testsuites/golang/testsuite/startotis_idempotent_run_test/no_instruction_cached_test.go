@@ -19,10 +19,12 @@ const (
 	noParams        = "{}"
 	noDryRun        = false
 	noParallelism   = 1
+	notOffline      = false
 )
 
 var (
 	noExperimentalFeature []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag
+	noGitHosts            []*kurtosis_core_rpc_api_bindings.GitHost
 )
 
 func TestStartosisIdempotentRun_RunSameScriptTwice(t *testing.T) {
@@ -168,7 +170,7 @@ func TestStartosisIdempotentRun_DeactivateInstructionsCaching(t *testing.T) {
 	deactivateInstructionsCaching := []kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag{
 		kurtosis_core_rpc_api_bindings.KurtosisFeatureFlag_NO_INSTRUCTIONS_CACHING,
 	}
-	run2result, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, runFunctionName, scriptToRun, noParams, noDryRun, noParallelism, deactivateInstructionsCaching)
+	run2result, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, runFunctionName, scriptToRun, noParams, noDryRun, noParallelism, deactivateInstructionsCaching, noGitHosts, notOffline)
 	require.NoError(t, err)
 
 	require.Nil(t, run2result.InterpretationError)
@@ -182,7 +184,7 @@ func TestStartosisIdempotentRun_DeactivateInstructionsCaching(t *testing.T) {
 }
 
 func mustRunStarlarkScript(t *testing.T, enclaveCtx *enclaves.EnclaveContext, ctx context.Context, script string) string {
-	result, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, runFunctionName, script, noParams, noDryRun, noParallelism, noExperimentalFeature)
+	result, err := enclaveCtx.RunStarlarkScriptBlocking(ctx, runFunctionName, script, noParams, noDryRun, noParallelism, noExperimentalFeature, noGitHosts, notOffline)
 	require.NoError(t, err)
 
 	require.Nil(t, result.InterpretationError)